@@ -63,10 +63,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LocalIdentity{},
 		&LocalIdentityList{},
 		&PasswordReq{},
+		&UnlockReq{},
+		&PolicySimulation{},
+		&AccessRequest{},
+		&AccessRequestList{},
+		&MultiFactorEnrollment{},
+		&MultiFactorEnrollmentList{},
+		&MultiFactorRequest{},
 		&APIKey{},
 		&APIKeyList{},
 		&APIKeyReq{},
 		&APIKeyReqPassword{},
+		&APIKeyRotateReq{},
 		&APISigningKey{},
 		&APISigningKeyList{},
 		&Category{},
@@ -88,6 +96,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GroupList{},
 		&IdentityProvider{},
 		&IdentityProviderList{},
+		&FederatedIdentity{},
+		&FederatedIdentityList{},
 		&Client{},
 		&ClientList{},
 
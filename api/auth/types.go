@@ -82,6 +82,9 @@ const (
 
 	// CustomPolicyBindingFinalize is an internal finalizer values to CustomPolicyBinding.
 	CustomPolicyBindingFinalize FinalizerName = "custompolicybinding"
+
+	// AccessRequestFinalize is an internal finalizer values to AccessRequest.
+	AccessRequestFinalize FinalizerName = "accessrequest"
 )
 
 // LocalIdentitySpec is a description of an identity.
@@ -114,6 +117,24 @@ type LocalIdentityStatus struct {
 	// The last time the local identity was updated.
 	// +optional
 	LastUpdateTime metav1.Time
+
+	// PasswordChangeTime is the last time the password was changed, the
+	// creation time of the local identity is used if it is not set.
+	// +optional
+	PasswordChangeTime metav1.Time
+	// PasswordHistory are the hashes of the previous passwords, the most
+	// recent first, which can not be reused.
+	// +optional
+	PasswordHistory []string
+	// FailedLoginCount is the number of consecutive failed logins.
+	// +optional
+	FailedLoginCount int32
+	// +optional
+	LastFailedLoginTime metav1.Time
+	// LockoutExpireTime is the time until which the local identity is locked
+	// out after too many failed logins.
+	// +optional
+	LockoutExpireTime metav1.Time
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -126,6 +147,207 @@ type PasswordReq struct {
 	OriginalPassword string
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UnlockReq unlocks a localIdentity which is locked by an administrator or
+// locked out after too many failed logins.
+type UnlockReq struct {
+	metav1.TypeMeta
+
+	// Reason is recorded in the log of the auth api server.
+	// +optional
+	Reason string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorEnrollment holds the second factors enrolled by a local identity,
+// its name is the same as the name of the local identity.
+type MultiFactorEnrollment struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the second factors of the local identity.
+	Spec MultiFactorEnrollmentSpec
+	// +optional
+	Status MultiFactorEnrollmentStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorEnrollmentList is the whole list of all multi-factor enrollments.
+type MultiFactorEnrollmentList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of multi-factor enrollments.
+	Items []MultiFactorEnrollment
+}
+
+// MultiFactorEnrollmentSpec is a description of the second factors of a local identity.
+type MultiFactorEnrollmentSpec struct {
+	TenantID string
+	// Username is the username of the local identity.
+	Username string
+	// +optional
+	TOTP *TOTPCredential
+	// +optional
+	WebAuthn []WebAuthnCredential
+	// RecoveryCodes are the bcrypt hashes of the unused recovery codes.
+	// +optional
+	RecoveryCodes []string
+}
+
+// TOTPCredential is a secret of time-based one-time passwords, see RFC 6238.
+type TOTPCredential struct {
+	// Secret is the base32 encoded shared secret.
+	Secret string
+	// Verified represents whether the user has proved the possession of the
+	// secret, only verified secrets are accepted when logging in.
+	Verified bool
+	// +optional
+	CreationTime metav1.Time
+}
+
+// WebAuthnCredential is a public key credential registered by an authenticator.
+type WebAuthnCredential struct {
+	// ID is the base64url encoded id of the credential.
+	ID string
+	// +optional
+	Name string
+	// PublicKey is the base64 encoded DER SubjectPublicKeyInfo of the credential.
+	PublicKey string
+	// Algorithm is the COSE algorithm identifier of the public key.
+	Algorithm int64
+	// SignCount is the last signature counter reported by the authenticator.
+	// +optional
+	SignCount int64
+	// +optional
+	CreationTime metav1.Time
+	// +optional
+	LastUsedTime metav1.Time
+}
+
+// MultiFactorEnrollmentStatus is a description of a multi-factor enrollment status.
+type MultiFactorEnrollmentStatus struct {
+	// Challenges are the pending challenges of webauthn ceremonies.
+	// +optional
+	Challenges []WebAuthnChallenge
+	// LastVerifiedTime is the last time a second factor is verified when logging in.
+	// +optional
+	LastVerifiedTime metav1.Time
+}
+
+// WebAuthnPurpose defines the ceremony a webauthn challenge is issued for.
+type WebAuthnPurpose string
+
+const (
+	// WebAuthnPurposeRegistration means the challenge is used to register a credential.
+	WebAuthnPurposeRegistration WebAuthnPurpose = "Registration"
+	// WebAuthnPurposeAuthentication means the challenge is used to log in.
+	WebAuthnPurposeAuthentication WebAuthnPurpose = "Authentication"
+)
+
+// WebAuthnChallenge is a challenge issued for a webauthn ceremony.
+type WebAuthnChallenge struct {
+	// Challenge is the base64url encoded random challenge.
+	Challenge  string
+	Purpose    WebAuthnPurpose
+	ExpireTime metav1.Time
+}
+
+// MultiFactorAction defines the action of a multi-factor request.
+type MultiFactorAction string
+
+const (
+	// MultiFactorEnrollTOTP generates a new totp secret, which must be verified
+	// before it is used to log in.
+	MultiFactorEnrollTOTP MultiFactorAction = "EnrollTOTP"
+	// MultiFactorVerifyTOTP verifies the totp secret by a one-time password.
+	MultiFactorVerifyTOTP MultiFactorAction = "VerifyTOTP"
+	// MultiFactorRemoveTOTP removes the totp secret.
+	MultiFactorRemoveTOTP MultiFactorAction = "RemoveTOTP"
+	// MultiFactorBeginWebAuthn issues a challenge to register a webauthn credential.
+	MultiFactorBeginWebAuthn MultiFactorAction = "BeginWebAuthn"
+	// MultiFactorRegisterWebAuthn registers the webauthn credential created
+	// for the issued challenge.
+	MultiFactorRegisterWebAuthn MultiFactorAction = "RegisterWebAuthn"
+	// MultiFactorRemoveWebAuthn removes a webauthn credential.
+	MultiFactorRemoveWebAuthn MultiFactorAction = "RemoveWebAuthn"
+	// MultiFactorGenerateRecoveryCodes replaces the recovery codes with new ones.
+	MultiFactorGenerateRecoveryCodes MultiFactorAction = "GenerateRecoveryCodes"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorRequest enrolls or removes a second factor of a local identity.
+type MultiFactorRequest struct {
+	metav1.TypeMeta
+
+	Spec MultiFactorRequestSpec
+	// +optional
+	Status MultiFactorRequestStatus
+}
+
+// MultiFactorRequestSpec is a description of a multi-factor request.
+type MultiFactorRequestSpec struct {
+	Action MultiFactorAction
+	// Code is the one-time password, required by VerifyTOTP.
+	// +optional
+	Code string
+	// WebAuthn is the credential created by the authenticator, required by
+	// RegisterWebAuthn. Only the id is required by RemoveWebAuthn.
+	// +optional
+	WebAuthn *WebAuthnRegistration
+}
+
+// WebAuthnRegistration is the public key credential created by the authenticator.
+type WebAuthnRegistration struct {
+	// ID is the base64url encoded id of the credential.
+	ID string
+	// +optional
+	Name string
+	// PublicKey is the base64 encoded DER SubjectPublicKeyInfo returned by
+	// AuthenticatorAttestationResponse.getPublicKey().
+	// +optional
+	PublicKey string
+	// Algorithm is the COSE algorithm identifier returned by
+	// AuthenticatorAttestationResponse.getPublicKeyAlgorithm().
+	// +optional
+	Algorithm int64
+	// ClientDataJSON is the base64url encoded client data of the response.
+	// +optional
+	ClientDataJSON string
+}
+
+// MultiFactorRequestStatus is the result of a multi-factor request.
+type MultiFactorRequestStatus struct {
+	// TOTPSecret is the base32 encoded secret generated by EnrollTOTP.
+	// +optional
+	TOTPSecret string
+	// TOTPURI is the otpauth uri of the secret, which is usually shown as a qr code.
+	// +optional
+	TOTPURI string
+	// Challenge is the base64url encoded challenge issued by BeginWebAuthn.
+	// +optional
+	Challenge string
+	// RelyingPartyID is the webauthn relying party id of the platform.
+	// +optional
+	RelyingPartyID string
+	// RecoveryCodes are generated by GenerateRecoveryCodes, they are only
+	// shown once.
+	// +optional
+	RecoveryCodes []string
+	// Factors are the second factors enrolled after the request.
+	// +optional
+	Factors []string
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -308,6 +530,29 @@ type APIKeySpec struct {
 
 	// ExpireAt is the expire time for api key
 	ExpireAt metav1.Time `json:"expire_at,omitempty"`
+
+	// Scope restricts the api key to a subset of the actions, resources and
+	// projects its owner is allowed to. The key is not restricted if empty.
+	Scope *APIKeyScope `json:"scope,omitempty"`
+
+	// SourceIPs restricts the addresses, given as IPs or CIDRs, the api key
+	// may be used from. The key may be used from anywhere if empty.
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+
+	// Predecessor is the name of the api key this one was rotated from.
+	Predecessor string `json:"predecessor,omitempty"`
+}
+
+// APIKeyScope restricts an api key. Each list holds patterns which may
+// contain "*"; an empty list does not restrict the key.
+type APIKeyScope struct {
+	// Actions are the actions, such as "getCluster", allowed with the api key.
+	Actions []string `json:"actions,omitempty"`
+	// Resources are the resources, such as "cluster:cls-xxx", allowed with
+	// the api key.
+	Resources []string `json:"resources,omitempty"`
+	// Projects are the projects the api key may be used in.
+	Projects []string `json:"projects,omitempty"`
 }
 
 // APIKeyStatus is a description of an api key status.
@@ -316,6 +561,15 @@ type APIKeyStatus struct {
 	Disabled bool `json:"disabled"`
 	// Expired represents whether the apikey has been expired.
 	Expired bool `json:"expired"`
+	// LastUsedTime is the time the api key was last used to authenticate.
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty"`
+	// LastUsedIP is the source address the api key was last used from.
+	LastUsedIP string `json:"lastUsedIP,omitempty"`
+	// Successor is the name of the api key this one was rotated to.
+	Successor string `json:"successor,omitempty"`
+	// RetireAt is the end of the overlap window of a rotated api key, after
+	// which it is no longer accepted.
+	RetireAt metav1.Time `json:"retireAt,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -329,6 +583,12 @@ type APIKeyReq struct {
 
 	// Description describes api keys usage.
 	Description string `json:"description"`
+
+	// Scope restricts the api key to a subset of the permissions of its owner.
+	Scope *APIKeyScope `json:"scope,omitempty"`
+
+	// SourceIPs restricts the addresses the api key may be used from.
+	SourceIPs []string `json:"sourceIPs,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -351,6 +611,30 @@ type APIKeyReqPassword struct {
 
 	// Expire holds the duration of the api key become invalid. By default, 168h(= seven days)
 	Expire metav1.Duration `json:"expire,omitempty"`
+
+	// Scope restricts the api key to a subset of the permissions of its owner.
+	Scope *APIKeyScope `json:"scope,omitempty"`
+
+	// SourceIPs restricts the addresses the api key may be used from.
+	SourceIPs []string `json:"sourceIPs,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIKeyRotateReq rotates an api key: a successor with the same scope and
+// source IPs is issued, and the rotated key keeps working until the overlap
+// window ends.
+type APIKeyRotateReq struct {
+	metav1.TypeMeta
+
+	// Expire holds the duration of the successor become invalid. By default, 168h(= seven days)
+	Expire metav1.Duration `json:"expire,omitempty"`
+
+	// Overlap holds the duration the rotated api key is still accepted. By default, 24h
+	Overlap metav1.Duration `json:"overlap,omitempty"`
+
+	// Description describes the usage of the successor, by default the one of the rotated api key.
+	Description string `json:"description,omitempty"`
 }
 
 // +genclient
@@ -500,7 +784,11 @@ type PolicySpec struct {
 	Username    string
 	Description string
 	Statement   Statement
-	Conditions  []byte
+	// Conditions is the json encoded conditions restricting when the
+	// statement takes effect, by the source ip, the time of day, the request
+	// labels, the resource tags, the presence of a second factor and the
+	// user extra.
+	Conditions []byte
 }
 
 // Statement defines a series of action on resource can be done or not.
@@ -931,6 +1219,253 @@ type AllowedStatus struct {
 	EvaluationError string
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PolicySimulation simulates an action on a resource with the local
+// authorizer, and explains the decision by the policies, roles and bindings
+// involved. A simulation without user and group finds who can perform the
+// action on the resource.
+type PolicySimulation struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec describes the access to simulate.
+	Spec PolicySimulationSpec
+	// Status is filled in by the server with the result of the simulation.
+	// +optional
+	Status PolicySimulationStatus
+}
+
+// PolicySimulationSpec describes the access to simulate.
+type PolicySimulationSpec struct {
+	// TenantID is the tenant of the subject, the tenant of the requester is
+	// used if it is not set.
+	// +optional
+	TenantID string
+	// User is the name of the user to simulate.
+	// +optional
+	User string
+	// Group is the id of the group to simulate if user is not set.
+	// +optional
+	Group string
+	// ProjectID is the project the action is performed in.
+	// +optional
+	ProjectID string
+	// Action is the action as in policy statements, such as getCluster.
+	Action string
+	// Resource is the resource as in policy statements, such as cluster:cls-xxx.
+	Resource string
+	// Extra are the extra attributes of the subject, such as the source ip and
+	// the request labels the conditions of policies are evaluated on.
+	// +optional
+	Extra map[string]ExtraValue
+}
+
+// PolicySimulationStatus is the result of a policy simulation.
+type PolicySimulationStatus struct {
+	// Review is the decision of the local authorizer as answered to subject
+	// access reviews.
+	// +optional
+	Review SubjectAccessReviewStatus
+	// Matches are the policies of the subject matching the action on the
+	// resource.
+	// +optional
+	Matches []PolicyMatch
+	// Users are the users who can perform the action on the resource, only
+	// set for simulations without user and group. The policies bound to all
+	// users do not list any user.
+	// +optional
+	Users []Subject
+	// Groups are the groups which can perform the action on the resource,
+	// only set for simulations without user and group.
+	// +optional
+	Groups []Subject
+}
+
+// PolicyMatch is a policy matching the simulated access and the chain of
+// objects through which it applies to the subject.
+type PolicyMatch struct {
+	// Policy is the id of the policy.
+	Policy string
+	// Effect is the effect of the policy statement.
+	Effect Effect
+	// Applied is false if the conditions of the policy keep it from taking
+	// effect.
+	Applied bool
+	// Conditions explains the evaluation of the conditions of the policy.
+	// +optional
+	Conditions string
+	// Chain lists the objects linking the subject to the policy, starting
+	// with the subject and ending with the policy.
+	Chain []PolicyLink
+}
+
+// PolicyLink is an object on the chain linking a subject to a policy.
+type PolicyLink struct {
+	// Kind is one of User, Group, Role, Policy, ProjectPolicyBinding and
+	// CustomPolicyBinding.
+	Kind string
+	// Name is the name of the object.
+	Name string
+	// ProjectID is the project the link applies in, empty if it applies in
+	// all projects.
+	// +optional
+	ProjectID string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest is a request of a user for a temporary grant of a project
+// policy or of a cluster role. Once approved, the grant is bound to the user
+// until it expires after the requested hours or is revoked.
+type AccessRequest struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the requested grant.
+	// +optional
+	Spec AccessRequestSpec
+	// +optional
+	Status AccessRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is the whole list of all access requests.
+type AccessRequestList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of access requests
+	Items []AccessRequest
+}
+
+// AccessRequestSpec is the description of an access request. It requests
+// either a project scoped policy on a project, or a cluster role of the
+// authz api on clusters.
+type AccessRequestSpec struct {
+	// +optional
+	TenantID string
+	// Username is the user to grant, set by the server to the requester
+	// unless the request is filed by the platform itself.
+	// +optional
+	Username string
+	// ProjectID is the project of the grant. It is required for a policy, and
+	// is the namespace of the role binding for a cluster role.
+	// +optional
+	ProjectID string
+	// PolicyID is the project scoped policy to grant on the project.
+	// +optional
+	PolicyID string
+	// ClusterRole is the authz role to grant on the clusters, in the form of
+	// namespace/name.
+	// +optional
+	ClusterRole string
+	// Clusters are the clusters of the cluster role, "*" for all clusters.
+	// +optional
+	Clusters []string
+	// Hours is how long the grant lasts once approved.
+	Hours int32
+	// Reason explains why the access is needed.
+	Reason string
+	// Notification is the message request created for every change of the
+	// phase of the request, nothing is sent if it is not set.
+	// +optional
+	Notification *AccessRequestNotification
+}
+
+// AccessRequestNotification refers to the notify channel, template and
+// receivers used to notify the changes of access requests.
+type AccessRequestNotification struct {
+	ChannelName  string
+	TemplateName string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
+}
+
+// AccessRequestStatus represents information about the status of an access
+// request.
+type AccessRequestStatus struct {
+	// +optional
+	Phase AccessRequestPhase
+	// Reviewer is the user who approved or rejected the request.
+	// +optional
+	Reviewer string
+	// Comment is the explanation of the reviewer.
+	// +optional
+	Comment string
+	// StartTime is the time the grant was bound to the user.
+	// +optional
+	StartTime metav1.Time
+	// ExpireTime is the time the grant is removed from the user.
+	// +optional
+	ExpireTime metav1.Time
+	// Granted is true if the grant is bound to the user by this request,
+	// and is removed at expiry. It is false if the user had the grant already.
+	// +optional
+	Granted bool
+	// BindingName is the project policy binding or the multi cluster role
+	// binding of the grant.
+	// +optional
+	BindingName string
+	// History is the audit trail of the request, one event for every change
+	// of its phase.
+	// +optional
+	History []AccessRequestEvent
+	// A human readable message indicating details about the last failure.
+	// +optional
+	Message string
+}
+
+// AccessRequestEvent records a change of the phase of an access request.
+type AccessRequestEvent struct {
+	Phase AccessRequestPhase
+	// Actor is the user who made the change, empty for the changes made by
+	// the controller.
+	// +optional
+	Actor string
+	Time  metav1.Time
+	// +optional
+	Message string
+	// Notified is true once the event has been sent through the notification
+	// of the request.
+	// +optional
+	Notified bool
+}
+
+// AccessRequestPhase indicates the phase of access requests.
+type AccessRequestPhase string
+
+// These are valid phases of access requests.
+const (
+	// AccessRequestPending indicates that the request is waiting for approval.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestApproved indicates that the request has been approved and
+	// waits for the grant to be bound.
+	AccessRequestApproved AccessRequestPhase = "Approved"
+	// AccessRequestRejected indicates that the request has been rejected.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestActive indicates that the grant is bound to the user.
+	AccessRequestActive AccessRequestPhase = "Active"
+	// AccessRequestExpired indicates that the grant has been removed at
+	// expiry.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+	// AccessRequestRevoked indicates that the grant has been revoked by an
+	// approver before expiry.
+	AccessRequestRevoked AccessRequestPhase = "Revoked"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -968,6 +1503,118 @@ type IdentityProviderSpec struct {
 	// Config holds all the configuration information specific to the connector type. Since there
 	// no generic struct we can use for this purpose, it is stored as a json string.
 	Config string
+	// MultiFactor is the multi-factor authentication policy of the local
+	// identities of the tenant.
+	// +optional
+	MultiFactor *MultiFactorPolicy
+	// PasswordPolicy is the password policy of the local identities of the
+	// tenant.
+	// +optional
+	PasswordPolicy *PasswordPolicy
+}
+
+// PasswordPolicy defines the password rules and the lockout of the local
+// identities of a tenant.
+type PasswordPolicy struct {
+	// MinLength is the minimum length of passwords.
+	// +optional
+	MinLength int32
+	// +optional
+	RequireUppercase bool
+	// +optional
+	RequireLowercase bool
+	// +optional
+	RequireDigit bool
+	// +optional
+	RequireSymbol bool
+	// HistoryCount is the number of previous passwords which can not be
+	// reused, including the current one.
+	// +optional
+	HistoryCount int32
+	// MaxAge is the maximum age of passwords, an expired password must be
+	// changed when logging in. Zero means passwords never expire.
+	// +optional
+	MaxAge metav1.Duration
+	// LockoutThreshold is the number of consecutive failed logins after which
+	// the local identity is locked out. Zero disables the lockout.
+	// +optional
+	LockoutThreshold int32
+	// LockoutDuration is how long the local identity is locked out. Zero
+	// means it is locked until unlocked by an administrator.
+	// +optional
+	LockoutDuration metav1.Duration
+}
+
+// MultiFactorPolicy makes the multi-factor authentication mandatory for the
+// local identities which are members of the groups or bound to the roles.
+// Local identities which have enrolled a second factor are always required to
+// use it. The ones which have not enrolled any yet log in with the password
+// only, so that they are able to enroll one through the mfa subresource of
+// their local identities, administrators may also enroll one for them through
+// the subresource beforehand.
+type MultiFactorPolicy struct {
+	// Groups are the names of the local groups.
+	// +optional
+	Groups []string
+	// Roles are the names of the roles.
+	// +optional
+	Roles []string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedIdentity records a user that logged in through an upstream
+// identity provider, such as saml or oidc, together with the groups
+// claimed by the upstream provider.
+type FederatedIdentity struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of federated identity in this set.
+	Spec FederatedIdentitySpec
+	// +optional
+	Status FederatedIdentityStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedIdentityList is the whole list of all federated identities.
+type FederatedIdentityList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of federated identities.
+	Items []FederatedIdentity
+}
+
+// FederatedIdentitySpec is a description of a federated identity.
+type FederatedIdentitySpec struct {
+	TenantID string
+	// ConnectorType is the type of the identity provider the user logged in through.
+	ConnectorType string
+	// UserID is the unique identity of the user in the upstream identity provider.
+	UserID   string
+	Username string
+	// +optional
+	DisplayName string
+	// +optional
+	Email string
+	// Groups is the names of tke groups the user belongs to, mapped from the
+	// group claims of the upstream identity provider.
+	// +optional
+	Groups []string
+}
+
+// FederatedIdentityStatus is a description of a federated identity status.
+type FederatedIdentityStatus struct {
+	// LastLoginTime is the last time the user logged in.
+	// +optional
+	LastLoginTime metav1.Time
 }
 
 // +genclient
@@ -38,6 +38,9 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForUser,
 		AddFieldLabelConversionsForGroup,
 		AddFieldLabelConversionsForIdentityProvider,
+		AddFieldLabelConversionsForFederatedIdentity,
+		AddFieldLabelConversionsForMultiFactorEnrollment,
+		AddFieldLabelConversionsForAccessRequest,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -196,6 +199,60 @@ func AddFieldLabelConversionsForIdentityProvider(scheme *runtime.Scheme) error {
 		})
 }
 
+// AddFieldLabelConversionsForFederatedIdentity adds a conversion function to convert
+// field selectors of FederatedIdentity from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForFederatedIdentity(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("FederatedIdentity"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.connectorType",
+				"spec.username",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForMultiFactorEnrollment adds a conversion function to convert
+// field selectors of MultiFactorEnrollment from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForMultiFactorEnrollment(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("MultiFactorEnrollment"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.username",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForAccessRequest adds a conversion function to convert
+// field selectors of AccessRequest from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForAccessRequest(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("AccessRequest"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.username",
+				"spec.projectID",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
 // AddFieldLabelConversionsForLocalGroup adds a conversion function to convert
 // field selectors of LocalGroup from the given version to internal version
 // representation.
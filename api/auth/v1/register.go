@@ -51,10 +51,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LocalIdentity{},
 		&LocalIdentityList{},
 		&PasswordReq{},
+		&UnlockReq{},
+		&PolicySimulation{},
+		&AccessRequest{},
+		&AccessRequestList{},
+		&MultiFactorEnrollment{},
+		&MultiFactorEnrollmentList{},
+		&MultiFactorRequest{},
 		&APIKey{},
 		&APIKeyList{},
 		&APIKeyReq{},
 		&APIKeyReqPassword{},
+		&APIKeyRotateReq{},
 		&APISigningKey{},
 		&APISigningKeyList{},
 		&Category{},
@@ -76,6 +84,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GroupList{},
 		&IdentityProvider{},
 		&IdentityProviderList{},
+		&FederatedIdentity{},
+		&FederatedIdentityList{},
 		&Client{},
 		&ClientList{},
 
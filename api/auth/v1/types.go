@@ -75,6 +75,9 @@ const (
 
 	// CustomPolicyBindingFinalize is an internal finalizer values to CustomPolicyBinding.
 	CustomPolicyBindingFinalize FinalizerName = "custompolicybinding"
+
+	// AccessRequestFinalize is an internal finalizer values to AccessRequest.
+	AccessRequestFinalize FinalizerName = "accessrequest"
 )
 
 // LocalIdentitySpec is a description of an identity.
@@ -112,6 +115,24 @@ type LocalIdentityStatus struct {
 	// The last time the local identity was updated.
 	// +optional
 	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+
+	// PasswordChangeTime is the last time the password was changed, the
+	// creation time of the local identity is used if it is not set.
+	// +optional
+	PasswordChangeTime metav1.Time `json:"passwordChangeTime,omitempty" protobuf:"bytes,4,opt,name=passwordChangeTime"`
+	// PasswordHistory are the hashes of the previous passwords, the most
+	// recent first, which can not be reused.
+	// +optional
+	PasswordHistory []string `json:"passwordHistory,omitempty" protobuf:"bytes,5,rep,name=passwordHistory"`
+	// FailedLoginCount is the number of consecutive failed logins.
+	// +optional
+	FailedLoginCount int32 `json:"failedLoginCount,omitempty" protobuf:"varint,6,opt,name=failedLoginCount"`
+	// +optional
+	LastFailedLoginTime metav1.Time `json:"lastFailedLoginTime,omitempty" protobuf:"bytes,7,opt,name=lastFailedLoginTime"`
+	// LockoutExpireTime is the time until which the local identity is locked
+	// out after too many failed logins.
+	// +optional
+	LockoutExpireTime metav1.Time `json:"lockoutExpireTime,omitempty" protobuf:"bytes,8,opt,name=lockoutExpireTime"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -124,6 +145,207 @@ type PasswordReq struct {
 	OriginalPassword string `json:"originalPassword,omitempty" protobuf:"bytes,2,opt,name=originalPassword"`
 }
 
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UnlockReq unlocks a localIdentity which is locked by an administrator or
+// locked out after too many failed logins.
+type UnlockReq struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Reason is recorded in the log of the auth api server.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorEnrollment holds the second factors enrolled by a local identity,
+// its name is the same as the name of the local identity.
+type MultiFactorEnrollment struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the second factors of the local identity.
+	Spec MultiFactorEnrollmentSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status MultiFactorEnrollmentStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorEnrollmentList is the whole list of all multi-factor enrollments.
+type MultiFactorEnrollmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of multi-factor enrollments.
+	Items []MultiFactorEnrollment `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MultiFactorEnrollmentSpec is a description of the second factors of a local identity.
+type MultiFactorEnrollmentSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// Username is the username of the local identity.
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	// +optional
+	TOTP *TOTPCredential `json:"totp,omitempty" protobuf:"bytes,3,opt,name=totp"`
+	// +optional
+	WebAuthn []WebAuthnCredential `json:"webAuthn,omitempty" protobuf:"bytes,4,rep,name=webAuthn"`
+	// RecoveryCodes are the bcrypt hashes of the unused recovery codes.
+	// +optional
+	RecoveryCodes []string `json:"recoveryCodes,omitempty" protobuf:"bytes,5,rep,name=recoveryCodes"`
+}
+
+// TOTPCredential is a secret of time-based one-time passwords, see RFC 6238.
+type TOTPCredential struct {
+	// Secret is the base32 encoded shared secret.
+	Secret string `json:"secret" protobuf:"bytes,1,opt,name=secret"`
+	// Verified represents whether the user has proved the possession of the
+	// secret, only verified secrets are accepted when logging in.
+	Verified bool `json:"verified" protobuf:"varint,2,opt,name=verified"`
+	// +optional
+	CreationTime metav1.Time `json:"creationTime,omitempty" protobuf:"bytes,3,opt,name=creationTime"`
+}
+
+// WebAuthnCredential is a public key credential registered by an authenticator.
+type WebAuthnCredential struct {
+	// ID is the base64url encoded id of the credential.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// PublicKey is the base64 encoded DER SubjectPublicKeyInfo of the credential.
+	PublicKey string `json:"publicKey" protobuf:"bytes,3,opt,name=publicKey"`
+	// Algorithm is the COSE algorithm identifier of the public key.
+	Algorithm int64 `json:"algorithm" protobuf:"varint,4,opt,name=algorithm"`
+	// SignCount is the last signature counter reported by the authenticator.
+	// +optional
+	SignCount int64 `json:"signCount,omitempty" protobuf:"varint,5,opt,name=signCount"`
+	// +optional
+	CreationTime metav1.Time `json:"creationTime,omitempty" protobuf:"bytes,6,opt,name=creationTime"`
+	// +optional
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty" protobuf:"bytes,7,opt,name=lastUsedTime"`
+}
+
+// MultiFactorEnrollmentStatus is a description of a multi-factor enrollment status.
+type MultiFactorEnrollmentStatus struct {
+	// Challenges are the pending challenges of webauthn ceremonies.
+	// +optional
+	Challenges []WebAuthnChallenge `json:"challenges,omitempty" protobuf:"bytes,1,rep,name=challenges"`
+	// LastVerifiedTime is the last time a second factor is verified when logging in.
+	// +optional
+	LastVerifiedTime metav1.Time `json:"lastVerifiedTime,omitempty" protobuf:"bytes,2,opt,name=lastVerifiedTime"`
+}
+
+// WebAuthnPurpose defines the ceremony a webauthn challenge is issued for.
+type WebAuthnPurpose string
+
+const (
+	// WebAuthnPurposeRegistration means the challenge is used to register a credential.
+	WebAuthnPurposeRegistration WebAuthnPurpose = "Registration"
+	// WebAuthnPurposeAuthentication means the challenge is used to log in.
+	WebAuthnPurposeAuthentication WebAuthnPurpose = "Authentication"
+)
+
+// WebAuthnChallenge is a challenge issued for a webauthn ceremony.
+type WebAuthnChallenge struct {
+	// Challenge is the base64url encoded random challenge.
+	Challenge  string          `json:"challenge" protobuf:"bytes,1,opt,name=challenge"`
+	Purpose    WebAuthnPurpose `json:"purpose" protobuf:"bytes,2,opt,name=purpose,casttype=WebAuthnPurpose"`
+	ExpireTime metav1.Time     `json:"expireTime" protobuf:"bytes,3,opt,name=expireTime"`
+}
+
+// MultiFactorAction defines the action of a multi-factor request.
+type MultiFactorAction string
+
+const (
+	// MultiFactorEnrollTOTP generates a new totp secret, which must be verified
+	// before it is used to log in.
+	MultiFactorEnrollTOTP MultiFactorAction = "EnrollTOTP"
+	// MultiFactorVerifyTOTP verifies the totp secret by a one-time password.
+	MultiFactorVerifyTOTP MultiFactorAction = "VerifyTOTP"
+	// MultiFactorRemoveTOTP removes the totp secret.
+	MultiFactorRemoveTOTP MultiFactorAction = "RemoveTOTP"
+	// MultiFactorBeginWebAuthn issues a challenge to register a webauthn credential.
+	MultiFactorBeginWebAuthn MultiFactorAction = "BeginWebAuthn"
+	// MultiFactorRegisterWebAuthn registers the webauthn credential created
+	// for the issued challenge.
+	MultiFactorRegisterWebAuthn MultiFactorAction = "RegisterWebAuthn"
+	// MultiFactorRemoveWebAuthn removes a webauthn credential.
+	MultiFactorRemoveWebAuthn MultiFactorAction = "RemoveWebAuthn"
+	// MultiFactorGenerateRecoveryCodes replaces the recovery codes with new ones.
+	MultiFactorGenerateRecoveryCodes MultiFactorAction = "GenerateRecoveryCodes"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultiFactorRequest enrolls or removes a second factor of a local identity.
+type MultiFactorRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Spec MultiFactorRequestSpec `json:"spec" protobuf:"bytes,1,opt,name=spec"`
+	// +optional
+	Status MultiFactorRequestStatus `json:"status,omitempty" protobuf:"bytes,2,opt,name=status"`
+}
+
+// MultiFactorRequestSpec is a description of a multi-factor request.
+type MultiFactorRequestSpec struct {
+	Action MultiFactorAction `json:"action" protobuf:"bytes,1,opt,name=action,casttype=MultiFactorAction"`
+	// Code is the one-time password, required by VerifyTOTP.
+	// +optional
+	Code string `json:"code,omitempty" protobuf:"bytes,2,opt,name=code"`
+	// WebAuthn is the credential created by the authenticator, required by
+	// RegisterWebAuthn. Only the id is required by RemoveWebAuthn.
+	// +optional
+	WebAuthn *WebAuthnRegistration `json:"webAuthn,omitempty" protobuf:"bytes,3,opt,name=webAuthn"`
+}
+
+// WebAuthnRegistration is the public key credential created by the authenticator.
+type WebAuthnRegistration struct {
+	// ID is the base64url encoded id of the credential.
+	ID string `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// +optional
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// PublicKey is the base64 encoded DER SubjectPublicKeyInfo returned by
+	// AuthenticatorAttestationResponse.getPublicKey().
+	// +optional
+	PublicKey string `json:"publicKey,omitempty" protobuf:"bytes,3,opt,name=publicKey"`
+	// Algorithm is the COSE algorithm identifier returned by
+	// AuthenticatorAttestationResponse.getPublicKeyAlgorithm().
+	// +optional
+	Algorithm int64 `json:"algorithm,omitempty" protobuf:"varint,4,opt,name=algorithm"`
+	// ClientDataJSON is the base64url encoded client data of the response.
+	// +optional
+	ClientDataJSON string `json:"clientDataJSON,omitempty" protobuf:"bytes,5,opt,name=clientDataJSON"`
+}
+
+// MultiFactorRequestStatus is the result of a multi-factor request.
+type MultiFactorRequestStatus struct {
+	// TOTPSecret is the base32 encoded secret generated by EnrollTOTP.
+	// +optional
+	TOTPSecret string `json:"totpSecret,omitempty" protobuf:"bytes,1,opt,name=totpSecret"`
+	// TOTPURI is the otpauth uri of the secret, which is usually shown as a qr code.
+	// +optional
+	TOTPURI string `json:"totpURI,omitempty" protobuf:"bytes,2,opt,name=totpURI"`
+	// Challenge is the base64url encoded challenge issued by BeginWebAuthn.
+	// +optional
+	Challenge string `json:"challenge,omitempty" protobuf:"bytes,3,opt,name=challenge"`
+	// RelyingPartyID is the webauthn relying party id of the platform.
+	// +optional
+	RelyingPartyID string `json:"relyingPartyID,omitempty" protobuf:"bytes,4,opt,name=relyingPartyID"`
+	// RecoveryCodes are generated by GenerateRecoveryCodes, they are only
+	// shown once.
+	// +optional
+	RecoveryCodes []string `json:"recoveryCodes,omitempty" protobuf:"bytes,5,rep,name=recoveryCodes"`
+	// Factors are the second factors enrolled after the request.
+	// +optional
+	Factors []string `json:"factors,omitempty" protobuf:"bytes,6,rep,name=factors"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -307,6 +529,35 @@ type APIKeySpec struct {
 
 	// ExpireAt is the expire time for api key
 	ExpireAt metav1.Time `json:"expire_at,omitempty" protobuf:"bytes,4,opt,name=expire_at,json=expireAt"`
+
+	// Scope restricts the api key to a subset of the actions, resources and
+	// projects its owner is allowed to. The key is not restricted if empty.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,7,opt,name=scope"`
+
+	// SourceIPs restricts the addresses, given as IPs or CIDRs, the api key
+	// may be used from. The key may be used from anywhere if empty.
+	// +optional
+	SourceIPs []string `json:"sourceIPs,omitempty" protobuf:"bytes,8,rep,name=sourceIPs"`
+
+	// Predecessor is the name of the api key this one was rotated from.
+	// +optional
+	Predecessor string `json:"predecessor,omitempty" protobuf:"bytes,9,opt,name=predecessor"`
+}
+
+// APIKeyScope restricts an api key. Each list holds patterns which may
+// contain "*"; an empty list does not restrict the key.
+type APIKeyScope struct {
+	// Actions are the actions, such as "getCluster", allowed with the api key.
+	// +optional
+	Actions []string `json:"actions,omitempty" protobuf:"bytes,1,rep,name=actions"`
+	// Resources are the resources, such as "cluster:cls-xxx", allowed with
+	// the api key.
+	// +optional
+	Resources []string `json:"resources,omitempty" protobuf:"bytes,2,rep,name=resources"`
+	// Projects are the projects the api key may be used in.
+	// +optional
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,3,rep,name=projects"`
 }
 
 // APIKeyStatus is a description of an api key status.
@@ -316,6 +567,19 @@ type APIKeyStatus struct {
 	Disabled bool `json:"disabled" protobuf:"varint,1,opt,name=disabled"`
 	// Expired represents whether the apikey has been expired.
 	Expired bool `json:"expired" protobuf:"varint,2,opt,name=expired"`
+	// LastUsedTime is the time the api key was last used to authenticate.
+	// +optional
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty" protobuf:"bytes,3,opt,name=lastUsedTime"`
+	// LastUsedIP is the source address the api key was last used from.
+	// +optional
+	LastUsedIP string `json:"lastUsedIP,omitempty" protobuf:"bytes,4,opt,name=lastUsedIP"`
+	// Successor is the name of the api key this one was rotated to.
+	// +optional
+	Successor string `json:"successor,omitempty" protobuf:"bytes,5,opt,name=successor"`
+	// RetireAt is the end of the overlap window of a rotated api key, after
+	// which it is no longer accepted.
+	// +optional
+	RetireAt metav1.Time `json:"retireAt,omitempty" protobuf:"bytes,6,opt,name=retireAt"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -330,6 +594,14 @@ type APIKeyReq struct {
 
 	// Description describes api keys usage.
 	Description string `json:"description" protobuf:"bytes,3,opt,name=description"`
+
+	// Scope restricts the api key to a subset of the permissions of its owner.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,4,opt,name=scope"`
+
+	// SourceIPs restricts the addresses the api key may be used from.
+	// +optional
+	SourceIPs []string `json:"sourceIPs,omitempty" protobuf:"bytes,5,rep,name=sourceIPs"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -354,6 +626,35 @@ type APIKeyReqPassword struct {
 	// Expire holds the duration of the api key become invalid. By default, 168h(= seven days)
 	// +optional
 	Expire metav1.Duration `json:"expire,omitempty" protobuf:"bytes,6,opt,name=expire"`
+
+	// Scope restricts the api key to a subset of the permissions of its owner.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,7,opt,name=scope"`
+
+	// SourceIPs restricts the addresses the api key may be used from.
+	// +optional
+	SourceIPs []string `json:"sourceIPs,omitempty" protobuf:"bytes,8,rep,name=sourceIPs"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIKeyRotateReq rotates an api key: a successor with the same scope and
+// source IPs is issued, and the rotated key keeps working until the overlap
+// window ends.
+type APIKeyRotateReq struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Expire holds the duration of the successor become invalid. By default, 168h(= seven days)
+	// +optional
+	Expire metav1.Duration `json:"expire,omitempty" protobuf:"bytes,1,opt,name=expire"`
+
+	// Overlap holds the duration the rotated api key is still accepted. By default, 24h
+	// +optional
+	Overlap metav1.Duration `json:"overlap,omitempty" protobuf:"bytes,2,opt,name=overlap"`
+
+	// Description describes the usage of the successor, by default the one of the rotated api key.
+	// +optional
+	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
 }
 
 // +genclient
@@ -509,6 +810,10 @@ type PolicySpec struct {
 
 	Statement Statement `json:"statement" protobuf:"bytes,5,rep,name=statement"`
 	// +optional
+	// Conditions is the json encoded conditions restricting when the
+	// statement takes effect, by the source ip, the time of day, the request
+	// labels, the resource tags, the presence of a second factor and the
+	// user extra.
 	Conditions []byte `json:"conditions,omitempty" protobuf:"bytes,6,rep,name=conditions"`
 }
 
@@ -943,6 +1248,253 @@ type AllowedStatus struct {
 	EvaluationError string `json:"evaluationError,omitempty" protobuf:"bytes,6,opt,name=evaluationError"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PolicySimulation simulates an action on a resource with the local
+// authorizer, and explains the decision by the policies, roles and bindings
+// involved. A simulation without user and group finds who can perform the
+// action on the resource.
+type PolicySimulation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec describes the access to simulate.
+	Spec PolicySimulationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is filled in by the server with the result of the simulation.
+	// +optional
+	Status PolicySimulationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// PolicySimulationSpec describes the access to simulate.
+type PolicySimulationSpec struct {
+	// TenantID is the tenant of the subject, the tenant of the requester is
+	// used if it is not set.
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	// User is the name of the user to simulate.
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,2,opt,name=user"`
+	// Group is the id of the group to simulate if user is not set.
+	// +optional
+	Group string `json:"group,omitempty" protobuf:"bytes,3,opt,name=group"`
+	// ProjectID is the project the action is performed in.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,4,opt,name=projectID"`
+	// Action is the action as in policy statements, such as getCluster.
+	Action string `json:"action" protobuf:"bytes,5,opt,name=action"`
+	// Resource is the resource as in policy statements, such as cluster:cls-xxx.
+	Resource string `json:"resource" protobuf:"bytes,6,opt,name=resource"`
+	// Extra are the extra attributes of the subject, such as the source ip and
+	// the request labels the conditions of policies are evaluated on.
+	// +optional
+	Extra map[string]ExtraValue `json:"extra,omitempty" protobuf:"bytes,7,rep,name=extra"`
+}
+
+// PolicySimulationStatus is the result of a policy simulation.
+type PolicySimulationStatus struct {
+	// Review is the decision of the local authorizer as answered to subject
+	// access reviews.
+	// +optional
+	Review SubjectAccessReviewStatus `json:"review,omitempty" protobuf:"bytes,1,opt,name=review"`
+	// Matches are the policies of the subject matching the action on the
+	// resource.
+	// +optional
+	Matches []PolicyMatch `json:"matches,omitempty" protobuf:"bytes,2,rep,name=matches"`
+	// Users are the users who can perform the action on the resource, only
+	// set for simulations without user and group. The policies bound to all
+	// users do not list any user.
+	// +optional
+	Users []Subject `json:"users,omitempty" protobuf:"bytes,3,rep,name=users"`
+	// Groups are the groups which can perform the action on the resource,
+	// only set for simulations without user and group.
+	// +optional
+	Groups []Subject `json:"groups,omitempty" protobuf:"bytes,4,rep,name=groups"`
+}
+
+// PolicyMatch is a policy matching the simulated access and the chain of
+// objects through which it applies to the subject.
+type PolicyMatch struct {
+	// Policy is the id of the policy.
+	Policy string `json:"policy" protobuf:"bytes,1,opt,name=policy"`
+	// Effect is the effect of the policy statement.
+	Effect Effect `json:"effect" protobuf:"bytes,2,opt,name=effect,casttype=Effect"`
+	// Applied is false if the conditions of the policy keep it from taking
+	// effect.
+	Applied bool `json:"applied" protobuf:"varint,3,opt,name=applied"`
+	// Conditions explains the evaluation of the conditions of the policy.
+	// +optional
+	Conditions string `json:"conditions,omitempty" protobuf:"bytes,4,opt,name=conditions"`
+	// Chain lists the objects linking the subject to the policy, starting
+	// with the subject and ending with the policy.
+	Chain []PolicyLink `json:"chain" protobuf:"bytes,5,rep,name=chain"`
+}
+
+// PolicyLink is an object on the chain linking a subject to a policy.
+type PolicyLink struct {
+	// Kind is one of User, Group, Role, Policy, ProjectPolicyBinding and
+	// CustomPolicyBinding.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name is the name of the object.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// ProjectID is the project the link applies in, empty if it applies in
+	// all projects.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,3,opt,name=projectID"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest is a request of a user for a temporary grant of a project
+// policy or of a cluster role. Once approved, the grant is bound to the user
+// until it expires after the requested hours or is revoked.
+type AccessRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the requested grant.
+	// +optional
+	Spec AccessRequestSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status AccessRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is the whole list of all access requests.
+type AccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of access requests
+	Items []AccessRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// AccessRequestSpec is the description of an access request. It requests
+// either a project scoped policy on a project, or a cluster role of the
+// authz api on clusters.
+type AccessRequestSpec struct {
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	// Username is the user to grant, set by the server to the requester
+	// unless the request is filed by the platform itself.
+	// +optional
+	Username string `json:"username,omitempty" protobuf:"bytes,2,opt,name=username"`
+	// ProjectID is the project of the grant. It is required for a policy, and
+	// is the namespace of the role binding for a cluster role.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,3,opt,name=projectID"`
+	// PolicyID is the project scoped policy to grant on the project.
+	// +optional
+	PolicyID string `json:"policyID,omitempty" protobuf:"bytes,4,opt,name=policyID"`
+	// ClusterRole is the authz role to grant on the clusters, in the form of
+	// namespace/name.
+	// +optional
+	ClusterRole string `json:"clusterRole,omitempty" protobuf:"bytes,5,opt,name=clusterRole"`
+	// Clusters are the clusters of the cluster role, "*" for all clusters.
+	// +optional
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters"`
+	// Hours is how long the grant lasts once approved.
+	Hours int32 `json:"hours" protobuf:"varint,7,opt,name=hours"`
+	// Reason explains why the access is needed.
+	Reason string `json:"reason" protobuf:"bytes,8,opt,name=reason"`
+	// Notification is the message request created for every change of the
+	// phase of the request, nothing is sent if it is not set.
+	// +optional
+	Notification *AccessRequestNotification `json:"notification,omitempty" protobuf:"bytes,9,opt,name=notification"`
+}
+
+// AccessRequestNotification refers to the notify channel, template and
+// receivers used to notify the changes of access requests.
+type AccessRequestNotification struct {
+	ChannelName  string `json:"channelName" protobuf:"bytes,1,opt,name=channelName"`
+	TemplateName string `json:"templateName" protobuf:"bytes,2,opt,name=templateName"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,4,rep,name=receiverGroups"`
+}
+
+// AccessRequestStatus represents information about the status of an access
+// request.
+type AccessRequestStatus struct {
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// Reviewer is the user who approved or rejected the request.
+	// +optional
+	Reviewer string `json:"reviewer,omitempty" protobuf:"bytes,2,opt,name=reviewer"`
+	// Comment is the explanation of the reviewer.
+	// +optional
+	Comment string `json:"comment,omitempty" protobuf:"bytes,3,opt,name=comment"`
+	// StartTime is the time the grant was bound to the user.
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,4,opt,name=startTime"`
+	// ExpireTime is the time the grant is removed from the user.
+	// +optional
+	ExpireTime metav1.Time `json:"expireTime,omitempty" protobuf:"bytes,5,opt,name=expireTime"`
+	// Granted is true if the grant is bound to the user by this request,
+	// and is removed at expiry. It is false if the user had the grant already.
+	// +optional
+	Granted bool `json:"granted,omitempty" protobuf:"varint,6,opt,name=granted"`
+	// BindingName is the project policy binding or the multi cluster role
+	// binding of the grant.
+	// +optional
+	BindingName string `json:"bindingName,omitempty" protobuf:"bytes,7,opt,name=bindingName"`
+	// History is the audit trail of the request, one event for every change
+	// of its phase.
+	// +optional
+	History []AccessRequestEvent `json:"history,omitempty" protobuf:"bytes,8,rep,name=history"`
+	// A human readable message indicating details about the last failure.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+}
+
+// AccessRequestEvent records a change of the phase of an access request.
+type AccessRequestEvent struct {
+	Phase AccessRequestPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// Actor is the user who made the change, empty for the changes made by
+	// the controller.
+	// +optional
+	Actor string      `json:"actor,omitempty" protobuf:"bytes,2,opt,name=actor"`
+	Time  metav1.Time `json:"time" protobuf:"bytes,3,opt,name=time"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Notified is true once the event has been sent through the notification
+	// of the request.
+	// +optional
+	Notified bool `json:"notified,omitempty" protobuf:"varint,5,opt,name=notified"`
+}
+
+// AccessRequestPhase indicates the phase of access requests.
+type AccessRequestPhase string
+
+// These are valid phases of access requests.
+const (
+	// AccessRequestPending indicates that the request is waiting for approval.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestApproved indicates that the request has been approved and
+	// waits for the grant to be bound.
+	AccessRequestApproved AccessRequestPhase = "Approved"
+	// AccessRequestRejected indicates that the request has been rejected.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestActive indicates that the grant is bound to the user.
+	AccessRequestActive AccessRequestPhase = "Active"
+	// AccessRequestExpired indicates that the grant has been removed at
+	// expiry.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+	// AccessRequestRevoked indicates that the grant has been revoked by an
+	// approver before expiry.
+	AccessRequestRevoked AccessRequestPhase = "Revoked"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -982,6 +1534,118 @@ type IdentityProviderSpec struct {
 	// Config holds all the configuration information specific to the connector type. Since there
 	// no generic struct we can use for this purpose, it is stored as a json string.
 	Config string `json:"config" protobuf:"bytes,4,opt,name=config"`
+	// MultiFactor is the multi-factor authentication policy of the local
+	// identities of the tenant.
+	// +optional
+	MultiFactor *MultiFactorPolicy `json:"multiFactor,omitempty" protobuf:"bytes,5,opt,name=multiFactor"`
+	// PasswordPolicy is the password policy of the local identities of the
+	// tenant.
+	// +optional
+	PasswordPolicy *PasswordPolicy `json:"passwordPolicy,omitempty" protobuf:"bytes,6,opt,name=passwordPolicy"`
+}
+
+// PasswordPolicy defines the password rules and the lockout of the local
+// identities of a tenant.
+type PasswordPolicy struct {
+	// MinLength is the minimum length of passwords.
+	// +optional
+	MinLength int32 `json:"minLength,omitempty" protobuf:"varint,1,opt,name=minLength"`
+	// +optional
+	RequireUppercase bool `json:"requireUppercase,omitempty" protobuf:"varint,2,opt,name=requireUppercase"`
+	// +optional
+	RequireLowercase bool `json:"requireLowercase,omitempty" protobuf:"varint,3,opt,name=requireLowercase"`
+	// +optional
+	RequireDigit bool `json:"requireDigit,omitempty" protobuf:"varint,4,opt,name=requireDigit"`
+	// +optional
+	RequireSymbol bool `json:"requireSymbol,omitempty" protobuf:"varint,5,opt,name=requireSymbol"`
+	// HistoryCount is the number of previous passwords which can not be
+	// reused, including the current one.
+	// +optional
+	HistoryCount int32 `json:"historyCount,omitempty" protobuf:"varint,6,opt,name=historyCount"`
+	// MaxAge is the maximum age of passwords, an expired password must be
+	// changed when logging in. Zero means passwords never expire.
+	// +optional
+	MaxAge metav1.Duration `json:"maxAge,omitempty" protobuf:"bytes,7,opt,name=maxAge"`
+	// LockoutThreshold is the number of consecutive failed logins after which
+	// the local identity is locked out. Zero disables the lockout.
+	// +optional
+	LockoutThreshold int32 `json:"lockoutThreshold,omitempty" protobuf:"varint,8,opt,name=lockoutThreshold"`
+	// LockoutDuration is how long the local identity is locked out. Zero
+	// means it is locked until unlocked by an administrator.
+	// +optional
+	LockoutDuration metav1.Duration `json:"lockoutDuration,omitempty" protobuf:"bytes,9,opt,name=lockoutDuration"`
+}
+
+// MultiFactorPolicy makes the multi-factor authentication mandatory for the
+// local identities which are members of the groups or bound to the roles.
+// Local identities which have enrolled a second factor are always required to
+// use it. The ones which have not enrolled any yet log in with the password
+// only, so that they are able to enroll one through the mfa subresource of
+// their local identities, administrators may also enroll one for them through
+// the subresource beforehand.
+type MultiFactorPolicy struct {
+	// Groups are the names of the local groups.
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,1,rep,name=groups"`
+	// Roles are the names of the roles.
+	// +optional
+	Roles []string `json:"roles,omitempty" protobuf:"bytes,2,rep,name=roles"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedIdentity records a user that logged in through an upstream
+// identity provider, such as saml or oidc, together with the groups
+// claimed by the upstream provider.
+type FederatedIdentity struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of federated identity in this set.
+	Spec FederatedIdentitySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status FederatedIdentityStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedIdentityList is the whole list of all federated identities.
+type FederatedIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of federated identities.
+	Items []FederatedIdentity `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// FederatedIdentitySpec is a description of a federated identity.
+type FederatedIdentitySpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// ConnectorType is the type of the identity provider the user logged in through.
+	ConnectorType string `json:"connectorType" protobuf:"bytes,2,opt,name=connectorType"`
+	// UserID is the unique identity of the user in the upstream identity provider.
+	UserID   string `json:"userID" protobuf:"bytes,3,opt,name=userID"`
+	Username string `json:"username" protobuf:"bytes,4,opt,name=username"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,5,opt,name=displayName"`
+	// +optional
+	Email string `json:"email,omitempty" protobuf:"bytes,6,opt,name=email"`
+	// Groups is the names of tke groups the user belongs to, mapped from the
+	// group claims of the upstream identity provider.
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,7,rep,name=groups"`
+}
+
+// FederatedIdentityStatus is a description of a federated identity status.
+type FederatedIdentityStatus struct {
+	// LastLoginTime is the last time the user logged in.
+	// +optional
+	LastLoginTime metav1.Time `json:"lastLoginTime,omitempty" protobuf:"bytes,1,opt,name=lastLoginTime"`
 }
 
 // +genclient
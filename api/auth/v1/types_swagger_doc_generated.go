@@ -51,6 +51,8 @@ var map_APIKeyReq = map[string]string{
 	"":            "APIKeyReq contains expiration time used to apply the api key.",
 	"expire":      "Expire is required, holds the duration of the api key become invalid. By default, 168h(= seven days)",
 	"description": "Description describes api keys usage.",
+	"scope":       "Scope restricts the api key to a subset of the permissions of its owner.",
+	"sourceIPs":   "SourceIPs restricts the addresses the api key may be used from.",
 }
 
 func (APIKeyReq) SwaggerDoc() map[string]string {
@@ -64,12 +66,36 @@ var map_APIKeyReqPassword = map[string]string{
 	"password":    "Password (encoded by base64)",
 	"description": "Description describes api keys usage.",
 	"expire":      "Expire holds the duration of the api key become invalid. By default, 168h(= seven days)",
+	"scope":       "Scope restricts the api key to a subset of the permissions of its owner.",
+	"sourceIPs":   "SourceIPs restricts the addresses the api key may be used from.",
 }
 
 func (APIKeyReqPassword) SwaggerDoc() map[string]string {
 	return map_APIKeyReqPassword
 }
 
+var map_APIKeyRotateReq = map[string]string{
+	"":            "APIKeyRotateReq rotates an api key: a successor with the same scope and source IPs is issued, and the rotated key keeps working until the overlap window ends.",
+	"expire":      "Expire holds the duration of the successor become invalid. By default, 168h(= seven days)",
+	"overlap":     "Overlap holds the duration the rotated api key is still accepted. By default, 24h",
+	"description": "Description describes the usage of the successor, by default the one of the rotated api key.",
+}
+
+func (APIKeyRotateReq) SwaggerDoc() map[string]string {
+	return map_APIKeyRotateReq
+}
+
+var map_APIKeyScope = map[string]string{
+	"":          "APIKeyScope restricts an api key. Each list holds patterns which may contain \"*\"; an empty list does not restrict the key.",
+	"actions":   "Actions are the actions, such as \"getCluster\", allowed with the api key.",
+	"resources": "Resources are the resources, such as \"cluster:cls-xxx\", allowed with the api key.",
+	"projects":  "Projects are the projects the api key may be used in.",
+}
+
+func (APIKeyScope) SwaggerDoc() map[string]string {
+	return map_APIKeyScope
+}
+
 var map_APIKeySpec = map[string]string{
 	"":            "APIKeySpec is a description of an apiKey.",
 	"apiKey":      "APIkey is the jwt token used to authenticate user, and contains user info and sign.",
@@ -77,6 +103,9 @@ var map_APIKeySpec = map[string]string{
 	"description": "Description describes api keys usage.",
 	"issue_at":    "IssueAt is the created time for api key",
 	"expire_at":   "ExpireAt is the expire time for api key",
+	"scope":       "Scope restricts the api key to a subset of the actions, resources and projects its owner is allowed to. The key is not restricted if empty.",
+	"sourceIPs":   "SourceIPs restricts the addresses, given as IPs or CIDRs, the api key may be used from. The key may be used from anywhere if empty.",
+	"predecessor": "Predecessor is the name of the api key this one was rotated from.",
 }
 
 func (APIKeySpec) SwaggerDoc() map[string]string {
@@ -84,9 +113,13 @@ func (APIKeySpec) SwaggerDoc() map[string]string {
 }
 
 var map_APIKeyStatus = map[string]string{
-	"":         "APIKeyStatus is a description of an api key status.",
-	"disabled": "Disabled represents whether the apikey has been disabled.",
-	"expired":  "Expired represents whether the apikey has been expired.",
+	"":             "APIKeyStatus is a description of an api key status.",
+	"disabled":     "Disabled represents whether the apikey has been disabled.",
+	"expired":      "Expired represents whether the apikey has been expired.",
+	"lastUsedTime": "LastUsedTime is the time the api key was last used to authenticate.",
+	"lastUsedIP":   "LastUsedIP is the source address the api key was last used from.",
+	"successor":    "Successor is the name of the api key this one was rotated to.",
+	"retireAt":     "RetireAt is the end of the overlap window of a rotated api key, after which it is no longer accepted.",
 }
 
 func (APIKeyStatus) SwaggerDoc() map[string]string {
@@ -110,6 +143,74 @@ func (APISigningKeyList) SwaggerDoc() map[string]string {
 	return map_APISigningKeyList
 }
 
+var map_AccessRequest = map[string]string{
+	"":     "AccessRequest is a request of a user for a temporary grant of a project policy or of a cluster role. Once approved, the grant is bound to the user until it expires after the requested hours or is revoked.",
+	"spec": "Spec defines the requested grant.",
+}
+
+func (AccessRequest) SwaggerDoc() map[string]string {
+	return map_AccessRequest
+}
+
+var map_AccessRequestEvent = map[string]string{
+	"":         "AccessRequestEvent records a change of the phase of an access request.",
+	"actor":    "Actor is the user who made the change, empty for the changes made by the controller.",
+	"notified": "Notified is true once the event has been sent through the notification of the request.",
+}
+
+func (AccessRequestEvent) SwaggerDoc() map[string]string {
+	return map_AccessRequestEvent
+}
+
+var map_AccessRequestList = map[string]string{
+	"":      "AccessRequestList is the whole list of all access requests.",
+	"items": "List of access requests",
+}
+
+func (AccessRequestList) SwaggerDoc() map[string]string {
+	return map_AccessRequestList
+}
+
+var map_AccessRequestNotification = map[string]string{
+	"": "AccessRequestNotification refers to the notify channel, template and receivers used to notify the changes of access requests.",
+}
+
+func (AccessRequestNotification) SwaggerDoc() map[string]string {
+	return map_AccessRequestNotification
+}
+
+var map_AccessRequestSpec = map[string]string{
+	"":             "AccessRequestSpec is the description of an access request. It requests either a project scoped policy on a project, or a cluster role of the authz api on clusters.",
+	"username":     "Username is the user to grant, set by the server to the requester unless the request is filed by the platform itself.",
+	"projectID":    "ProjectID is the project of the grant. It is required for a policy, and is the namespace of the role binding for a cluster role.",
+	"policyID":     "PolicyID is the project scoped policy to grant on the project.",
+	"clusterRole":  "ClusterRole is the authz role to grant on the clusters, in the form of namespace/name.",
+	"clusters":     "Clusters are the clusters of the cluster role, \"*\" for all clusters.",
+	"hours":        "Hours is how long the grant lasts once approved.",
+	"reason":       "Reason explains why the access is needed.",
+	"notification": "Notification is the message request created for every change of the phase of the request, nothing is sent if it is not set.",
+}
+
+func (AccessRequestSpec) SwaggerDoc() map[string]string {
+	return map_AccessRequestSpec
+}
+
+var map_AccessRequestStatus = map[string]string{
+	"":            "AccessRequestStatus represents information about the status of an access request.",
+	"reviewer":    "Reviewer is the user who approved or rejected the request.",
+	"comment":     "Comment is the explanation of the reviewer.",
+	"startTime":   "StartTime is the time the grant was bound to the user.",
+	"expireTime":  "ExpireTime is the time the grant is removed from the user.",
+	"granted":     "Granted is true if the grant is bound to the user by this request, and is removed at expiry. It is false if the user had the grant already.",
+	"bindingName": "BindingName is the project policy binding or the multi cluster role binding of the grant.",
+	"history":     "History is the audit trail of the request, one event for every change of its phase.",
+	"message":     "A human readable message indicating details about the last failure.",
+}
+
+func (AccessRequestStatus) SwaggerDoc() map[string]string {
+	return map_AccessRequestStatus
+}
+
 var map_Action = map[string]string{
 	"":            "Action defines a action verb for authorization.",
 	"name":        "Name represents user access review request verb.",
@@ -251,6 +352,44 @@ func (CustomPolicyBindingStatus) SwaggerDoc() map[string]string {
 	return map_CustomPolicyBindingStatus
 }
 
+var map_FederatedIdentity = map[string]string{
+	"":     "FederatedIdentity records a user that logged in through an upstream identity provider, such as saml or oidc, together with the groups claimed by the upstream provider.",
+	"spec": "Spec defines the desired identities of federated identity in this set.",
+}
+
+func (FederatedIdentity) SwaggerDoc() map[string]string {
+	return map_FederatedIdentity
+}
+
+var map_FederatedIdentityList = map[string]string{
+	"":      "FederatedIdentityList is the whole list of all federated identities.",
+	"items": "List of federated identities.",
+}
+
+func (FederatedIdentityList) SwaggerDoc() map[string]string {
+	return map_FederatedIdentityList
+}
+
+var map_FederatedIdentitySpec = map[string]string{
+	"":              "FederatedIdentitySpec is a description of a federated identity.",
+	"connectorType": "ConnectorType is the type of the identity provider the user logged in through.",
+	"userID":        "UserID is the unique identity of the user in the upstream identity provider.",
+	"groups":        "Groups is the names of tke groups the user belongs to, mapped from the group claims of the upstream identity provider.",
+}
+
+func (FederatedIdentitySpec) SwaggerDoc() map[string]string {
+	return map_FederatedIdentitySpec
+}
+
+var map_FederatedIdentityStatus = map[string]string{
+	"":              "FederatedIdentityStatus is a description of a federated identity status.",
+	"lastLoginTime": "LastLoginTime is the last time the user logged in.",
+}
+
+func (FederatedIdentityStatus) SwaggerDoc() map[string]string {
+	return map_FederatedIdentityStatus
+}
+
 var map_Group = map[string]string{
 	"":     "Group is an object that contains the metadata about identify about tke local idp or third-party idp.",
 	"spec": "Spec defines the desired identities of group in this set.",
@@ -310,6 +449,8 @@ var map_IdentityProviderSpec = map[string]string{
 	"type":           "The type of the connector. E.g. 'oidc' or 'ldap'",
 	"administrators": "The administrators means the users is super admin for the idp.",
 	"config":         "Config holds all the configuration information specific to the connector type. Since there no generic struct we can use for this purpose, it is stored as a json string.",
+	"multiFactor":    "MultiFactor is the multi-factor authentication policy of the local identities of the tenant.",
+	"passwordPolicy": "PasswordPolicy is the password policy of the local identities of the tenant.",
 }
 
 func (IdentityProviderSpec) SwaggerDoc() map[string]string {
@@ -378,14 +519,98 @@ func (LocalIdentitySpec) SwaggerDoc() map[string]string {
 }
 
 var map_LocalIdentityStatus = map[string]string{
-	"":               "LocalIdentityStatus is a description of an identity status.",
-	"lastUpdateTime": "The last time the local identity was updated.",
+	"":                   "LocalIdentityStatus is a description of an identity status.",
+	"lastUpdateTime":     "The last time the local identity was updated.",
+	"passwordChangeTime": "PasswordChangeTime is the last time the password was changed, the creation time of the local identity is used if it is not set.",
+	"passwordHistory":    "PasswordHistory are the hashes of the previous passwords, the most recent first, which can not be reused.",
+	"failedLoginCount":   "FailedLoginCount is the number of consecutive failed logins.",
+	"lockoutExpireTime":  "LockoutExpireTime is the time until which the local identity is locked out after too many failed logins.",
 }
 
 func (LocalIdentityStatus) SwaggerDoc() map[string]string {
 	return map_LocalIdentityStatus
 }
 
+var map_MultiFactorEnrollment = map[string]string{
+	"":     "MultiFactorEnrollment holds the second factors enrolled by a local identity, its name is the same as the name of the local identity.",
+	"spec": "Spec defines the second factors of the local identity.",
+}
+
+func (MultiFactorEnrollment) SwaggerDoc() map[string]string {
+	return map_MultiFactorEnrollment
+}
+
+var map_MultiFactorEnrollmentList = map[string]string{
+	"":      "MultiFactorEnrollmentList is the whole list of all multi-factor enrollments.",
+	"items": "List of multi-factor enrollments.",
+}
+
+func (MultiFactorEnrollmentList) SwaggerDoc() map[string]string {
+	return map_MultiFactorEnrollmentList
+}
+
+var map_MultiFactorEnrollmentSpec = map[string]string{
+	"":              "MultiFactorEnrollmentSpec is a description of the second factors of a local identity.",
+	"username":      "Username is the username of the local identity.",
+	"recoveryCodes": "RecoveryCodes are the bcrypt hashes of the unused recovery codes.",
+}
+
+func (MultiFactorEnrollmentSpec) SwaggerDoc() map[string]string {
+	return map_MultiFactorEnrollmentSpec
+}
+
+var map_MultiFactorEnrollmentStatus = map[string]string{
+	"":                 "MultiFactorEnrollmentStatus is a description of a multi-factor enrollment status.",
+	"challenges":       "Challenges are the pending challenges of webauthn ceremonies.",
+	"lastVerifiedTime": "LastVerifiedTime is the last time a second factor is verified when logging in.",
+}
+
+func (MultiFactorEnrollmentStatus) SwaggerDoc() map[string]string {
+	return map_MultiFactorEnrollmentStatus
+}
+
+var map_MultiFactorPolicy = map[string]string{
+	"":       "MultiFactorPolicy makes the multi-factor authentication mandatory for the local identities which are members of the groups or bound to the roles. Local identities which have enrolled a second factor are always required to use it. The ones which have not enrolled any yet log in with the password only, so that they are able to enroll one through the mfa subresource of their local identities, administrators may also enroll one for them through the subresource beforehand.",
+	"groups": "Groups are the names of the local groups.",
+	"roles":  "Roles are the names of the roles.",
+}
+
+func (MultiFactorPolicy) SwaggerDoc() map[string]string {
+	return map_MultiFactorPolicy
+}
+
+var map_MultiFactorRequest = map[string]string{
+	"": "MultiFactorRequest enrolls or removes a second factor of a local identity.",
+}
+
+func (MultiFactorRequest) SwaggerDoc() map[string]string {
+	return map_MultiFactorRequest
+}
+
+var map_MultiFactorRequestSpec = map[string]string{
+	"":         "MultiFactorRequestSpec is a description of a multi-factor request.",
+	"code":     "Code is the one-time password, required by VerifyTOTP.",
+	"webAuthn": "WebAuthn is the credential created by the authenticator, required by RegisterWebAuthn. Only the id is required by RemoveWebAuthn.",
+}
+
+func (MultiFactorRequestSpec) SwaggerDoc() map[string]string {
+	return map_MultiFactorRequestSpec
+}
+
+var map_MultiFactorRequestStatus = map[string]string{
+	"":               "MultiFactorRequestStatus is the result of a multi-factor request.",
+	"totpSecret":     "TOTPSecret is the base32 encoded secret generated by EnrollTOTP.",
+	"totpURI":        "TOTPURI is the otpauth uri of the secret, which is usually shown as a qr code.",
+	"challenge":      "Challenge is the base64url encoded challenge issued by BeginWebAuthn.",
+	"relyingPartyID": "RelyingPartyID is the webauthn relying party id of the platform.",
+	"recoveryCodes":  "RecoveryCodes are generated by GenerateRecoveryCodes, they are only shown once.",
+	"factors":        "Factors are the second factors enrolled after the request.",
+}
+
+func (MultiFactorRequestStatus) SwaggerDoc() map[string]string {
+	return map_MultiFactorRequestStatus
+}
+
 var map_NonResourceAttributes = map[string]string{
 	"":     "NonResourceAttributes includes the authorization attributes available for non-resource requests to the Authorizer interface",
 	"path": "Path is the URL path of the request",
@@ -396,6 +621,19 @@ func (NonResourceAttributes) SwaggerDoc() map[string]string {
 	return map_NonResourceAttributes
 }
 
+var map_PasswordPolicy = map[string]string{
+	"":                 "PasswordPolicy defines the password rules and the lockout of the local identities of a tenant.",
+	"minLength":        "MinLength is the minimum length of passwords.",
+	"historyCount":     "HistoryCount is the number of previous passwords which can not be reused, including the current one.",
+	"maxAge":           "MaxAge is the maximum age of passwords, an expired password must be changed when logging in. Zero means passwords never expire.",
+	"lockoutThreshold": "LockoutThreshold is the number of consecutive failed logins after which the local identity is locked out. Zero disables the lockout.",
+	"lockoutDuration":  "LockoutDuration is how long the local identity is locked out. Zero means it is locked until unlocked by an administrator.",
+}
+
+func (PasswordPolicy) SwaggerDoc() map[string]string {
+	return map_PasswordPolicy
+}
+
 var map_PasswordReq = map[string]string{
 	"": "PasswordReq contains info to update password for a localIdentity",
 }
@@ -422,6 +660,17 @@ func (PolicyBinding) SwaggerDoc() map[string]string {
 	return map_PolicyBinding
 }
 
+var map_PolicyLink = map[string]string{
+	"":          "PolicyLink is an object on the chain linking a subject to a policy.",
+	"kind":      "Kind is one of User, Group, Role, Policy, ProjectPolicyBinding and CustomPolicyBinding.",
+	"name":      "Name is the name of the object.",
+	"projectID": "ProjectID is the project the link applies in, empty if it applies in all projects.",
+}
+
+func (PolicyLink) SwaggerDoc() map[string]string {
+	return map_PolicyLink
+}
+
 var map_PolicyList = map[string]string{
 	"":      "PolicyList is the whole list of all policies.",
 	"items": "List of policies.",
@@ -431,8 +680,59 @@ func (PolicyList) SwaggerDoc() map[string]string {
 	return map_PolicyList
 }
 
+var map_PolicyMatch = map[string]string{
+	"":           "PolicyMatch is a policy matching the simulated access and the chain of objects through which it applies to the subject.",
+	"policy":     "Policy is the id of the policy.",
+	"effect":     "Effect is the effect of the policy statement.",
+	"applied":    "Applied is false if the conditions of the policy keep it from taking effect.",
+	"conditions": "Conditions explains the evaluation of the conditions of the policy.",
+	"chain":      "Chain lists the objects linking the subject to the policy, starting with the subject and ending with the policy.",
+}
+
+func (PolicyMatch) SwaggerDoc() map[string]string {
+	return map_PolicyMatch
+}
+
+var map_PolicySimulation = map[string]string{
+	"":       "PolicySimulation simulates an action on a resource with the local authorizer, and explains the decision by the policies, roles and bindings involved. A simulation without user and group finds who can perform the action on the resource.",
+	"spec":   "Spec describes the access to simulate.",
+	"status": "Status is filled in by the server with the result of the simulation.",
+}
+
+func (PolicySimulation) SwaggerDoc() map[string]string {
+	return map_PolicySimulation
+}
+
+var map_PolicySimulationSpec = map[string]string{
+	"":          "PolicySimulationSpec describes the access to simulate.",
+	"tenantID":  "TenantID is the tenant of the subject, the tenant of the requester is used if it is not set.",
+	"user":      "User is the name of the user to simulate.",
+	"group":     "Group is the id of the group to simulate if user is not set.",
+	"projectID": "ProjectID is the project the action is performed in.",
+	"action":    "Action is the action as in policy statements, such as getCluster.",
+	"resource":  "Resource is the resource as in policy statements, such as cluster:cls-xxx.",
+	"extra":     "Extra are the extra attributes of the subject, such as the source ip and the request labels the conditions of policies are evaluated on.",
+}
+
+func (PolicySimulationSpec) SwaggerDoc() map[string]string {
+	return map_PolicySimulationSpec
+}
+
+var map_PolicySimulationStatus = map[string]string{
+	"":        "PolicySimulationStatus is the result of a policy simulation.",
+	"review":  "Review is the decision of the local authorizer as answered to subject access reviews.",
+	"matches": "Matches are the policies of the subject matching the action on the resource.",
+	"users":   "Users are the users who can perform the action on the resource, only set for simulations without user and group. The policies bound to all users do not list any user.",
+	"groups":  "Groups are the groups which can perform the action on the resource, only set for simulations without user and group.",
+}
+
+func (PolicySimulationStatus) SwaggerDoc() map[string]string {
+	return map_PolicySimulationStatus
+}
+
 var map_PolicySpec = map[string]string{
-	"": "PolicySpec is a description of a policy.",
+	"":           "PolicySpec is a description of a policy.",
+	"conditions": "Conditions is the json encoded conditions restricting when the statement takes effect, by the source ip, the time of day, the request labels, the resource tags, the presence of a second factor and the user extra.",
 }
 
 func (PolicySpec) SwaggerDoc() map[string]string {
@@ -650,6 +950,25 @@ func (SubjectAccessReviewStatus) SwaggerDoc() map[string]string {
 	return map_SubjectAccessReviewStatus
 }
 
+var map_TOTPCredential = map[string]string{
+	"":         "TOTPCredential is a secret of time-based one-time passwords, see RFC 6238.",
+	"secret":   "Secret is the base32 encoded shared secret.",
+	"verified": "Verified represents whether the user has proved the possession of the secret, only verified secrets are accepted when logging in.",
+}
+
+func (TOTPCredential) SwaggerDoc() map[string]string {
+	return map_TOTPCredential
+}
+
+var map_UnlockReq = map[string]string{
+	"":       "UnlockReq unlocks a localIdentity which is locked by an administrator or locked out after too many failed logins.",
+	"reason": "Reason is recorded in the log of the auth api server.",
+}
+
+func (UnlockReq) SwaggerDoc() map[string]string {
+	return map_UnlockReq
+}
+
 var map_User = map[string]string{
 	"":     "User is an object that contains the metadata about identify about tke local idp or third-party idp.",
 	"spec": "Spec defines the desired identities of identity in this set.",
@@ -677,4 +996,37 @@ func (UserSpec) SwaggerDoc() map[string]string {
 	return map_UserSpec
 }
 
+var map_WebAuthnChallenge = map[string]string{
+	"":          "WebAuthnChallenge is a challenge issued for a webauthn ceremony.",
+	"challenge": "Challenge is the base64url encoded random challenge.",
+}
+
+func (WebAuthnChallenge) SwaggerDoc() map[string]string {
+	return map_WebAuthnChallenge
+}
+
+var map_WebAuthnCredential = map[string]string{
+	"":          "WebAuthnCredential is a public key credential registered by an authenticator.",
+	"id":        "ID is the base64url encoded id of the credential.",
+	"publicKey": "PublicKey is the base64 encoded DER SubjectPublicKeyInfo of the credential.",
+	"algorithm": "Algorithm is the COSE algorithm identifier of the public key.",
+	"signCount": "SignCount is the last signature counter reported by the authenticator.",
+}
+
+func (WebAuthnCredential) SwaggerDoc() map[string]string {
+	return map_WebAuthnCredential
+}
+
+var map_WebAuthnRegistration = map[string]string{
+	"":               "WebAuthnRegistration is the public key credential created by the authenticator.",
+	"id":             "ID is the base64url encoded id of the credential.",
+	"publicKey":      "PublicKey is the base64 encoded DER SubjectPublicKeyInfo returned by AuthenticatorAttestationResponse.getPublicKey().",
+	"algorithm":      "Algorithm is the COSE algorithm identifier returned by AuthenticatorAttestationResponse.getPublicKeyAlgorithm().",
+	"clientDataJSON": "ClientDataJSON is the base64url encoded client data of the response.",
+}
+
+func (WebAuthnRegistration) SwaggerDoc() map[string]string {
+	return map_WebAuthnRegistration
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
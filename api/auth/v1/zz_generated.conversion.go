@@ -77,6 +77,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*APIKeyRotateReq)(nil), (*auth.APIKeyRotateReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(a.(*APIKeyRotateReq), b.(*auth.APIKeyRotateReq), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.APIKeyRotateReq)(nil), (*APIKeyRotateReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(a.(*auth.APIKeyRotateReq), b.(*APIKeyRotateReq), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIKeyScope)(nil), (*auth.APIKeyScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_APIKeyScope_To_auth_APIKeyScope(a.(*APIKeyScope), b.(*auth.APIKeyScope), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.APIKeyScope)(nil), (*APIKeyScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_APIKeyScope_To_v1_APIKeyScope(a.(*auth.APIKeyScope), b.(*APIKeyScope), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIKeySpec)(nil), (*auth.APIKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_APIKeySpec_To_auth_APIKeySpec(a.(*APIKeySpec), b.(*auth.APIKeySpec), scope)
 	}); err != nil {
@@ -117,6 +137,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequest)(nil), (*auth.AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequest_To_auth_AccessRequest(a.(*AccessRequest), b.(*auth.AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequest)(nil), (*AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequest_To_v1_AccessRequest(a.(*auth.AccessRequest), b.(*AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestEvent)(nil), (*auth.AccessRequestEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestEvent_To_auth_AccessRequestEvent(a.(*AccessRequestEvent), b.(*auth.AccessRequestEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestEvent)(nil), (*AccessRequestEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestEvent_To_v1_AccessRequestEvent(a.(*auth.AccessRequestEvent), b.(*AccessRequestEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestList)(nil), (*auth.AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestList_To_auth_AccessRequestList(a.(*AccessRequestList), b.(*auth.AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestList)(nil), (*AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestList_To_v1_AccessRequestList(a.(*auth.AccessRequestList), b.(*AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestNotification)(nil), (*auth.AccessRequestNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestNotification_To_auth_AccessRequestNotification(a.(*AccessRequestNotification), b.(*auth.AccessRequestNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestNotification)(nil), (*AccessRequestNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestNotification_To_v1_AccessRequestNotification(a.(*auth.AccessRequestNotification), b.(*AccessRequestNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestSpec)(nil), (*auth.AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(a.(*AccessRequestSpec), b.(*auth.AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestSpec)(nil), (*AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(a.(*auth.AccessRequestSpec), b.(*AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestStatus)(nil), (*auth.AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(a.(*AccessRequestStatus), b.(*auth.AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestStatus)(nil), (*AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(a.(*auth.AccessRequestStatus), b.(*AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Action)(nil), (*auth.Action)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Action_To_auth_Action(a.(*Action), b.(*auth.Action), scope)
 	}); err != nil {
@@ -267,6 +347,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FederatedIdentity)(nil), (*auth.FederatedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedIdentity_To_auth_FederatedIdentity(a.(*FederatedIdentity), b.(*auth.FederatedIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.FederatedIdentity)(nil), (*FederatedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_FederatedIdentity_To_v1_FederatedIdentity(a.(*auth.FederatedIdentity), b.(*FederatedIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedIdentityList)(nil), (*auth.FederatedIdentityList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedIdentityList_To_auth_FederatedIdentityList(a.(*FederatedIdentityList), b.(*auth.FederatedIdentityList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.FederatedIdentityList)(nil), (*FederatedIdentityList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_FederatedIdentityList_To_v1_FederatedIdentityList(a.(*auth.FederatedIdentityList), b.(*FederatedIdentityList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedIdentitySpec)(nil), (*auth.FederatedIdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec(a.(*FederatedIdentitySpec), b.(*auth.FederatedIdentitySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.FederatedIdentitySpec)(nil), (*FederatedIdentitySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec(a.(*auth.FederatedIdentitySpec), b.(*FederatedIdentitySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedIdentityStatus)(nil), (*auth.FederatedIdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus(a.(*FederatedIdentityStatus), b.(*auth.FederatedIdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.FederatedIdentityStatus)(nil), (*FederatedIdentityStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus(a.(*auth.FederatedIdentityStatus), b.(*FederatedIdentityStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Group)(nil), (*auth.Group)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Group_To_auth_Group(a.(*Group), b.(*auth.Group), scope)
 	}); err != nil {
@@ -417,6 +537,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorEnrollment)(nil), (*auth.MultiFactorEnrollment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorEnrollment_To_auth_MultiFactorEnrollment(a.(*MultiFactorEnrollment), b.(*auth.MultiFactorEnrollment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorEnrollment)(nil), (*MultiFactorEnrollment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorEnrollment_To_v1_MultiFactorEnrollment(a.(*auth.MultiFactorEnrollment), b.(*MultiFactorEnrollment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorEnrollmentList)(nil), (*auth.MultiFactorEnrollmentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorEnrollmentList_To_auth_MultiFactorEnrollmentList(a.(*MultiFactorEnrollmentList), b.(*auth.MultiFactorEnrollmentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorEnrollmentList)(nil), (*MultiFactorEnrollmentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorEnrollmentList_To_v1_MultiFactorEnrollmentList(a.(*auth.MultiFactorEnrollmentList), b.(*MultiFactorEnrollmentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorEnrollmentSpec)(nil), (*auth.MultiFactorEnrollmentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec(a.(*MultiFactorEnrollmentSpec), b.(*auth.MultiFactorEnrollmentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorEnrollmentSpec)(nil), (*MultiFactorEnrollmentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec(a.(*auth.MultiFactorEnrollmentSpec), b.(*MultiFactorEnrollmentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorEnrollmentStatus)(nil), (*auth.MultiFactorEnrollmentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus(a.(*MultiFactorEnrollmentStatus), b.(*auth.MultiFactorEnrollmentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorEnrollmentStatus)(nil), (*MultiFactorEnrollmentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus(a.(*auth.MultiFactorEnrollmentStatus), b.(*MultiFactorEnrollmentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorPolicy)(nil), (*auth.MultiFactorPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorPolicy_To_auth_MultiFactorPolicy(a.(*MultiFactorPolicy), b.(*auth.MultiFactorPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorPolicy)(nil), (*MultiFactorPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorPolicy_To_v1_MultiFactorPolicy(a.(*auth.MultiFactorPolicy), b.(*MultiFactorPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorRequest)(nil), (*auth.MultiFactorRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorRequest_To_auth_MultiFactorRequest(a.(*MultiFactorRequest), b.(*auth.MultiFactorRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorRequest)(nil), (*MultiFactorRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorRequest_To_v1_MultiFactorRequest(a.(*auth.MultiFactorRequest), b.(*MultiFactorRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorRequestSpec)(nil), (*auth.MultiFactorRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec(a.(*MultiFactorRequestSpec), b.(*auth.MultiFactorRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorRequestSpec)(nil), (*MultiFactorRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec(a.(*auth.MultiFactorRequestSpec), b.(*MultiFactorRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultiFactorRequestStatus)(nil), (*auth.MultiFactorRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus(a.(*MultiFactorRequestStatus), b.(*auth.MultiFactorRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MultiFactorRequestStatus)(nil), (*MultiFactorRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus(a.(*auth.MultiFactorRequestStatus), b.(*MultiFactorRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NonResourceAttributes)(nil), (*auth.NonResourceAttributes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NonResourceAttributes_To_auth_NonResourceAttributes(a.(*NonResourceAttributes), b.(*auth.NonResourceAttributes), scope)
 	}); err != nil {
@@ -427,6 +627,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PasswordPolicy)(nil), (*auth.PasswordPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PasswordPolicy_To_auth_PasswordPolicy(a.(*PasswordPolicy), b.(*auth.PasswordPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PasswordPolicy)(nil), (*PasswordPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PasswordPolicy_To_v1_PasswordPolicy(a.(*auth.PasswordPolicy), b.(*PasswordPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PasswordReq)(nil), (*auth.PasswordReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PasswordReq_To_auth_PasswordReq(a.(*PasswordReq), b.(*auth.PasswordReq), scope)
 	}); err != nil {
@@ -457,6 +667,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PolicyLink)(nil), (*auth.PolicyLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicyLink_To_auth_PolicyLink(a.(*PolicyLink), b.(*auth.PolicyLink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PolicyLink)(nil), (*PolicyLink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PolicyLink_To_v1_PolicyLink(a.(*auth.PolicyLink), b.(*PolicyLink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PolicyList)(nil), (*auth.PolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PolicyList_To_auth_PolicyList(a.(*PolicyList), b.(*auth.PolicyList), scope)
 	}); err != nil {
@@ -467,6 +687,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PolicyMatch)(nil), (*auth.PolicyMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicyMatch_To_auth_PolicyMatch(a.(*PolicyMatch), b.(*auth.PolicyMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PolicyMatch)(nil), (*PolicyMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PolicyMatch_To_v1_PolicyMatch(a.(*auth.PolicyMatch), b.(*PolicyMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicySimulation)(nil), (*auth.PolicySimulation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicySimulation_To_auth_PolicySimulation(a.(*PolicySimulation), b.(*auth.PolicySimulation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PolicySimulation)(nil), (*PolicySimulation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PolicySimulation_To_v1_PolicySimulation(a.(*auth.PolicySimulation), b.(*PolicySimulation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicySimulationSpec)(nil), (*auth.PolicySimulationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec(a.(*PolicySimulationSpec), b.(*auth.PolicySimulationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PolicySimulationSpec)(nil), (*PolicySimulationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec(a.(*auth.PolicySimulationSpec), b.(*PolicySimulationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicySimulationStatus)(nil), (*auth.PolicySimulationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus(a.(*PolicySimulationStatus), b.(*auth.PolicySimulationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.PolicySimulationStatus)(nil), (*PolicySimulationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus(a.(*auth.PolicySimulationStatus), b.(*PolicySimulationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PolicySpec)(nil), (*auth.PolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_PolicySpec_To_auth_PolicySpec(a.(*PolicySpec), b.(*auth.PolicySpec), scope)
 	}); err != nil {
@@ -697,6 +957,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TOTPCredential)(nil), (*auth.TOTPCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TOTPCredential_To_auth_TOTPCredential(a.(*TOTPCredential), b.(*auth.TOTPCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TOTPCredential)(nil), (*TOTPCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TOTPCredential_To_v1_TOTPCredential(a.(*auth.TOTPCredential), b.(*TOTPCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UnlockReq)(nil), (*auth.UnlockReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UnlockReq_To_auth_UnlockReq(a.(*UnlockReq), b.(*auth.UnlockReq), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.UnlockReq)(nil), (*UnlockReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_UnlockReq_To_v1_UnlockReq(a.(*auth.UnlockReq), b.(*UnlockReq), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*User)(nil), (*auth.User)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_User_To_auth_User(a.(*User), b.(*auth.User), scope)
 	}); err != nil {
@@ -727,6 +1007,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WebAuthnChallenge)(nil), (*auth.WebAuthnChallenge)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_WebAuthnChallenge_To_auth_WebAuthnChallenge(a.(*WebAuthnChallenge), b.(*auth.WebAuthnChallenge), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.WebAuthnChallenge)(nil), (*WebAuthnChallenge)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_WebAuthnChallenge_To_v1_WebAuthnChallenge(a.(*auth.WebAuthnChallenge), b.(*WebAuthnChallenge), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WebAuthnCredential)(nil), (*auth.WebAuthnCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_WebAuthnCredential_To_auth_WebAuthnCredential(a.(*WebAuthnCredential), b.(*auth.WebAuthnCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.WebAuthnCredential)(nil), (*WebAuthnCredential)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_WebAuthnCredential_To_v1_WebAuthnCredential(a.(*auth.WebAuthnCredential), b.(*WebAuthnCredential), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WebAuthnRegistration)(nil), (*auth.WebAuthnRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_WebAuthnRegistration_To_auth_WebAuthnRegistration(a.(*WebAuthnRegistration), b.(*auth.WebAuthnRegistration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.WebAuthnRegistration)(nil), (*WebAuthnRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_WebAuthnRegistration_To_v1_WebAuthnRegistration(a.(*auth.WebAuthnRegistration), b.(*WebAuthnRegistration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -787,6 +1097,8 @@ func Convert_auth_APIKeyList_To_v1_APIKeyList(in *auth.APIKeyList, out *APIKeyLi
 func autoConvert_v1_APIKeyReq_To_auth_APIKeyReq(in *APIKeyReq, out *auth.APIKeyReq, s conversion.Scope) error {
 	out.Expire = in.Expire
 	out.Description = in.Description
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
 	return nil
 }
 
@@ -798,6 +1110,8 @@ func Convert_v1_APIKeyReq_To_auth_APIKeyReq(in *APIKeyReq, out *auth.APIKeyReq,
 func autoConvert_auth_APIKeyReq_To_v1_APIKeyReq(in *auth.APIKeyReq, out *APIKeyReq, s conversion.Scope) error {
 	out.Expire = in.Expire
 	out.Description = in.Description
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
 	return nil
 }
 
@@ -812,6 +1126,8 @@ func autoConvert_v1_APIKeyReqPassword_To_auth_APIKeyReqPassword(in *APIKeyReqPas
 	out.Password = in.Password
 	out.Description = in.Description
 	out.Expire = in.Expire
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
 	return nil
 }
 
@@ -826,6 +1142,8 @@ func autoConvert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in *auth.APIKeyR
 	out.Password = in.Password
 	out.Description = in.Description
 	out.Expire = in.Expire
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
 	return nil
 }
 
@@ -834,6 +1152,54 @@ func Convert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in *auth.APIKeyReqPa
 	return autoConvert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in, out, s)
 }
 
+func autoConvert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in *APIKeyRotateReq, out *auth.APIKeyRotateReq, s conversion.Scope) error {
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq is an autogenerated conversion function.
+func Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in *APIKeyRotateReq, out *auth.APIKeyRotateReq, s conversion.Scope) error {
+	return autoConvert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in, out, s)
+}
+
+func autoConvert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in *auth.APIKeyRotateReq, out *APIKeyRotateReq, s conversion.Scope) error {
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq is an autogenerated conversion function.
+func Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in *auth.APIKeyRotateReq, out *APIKeyRotateReq, s conversion.Scope) error {
+	return autoConvert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in, out, s)
+}
+
+func autoConvert_v1_APIKeyScope_To_auth_APIKeyScope(in *APIKeyScope, out *auth.APIKeyScope, s conversion.Scope) error {
+	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_v1_APIKeyScope_To_auth_APIKeyScope is an autogenerated conversion function.
+func Convert_v1_APIKeyScope_To_auth_APIKeyScope(in *APIKeyScope, out *auth.APIKeyScope, s conversion.Scope) error {
+	return autoConvert_v1_APIKeyScope_To_auth_APIKeyScope(in, out, s)
+}
+
+func autoConvert_auth_APIKeyScope_To_v1_APIKeyScope(in *auth.APIKeyScope, out *APIKeyScope, s conversion.Scope) error {
+	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_auth_APIKeyScope_To_v1_APIKeyScope is an autogenerated conversion function.
+func Convert_auth_APIKeyScope_To_v1_APIKeyScope(in *auth.APIKeyScope, out *APIKeyScope, s conversion.Scope) error {
+	return autoConvert_auth_APIKeyScope_To_v1_APIKeyScope(in, out, s)
+}
+
 func autoConvert_v1_APIKeySpec_To_auth_APIKeySpec(in *APIKeySpec, out *auth.APIKeySpec, s conversion.Scope) error {
 	out.APIkey = in.APIkey
 	out.TenantID = in.TenantID
@@ -841,6 +1207,9 @@ func autoConvert_v1_APIKeySpec_To_auth_APIKeySpec(in *APIKeySpec, out *auth.APIK
 	out.Description = in.Description
 	out.IssueAt = in.IssueAt
 	out.ExpireAt = in.ExpireAt
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
+	out.Predecessor = in.Predecessor
 	return nil
 }
 
@@ -856,6 +1225,9 @@ func autoConvert_auth_APIKeySpec_To_v1_APIKeySpec(in *auth.APIKeySpec, out *APIK
 	out.Description = in.Description
 	out.IssueAt = in.IssueAt
 	out.ExpireAt = in.ExpireAt
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
+	out.SourceIPs = *(*[]string)(unsafe.Pointer(&in.SourceIPs))
+	out.Predecessor = in.Predecessor
 	return nil
 }
 
@@ -867,6 +1239,10 @@ func Convert_auth_APIKeySpec_To_v1_APIKeySpec(in *auth.APIKeySpec, out *APIKeySp
 func autoConvert_v1_APIKeyStatus_To_auth_APIKeyStatus(in *APIKeyStatus, out *auth.APIKeyStatus, s conversion.Scope) error {
 	out.Disabled = in.Disabled
 	out.Expired = in.Expired
+	out.LastUsedTime = in.LastUsedTime
+	out.LastUsedIP = in.LastUsedIP
+	out.Successor = in.Successor
+	out.RetireAt = in.RetireAt
 	return nil
 }
 
@@ -878,6 +1254,10 @@ func Convert_v1_APIKeyStatus_To_auth_APIKeyStatus(in *APIKeyStatus, out *auth.AP
 func autoConvert_auth_APIKeyStatus_To_v1_APIKeyStatus(in *auth.APIKeyStatus, out *APIKeyStatus, s conversion.Scope) error {
 	out.Disabled = in.Disabled
 	out.Expired = in.Expired
+	out.LastUsedTime = in.LastUsedTime
+	out.LastUsedIP = in.LastUsedIP
+	out.Successor = in.Successor
+	out.RetireAt = in.RetireAt
 	return nil
 }
 
@@ -932,56 +1312,236 @@ func Convert_auth_APISigningKeyList_To_v1_APISigningKeyList(in *auth.APISigningK
 	return autoConvert_auth_APISigningKeyList_To_v1_APISigningKeyList(in, out, s)
 }
 
-func autoConvert_v1_Action_To_auth_Action(in *Action, out *auth.Action, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Description = in.Description
+func autoConvert_v1_AccessRequest_To_auth_AccessRequest(in *AccessRequest, out *auth.AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_Action_To_auth_Action is an autogenerated conversion function.
-func Convert_v1_Action_To_auth_Action(in *Action, out *auth.Action, s conversion.Scope) error {
-	return autoConvert_v1_Action_To_auth_Action(in, out, s)
+// Convert_v1_AccessRequest_To_auth_AccessRequest is an autogenerated conversion function.
+func Convert_v1_AccessRequest_To_auth_AccessRequest(in *AccessRequest, out *auth.AccessRequest, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequest_To_auth_AccessRequest(in, out, s)
 }
 
-func autoConvert_auth_Action_To_v1_Action(in *auth.Action, out *Action, s conversion.Scope) error {
-	out.Name = in.Name
-	out.Description = in.Description
+func autoConvert_auth_AccessRequest_To_v1_AccessRequest(in *auth.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_auth_Action_To_v1_Action is an autogenerated conversion function.
-func Convert_auth_Action_To_v1_Action(in *auth.Action, out *Action, s conversion.Scope) error {
-	return autoConvert_auth_Action_To_v1_Action(in, out, s)
+// Convert_auth_AccessRequest_To_v1_AccessRequest is an autogenerated conversion function.
+func Convert_auth_AccessRequest_To_v1_AccessRequest(in *auth.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequest_To_v1_AccessRequest(in, out, s)
 }
 
-func autoConvert_v1_AllowedStatus_To_auth_AllowedStatus(in *AllowedStatus, out *auth.AllowedStatus, s conversion.Scope) error {
-	out.Resource = in.Resource
-	out.Verb = in.Verb
-	out.Allowed = in.Allowed
-	out.Denied = in.Denied
-	out.Reason = in.Reason
-	out.EvaluationError = in.EvaluationError
+func autoConvert_v1_AccessRequestEvent_To_auth_AccessRequestEvent(in *AccessRequestEvent, out *auth.AccessRequestEvent, s conversion.Scope) error {
+	out.Phase = auth.AccessRequestPhase(in.Phase)
+	out.Actor = in.Actor
+	out.Time = in.Time
+	out.Message = in.Message
+	out.Notified = in.Notified
 	return nil
 }
 
-// Convert_v1_AllowedStatus_To_auth_AllowedStatus is an autogenerated conversion function.
-func Convert_v1_AllowedStatus_To_auth_AllowedStatus(in *AllowedStatus, out *auth.AllowedStatus, s conversion.Scope) error {
-	return autoConvert_v1_AllowedStatus_To_auth_AllowedStatus(in, out, s)
+// Convert_v1_AccessRequestEvent_To_auth_AccessRequestEvent is an autogenerated conversion function.
+func Convert_v1_AccessRequestEvent_To_auth_AccessRequestEvent(in *AccessRequestEvent, out *auth.AccessRequestEvent, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestEvent_To_auth_AccessRequestEvent(in, out, s)
 }
 
-func autoConvert_auth_AllowedStatus_To_v1_AllowedStatus(in *auth.AllowedStatus, out *AllowedStatus, s conversion.Scope) error {
-	out.Resource = in.Resource
-	out.Verb = in.Verb
-	out.Allowed = in.Allowed
-	out.Denied = in.Denied
-	out.Reason = in.Reason
-	out.EvaluationError = in.EvaluationError
+func autoConvert_auth_AccessRequestEvent_To_v1_AccessRequestEvent(in *auth.AccessRequestEvent, out *AccessRequestEvent, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.Actor = in.Actor
+	out.Time = in.Time
+	out.Message = in.Message
+	out.Notified = in.Notified
 	return nil
 }
 
-// Convert_auth_AllowedStatus_To_v1_AllowedStatus is an autogenerated conversion function.
-func Convert_auth_AllowedStatus_To_v1_AllowedStatus(in *auth.AllowedStatus, out *AllowedStatus, s conversion.Scope) error {
-	return autoConvert_auth_AllowedStatus_To_v1_AllowedStatus(in, out, s)
+// Convert_auth_AccessRequestEvent_To_v1_AccessRequestEvent is an autogenerated conversion function.
+func Convert_auth_AccessRequestEvent_To_v1_AccessRequestEvent(in *auth.AccessRequestEvent, out *AccessRequestEvent, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestEvent_To_v1_AccessRequestEvent(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestList_To_auth_AccessRequestList(in *AccessRequestList, out *auth.AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_AccessRequestList_To_auth_AccessRequestList is an autogenerated conversion function.
+func Convert_v1_AccessRequestList_To_auth_AccessRequestList(in *AccessRequestList, out *auth.AccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestList_To_auth_AccessRequestList(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestList_To_v1_AccessRequestList(in *auth.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_AccessRequestList_To_v1_AccessRequestList is an autogenerated conversion function.
+func Convert_auth_AccessRequestList_To_v1_AccessRequestList(in *auth.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestList_To_v1_AccessRequestList(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestNotification_To_auth_AccessRequestNotification(in *AccessRequestNotification, out *auth.AccessRequestNotification, s conversion.Scope) error {
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_v1_AccessRequestNotification_To_auth_AccessRequestNotification is an autogenerated conversion function.
+func Convert_v1_AccessRequestNotification_To_auth_AccessRequestNotification(in *AccessRequestNotification, out *auth.AccessRequestNotification, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestNotification_To_auth_AccessRequestNotification(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestNotification_To_v1_AccessRequestNotification(in *auth.AccessRequestNotification, out *AccessRequestNotification, s conversion.Scope) error {
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_auth_AccessRequestNotification_To_v1_AccessRequestNotification is an autogenerated conversion function.
+func Convert_auth_AccessRequestNotification_To_v1_AccessRequestNotification(in *auth.AccessRequestNotification, out *AccessRequestNotification, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestNotification_To_v1_AccessRequestNotification(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in *AccessRequestSpec, out *auth.AccessRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.ProjectID = in.ProjectID
+	out.PolicyID = in.PolicyID
+	out.ClusterRole = in.ClusterRole
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Hours = in.Hours
+	out.Reason = in.Reason
+	out.Notification = (*auth.AccessRequestNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec is an autogenerated conversion function.
+func Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in *AccessRequestSpec, out *auth.AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in *auth.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.ProjectID = in.ProjectID
+	out.PolicyID = in.PolicyID
+	out.ClusterRole = in.ClusterRole
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Hours = in.Hours
+	out.Reason = in.Reason
+	out.Notification = (*AccessRequestNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec is an autogenerated conversion function.
+func Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in *auth.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in *AccessRequestStatus, out *auth.AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = auth.AccessRequestPhase(in.Phase)
+	out.Reviewer = in.Reviewer
+	out.Comment = in.Comment
+	out.StartTime = in.StartTime
+	out.ExpireTime = in.ExpireTime
+	out.Granted = in.Granted
+	out.BindingName = in.BindingName
+	out.History = *(*[]auth.AccessRequestEvent)(unsafe.Pointer(&in.History))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus is an autogenerated conversion function.
+func Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in *AccessRequestStatus, out *auth.AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in *auth.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.Reviewer = in.Reviewer
+	out.Comment = in.Comment
+	out.StartTime = in.StartTime
+	out.ExpireTime = in.ExpireTime
+	out.Granted = in.Granted
+	out.BindingName = in.BindingName
+	out.History = *(*[]AccessRequestEvent)(unsafe.Pointer(&in.History))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus is an autogenerated conversion function.
+func Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in *auth.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_Action_To_auth_Action(in *Action, out *auth.Action, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1_Action_To_auth_Action is an autogenerated conversion function.
+func Convert_v1_Action_To_auth_Action(in *Action, out *auth.Action, s conversion.Scope) error {
+	return autoConvert_v1_Action_To_auth_Action(in, out, s)
+}
+
+func autoConvert_auth_Action_To_v1_Action(in *auth.Action, out *Action, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_auth_Action_To_v1_Action is an autogenerated conversion function.
+func Convert_auth_Action_To_v1_Action(in *auth.Action, out *Action, s conversion.Scope) error {
+	return autoConvert_auth_Action_To_v1_Action(in, out, s)
+}
+
+func autoConvert_v1_AllowedStatus_To_auth_AllowedStatus(in *AllowedStatus, out *auth.AllowedStatus, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Verb = in.Verb
+	out.Allowed = in.Allowed
+	out.Denied = in.Denied
+	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+// Convert_v1_AllowedStatus_To_auth_AllowedStatus is an autogenerated conversion function.
+func Convert_v1_AllowedStatus_To_auth_AllowedStatus(in *AllowedStatus, out *auth.AllowedStatus, s conversion.Scope) error {
+	return autoConvert_v1_AllowedStatus_To_auth_AllowedStatus(in, out, s)
+}
+
+func autoConvert_auth_AllowedStatus_To_v1_AllowedStatus(in *auth.AllowedStatus, out *AllowedStatus, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Verb = in.Verb
+	out.Allowed = in.Allowed
+	out.Denied = in.Denied
+	out.Reason = in.Reason
+	out.EvaluationError = in.EvaluationError
+	return nil
+}
+
+// Convert_auth_AllowedStatus_To_v1_AllowedStatus is an autogenerated conversion function.
+func Convert_auth_AllowedStatus_To_v1_AllowedStatus(in *auth.AllowedStatus, out *AllowedStatus, s conversion.Scope) error {
+	return autoConvert_auth_AllowedStatus_To_v1_AllowedStatus(in, out, s)
 }
 
 func autoConvert_v1_Binding_To_auth_Binding(in *Binding, out *auth.Binding, s conversion.Scope) error {
@@ -1314,6 +1874,112 @@ func Convert_auth_CustomPolicyBindingStatus_To_v1_CustomPolicyBindingStatus(in *
 	return autoConvert_auth_CustomPolicyBindingStatus_To_v1_CustomPolicyBindingStatus(in, out, s)
 }
 
+func autoConvert_v1_FederatedIdentity_To_auth_FederatedIdentity(in *FederatedIdentity, out *auth.FederatedIdentity, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_FederatedIdentity_To_auth_FederatedIdentity is an autogenerated conversion function.
+func Convert_v1_FederatedIdentity_To_auth_FederatedIdentity(in *FederatedIdentity, out *auth.FederatedIdentity, s conversion.Scope) error {
+	return autoConvert_v1_FederatedIdentity_To_auth_FederatedIdentity(in, out, s)
+}
+
+func autoConvert_auth_FederatedIdentity_To_v1_FederatedIdentity(in *auth.FederatedIdentity, out *FederatedIdentity, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_FederatedIdentity_To_v1_FederatedIdentity is an autogenerated conversion function.
+func Convert_auth_FederatedIdentity_To_v1_FederatedIdentity(in *auth.FederatedIdentity, out *FederatedIdentity, s conversion.Scope) error {
+	return autoConvert_auth_FederatedIdentity_To_v1_FederatedIdentity(in, out, s)
+}
+
+func autoConvert_v1_FederatedIdentityList_To_auth_FederatedIdentityList(in *FederatedIdentityList, out *auth.FederatedIdentityList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.FederatedIdentity)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_FederatedIdentityList_To_auth_FederatedIdentityList is an autogenerated conversion function.
+func Convert_v1_FederatedIdentityList_To_auth_FederatedIdentityList(in *FederatedIdentityList, out *auth.FederatedIdentityList, s conversion.Scope) error {
+	return autoConvert_v1_FederatedIdentityList_To_auth_FederatedIdentityList(in, out, s)
+}
+
+func autoConvert_auth_FederatedIdentityList_To_v1_FederatedIdentityList(in *auth.FederatedIdentityList, out *FederatedIdentityList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]FederatedIdentity)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_FederatedIdentityList_To_v1_FederatedIdentityList is an autogenerated conversion function.
+func Convert_auth_FederatedIdentityList_To_v1_FederatedIdentityList(in *auth.FederatedIdentityList, out *FederatedIdentityList, s conversion.Scope) error {
+	return autoConvert_auth_FederatedIdentityList_To_v1_FederatedIdentityList(in, out, s)
+}
+
+func autoConvert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec(in *FederatedIdentitySpec, out *auth.FederatedIdentitySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ConnectorType = in.ConnectorType
+	out.UserID = in.UserID
+	out.Username = in.Username
+	out.DisplayName = in.DisplayName
+	out.Email = in.Email
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec is an autogenerated conversion function.
+func Convert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec(in *FederatedIdentitySpec, out *auth.FederatedIdentitySpec, s conversion.Scope) error {
+	return autoConvert_v1_FederatedIdentitySpec_To_auth_FederatedIdentitySpec(in, out, s)
+}
+
+func autoConvert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec(in *auth.FederatedIdentitySpec, out *FederatedIdentitySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ConnectorType = in.ConnectorType
+	out.UserID = in.UserID
+	out.Username = in.Username
+	out.DisplayName = in.DisplayName
+	out.Email = in.Email
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec is an autogenerated conversion function.
+func Convert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec(in *auth.FederatedIdentitySpec, out *FederatedIdentitySpec, s conversion.Scope) error {
+	return autoConvert_auth_FederatedIdentitySpec_To_v1_FederatedIdentitySpec(in, out, s)
+}
+
+func autoConvert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus(in *FederatedIdentityStatus, out *auth.FederatedIdentityStatus, s conversion.Scope) error {
+	out.LastLoginTime = in.LastLoginTime
+	return nil
+}
+
+// Convert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus is an autogenerated conversion function.
+func Convert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus(in *FederatedIdentityStatus, out *auth.FederatedIdentityStatus, s conversion.Scope) error {
+	return autoConvert_v1_FederatedIdentityStatus_To_auth_FederatedIdentityStatus(in, out, s)
+}
+
+func autoConvert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus(in *auth.FederatedIdentityStatus, out *FederatedIdentityStatus, s conversion.Scope) error {
+	out.LastLoginTime = in.LastLoginTime
+	return nil
+}
+
+// Convert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus is an autogenerated conversion function.
+func Convert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus(in *auth.FederatedIdentityStatus, out *FederatedIdentityStatus, s conversion.Scope) error {
+	return autoConvert_auth_FederatedIdentityStatus_To_v1_FederatedIdentityStatus(in, out, s)
+}
+
 func autoConvert_v1_Group_To_auth_Group(in *Group, out *auth.Group, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_GroupSpec_To_auth_GroupSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1469,6 +2135,8 @@ func autoConvert_v1_IdentityProviderSpec_To_auth_IdentityProviderSpec(in *Identi
 	out.Type = in.Type
 	out.Administrators = *(*[]string)(unsafe.Pointer(&in.Administrators))
 	out.Config = in.Config
+	out.MultiFactor = (*auth.MultiFactorPolicy)(unsafe.Pointer(in.MultiFactor))
+	out.PasswordPolicy = (*auth.PasswordPolicy)(unsafe.Pointer(in.PasswordPolicy))
 	return nil
 }
 
@@ -1482,6 +2150,8 @@ func autoConvert_auth_IdentityProviderSpec_To_v1_IdentityProviderSpec(in *auth.I
 	out.Type = in.Type
 	out.Administrators = *(*[]string)(unsafe.Pointer(&in.Administrators))
 	out.Config = in.Config
+	out.MultiFactor = (*MultiFactorPolicy)(unsafe.Pointer(in.MultiFactor))
+	out.PasswordPolicy = (*PasswordPolicy)(unsafe.Pointer(in.PasswordPolicy))
 	return nil
 }
 
@@ -1710,6 +2380,11 @@ func autoConvert_v1_LocalIdentityStatus_To_auth_LocalIdentityStatus(in *LocalIde
 	out.Phase = auth.LocalIdentityPhase(in.Phase)
 	out.Locked = in.Locked
 	out.LastUpdateTime = in.LastUpdateTime
+	out.PasswordChangeTime = in.PasswordChangeTime
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
+	out.FailedLoginCount = in.FailedLoginCount
+	out.LastFailedLoginTime = in.LastFailedLoginTime
+	out.LockoutExpireTime = in.LockoutExpireTime
 	return nil
 }
 
@@ -1722,6 +2397,11 @@ func autoConvert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in *auth.Loc
 	out.Locked = in.Locked
 	out.Phase = LocalIdentityPhase(in.Phase)
 	out.LastUpdateTime = in.LastUpdateTime
+	out.PasswordChangeTime = in.PasswordChangeTime
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
+	out.FailedLoginCount = in.FailedLoginCount
+	out.LastFailedLoginTime = in.LastFailedLoginTime
+	out.LockoutExpireTime = in.LockoutExpireTime
 	return nil
 }
 
@@ -1730,6 +2410,216 @@ func Convert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in *auth.LocalId
 	return autoConvert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in, out, s)
 }
 
+func autoConvert_v1_MultiFactorEnrollment_To_auth_MultiFactorEnrollment(in *MultiFactorEnrollment, out *auth.MultiFactorEnrollment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_MultiFactorEnrollment_To_auth_MultiFactorEnrollment is an autogenerated conversion function.
+func Convert_v1_MultiFactorEnrollment_To_auth_MultiFactorEnrollment(in *MultiFactorEnrollment, out *auth.MultiFactorEnrollment, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorEnrollment_To_auth_MultiFactorEnrollment(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorEnrollment_To_v1_MultiFactorEnrollment(in *auth.MultiFactorEnrollment, out *MultiFactorEnrollment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_MultiFactorEnrollment_To_v1_MultiFactorEnrollment is an autogenerated conversion function.
+func Convert_auth_MultiFactorEnrollment_To_v1_MultiFactorEnrollment(in *auth.MultiFactorEnrollment, out *MultiFactorEnrollment, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorEnrollment_To_v1_MultiFactorEnrollment(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorEnrollmentList_To_auth_MultiFactorEnrollmentList(in *MultiFactorEnrollmentList, out *auth.MultiFactorEnrollmentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.MultiFactorEnrollment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_MultiFactorEnrollmentList_To_auth_MultiFactorEnrollmentList is an autogenerated conversion function.
+func Convert_v1_MultiFactorEnrollmentList_To_auth_MultiFactorEnrollmentList(in *MultiFactorEnrollmentList, out *auth.MultiFactorEnrollmentList, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorEnrollmentList_To_auth_MultiFactorEnrollmentList(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorEnrollmentList_To_v1_MultiFactorEnrollmentList(in *auth.MultiFactorEnrollmentList, out *MultiFactorEnrollmentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MultiFactorEnrollment)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_MultiFactorEnrollmentList_To_v1_MultiFactorEnrollmentList is an autogenerated conversion function.
+func Convert_auth_MultiFactorEnrollmentList_To_v1_MultiFactorEnrollmentList(in *auth.MultiFactorEnrollmentList, out *MultiFactorEnrollmentList, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorEnrollmentList_To_v1_MultiFactorEnrollmentList(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec(in *MultiFactorEnrollmentSpec, out *auth.MultiFactorEnrollmentSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.TOTP = (*auth.TOTPCredential)(unsafe.Pointer(in.TOTP))
+	out.WebAuthn = *(*[]auth.WebAuthnCredential)(unsafe.Pointer(&in.WebAuthn))
+	out.RecoveryCodes = *(*[]string)(unsafe.Pointer(&in.RecoveryCodes))
+	return nil
+}
+
+// Convert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec is an autogenerated conversion function.
+func Convert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec(in *MultiFactorEnrollmentSpec, out *auth.MultiFactorEnrollmentSpec, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorEnrollmentSpec_To_auth_MultiFactorEnrollmentSpec(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec(in *auth.MultiFactorEnrollmentSpec, out *MultiFactorEnrollmentSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.TOTP = (*TOTPCredential)(unsafe.Pointer(in.TOTP))
+	out.WebAuthn = *(*[]WebAuthnCredential)(unsafe.Pointer(&in.WebAuthn))
+	out.RecoveryCodes = *(*[]string)(unsafe.Pointer(&in.RecoveryCodes))
+	return nil
+}
+
+// Convert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec is an autogenerated conversion function.
+func Convert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec(in *auth.MultiFactorEnrollmentSpec, out *MultiFactorEnrollmentSpec, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorEnrollmentSpec_To_v1_MultiFactorEnrollmentSpec(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus(in *MultiFactorEnrollmentStatus, out *auth.MultiFactorEnrollmentStatus, s conversion.Scope) error {
+	out.Challenges = *(*[]auth.WebAuthnChallenge)(unsafe.Pointer(&in.Challenges))
+	out.LastVerifiedTime = in.LastVerifiedTime
+	return nil
+}
+
+// Convert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus is an autogenerated conversion function.
+func Convert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus(in *MultiFactorEnrollmentStatus, out *auth.MultiFactorEnrollmentStatus, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorEnrollmentStatus_To_auth_MultiFactorEnrollmentStatus(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus(in *auth.MultiFactorEnrollmentStatus, out *MultiFactorEnrollmentStatus, s conversion.Scope) error {
+	out.Challenges = *(*[]WebAuthnChallenge)(unsafe.Pointer(&in.Challenges))
+	out.LastVerifiedTime = in.LastVerifiedTime
+	return nil
+}
+
+// Convert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus is an autogenerated conversion function.
+func Convert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus(in *auth.MultiFactorEnrollmentStatus, out *MultiFactorEnrollmentStatus, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorEnrollmentStatus_To_v1_MultiFactorEnrollmentStatus(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorPolicy_To_auth_MultiFactorPolicy(in *MultiFactorPolicy, out *auth.MultiFactorPolicy, s conversion.Scope) error {
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1_MultiFactorPolicy_To_auth_MultiFactorPolicy is an autogenerated conversion function.
+func Convert_v1_MultiFactorPolicy_To_auth_MultiFactorPolicy(in *MultiFactorPolicy, out *auth.MultiFactorPolicy, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorPolicy_To_auth_MultiFactorPolicy(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorPolicy_To_v1_MultiFactorPolicy(in *auth.MultiFactorPolicy, out *MultiFactorPolicy, s conversion.Scope) error {
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_auth_MultiFactorPolicy_To_v1_MultiFactorPolicy is an autogenerated conversion function.
+func Convert_auth_MultiFactorPolicy_To_v1_MultiFactorPolicy(in *auth.MultiFactorPolicy, out *MultiFactorPolicy, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorPolicy_To_v1_MultiFactorPolicy(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorRequest_To_auth_MultiFactorRequest(in *MultiFactorRequest, out *auth.MultiFactorRequest, s conversion.Scope) error {
+	if err := Convert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_MultiFactorRequest_To_auth_MultiFactorRequest is an autogenerated conversion function.
+func Convert_v1_MultiFactorRequest_To_auth_MultiFactorRequest(in *MultiFactorRequest, out *auth.MultiFactorRequest, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorRequest_To_auth_MultiFactorRequest(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorRequest_To_v1_MultiFactorRequest(in *auth.MultiFactorRequest, out *MultiFactorRequest, s conversion.Scope) error {
+	if err := Convert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_MultiFactorRequest_To_v1_MultiFactorRequest is an autogenerated conversion function.
+func Convert_auth_MultiFactorRequest_To_v1_MultiFactorRequest(in *auth.MultiFactorRequest, out *MultiFactorRequest, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorRequest_To_v1_MultiFactorRequest(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec(in *MultiFactorRequestSpec, out *auth.MultiFactorRequestSpec, s conversion.Scope) error {
+	out.Action = auth.MultiFactorAction(in.Action)
+	out.Code = in.Code
+	out.WebAuthn = (*auth.WebAuthnRegistration)(unsafe.Pointer(in.WebAuthn))
+	return nil
+}
+
+// Convert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec is an autogenerated conversion function.
+func Convert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec(in *MultiFactorRequestSpec, out *auth.MultiFactorRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorRequestSpec_To_auth_MultiFactorRequestSpec(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec(in *auth.MultiFactorRequestSpec, out *MultiFactorRequestSpec, s conversion.Scope) error {
+	out.Action = MultiFactorAction(in.Action)
+	out.Code = in.Code
+	out.WebAuthn = (*WebAuthnRegistration)(unsafe.Pointer(in.WebAuthn))
+	return nil
+}
+
+// Convert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec is an autogenerated conversion function.
+func Convert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec(in *auth.MultiFactorRequestSpec, out *MultiFactorRequestSpec, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorRequestSpec_To_v1_MultiFactorRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus(in *MultiFactorRequestStatus, out *auth.MultiFactorRequestStatus, s conversion.Scope) error {
+	out.TOTPSecret = in.TOTPSecret
+	out.TOTPURI = in.TOTPURI
+	out.Challenge = in.Challenge
+	out.RelyingPartyID = in.RelyingPartyID
+	out.RecoveryCodes = *(*[]string)(unsafe.Pointer(&in.RecoveryCodes))
+	out.Factors = *(*[]string)(unsafe.Pointer(&in.Factors))
+	return nil
+}
+
+// Convert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus is an autogenerated conversion function.
+func Convert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus(in *MultiFactorRequestStatus, out *auth.MultiFactorRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_MultiFactorRequestStatus_To_auth_MultiFactorRequestStatus(in, out, s)
+}
+
+func autoConvert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus(in *auth.MultiFactorRequestStatus, out *MultiFactorRequestStatus, s conversion.Scope) error {
+	out.TOTPSecret = in.TOTPSecret
+	out.TOTPURI = in.TOTPURI
+	out.Challenge = in.Challenge
+	out.RelyingPartyID = in.RelyingPartyID
+	out.RecoveryCodes = *(*[]string)(unsafe.Pointer(&in.RecoveryCodes))
+	out.Factors = *(*[]string)(unsafe.Pointer(&in.Factors))
+	return nil
+}
+
+// Convert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus is an autogenerated conversion function.
+func Convert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus(in *auth.MultiFactorRequestStatus, out *MultiFactorRequestStatus, s conversion.Scope) error {
+	return autoConvert_auth_MultiFactorRequestStatus_To_v1_MultiFactorRequestStatus(in, out, s)
+}
+
 func autoConvert_v1_NonResourceAttributes_To_auth_NonResourceAttributes(in *NonResourceAttributes, out *auth.NonResourceAttributes, s conversion.Scope) error {
 	out.Path = in.Path
 	out.Verb = in.Verb
@@ -1752,6 +2642,42 @@ func Convert_auth_NonResourceAttributes_To_v1_NonResourceAttributes(in *auth.Non
 	return autoConvert_auth_NonResourceAttributes_To_v1_NonResourceAttributes(in, out, s)
 }
 
+func autoConvert_v1_PasswordPolicy_To_auth_PasswordPolicy(in *PasswordPolicy, out *auth.PasswordPolicy, s conversion.Scope) error {
+	out.MinLength = in.MinLength
+	out.RequireUppercase = in.RequireUppercase
+	out.RequireLowercase = in.RequireLowercase
+	out.RequireDigit = in.RequireDigit
+	out.RequireSymbol = in.RequireSymbol
+	out.HistoryCount = in.HistoryCount
+	out.MaxAge = in.MaxAge
+	out.LockoutThreshold = in.LockoutThreshold
+	out.LockoutDuration = in.LockoutDuration
+	return nil
+}
+
+// Convert_v1_PasswordPolicy_To_auth_PasswordPolicy is an autogenerated conversion function.
+func Convert_v1_PasswordPolicy_To_auth_PasswordPolicy(in *PasswordPolicy, out *auth.PasswordPolicy, s conversion.Scope) error {
+	return autoConvert_v1_PasswordPolicy_To_auth_PasswordPolicy(in, out, s)
+}
+
+func autoConvert_auth_PasswordPolicy_To_v1_PasswordPolicy(in *auth.PasswordPolicy, out *PasswordPolicy, s conversion.Scope) error {
+	out.MinLength = in.MinLength
+	out.RequireUppercase = in.RequireUppercase
+	out.RequireLowercase = in.RequireLowercase
+	out.RequireDigit = in.RequireDigit
+	out.RequireSymbol = in.RequireSymbol
+	out.HistoryCount = in.HistoryCount
+	out.MaxAge = in.MaxAge
+	out.LockoutThreshold = in.LockoutThreshold
+	out.LockoutDuration = in.LockoutDuration
+	return nil
+}
+
+// Convert_auth_PasswordPolicy_To_v1_PasswordPolicy is an autogenerated conversion function.
+func Convert_auth_PasswordPolicy_To_v1_PasswordPolicy(in *auth.PasswordPolicy, out *PasswordPolicy, s conversion.Scope) error {
+	return autoConvert_auth_PasswordPolicy_To_v1_PasswordPolicy(in, out, s)
+}
+
 func autoConvert_v1_PasswordReq_To_auth_PasswordReq(in *PasswordReq, out *auth.PasswordReq, s conversion.Scope) error {
 	out.HashedPassword = in.HashedPassword
 	out.OriginalPassword = in.OriginalPassword
@@ -1826,6 +2752,30 @@ func Convert_auth_PolicyBinding_To_v1_PolicyBinding(in *auth.PolicyBinding, out
 	return autoConvert_auth_PolicyBinding_To_v1_PolicyBinding(in, out, s)
 }
 
+func autoConvert_v1_PolicyLink_To_auth_PolicyLink(in *PolicyLink, out *auth.PolicyLink, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ProjectID = in.ProjectID
+	return nil
+}
+
+// Convert_v1_PolicyLink_To_auth_PolicyLink is an autogenerated conversion function.
+func Convert_v1_PolicyLink_To_auth_PolicyLink(in *PolicyLink, out *auth.PolicyLink, s conversion.Scope) error {
+	return autoConvert_v1_PolicyLink_To_auth_PolicyLink(in, out, s)
+}
+
+func autoConvert_auth_PolicyLink_To_v1_PolicyLink(in *auth.PolicyLink, out *PolicyLink, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ProjectID = in.ProjectID
+	return nil
+}
+
+// Convert_auth_PolicyLink_To_v1_PolicyLink is an autogenerated conversion function.
+func Convert_auth_PolicyLink_To_v1_PolicyLink(in *auth.PolicyLink, out *PolicyLink, s conversion.Scope) error {
+	return autoConvert_auth_PolicyLink_To_v1_PolicyLink(in, out, s)
+}
+
 func autoConvert_v1_PolicyList_To_auth_PolicyList(in *PolicyList, out *auth.PolicyList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]auth.Policy)(unsafe.Pointer(&in.Items))
@@ -1848,6 +2798,128 @@ func Convert_auth_PolicyList_To_v1_PolicyList(in *auth.PolicyList, out *PolicyLi
 	return autoConvert_auth_PolicyList_To_v1_PolicyList(in, out, s)
 }
 
+func autoConvert_v1_PolicyMatch_To_auth_PolicyMatch(in *PolicyMatch, out *auth.PolicyMatch, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Effect = auth.Effect(in.Effect)
+	out.Applied = in.Applied
+	out.Conditions = in.Conditions
+	out.Chain = *(*[]auth.PolicyLink)(unsafe.Pointer(&in.Chain))
+	return nil
+}
+
+// Convert_v1_PolicyMatch_To_auth_PolicyMatch is an autogenerated conversion function.
+func Convert_v1_PolicyMatch_To_auth_PolicyMatch(in *PolicyMatch, out *auth.PolicyMatch, s conversion.Scope) error {
+	return autoConvert_v1_PolicyMatch_To_auth_PolicyMatch(in, out, s)
+}
+
+func autoConvert_auth_PolicyMatch_To_v1_PolicyMatch(in *auth.PolicyMatch, out *PolicyMatch, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Effect = Effect(in.Effect)
+	out.Applied = in.Applied
+	out.Conditions = in.Conditions
+	out.Chain = *(*[]PolicyLink)(unsafe.Pointer(&in.Chain))
+	return nil
+}
+
+// Convert_auth_PolicyMatch_To_v1_PolicyMatch is an autogenerated conversion function.
+func Convert_auth_PolicyMatch_To_v1_PolicyMatch(in *auth.PolicyMatch, out *PolicyMatch, s conversion.Scope) error {
+	return autoConvert_auth_PolicyMatch_To_v1_PolicyMatch(in, out, s)
+}
+
+func autoConvert_v1_PolicySimulation_To_auth_PolicySimulation(in *PolicySimulation, out *auth.PolicySimulation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_PolicySimulation_To_auth_PolicySimulation is an autogenerated conversion function.
+func Convert_v1_PolicySimulation_To_auth_PolicySimulation(in *PolicySimulation, out *auth.PolicySimulation, s conversion.Scope) error {
+	return autoConvert_v1_PolicySimulation_To_auth_PolicySimulation(in, out, s)
+}
+
+func autoConvert_auth_PolicySimulation_To_v1_PolicySimulation(in *auth.PolicySimulation, out *PolicySimulation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_PolicySimulation_To_v1_PolicySimulation is an autogenerated conversion function.
+func Convert_auth_PolicySimulation_To_v1_PolicySimulation(in *auth.PolicySimulation, out *PolicySimulation, s conversion.Scope) error {
+	return autoConvert_auth_PolicySimulation_To_v1_PolicySimulation(in, out, s)
+}
+
+func autoConvert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec(in *PolicySimulationSpec, out *auth.PolicySimulationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.User = in.User
+	out.Group = in.Group
+	out.ProjectID = in.ProjectID
+	out.Action = in.Action
+	out.Resource = in.Resource
+	out.Extra = *(*map[string]auth.ExtraValue)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec is an autogenerated conversion function.
+func Convert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec(in *PolicySimulationSpec, out *auth.PolicySimulationSpec, s conversion.Scope) error {
+	return autoConvert_v1_PolicySimulationSpec_To_auth_PolicySimulationSpec(in, out, s)
+}
+
+func autoConvert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec(in *auth.PolicySimulationSpec, out *PolicySimulationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.User = in.User
+	out.Group = in.Group
+	out.ProjectID = in.ProjectID
+	out.Action = in.Action
+	out.Resource = in.Resource
+	out.Extra = *(*map[string]ExtraValue)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec is an autogenerated conversion function.
+func Convert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec(in *auth.PolicySimulationSpec, out *PolicySimulationSpec, s conversion.Scope) error {
+	return autoConvert_auth_PolicySimulationSpec_To_v1_PolicySimulationSpec(in, out, s)
+}
+
+func autoConvert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus(in *PolicySimulationStatus, out *auth.PolicySimulationStatus, s conversion.Scope) error {
+	if err := Convert_v1_SubjectAccessReviewStatus_To_auth_SubjectAccessReviewStatus(&in.Review, &out.Review, s); err != nil {
+		return err
+	}
+	out.Matches = *(*[]auth.PolicyMatch)(unsafe.Pointer(&in.Matches))
+	out.Users = *(*[]auth.Subject)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]auth.Subject)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus is an autogenerated conversion function.
+func Convert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus(in *PolicySimulationStatus, out *auth.PolicySimulationStatus, s conversion.Scope) error {
+	return autoConvert_v1_PolicySimulationStatus_To_auth_PolicySimulationStatus(in, out, s)
+}
+
+func autoConvert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus(in *auth.PolicySimulationStatus, out *PolicySimulationStatus, s conversion.Scope) error {
+	if err := Convert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(&in.Review, &out.Review, s); err != nil {
+		return err
+	}
+	out.Matches = *(*[]PolicyMatch)(unsafe.Pointer(&in.Matches))
+	out.Users = *(*[]Subject)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]Subject)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus is an autogenerated conversion function.
+func Convert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus(in *auth.PolicySimulationStatus, out *PolicySimulationStatus, s conversion.Scope) error {
+	return autoConvert_auth_PolicySimulationStatus_To_v1_PolicySimulationStatus(in, out, s)
+}
+
 func autoConvert_v1_PolicySpec_To_auth_PolicySpec(in *PolicySpec, out *auth.PolicySpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]auth.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.DisplayName = in.DisplayName
@@ -2478,6 +3550,50 @@ func Convert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(in *
 	return autoConvert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(in, out, s)
 }
 
+func autoConvert_v1_TOTPCredential_To_auth_TOTPCredential(in *TOTPCredential, out *auth.TOTPCredential, s conversion.Scope) error {
+	out.Secret = in.Secret
+	out.Verified = in.Verified
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_v1_TOTPCredential_To_auth_TOTPCredential is an autogenerated conversion function.
+func Convert_v1_TOTPCredential_To_auth_TOTPCredential(in *TOTPCredential, out *auth.TOTPCredential, s conversion.Scope) error {
+	return autoConvert_v1_TOTPCredential_To_auth_TOTPCredential(in, out, s)
+}
+
+func autoConvert_auth_TOTPCredential_To_v1_TOTPCredential(in *auth.TOTPCredential, out *TOTPCredential, s conversion.Scope) error {
+	out.Secret = in.Secret
+	out.Verified = in.Verified
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_auth_TOTPCredential_To_v1_TOTPCredential is an autogenerated conversion function.
+func Convert_auth_TOTPCredential_To_v1_TOTPCredential(in *auth.TOTPCredential, out *TOTPCredential, s conversion.Scope) error {
+	return autoConvert_auth_TOTPCredential_To_v1_TOTPCredential(in, out, s)
+}
+
+func autoConvert_v1_UnlockReq_To_auth_UnlockReq(in *UnlockReq, out *auth.UnlockReq, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_UnlockReq_To_auth_UnlockReq is an autogenerated conversion function.
+func Convert_v1_UnlockReq_To_auth_UnlockReq(in *UnlockReq, out *auth.UnlockReq, s conversion.Scope) error {
+	return autoConvert_v1_UnlockReq_To_auth_UnlockReq(in, out, s)
+}
+
+func autoConvert_auth_UnlockReq_To_v1_UnlockReq(in *auth.UnlockReq, out *UnlockReq, s conversion.Scope) error {
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_auth_UnlockReq_To_v1_UnlockReq is an autogenerated conversion function.
+func Convert_auth_UnlockReq_To_v1_UnlockReq(in *auth.UnlockReq, out *UnlockReq, s conversion.Scope) error {
+	return autoConvert_auth_UnlockReq_To_v1_UnlockReq(in, out, s)
+}
+
 func autoConvert_v1_User_To_auth_User(in *User, out *auth.User, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_UserSpec_To_auth_UserSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2557,3 +3673,87 @@ func autoConvert_auth_UserSpec_To_v1_UserSpec(in *auth.UserSpec, out *UserSpec,
 func Convert_auth_UserSpec_To_v1_UserSpec(in *auth.UserSpec, out *UserSpec, s conversion.Scope) error {
 	return autoConvert_auth_UserSpec_To_v1_UserSpec(in, out, s)
 }
+
+func autoConvert_v1_WebAuthnChallenge_To_auth_WebAuthnChallenge(in *WebAuthnChallenge, out *auth.WebAuthnChallenge, s conversion.Scope) error {
+	out.Challenge = in.Challenge
+	out.Purpose = auth.WebAuthnPurpose(in.Purpose)
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_v1_WebAuthnChallenge_To_auth_WebAuthnChallenge is an autogenerated conversion function.
+func Convert_v1_WebAuthnChallenge_To_auth_WebAuthnChallenge(in *WebAuthnChallenge, out *auth.WebAuthnChallenge, s conversion.Scope) error {
+	return autoConvert_v1_WebAuthnChallenge_To_auth_WebAuthnChallenge(in, out, s)
+}
+
+func autoConvert_auth_WebAuthnChallenge_To_v1_WebAuthnChallenge(in *auth.WebAuthnChallenge, out *WebAuthnChallenge, s conversion.Scope) error {
+	out.Challenge = in.Challenge
+	out.Purpose = WebAuthnPurpose(in.Purpose)
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_auth_WebAuthnChallenge_To_v1_WebAuthnChallenge is an autogenerated conversion function.
+func Convert_auth_WebAuthnChallenge_To_v1_WebAuthnChallenge(in *auth.WebAuthnChallenge, out *WebAuthnChallenge, s conversion.Scope) error {
+	return autoConvert_auth_WebAuthnChallenge_To_v1_WebAuthnChallenge(in, out, s)
+}
+
+func autoConvert_v1_WebAuthnCredential_To_auth_WebAuthnCredential(in *WebAuthnCredential, out *auth.WebAuthnCredential, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	out.Algorithm = in.Algorithm
+	out.SignCount = in.SignCount
+	out.CreationTime = in.CreationTime
+	out.LastUsedTime = in.LastUsedTime
+	return nil
+}
+
+// Convert_v1_WebAuthnCredential_To_auth_WebAuthnCredential is an autogenerated conversion function.
+func Convert_v1_WebAuthnCredential_To_auth_WebAuthnCredential(in *WebAuthnCredential, out *auth.WebAuthnCredential, s conversion.Scope) error {
+	return autoConvert_v1_WebAuthnCredential_To_auth_WebAuthnCredential(in, out, s)
+}
+
+func autoConvert_auth_WebAuthnCredential_To_v1_WebAuthnCredential(in *auth.WebAuthnCredential, out *WebAuthnCredential, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	out.Algorithm = in.Algorithm
+	out.SignCount = in.SignCount
+	out.CreationTime = in.CreationTime
+	out.LastUsedTime = in.LastUsedTime
+	return nil
+}
+
+// Convert_auth_WebAuthnCredential_To_v1_WebAuthnCredential is an autogenerated conversion function.
+func Convert_auth_WebAuthnCredential_To_v1_WebAuthnCredential(in *auth.WebAuthnCredential, out *WebAuthnCredential, s conversion.Scope) error {
+	return autoConvert_auth_WebAuthnCredential_To_v1_WebAuthnCredential(in, out, s)
+}
+
+func autoConvert_v1_WebAuthnRegistration_To_auth_WebAuthnRegistration(in *WebAuthnRegistration, out *auth.WebAuthnRegistration, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	out.Algorithm = in.Algorithm
+	out.ClientDataJSON = in.ClientDataJSON
+	return nil
+}
+
+// Convert_v1_WebAuthnRegistration_To_auth_WebAuthnRegistration is an autogenerated conversion function.
+func Convert_v1_WebAuthnRegistration_To_auth_WebAuthnRegistration(in *WebAuthnRegistration, out *auth.WebAuthnRegistration, s conversion.Scope) error {
+	return autoConvert_v1_WebAuthnRegistration_To_auth_WebAuthnRegistration(in, out, s)
+}
+
+func autoConvert_auth_WebAuthnRegistration_To_v1_WebAuthnRegistration(in *auth.WebAuthnRegistration, out *WebAuthnRegistration, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Name = in.Name
+	out.PublicKey = in.PublicKey
+	out.Algorithm = in.Algorithm
+	out.ClientDataJSON = in.ClientDataJSON
+	return nil
+}
+
+// Convert_auth_WebAuthnRegistration_To_v1_WebAuthnRegistration is an autogenerated conversion function.
+func Convert_auth_WebAuthnRegistration_To_v1_WebAuthnRegistration(in *auth.WebAuthnRegistration, out *WebAuthnRegistration, s conversion.Scope) error {
+	return autoConvert_auth_WebAuthnRegistration_To_v1_WebAuthnRegistration(in, out, s)
+}
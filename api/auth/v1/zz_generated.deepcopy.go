@@ -32,7 +32,7 @@ func (in *APIKey) DeepCopyInto(out *APIKey) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -92,6 +92,16 @@ func (in *APIKeyReq) DeepCopyInto(out *APIKeyReq) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.Expire = in.Expire
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -118,6 +128,16 @@ func (in *APIKeyReqPassword) DeepCopyInto(out *APIKeyReqPassword) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.Expire = in.Expire
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -139,11 +159,79 @@ func (in *APIKeyReqPassword) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyRotateReq) DeepCopyInto(out *APIKeyRotateReq) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyRotateReq.
+func (in *APIKeyRotateReq) DeepCopy() *APIKeyRotateReq {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyRotateReq)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyRotateReq) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyScope) DeepCopyInto(out *APIKeyScope) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyScope.
+func (in *APIKeyScope) DeepCopy() *APIKeyScope {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
 	*out = *in
 	in.IssueAt.DeepCopyInto(&out.IssueAt)
 	in.ExpireAt.DeepCopyInto(&out.ExpireAt)
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceIPs != nil {
+		in, out := &in.SourceIPs, &out.SourceIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -160,6 +248,8 @@ func (in *APIKeySpec) DeepCopy() *APIKeySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeyStatus) DeepCopyInto(out *APIKeyStatus) {
 	*out = *in
+	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
+	in.RetireAt.DeepCopyInto(&out.RetireAt)
 	return
 }
 
@@ -242,6 +332,161 @@ func (in *APISigningKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequest) DeepCopyInto(out *AccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequest.
+func (in *AccessRequest) DeepCopy() *AccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestEvent) DeepCopyInto(out *AccessRequestEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestEvent.
+func (in *AccessRequestEvent) DeepCopy() *AccessRequestEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestList) DeepCopyInto(out *AccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestList.
+func (in *AccessRequestList) DeepCopy() *AccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestNotification) DeepCopyInto(out *AccessRequestNotification) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestNotification.
+func (in *AccessRequestNotification) DeepCopy() *AccessRequestNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestSpec) DeepCopyInto(out *AccessRequestSpec) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(AccessRequestNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestSpec.
+func (in *AccessRequestSpec) DeepCopy() *AccessRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessRequestStatus) DeepCopyInto(out *AccessRequestStatus) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]AccessRequestEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestStatus.
+func (in *AccessRequestStatus) DeepCopy() *AccessRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Action) DeepCopyInto(out *Action) {
 	*out = *in
@@ -690,6 +935,105 @@ func (in ExtraValue) DeepCopy() ExtraValue {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedIdentity) DeepCopyInto(out *FederatedIdentity) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedIdentity.
+func (in *FederatedIdentity) DeepCopy() *FederatedIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FederatedIdentity) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedIdentityList) DeepCopyInto(out *FederatedIdentityList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FederatedIdentity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedIdentityList.
+func (in *FederatedIdentityList) DeepCopy() *FederatedIdentityList {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedIdentityList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FederatedIdentityList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedIdentitySpec) DeepCopyInto(out *FederatedIdentitySpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedIdentitySpec.
+func (in *FederatedIdentitySpec) DeepCopy() *FederatedIdentitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedIdentitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedIdentityStatus) DeepCopyInto(out *FederatedIdentityStatus) {
+	*out = *in
+	in.LastLoginTime.DeepCopyInto(&out.LastLoginTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedIdentityStatus.
+func (in *FederatedIdentityStatus) DeepCopy() *FederatedIdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedIdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -863,6 +1207,16 @@ func (in *IdentityProviderSpec) DeepCopyInto(out *IdentityProviderSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MultiFactor != nil {
+		in, out := &in.MultiFactor, &out.MultiFactor
+		*out = new(MultiFactorPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordPolicy != nil {
+		in, out := &in.PasswordPolicy, &out.PasswordPolicy
+		*out = new(PasswordPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -1084,8 +1438,16 @@ func (in *LocalIdentitySpec) DeepCopy() *LocalIdentitySpec {
 func (in *LocalIdentityStatus) DeepCopyInto(out *LocalIdentityStatus) {
 	*out = *in
 	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
-	return
-}
+	in.PasswordChangeTime.DeepCopyInto(&out.PasswordChangeTime)
+	if in.PasswordHistory != nil {
+		in, out := &in.PasswordHistory, &out.PasswordHistory
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastFailedLoginTime.DeepCopyInto(&out.LastFailedLoginTime)
+	in.LockoutExpireTime.DeepCopyInto(&out.LockoutExpireTime)
+	return
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalIdentityStatus.
 func (in *LocalIdentityStatus) DeepCopy() *LocalIdentityStatus {
@@ -1097,6 +1459,224 @@ func (in *LocalIdentityStatus) DeepCopy() *LocalIdentityStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorEnrollment) DeepCopyInto(out *MultiFactorEnrollment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorEnrollment.
+func (in *MultiFactorEnrollment) DeepCopy() *MultiFactorEnrollment {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorEnrollment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiFactorEnrollment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorEnrollmentList) DeepCopyInto(out *MultiFactorEnrollmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MultiFactorEnrollment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorEnrollmentList.
+func (in *MultiFactorEnrollmentList) DeepCopy() *MultiFactorEnrollmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorEnrollmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiFactorEnrollmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorEnrollmentSpec) DeepCopyInto(out *MultiFactorEnrollmentSpec) {
+	*out = *in
+	if in.TOTP != nil {
+		in, out := &in.TOTP, &out.TOTP
+		*out = new(TOTPCredential)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebAuthn != nil {
+		in, out := &in.WebAuthn, &out.WebAuthn
+		*out = make([]WebAuthnCredential, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RecoveryCodes != nil {
+		in, out := &in.RecoveryCodes, &out.RecoveryCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorEnrollmentSpec.
+func (in *MultiFactorEnrollmentSpec) DeepCopy() *MultiFactorEnrollmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorEnrollmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorEnrollmentStatus) DeepCopyInto(out *MultiFactorEnrollmentStatus) {
+	*out = *in
+	if in.Challenges != nil {
+		in, out := &in.Challenges, &out.Challenges
+		*out = make([]WebAuthnChallenge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastVerifiedTime.DeepCopyInto(&out.LastVerifiedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorEnrollmentStatus.
+func (in *MultiFactorEnrollmentStatus) DeepCopy() *MultiFactorEnrollmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorEnrollmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorPolicy) DeepCopyInto(out *MultiFactorPolicy) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorPolicy.
+func (in *MultiFactorPolicy) DeepCopy() *MultiFactorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorRequest) DeepCopyInto(out *MultiFactorRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorRequest.
+func (in *MultiFactorRequest) DeepCopy() *MultiFactorRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultiFactorRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorRequestSpec) DeepCopyInto(out *MultiFactorRequestSpec) {
+	*out = *in
+	if in.WebAuthn != nil {
+		in, out := &in.WebAuthn, &out.WebAuthn
+		*out = new(WebAuthnRegistration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorRequestSpec.
+func (in *MultiFactorRequestSpec) DeepCopy() *MultiFactorRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultiFactorRequestStatus) DeepCopyInto(out *MultiFactorRequestStatus) {
+	*out = *in
+	if in.RecoveryCodes != nil {
+		in, out := &in.RecoveryCodes, &out.RecoveryCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Factors != nil {
+		in, out := &in.Factors, &out.Factors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultiFactorRequestStatus.
+func (in *MultiFactorRequestStatus) DeepCopy() *MultiFactorRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultiFactorRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NonResourceAttributes) DeepCopyInto(out *NonResourceAttributes) {
 	*out = *in
@@ -1113,6 +1693,24 @@ func (in *NonResourceAttributes) DeepCopy() *NonResourceAttributes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordPolicy) DeepCopyInto(out *PasswordPolicy) {
+	*out = *in
+	out.MaxAge = in.MaxAge
+	out.LockoutDuration = in.LockoutDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordPolicy.
+func (in *PasswordPolicy) DeepCopy() *PasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PasswordReq) DeepCopyInto(out *PasswordReq) {
 	*out = *in
@@ -1196,6 +1794,22 @@ func (in *PolicyBinding) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyLink) DeepCopyInto(out *PolicyLink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyLink.
+func (in *PolicyLink) DeepCopy() *PolicyLink {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyList) DeepCopyInto(out *PolicyList) {
 	*out = *in
@@ -1229,6 +1843,120 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyMatch) DeepCopyInto(out *PolicyMatch) {
+	*out = *in
+	if in.Chain != nil {
+		in, out := &in.Chain, &out.Chain
+		*out = make([]PolicyLink, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyMatch.
+func (in *PolicyMatch) DeepCopy() *PolicyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySimulation) DeepCopyInto(out *PolicySimulation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySimulation.
+func (in *PolicySimulation) DeepCopy() *PolicySimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PolicySimulation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySimulationSpec) DeepCopyInto(out *PolicySimulationSpec) {
+	*out = *in
+	if in.Extra != nil {
+		in, out := &in.Extra, &out.Extra
+		*out = make(map[string]ExtraValue, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(ExtraValue, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySimulationSpec.
+func (in *PolicySimulationSpec) DeepCopy() *PolicySimulationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySimulationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicySimulationStatus) DeepCopyInto(out *PolicySimulationStatus) {
+	*out = *in
+	in.Review.DeepCopyInto(&out.Review)
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]PolicyMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicySimulationStatus.
+func (in *PolicySimulationStatus) DeepCopy() *PolicySimulationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicySimulationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicySpec) DeepCopyInto(out *PolicySpec) {
 	*out = *in
@@ -1917,6 +2645,48 @@ func (in *SubjectAccessReviewStatus) DeepCopy() *SubjectAccessReviewStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPCredential) DeepCopyInto(out *TOTPCredential) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPCredential.
+func (in *TOTPCredential) DeepCopy() *TOTPCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnlockReq) DeepCopyInto(out *UnlockReq) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnlockReq.
+func (in *UnlockReq) DeepCopy() *UnlockReq {
+	if in == nil {
+		return nil
+	}
+	out := new(UnlockReq)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *UnlockReq) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -1999,3 +2769,54 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebAuthnChallenge) DeepCopyInto(out *WebAuthnChallenge) {
+	*out = *in
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebAuthnChallenge.
+func (in *WebAuthnChallenge) DeepCopy() *WebAuthnChallenge {
+	if in == nil {
+		return nil
+	}
+	out := new(WebAuthnChallenge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebAuthnCredential) DeepCopyInto(out *WebAuthnCredential) {
+	*out = *in
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebAuthnCredential.
+func (in *WebAuthnCredential) DeepCopy() *WebAuthnCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(WebAuthnCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebAuthnRegistration) DeepCopyInto(out *WebAuthnRegistration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebAuthnRegistration.
+func (in *WebAuthnRegistration) DeepCopy() *WebAuthnRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebAuthnRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -66,6 +66,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Namespace{},
 		&NamespaceList{},
 		&NamespaceCertOptions{},
+		&ProjectBillingOptions{},
+		&ProjectImportOptions{},
 
 		&Platform{},
 		&PlatformList{},
@@ -83,6 +85,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+		&QuotaRequest{},
+		&QuotaRequestList{},
+
+		&NamespaceTemplate{},
+		&NamespaceTemplateList{},
 	)
 	return nil
 }
@@ -19,6 +19,9 @@
 package business
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -56,6 +59,45 @@ type ProjectList struct {
 	Items []Project
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectBillingOptions is the query options of getting the billing report
+// of a project.
+type ProjectBillingOptions struct {
+	metav1.TypeMeta
+
+	// Start is the first day of the report in RFC3339 or 2006-01-02 format.
+	Start string
+	// End is the day after the last day of the report in RFC3339 or
+	// 2006-01-02 format, defaults to now.
+	// +optional
+	End string
+	// Format of the report, json or csv, defaults to json.
+	// +optional
+	Format string
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectImportOptions is the query options of importing a project bundle.
+type ProjectImportOptions struct {
+	metav1.TypeMeta
+
+	// TenantID is the tenant the bundle is imported into, defaults to the
+	// tenant of the user, or the tenant of the bundle.
+	// +optional
+	TenantID string
+	// ClusterMapping renames the clusters of the bundle, each item is in
+	// the source=destination format.
+	// +optional
+	ClusterMapping []string
+	// DryRun only reports the conflicts without creating anything.
+	// +optional
+	DryRun bool
+}
+
 // ProjectSpec is a description of a project.
 type ProjectSpec struct {
 	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
@@ -72,6 +114,85 @@ type ProjectSpec struct {
 	// Clusters represents clusters that can be used and the resource limits of each cluster.
 	// +optional
 	Clusters ClusterHard
+	// NamespaceTemplate is the name of the namespace template rendered into
+	// every business namespace of the project.
+	// +optional
+	NamespaceTemplate string
+	// QuotaApproval defines who approves the quota requests of the children
+	// of the project, and of the project itself if it has no parent.
+	// +optional
+	QuotaApproval *ProjectQuotaApproval
+	// Borrowing opts the project in to borrow the unused capacity of its
+	// parent project and sibling projects when its namespaces run out of
+	// quota.
+	// +optional
+	Borrowing *ProjectBorrowing
+	// Lifecycle describes who owns the project, who pays for it and when it
+	// expires.
+	// +optional
+	Lifecycle *ProjectLifecycle
+}
+
+// ProjectLifecycle describes the lifecycle metadata of a project.
+type ProjectLifecycle struct {
+	// Owner is the name of the user responsible for the project, who is
+	// warned before the project expires.
+	Owner string
+	// CostCenter is the cost center the usage of the project is charged to.
+	// +optional
+	CostCenter string
+	// Tier is the environment tier of the project.
+	// +optional
+	Tier ProjectTier
+	// ExpiryTime is the time the project expires at. The project is locked
+	// when it expires, it never expires if not set.
+	// +optional
+	ExpiryTime *metav1.Time
+}
+
+// ProjectTier is the environment tier of a project.
+type ProjectTier string
+
+// These are valid environment tiers of projects.
+const (
+	// ProjectTierDevelopment is the tier of development projects.
+	ProjectTierDevelopment ProjectTier = "Development"
+	// ProjectTierTesting is the tier of testing projects.
+	ProjectTierTesting ProjectTier = "Testing"
+	// ProjectTierStaging is the tier of staging projects.
+	ProjectTierStaging ProjectTier = "Staging"
+	// ProjectTierProduction is the tier of production projects.
+	ProjectTierProduction ProjectTier = "Production"
+)
+
+// ProjectBorrowing defines how much capacity a project can borrow.
+type ProjectBorrowing struct {
+	// Ceiling is the max quantities the project can borrow in each cluster in
+	// addition to its own hard limits.
+	Ceiling ClusterHard
+}
+
+// ProjectQuotaApproval defines the approvers of quota requests and how they
+// are notified.
+type ProjectQuotaApproval struct {
+	// Approvers are the names of the users allowed to approve or reject the
+	// quota requests.
+	Approvers []string
+	// Notification is the message request created for every approval and
+	// rejection, nothing is sent if it is not set.
+	// +optional
+	Notification *QuotaRequestNotification
+}
+
+// QuotaRequestNotification refers to the notify channel, template and
+// receivers used to notify the decisions on quota requests.
+type QuotaRequestNotification struct {
+	ChannelName  string
+	TemplateName string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
 }
 
 // ProjectStatus represents information about the status of a project.
@@ -100,6 +221,43 @@ type ProjectStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string
+	// Loans are the capacities the namespaces of the project borrowed from
+	// other projects.
+	// +optional
+	Loans []ProjectLoan
+	// Lifecycle represents how far the project went in its lifecycle.
+	// +optional
+	Lifecycle *ProjectLifecycleStatus
+}
+
+// ProjectLifecycleStatus represents the progress of a project towards its
+// expiry.
+type ProjectLifecycleStatus struct {
+	// ExpiryTime is the expiry time of the project the other times refer to,
+	// the lifecycle restarts when the expiry time of the project changes.
+	// +optional
+	ExpiryTime *metav1.Time
+	// WarningTime is the time the owner was warned that the project expires.
+	// +optional
+	WarningTime *metav1.Time
+	// ExpiredTime is the time the project was locked because it expired.
+	// +optional
+	ExpiredTime *metav1.Time
+}
+
+// ProjectLoan is the capacity a namespace of a project borrowed from another
+// project in a cluster. It is reclaimed when the lender needs it.
+type ProjectLoan struct {
+	// Lender is the name of the project the capacity is borrowed from.
+	Lender      string
+	ClusterName string
+	// Namespace is the name of the namespace of the project using the capacity.
+	Namespace string
+	// Resources are the borrowed quantities.
+	Resources ResourceList
+	// The last time the borrowed quantities changed.
+	// +optional
+	LastTransitionTime metav1.Time
 }
 
 // ProjectPhase defines the phase of project constructor.
@@ -223,6 +381,21 @@ type NamespaceStatus struct {
 	CachedSpecHard ResourceList
 	// +optional
 	Certificate *NamespaceCert
+	// TemplateName is the namespace template last rendered onto the cluster.
+	// +optional
+	TemplateName string
+	// TemplateGeneration is the generation of the namespace template last
+	// rendered onto the cluster.
+	// +optional
+	TemplateGeneration int64
+	// TemplateObjects is the sync state of every object rendered from the
+	// namespace template.
+	// +optional
+	TemplateObjects []NamespaceTemplateObjectStatus
+	// Borrowed represents the quantities the namespace borrowed from other
+	// projects in addition to its hard limits.
+	// +optional
+	Borrowed ResourceList
 }
 
 // NamespaceCert represents a x509 certificate of a namespace in project.
@@ -237,6 +410,39 @@ type NamespaceCert struct {
 	APIServer string
 }
 
+// NamespaceTemplateObjectStatus represents the sync state of an object
+// rendered from the namespace template.
+type NamespaceTemplateObjectStatus struct {
+	// Kind of the rendered object, such as LimitRange or NetworkPolicy.
+	Kind string
+	// Name of the rendered object.
+	Name string
+	// State is the sync state of the object.
+	State NamespaceTemplateObjectState
+	// The last time the object was rendered to match the template.
+	// +optional
+	LastSyncTime metav1.Time
+	// The last time the object was found drifted from the template and
+	// corrected.
+	// +optional
+	LastDriftTime metav1.Time
+	// A human readable message indicating why the object failed to sync.
+	// +optional
+	Message string
+}
+
+// NamespaceTemplateObjectState indicates the sync state of a rendered object.
+type NamespaceTemplateObjectState string
+
+// These are valid sync states of rendered objects.
+const (
+	// NamespaceTemplateObjectSynced indicates the object matches the template.
+	NamespaceTemplateObjectSynced NamespaceTemplateObjectState = "Synced"
+	// NamespaceTemplateObjectFailed indicates the object could not be
+	// rendered onto the cluster.
+	NamespaceTemplateObjectFailed NamespaceTemplateObjectState = "Failed"
+)
+
 // NamespacePhase indicates the status of namespace in project.
 type NamespacePhase string
 
@@ -261,6 +467,87 @@ const (
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// NamespaceTemplate describes the default objects rendered into every business
+// namespace of the projects referencing it.
+type NamespaceTemplate struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the objects rendered into the namespaces.
+	// +optional
+	Spec NamespaceTemplateSpec
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplateList is the whole list of all namespace templates.
+type NamespaceTemplateList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of namespace templates
+	Items []NamespaceTemplate
+}
+
+// NamespaceTemplateSpec is a description of a namespace template.
+type NamespaceTemplateSpec struct {
+	TenantID string
+	// +optional
+	DisplayName string
+	// Labels are merged into the labels of the kubernetes namespace.
+	// +optional
+	Labels map[string]string
+	// Annotations are merged into the annotations of the kubernetes namespace.
+	// +optional
+	Annotations map[string]string
+	// PodSecurity sets the pod security admission labels of the kubernetes
+	// namespace.
+	// +optional
+	PodSecurity *NamespaceTemplatePodSecurity
+	// LimitRange is the default limit range of the kubernetes namespace.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec
+	// NetworkPolicies are created in the kubernetes namespace.
+	// +optional
+	NetworkPolicies []NamespaceTemplateNetworkPolicy
+	// RoleBindings are created in the kubernetes namespace.
+	// +optional
+	RoleBindings []NamespaceTemplateRoleBinding
+}
+
+// NamespaceTemplatePodSecurity is the pod security standard level applied to
+// the namespace for each pod security admission mode.
+type NamespaceTemplatePodSecurity struct {
+	// +optional
+	Enforce string
+	// +optional
+	Audit string
+	// +optional
+	Warn string
+}
+
+// NamespaceTemplateNetworkPolicy is a network policy created in the namespace.
+type NamespaceTemplateNetworkPolicy struct {
+	Name string
+	Spec networkingv1.NetworkPolicySpec
+}
+
+// NamespaceTemplateRoleBinding is a role binding created in the namespace.
+type NamespaceTemplateRoleBinding struct {
+	Name    string
+	RoleRef rbacv1.RoleRef
+	// +optional
+	Subjects []rbacv1.Subject
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // Platform is a platform in TKE.
 type Platform struct {
 	metav1.TypeMeta
@@ -541,6 +828,20 @@ type NsEmigrationSpec struct {
 	Namespace   string
 	NsShowName  string
 	Destination string
+	// DestinationCluster is the cluster the namespace moves to. When it is set,
+	// the workloads of the namespace are copied from the current cluster to
+	// the destination cluster, instead of only re-parenting the namespace.
+	// +optional
+	DestinationCluster string
+	// RegistryMappings maps the image registry prefixes used in the current
+	// cluster to the ones reachable from the destination cluster, such as
+	// "registry-a.example.com/library" to "registry-b.example.com/library".
+	// +optional
+	RegistryMappings map[string]string
+	// StorageClassMappings maps the storage classes of the current cluster to
+	// the ones of the destination cluster.
+	// +optional
+	StorageClassMappings map[string]string
 }
 
 // NsEmigrationStatus represents information about the status of a namespace emigration.
@@ -556,8 +857,49 @@ type NsEmigrationStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string
+	// DestinationNamespace is the name of the namespace created in the
+	// destination project.
+	// +optional
+	DestinationNamespace string
+	// Resources is the progress of every resource copied to the destination
+	// cluster.
+	// +optional
+	Resources []NsEmigrationResourceStatus
 }
 
+// NsEmigrationResourceStatus represents the progress of a resource copied to
+// the destination cluster.
+type NsEmigrationResourceStatus struct {
+	// Kind of the resource, such as Deployment or ConfigMap.
+	Kind string
+	// Name of the resource.
+	Name string
+	// +optional
+	Phase NsEmigrationResourcePhase
+	// A human readable message indicating why the resource failed to copy or
+	// roll back.
+	// +optional
+	Message string
+}
+
+// NsEmigrationResourcePhase indicates the phase of a resource copied to the
+// destination cluster.
+type NsEmigrationResourcePhase string
+
+// These are valid phases of resources copied to the destination cluster.
+const (
+	// NsEmigrationResourcePending indicates that the resource is waiting to be copied.
+	NsEmigrationResourcePending NsEmigrationResourcePhase = "Pending"
+	// NsEmigrationResourceCopied indicates that the resource has been copied.
+	NsEmigrationResourceCopied NsEmigrationResourcePhase = "Copied"
+	// NsEmigrationResourceFailed indicates that the resource failed to copy
+	// or roll back.
+	NsEmigrationResourceFailed NsEmigrationResourcePhase = "Failed"
+	// NsEmigrationResourceRolledBack indicates that the copied resource has
+	// been removed from the destination cluster.
+	NsEmigrationResourceRolledBack NsEmigrationResourcePhase = "RolledBack"
+)
+
 // NsEmigrationPhase indicates the phase of namespace emigrations.
 type NsEmigrationPhase string
 
@@ -571,6 +913,15 @@ const (
 	NsEmigrationOldOneDetached NsEmigrationPhase = "OldOneDetached"
 	// NsEmigrationNewOneCreated indicates that new namespace has been created.
 	NsEmigrationNewOneCreated NsEmigrationPhase = "NewOneCreated"
+	// NsEmigrationResourcesCopying indicates that the resources of old namespace
+	// are being copied to the destination cluster.
+	NsEmigrationResourcesCopying NsEmigrationPhase = "ResourcesCopying"
+	// NsEmigrationRollingBack indicates that the copied resources and new
+	// namespace are being removed after a failed copy.
+	NsEmigrationRollingBack NsEmigrationPhase = "RollingBack"
+	// NsEmigrationRolledBack indicates that the emigration has been rolled back
+	// and old namespace is available again.
+	NsEmigrationRolledBack NsEmigrationPhase = "RolledBack"
 	// NsEmigrationOldOneTerminating indicates that old namespace is terminating.
 	NsEmigrationOldOneTerminating NsEmigrationPhase = "OldOneTerminating"
 	// NsEmigrationFinished indicates that the emigration finished.
@@ -578,3 +929,84 @@ const (
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaRequest is a request of a project member to change the resource
+// limits of the project in a cluster, approved or rejected by the approvers
+// of the parent project.
+type QuotaRequest struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the requested resource limits.
+	// +optional
+	Spec QuotaRequestSpec
+	// +optional
+	Status QuotaRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaRequestList is the whole list of all quota requests of a project.
+type QuotaRequestList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of quota requests
+	Items []QuotaRequest
+}
+
+// QuotaRequestSpec is the description of a quota request.
+type QuotaRequestSpec struct {
+	TenantID string
+	// ClusterName is the cluster whose resource limits are requested.
+	ClusterName string
+	// Hard is the requested resource limits, replacing the limits of the
+	// same resources of the project in the cluster.
+	Hard ResourceList
+	// Justification explains why the resources are needed.
+	Justification string
+	// Requester is the user who filed the request, set by the server.
+	// +optional
+	Requester string
+}
+
+// QuotaRequestStatus represents information about the status of a quota request.
+type QuotaRequestStatus struct {
+	// +optional
+	Phase QuotaRequestPhase
+	// Reviewer is the approver who approved or rejected the request.
+	// +optional
+	Reviewer string
+	// Comment of the reviewer.
+	// +optional
+	Comment string
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// Notified is true once the requester and the approvers have been
+	// notified of the decision.
+	// +optional
+	Notified bool
+	// A human readable message indicating why the notification failed.
+	// +optional
+	Message string
+}
+
+// QuotaRequestPhase indicates the phase of quota requests.
+type QuotaRequestPhase string
+
+// These are valid phases of quota requests.
+const (
+	// QuotaRequestPending indicates that the request is waiting for approval.
+	QuotaRequestPending QuotaRequestPhase = "Pending"
+	// QuotaRequestApproved indicates that the request has been approved and
+	// the resource limits of the project have been changed.
+	QuotaRequestApproved QuotaRequestPhase = "Approved"
+	// QuotaRequestRejected indicates that the request has been rejected.
+	QuotaRequestRejected QuotaRequestPhase = "Rejected"
+)
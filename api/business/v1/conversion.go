@@ -31,6 +31,8 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForNamespace,
 		AddFieldLabelConversionsForImageNamespace,
 		AddFieldLabelConversionsForChartGroup,
+		AddFieldLabelConversionsForNamespaceTemplate,
+		AddFieldLabelConversionsForQuotaRequest,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -127,3 +129,39 @@ func AddFieldLabelConversionsForChartGroup(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForNamespaceTemplate adds a conversion function to
+// convert field selectors of NamespaceTemplate from the given version to
+// internal version representation.
+func AddFieldLabelConversionsForNamespaceTemplate(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("NamespaceTemplate"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForQuotaRequest adds a conversion function to convert
+// field selectors of QuotaRequest from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForQuotaRequest(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("QuotaRequest"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.requester",
+				"status.phase",
+				"metadata.name",
+				"metadata.namespace":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -54,6 +54,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Namespace{},
 		&NamespaceList{},
 		&NamespaceCertOptions{},
+		&ProjectBillingOptions{},
+		&ProjectImportOptions{},
 
 		&Platform{},
 		&PlatformList{},
@@ -71,6 +73,11 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+		&QuotaRequest{},
+		&QuotaRequestList{},
+
+		&NamespaceTemplate{},
+		&NamespaceTemplateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -19,6 +19,9 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -54,6 +57,45 @@ type ProjectList struct {
 	Items []Project `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectBillingOptions is the query options of getting the billing report
+// of a project.
+type ProjectBillingOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Start is the first day of the report in RFC3339 or 2006-01-02 format.
+	Start string `json:"start,omitempty" protobuf:"bytes,1,opt,name=start"`
+	// End is the day after the last day of the report in RFC3339 or
+	// 2006-01-02 format, defaults to now.
+	// +optional
+	End string `json:"end,omitempty" protobuf:"bytes,2,opt,name=end"`
+	// Format of the report, json or csv, defaults to json.
+	// +optional
+	Format string `json:"format,omitempty" protobuf:"bytes,3,opt,name=format"`
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectImportOptions is the query options of importing a project bundle.
+type ProjectImportOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// TenantID is the tenant the bundle is imported into, defaults to the
+	// tenant of the user, or the tenant of the bundle.
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,1,opt,name=tenantID"`
+	// ClusterMapping renames the clusters of the bundle, each item is in
+	// the source=destination format.
+	// +optional
+	ClusterMapping []string `json:"clusterMapping,omitempty" protobuf:"bytes,2,rep,name=clusterMapping"`
+	// DryRun only reports the conflicts without creating anything.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,3,opt,name=dryRun"`
+}
+
 // ProjectSpec is a description of a project.
 type ProjectSpec struct {
 	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
@@ -72,6 +114,85 @@ type ProjectSpec struct {
 	// Clusters represents clusters that can be used and the resource limits of each cluster.
 	// +optional
 	Clusters ClusterHard `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters,casttype=ClusterHard"`
+	// NamespaceTemplate is the name of the namespace template rendered into
+	// every business namespace of the project.
+	// +optional
+	NamespaceTemplate string `json:"namespaceTemplate,omitempty" protobuf:"bytes,7,opt,name=namespaceTemplate"`
+	// QuotaApproval defines who approves the quota requests of the children
+	// of the project, and of the project itself if it has no parent.
+	// +optional
+	QuotaApproval *ProjectQuotaApproval `json:"quotaApproval,omitempty" protobuf:"bytes,8,opt,name=quotaApproval"`
+	// Borrowing opts the project in to borrow the unused capacity of its
+	// parent project and sibling projects when its namespaces run out of
+	// quota.
+	// +optional
+	Borrowing *ProjectBorrowing `json:"borrowing,omitempty" protobuf:"bytes,9,opt,name=borrowing"`
+	// Lifecycle describes who owns the project, who pays for it and when it
+	// expires.
+	// +optional
+	Lifecycle *ProjectLifecycle `json:"lifecycle,omitempty" protobuf:"bytes,10,opt,name=lifecycle"`
+}
+
+// ProjectLifecycle describes the lifecycle metadata of a project.
+type ProjectLifecycle struct {
+	// Owner is the name of the user responsible for the project, who is
+	// warned before the project expires.
+	Owner string `json:"owner" protobuf:"bytes,1,opt,name=owner"`
+	// CostCenter is the cost center the usage of the project is charged to.
+	// +optional
+	CostCenter string `json:"costCenter,omitempty" protobuf:"bytes,2,opt,name=costCenter"`
+	// Tier is the environment tier of the project.
+	// +optional
+	Tier ProjectTier `json:"tier,omitempty" protobuf:"bytes,3,opt,name=tier,casttype=ProjectTier"`
+	// ExpiryTime is the time the project expires at. The project is locked
+	// when it expires, it never expires if not set.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty" protobuf:"bytes,4,opt,name=expiryTime"`
+}
+
+// ProjectTier is the environment tier of a project.
+type ProjectTier string
+
+// These are valid environment tiers of projects.
+const (
+	// ProjectTierDevelopment is the tier of development projects.
+	ProjectTierDevelopment ProjectTier = "Development"
+	// ProjectTierTesting is the tier of testing projects.
+	ProjectTierTesting ProjectTier = "Testing"
+	// ProjectTierStaging is the tier of staging projects.
+	ProjectTierStaging ProjectTier = "Staging"
+	// ProjectTierProduction is the tier of production projects.
+	ProjectTierProduction ProjectTier = "Production"
+)
+
+// ProjectBorrowing defines how much capacity a project can borrow.
+type ProjectBorrowing struct {
+	// Ceiling is the max quantities the project can borrow in each cluster in
+	// addition to its own hard limits.
+	Ceiling ClusterHard `json:"ceiling" protobuf:"bytes,1,rep,name=ceiling,casttype=ClusterHard"`
+}
+
+// ProjectQuotaApproval defines the approvers of quota requests and how they
+// are notified.
+type ProjectQuotaApproval struct {
+	// Approvers are the names of the users allowed to approve or reject the
+	// quota requests.
+	Approvers []string `json:"approvers" protobuf:"bytes,1,rep,name=approvers"`
+	// Notification is the message request created for every approval and
+	// rejection, nothing is sent if it is not set.
+	// +optional
+	Notification *QuotaRequestNotification `json:"notification,omitempty" protobuf:"bytes,2,opt,name=notification"`
+}
+
+// QuotaRequestNotification refers to the notify channel, template and
+// receivers used to notify the decisions on quota requests.
+type QuotaRequestNotification struct {
+	ChannelName  string `json:"channelName" protobuf:"bytes,1,opt,name=channelName"`
+	TemplateName string `json:"templateName" protobuf:"bytes,2,opt,name=templateName"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,4,rep,name=receiverGroups"`
 }
 
 // ProjectStatus represents information about the status of a project.
@@ -100,6 +221,43 @@ type ProjectStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,10,opt,name=message"`
+	// Loans are the capacities the namespaces of the project borrowed from
+	// other projects.
+	// +optional
+	Loans []ProjectLoan `json:"loans,omitempty" protobuf:"bytes,11,rep,name=loans"`
+	// Lifecycle represents how far the project went in its lifecycle.
+	// +optional
+	Lifecycle *ProjectLifecycleStatus `json:"lifecycle,omitempty" protobuf:"bytes,12,opt,name=lifecycle"`
+}
+
+// ProjectLifecycleStatus represents the progress of a project towards its
+// expiry.
+type ProjectLifecycleStatus struct {
+	// ExpiryTime is the expiry time of the project the other times refer to,
+	// the lifecycle restarts when the expiry time of the project changes.
+	// +optional
+	ExpiryTime *metav1.Time `json:"expiryTime,omitempty" protobuf:"bytes,1,opt,name=expiryTime"`
+	// WarningTime is the time the owner was warned that the project expires.
+	// +optional
+	WarningTime *metav1.Time `json:"warningTime,omitempty" protobuf:"bytes,2,opt,name=warningTime"`
+	// ExpiredTime is the time the project was locked because it expired.
+	// +optional
+	ExpiredTime *metav1.Time `json:"expiredTime,omitempty" protobuf:"bytes,3,opt,name=expiredTime"`
+}
+
+// ProjectLoan is the capacity a namespace of a project borrowed from another
+// project in a cluster. It is reclaimed when the lender needs it.
+type ProjectLoan struct {
+	// Lender is the name of the project the capacity is borrowed from.
+	Lender      string `json:"lender" protobuf:"bytes,1,opt,name=lender"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// Namespace is the name of the namespace of the project using the capacity.
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Resources are the borrowed quantities.
+	Resources ResourceList `json:"resources" protobuf:"bytes,4,rep,name=resources,casttype=ResourceList"`
+	// The last time the borrowed quantities changed.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,5,opt,name=lastTransitionTime"`
 }
 
 // ProjectPhase defines the phase of project constructor.
@@ -224,6 +382,21 @@ type NamespaceStatus struct {
 	CachedSpecHard ResourceList `json:"cachedSpecHard,omitempty" protobuf:"bytes,7,rep,name=cachedSpecHard,casttype=ResourceList"`
 	// +optional
 	Certificate *NamespaceCert `json:"certificate,omitempty" protobuf:"bytes,8,name=certificate,casttype=NamespaceCert"`
+	// TemplateName is the namespace template last rendered onto the cluster.
+	// +optional
+	TemplateName string `json:"templateName,omitempty" protobuf:"bytes,9,opt,name=templateName"`
+	// TemplateGeneration is the generation of the namespace template last
+	// rendered onto the cluster.
+	// +optional
+	TemplateGeneration int64 `json:"templateGeneration,omitempty" protobuf:"varint,10,opt,name=templateGeneration"`
+	// TemplateObjects is the sync state of every object rendered from the
+	// namespace template.
+	// +optional
+	TemplateObjects []NamespaceTemplateObjectStatus `json:"templateObjects,omitempty" protobuf:"bytes,11,rep,name=templateObjects"`
+	// Borrowed represents the quantities the namespace borrowed from other
+	// projects in addition to its hard limits.
+	// +optional
+	Borrowed ResourceList `json:"borrowed,omitempty" protobuf:"bytes,12,rep,name=borrowed,casttype=ResourceList"`
 }
 
 // NamespaceCert represents a x509 certificate of a namespace in project.
@@ -238,6 +411,39 @@ type NamespaceCert struct {
 	APIServer string `json:"apiServer,omitempty" protobuf:"bytes,4,rep,name=apiServer"`
 }
 
+// NamespaceTemplateObjectStatus represents the sync state of an object
+// rendered from the namespace template.
+type NamespaceTemplateObjectStatus struct {
+	// Kind of the rendered object, such as LimitRange or NetworkPolicy.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name of the rendered object.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// State is the sync state of the object.
+	State NamespaceTemplateObjectState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=NamespaceTemplateObjectState"`
+	// The last time the object was rendered to match the template.
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty" protobuf:"bytes,4,opt,name=lastSyncTime"`
+	// The last time the object was found drifted from the template and
+	// corrected.
+	// +optional
+	LastDriftTime metav1.Time `json:"lastDriftTime,omitempty" protobuf:"bytes,5,opt,name=lastDriftTime"`
+	// A human readable message indicating why the object failed to sync.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// NamespaceTemplateObjectState indicates the sync state of a rendered object.
+type NamespaceTemplateObjectState string
+
+// These are valid sync states of rendered objects.
+const (
+	// NamespaceTemplateObjectSynced indicates the object matches the template.
+	NamespaceTemplateObjectSynced NamespaceTemplateObjectState = "Synced"
+	// NamespaceTemplateObjectFailed indicates the object could not be
+	// rendered onto the cluster.
+	NamespaceTemplateObjectFailed NamespaceTemplateObjectState = "Failed"
+)
+
 // NamespacePhase indicates the status of namespace in project.
 type NamespacePhase string
 
@@ -262,6 +468,87 @@ const (
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// NamespaceTemplate describes the default objects rendered into every business
+// namespace of the projects referencing it.
+type NamespaceTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the objects rendered into the namespaces.
+	// +optional
+	Spec NamespaceTemplateSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplateList is the whole list of all namespace templates.
+type NamespaceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of namespace templates
+	Items []NamespaceTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NamespaceTemplateSpec is a description of a namespace template.
+type NamespaceTemplateSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// Labels are merged into the labels of the kubernetes namespace.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+	// Annotations are merged into the annotations of the kubernetes namespace.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations"`
+	// PodSecurity sets the pod security admission labels of the kubernetes
+	// namespace.
+	// +optional
+	PodSecurity *NamespaceTemplatePodSecurity `json:"podSecurity,omitempty" protobuf:"bytes,5,opt,name=podSecurity"`
+	// LimitRange is the default limit range of the kubernetes namespace.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty" protobuf:"bytes,6,opt,name=limitRange"`
+	// NetworkPolicies are created in the kubernetes namespace.
+	// +optional
+	NetworkPolicies []NamespaceTemplateNetworkPolicy `json:"networkPolicies,omitempty" protobuf:"bytes,7,rep,name=networkPolicies"`
+	// RoleBindings are created in the kubernetes namespace.
+	// +optional
+	RoleBindings []NamespaceTemplateRoleBinding `json:"roleBindings,omitempty" protobuf:"bytes,8,rep,name=roleBindings"`
+}
+
+// NamespaceTemplatePodSecurity is the pod security standard level applied to
+// the namespace for each pod security admission mode.
+type NamespaceTemplatePodSecurity struct {
+	// +optional
+	Enforce string `json:"enforce,omitempty" protobuf:"bytes,1,opt,name=enforce"`
+	// +optional
+	Audit string `json:"audit,omitempty" protobuf:"bytes,2,opt,name=audit"`
+	// +optional
+	Warn string `json:"warn,omitempty" protobuf:"bytes,3,opt,name=warn"`
+}
+
+// NamespaceTemplateNetworkPolicy is a network policy created in the namespace.
+type NamespaceTemplateNetworkPolicy struct {
+	Name string                         `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Spec networkingv1.NetworkPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// NamespaceTemplateRoleBinding is a role binding created in the namespace.
+type NamespaceTemplateRoleBinding struct {
+	Name    string         `json:"name" protobuf:"bytes,1,opt,name=name"`
+	RoleRef rbacv1.RoleRef `json:"roleRef" protobuf:"bytes,2,opt,name=roleRef"`
+	// +optional
+	Subjects []rbacv1.Subject `json:"subjects,omitempty" protobuf:"bytes,3,rep,name=subjects"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
 // Platform is a platform in TKE.
 type Platform struct {
 	metav1.TypeMeta `json:",inline"`
@@ -545,6 +832,20 @@ type NsEmigrationSpec struct {
 	Namespace   string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
 	NsShowName  string `json:"nsShowName" protobuf:"bytes,3,opt,name=nsShowName"`
 	Destination string `json:"destination" protobuf:"bytes,4,opt,name=destination"`
+	// DestinationCluster is the cluster the namespace moves to. When it is set,
+	// the workloads of the namespace are copied from the current cluster to
+	// the destination cluster, instead of only re-parenting the namespace.
+	// +optional
+	DestinationCluster string `json:"destinationCluster,omitempty" protobuf:"bytes,5,opt,name=destinationCluster"`
+	// RegistryMappings maps the image registry prefixes used in the current
+	// cluster to the ones reachable from the destination cluster, such as
+	// "registry-a.example.com/library" to "registry-b.example.com/library".
+	// +optional
+	RegistryMappings map[string]string `json:"registryMappings,omitempty" protobuf:"bytes,6,rep,name=registryMappings"`
+	// StorageClassMappings maps the storage classes of the current cluster to
+	// the ones of the destination cluster.
+	// +optional
+	StorageClassMappings map[string]string `json:"storageClassMappings,omitempty" protobuf:"bytes,7,rep,name=storageClassMappings"`
 }
 
 // NsEmigrationStatus represents information about the status of a namespace emigration.
@@ -560,8 +861,49 @@ type NsEmigrationStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// DestinationNamespace is the name of the namespace created in the
+	// destination project.
+	// +optional
+	DestinationNamespace string `json:"destinationNamespace,omitempty" protobuf:"bytes,5,opt,name=destinationNamespace"`
+	// Resources is the progress of every resource copied to the destination
+	// cluster.
+	// +optional
+	Resources []NsEmigrationResourceStatus `json:"resources,omitempty" protobuf:"bytes,6,rep,name=resources"`
 }
 
+// NsEmigrationResourceStatus represents the progress of a resource copied to
+// the destination cluster.
+type NsEmigrationResourceStatus struct {
+	// Kind of the resource, such as Deployment or ConfigMap.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// Name of the resource.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// +optional
+	Phase NsEmigrationResourcePhase `json:"phase" protobuf:"bytes,3,opt,name=phase,casttype=NsEmigrationResourcePhase"`
+	// A human readable message indicating why the resource failed to copy or
+	// roll back.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// NsEmigrationResourcePhase indicates the phase of a resource copied to the
+// destination cluster.
+type NsEmigrationResourcePhase string
+
+// These are valid phases of resources copied to the destination cluster.
+const (
+	// NsEmigrationResourcePending indicates that the resource is waiting to be copied.
+	NsEmigrationResourcePending NsEmigrationResourcePhase = "Pending"
+	// NsEmigrationResourceCopied indicates that the resource has been copied.
+	NsEmigrationResourceCopied NsEmigrationResourcePhase = "Copied"
+	// NsEmigrationResourceFailed indicates that the resource failed to copy
+	// or roll back.
+	NsEmigrationResourceFailed NsEmigrationResourcePhase = "Failed"
+	// NsEmigrationResourceRolledBack indicates that the copied resource has
+	// been removed from the destination cluster.
+	NsEmigrationResourceRolledBack NsEmigrationResourcePhase = "RolledBack"
+)
+
 // NsEmigrationPhase indicates the phase of namespace emigrations.
 type NsEmigrationPhase string
 
@@ -575,6 +917,15 @@ const (
 	NsEmigrationOldOneDetached NsEmigrationPhase = "OldOneDetached"
 	// NsEmigrationNewOneCreated indicates that new namespace has been created.
 	NsEmigrationNewOneCreated NsEmigrationPhase = "NewOneCreated"
+	// NsEmigrationResourcesCopying indicates that the resources of old namespace
+	// are being copied to the destination cluster.
+	NsEmigrationResourcesCopying NsEmigrationPhase = "ResourcesCopying"
+	// NsEmigrationRollingBack indicates that the copied resources and new
+	// namespace are being removed after a failed copy.
+	NsEmigrationRollingBack NsEmigrationPhase = "RollingBack"
+	// NsEmigrationRolledBack indicates that the emigration has been rolled back
+	// and old namespace is available again.
+	NsEmigrationRolledBack NsEmigrationPhase = "RolledBack"
 	// NsEmigrationOldOneTerminating indicates that old namespace is terminating.
 	NsEmigrationOldOneTerminating NsEmigrationPhase = "OldOneTerminating"
 	// NsEmigrationFinished indicates that the emigration finished.
@@ -582,3 +933,84 @@ const (
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaRequest is a request of a project member to change the resource
+// limits of the project in a cluster, approved or rejected by the approvers
+// of the parent project.
+type QuotaRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the requested resource limits.
+	// +optional
+	Spec QuotaRequestSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status QuotaRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// QuotaRequestList is the whole list of all quota requests of a project.
+type QuotaRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of quota requests
+	Items []QuotaRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// QuotaRequestSpec is the description of a quota request.
+type QuotaRequestSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// ClusterName is the cluster whose resource limits are requested.
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// Hard is the requested resource limits, replacing the limits of the
+	// same resources of the project in the cluster.
+	Hard ResourceList `json:"hard" protobuf:"bytes,3,rep,name=hard,casttype=ResourceList"`
+	// Justification explains why the resources are needed.
+	Justification string `json:"justification" protobuf:"bytes,4,opt,name=justification"`
+	// Requester is the user who filed the request, set by the server.
+	// +optional
+	Requester string `json:"requester,omitempty" protobuf:"bytes,5,opt,name=requester"`
+}
+
+// QuotaRequestStatus represents information about the status of a quota request.
+type QuotaRequestStatus struct {
+	// +optional
+	Phase QuotaRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=QuotaRequestPhase"`
+	// Reviewer is the approver who approved or rejected the request.
+	// +optional
+	Reviewer string `json:"reviewer,omitempty" protobuf:"bytes,2,opt,name=reviewer"`
+	// Comment of the reviewer.
+	// +optional
+	Comment string `json:"comment,omitempty" protobuf:"bytes,3,opt,name=comment"`
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	// Notified is true once the requester and the approvers have been
+	// notified of the decision.
+	// +optional
+	Notified bool `json:"notified,omitempty" protobuf:"varint,5,opt,name=notified"`
+	// A human readable message indicating why the notification failed.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// QuotaRequestPhase indicates the phase of quota requests.
+type QuotaRequestPhase string
+
+// These are valid phases of quota requests.
+const (
+	// QuotaRequestPending indicates that the request is waiting for approval.
+	QuotaRequestPending QuotaRequestPhase = "Pending"
+	// QuotaRequestApproved indicates that the request has been approved and
+	// the resource limits of the project have been changed.
+	QuotaRequestApproved QuotaRequestPhase = "Approved"
+	// QuotaRequestRejected indicates that the request has been rejected.
+	QuotaRequestRejected QuotaRequestPhase = "Rejected"
+)
@@ -183,12 +183,86 @@ var map_NamespaceStatus = map[string]string{
 	"reason":             "The reason for the condition's last transition.",
 	"message":            "A human readable message indicating details about the transition.",
 	"used":               "Used represents the resources of a namespace that are used.",
+	"templateName":       "TemplateName is the namespace template last rendered onto the cluster.",
+	"templateGeneration": "TemplateGeneration is the generation of the namespace template last rendered onto the cluster.",
+	"templateObjects":    "TemplateObjects is the sync state of every object rendered from the namespace template.",
+	"borrowed":           "Borrowed represents the quantities the namespace borrowed from other projects in addition to its hard limits.",
 }
 
 func (NamespaceStatus) SwaggerDoc() map[string]string {
 	return map_NamespaceStatus
 }
 
+var map_NamespaceTemplate = map[string]string{
+	"":     "NamespaceTemplate describes the default objects rendered into every business namespace of the projects referencing it.",
+	"spec": "Spec defines the objects rendered into the namespaces.",
+}
+
+func (NamespaceTemplate) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplate
+}
+
+var map_NamespaceTemplateList = map[string]string{
+	"":      "NamespaceTemplateList is the whole list of all namespace templates.",
+	"items": "List of namespace templates",
+}
+
+func (NamespaceTemplateList) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateList
+}
+
+var map_NamespaceTemplateNetworkPolicy = map[string]string{
+	"": "NamespaceTemplateNetworkPolicy is a network policy created in the namespace.",
+}
+
+func (NamespaceTemplateNetworkPolicy) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateNetworkPolicy
+}
+
+var map_NamespaceTemplateObjectStatus = map[string]string{
+	"":              "NamespaceTemplateObjectStatus represents the sync state of an object rendered from the namespace template.",
+	"kind":          "Kind of the rendered object, such as LimitRange or NetworkPolicy.",
+	"name":          "Name of the rendered object.",
+	"state":         "State is the sync state of the object.",
+	"lastSyncTime":  "The last time the object was rendered to match the template.",
+	"lastDriftTime": "The last time the object was found drifted from the template and corrected.",
+	"message":       "A human readable message indicating why the object failed to sync.",
+}
+
+func (NamespaceTemplateObjectStatus) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateObjectStatus
+}
+
+var map_NamespaceTemplatePodSecurity = map[string]string{
+	"": "NamespaceTemplatePodSecurity is the pod security standard level applied to the namespace for each pod security admission mode.",
+}
+
+func (NamespaceTemplatePodSecurity) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplatePodSecurity
+}
+
+var map_NamespaceTemplateRoleBinding = map[string]string{
+	"": "NamespaceTemplateRoleBinding is a role binding created in the namespace.",
+}
+
+func (NamespaceTemplateRoleBinding) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateRoleBinding
+}
+
+var map_NamespaceTemplateSpec = map[string]string{
+	"":                "NamespaceTemplateSpec is a description of a namespace template.",
+	"labels":          "Labels are merged into the labels of the kubernetes namespace.",
+	"annotations":     "Annotations are merged into the annotations of the kubernetes namespace.",
+	"podSecurity":     "PodSecurity sets the pod security admission labels of the kubernetes namespace.",
+	"limitRange":      "LimitRange is the default limit range of the kubernetes namespace.",
+	"networkPolicies": "NetworkPolicies are created in the kubernetes namespace.",
+	"roleBindings":    "RoleBindings are created in the kubernetes namespace.",
+}
+
+func (NamespaceTemplateSpec) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateSpec
+}
+
 var map_NsEmigration = map[string]string{
 	"":     "NsEmigration is an namespace emigration.",
 	"spec": "Spec defines the desired identities of emigrations in this set.",
@@ -207,8 +281,22 @@ func (NsEmigrationList) SwaggerDoc() map[string]string {
 	return map_NsEmigrationList
 }
 
+var map_NsEmigrationResourceStatus = map[string]string{
+	"":        "NsEmigrationResourceStatus represents the progress of a resource copied to the destination cluster.",
+	"kind":    "Kind of the resource, such as Deployment or ConfigMap.",
+	"name":    "Name of the resource.",
+	"message": "A human readable message indicating why the resource failed to copy or roll back.",
+}
+
+func (NsEmigrationResourceStatus) SwaggerDoc() map[string]string {
+	return map_NsEmigrationResourceStatus
+}
+
 var map_NsEmigrationSpec = map[string]string{
-	"": "NsEmigrationSpec represents a namespace emigration.",
+	"":                     "NsEmigrationSpec represents a namespace emigration.",
+	"destinationCluster":   "DestinationCluster is the cluster the namespace moves to. When it is set, the workloads of the namespace are copied from the current cluster to the destination cluster, instead of only re-parenting the namespace.",
+	"registryMappings":     "RegistryMappings maps the image registry prefixes used in the current cluster to the ones reachable from the destination cluster, such as \"registry-a.example.com/library\" to \"registry-b.example.com/library\".",
+	"storageClassMappings": "StorageClassMappings maps the storage classes of the current cluster to the ones of the destination cluster.",
 }
 
 func (NsEmigrationSpec) SwaggerDoc() map[string]string {
@@ -216,10 +304,12 @@ func (NsEmigrationSpec) SwaggerDoc() map[string]string {
 }
 
 var map_NsEmigrationStatus = map[string]string{
-	"":                   "NsEmigrationStatus represents information about the status of a namespace emigration.",
-	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
-	"reason":             "The reason for the condition's last transition.",
-	"message":            "A human readable message indicating details about the transition.",
+	"":                     "NsEmigrationStatus represents information about the status of a namespace emigration.",
+	"lastTransitionTime":   "The last time the condition transitioned from one status to another.",
+	"reason":               "The reason for the condition's last transition.",
+	"message":              "A human readable message indicating details about the transition.",
+	"destinationNamespace": "DestinationNamespace is the name of the namespace created in the destination project.",
+	"resources":            "Resources is the progress of every resource copied to the destination cluster.",
 }
 
 func (NsEmigrationStatus) SwaggerDoc() map[string]string {
@@ -282,6 +372,60 @@ func (Project) SwaggerDoc() map[string]string {
 	return map_Project
 }
 
+var map_ProjectBillingOptions = map[string]string{
+	"":       "ProjectBillingOptions is the query options of getting the billing report of a project.",
+	"start":  "Start is the first day of the report in RFC3339 or 2006-01-02 format.",
+	"end":    "End is the day after the last day of the report in RFC3339 or 2006-01-02 format, defaults to now.",
+	"format": "Format of the report, json or csv, defaults to json.",
+}
+
+func (ProjectBillingOptions) SwaggerDoc() map[string]string {
+	return map_ProjectBillingOptions
+}
+
+var map_ProjectBorrowing = map[string]string{
+	"":        "ProjectBorrowing defines how much capacity a project can borrow.",
+	"ceiling": "Ceiling is the max quantities the project can borrow in each cluster in addition to its own hard limits.",
+}
+
+func (ProjectBorrowing) SwaggerDoc() map[string]string {
+	return map_ProjectBorrowing
+}
+
+var map_ProjectImportOptions = map[string]string{
+	"":               "ProjectImportOptions is the query options of importing a project bundle.",
+	"tenantID":       "TenantID is the tenant the bundle is imported into, defaults to the tenant of the user, or the tenant of the bundle.",
+	"clusterMapping": "ClusterMapping renames the clusters of the bundle, each item is in the source=destination format.",
+	"dryRun":         "DryRun only reports the conflicts without creating anything.",
+}
+
+func (ProjectImportOptions) SwaggerDoc() map[string]string {
+	return map_ProjectImportOptions
+}
+
+var map_ProjectLifecycle = map[string]string{
+	"":           "ProjectLifecycle describes the lifecycle metadata of a project.",
+	"owner":      "Owner is the name of the user responsible for the project, who is warned before the project expires.",
+	"costCenter": "CostCenter is the cost center the usage of the project is charged to.",
+	"tier":       "Tier is the environment tier of the project.",
+	"expiryTime": "ExpiryTime is the time the project expires at. The project is locked when it expires, it never expires if not set.",
+}
+
+func (ProjectLifecycle) SwaggerDoc() map[string]string {
+	return map_ProjectLifecycle
+}
+
+var map_ProjectLifecycleStatus = map[string]string{
+	"":            "ProjectLifecycleStatus represents the progress of a project towards its expiry.",
+	"expiryTime":  "ExpiryTime is the expiry time of the project the other times refer to, the lifecycle restarts when the expiry time of the project changes.",
+	"warningTime": "WarningTime is the time the owner was warned that the project expires.",
+	"expiredTime": "ExpiredTime is the time the project was locked because it expired.",
+}
+
+func (ProjectLifecycleStatus) SwaggerDoc() map[string]string {
+	return map_ProjectLifecycleStatus
+}
+
 var map_ProjectList = map[string]string{
 	"":      "ProjectList is the whole list of all projects which owned by a tenant.",
 	"items": "List of projects",
@@ -291,12 +435,38 @@ func (ProjectList) SwaggerDoc() map[string]string {
 	return map_ProjectList
 }
 
+var map_ProjectLoan = map[string]string{
+	"":                   "ProjectLoan is the capacity a namespace of a project borrowed from another project in a cluster. It is reclaimed when the lender needs it.",
+	"lender":             "Lender is the name of the project the capacity is borrowed from.",
+	"namespace":          "Namespace is the name of the namespace of the project using the capacity.",
+	"resources":          "Resources are the borrowed quantities.",
+	"lastTransitionTime": "The last time the borrowed quantities changed.",
+}
+
+func (ProjectLoan) SwaggerDoc() map[string]string {
+	return map_ProjectLoan
+}
+
+var map_ProjectQuotaApproval = map[string]string{
+	"":             "ProjectQuotaApproval defines the approvers of quota requests and how they are notified.",
+	"approvers":    "Approvers are the names of the users allowed to approve or reject the quota requests.",
+	"notification": "Notification is the message request created for every approval and rejection, nothing is sent if it is not set.",
+}
+
+func (ProjectQuotaApproval) SwaggerDoc() map[string]string {
+	return map_ProjectQuotaApproval
+}
+
 var map_ProjectSpec = map[string]string{
 	"":                  "ProjectSpec is a description of a project.",
 	"finalizers":        "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
 	"members":           "Users represents the user list of project.",
 	"parentProjectName": "ParentProjectName indicates the superior project name of this service.",
 	"clusters":          "Clusters represents clusters that can be used and the resource limits of each cluster.",
+	"namespaceTemplate": "NamespaceTemplate is the name of the namespace template rendered into every business namespace of the project.",
+	"quotaApproval":     "QuotaApproval defines who approves the quota requests of the children of the project, and of the project itself if it has no parent.",
+	"borrowing":         "Borrowing opts the project in to borrow the unused capacity of its parent project and sibling projects when its namespaces run out of quota.",
+	"lifecycle":         "Lifecycle describes who owns the project, who pays for it and when it expires.",
 }
 
 func (ProjectSpec) SwaggerDoc() map[string]string {
@@ -309,12 +479,65 @@ var map_ProjectStatus = map[string]string{
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
 	"reason":             "The reason for the condition's last transition.",
 	"message":            "A human readable message indicating details about the transition.",
+	"loans":              "Loans are the capacities the namespaces of the project borrowed from other projects.",
+	"lifecycle":          "Lifecycle represents how far the project went in its lifecycle.",
 }
 
 func (ProjectStatus) SwaggerDoc() map[string]string {
 	return map_ProjectStatus
 }
 
+var map_QuotaRequest = map[string]string{
+	"":     "QuotaRequest is a request of a project member to change the resource limits of the project in a cluster, approved or rejected by the approvers of the parent project.",
+	"spec": "Spec defines the requested resource limits.",
+}
+
+func (QuotaRequest) SwaggerDoc() map[string]string {
+	return map_QuotaRequest
+}
+
+var map_QuotaRequestList = map[string]string{
+	"":      "QuotaRequestList is the whole list of all quota requests of a project.",
+	"items": "List of quota requests",
+}
+
+func (QuotaRequestList) SwaggerDoc() map[string]string {
+	return map_QuotaRequestList
+}
+
+var map_QuotaRequestNotification = map[string]string{
+	"": "QuotaRequestNotification refers to the notify channel, template and receivers used to notify the decisions on quota requests.",
+}
+
+func (QuotaRequestNotification) SwaggerDoc() map[string]string {
+	return map_QuotaRequestNotification
+}
+
+var map_QuotaRequestSpec = map[string]string{
+	"":              "QuotaRequestSpec is the description of a quota request.",
+	"clusterName":   "ClusterName is the cluster whose resource limits are requested.",
+	"hard":          "Hard is the requested resource limits, replacing the limits of the same resources of the project in the cluster.",
+	"justification": "Justification explains why the resources are needed.",
+	"requester":     "Requester is the user who filed the request, set by the server.",
+}
+
+func (QuotaRequestSpec) SwaggerDoc() map[string]string {
+	return map_QuotaRequestSpec
+}
+
+var map_QuotaRequestStatus = map[string]string{
+	"":                   "QuotaRequestStatus represents information about the status of a quota request.",
+	"reviewer":           "Reviewer is the approver who approved or rejected the request.",
+	"comment":            "Comment of the reviewer.",
+	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
+	"notified":           "Notified is true once the requester and the approvers have been notified of the decision.",
+	"message":            "A human readable message indicating why the notification failed.",
+}
+
+func (QuotaRequestStatus) SwaggerDoc() map[string]string {
+	return map_QuotaRequestStatus
+}
+
 var map_UsedQuantity = map[string]string{
 	"": "UsedQuantity is a straightforward wrapper of ResourceList.",
 }
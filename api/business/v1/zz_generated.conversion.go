@@ -23,8 +23,12 @@
 package v1
 
 import (
+	url "net/url"
 	unsafe "unsafe"
 
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	business "tkestack.io/tke/api/business"
@@ -207,6 +211,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplate)(nil), (*business.NamespaceTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate(a.(*NamespaceTemplate), b.(*business.NamespaceTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplate)(nil), (*NamespaceTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate(a.(*business.NamespaceTemplate), b.(*NamespaceTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateList)(nil), (*business.NamespaceTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(a.(*NamespaceTemplateList), b.(*business.NamespaceTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateList)(nil), (*NamespaceTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(a.(*business.NamespaceTemplateList), b.(*NamespaceTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateNetworkPolicy)(nil), (*business.NamespaceTemplateNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(a.(*NamespaceTemplateNetworkPolicy), b.(*business.NamespaceTemplateNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateNetworkPolicy)(nil), (*NamespaceTemplateNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(a.(*business.NamespaceTemplateNetworkPolicy), b.(*NamespaceTemplateNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateObjectStatus)(nil), (*business.NamespaceTemplateObjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateObjectStatus_To_business_NamespaceTemplateObjectStatus(a.(*NamespaceTemplateObjectStatus), b.(*business.NamespaceTemplateObjectStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateObjectStatus)(nil), (*NamespaceTemplateObjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateObjectStatus_To_v1_NamespaceTemplateObjectStatus(a.(*business.NamespaceTemplateObjectStatus), b.(*NamespaceTemplateObjectStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplatePodSecurity)(nil), (*business.NamespaceTemplatePodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(a.(*NamespaceTemplatePodSecurity), b.(*business.NamespaceTemplatePodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplatePodSecurity)(nil), (*NamespaceTemplatePodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(a.(*business.NamespaceTemplatePodSecurity), b.(*NamespaceTemplatePodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateRoleBinding)(nil), (*business.NamespaceTemplateRoleBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(a.(*NamespaceTemplateRoleBinding), b.(*business.NamespaceTemplateRoleBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateRoleBinding)(nil), (*NamespaceTemplateRoleBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(a.(*business.NamespaceTemplateRoleBinding), b.(*NamespaceTemplateRoleBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateSpec)(nil), (*business.NamespaceTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(a.(*NamespaceTemplateSpec), b.(*business.NamespaceTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateSpec)(nil), (*NamespaceTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(a.(*business.NamespaceTemplateSpec), b.(*NamespaceTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NsEmigration)(nil), (*business.NsEmigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NsEmigration_To_business_NsEmigration(a.(*NsEmigration), b.(*business.NsEmigration), scope)
 	}); err != nil {
@@ -227,6 +301,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NsEmigrationResourceStatus)(nil), (*business.NsEmigrationResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NsEmigrationResourceStatus_To_business_NsEmigrationResourceStatus(a.(*NsEmigrationResourceStatus), b.(*business.NsEmigrationResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NsEmigrationResourceStatus)(nil), (*NsEmigrationResourceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NsEmigrationResourceStatus_To_v1_NsEmigrationResourceStatus(a.(*business.NsEmigrationResourceStatus), b.(*NsEmigrationResourceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NsEmigrationSpec)(nil), (*business.NsEmigrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NsEmigrationSpec_To_business_NsEmigrationSpec(a.(*NsEmigrationSpec), b.(*business.NsEmigrationSpec), scope)
 	}); err != nil {
@@ -307,6 +391,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectBillingOptions)(nil), (*business.ProjectBillingOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectBillingOptions_To_business_ProjectBillingOptions(a.(*ProjectBillingOptions), b.(*business.ProjectBillingOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectBillingOptions)(nil), (*ProjectBillingOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectBillingOptions_To_v1_ProjectBillingOptions(a.(*business.ProjectBillingOptions), b.(*ProjectBillingOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectBorrowing)(nil), (*business.ProjectBorrowing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectBorrowing_To_business_ProjectBorrowing(a.(*ProjectBorrowing), b.(*business.ProjectBorrowing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectBorrowing)(nil), (*ProjectBorrowing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectBorrowing_To_v1_ProjectBorrowing(a.(*business.ProjectBorrowing), b.(*ProjectBorrowing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectImportOptions)(nil), (*business.ProjectImportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectImportOptions_To_business_ProjectImportOptions(a.(*ProjectImportOptions), b.(*business.ProjectImportOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectImportOptions)(nil), (*ProjectImportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectImportOptions_To_v1_ProjectImportOptions(a.(*business.ProjectImportOptions), b.(*ProjectImportOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectLifecycle)(nil), (*business.ProjectLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectLifecycle_To_business_ProjectLifecycle(a.(*ProjectLifecycle), b.(*business.ProjectLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectLifecycle)(nil), (*ProjectLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectLifecycle_To_v1_ProjectLifecycle(a.(*business.ProjectLifecycle), b.(*ProjectLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectLifecycleStatus)(nil), (*business.ProjectLifecycleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectLifecycleStatus_To_business_ProjectLifecycleStatus(a.(*ProjectLifecycleStatus), b.(*business.ProjectLifecycleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectLifecycleStatus)(nil), (*ProjectLifecycleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectLifecycleStatus_To_v1_ProjectLifecycleStatus(a.(*business.ProjectLifecycleStatus), b.(*ProjectLifecycleStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*business.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ProjectList_To_business_ProjectList(a.(*ProjectList), b.(*business.ProjectList), scope)
 	}); err != nil {
@@ -317,6 +451,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectLoan)(nil), (*business.ProjectLoan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectLoan_To_business_ProjectLoan(a.(*ProjectLoan), b.(*business.ProjectLoan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectLoan)(nil), (*ProjectLoan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectLoan_To_v1_ProjectLoan(a.(*business.ProjectLoan), b.(*ProjectLoan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuotaApproval)(nil), (*business.ProjectQuotaApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectQuotaApproval_To_business_ProjectQuotaApproval(a.(*ProjectQuotaApproval), b.(*business.ProjectQuotaApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectQuotaApproval)(nil), (*ProjectQuotaApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectQuotaApproval_To_v1_ProjectQuotaApproval(a.(*business.ProjectQuotaApproval), b.(*ProjectQuotaApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*business.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ProjectSpec_To_business_ProjectSpec(a.(*ProjectSpec), b.(*business.ProjectSpec), scope)
 	}); err != nil {
@@ -337,6 +491,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaRequest)(nil), (*business.QuotaRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaRequest_To_business_QuotaRequest(a.(*QuotaRequest), b.(*business.QuotaRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaRequest)(nil), (*QuotaRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaRequest_To_v1_QuotaRequest(a.(*business.QuotaRequest), b.(*QuotaRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaRequestList)(nil), (*business.QuotaRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaRequestList_To_business_QuotaRequestList(a.(*QuotaRequestList), b.(*business.QuotaRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaRequestList)(nil), (*QuotaRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaRequestList_To_v1_QuotaRequestList(a.(*business.QuotaRequestList), b.(*QuotaRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaRequestNotification)(nil), (*business.QuotaRequestNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaRequestNotification_To_business_QuotaRequestNotification(a.(*QuotaRequestNotification), b.(*business.QuotaRequestNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaRequestNotification)(nil), (*QuotaRequestNotification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaRequestNotification_To_v1_QuotaRequestNotification(a.(*business.QuotaRequestNotification), b.(*QuotaRequestNotification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaRequestSpec)(nil), (*business.QuotaRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec(a.(*QuotaRequestSpec), b.(*business.QuotaRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaRequestSpec)(nil), (*QuotaRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec(a.(*business.QuotaRequestSpec), b.(*QuotaRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaRequestStatus)(nil), (*business.QuotaRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus(a.(*QuotaRequestStatus), b.(*business.QuotaRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaRequestStatus)(nil), (*QuotaRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus(a.(*business.QuotaRequestStatus), b.(*QuotaRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*UsedQuantity)(nil), (*business.UsedQuantity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_UsedQuantity_To_business_UsedQuantity(a.(*UsedQuantity), b.(*business.UsedQuantity), scope)
 	}); err != nil {
@@ -347,6 +551,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ProjectBillingOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ProjectBillingOptions(a.(*url.Values), b.(*ProjectBillingOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ProjectImportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ProjectImportOptions(a.(*url.Values), b.(*ProjectImportOptions), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -771,6 +985,10 @@ func autoConvert_v1_NamespaceStatus_To_business_NamespaceStatus(in *NamespaceSta
 	out.Used = *(*business.ResourceList)(unsafe.Pointer(&in.Used))
 	out.CachedSpecHard = *(*business.ResourceList)(unsafe.Pointer(&in.CachedSpecHard))
 	out.Certificate = (*business.NamespaceCert)(unsafe.Pointer(in.Certificate))
+	out.TemplateName = in.TemplateName
+	out.TemplateGeneration = in.TemplateGeneration
+	out.TemplateObjects = *(*[]business.NamespaceTemplateObjectStatus)(unsafe.Pointer(&in.TemplateObjects))
+	out.Borrowed = *(*business.ResourceList)(unsafe.Pointer(&in.Borrowed))
 	return nil
 }
 
@@ -788,6 +1006,10 @@ func autoConvert_business_NamespaceStatus_To_v1_NamespaceStatus(in *business.Nam
 	out.Used = *(*ResourceList)(unsafe.Pointer(&in.Used))
 	out.CachedSpecHard = *(*ResourceList)(unsafe.Pointer(&in.CachedSpecHard))
 	out.Certificate = (*NamespaceCert)(unsafe.Pointer(in.Certificate))
+	out.TemplateName = in.TemplateName
+	out.TemplateGeneration = in.TemplateGeneration
+	out.TemplateObjects = *(*[]NamespaceTemplateObjectStatus)(unsafe.Pointer(&in.TemplateObjects))
+	out.Borrowed = *(*ResourceList)(unsafe.Pointer(&in.Borrowed))
 	return nil
 }
 
@@ -796,6 +1018,188 @@ func Convert_business_NamespaceStatus_To_v1_NamespaceStatus(in *business.Namespa
 	return autoConvert_business_NamespaceStatus_To_v1_NamespaceStatus(in, out, s)
 }
 
+func autoConvert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in *NamespaceTemplate, out *business.NamespaceTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in *NamespaceTemplate, out *business.NamespaceTemplate, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in *business.NamespaceTemplate, out *NamespaceTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate is an autogenerated conversion function.
+func Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in *business.NamespaceTemplate, out *NamespaceTemplate, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in *NamespaceTemplateList, out *business.NamespaceTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]business.NamespaceTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in *NamespaceTemplateList, out *business.NamespaceTemplateList, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in *business.NamespaceTemplateList, out *NamespaceTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespaceTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in *business.NamespaceTemplateList, out *NamespaceTemplateList, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in *NamespaceTemplateNetworkPolicy, out *business.NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in *NamespaceTemplateNetworkPolicy, out *business.NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in *business.NamespaceTemplateNetworkPolicy, out *NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in *business.NamespaceTemplateNetworkPolicy, out *NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateObjectStatus_To_business_NamespaceTemplateObjectStatus(in *NamespaceTemplateObjectStatus, out *business.NamespaceTemplateObjectStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.State = business.NamespaceTemplateObjectState(in.State)
+	out.LastSyncTime = in.LastSyncTime
+	out.LastDriftTime = in.LastDriftTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateObjectStatus_To_business_NamespaceTemplateObjectStatus is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateObjectStatus_To_business_NamespaceTemplateObjectStatus(in *NamespaceTemplateObjectStatus, out *business.NamespaceTemplateObjectStatus, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateObjectStatus_To_business_NamespaceTemplateObjectStatus(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateObjectStatus_To_v1_NamespaceTemplateObjectStatus(in *business.NamespaceTemplateObjectStatus, out *NamespaceTemplateObjectStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.State = NamespaceTemplateObjectState(in.State)
+	out.LastSyncTime = in.LastSyncTime
+	out.LastDriftTime = in.LastDriftTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_NamespaceTemplateObjectStatus_To_v1_NamespaceTemplateObjectStatus is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateObjectStatus_To_v1_NamespaceTemplateObjectStatus(in *business.NamespaceTemplateObjectStatus, out *NamespaceTemplateObjectStatus, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateObjectStatus_To_v1_NamespaceTemplateObjectStatus(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in *NamespaceTemplatePodSecurity, out *business.NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.Audit = in.Audit
+	out.Warn = in.Warn
+	return nil
+}
+
+// Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in *NamespaceTemplatePodSecurity, out *business.NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in *business.NamespaceTemplatePodSecurity, out *NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.Audit = in.Audit
+	out.Warn = in.Warn
+	return nil
+}
+
+// Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity is an autogenerated conversion function.
+func Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in *business.NamespaceTemplatePodSecurity, out *NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in *NamespaceTemplateRoleBinding, out *business.NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RoleRef = in.RoleRef
+	out.Subjects = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in *NamespaceTemplateRoleBinding, out *business.NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in *business.NamespaceTemplateRoleBinding, out *NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.RoleRef = in.RoleRef
+	out.Subjects = *(*[]rbacv1.Subject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in *business.NamespaceTemplateRoleBinding, out *NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in *NamespaceTemplateSpec, out *business.NamespaceTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.PodSecurity = (*business.NamespaceTemplatePodSecurity)(unsafe.Pointer(in.PodSecurity))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	out.NetworkPolicies = *(*[]business.NamespaceTemplateNetworkPolicy)(unsafe.Pointer(&in.NetworkPolicies))
+	out.RoleBindings = *(*[]business.NamespaceTemplateRoleBinding)(unsafe.Pointer(&in.RoleBindings))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in *NamespaceTemplateSpec, out *business.NamespaceTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in *business.NamespaceTemplateSpec, out *NamespaceTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.PodSecurity = (*NamespaceTemplatePodSecurity)(unsafe.Pointer(in.PodSecurity))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	out.NetworkPolicies = *(*[]NamespaceTemplateNetworkPolicy)(unsafe.Pointer(&in.NetworkPolicies))
+	out.RoleBindings = *(*[]NamespaceTemplateRoleBinding)(unsafe.Pointer(&in.RoleBindings))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in *business.NamespaceTemplateSpec, out *NamespaceTemplateSpec, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in, out, s)
+}
+
 func autoConvert_v1_NsEmigration_To_business_NsEmigration(in *NsEmigration, out *business.NsEmigration, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_NsEmigrationSpec_To_business_NsEmigrationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -850,11 +1254,40 @@ func Convert_business_NsEmigrationList_To_v1_NsEmigrationList(in *business.NsEmi
 	return autoConvert_business_NsEmigrationList_To_v1_NsEmigrationList(in, out, s)
 }
 
+func autoConvert_v1_NsEmigrationResourceStatus_To_business_NsEmigrationResourceStatus(in *NsEmigrationResourceStatus, out *business.NsEmigrationResourceStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = business.NsEmigrationResourcePhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_NsEmigrationResourceStatus_To_business_NsEmigrationResourceStatus is an autogenerated conversion function.
+func Convert_v1_NsEmigrationResourceStatus_To_business_NsEmigrationResourceStatus(in *NsEmigrationResourceStatus, out *business.NsEmigrationResourceStatus, s conversion.Scope) error {
+	return autoConvert_v1_NsEmigrationResourceStatus_To_business_NsEmigrationResourceStatus(in, out, s)
+}
+
+func autoConvert_business_NsEmigrationResourceStatus_To_v1_NsEmigrationResourceStatus(in *business.NsEmigrationResourceStatus, out *NsEmigrationResourceStatus, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Phase = NsEmigrationResourcePhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_NsEmigrationResourceStatus_To_v1_NsEmigrationResourceStatus is an autogenerated conversion function.
+func Convert_business_NsEmigrationResourceStatus_To_v1_NsEmigrationResourceStatus(in *business.NsEmigrationResourceStatus, out *NsEmigrationResourceStatus, s conversion.Scope) error {
+	return autoConvert_business_NsEmigrationResourceStatus_To_v1_NsEmigrationResourceStatus(in, out, s)
+}
+
 func autoConvert_v1_NsEmigrationSpec_To_business_NsEmigrationSpec(in *NsEmigrationSpec, out *business.NsEmigrationSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.Namespace = in.Namespace
 	out.NsShowName = in.NsShowName
 	out.Destination = in.Destination
+	out.DestinationCluster = in.DestinationCluster
+	out.RegistryMappings = *(*map[string]string)(unsafe.Pointer(&in.RegistryMappings))
+	out.StorageClassMappings = *(*map[string]string)(unsafe.Pointer(&in.StorageClassMappings))
 	return nil
 }
 
@@ -868,6 +1301,9 @@ func autoConvert_business_NsEmigrationSpec_To_v1_NsEmigrationSpec(in *business.N
 	out.Namespace = in.Namespace
 	out.NsShowName = in.NsShowName
 	out.Destination = in.Destination
+	out.DestinationCluster = in.DestinationCluster
+	out.RegistryMappings = *(*map[string]string)(unsafe.Pointer(&in.RegistryMappings))
+	out.StorageClassMappings = *(*map[string]string)(unsafe.Pointer(&in.StorageClassMappings))
 	return nil
 }
 
@@ -881,6 +1317,8 @@ func autoConvert_v1_NsEmigrationStatus_To_business_NsEmigrationStatus(in *NsEmig
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.DestinationNamespace = in.DestinationNamespace
+	out.Resources = *(*[]business.NsEmigrationResourceStatus)(unsafe.Pointer(&in.Resources))
 	return nil
 }
 
@@ -894,6 +1332,8 @@ func autoConvert_business_NsEmigrationStatus_To_v1_NsEmigrationStatus(in *busine
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.DestinationNamespace = in.DestinationNamespace
+	out.Resources = *(*[]NsEmigrationResourceStatus)(unsafe.Pointer(&in.Resources))
 	return nil
 }
 
@@ -1052,6 +1492,186 @@ func Convert_business_Project_To_v1_Project(in *business.Project, out *Project,
 	return autoConvert_business_Project_To_v1_Project(in, out, s)
 }
 
+func autoConvert_v1_ProjectBillingOptions_To_business_ProjectBillingOptions(in *ProjectBillingOptions, out *business.ProjectBillingOptions, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	out.Format = in.Format
+	return nil
+}
+
+// Convert_v1_ProjectBillingOptions_To_business_ProjectBillingOptions is an autogenerated conversion function.
+func Convert_v1_ProjectBillingOptions_To_business_ProjectBillingOptions(in *ProjectBillingOptions, out *business.ProjectBillingOptions, s conversion.Scope) error {
+	return autoConvert_v1_ProjectBillingOptions_To_business_ProjectBillingOptions(in, out, s)
+}
+
+func autoConvert_business_ProjectBillingOptions_To_v1_ProjectBillingOptions(in *business.ProjectBillingOptions, out *ProjectBillingOptions, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	out.Format = in.Format
+	return nil
+}
+
+// Convert_business_ProjectBillingOptions_To_v1_ProjectBillingOptions is an autogenerated conversion function.
+func Convert_business_ProjectBillingOptions_To_v1_ProjectBillingOptions(in *business.ProjectBillingOptions, out *ProjectBillingOptions, s conversion.Scope) error {
+	return autoConvert_business_ProjectBillingOptions_To_v1_ProjectBillingOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_ProjectBillingOptions(in *url.Values, out *ProjectBillingOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["start"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Start, s); err != nil {
+			return err
+		}
+	} else {
+		out.Start = ""
+	}
+	if values, ok := map[string][]string(*in)["end"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.End, s); err != nil {
+			return err
+		}
+	} else {
+		out.End = ""
+	}
+	if values, ok := map[string][]string(*in)["format"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Format, s); err != nil {
+			return err
+		}
+	} else {
+		out.Format = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_ProjectBillingOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_ProjectBillingOptions(in *url.Values, out *ProjectBillingOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_ProjectBillingOptions(in, out, s)
+}
+
+func autoConvert_v1_ProjectBorrowing_To_business_ProjectBorrowing(in *ProjectBorrowing, out *business.ProjectBorrowing, s conversion.Scope) error {
+	out.Ceiling = *(*business.ClusterHard)(unsafe.Pointer(&in.Ceiling))
+	return nil
+}
+
+// Convert_v1_ProjectBorrowing_To_business_ProjectBorrowing is an autogenerated conversion function.
+func Convert_v1_ProjectBorrowing_To_business_ProjectBorrowing(in *ProjectBorrowing, out *business.ProjectBorrowing, s conversion.Scope) error {
+	return autoConvert_v1_ProjectBorrowing_To_business_ProjectBorrowing(in, out, s)
+}
+
+func autoConvert_business_ProjectBorrowing_To_v1_ProjectBorrowing(in *business.ProjectBorrowing, out *ProjectBorrowing, s conversion.Scope) error {
+	out.Ceiling = *(*ClusterHard)(unsafe.Pointer(&in.Ceiling))
+	return nil
+}
+
+// Convert_business_ProjectBorrowing_To_v1_ProjectBorrowing is an autogenerated conversion function.
+func Convert_business_ProjectBorrowing_To_v1_ProjectBorrowing(in *business.ProjectBorrowing, out *ProjectBorrowing, s conversion.Scope) error {
+	return autoConvert_business_ProjectBorrowing_To_v1_ProjectBorrowing(in, out, s)
+}
+
+func autoConvert_v1_ProjectImportOptions_To_business_ProjectImportOptions(in *ProjectImportOptions, out *business.ProjectImportOptions, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterMapping = *(*[]string)(unsafe.Pointer(&in.ClusterMapping))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1_ProjectImportOptions_To_business_ProjectImportOptions is an autogenerated conversion function.
+func Convert_v1_ProjectImportOptions_To_business_ProjectImportOptions(in *ProjectImportOptions, out *business.ProjectImportOptions, s conversion.Scope) error {
+	return autoConvert_v1_ProjectImportOptions_To_business_ProjectImportOptions(in, out, s)
+}
+
+func autoConvert_business_ProjectImportOptions_To_v1_ProjectImportOptions(in *business.ProjectImportOptions, out *ProjectImportOptions, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterMapping = *(*[]string)(unsafe.Pointer(&in.ClusterMapping))
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_business_ProjectImportOptions_To_v1_ProjectImportOptions is an autogenerated conversion function.
+func Convert_business_ProjectImportOptions_To_v1_ProjectImportOptions(in *business.ProjectImportOptions, out *ProjectImportOptions, s conversion.Scope) error {
+	return autoConvert_business_ProjectImportOptions_To_v1_ProjectImportOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_ProjectImportOptions(in *url.Values, out *ProjectImportOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["tenantID"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.TenantID, s); err != nil {
+			return err
+		}
+	} else {
+		out.TenantID = ""
+	}
+	if values, ok := map[string][]string(*in)["clusterMapping"]; ok && len(values) > 0 {
+		out.ClusterMapping = *(*[]string)(unsafe.Pointer(&values))
+	} else {
+		out.ClusterMapping = nil
+	}
+	if values, ok := map[string][]string(*in)["dryRun"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.DryRun, s); err != nil {
+			return err
+		}
+	} else {
+		out.DryRun = false
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_ProjectImportOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_ProjectImportOptions(in *url.Values, out *ProjectImportOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_ProjectImportOptions(in, out, s)
+}
+
+func autoConvert_v1_ProjectLifecycle_To_business_ProjectLifecycle(in *ProjectLifecycle, out *business.ProjectLifecycle, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.CostCenter = in.CostCenter
+	out.Tier = business.ProjectTier(in.Tier)
+	out.ExpiryTime = (*metav1.Time)(unsafe.Pointer(in.ExpiryTime))
+	return nil
+}
+
+// Convert_v1_ProjectLifecycle_To_business_ProjectLifecycle is an autogenerated conversion function.
+func Convert_v1_ProjectLifecycle_To_business_ProjectLifecycle(in *ProjectLifecycle, out *business.ProjectLifecycle, s conversion.Scope) error {
+	return autoConvert_v1_ProjectLifecycle_To_business_ProjectLifecycle(in, out, s)
+}
+
+func autoConvert_business_ProjectLifecycle_To_v1_ProjectLifecycle(in *business.ProjectLifecycle, out *ProjectLifecycle, s conversion.Scope) error {
+	out.Owner = in.Owner
+	out.CostCenter = in.CostCenter
+	out.Tier = ProjectTier(in.Tier)
+	out.ExpiryTime = (*metav1.Time)(unsafe.Pointer(in.ExpiryTime))
+	return nil
+}
+
+// Convert_business_ProjectLifecycle_To_v1_ProjectLifecycle is an autogenerated conversion function.
+func Convert_business_ProjectLifecycle_To_v1_ProjectLifecycle(in *business.ProjectLifecycle, out *ProjectLifecycle, s conversion.Scope) error {
+	return autoConvert_business_ProjectLifecycle_To_v1_ProjectLifecycle(in, out, s)
+}
+
+func autoConvert_v1_ProjectLifecycleStatus_To_business_ProjectLifecycleStatus(in *ProjectLifecycleStatus, out *business.ProjectLifecycleStatus, s conversion.Scope) error {
+	out.ExpiryTime = (*metav1.Time)(unsafe.Pointer(in.ExpiryTime))
+	out.WarningTime = (*metav1.Time)(unsafe.Pointer(in.WarningTime))
+	out.ExpiredTime = (*metav1.Time)(unsafe.Pointer(in.ExpiredTime))
+	return nil
+}
+
+// Convert_v1_ProjectLifecycleStatus_To_business_ProjectLifecycleStatus is an autogenerated conversion function.
+func Convert_v1_ProjectLifecycleStatus_To_business_ProjectLifecycleStatus(in *ProjectLifecycleStatus, out *business.ProjectLifecycleStatus, s conversion.Scope) error {
+	return autoConvert_v1_ProjectLifecycleStatus_To_business_ProjectLifecycleStatus(in, out, s)
+}
+
+func autoConvert_business_ProjectLifecycleStatus_To_v1_ProjectLifecycleStatus(in *business.ProjectLifecycleStatus, out *ProjectLifecycleStatus, s conversion.Scope) error {
+	out.ExpiryTime = (*metav1.Time)(unsafe.Pointer(in.ExpiryTime))
+	out.WarningTime = (*metav1.Time)(unsafe.Pointer(in.WarningTime))
+	out.ExpiredTime = (*metav1.Time)(unsafe.Pointer(in.ExpiredTime))
+	return nil
+}
+
+// Convert_business_ProjectLifecycleStatus_To_v1_ProjectLifecycleStatus is an autogenerated conversion function.
+func Convert_business_ProjectLifecycleStatus_To_v1_ProjectLifecycleStatus(in *business.ProjectLifecycleStatus, out *ProjectLifecycleStatus, s conversion.Scope) error {
+	return autoConvert_business_ProjectLifecycleStatus_To_v1_ProjectLifecycleStatus(in, out, s)
+}
+
 func autoConvert_v1_ProjectList_To_business_ProjectList(in *ProjectList, out *business.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]business.Project)(unsafe.Pointer(&in.Items))
@@ -1074,6 +1694,56 @@ func Convert_business_ProjectList_To_v1_ProjectList(in *business.ProjectList, ou
 	return autoConvert_business_ProjectList_To_v1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1_ProjectLoan_To_business_ProjectLoan(in *ProjectLoan, out *business.ProjectLoan, s conversion.Scope) error {
+	out.Lender = in.Lender
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Resources = *(*business.ResourceList)(unsafe.Pointer(&in.Resources))
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_ProjectLoan_To_business_ProjectLoan is an autogenerated conversion function.
+func Convert_v1_ProjectLoan_To_business_ProjectLoan(in *ProjectLoan, out *business.ProjectLoan, s conversion.Scope) error {
+	return autoConvert_v1_ProjectLoan_To_business_ProjectLoan(in, out, s)
+}
+
+func autoConvert_business_ProjectLoan_To_v1_ProjectLoan(in *business.ProjectLoan, out *ProjectLoan, s conversion.Scope) error {
+	out.Lender = in.Lender
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Resources = *(*ResourceList)(unsafe.Pointer(&in.Resources))
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_business_ProjectLoan_To_v1_ProjectLoan is an autogenerated conversion function.
+func Convert_business_ProjectLoan_To_v1_ProjectLoan(in *business.ProjectLoan, out *ProjectLoan, s conversion.Scope) error {
+	return autoConvert_business_ProjectLoan_To_v1_ProjectLoan(in, out, s)
+}
+
+func autoConvert_v1_ProjectQuotaApproval_To_business_ProjectQuotaApproval(in *ProjectQuotaApproval, out *business.ProjectQuotaApproval, s conversion.Scope) error {
+	out.Approvers = *(*[]string)(unsafe.Pointer(&in.Approvers))
+	out.Notification = (*business.QuotaRequestNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_v1_ProjectQuotaApproval_To_business_ProjectQuotaApproval is an autogenerated conversion function.
+func Convert_v1_ProjectQuotaApproval_To_business_ProjectQuotaApproval(in *ProjectQuotaApproval, out *business.ProjectQuotaApproval, s conversion.Scope) error {
+	return autoConvert_v1_ProjectQuotaApproval_To_business_ProjectQuotaApproval(in, out, s)
+}
+
+func autoConvert_business_ProjectQuotaApproval_To_v1_ProjectQuotaApproval(in *business.ProjectQuotaApproval, out *ProjectQuotaApproval, s conversion.Scope) error {
+	out.Approvers = *(*[]string)(unsafe.Pointer(&in.Approvers))
+	out.Notification = (*QuotaRequestNotification)(unsafe.Pointer(in.Notification))
+	return nil
+}
+
+// Convert_business_ProjectQuotaApproval_To_v1_ProjectQuotaApproval is an autogenerated conversion function.
+func Convert_business_ProjectQuotaApproval_To_v1_ProjectQuotaApproval(in *business.ProjectQuotaApproval, out *ProjectQuotaApproval, s conversion.Scope) error {
+	return autoConvert_business_ProjectQuotaApproval_To_v1_ProjectQuotaApproval(in, out, s)
+}
+
 func autoConvert_v1_ProjectSpec_To_business_ProjectSpec(in *ProjectSpec, out *business.ProjectSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]business.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
@@ -1081,6 +1751,10 @@ func autoConvert_v1_ProjectSpec_To_business_ProjectSpec(in *ProjectSpec, out *bu
 	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
 	out.ParentProjectName = in.ParentProjectName
 	out.Clusters = *(*business.ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.NamespaceTemplate = in.NamespaceTemplate
+	out.QuotaApproval = (*business.ProjectQuotaApproval)(unsafe.Pointer(in.QuotaApproval))
+	out.Borrowing = (*business.ProjectBorrowing)(unsafe.Pointer(in.Borrowing))
+	out.Lifecycle = (*business.ProjectLifecycle)(unsafe.Pointer(in.Lifecycle))
 	return nil
 }
 
@@ -1096,6 +1770,10 @@ func autoConvert_business_ProjectSpec_To_v1_ProjectSpec(in *business.ProjectSpec
 	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
 	out.ParentProjectName = in.ParentProjectName
 	out.Clusters = *(*ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.NamespaceTemplate = in.NamespaceTemplate
+	out.QuotaApproval = (*ProjectQuotaApproval)(unsafe.Pointer(in.QuotaApproval))
+	out.Borrowing = (*ProjectBorrowing)(unsafe.Pointer(in.Borrowing))
+	out.Lifecycle = (*ProjectLifecycle)(unsafe.Pointer(in.Lifecycle))
 	return nil
 }
 
@@ -1115,6 +1793,8 @@ func autoConvert_v1_ProjectStatus_To_business_ProjectStatus(in *ProjectStatus, o
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.Loans = *(*[]business.ProjectLoan)(unsafe.Pointer(&in.Loans))
+	out.Lifecycle = (*business.ProjectLifecycleStatus)(unsafe.Pointer(in.Lifecycle))
 	return nil
 }
 
@@ -1134,6 +1814,8 @@ func autoConvert_business_ProjectStatus_To_v1_ProjectStatus(in *business.Project
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.Loans = *(*[]ProjectLoan)(unsafe.Pointer(&in.Loans))
+	out.Lifecycle = (*ProjectLifecycleStatus)(unsafe.Pointer(in.Lifecycle))
 	return nil
 }
 
@@ -1142,6 +1824,144 @@ func Convert_business_ProjectStatus_To_v1_ProjectStatus(in *business.ProjectStat
 	return autoConvert_business_ProjectStatus_To_v1_ProjectStatus(in, out, s)
 }
 
+func autoConvert_v1_QuotaRequest_To_business_QuotaRequest(in *QuotaRequest, out *business.QuotaRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_QuotaRequest_To_business_QuotaRequest is an autogenerated conversion function.
+func Convert_v1_QuotaRequest_To_business_QuotaRequest(in *QuotaRequest, out *business.QuotaRequest, s conversion.Scope) error {
+	return autoConvert_v1_QuotaRequest_To_business_QuotaRequest(in, out, s)
+}
+
+func autoConvert_business_QuotaRequest_To_v1_QuotaRequest(in *business.QuotaRequest, out *QuotaRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_QuotaRequest_To_v1_QuotaRequest is an autogenerated conversion function.
+func Convert_business_QuotaRequest_To_v1_QuotaRequest(in *business.QuotaRequest, out *QuotaRequest, s conversion.Scope) error {
+	return autoConvert_business_QuotaRequest_To_v1_QuotaRequest(in, out, s)
+}
+
+func autoConvert_v1_QuotaRequestList_To_business_QuotaRequestList(in *QuotaRequestList, out *business.QuotaRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]business.QuotaRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_QuotaRequestList_To_business_QuotaRequestList is an autogenerated conversion function.
+func Convert_v1_QuotaRequestList_To_business_QuotaRequestList(in *QuotaRequestList, out *business.QuotaRequestList, s conversion.Scope) error {
+	return autoConvert_v1_QuotaRequestList_To_business_QuotaRequestList(in, out, s)
+}
+
+func autoConvert_business_QuotaRequestList_To_v1_QuotaRequestList(in *business.QuotaRequestList, out *QuotaRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]QuotaRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_business_QuotaRequestList_To_v1_QuotaRequestList is an autogenerated conversion function.
+func Convert_business_QuotaRequestList_To_v1_QuotaRequestList(in *business.QuotaRequestList, out *QuotaRequestList, s conversion.Scope) error {
+	return autoConvert_business_QuotaRequestList_To_v1_QuotaRequestList(in, out, s)
+}
+
+func autoConvert_v1_QuotaRequestNotification_To_business_QuotaRequestNotification(in *QuotaRequestNotification, out *business.QuotaRequestNotification, s conversion.Scope) error {
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_v1_QuotaRequestNotification_To_business_QuotaRequestNotification is an autogenerated conversion function.
+func Convert_v1_QuotaRequestNotification_To_business_QuotaRequestNotification(in *QuotaRequestNotification, out *business.QuotaRequestNotification, s conversion.Scope) error {
+	return autoConvert_v1_QuotaRequestNotification_To_business_QuotaRequestNotification(in, out, s)
+}
+
+func autoConvert_business_QuotaRequestNotification_To_v1_QuotaRequestNotification(in *business.QuotaRequestNotification, out *QuotaRequestNotification, s conversion.Scope) error {
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_business_QuotaRequestNotification_To_v1_QuotaRequestNotification is an autogenerated conversion function.
+func Convert_business_QuotaRequestNotification_To_v1_QuotaRequestNotification(in *business.QuotaRequestNotification, out *QuotaRequestNotification, s conversion.Scope) error {
+	return autoConvert_business_QuotaRequestNotification_To_v1_QuotaRequestNotification(in, out, s)
+}
+
+func autoConvert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec(in *QuotaRequestSpec, out *business.QuotaRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*business.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Justification = in.Justification
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec is an autogenerated conversion function.
+func Convert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec(in *QuotaRequestSpec, out *business.QuotaRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_QuotaRequestSpec_To_business_QuotaRequestSpec(in, out, s)
+}
+
+func autoConvert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec(in *business.QuotaRequestSpec, out *QuotaRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Justification = in.Justification
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec is an autogenerated conversion function.
+func Convert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec(in *business.QuotaRequestSpec, out *QuotaRequestSpec, s conversion.Scope) error {
+	return autoConvert_business_QuotaRequestSpec_To_v1_QuotaRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus(in *QuotaRequestStatus, out *business.QuotaRequestStatus, s conversion.Scope) error {
+	out.Phase = business.QuotaRequestPhase(in.Phase)
+	out.Reviewer = in.Reviewer
+	out.Comment = in.Comment
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Notified = in.Notified
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus is an autogenerated conversion function.
+func Convert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus(in *QuotaRequestStatus, out *business.QuotaRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_QuotaRequestStatus_To_business_QuotaRequestStatus(in, out, s)
+}
+
+func autoConvert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus(in *business.QuotaRequestStatus, out *QuotaRequestStatus, s conversion.Scope) error {
+	out.Phase = QuotaRequestPhase(in.Phase)
+	out.Reviewer = in.Reviewer
+	out.Comment = in.Comment
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Notified = in.Notified
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus is an autogenerated conversion function.
+func Convert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus(in *business.QuotaRequestStatus, out *QuotaRequestStatus, s conversion.Scope) error {
+	return autoConvert_business_QuotaRequestStatus_To_v1_QuotaRequestStatus(in, out, s)
+}
+
 func autoConvert_v1_UsedQuantity_To_business_UsedQuantity(in *UsedQuantity, out *business.UsedQuantity, s conversion.Scope) error {
 	out.Used = *(*business.ResourceList)(unsafe.Pointer(&in.Used))
 	return nil
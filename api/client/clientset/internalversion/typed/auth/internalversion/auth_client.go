@@ -29,15 +29,19 @@ type AuthInterface interface {
 	RESTClient() rest.Interface
 	APIKeysGetter
 	APISigningKeysGetter
+	AccessRequestsGetter
 	CategoriesGetter
 	ClientsGetter
 	ConfigMapsGetter
 	CustomPolicyBindingsGetter
+	FederatedIdentitiesGetter
 	GroupsGetter
 	IdentityProvidersGetter
 	LocalGroupsGetter
 	LocalIdentitiesGetter
+	MultiFactorEnrollmentsGetter
 	PoliciesGetter
+	PolicySimulationsGetter
 	ProjectsGetter
 	ProjectPolicyBindingsGetter
 	RolesGetter
@@ -58,6 +62,10 @@ func (c *AuthClient) APISigningKeys() APISigningKeyInterface {
 	return newAPISigningKeys(c)
 }
 
+func (c *AuthClient) AccessRequests() AccessRequestInterface {
+	return newAccessRequests(c)
+}
+
 func (c *AuthClient) Categories() CategoryInterface {
 	return newCategories(c)
 }
@@ -74,6 +82,10 @@ func (c *AuthClient) CustomPolicyBindings(namespace string) CustomPolicyBindingI
 	return newCustomPolicyBindings(c, namespace)
 }
 
+func (c *AuthClient) FederatedIdentities() FederatedIdentityInterface {
+	return newFederatedIdentities(c)
+}
+
 func (c *AuthClient) Groups() GroupInterface {
 	return newGroups(c)
 }
@@ -90,10 +102,18 @@ func (c *AuthClient) LocalIdentities() LocalIdentityInterface {
 	return newLocalIdentities(c)
 }
 
+func (c *AuthClient) MultiFactorEnrollments() MultiFactorEnrollmentInterface {
+	return newMultiFactorEnrollments(c)
+}
+
 func (c *AuthClient) Policies() PolicyInterface {
 	return newPolicies(c)
 }
 
+func (c *AuthClient) PolicySimulations() PolicySimulationInterface {
+	return newPolicySimulations(c)
+}
+
 func (c *AuthClient) Projects() ProjectInterface {
 	return newProjects(c)
 }
@@ -38,6 +38,10 @@ func (c *FakeAuth) APISigningKeys() internalversion.APISigningKeyInterface {
 	return &FakeAPISigningKeys{c}
 }
 
+func (c *FakeAuth) AccessRequests() internalversion.AccessRequestInterface {
+	return &FakeAccessRequests{c}
+}
+
 func (c *FakeAuth) Categories() internalversion.CategoryInterface {
 	return &FakeCategories{c}
 }
@@ -54,6 +58,10 @@ func (c *FakeAuth) CustomPolicyBindings(namespace string) internalversion.Custom
 	return &FakeCustomPolicyBindings{c, namespace}
 }
 
+func (c *FakeAuth) FederatedIdentities() internalversion.FederatedIdentityInterface {
+	return &FakeFederatedIdentities{c}
+}
+
 func (c *FakeAuth) Groups() internalversion.GroupInterface {
 	return &FakeGroups{c}
 }
@@ -70,10 +78,18 @@ func (c *FakeAuth) LocalIdentities() internalversion.LocalIdentityInterface {
 	return &FakeLocalIdentities{c}
 }
 
+func (c *FakeAuth) MultiFactorEnrollments() internalversion.MultiFactorEnrollmentInterface {
+	return &FakeMultiFactorEnrollments{c}
+}
+
 func (c *FakeAuth) Policies() internalversion.PolicyInterface {
 	return &FakePolicies{c}
 }
 
+func (c *FakeAuth) PolicySimulations() internalversion.PolicySimulationInterface {
+	return &FakePolicySimulations{c}
+}
+
 func (c *FakeAuth) Projects() internalversion.ProjectInterface {
 	return &FakeProjects{c}
 }
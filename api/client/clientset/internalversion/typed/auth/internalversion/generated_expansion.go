@@ -24,6 +24,8 @@ type APIKeyExpansion interface{}
 
 type APISigningKeyExpansion interface{}
 
+type AccessRequestExpansion interface{}
+
 type CategoryExpansion interface{}
 
 type ClientExpansion interface{}
@@ -32,6 +34,8 @@ type ConfigMapExpansion interface{}
 
 type CustomPolicyBindingExpansion interface{}
 
+type FederatedIdentityExpansion interface{}
+
 type GroupExpansion interface{}
 
 type IdentityProviderExpansion interface{}
@@ -40,8 +44,12 @@ type LocalGroupExpansion interface{}
 
 type LocalIdentityExpansion interface{}
 
+type MultiFactorEnrollmentExpansion interface{}
+
 type PolicyExpansion interface{}
 
+type PolicySimulationExpansion interface{}
+
 type ProjectExpansion interface{}
 
 type ProjectPolicyBindingExpansion interface{}
@@ -30,6 +30,10 @@ type FakeNotify struct {
 	*testing.Fake
 }
 
+func (c *FakeNotify) AuditRules() internalversion.AuditRuleInterface {
+	return &FakeAuditRules{c}
+}
+
 func (c *FakeNotify) Channels() internalversion.ChannelInterface {
 	return &FakeChannels{c}
 }
@@ -38,6 +42,10 @@ func (c *FakeNotify) ConfigMaps() internalversion.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeNotify) EscalationPolicies() internalversion.EscalationPolicyInterface {
+	return &FakeEscalationPolicies{c}
+}
+
 func (c *FakeNotify) Messages() internalversion.MessageInterface {
 	return &FakeMessages{c}
 }
@@ -46,6 +54,10 @@ func (c *FakeNotify) MessageRequests(namespace string) internalversion.MessageRe
 	return &FakeMessageRequests{c, namespace}
 }
 
+func (c *FakeNotify) OnCallSchedules() internalversion.OnCallScheduleInterface {
+	return &FakeOnCallSchedules{c}
+}
+
 func (c *FakeNotify) Receivers() internalversion.ReceiverInterface {
 	return &FakeReceivers{c}
 }
@@ -54,6 +66,10 @@ func (c *FakeNotify) ReceiverGroups() internalversion.ReceiverGroupInterface {
 	return &FakeReceiverGroups{c}
 }
 
+func (c *FakeNotify) Silences() internalversion.SilenceInterface {
+	return &FakeSilences{c}
+}
+
 func (c *FakeNotify) Templates(namespace string) internalversion.TemplateInterface {
 	return &FakeTemplates{c, namespace}
 }
@@ -27,12 +27,16 @@ import (
 
 type NotifyInterface interface {
 	RESTClient() rest.Interface
+	AuditRulesGetter
 	ChannelsGetter
 	ConfigMapsGetter
+	EscalationPoliciesGetter
 	MessagesGetter
 	MessageRequestsGetter
+	OnCallSchedulesGetter
 	ReceiversGetter
 	ReceiverGroupsGetter
+	SilencesGetter
 	TemplatesGetter
 }
 
@@ -41,6 +45,10 @@ type NotifyClient struct {
 	restClient rest.Interface
 }
 
+func (c *NotifyClient) AuditRules() AuditRuleInterface {
+	return newAuditRules(c)
+}
+
 func (c *NotifyClient) Channels() ChannelInterface {
 	return newChannels(c)
 }
@@ -49,6 +57,10 @@ func (c *NotifyClient) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *NotifyClient) EscalationPolicies() EscalationPolicyInterface {
+	return newEscalationPolicies(c)
+}
+
 func (c *NotifyClient) Messages() MessageInterface {
 	return newMessages(c)
 }
@@ -57,6 +69,10 @@ func (c *NotifyClient) MessageRequests(namespace string) MessageRequestInterface
 	return newMessageRequests(c, namespace)
 }
 
+func (c *NotifyClient) OnCallSchedules() OnCallScheduleInterface {
+	return newOnCallSchedules(c)
+}
+
 func (c *NotifyClient) Receivers() ReceiverInterface {
 	return newReceivers(c)
 }
@@ -65,6 +81,10 @@ func (c *NotifyClient) ReceiverGroups() ReceiverGroupInterface {
 	return newReceiverGroups(c)
 }
 
+func (c *NotifyClient) Silences() SilenceInterface {
+	return newSilences(c)
+}
+
 func (c *NotifyClient) Templates(namespace string) TemplateInterface {
 	return newTemplates(c, namespace)
 }
@@ -42,6 +42,7 @@ type ReceiversGetter interface {
 type ReceiverInterface interface {
 	Create(ctx context.Context, receiver *notify.Receiver, opts v1.CreateOptions) (*notify.Receiver, error)
 	Update(ctx context.Context, receiver *notify.Receiver, opts v1.UpdateOptions) (*notify.Receiver, error)
+	UpdateStatus(ctx context.Context, receiver *notify.Receiver, opts v1.UpdateOptions) (*notify.Receiver, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*notify.Receiver, error)
 	List(ctx context.Context, opts v1.ListOptions) (*notify.ReceiverList, error)
@@ -129,6 +130,21 @@ func (c *receivers) Update(ctx context.Context, receiver *notify.Receiver, opts
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *receivers) UpdateStatus(ctx context.Context, receiver *notify.Receiver, opts v1.UpdateOptions) (result *notify.Receiver, err error) {
+	result = &notify.Receiver{}
+	err = c.client.Put().
+		Resource("receivers").
+		Name(receiver.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(receiver).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the receiver and deletes it. Returns an error if one occurs.
 func (c *receivers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
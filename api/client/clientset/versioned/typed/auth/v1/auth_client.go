@@ -30,15 +30,19 @@ type AuthV1Interface interface {
 	RESTClient() rest.Interface
 	APIKeysGetter
 	APISigningKeysGetter
+	AccessRequestsGetter
 	CategoriesGetter
 	ClientsGetter
 	ConfigMapsGetter
 	CustomPolicyBindingsGetter
+	FederatedIdentitiesGetter
 	GroupsGetter
 	IdentityProvidersGetter
 	LocalGroupsGetter
 	LocalIdentitiesGetter
+	MultiFactorEnrollmentsGetter
 	PoliciesGetter
+	PolicySimulationsGetter
 	ProjectPolicyBindingsGetter
 	RolesGetter
 	RulesGetter
@@ -58,6 +62,10 @@ func (c *AuthV1Client) APISigningKeys() APISigningKeyInterface {
 	return newAPISigningKeys(c)
 }
 
+func (c *AuthV1Client) AccessRequests() AccessRequestInterface {
+	return newAccessRequests(c)
+}
+
 func (c *AuthV1Client) Categories() CategoryInterface {
 	return newCategories(c)
 }
@@ -74,6 +82,10 @@ func (c *AuthV1Client) CustomPolicyBindings(namespace string) CustomPolicyBindin
 	return newCustomPolicyBindings(c, namespace)
 }
 
+func (c *AuthV1Client) FederatedIdentities() FederatedIdentityInterface {
+	return newFederatedIdentities(c)
+}
+
 func (c *AuthV1Client) Groups() GroupInterface {
 	return newGroups(c)
 }
@@ -90,10 +102,18 @@ func (c *AuthV1Client) LocalIdentities() LocalIdentityInterface {
 	return newLocalIdentities(c)
 }
 
+func (c *AuthV1Client) MultiFactorEnrollments() MultiFactorEnrollmentInterface {
+	return newMultiFactorEnrollments(c)
+}
+
 func (c *AuthV1Client) Policies() PolicyInterface {
 	return newPolicies(c)
 }
 
+func (c *AuthV1Client) PolicySimulations() PolicySimulationInterface {
+	return newPolicySimulations(c)
+}
+
 func (c *AuthV1Client) ProjectPolicyBindings() ProjectPolicyBindingInterface {
 	return newProjectPolicyBindings(c)
 }
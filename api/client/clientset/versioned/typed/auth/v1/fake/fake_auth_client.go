@@ -38,6 +38,10 @@ func (c *FakeAuthV1) APISigningKeys() v1.APISigningKeyInterface {
 	return &FakeAPISigningKeys{c}
 }
 
+func (c *FakeAuthV1) AccessRequests() v1.AccessRequestInterface {
+	return &FakeAccessRequests{c}
+}
+
 func (c *FakeAuthV1) Categories() v1.CategoryInterface {
 	return &FakeCategories{c}
 }
@@ -54,6 +58,10 @@ func (c *FakeAuthV1) CustomPolicyBindings(namespace string) v1.CustomPolicyBindi
 	return &FakeCustomPolicyBindings{c, namespace}
 }
 
+func (c *FakeAuthV1) FederatedIdentities() v1.FederatedIdentityInterface {
+	return &FakeFederatedIdentities{c}
+}
+
 func (c *FakeAuthV1) Groups() v1.GroupInterface {
 	return &FakeGroups{c}
 }
@@ -70,10 +78,18 @@ func (c *FakeAuthV1) LocalIdentities() v1.LocalIdentityInterface {
 	return &FakeLocalIdentities{c}
 }
 
+func (c *FakeAuthV1) MultiFactorEnrollments() v1.MultiFactorEnrollmentInterface {
+	return &FakeMultiFactorEnrollments{c}
+}
+
 func (c *FakeAuthV1) Policies() v1.PolicyInterface {
 	return &FakePolicies{c}
 }
 
+func (c *FakeAuthV1) PolicySimulations() v1.PolicySimulationInterface {
+	return &FakePolicySimulations{c}
+}
+
 func (c *FakeAuthV1) ProjectPolicyBindings() v1.ProjectPolicyBindingInterface {
 	return &FakeProjectPolicyBindings{c}
 }
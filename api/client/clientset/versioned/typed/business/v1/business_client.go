@@ -32,10 +32,12 @@ type BusinessV1Interface interface {
 	ConfigMapsGetter
 	ImageNamespacesGetter
 	NamespacesGetter
+	NamespaceTemplatesGetter
 	NsEmigrationsGetter
 	PlatformsGetter
 	PortalsGetter
 	ProjectsGetter
+	QuotaRequestsGetter
 }
 
 // BusinessV1Client is used to interact with features provided by the business.tkestack.io group.
@@ -59,6 +61,10 @@ func (c *BusinessV1Client) Namespaces(namespace string) NamespaceInterface {
 	return newNamespaces(c, namespace)
 }
 
+func (c *BusinessV1Client) NamespaceTemplates() NamespaceTemplateInterface {
+	return newNamespaceTemplates(c)
+}
+
 func (c *BusinessV1Client) NsEmigrations(namespace string) NsEmigrationInterface {
 	return newNsEmigrations(c, namespace)
 }
@@ -75,6 +81,10 @@ func (c *BusinessV1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
 
+func (c *BusinessV1Client) QuotaRequests(namespace string) QuotaRequestInterface {
+	return newQuotaRequests(c, namespace)
+}
+
 // NewForConfig creates a new BusinessV1Client for the given config.
 func NewForConfig(c *rest.Config) (*BusinessV1Client, error) {
 	config := *c
@@ -30,6 +30,10 @@ type FakeNotifyV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeNotifyV1) AuditRules() v1.AuditRuleInterface {
+	return &FakeAuditRules{c}
+}
+
 func (c *FakeNotifyV1) Channels() v1.ChannelInterface {
 	return &FakeChannels{c}
 }
@@ -38,6 +42,10 @@ func (c *FakeNotifyV1) ConfigMaps() v1.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeNotifyV1) EscalationPolicies() v1.EscalationPolicyInterface {
+	return &FakeEscalationPolicies{c}
+}
+
 func (c *FakeNotifyV1) Messages() v1.MessageInterface {
 	return &FakeMessages{c}
 }
@@ -46,6 +54,10 @@ func (c *FakeNotifyV1) MessageRequests(namespace string) v1.MessageRequestInterf
 	return &FakeMessageRequests{c, namespace}
 }
 
+func (c *FakeNotifyV1) OnCallSchedules() v1.OnCallScheduleInterface {
+	return &FakeOnCallSchedules{c}
+}
+
 func (c *FakeNotifyV1) Receivers() v1.ReceiverInterface {
 	return &FakeReceivers{c}
 }
@@ -54,6 +66,10 @@ func (c *FakeNotifyV1) ReceiverGroups() v1.ReceiverGroupInterface {
 	return &FakeReceiverGroups{c}
 }
 
+func (c *FakeNotifyV1) Silences() v1.SilenceInterface {
+	return &FakeSilences{c}
+}
+
 func (c *FakeNotifyV1) Templates(namespace string) v1.TemplateInterface {
 	return &FakeTemplates{c, namespace}
 }
@@ -98,6 +98,17 @@ func (c *FakeReceivers) Update(ctx context.Context, receiver *notifyv1.Receiver,
 	return obj.(*notifyv1.Receiver), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeReceivers) UpdateStatus(ctx context.Context, receiver *notifyv1.Receiver, opts v1.UpdateOptions) (*notifyv1.Receiver, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(receiversResource, "status", receiver), &notifyv1.Receiver{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*notifyv1.Receiver), err
+}
+
 // Delete takes name of the receiver and deletes it. Returns an error if one occurs.
 func (c *FakeReceivers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
@@ -20,16 +20,24 @@
 
 package v1
 
+type AuditRuleExpansion interface{}
+
 type ChannelExpansion interface{}
 
 type ConfigMapExpansion interface{}
 
+type EscalationPolicyExpansion interface{}
+
 type MessageExpansion interface{}
 
 type MessageRequestExpansion interface{}
 
+type OnCallScheduleExpansion interface{}
+
 type ReceiverExpansion interface{}
 
 type ReceiverGroupExpansion interface{}
 
+type SilenceExpansion interface{}
+
 type TemplateExpansion interface{}
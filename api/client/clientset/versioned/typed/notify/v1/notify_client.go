@@ -28,12 +28,16 @@ import (
 
 type NotifyV1Interface interface {
 	RESTClient() rest.Interface
+	AuditRulesGetter
 	ChannelsGetter
 	ConfigMapsGetter
+	EscalationPoliciesGetter
 	MessagesGetter
 	MessageRequestsGetter
+	OnCallSchedulesGetter
 	ReceiversGetter
 	ReceiverGroupsGetter
+	SilencesGetter
 	TemplatesGetter
 }
 
@@ -42,6 +46,10 @@ type NotifyV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *NotifyV1Client) AuditRules() AuditRuleInterface {
+	return newAuditRules(c)
+}
+
 func (c *NotifyV1Client) Channels() ChannelInterface {
 	return newChannels(c)
 }
@@ -50,6 +58,10 @@ func (c *NotifyV1Client) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *NotifyV1Client) EscalationPolicies() EscalationPolicyInterface {
+	return newEscalationPolicies(c)
+}
+
 func (c *NotifyV1Client) Messages() MessageInterface {
 	return newMessages(c)
 }
@@ -58,6 +70,10 @@ func (c *NotifyV1Client) MessageRequests(namespace string) MessageRequestInterfa
 	return newMessageRequests(c, namespace)
 }
 
+func (c *NotifyV1Client) OnCallSchedules() OnCallScheduleInterface {
+	return newOnCallSchedules(c)
+}
+
 func (c *NotifyV1Client) Receivers() ReceiverInterface {
 	return newReceivers(c)
 }
@@ -66,6 +82,10 @@ func (c *NotifyV1Client) ReceiverGroups() ReceiverGroupInterface {
 	return newReceiverGroups(c)
 }
 
+func (c *NotifyV1Client) Silences() SilenceInterface {
+	return newSilences(c)
+}
+
 func (c *NotifyV1Client) Templates(namespace string) TemplateInterface {
 	return newTemplates(c, namespace)
 }
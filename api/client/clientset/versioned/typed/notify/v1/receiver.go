@@ -42,6 +42,7 @@ type ReceiversGetter interface {
 type ReceiverInterface interface {
 	Create(ctx context.Context, receiver *v1.Receiver, opts metav1.CreateOptions) (*v1.Receiver, error)
 	Update(ctx context.Context, receiver *v1.Receiver, opts metav1.UpdateOptions) (*v1.Receiver, error)
+	UpdateStatus(ctx context.Context, receiver *v1.Receiver, opts metav1.UpdateOptions) (*v1.Receiver, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Receiver, error)
 	List(ctx context.Context, opts metav1.ListOptions) (*v1.ReceiverList, error)
@@ -129,6 +130,21 @@ func (c *receivers) Update(ctx context.Context, receiver *v1.Receiver, opts meta
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *receivers) UpdateStatus(ctx context.Context, receiver *v1.Receiver, opts metav1.UpdateOptions) (result *v1.Receiver, err error) {
+	result = &v1.Receiver{}
+	err = c.client.Put().
+		Resource("receivers").
+		Name(receiver.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(receiver).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the receiver and deletes it. Returns an error if one occurs.
 func (c *receivers) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
 	return c.client.Delete().
@@ -30,6 +30,8 @@ type Interface interface {
 	APIKeys() APIKeyInformer
 	// APISigningKeys returns a APISigningKeyInformer.
 	APISigningKeys() APISigningKeyInformer
+	// AccessRequests returns a AccessRequestInformer.
+	AccessRequests() AccessRequestInformer
 	// Categories returns a CategoryInformer.
 	Categories() CategoryInformer
 	// Clients returns a ClientInformer.
@@ -38,6 +40,8 @@ type Interface interface {
 	ConfigMaps() ConfigMapInformer
 	// CustomPolicyBindings returns a CustomPolicyBindingInformer.
 	CustomPolicyBindings() CustomPolicyBindingInformer
+	// FederatedIdentities returns a FederatedIdentityInformer.
+	FederatedIdentities() FederatedIdentityInformer
 	// Groups returns a GroupInformer.
 	Groups() GroupInformer
 	// IdentityProviders returns a IdentityProviderInformer.
@@ -46,6 +50,8 @@ type Interface interface {
 	LocalGroups() LocalGroupInformer
 	// LocalIdentities returns a LocalIdentityInformer.
 	LocalIdentities() LocalIdentityInformer
+	// MultiFactorEnrollments returns a MultiFactorEnrollmentInformer.
+	MultiFactorEnrollments() MultiFactorEnrollmentInformer
 	// Policies returns a PolicyInformer.
 	Policies() PolicyInformer
 	// ProjectPolicyBindings returns a ProjectPolicyBindingInformer.
@@ -79,6 +85,11 @@ func (v *version) APISigningKeys() APISigningKeyInformer {
 	return &aPISigningKeyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// AccessRequests returns a AccessRequestInformer.
+func (v *version) AccessRequests() AccessRequestInformer {
+	return &accessRequestInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Categories returns a CategoryInformer.
 func (v *version) Categories() CategoryInformer {
 	return &categoryInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -99,6 +110,11 @@ func (v *version) CustomPolicyBindings() CustomPolicyBindingInformer {
 	return &customPolicyBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// FederatedIdentities returns a FederatedIdentityInformer.
+func (v *version) FederatedIdentities() FederatedIdentityInformer {
+	return &federatedIdentityInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Groups returns a GroupInformer.
 func (v *version) Groups() GroupInformer {
 	return &groupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -119,6 +135,11 @@ func (v *version) LocalIdentities() LocalIdentityInformer {
 	return &localIdentityInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// MultiFactorEnrollments returns a MultiFactorEnrollmentInformer.
+func (v *version) MultiFactorEnrollments() MultiFactorEnrollmentInformer {
+	return &multiFactorEnrollmentInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Policies returns a PolicyInformer.
 func (v *version) Policies() PolicyInformer {
 	return &policyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
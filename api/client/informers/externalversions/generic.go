@@ -74,6 +74,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().APIKeys().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("apisigningkeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().APISigningKeys().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("accessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().AccessRequests().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("categories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Categories().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("clients"):
@@ -82,6 +84,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().ConfigMaps().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("custompolicybindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().CustomPolicyBindings().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("federatedidentities"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().FederatedIdentities().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("groups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Groups().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("identityproviders"):
@@ -90,6 +94,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().LocalGroups().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("localidentities"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().LocalIdentities().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("multifactorenrollments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().MultiFactorEnrollments().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("policies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Policies().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("projectpolicybindings"):
@@ -120,12 +126,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().ImageNamespaces().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Namespaces().Informer()}, nil
+	case businessv1.SchemeGroupVersion.WithResource("namespacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NamespaceTemplates().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NsEmigrations().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("platforms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Platforms().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Projects().Informer()}, nil
+	case businessv1.SchemeGroupVersion.WithResource("quotarequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().QuotaRequests().Informer()}, nil
 
 		// Group=logagent.tkestack.io, Version=v1
 	case logagentv1.SchemeGroupVersion.WithResource("configmaps"):
@@ -146,18 +156,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().V1().Prometheuses().Informer()}, nil
 
 		// Group=notify.tkestack.io, Version=v1
+	case notifyv1.SchemeGroupVersion.WithResource("auditrules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().AuditRules().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("channels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Channels().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().ConfigMaps().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("escalationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().EscalationPolicies().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("messages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Messages().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("messagerequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().MessageRequests().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("oncallschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().OnCallSchedules().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("receivers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Receivers().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("receivergroups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().ReceiverGroups().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("silences"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Silences().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("templates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Templates().Informer()}, nil
 
@@ -26,18 +26,26 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AuditRules returns a AuditRuleInformer.
+	AuditRules() AuditRuleInformer
 	// Channels returns a ChannelInformer.
 	Channels() ChannelInformer
 	// ConfigMaps returns a ConfigMapInformer.
 	ConfigMaps() ConfigMapInformer
+	// EscalationPolicies returns a EscalationPolicyInformer.
+	EscalationPolicies() EscalationPolicyInformer
 	// Messages returns a MessageInformer.
 	Messages() MessageInformer
 	// MessageRequests returns a MessageRequestInformer.
 	MessageRequests() MessageRequestInformer
+	// OnCallSchedules returns a OnCallScheduleInformer.
+	OnCallSchedules() OnCallScheduleInformer
 	// Receivers returns a ReceiverInformer.
 	Receivers() ReceiverInformer
 	// ReceiverGroups returns a ReceiverGroupInformer.
 	ReceiverGroups() ReceiverGroupInformer
+	// Silences returns a SilenceInformer.
+	Silences() SilenceInformer
 	// Templates returns a TemplateInformer.
 	Templates() TemplateInformer
 }
@@ -53,6 +61,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AuditRules returns a AuditRuleInformer.
+func (v *version) AuditRules() AuditRuleInformer {
+	return &auditRuleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Channels returns a ChannelInformer.
 func (v *version) Channels() ChannelInformer {
 	return &channelInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -63,6 +76,11 @@ func (v *version) ConfigMaps() ConfigMapInformer {
 	return &configMapInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EscalationPolicies returns a EscalationPolicyInformer.
+func (v *version) EscalationPolicies() EscalationPolicyInformer {
+	return &escalationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Messages returns a MessageInformer.
 func (v *version) Messages() MessageInformer {
 	return &messageInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -73,6 +91,11 @@ func (v *version) MessageRequests() MessageRequestInformer {
 	return &messageRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OnCallSchedules returns a OnCallScheduleInformer.
+func (v *version) OnCallSchedules() OnCallScheduleInformer {
+	return &onCallScheduleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Receivers returns a ReceiverInformer.
 func (v *version) Receivers() ReceiverInformer {
 	return &receiverInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -83,6 +106,11 @@ func (v *version) ReceiverGroups() ReceiverGroupInformer {
 	return &receiverGroupInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Silences returns a SilenceInformer.
+func (v *version) Silences() SilenceInformer {
+	return &silenceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Templates returns a TemplateInformer.
 func (v *version) Templates() TemplateInformer {
 	return &templateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
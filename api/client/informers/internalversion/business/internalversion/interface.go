@@ -34,12 +34,16 @@ type Interface interface {
 	ImageNamespaces() ImageNamespaceInformer
 	// Namespaces returns a NamespaceInformer.
 	Namespaces() NamespaceInformer
+	// NamespaceTemplates returns a NamespaceTemplateInformer.
+	NamespaceTemplates() NamespaceTemplateInformer
 	// NsEmigrations returns a NsEmigrationInformer.
 	NsEmigrations() NsEmigrationInformer
 	// Platforms returns a PlatformInformer.
 	Platforms() PlatformInformer
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
+	// QuotaRequests returns a QuotaRequestInformer.
+	QuotaRequests() QuotaRequestInformer
 }
 
 type version struct {
@@ -73,6 +77,11 @@ func (v *version) Namespaces() NamespaceInformer {
 	return &namespaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NamespaceTemplates returns a NamespaceTemplateInformer.
+func (v *version) NamespaceTemplates() NamespaceTemplateInformer {
+	return &namespaceTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // NsEmigrations returns a NsEmigrationInformer.
 func (v *version) NsEmigrations() NsEmigrationInformer {
 	return &nsEmigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -87,3 +96,8 @@ func (v *version) Platforms() PlatformInformer {
 func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// QuotaRequests returns a QuotaRequestInformer.
+func (v *version) QuotaRequests() QuotaRequestInformer {
+	return &quotaRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -74,6 +74,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().APIKeys().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("apisigningkeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().APISigningKeys().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("accessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().AccessRequests().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("categories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Categories().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("clients"):
@@ -82,6 +84,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().ConfigMaps().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("custompolicybindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().CustomPolicyBindings().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("federatedidentities"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().FederatedIdentities().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("groups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Groups().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("identityproviders"):
@@ -90,6 +94,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().LocalGroups().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("localidentities"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().LocalIdentities().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("multifactorenrollments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().MultiFactorEnrollments().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("policies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Policies().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("projects"):
@@ -122,12 +128,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().ImageNamespaces().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Namespaces().Informer()}, nil
+	case business.SchemeGroupVersion.WithResource("namespacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NamespaceTemplates().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NsEmigrations().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("platforms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Platforms().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Projects().Informer()}, nil
+	case business.SchemeGroupVersion.WithResource("quotarequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().QuotaRequests().Informer()}, nil
 
 		// Group=logagent.tkestack.io, Version=internalVersion
 	case logagent.SchemeGroupVersion.WithResource("configmaps"):
@@ -148,18 +158,26 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().InternalVersion().Prometheuses().Informer()}, nil
 
 		// Group=notify.tkestack.io, Version=internalVersion
+	case notify.SchemeGroupVersion.WithResource("auditrules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().AuditRules().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("channels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Channels().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().ConfigMaps().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("escalationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().EscalationPolicies().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("messages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Messages().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("messagerequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().MessageRequests().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("oncallschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().OnCallSchedules().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("receivers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Receivers().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("receivergroups"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().ReceiverGroups().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("silences"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Silences().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("templates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Templates().Informer()}, nil
 
@@ -28,6 +28,10 @@ type APIKeyListerExpansion interface{}
 // APISigningKeyLister.
 type APISigningKeyListerExpansion interface{}
 
+// AccessRequestListerExpansion allows custom methods to be added to
+// AccessRequestLister.
+type AccessRequestListerExpansion interface{}
+
 // CategoryListerExpansion allows custom methods to be added to
 // CategoryLister.
 type CategoryListerExpansion interface{}
@@ -48,6 +52,10 @@ type CustomPolicyBindingListerExpansion interface{}
 // CustomPolicyBindingNamespaceLister.
 type CustomPolicyBindingNamespaceListerExpansion interface{}
 
+// FederatedIdentityListerExpansion allows custom methods to be added to
+// FederatedIdentityLister.
+type FederatedIdentityListerExpansion interface{}
+
 // GroupListerExpansion allows custom methods to be added to
 // GroupLister.
 type GroupListerExpansion interface{}
@@ -64,6 +72,10 @@ type LocalGroupListerExpansion interface{}
 // LocalIdentityLister.
 type LocalIdentityListerExpansion interface{}
 
+// MultiFactorEnrollmentListerExpansion allows custom methods to be added to
+// MultiFactorEnrollmentLister.
+type MultiFactorEnrollmentListerExpansion interface{}
+
 // PolicyListerExpansion allows custom methods to be added to
 // PolicyLister.
 type PolicyListerExpansion interface{}
@@ -48,6 +48,10 @@ type NamespaceListerExpansion interface{}
 // NamespaceNamespaceLister.
 type NamespaceNamespaceListerExpansion interface{}
 
+// NamespaceTemplateListerExpansion allows custom methods to be added to
+// NamespaceTemplateLister.
+type NamespaceTemplateListerExpansion interface{}
+
 // NsEmigrationListerExpansion allows custom methods to be added to
 // NsEmigrationLister.
 type NsEmigrationListerExpansion interface{}
@@ -67,3 +71,11 @@ type PortalListerExpansion interface{}
 // ProjectListerExpansion allows custom methods to be added to
 // ProjectLister.
 type ProjectListerExpansion interface{}
+
+// QuotaRequestListerExpansion allows custom methods to be added to
+// QuotaRequestLister.
+type QuotaRequestListerExpansion interface{}
+
+// QuotaRequestNamespaceListerExpansion allows custom methods to be added to
+// QuotaRequestNamespaceLister.
+type QuotaRequestNamespaceListerExpansion interface{}
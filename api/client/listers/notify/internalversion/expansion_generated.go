@@ -20,6 +20,10 @@
 
 package internalversion
 
+// AuditRuleListerExpansion allows custom methods to be added to
+// AuditRuleLister.
+type AuditRuleListerExpansion interface{}
+
 // ChannelListerExpansion allows custom methods to be added to
 // ChannelLister.
 type ChannelListerExpansion interface{}
@@ -28,6 +32,10 @@ type ChannelListerExpansion interface{}
 // ConfigMapLister.
 type ConfigMapListerExpansion interface{}
 
+// EscalationPolicyListerExpansion allows custom methods to be added to
+// EscalationPolicyLister.
+type EscalationPolicyListerExpansion interface{}
+
 // MessageListerExpansion allows custom methods to be added to
 // MessageLister.
 type MessageListerExpansion interface{}
@@ -40,6 +48,10 @@ type MessageRequestListerExpansion interface{}
 // MessageRequestNamespaceLister.
 type MessageRequestNamespaceListerExpansion interface{}
 
+// OnCallScheduleListerExpansion allows custom methods to be added to
+// OnCallScheduleLister.
+type OnCallScheduleListerExpansion interface{}
+
 // ReceiverListerExpansion allows custom methods to be added to
 // ReceiverLister.
 type ReceiverListerExpansion interface{}
@@ -48,6 +60,10 @@ type ReceiverListerExpansion interface{}
 // ReceiverGroupLister.
 type ReceiverGroupListerExpansion interface{}
 
+// SilenceListerExpansion allows custom methods to be added to
+// SilenceLister.
+type SilenceListerExpansion interface{}
+
 // TemplateListerExpansion allows custom methods to be added to
 // TemplateLister.
 type TemplateListerExpansion interface{}
@@ -79,6 +79,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMapList{},
 
 		&Message{},
-		&MessageList{})
+		&MessageList{},
+
+		&Silence{},
+		&SilenceList{},
+
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
+
+		&EscalationPolicy{},
+		&EscalationPolicyList{},
+
+		&AuditRule{},
+		&AuditRuleList{})
 	return nil
 }
@@ -75,6 +75,14 @@ type ChannelSpec struct {
 	SMTP *ChannelSMTP
 	// +optional
 	Webhook *ChannelWebhook
+	// +optional
+	Slack *ChannelSlack
+	// +optional
+	DingTalk *ChannelDingTalk
+	// +optional
+	WeCom *ChannelWeCom
+	// +optional
+	Lark *ChannelLark
 }
 
 // ChannelStatus represents information about the status of a cluster.
@@ -128,6 +136,91 @@ type ChannelWebhook struct {
 	URL string
 	// +optional
 	Headers map[string]string
+	// Method indicates the HTTP method used to request the webhook server,
+	// defaults to POST.
+	// +optional
+	Method string
+	// BodyTemplate indicates a go template used to render the request body,
+	// the message variables, `content`, `alertStatus` and `receivers` are
+	// available in the template, and the `json` function quotes a value as
+	// a json string. The default body contains the receivers and content.
+	// +optional
+	BodyTemplate string
+	// SigningSecretRef references the key of secret used to sign the request
+	// with HMAC-SHA256, the signature is sent in the X-TKE-Signature header.
+	// +optional
+	SigningSecretRef *SecretKeySelector
+	// ClientCertificateSecretRef references a kubernetes.io/tls secret which
+	// holds the client certificate and key used for mutual TLS, the ca.crt
+	// of secret is used to verify the webhook server if it exists.
+	// +optional
+	ClientCertificateSecretRef *SecretReference
+	// TimeoutSeconds indicates the timeout of the request, defaults to 10.
+	// +optional
+	TimeoutSeconds int32
+	// InsecureSkipVerify indicates whether to skip the verification of the
+	// webhook server certificate, the server is verified against the system
+	// roots or the ca.crt of the client certificate secret by default.
+	// +optional
+	InsecureSkipVerify bool
+}
+
+// SecretReference references a Secret in the cluster where TKE is deployed.
+type SecretReference struct {
+	// Namespace of the secret, which must be and defaults to notify- followed
+	// by the tenant ID of the channel, so that a channel only reads the
+	// secrets of its own tenant.
+	// +optional
+	Namespace string
+	Name      string
+}
+
+// SecretKeySelector selects a key of a Secret in the cluster where TKE is
+// deployed.
+type SecretKeySelector struct {
+	// Namespace of the secret, which must be and defaults to notify- followed
+	// by the tenant ID of the channel, so that a channel only reads the
+	// secrets of its own tenant.
+	// +optional
+	Namespace string
+	Name      string
+	Key       string
+}
+
+// ChannelSlack indicates a channel configuration for sending notifications
+// to a Slack channel using an incoming webhook.
+// See: https://api.slack.com/messaging/webhooks
+type ChannelSlack struct {
+	WebhookURL string
+}
+
+// ChannelDingTalk indicates a channel configuration for sending notifications
+// to a DingTalk group using a custom robot.
+// See: https://open.dingtalk.com/document/robots/custom-robot-access
+type ChannelDingTalk struct {
+	WebhookURL string
+	// Secret indicates the signing secret of the robot, the requests are
+	// signed if it is specified.
+	// +optional
+	Secret string
+}
+
+// ChannelWeCom indicates a channel configuration for sending notifications
+// to a WeCom (WeChat Work) group using a group robot.
+// See: https://developer.work.weixin.qq.com/document/path/91770
+type ChannelWeCom struct {
+	WebhookURL string
+}
+
+// ChannelLark indicates a channel configuration for sending notifications
+// to a Lark (Feishu) group using a custom bot.
+// See: https://open.feishu.cn/document/ukTMukTMukTM/ucTM5YjL3ETO24yNxkjN
+type ChannelLark struct {
+	WebhookURL string
+	// Secret indicates the signing secret of the bot, the requests are
+	// signed if it is specified.
+	// +optional
+	Secret string
 }
 
 // +genclient
@@ -167,6 +260,8 @@ type TemplateSpec struct {
 	Wechat *TemplateWechat
 	// +optional
 	Text *TemplateText
+	// +optional
+	Markdown *TemplateMarkdown
 }
 
 // TemplateTencentCloudSMS indicates the template used when sending text
@@ -208,6 +303,15 @@ type TemplateText struct {
 	Header string
 }
 
+// TemplateMarkdown indicates the template used to send markdown notifications
+// to the chat tool channels, such as Slack, DingTalk, WeCom and Lark.
+type TemplateMarkdown struct {
+	Body string
+	// Title indicates the title of the message card.
+	// +optional
+	Title string
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
@@ -222,6 +326,9 @@ type Receiver struct {
 	// Spec defines the desired receiver.
 	// +optional
 	Spec ReceiverSpec
+	// Status defines the deliveries counted by the rate limits of the receiver.
+	// +optional
+	Status ReceiverStatus
 }
 
 // +genclient:nonNamespaced
@@ -249,6 +356,14 @@ const (
 	ReceiverChannelWechatOpenID ReceiverChannel = "wechat_openid"
 	// ReceiverChannelWebhook only indicates channel type webhook
 	ReceiverChannelWebhook ReceiverChannel = "webhook"
+	// ReceiverChannelSlack represents the member id for slack of receiver.
+	ReceiverChannelSlack ReceiverChannel = "slack"
+	// ReceiverChannelDingTalk represents the user id for dingtalk of receiver.
+	ReceiverChannelDingTalk ReceiverChannel = "dingtalk"
+	// ReceiverChannelWeCom represents the user id for wecom of receiver.
+	ReceiverChannelWeCom ReceiverChannel = "wecom"
+	// ReceiverChannelLark represents the open id for lark of receiver.
+	ReceiverChannelLark ReceiverChannel = "lark"
 )
 
 // ReceiverSpec is a description of a receiver.
@@ -265,6 +380,90 @@ type ReceiverSpec struct {
 	// email address.
 	// +optional
 	Identities map[ReceiverChannel]string
+	// Preferences controls when and how often the messages are delivered to
+	// the receiver.
+	// +optional
+	Preferences *ReceiverPreferences
+}
+
+// ReceiverPreferences describes the notification preferences of a receiver,
+// they apply to the messages delivered to the receiver individually, which
+// are the messages of mobile, wechat and email channels.
+type ReceiverPreferences struct {
+	// TimeZone is the IANA time zone name in which the quiet hours are
+	// evaluated, defaults to UTC.
+	// +optional
+	TimeZone string
+	// QuietHours defer the messages until the end of the quiet hours.
+	// +optional
+	QuietHours []ReceiverQuietHours
+	// RateLimits defer the messages exceeding the limits until the limits
+	// allow them.
+	// +optional
+	RateLimits []ReceiverRateLimit
+	// Digest batches the messages of low severity into a periodic digest email.
+	// +optional
+	Digest *ReceiverDigest
+}
+
+// ReceiverQuietHours is a daily time window during which no message is
+// delivered, the window crosses midnight if the end time is not after the
+// start time.
+type ReceiverQuietHours struct {
+	// Channels are the receiver channels the quiet hours apply to, all of the
+	// channels if it is empty.
+	// +optional
+	Channels []ReceiverChannel
+	// StartTime is the local time of day in the format of HH:MM.
+	StartTime string
+	// EndTime is the local time of day in the format of HH:MM.
+	EndTime string
+}
+
+// ReceiverRateLimit limits the number of messages delivered to the receiver
+// within a period.
+type ReceiverRateLimit struct {
+	// Channel is the receiver channel the limit applies to, the limit counts
+	// the messages of all channels if it is empty.
+	// +optional
+	Channel ReceiverChannel
+	// Limit is the maximum number of messages delivered within the period.
+	Limit int32
+	// PeriodMinutes is the length of the sliding period.
+	PeriodMinutes int32
+}
+
+// ReceiverDigest batches the messages of the given severities into a digest
+// email sent periodically.
+type ReceiverDigest struct {
+	// Severities are the values of the severity variable of the messages which
+	// are batched into the digest.
+	Severities []string
+	// IntervalMinutes is how long the first batched message waits before the
+	// digest is sent, defaults to 60.
+	// +optional
+	IntervalMinutes int32
+	// Channel is the name of the SMTP channel which sends the digest.
+	Channel string
+	// Template is the name of the text template in the channel which renders
+	// the digest.
+	Template string
+}
+
+// ReceiverStatus represents information about the status of a receiver.
+type ReceiverStatus struct {
+	// Deliveries are the messages recently delivered to the receiver on the
+	// channels limited by the rate limits, the deliveries which are older than
+	// the longest period of the rate limits are removed.
+	// +optional
+	Deliveries []ReceiverDelivery
+}
+
+// ReceiverDelivery is a message delivered to the receiver.
+type ReceiverDelivery struct {
+	Channel ReceiverChannel
+	// +optional
+	Time metav1.Time
 }
 
 // +genclient
@@ -288,6 +487,11 @@ type ReceiverGroupSpec struct {
 	DisplayName string
 	// +optional
 	Receivers []string
+	// EscalationPolicy is the name of the escalation policy which resolves the
+	// receivers to notify at send time, the static receivers are not notified
+	// if it is specified.
+	// +optional
+	EscalationPolicy string
 }
 
 // +genclient:nonNamespaced
@@ -356,6 +560,65 @@ type MessageRequestStatus struct {
 
 	//alert's status in notification sending from alertmanager
 	AlertStatus string
+	// Retries records the delivery retry state of each failed receiver, keyed
+	// the same way as Errors.
+	// +optional
+	Retries map[string]MessageRequestRetryStatus
+	// Escalations records the escalation state of each receiver group which
+	// has an escalation policy, keyed by the name of the receiver group.
+	// +optional
+	Escalations map[string]MessageRequestEscalationStatus
+	// Deferrals records the deliveries deferred by the preferences of the
+	// receivers, keyed by the receiver name and the receiver channel joined
+	// by a slash.
+	// +optional
+	Deferrals map[string]MessageRequestDeferralStatus
+}
+
+// MessageRequestDeferralStatus describes a deferred delivery.
+type MessageRequestDeferralStatus struct {
+	// The time at which the delivery was deferred.
+	// +optional
+	DeferredTime metav1.Time
+	// The time after which the delivery will be attempted again.
+	// +optional
+	DeliverAfter metav1.Time
+	// A human readable message indicating why the delivery was deferred.
+	// +optional
+	Reason string
+}
+
+// MessageRequestEscalationStatus describes the escalation state of a receiver
+// group.
+type MessageRequestEscalationStatus struct {
+	// Policy is the name of the escalation policy being followed.
+	Policy string
+	// Level is the index of the escalation rule notified most recently.
+	// +optional
+	Level int32
+	// The last time the receivers of a level were notified.
+	// +optional
+	LastEscalationTime metav1.Time
+	// The time after which the next level will be notified if no message has
+	// been acknowledged, it is empty if the escalation has finished.
+	// +optional
+	NextEscalationTime metav1.Time
+}
+
+// MessageRequestRetryStatus describes the delivery retry state of a receiver.
+type MessageRequestRetryStatus struct {
+	// Attempts is the number of delivery attempts made so far.
+	// +optional
+	Attempts int32
+	// The last time a delivery was attempted.
+	// +optional
+	LastAttemptTime metav1.Time
+	// The time after which the next delivery will be attempted.
+	// +optional
+	NextAttemptTime metav1.Time
+	// The error returned by the last delivery attempt.
+	// +optional
+	LastError string
 }
 
 // MessageRequestPhase indicates the status of message request.
@@ -374,6 +637,21 @@ const (
 	MessageRequestFailed MessageRequestPhase = "Failed"
 	// MessageRequestPartialFailure indicates that the partial failure to sent.
 	MessageRequestPartialFailure MessageRequestPhase = "PartialFailure"
+	// MessageRequestRetrying indicates that some receivers failed and the message
+	// is waiting for the next delivery attempt.
+	MessageRequestRetrying MessageRequestPhase = "Retrying"
+	// MessageRequestDeadLetter indicates that the delivery retries have been
+	// exhausted and the message request must be retried manually.
+	MessageRequestDeadLetter MessageRequestPhase = "DeadLetter"
+	// MessageRequestEscalating indicates that the message request has been sent
+	// and is waiting for an acknowledgement before escalating to the next level.
+	MessageRequestEscalating MessageRequestPhase = "Escalating"
+	// MessageRequestAcknowledged indicates that a message of the request has
+	// been acknowledged and the escalation has stopped.
+	MessageRequestAcknowledged MessageRequestPhase = "Acknowledged"
+	// MessageRequestDeferred indicates that the delivery to some receivers has
+	// been deferred by their notification preferences.
+	MessageRequestDeferred MessageRequestPhase = "Deferred"
 )
 
 // +genclient
@@ -428,6 +706,25 @@ type MessageSpec struct {
 	ReceiverChannelName string
 	// +optional
 	ClusterID string
+	// WebhookRequest records the request sent to the webhook server.
+	// +optional
+	WebhookRequest *WebhookRequest
+	// MessageRequestName is the name of the message request which sent the
+	// message, the message request is in the namespace of ReceiverChannelName.
+	// +optional
+	MessageRequestName string
+}
+
+// WebhookRequest describes a request sent to the webhook server.
+type WebhookRequest struct {
+	Method string
+	URL    string
+	// Headers are the headers of the request, the values of the headers of
+	// the channel are redacted as they may hold credentials.
+	// +optional
+	Headers map[string]string
+	// +optional
+	Body string
 }
 
 // MessageStatus represents information about the status of a message.
@@ -440,8 +737,47 @@ type MessageStatus struct {
 
 	// alert's status in notification sending from alertmanager
 	AlertStatus string
+	// The time at which the message was acknowledged.
+	// +optional
+	AcknowledgedTime metav1.Time
+	// The name of the user who acknowledged the message.
+	// +optional
+	AcknowledgedBy string
+	// DeliveryState indicates how the message was delivered, empty means the
+	// message was delivered immediately.
+	// +optional
+	DeliveryState MessageDeliveryState
+	// A human readable message indicating why the delivery was deferred.
+	// +optional
+	DeliveryReason string
+	// The time at which the delivery was deferred.
+	// +optional
+	DeferredTime metav1.Time
+	// DigestMessageName is the name of the digest message which the message
+	// has been merged into.
+	// +optional
+	DigestMessageName string
 }
 
+// MessageDeliveryState indicates how a message was delivered.
+type MessageDeliveryState string
+
+// These are valid delivery states of message.
+const (
+	// MessageDeliveryDeferred indicates that the message was delivered after
+	// being deferred by quiet hours or rate limits.
+	MessageDeliveryDeferred MessageDeliveryState = "Deferred"
+	// MessageDeliveryDigestPending indicates that the message is waiting to be
+	// merged into the next digest.
+	MessageDeliveryDigestPending MessageDeliveryState = "DigestPending"
+	// MessageDeliveryDigested indicates that the message has been merged into
+	// a digest.
+	MessageDeliveryDigested MessageDeliveryState = "Digested"
+	// MessageDeliveryDigest indicates that the message is a digest merging
+	// other messages.
+	MessageDeliveryDigest MessageDeliveryState = "Digest"
+)
+
 // MessagePhase indicates the status of message.
 type MessagePhase string
 
@@ -451,6 +787,9 @@ const (
 	MessageUnread MessagePhase = "Unread"
 	// MessageRead indicates that the recipient has read the message.
 	MessageRead MessagePhase = "Read"
+	// MessageAcknowledged indicates that the recipient has acknowledged the
+	// message, which stops the escalation of its message request.
+	MessageAcknowledged MessagePhase = "Acknowledged"
 )
 
 // +genclient
@@ -494,3 +833,312 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Silence suppresses the alerts matched by its matchers during the specified
+// time range before any message request is created for them.
+type Silence struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired silence.
+	// +optional
+	Spec SilenceSpec
+	// +optional
+	Status SilenceStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SilenceList is the whole list of all silences which owned by a tenant.
+type SilenceList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of silences.
+	Items []Silence
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallSchedule describes who is on call at any time by rotating receivers
+// in layers.
+type OnCallSchedule struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired on-call schedule.
+	// +optional
+	Spec OnCallScheduleSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallScheduleList is the whole list of all on-call schedules which owned by a tenant.
+type OnCallScheduleList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of on-call schedules.
+	Items []OnCallSchedule
+}
+
+// OnCallScheduleSpec is a description of an on-call schedule.
+type OnCallScheduleSpec struct {
+	TenantID    string
+	DisplayName string
+	// TimeZone is the IANA time zone name in which the hand-offs and the
+	// restrictions of the layers are evaluated, defaults to UTC.
+	// +optional
+	TimeZone string
+	// Layers are evaluated from the last to the first, the receiver of the
+	// last layer which is active at the time is on call.
+	Layers []OnCallLayer
+	// Overrides take precedence over all of the layers.
+	// +optional
+	Overrides []OnCallOverride
+}
+
+// OnCallLayer rotates the on-call duty among the receivers.
+type OnCallLayer struct {
+	Name string
+	// Receivers are the names of the receivers in rotation order.
+	Receivers []string
+	// Start is the time at which the first receiver starts the duty, the
+	// hand-offs happen at the same local time of day.
+	Start        metav1.Time
+	RotationType OnCallRotationType
+	// ShiftLength is the number of rotation units of one shift, defaults to 1.
+	// +optional
+	ShiftLength int32
+	// Restrictions limit the layer to the given time windows of each day, the
+	// layer is always active if it is empty.
+	// +optional
+	Restrictions []OnCallRestriction
+}
+
+// OnCallRotationType indicates the unit of the rotation.
+type OnCallRotationType string
+
+// These are valid rotation types of on-call layer.
+const (
+	// OnCallRotationHourly hands off the duty every ShiftLength hours.
+	OnCallRotationHourly OnCallRotationType = "Hourly"
+	// OnCallRotationDaily hands off the duty every ShiftLength days.
+	OnCallRotationDaily OnCallRotationType = "Daily"
+	// OnCallRotationWeekly hands off the duty every ShiftLength weeks.
+	OnCallRotationWeekly OnCallRotationType = "Weekly"
+)
+
+// OnCallRestriction is a daily time window in the time zone of the schedule,
+// the window crosses midnight if the end time is not after the start time.
+type OnCallRestriction struct {
+	// StartTime is the local time of day in the format of HH:MM.
+	StartTime string
+	// EndTime is the local time of day in the format of HH:MM.
+	EndTime string
+}
+
+// OnCallOverride puts the receiver on call during the time range regardless
+// of the layers.
+type OnCallOverride struct {
+	Receiver string
+	StartsAt metav1.Time
+	EndsAt   metav1.Time
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EscalationPolicy describes who to notify in turn until a message is
+// acknowledged.
+type EscalationPolicy struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired escalation policy.
+	// +optional
+	Spec EscalationPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EscalationPolicyList is the whole list of all escalation policies which owned by a tenant.
+type EscalationPolicyList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of escalation policies.
+	Items []EscalationPolicy
+}
+
+// EscalationPolicySpec is a description of an escalation policy.
+type EscalationPolicySpec struct {
+	TenantID    string
+	DisplayName string
+	// Rules are the escalation levels, the first rule is notified when the
+	// message is sent.
+	Rules []EscalationRule
+}
+
+// EscalationRule is a level of the escalation policy.
+type EscalationRule struct {
+	// DelayMinutes is how long to wait for an acknowledgement before
+	// escalating to the next rule.
+	// +optional
+	DelayMinutes int32
+	Targets      []EscalationTarget
+}
+
+// EscalationTarget references the receivers notified by an escalation rule.
+type EscalationTarget struct {
+	Kind EscalationTargetKind
+	Name string
+}
+
+// EscalationTargetKind indicates the kind of escalation target.
+type EscalationTargetKind string
+
+// These are valid kinds of escalation target.
+const (
+	// EscalationTargetReceiver notifies the receiver.
+	EscalationTargetReceiver EscalationTargetKind = "Receiver"
+	// EscalationTargetReceiverGroup notifies the static receivers of the
+	// receiver group, its escalation policy is ignored.
+	EscalationTargetReceiverGroup EscalationTargetKind = "ReceiverGroup"
+	// EscalationTargetOnCallSchedule notifies the receivers currently on call.
+	EscalationTargetOnCallSchedule EscalationTargetKind = "OnCallSchedule"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditRule creates a message request for every audit event matched by it
+// when the event is stored by the audit server.
+type AuditRule struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired audit rule.
+	// +optional
+	Spec AuditRuleSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditRuleList is the whole list of all audit rules which owned by a tenant.
+type AuditRuleList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of audit rules.
+	Items []AuditRule
+}
+
+// AuditRuleSpec is a description of an audit rule.
+type AuditRuleSpec struct {
+	TenantID    string
+	DisplayName string
+	// Disabled stops the rule from matching any event.
+	// +optional
+	Disabled bool
+	Match    AuditRuleMatch
+	// ChannelName is the channel of the message requests, which are created
+	// in the namespace named after it.
+	ChannelName  string
+	TemplateName string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
+}
+
+// AuditRuleMatch selects the audit events of the tenant of the rule by their
+// fields. An event belongs to the tenant of its user, or of its cluster if the
+// user has no tenant. An empty list matches any value, and a value of a list
+// may use * to match any characters.
+type AuditRuleMatch struct {
+	// +optional
+	Users []string
+	// +optional
+	Verbs []string
+	// Resources are the resources of the events, a subresource is matched as
+	// the resource followed by a slash and the subresource, such as pods/exec.
+	// +optional
+	Resources []string
+	// Clusters are the names of the clusters of the tenant, which do not use *.
+	// +optional
+	Clusters []string
+	// +optional
+	Namespaces []string
+	// Codes are the response codes of the events.
+	// +optional
+	Codes []int32
+}
+
+// SilenceSpec is a description of a silence.
+type SilenceSpec struct {
+	TenantID string
+	// Matchers are ANDed together, an alert is silenced only if all of them
+	// match its labels.
+	Matchers []SilenceMatcher
+	// StartsAt is the time from which the silence takes effect.
+	StartsAt metav1.Time
+	// EndsAt is the time after which the silence no longer takes effect.
+	EndsAt metav1.Time
+	// CreatedBy is the name of the user who created the silence.
+	// +optional
+	CreatedBy string
+	// +optional
+	Comment string
+}
+
+// SilenceMatcher matches an alert label by its value.
+type SilenceMatcher struct {
+	// Name is the name of the alert label.
+	Name string
+	// Value is the literal value or the regular expression, depending on the operator.
+	Value string
+	// +optional
+	Operator SilenceMatchOperator
+}
+
+// SilenceMatchOperator indicates how a silence matcher compares label values.
+type SilenceMatchOperator string
+
+// These are valid operators of silence matcher.
+const (
+	// SilenceMatchEqual matches labels equal to the value.
+	SilenceMatchEqual SilenceMatchOperator = "="
+	// SilenceMatchNotEqual matches labels not equal to the value.
+	SilenceMatchNotEqual SilenceMatchOperator = "!="
+	// SilenceMatchRegexp matches labels fully matching the regular expression.
+	SilenceMatchRegexp SilenceMatchOperator = "=~"
+	// SilenceMatchNotRegexp matches labels not fully matching the regular expression.
+	SilenceMatchNotRegexp SilenceMatchOperator = "!~"
+)
+
+// SilenceStatus represents information about the status of a silence.
+type SilenceStatus struct {
+	// SuppressedCount is the number of alerts suppressed by the silence.
+	// +optional
+	SuppressedCount int64
+	// The last time an alert was suppressed by the silence.
+	// +optional
+	LastSuppressedTime metav1.Time
+}
@@ -32,6 +32,10 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForReceiverGroup,
 		AddFieldLabelConversionsForMessageRequest,
 		AddFieldLabelConversionsForMessage,
+		AddFieldLabelConversionsForSilence,
+		AddFieldLabelConversionsForOnCallSchedule,
+		AddFieldLabelConversionsForEscalationPolicy,
+		AddFieldLabelConversionsForAuditRule,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -142,6 +146,8 @@ func AddFieldLabelConversionsForMessage(scheme *runtime.Scheme) error {
 				"spec.alarmPolicyType",
 				"spec.receiverChannelName",
 				"spec.clusterID",
+				"spec.messageRequestName",
+				"status.deliveryState",
 				"status.alertStatus":
 				return label, value, nil
 			default:
@@ -149,3 +155,68 @@ func AddFieldLabelConversionsForMessage(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForSilence adds a conversion function to convert
+// field selectors of Silence from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForSilence(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Silence"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.createdBy",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForOnCallSchedule adds a conversion function to convert
+// field selectors of OnCallSchedule from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForOnCallSchedule(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("OnCallSchedule"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForEscalationPolicy adds a conversion function to convert
+// field selectors of EscalationPolicy from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForEscalationPolicy(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("EscalationPolicy"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForAuditRule adds a conversion function to convert
+// field selectors of AuditRule from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForAuditRule(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("AuditRule"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -45,10 +45,122 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *AuditRule) Reset()      { *m = AuditRule{} }
+func (*AuditRule) ProtoMessage() {}
+func (*AuditRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{0}
+}
+func (m *AuditRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRule.Merge(m, src)
+}
+func (m *AuditRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRule proto.InternalMessageInfo
+
+func (m *AuditRuleList) Reset()      { *m = AuditRuleList{} }
+func (*AuditRuleList) ProtoMessage() {}
+func (*AuditRuleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{1}
+}
+func (m *AuditRuleList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRuleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditRuleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRuleList.Merge(m, src)
+}
+func (m *AuditRuleList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRuleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRuleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRuleList proto.InternalMessageInfo
+
+func (m *AuditRuleMatch) Reset()      { *m = AuditRuleMatch{} }
+func (*AuditRuleMatch) ProtoMessage() {}
+func (*AuditRuleMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{2}
+}
+func (m *AuditRuleMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRuleMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditRuleMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRuleMatch.Merge(m, src)
+}
+func (m *AuditRuleMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRuleMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRuleMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRuleMatch proto.InternalMessageInfo
+
+func (m *AuditRuleSpec) Reset()      { *m = AuditRuleSpec{} }
+func (*AuditRuleSpec) ProtoMessage() {}
+func (*AuditRuleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{3}
+}
+func (m *AuditRuleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRuleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AuditRuleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRuleSpec.Merge(m, src)
+}
+func (m *AuditRuleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRuleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRuleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRuleSpec proto.InternalMessageInfo
+
 func (m *Channel) Reset()      { *m = Channel{} }
 func (*Channel) ProtoMessage() {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{0}
+	return fileDescriptor_1fbd89bf08e8a478, []int{4}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -73,10 +185,66 @@ func (m *Channel) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Channel proto.InternalMessageInfo
 
+func (m *ChannelDingTalk) Reset()      { *m = ChannelDingTalk{} }
+func (*ChannelDingTalk) ProtoMessage() {}
+func (*ChannelDingTalk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{5}
+}
+func (m *ChannelDingTalk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDingTalk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChannelDingTalk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDingTalk.Merge(m, src)
+}
+func (m *ChannelDingTalk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDingTalk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDingTalk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDingTalk proto.InternalMessageInfo
+
+func (m *ChannelLark) Reset()      { *m = ChannelLark{} }
+func (*ChannelLark) ProtoMessage() {}
+func (*ChannelLark) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{6}
+}
+func (m *ChannelLark) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelLark) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChannelLark) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelLark.Merge(m, src)
+}
+func (m *ChannelLark) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelLark) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelLark.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelLark proto.InternalMessageInfo
+
 func (m *ChannelList) Reset()      { *m = ChannelList{} }
 func (*ChannelList) ProtoMessage() {}
 func (*ChannelList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{1}
+	return fileDescriptor_1fbd89bf08e8a478, []int{7}
 }
 func (m *ChannelList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -104,7 +272,7 @@ var xxx_messageInfo_ChannelList proto.InternalMessageInfo
 func (m *ChannelSMTP) Reset()      { *m = ChannelSMTP{} }
 func (*ChannelSMTP) ProtoMessage() {}
 func (*ChannelSMTP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{2}
+	return fileDescriptor_1fbd89bf08e8a478, []int{8}
 }
 func (m *ChannelSMTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,10 +297,38 @@ func (m *ChannelSMTP) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChannelSMTP proto.InternalMessageInfo
 
+func (m *ChannelSlack) Reset()      { *m = ChannelSlack{} }
+func (*ChannelSlack) ProtoMessage() {}
+func (*ChannelSlack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{9}
+}
+func (m *ChannelSlack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelSlack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChannelSlack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSlack.Merge(m, src)
+}
+func (m *ChannelSlack) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelSlack) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSlack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelSlack proto.InternalMessageInfo
+
 func (m *ChannelSpec) Reset()      { *m = ChannelSpec{} }
 func (*ChannelSpec) ProtoMessage() {}
 func (*ChannelSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{3}
+	return fileDescriptor_1fbd89bf08e8a478, []int{10}
 }
 func (m *ChannelSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -160,7 +356,7 @@ var xxx_messageInfo_ChannelSpec proto.InternalMessageInfo
 func (m *ChannelStatus) Reset()      { *m = ChannelStatus{} }
 func (*ChannelStatus) ProtoMessage() {}
 func (*ChannelStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{4}
+	return fileDescriptor_1fbd89bf08e8a478, []int{11}
 }
 func (m *ChannelStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +384,7 @@ var xxx_messageInfo_ChannelStatus proto.InternalMessageInfo
 func (m *ChannelTencentCloudSMS) Reset()      { *m = ChannelTencentCloudSMS{} }
 func (*ChannelTencentCloudSMS) ProtoMessage() {}
 func (*ChannelTencentCloudSMS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{5}
+	return fileDescriptor_1fbd89bf08e8a478, []int{12}
 }
 func (m *ChannelTencentCloudSMS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -213,10 +409,38 @@ func (m *ChannelTencentCloudSMS) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ChannelTencentCloudSMS proto.InternalMessageInfo
 
+func (m *ChannelWeCom) Reset()      { *m = ChannelWeCom{} }
+func (*ChannelWeCom) ProtoMessage() {}
+func (*ChannelWeCom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{13}
+}
+func (m *ChannelWeCom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelWeCom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChannelWeCom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelWeCom.Merge(m, src)
+}
+func (m *ChannelWeCom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelWeCom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelWeCom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelWeCom proto.InternalMessageInfo
+
 func (m *ChannelWebhook) Reset()      { *m = ChannelWebhook{} }
 func (*ChannelWebhook) ProtoMessage() {}
 func (*ChannelWebhook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{6}
+	return fileDescriptor_1fbd89bf08e8a478, []int{14}
 }
 func (m *ChannelWebhook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,7 +468,7 @@ var xxx_messageInfo_ChannelWebhook proto.InternalMessageInfo
 func (m *ChannelWechat) Reset()      { *m = ChannelWechat{} }
 func (*ChannelWechat) ProtoMessage() {}
 func (*ChannelWechat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{7}
+	return fileDescriptor_1fbd89bf08e8a478, []int{15}
 }
 func (m *ChannelWechat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +496,7 @@ var xxx_messageInfo_ChannelWechat proto.InternalMessageInfo
 func (m *ConfigMap) Reset()      { *m = ConfigMap{} }
 func (*ConfigMap) ProtoMessage() {}
 func (*ConfigMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{8}
+	return fileDescriptor_1fbd89bf08e8a478, []int{16}
 }
 func (m *ConfigMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -300,7 +524,7 @@ var xxx_messageInfo_ConfigMap proto.InternalMessageInfo
 func (m *ConfigMapList) Reset()      { *m = ConfigMapList{} }
 func (*ConfigMapList) ProtoMessage() {}
 func (*ConfigMapList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{9}
+	return fileDescriptor_1fbd89bf08e8a478, []int{17}
 }
 func (m *ConfigMapList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,15 +549,15 @@ func (m *ConfigMapList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfigMapList proto.InternalMessageInfo
 
-func (m *Message) Reset()      { *m = Message{} }
-func (*Message) ProtoMessage() {}
-func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{10}
+func (m *EscalationPolicy) Reset()      { *m = EscalationPolicy{} }
+func (*EscalationPolicy) ProtoMessage() {}
+func (*EscalationPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{18}
 }
-func (m *Message) XXX_Unmarshal(b []byte) error {
+func (m *EscalationPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscalationPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -341,27 +565,27 @@ func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Message.Merge(m, src)
+func (m *EscalationPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalationPolicy.Merge(m, src)
 }
-func (m *Message) XXX_Size() int {
+func (m *EscalationPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_Message.DiscardUnknown(m)
+func (m *EscalationPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalationPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Message proto.InternalMessageInfo
+var xxx_messageInfo_EscalationPolicy proto.InternalMessageInfo
 
-func (m *MessageList) Reset()      { *m = MessageList{} }
-func (*MessageList) ProtoMessage() {}
-func (*MessageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{11}
+func (m *EscalationPolicyList) Reset()      { *m = EscalationPolicyList{} }
+func (*EscalationPolicyList) ProtoMessage() {}
+func (*EscalationPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{19}
 }
-func (m *MessageList) XXX_Unmarshal(b []byte) error {
+func (m *EscalationPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscalationPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -369,27 +593,27 @@ func (m *MessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *MessageList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageList.Merge(m, src)
+func (m *EscalationPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalationPolicyList.Merge(m, src)
 }
-func (m *MessageList) XXX_Size() int {
+func (m *EscalationPolicyList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageList.DiscardUnknown(m)
+func (m *EscalationPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalationPolicyList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageList proto.InternalMessageInfo
+var xxx_messageInfo_EscalationPolicyList proto.InternalMessageInfo
 
-func (m *MessageRequest) Reset()      { *m = MessageRequest{} }
-func (*MessageRequest) ProtoMessage() {}
-func (*MessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{12}
+func (m *EscalationPolicySpec) Reset()      { *m = EscalationPolicySpec{} }
+func (*EscalationPolicySpec) ProtoMessage() {}
+func (*EscalationPolicySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{20}
 }
-func (m *MessageRequest) XXX_Unmarshal(b []byte) error {
+func (m *EscalationPolicySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscalationPolicySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -397,27 +621,27 @@ func (m *MessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (m *MessageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageRequest.Merge(m, src)
+func (m *EscalationPolicySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalationPolicySpec.Merge(m, src)
 }
-func (m *MessageRequest) XXX_Size() int {
+func (m *EscalationPolicySpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageRequest.DiscardUnknown(m)
+func (m *EscalationPolicySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalationPolicySpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageRequest proto.InternalMessageInfo
+var xxx_messageInfo_EscalationPolicySpec proto.InternalMessageInfo
 
-func (m *MessageRequestList) Reset()      { *m = MessageRequestList{} }
-func (*MessageRequestList) ProtoMessage() {}
-func (*MessageRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{13}
+func (m *EscalationRule) Reset()      { *m = EscalationRule{} }
+func (*EscalationRule) ProtoMessage() {}
+func (*EscalationRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{21}
 }
-func (m *MessageRequestList) XXX_Unmarshal(b []byte) error {
+func (m *EscalationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscalationRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -425,27 +649,27 @@ func (m *MessageRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 	return b[:n], nil
 }
-func (m *MessageRequestList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageRequestList.Merge(m, src)
+func (m *EscalationRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalationRule.Merge(m, src)
 }
-func (m *MessageRequestList) XXX_Size() int {
+func (m *EscalationRule) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageRequestList) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageRequestList.DiscardUnknown(m)
+func (m *EscalationRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalationRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageRequestList proto.InternalMessageInfo
+var xxx_messageInfo_EscalationRule proto.InternalMessageInfo
 
-func (m *MessageRequestSpec) Reset()      { *m = MessageRequestSpec{} }
-func (*MessageRequestSpec) ProtoMessage() {}
-func (*MessageRequestSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{14}
+func (m *EscalationTarget) Reset()      { *m = EscalationTarget{} }
+func (*EscalationTarget) ProtoMessage() {}
+func (*EscalationTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{22}
 }
-func (m *MessageRequestSpec) XXX_Unmarshal(b []byte) error {
+func (m *EscalationTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EscalationTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -453,27 +677,27 @@ func (m *MessageRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 	return b[:n], nil
 }
-func (m *MessageRequestSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageRequestSpec.Merge(m, src)
+func (m *EscalationTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalationTarget.Merge(m, src)
 }
-func (m *MessageRequestSpec) XXX_Size() int {
+func (m *EscalationTarget) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageRequestSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageRequestSpec.DiscardUnknown(m)
+func (m *EscalationTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalationTarget.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageRequestSpec proto.InternalMessageInfo
+var xxx_messageInfo_EscalationTarget proto.InternalMessageInfo
 
-func (m *MessageRequestStatus) Reset()      { *m = MessageRequestStatus{} }
-func (*MessageRequestStatus) ProtoMessage() {}
-func (*MessageRequestStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{15}
+func (m *Message) Reset()      { *m = Message{} }
+func (*Message) ProtoMessage() {}
+func (*Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{23}
 }
-func (m *MessageRequestStatus) XXX_Unmarshal(b []byte) error {
+func (m *Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -481,27 +705,27 @@ func (m *MessageRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte
 	}
 	return b[:n], nil
 }
-func (m *MessageRequestStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageRequestStatus.Merge(m, src)
+func (m *Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Message.Merge(m, src)
 }
-func (m *MessageRequestStatus) XXX_Size() int {
+func (m *Message) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageRequestStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageRequestStatus.DiscardUnknown(m)
+func (m *Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_Message.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageRequestStatus proto.InternalMessageInfo
+var xxx_messageInfo_Message proto.InternalMessageInfo
 
-func (m *MessageSpec) Reset()      { *m = MessageSpec{} }
-func (*MessageSpec) ProtoMessage() {}
-func (*MessageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{16}
+func (m *MessageList) Reset()      { *m = MessageList{} }
+func (*MessageList) ProtoMessage() {}
+func (*MessageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{24}
 }
-func (m *MessageSpec) XXX_Unmarshal(b []byte) error {
+func (m *MessageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -509,27 +733,27 @@ func (m *MessageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *MessageSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageSpec.Merge(m, src)
+func (m *MessageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageList.Merge(m, src)
 }
-func (m *MessageSpec) XXX_Size() int {
+func (m *MessageList) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageSpec.DiscardUnknown(m)
+func (m *MessageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageSpec proto.InternalMessageInfo
+var xxx_messageInfo_MessageList proto.InternalMessageInfo
 
-func (m *MessageStatus) Reset()      { *m = MessageStatus{} }
-func (*MessageStatus) ProtoMessage() {}
-func (*MessageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{17}
+func (m *MessageRequest) Reset()      { *m = MessageRequest{} }
+func (*MessageRequest) ProtoMessage() {}
+func (*MessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{25}
 }
-func (m *MessageStatus) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -537,27 +761,27 @@ func (m *MessageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *MessageStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageStatus.Merge(m, src)
+func (m *MessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequest.Merge(m, src)
 }
-func (m *MessageStatus) XXX_Size() int {
+func (m *MessageRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageStatus.DiscardUnknown(m)
+func (m *MessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageStatus proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequest proto.InternalMessageInfo
 
-func (m *Receiver) Reset()      { *m = Receiver{} }
-func (*Receiver) ProtoMessage() {}
-func (*Receiver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{18}
+func (m *MessageRequestDeferralStatus) Reset()      { *m = MessageRequestDeferralStatus{} }
+func (*MessageRequestDeferralStatus) ProtoMessage() {}
+func (*MessageRequestDeferralStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{26}
 }
-func (m *Receiver) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestDeferralStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Receiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestDeferralStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -565,27 +789,27 @@ func (m *Receiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Receiver) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Receiver.Merge(m, src)
+func (m *MessageRequestDeferralStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestDeferralStatus.Merge(m, src)
 }
-func (m *Receiver) XXX_Size() int {
+func (m *MessageRequestDeferralStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *Receiver) XXX_DiscardUnknown() {
-	xxx_messageInfo_Receiver.DiscardUnknown(m)
+func (m *MessageRequestDeferralStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestDeferralStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Receiver proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestDeferralStatus proto.InternalMessageInfo
 
-func (m *ReceiverGroup) Reset()      { *m = ReceiverGroup{} }
-func (*ReceiverGroup) ProtoMessage() {}
-func (*ReceiverGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{19}
+func (m *MessageRequestEscalationStatus) Reset()      { *m = MessageRequestEscalationStatus{} }
+func (*MessageRequestEscalationStatus) ProtoMessage() {}
+func (*MessageRequestEscalationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{27}
 }
-func (m *ReceiverGroup) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestEscalationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReceiverGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestEscalationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -593,27 +817,27 @@ func (m *ReceiverGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *ReceiverGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiverGroup.Merge(m, src)
+func (m *MessageRequestEscalationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestEscalationStatus.Merge(m, src)
 }
-func (m *ReceiverGroup) XXX_Size() int {
+func (m *MessageRequestEscalationStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReceiverGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiverGroup.DiscardUnknown(m)
+func (m *MessageRequestEscalationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestEscalationStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiverGroup proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestEscalationStatus proto.InternalMessageInfo
 
-func (m *ReceiverGroupList) Reset()      { *m = ReceiverGroupList{} }
-func (*ReceiverGroupList) ProtoMessage() {}
-func (*ReceiverGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{20}
+func (m *MessageRequestList) Reset()      { *m = MessageRequestList{} }
+func (*MessageRequestList) ProtoMessage() {}
+func (*MessageRequestList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{28}
 }
-func (m *ReceiverGroupList) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReceiverGroupList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -621,27 +845,27 @@ func (m *ReceiverGroupList) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (m *ReceiverGroupList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiverGroupList.Merge(m, src)
+func (m *MessageRequestList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestList.Merge(m, src)
 }
-func (m *ReceiverGroupList) XXX_Size() int {
+func (m *MessageRequestList) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReceiverGroupList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiverGroupList.DiscardUnknown(m)
+func (m *MessageRequestList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiverGroupList proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestList proto.InternalMessageInfo
 
-func (m *ReceiverGroupSpec) Reset()      { *m = ReceiverGroupSpec{} }
-func (*ReceiverGroupSpec) ProtoMessage() {}
-func (*ReceiverGroupSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{21}
+func (m *MessageRequestRetryStatus) Reset()      { *m = MessageRequestRetryStatus{} }
+func (*MessageRequestRetryStatus) ProtoMessage() {}
+func (*MessageRequestRetryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{29}
 }
-func (m *ReceiverGroupSpec) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestRetryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReceiverGroupSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestRetryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -649,27 +873,27 @@ func (m *ReceiverGroupSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 	}
 	return b[:n], nil
 }
-func (m *ReceiverGroupSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiverGroupSpec.Merge(m, src)
+func (m *MessageRequestRetryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestRetryStatus.Merge(m, src)
 }
-func (m *ReceiverGroupSpec) XXX_Size() int {
+func (m *MessageRequestRetryStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReceiverGroupSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiverGroupSpec.DiscardUnknown(m)
+func (m *MessageRequestRetryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestRetryStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiverGroupSpec proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestRetryStatus proto.InternalMessageInfo
 
-func (m *ReceiverList) Reset()      { *m = ReceiverList{} }
-func (*ReceiverList) ProtoMessage() {}
-func (*ReceiverList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{22}
+func (m *MessageRequestSpec) Reset()      { *m = MessageRequestSpec{} }
+func (*MessageRequestSpec) ProtoMessage() {}
+func (*MessageRequestSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{30}
 }
-func (m *ReceiverList) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReceiverList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -677,27 +901,27 @@ func (m *ReceiverList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ReceiverList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiverList.Merge(m, src)
+func (m *MessageRequestSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestSpec.Merge(m, src)
 }
-func (m *ReceiverList) XXX_Size() int {
+func (m *MessageRequestSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReceiverList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiverList.DiscardUnknown(m)
+func (m *MessageRequestSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiverList proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestSpec proto.InternalMessageInfo
 
-func (m *ReceiverSpec) Reset()      { *m = ReceiverSpec{} }
-func (*ReceiverSpec) ProtoMessage() {}
-func (*ReceiverSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{23}
+func (m *MessageRequestStatus) Reset()      { *m = MessageRequestStatus{} }
+func (*MessageRequestStatus) ProtoMessage() {}
+func (*MessageRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{31}
 }
-func (m *ReceiverSpec) XXX_Unmarshal(b []byte) error {
+func (m *MessageRequestStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReceiverSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -705,27 +929,27 @@ func (m *ReceiverSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ReceiverSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiverSpec.Merge(m, src)
+func (m *MessageRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageRequestStatus.Merge(m, src)
 }
-func (m *ReceiverSpec) XXX_Size() int {
+func (m *MessageRequestStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReceiverSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiverSpec.DiscardUnknown(m)
+func (m *MessageRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageRequestStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiverSpec proto.InternalMessageInfo
+var xxx_messageInfo_MessageRequestStatus proto.InternalMessageInfo
 
-func (m *Template) Reset()      { *m = Template{} }
-func (*Template) ProtoMessage() {}
-func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{24}
+func (m *MessageSpec) Reset()      { *m = MessageSpec{} }
+func (*MessageSpec) ProtoMessage() {}
+func (*MessageSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{32}
 }
-func (m *Template) XXX_Unmarshal(b []byte) error {
+func (m *MessageSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -733,27 +957,27 @@ func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Template) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Template.Merge(m, src)
+func (m *MessageSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSpec.Merge(m, src)
 }
-func (m *Template) XXX_Size() int {
+func (m *MessageSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *Template) XXX_DiscardUnknown() {
-	xxx_messageInfo_Template.DiscardUnknown(m)
+func (m *MessageSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Template proto.InternalMessageInfo
+var xxx_messageInfo_MessageSpec proto.InternalMessageInfo
 
-func (m *TemplateList) Reset()      { *m = TemplateList{} }
-func (*TemplateList) ProtoMessage() {}
-func (*TemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{25}
+func (m *MessageStatus) Reset()      { *m = MessageStatus{} }
+func (*MessageStatus) ProtoMessage() {}
+func (*MessageStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{33}
 }
-func (m *TemplateList) XXX_Unmarshal(b []byte) error {
+func (m *MessageStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -761,27 +985,27 @@ func (m *TemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *TemplateList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateList.Merge(m, src)
+func (m *MessageStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageStatus.Merge(m, src)
 }
-func (m *TemplateList) XXX_Size() int {
+func (m *MessageStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *TemplateList) XXX_DiscardUnknown() {
-	xxx_messageInfo_TemplateList.DiscardUnknown(m)
+func (m *MessageStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TemplateList proto.InternalMessageInfo
+var xxx_messageInfo_MessageStatus proto.InternalMessageInfo
 
-func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
-func (*TemplateSpec) ProtoMessage() {}
-func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{26}
+func (m *OnCallLayer) Reset()      { *m = OnCallLayer{} }
+func (*OnCallLayer) ProtoMessage() {}
+func (*OnCallLayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{34}
 }
-func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
+func (m *OnCallLayer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OnCallLayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -789,27 +1013,27 @@ func (m *TemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *TemplateSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateSpec.Merge(m, src)
+func (m *OnCallLayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallLayer.Merge(m, src)
 }
-func (m *TemplateSpec) XXX_Size() int {
+func (m *OnCallLayer) XXX_Size() int {
 	return m.Size()
 }
-func (m *TemplateSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_TemplateSpec.DiscardUnknown(m)
+func (m *OnCallLayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallLayer.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
+var xxx_messageInfo_OnCallLayer proto.InternalMessageInfo
 
-func (m *TemplateTencentCloudSMS) Reset()      { *m = TemplateTencentCloudSMS{} }
-func (*TemplateTencentCloudSMS) ProtoMessage() {}
-func (*TemplateTencentCloudSMS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{27}
+func (m *OnCallOverride) Reset()      { *m = OnCallOverride{} }
+func (*OnCallOverride) ProtoMessage() {}
+func (*OnCallOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{35}
 }
-func (m *TemplateTencentCloudSMS) XXX_Unmarshal(b []byte) error {
+func (m *OnCallOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TemplateTencentCloudSMS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OnCallOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -817,27 +1041,27 @@ func (m *TemplateTencentCloudSMS) XXX_Marshal(b []byte, deterministic bool) ([]b
 	}
 	return b[:n], nil
 }
-func (m *TemplateTencentCloudSMS) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateTencentCloudSMS.Merge(m, src)
+func (m *OnCallOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallOverride.Merge(m, src)
 }
-func (m *TemplateTencentCloudSMS) XXX_Size() int {
+func (m *OnCallOverride) XXX_Size() int {
 	return m.Size()
 }
-func (m *TemplateTencentCloudSMS) XXX_DiscardUnknown() {
-	xxx_messageInfo_TemplateTencentCloudSMS.DiscardUnknown(m)
+func (m *OnCallOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallOverride.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TemplateTencentCloudSMS proto.InternalMessageInfo
+var xxx_messageInfo_OnCallOverride proto.InternalMessageInfo
 
-func (m *TemplateText) Reset()      { *m = TemplateText{} }
-func (*TemplateText) ProtoMessage() {}
-func (*TemplateText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{28}
+func (m *OnCallRestriction) Reset()      { *m = OnCallRestriction{} }
+func (*OnCallRestriction) ProtoMessage() {}
+func (*OnCallRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{36}
 }
-func (m *TemplateText) XXX_Unmarshal(b []byte) error {
+func (m *OnCallRestriction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TemplateText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OnCallRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -845,27 +1069,27 @@ func (m *TemplateText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *TemplateText) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateText.Merge(m, src)
+func (m *OnCallRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallRestriction.Merge(m, src)
 }
-func (m *TemplateText) XXX_Size() int {
+func (m *OnCallRestriction) XXX_Size() int {
 	return m.Size()
 }
-func (m *TemplateText) XXX_DiscardUnknown() {
-	xxx_messageInfo_TemplateText.DiscardUnknown(m)
+func (m *OnCallRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TemplateText proto.InternalMessageInfo
+var xxx_messageInfo_OnCallRestriction proto.InternalMessageInfo
 
-func (m *TemplateWechat) Reset()      { *m = TemplateWechat{} }
-func (*TemplateWechat) ProtoMessage() {}
-func (*TemplateWechat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1fbd89bf08e8a478, []int{29}
+func (m *OnCallSchedule) Reset()      { *m = OnCallSchedule{} }
+func (*OnCallSchedule) ProtoMessage() {}
+func (*OnCallSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{37}
 }
-func (m *TemplateWechat) XXX_Unmarshal(b []byte) error {
+func (m *OnCallSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TemplateWechat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OnCallSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -873,1234 +1097,1190 @@ func (m *TemplateWechat) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 	}
 	return b[:n], nil
 }
-func (m *TemplateWechat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TemplateWechat.Merge(m, src)
+func (m *OnCallSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallSchedule.Merge(m, src)
 }
-func (m *TemplateWechat) XXX_Size() int {
+func (m *OnCallSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *TemplateWechat) XXX_DiscardUnknown() {
-	xxx_messageInfo_TemplateWechat.DiscardUnknown(m)
+func (m *OnCallSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TemplateWechat proto.InternalMessageInfo
+var xxx_messageInfo_OnCallSchedule proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Channel)(nil), "tkestack.io.tke.api.notify.v1.Channel")
-	proto.RegisterType((*ChannelList)(nil), "tkestack.io.tke.api.notify.v1.ChannelList")
-	proto.RegisterType((*ChannelSMTP)(nil), "tkestack.io.tke.api.notify.v1.ChannelSMTP")
-	proto.RegisterType((*ChannelSpec)(nil), "tkestack.io.tke.api.notify.v1.ChannelSpec")
-	proto.RegisterType((*ChannelStatus)(nil), "tkestack.io.tke.api.notify.v1.ChannelStatus")
-	proto.RegisterType((*ChannelTencentCloudSMS)(nil), "tkestack.io.tke.api.notify.v1.ChannelTencentCloudSMS")
-	proto.RegisterType((*ChannelWebhook)(nil), "tkestack.io.tke.api.notify.v1.ChannelWebhook")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.ChannelWebhook.HeadersEntry")
-	proto.RegisterType((*ChannelWechat)(nil), "tkestack.io.tke.api.notify.v1.ChannelWechat")
-	proto.RegisterType((*ConfigMap)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap")
-	proto.RegisterMapType((map[string][]byte)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap.BinaryDataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap.DataEntry")
-	proto.RegisterType((*ConfigMapList)(nil), "tkestack.io.tke.api.notify.v1.ConfigMapList")
-	proto.RegisterType((*Message)(nil), "tkestack.io.tke.api.notify.v1.Message")
-	proto.RegisterType((*MessageList)(nil), "tkestack.io.tke.api.notify.v1.MessageList")
-	proto.RegisterType((*MessageRequest)(nil), "tkestack.io.tke.api.notify.v1.MessageRequest")
-	proto.RegisterType((*MessageRequestList)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestList")
-	proto.RegisterType((*MessageRequestSpec)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestSpec")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestSpec.VariablesEntry")
-	proto.RegisterType((*MessageRequestStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus.ErrorsEntry")
-	proto.RegisterType((*MessageSpec)(nil), "tkestack.io.tke.api.notify.v1.MessageSpec")
-	proto.RegisterType((*MessageStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageStatus")
-	proto.RegisterType((*Receiver)(nil), "tkestack.io.tke.api.notify.v1.Receiver")
-	proto.RegisterType((*ReceiverGroup)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroup")
-	proto.RegisterType((*ReceiverGroupList)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroupList")
-	proto.RegisterType((*ReceiverGroupSpec)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroupSpec")
-	proto.RegisterType((*ReceiverList)(nil), "tkestack.io.tke.api.notify.v1.ReceiverList")
-	proto.RegisterType((*ReceiverSpec)(nil), "tkestack.io.tke.api.notify.v1.ReceiverSpec")
-	proto.RegisterMapType((map[ReceiverChannel]string)(nil), "tkestack.io.tke.api.notify.v1.ReceiverSpec.IdentitiesEntry")
-	proto.RegisterType((*Template)(nil), "tkestack.io.tke.api.notify.v1.Template")
-	proto.RegisterType((*TemplateList)(nil), "tkestack.io.tke.api.notify.v1.TemplateList")
-	proto.RegisterType((*TemplateSpec)(nil), "tkestack.io.tke.api.notify.v1.TemplateSpec")
-	proto.RegisterType((*TemplateTencentCloudSMS)(nil), "tkestack.io.tke.api.notify.v1.TemplateTencentCloudSMS")
-	proto.RegisterType((*TemplateText)(nil), "tkestack.io.tke.api.notify.v1.TemplateText")
-	proto.RegisterType((*TemplateWechat)(nil), "tkestack.io.tke.api.notify.v1.TemplateWechat")
-}
-
-func init() {
-	proto.RegisterFile("tkestack.io/tke/api/notify/v1/generated.proto", fileDescriptor_1fbd89bf08e8a478)
+func (m *OnCallScheduleList) Reset()      { *m = OnCallScheduleList{} }
+func (*OnCallScheduleList) ProtoMessage() {}
+func (*OnCallScheduleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{38}
 }
-
-var fileDescriptor_1fbd89bf08e8a478 = []byte{
-	// 1944 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x4f, 0xfb, 0x23, 0x89, 0x9f, 0xf3, 0xb5, 0x95, 0x11, 0x6b, 0x19, 0xc6, 0x19, 0x19, 0x58,
-	0xa2, 0x9d, 0x49, 0x7b, 0x93, 0x65, 0x97, 0x21, 0xb0, 0x5a, 0xe2, 0x64, 0x60, 0xa2, 0x89, 0xc1,
-	0x5b, 0xf1, 0x02, 0x02, 0x0e, 0x54, 0xec, 0x1a, 0xbb, 0xb1, 0xdd, 0xdd, 0xdb, 0x5d, 0xce, 0x8c,
-	0x39, 0xa1, 0xfd, 0x07, 0x80, 0xcb, 0x1e, 0xe0, 0xca, 0x22, 0xf1, 0x1f, 0x20, 0x3e, 0x6e, 0x80,
-	0xe6, 0xb8, 0xe2, 0xb4, 0x12, 0x52, 0x60, 0x8c, 0xf8, 0x0b, 0xb8, 0xcd, 0x09, 0x55, 0x75, 0x75,
-	0xf5, 0x87, 0xd3, 0x93, 0x76, 0x84, 0xac, 0xdc, 0xdc, 0xef, 0xe3, 0x57, 0xaf, 0xde, 0xab, 0x57,
-	0xf5, 0xab, 0x32, 0xec, 0xb0, 0x3e, 0x75, 0x19, 0x69, 0xf7, 0x75, 0xc3, 0xaa, 0xb1, 0x3e, 0xad,
-	0x11, 0xdb, 0xa8, 0x99, 0x16, 0x33, 0x1e, 0x8f, 0x6b, 0xe7, 0xbb, 0xb5, 0x2e, 0x35, 0xa9, 0x43,
-	0x18, 0xed, 0xe8, 0xb6, 0x63, 0x31, 0x0b, 0xdd, 0x0e, 0x99, 0xeb, 0xac, 0x4f, 0x75, 0x62, 0x1b,
-	0xba, 0x67, 0xae, 0x9f, 0xef, 0x96, 0x77, 0xba, 0x06, 0xeb, 0x8d, 0xce, 0xf4, 0xb6, 0x35, 0xac,
-	0x75, 0xad, 0xae, 0x55, 0x13, 0x5e, 0x67, 0xa3, 0xc7, 0xe2, 0x4b, 0x7c, 0x88, 0x5f, 0x1e, 0x5a,
-	0xf9, 0xcb, 0xfd, 0xfb, 0x2e, 0x1f, 0x97, 0xd8, 0xc6, 0x90, 0xb4, 0x7b, 0x86, 0x49, 0x9d, 0x71,
-	0xcd, 0xee, 0x77, 0xb9, 0xc0, 0xad, 0x0d, 0x29, 0x23, 0x97, 0xc4, 0x50, 0xae, 0x25, 0x79, 0x39,
-	0x23, 0x93, 0x19, 0x43, 0x3a, 0xe5, 0xf0, 0xf6, 0x55, 0x0e, 0x6e, 0xbb, 0x47, 0x87, 0x24, 0xee,
-	0x57, 0xfd, 0x79, 0x06, 0x96, 0x0e, 0x7b, 0xc4, 0x34, 0xe9, 0x00, 0xfd, 0x18, 0x96, 0x79, 0x3c,
-	0x1d, 0xc2, 0x48, 0x49, 0xbb, 0xa3, 0x6d, 0x17, 0xf7, 0xde, 0xd0, 0x3d, 0x58, 0x3d, 0x0c, 0xab,
-	0xdb, 0xfd, 0x2e, 0x17, 0xb8, 0x3a, 0xb7, 0xd6, 0xcf, 0x77, 0xf5, 0xef, 0x9c, 0xfd, 0x84, 0xb6,
-	0x59, 0x83, 0x32, 0x52, 0x47, 0xcf, 0x2e, 0xb6, 0x16, 0x26, 0x17, 0x5b, 0x10, 0xc8, 0xb0, 0x42,
-	0x45, 0x27, 0x90, 0x73, 0x6d, 0xda, 0x2e, 0x65, 0x04, 0xfa, 0xeb, 0xfa, 0x4b, 0x33, 0xad, 0xcb,
-	0xb8, 0x4e, 0x6d, 0xda, 0xae, 0xaf, 0x48, 0xdc, 0x1c, 0xff, 0xc2, 0x02, 0x05, 0xb5, 0x60, 0xd1,
-	0x65, 0x84, 0x8d, 0xdc, 0x52, 0x56, 0xe0, 0xdd, 0x4b, 0x89, 0x27, 0x7c, 0xea, 0x6b, 0x12, 0x71,
-	0xd1, 0xfb, 0xc6, 0x12, 0xab, 0xfa, 0x7b, 0x0d, 0x8a, 0xd2, 0xf2, 0xc4, 0x70, 0x19, 0xfa, 0xd1,
-	0x54, 0x56, 0xf4, 0x74, 0x59, 0xe1, 0xde, 0x22, 0x27, 0x1b, 0x72, 0xa4, 0x65, 0x5f, 0x12, 0xca,
-	0xc8, 0x23, 0xc8, 0x1b, 0x8c, 0x0e, 0xdd, 0x52, 0xe6, 0x4e, 0x76, 0xbb, 0xb8, 0xf7, 0x5a, 0xba,
-	0x29, 0xd4, 0x57, 0x25, 0x64, 0xfe, 0x98, 0x3b, 0x63, 0x0f, 0xa3, 0xfa, 0x8f, 0x20, 0xf4, 0xd3,
-	0x46, 0xab, 0x89, 0xee, 0xc1, 0xb2, 0x3b, 0x64, 0xf6, 0x43, 0xcb, 0x65, 0x22, 0xf4, 0x42, 0x10,
-	0x0a, 0xd7, 0x73, 0x39, 0x56, 0x16, 0xbe, 0x75, 0xd3, 0x72, 0x98, 0x28, 0x50, 0x3e, 0x6a, 0xcd,
-	0xe5, 0x58, 0x59, 0xa0, 0xdb, 0x90, 0x65, 0x03, 0x2f, 0xf3, 0xcb, 0xf5, 0xa2, 0x34, 0xcc, 0xb6,
-	0x4e, 0x4e, 0x31, 0x97, 0xa3, 0xcf, 0x43, 0x9e, 0x0e, 0x89, 0x31, 0x28, 0xe5, 0xc4, 0xb8, 0x2a,
-	0xde, 0x07, 0x5c, 0x88, 0x3d, 0x1d, 0x1f, 0xd1, 0x26, 0xae, 0xfb, 0xc4, 0x72, 0x3a, 0xa5, 0x7c,
-	0x34, 0xbe, 0xa6, 0x94, 0x63, 0x65, 0x51, 0xfd, 0x75, 0x2e, 0x98, 0x1d, 0x2f, 0xff, 0xbb, 0x00,
-	0x8f, 0x0d, 0x93, 0x0c, 0x8c, 0x9f, 0x52, 0xc7, 0x2d, 0x69, 0x77, 0xb2, 0xdb, 0x85, 0xfa, 0x16,
-	0x5f, 0x7a, 0xdf, 0x54, 0xd2, 0x17, 0x17, 0x5b, 0xab, 0xea, 0xeb, 0xdb, 0x64, 0x48, 0x71, 0xc8,
-	0x85, 0x0f, 0xcf, 0xa8, 0x49, 0x4c, 0x76, 0x7c, 0x24, 0x26, 0x1c, 0x1a, 0xbe, 0x25, 0xe5, 0x58,
-	0x59, 0xa0, 0xb7, 0xa0, 0xd8, 0x31, 0x5c, 0x7b, 0x40, 0xc6, 0x1c, 0x48, 0x4c, 0xbc, 0x50, 0xdf,
-	0x94, 0x0e, 0xc5, 0xa3, 0x40, 0x85, 0xc3, 0x76, 0x88, 0xc1, 0x3a, 0xa3, 0x66, 0x9b, 0x9a, 0xec,
-	0x70, 0x60, 0x8d, 0x3a, 0xa7, 0x8d, 0x53, 0x91, 0x92, 0xe2, 0xde, 0x5b, 0xe9, 0x4a, 0xdd, 0x8a,
-	0x3a, 0xd7, 0x37, 0x27, 0x17, 0x5b, 0xeb, 0x31, 0x21, 0x8e, 0x0f, 0x81, 0x9a, 0xb0, 0xf8, 0x84,
-	0xb6, 0x7b, 0x84, 0x89, 0xbc, 0xa6, 0x6e, 0x8d, 0xef, 0x09, 0x9f, 0x3a, 0xf0, 0xb6, 0xf0, 0x7e,
-	0x63, 0x89, 0x83, 0x1e, 0x42, 0x8e, 0xd7, 0xbe, 0xb4, 0x38, 0x53, 0xeb, 0x36, 0x5a, 0xcd, 0xfa,
-	0xb2, 0x68, 0xdb, 0x46, 0xab, 0x89, 0x05, 0x02, 0x6a, 0xc1, 0xd2, 0x13, 0x7a, 0xd6, 0xb3, 0xac,
-	0x7e, 0x69, 0x49, 0x80, 0xed, 0xa4, 0x0d, 0x4e, 0x38, 0xd5, 0x8b, 0x93, 0x8b, 0xad, 0x25, 0xf9,
-	0x81, 0x7d, 0xa8, 0xea, 0x11, 0xac, 0x46, 0xfa, 0x1b, 0xbd, 0x09, 0x79, 0xbb, 0x47, 0x5c, 0xbf,
-	0x52, 0xb7, 0xfd, 0x15, 0xd8, 0xe4, 0xc2, 0x17, 0x17, 0x5b, 0x2b, 0xd2, 0x5c, 0x7c, 0x63, 0xcf,
-	0xb6, 0xfa, 0x91, 0x06, 0x9f, 0xb9, 0x3c, 0xf1, 0xe8, 0x35, 0x58, 0x24, 0xb6, 0xfd, 0x88, 0x8e,
-	0x65, 0x2b, 0xa9, 0xfd, 0xe3, 0x40, 0x48, 0xb1, 0xd4, 0x8a, 0x36, 0xea, 0xf4, 0x0f, 0x6c, 0x7b,
-	0x7a, 0x55, 0x9d, 0x4a, 0x39, 0x56, 0x16, 0x1c, 0x95, 0x3e, 0x65, 0xd4, 0xec, 0xc8, 0x30, 0x15,
-	0xea, 0x03, 0x21, 0xc5, 0x52, 0x5b, 0xfd, 0xbb, 0x06, 0x6b, 0xd1, 0x3c, 0xf0, 0x0e, 0x1c, 0x39,
-	0x03, 0x19, 0x8d, 0xea, 0xc0, 0xf7, 0xf1, 0x09, 0xe6, 0x72, 0x44, 0x61, 0xa9, 0x47, 0x49, 0x87,
-	0xf7, 0x86, 0xb7, 0xb7, 0xec, 0xcf, 0x94, 0x66, 0xfd, 0xa1, 0xe7, 0xfc, 0xc0, 0x64, 0xce, 0xb8,
-	0xbe, 0x2e, 0xe1, 0x97, 0xa4, 0x14, 0xfb, 0xd8, 0xe5, 0x7d, 0x58, 0x09, 0x5b, 0xa2, 0x0d, 0xc8,
-	0xf6, 0xfd, 0x1c, 0x61, 0xfe, 0x13, 0xdd, 0x82, 0xfc, 0x39, 0x19, 0x8c, 0xa8, 0x97, 0x0d, 0xec,
-	0x7d, 0xec, 0x67, 0xee, 0x6b, 0x55, 0xaa, 0x6a, 0xe6, 0x2d, 0x36, 0xbe, 0x6b, 0x10, 0x91, 0x38,
-	0x2d, 0xba, 0x6b, 0x78, 0x59, 0xf3, 0x74, 0xa8, 0x06, 0x05, 0x62, 0xdb, 0xa7, 0xb4, 0xed, 0x50,
-	0x26, 0x33, 0xfc, 0x8a, 0x34, 0x2c, 0x1c, 0xf8, 0x0a, 0x1c, 0xd8, 0x54, 0x7f, 0x97, 0x85, 0xc2,
-	0xa1, 0x65, 0x3e, 0x36, 0xba, 0x0d, 0x62, 0xcf, 0xe1, 0x94, 0x6b, 0x41, 0x4e, 0xa0, 0x7b, 0x69,
-	0xdf, 0xbb, 0x2a, 0xed, 0x7e, 0x64, 0xfa, 0x11, 0x61, 0xc4, 0x4b, 0xb7, 0x3a, 0xed, 0xb8, 0x08,
-	0x0b, 0x34, 0x34, 0x00, 0x38, 0x33, 0x4c, 0xe2, 0x8c, 0xb9, 0xac, 0x94, 0x15, 0xd8, 0xf7, 0x53,
-	0x63, 0xd7, 0x95, 0xab, 0x37, 0x82, 0x9a, 0x41, 0xa0, 0xc0, 0x21, 0xfc, 0xf2, 0x57, 0xa0, 0xa0,
-	0x8c, 0x67, 0xa9, 0x69, 0xf9, 0x1d, 0x58, 0x8f, 0x8d, 0x75, 0x95, 0xfb, 0x4a, 0x78, 0x49, 0xfc,
-	0x49, 0x83, 0x55, 0x15, 0xf5, 0x1c, 0xce, 0xdf, 0x46, 0xf4, 0xfc, 0xdd, 0x4e, 0x9b, 0xd0, 0x84,
-	0x13, 0x98, 0xd3, 0xa9, 0x06, 0x75, 0x5d, 0xd2, 0xa5, 0x37, 0x8e, 0x4e, 0xc9, 0xb8, 0xfe, 0x6f,
-	0x74, 0xca, 0xc7, 0xbb, 0x9a, 0x4e, 0x49, 0xcb, 0x9b, 0x47, 0xa7, 0x64, 0x60, 0x09, 0xc5, 0xfc,
-	0x4d, 0x06, 0xd6, 0xa4, 0x05, 0xa6, 0x1f, 0x8c, 0xa8, 0xcb, 0xe6, 0x50, 0xd3, 0xd3, 0x48, 0x4d,
-	0x77, 0xd3, 0x4d, 0x40, 0x86, 0x97, 0x58, 0xda, 0x1f, 0xc6, 0x4a, 0xfb, 0xe6, 0x6c, 0xb0, 0x2f,
-	0xaf, 0xf0, 0xdf, 0x34, 0x40, 0x51, 0x87, 0x39, 0x14, 0x1a, 0x47, 0x0b, 0xbd, 0x33, 0xd3, 0x84,
-	0x12, 0xea, 0xfd, 0x51, 0x36, 0x3e, 0x11, 0xc1, 0x33, 0xc3, 0x34, 0x51, 0xbb, 0x92, 0x26, 0xde,
-	0x87, 0x15, 0x46, 0x87, 0xf6, 0x80, 0x30, 0x2a, 0x78, 0xa2, 0x77, 0x40, 0xdd, 0x92, 0x1e, 0x2b,
-	0xad, 0x90, 0x0e, 0x47, 0x2c, 0xd1, 0x5d, 0x28, 0x38, 0xb4, 0x4d, 0x8d, 0x73, 0x7e, 0x64, 0x67,
-	0x05, 0x9d, 0x5d, 0xe5, 0x67, 0x1a, 0xf6, 0x85, 0x38, 0xd0, 0xa3, 0x7d, 0x58, 0xf3, 0x3f, 0xbe,
-	0xe5, 0x58, 0x23, 0xdb, 0x2d, 0xe5, 0x84, 0x07, 0x9a, 0x5c, 0x6c, 0xad, 0xe1, 0x88, 0x06, 0xc7,
-	0x2c, 0xd1, 0x07, 0x50, 0x38, 0x27, 0x8e, 0x41, 0xce, 0x06, 0xd4, 0x2d, 0xe5, 0x45, 0xfe, 0xbe,
-	0x31, 0xf3, 0x3a, 0xd3, 0xbf, 0xeb, 0x43, 0x78, 0x07, 0x8a, 0x3a, 0x82, 0x95, 0x1c, 0x07, 0xa3,
-	0x94, 0xbf, 0x0e, 0x6b, 0x51, 0xfb, 0x99, 0x78, 0xc2, 0xc7, 0x59, 0xb8, 0x75, 0xd9, 0x92, 0x44,
-	0xfb, 0x3e, 0xc7, 0xf3, 0xea, 0xf2, 0x85, 0x38, 0xc7, 0xdb, 0x8c, 0x7a, 0x85, 0xa9, 0x1e, 0x3a,
-	0x07, 0x34, 0x20, 0x2e, 0x6b, 0x39, 0xc4, 0x74, 0x0d, 0x66, 0x58, 0x66, 0xcb, 0x90, 0xe5, 0xe2,
-	0x5b, 0x69, 0xaa, 0x95, 0xca, 0x3d, 0xea, 0x65, 0x39, 0x28, 0x3a, 0x99, 0x42, 0xc3, 0x97, 0x8c,
-	0x80, 0xba, 0xb0, 0x48, 0x1d, 0xc7, 0x92, 0x35, 0x2e, 0xee, 0xbd, 0x7b, 0x8d, 0x5e, 0xd4, 0x1f,
-	0x08, 0x04, 0x2f, 0xf3, 0x01, 0x65, 0x14, 0x42, 0x2c, 0xe1, 0xf9, 0x85, 0x85, 0x0c, 0xa8, 0x23,
-	0x5d, 0xe4, 0x45, 0x4c, 0x5d, 0x58, 0x0e, 0x02, 0x15, 0x0e, 0xdb, 0x95, 0xbf, 0x0a, 0xc5, 0x10,
-	0xfa, 0x4c, 0x75, 0xfa, 0x73, 0x5e, 0xed, 0xf5, 0xd7, 0xeb, 0x1c, 0x7f, 0xa1, 0x5e, 0xd6, 0x39,
-	0x38, 0xa4, 0xc3, 0x11, 0x4b, 0xd4, 0x82, 0x75, 0xff, 0x5b, 0xf2, 0x49, 0xc9, 0xa6, 0x5f, 0x97,
-	0xce, 0xeb, 0x38, 0xaa, 0x7e, 0x31, 0x2d, 0xc2, 0x71, 0x08, 0x1e, 0xbd, 0xd1, 0xa1, 0x26, 0x33,
-	0xd8, 0x58, 0x26, 0x4f, 0x45, 0x7f, 0x2c, 0xe5, 0x58, 0x59, 0x70, 0xeb, 0x91, 0x4b, 0x1d, 0x93,
-	0x47, 0x1e, 0xbb, 0xcb, 0xbe, 0x2f, 0xe5, 0x58, 0x59, 0x70, 0xda, 0xef, 0x11, 0x68, 0x71, 0x9f,
-	0x0a, 0xd1, 0x7e, 0x8f, 0x4b, 0x63, 0xa9, 0x45, 0x77, 0x20, 0x77, 0x66, 0x75, 0xc6, 0xe2, 0xa2,
-	0x54, 0x08, 0xb6, 0xf6, 0xba, 0xd5, 0x19, 0x63, 0xa1, 0x41, 0x47, 0xb0, 0xd1, 0xf6, 0x02, 0x96,
-	0x99, 0x3f, 0x3e, 0x2a, 0x2d, 0x0b, 0xeb, 0x92, 0xb4, 0xde, 0x38, 0x8c, 0xe9, 0xf1, 0x94, 0x07,
-	0x3a, 0x80, 0x75, 0x32, 0x20, 0xce, 0xb0, 0x69, 0x0d, 0x8c, 0xb6, 0x77, 0xc1, 0x2d, 0x08, 0x90,
-	0x57, 0xfd, 0x0c, 0x1e, 0x44, 0xd5, 0x38, 0x6e, 0x1f, 0x83, 0x68, 0x8d, 0x6d, 0x5a, 0x82, 0x44,
-	0x08, 0xae, 0xc6, 0x71, 0x7b, 0xd4, 0x80, 0xcd, 0x58, 0x11, 0x44, 0x24, 0x45, 0x01, 0xf3, 0x59,
-	0x09, 0xb3, 0x89, 0xa7, 0x4d, 0xf0, 0x65, 0x7e, 0xfc, 0xa2, 0xd0, 0x1e, 0x8c, 0x5c, 0x46, 0x9d,
-	0xe3, 0xa3, 0xd2, 0x4a, 0xf4, 0xa2, 0x70, 0xe8, 0x2b, 0x70, 0x60, 0x53, 0xfd, 0xaf, 0x06, 0xab,
-	0x11, 0x56, 0x13, 0x5c, 0x22, 0xb5, 0x84, 0x4b, 0xa4, 0x34, 0xbf, 0x11, 0x3b, 0x4b, 0xac, 0xe1,
-	0xb3, 0xe9, 0x1a, 0xbe, 0xfa, 0x47, 0x0d, 0x96, 0xfd, 0x9c, 0xce, 0x81, 0xe0, 0x34, 0x22, 0x04,
-	0xe7, 0xee, 0x15, 0xbb, 0x9f, 0x1f, 0x58, 0x12, 0xb5, 0xa9, 0xfe, 0x55, 0x83, 0xd5, 0xc8, 0x79,
-	0x37, 0x87, 0x29, 0xe0, 0xc8, 0x14, 0xde, 0x48, 0x39, 0x05, 0x11, 0x5d, 0xe2, 0x3c, 0xfe, 0xa2,
-	0xc1, 0x2b, 0x11, 0xcb, 0x39, 0x90, 0xa8, 0xf7, 0xa2, 0x24, 0xea, 0xde, 0x2c, 0x13, 0x49, 0xe0,
-	0x50, 0x1f, 0xc7, 0xa7, 0x71, 0x8d, 0x83, 0x20, 0xf6, 0xd2, 0x96, 0x49, 0xf9, 0xd2, 0x36, 0x0b,
-	0x7f, 0xaa, 0xfe, 0x41, 0x03, 0x75, 0xa2, 0xcc, 0x21, 0xd3, 0x27, 0xd1, 0x4c, 0x7f, 0x29, 0x65,
-	0xa6, 0x13, 0x92, 0xfc, 0x9f, 0x4c, 0x10, 0xfc, 0xfc, 0xf2, 0x1b, 0x3e, 0xe1, 0xb2, 0x57, 0x9e,
-	0x70, 0x1f, 0x6a, 0x00, 0xf2, 0x70, 0x34, 0xa8, 0xc7, 0x4e, 0x8b, 0x7b, 0x5f, 0x9b, 0xa1, 0xdb,
-	0xf5, 0x63, 0xe5, 0xed, 0xf1, 0x9c, 0x2f, 0xfa, 0x3d, 0x19, 0x28, 0x3e, 0xfc, 0xe7, 0xf4, 0x39,
-	0x1e, 0x1a, 0xb5, 0xfc, 0x0e, 0xac, 0xc7, 0x50, 0x66, 0xe2, 0x33, 0x7c, 0x67, 0xf4, 0x09, 0xfb,
-	0x8d, 0xdb, 0x19, 0xfd, 0xc0, 0x12, 0x77, 0x14, 0xbe, 0xc4, 0x7d, 0xa3, 0x9b, 0xb7, 0xc4, 0xfd,
-	0xc8, 0x12, 0x96, 0xf8, 0x6f, 0xb3, 0x41, 0xf0, 0xf3, 0x5b, 0xe2, 0x9f, 0x83, 0x5c, 0x9f, 0x8e,
-	0xfd, 0xdd, 0x43, 0x3c, 0x5c, 0x3f, 0xa2, 0x63, 0x17, 0x0b, 0x29, 0x1a, 0x25, 0x3d, 0xe5, 0xbf,
-	0x9d, 0x72, 0xae, 0xd7, 0x7b, 0xcb, 0x7f, 0x2f, 0xf6, 0x96, 0xbf, 0x93, 0x72, 0xb4, 0x97, 0x3c,
-	0xe6, 0x1f, 0x43, 0x8e, 0xd1, 0xa7, 0x4c, 0x3e, 0xe6, 0xdf, 0x4d, 0x1d, 0xfe, 0x53, 0xe6, 0x25,
-	0x85, 0xff, 0xc2, 0x02, 0xa2, 0xfa, 0x4b, 0x0d, 0x5e, 0x4d, 0x98, 0x1f, 0xda, 0x03, 0xf0, 0x6f,
-	0xb8, 0xaa, 0x6a, 0xaa, 0x05, 0x5a, 0x4a, 0x83, 0x43, 0x56, 0x9c, 0xf1, 0xba, 0x46, 0xd7, 0x94,
-	0x25, 0x0b, 0xd6, 0xb5, 0xd1, 0x35, 0xb1, 0xd0, 0x28, 0x4e, 0x9c, 0x4d, 0xe2, 0xc4, 0xd5, 0xef,
-	0x07, 0x6b, 0x87, 0x47, 0xaa, 0x3c, 0xb4, 0x44, 0x16, 0x1d, 0xf0, 0xf1, 0xcc, 0xcb, 0xf8, 0x78,
-	0xf5, 0x57, 0x19, 0x58, 0x8b, 0xe6, 0xf7, 0x5a, 0x93, 0x94, 0x4f, 0xf7, 0x99, 0x84, 0xa7, 0xfb,
-	0x23, 0xd8, 0x18, 0x1a, 0xa6, 0xd1, 0x74, 0xac, 0xae, 0x43, 0x86, 0xde, 0x5f, 0x09, 0xd9, 0x28,
-	0xa7, 0x6f, 0xc4, 0xf4, 0x78, 0xca, 0x83, 0xb3, 0xe9, 0x90, 0xac, 0xc9, 0x59, 0x2a, 0x61, 0x3d,
-	0x79, 0x95, 0x51, 0x6c, 0xba, 0x31, 0x6d, 0x82, 0x2f, 0xf3, 0x53, 0x49, 0xcc, 0x27, 0x25, 0xb1,
-	0xbe, 0xfd, 0xec, 0x79, 0x65, 0xe1, 0x93, 0xe7, 0x95, 0x85, 0x4f, 0x9f, 0x57, 0x16, 0x7e, 0x36,
-	0xa9, 0x68, 0xcf, 0x26, 0x15, 0xed, 0x93, 0x49, 0x45, 0xfb, 0x74, 0x52, 0xd1, 0xfe, 0x35, 0xa9,
-	0x68, 0xbf, 0xf8, 0x77, 0x65, 0xe1, 0x07, 0x99, 0xf3, 0xdd, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x4b, 0x2f, 0xfe, 0x30, 0x92, 0x1f, 0x00, 0x00,
+func (m *OnCallScheduleList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Channel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OnCallScheduleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OnCallScheduleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallScheduleList.Merge(m, src)
 }
-
-func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *OnCallScheduleList) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnCallScheduleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallScheduleList.DiscardUnknown(m)
 }
 
-func (m *ChannelList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_OnCallScheduleList proto.InternalMessageInfo
+
+func (m *OnCallScheduleSpec) Reset()      { *m = OnCallScheduleSpec{} }
+func (*OnCallScheduleSpec) ProtoMessage() {}
+func (*OnCallScheduleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{39}
+}
+func (m *OnCallScheduleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnCallScheduleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OnCallScheduleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnCallScheduleSpec.Merge(m, src)
+}
+func (m *OnCallScheduleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnCallScheduleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnCallScheduleSpec.DiscardUnknown(m)
 }
 
-func (m *ChannelList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_OnCallScheduleSpec proto.InternalMessageInfo
+
+func (m *Receiver) Reset()      { *m = Receiver{} }
+func (*Receiver) ProtoMessage() {}
+func (*Receiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{40}
+}
+func (m *Receiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *Receiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receiver.Merge(m, src)
+}
+func (m *Receiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receiver.DiscardUnknown(m)
 }
 
-func (m *ChannelSMTP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Receiver proto.InternalMessageInfo
+
+func (m *ReceiverDelivery) Reset()      { *m = ReceiverDelivery{} }
+func (*ReceiverDelivery) ProtoMessage() {}
+func (*ReceiverDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{41}
+}
+func (m *ReceiverDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelSMTP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverDelivery.Merge(m, src)
+}
+func (m *ReceiverDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverDelivery.DiscardUnknown(m)
 }
 
-func (m *ChannelSMTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Password)
-	copy(dAtA[i:], m.Password)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Email)
-	copy(dAtA[i:], m.Email)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Email)))
-	i--
-	dAtA[i] = 0x22
-	i--
-	if m.TLS {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+var xxx_messageInfo_ReceiverDelivery proto.InternalMessageInfo
+
+func (m *ReceiverDigest) Reset()      { *m = ReceiverDigest{} }
+func (*ReceiverDigest) ProtoMessage() {}
+func (*ReceiverDigest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{42}
+}
+func (m *ReceiverDigest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverDigest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x18
-	i = encodeVarintGenerated(dAtA, i, uint64(m.SMTPPort))
-	i--
-	dAtA[i] = 0x10
-	i -= len(m.SMTPHost)
-	copy(dAtA[i:], m.SMTPHost)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SMTPHost)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ReceiverDigest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverDigest.Merge(m, src)
+}
+func (m *ReceiverDigest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverDigest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverDigest.DiscardUnknown(m)
 }
 
-func (m *ChannelSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverDigest proto.InternalMessageInfo
+
+func (m *ReceiverGroup) Reset()      { *m = ReceiverGroup{} }
+func (*ReceiverGroup) ProtoMessage() {}
+func (*ReceiverGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{43}
+}
+func (m *ReceiverGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverGroup.Merge(m, src)
+}
+func (m *ReceiverGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverGroup.DiscardUnknown(m)
 }
 
-func (m *ChannelSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Webhook != nil {
-		{
-			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.SMTP != nil {
-		{
-			size, err := m.SMTP.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Wechat != nil {
-		{
-			size, err := m.Wechat.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TencentCloudSMS != nil {
-		{
-			size, err := m.TencentCloudSMS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Finalizers) > 0 {
-		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Finalizers[iNdEx])
-			copy(dAtA[i:], m.Finalizers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_ReceiverGroup proto.InternalMessageInfo
 
-func (m *ChannelStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverGroupList) Reset()      { *m = ReceiverGroupList{} }
+func (*ReceiverGroupList) ProtoMessage() {}
+func (*ReceiverGroupList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{44}
+}
+func (m *ReceiverGroupList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverGroupList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverGroupList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverGroupList.Merge(m, src)
 }
-
-func (m *ChannelStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0x1a
-	return len(dAtA) - i, nil
+func (m *ReceiverGroupList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverGroupList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverGroupList.DiscardUnknown(m)
 }
 
-func (m *ChannelTencentCloudSMS) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverGroupList proto.InternalMessageInfo
+
+func (m *ReceiverGroupSpec) Reset()      { *m = ReceiverGroupSpec{} }
+func (*ReceiverGroupSpec) ProtoMessage() {}
+func (*ReceiverGroupSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{45}
+}
+func (m *ReceiverGroupSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverGroupSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelTencentCloudSMS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverGroupSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverGroupSpec.Merge(m, src)
 }
-
-func (m *ChannelTencentCloudSMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Extend)
-	copy(dAtA[i:], m.Extend)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Extend)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.SdkAppID)
-	copy(dAtA[i:], m.SdkAppID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SdkAppID)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.AppKey)
-	copy(dAtA[i:], m.AppKey)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppKey)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *ReceiverGroupSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverGroupSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverGroupSpec.DiscardUnknown(m)
 }
 
-func (m *ChannelWebhook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverGroupSpec proto.InternalMessageInfo
+
+func (m *ReceiverList) Reset()      { *m = ReceiverList{} }
+func (*ReceiverList) ProtoMessage() {}
+func (*ReceiverList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{46}
+}
+func (m *ReceiverList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelWebhook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverList.Merge(m, src)
+}
+func (m *ReceiverList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverList.DiscardUnknown(m)
 }
 
-func (m *ChannelWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Headers) > 0 {
-		keysForHeaders := make([]string, 0, len(m.Headers))
-		for k := range m.Headers {
-			keysForHeaders = append(keysForHeaders, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
-		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Headers[string(keysForHeaders[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForHeaders[iNdEx])
-			copy(dAtA[i:], keysForHeaders[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+var xxx_messageInfo_ReceiverList proto.InternalMessageInfo
+
+func (m *ReceiverPreferences) Reset()      { *m = ReceiverPreferences{} }
+func (*ReceiverPreferences) ProtoMessage() {}
+func (*ReceiverPreferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{47}
+}
+func (m *ReceiverPreferences) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverPreferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.URL)
-	copy(dAtA[i:], m.URL)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ReceiverPreferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverPreferences.Merge(m, src)
+}
+func (m *ReceiverPreferences) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverPreferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverPreferences.DiscardUnknown(m)
 }
 
-func (m *ChannelWechat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverPreferences proto.InternalMessageInfo
+
+func (m *ReceiverQuietHours) Reset()      { *m = ReceiverQuietHours{} }
+func (*ReceiverQuietHours) ProtoMessage() {}
+func (*ReceiverQuietHours) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{48}
+}
+func (m *ReceiverQuietHours) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverQuietHours) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChannelWechat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverQuietHours) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverQuietHours.Merge(m, src)
+}
+func (m *ReceiverQuietHours) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverQuietHours) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverQuietHours.DiscardUnknown(m)
 }
 
-func (m *ChannelWechat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.AppSecret)
-	copy(dAtA[i:], m.AppSecret)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppSecret)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.AppID)
-	copy(dAtA[i:], m.AppID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppID)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_ReceiverQuietHours proto.InternalMessageInfo
 
-func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverRateLimit) Reset()      { *m = ReceiverRateLimit{} }
+func (*ReceiverRateLimit) ProtoMessage() {}
+func (*ReceiverRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{49}
+}
+func (m *ReceiverRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverRateLimit.Merge(m, src)
 }
-
-func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BinaryData) > 0 {
-		keysForBinaryData := make([]string, 0, len(m.BinaryData))
-		for k := range m.BinaryData {
-			keysForBinaryData = append(keysForBinaryData, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
-		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
-			baseI := i
-			if v != nil {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(keysForBinaryData[iNdEx])
-			copy(dAtA[i:], keysForBinaryData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Data) > 0 {
-		keysForData := make([]string, 0, len(m.Data))
-		for k := range m.Data {
-			keysForData = append(keysForData, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Data[string(keysForData[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForData[iNdEx])
-			copy(dAtA[i:], keysForData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *ReceiverRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverRateLimit.DiscardUnknown(m)
 }
 
-func (m *ConfigMapList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverRateLimit proto.InternalMessageInfo
+
+func (m *ReceiverSpec) Reset()      { *m = ReceiverSpec{} }
+func (*ReceiverSpec) ProtoMessage() {}
+func (*ReceiverSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{50}
+}
+func (m *ReceiverSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ConfigMapList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReceiverSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverSpec.Merge(m, src)
+}
+func (m *ReceiverSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverSpec.DiscardUnknown(m)
 }
 
-func (m *ConfigMapList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_ReceiverSpec proto.InternalMessageInfo
+
+func (m *ReceiverStatus) Reset()      { *m = ReceiverStatus{} }
+func (*ReceiverStatus) ProtoMessage() {}
+func (*ReceiverStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{51}
+}
+func (m *ReceiverStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiverStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ReceiverStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiverStatus.Merge(m, src)
+}
+func (m *ReceiverStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiverStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiverStatus.DiscardUnknown(m)
 }
 
-func (m *Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ReceiverStatus proto.InternalMessageInfo
+
+func (m *SecretKeySelector) Reset()      { *m = SecretKeySelector{} }
+func (*SecretKeySelector) ProtoMessage() {}
+func (*SecretKeySelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{52}
+}
+func (m *SecretKeySelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretKeySelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SecretKeySelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretKeySelector.Merge(m, src)
+}
+func (m *SecretKeySelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretKeySelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretKeySelector.DiscardUnknown(m)
 }
 
-func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_SecretKeySelector proto.InternalMessageInfo
+
+func (m *SecretReference) Reset()      { *m = SecretReference{} }
+func (*SecretReference) ProtoMessage() {}
+func (*SecretReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{53}
+}
+func (m *SecretReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *SecretReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretReference.Merge(m, src)
+}
+func (m *SecretReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretReference.DiscardUnknown(m)
 }
 
-func (m *MessageList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SecretReference proto.InternalMessageInfo
+
+func (m *Silence) Reset()      { *m = Silence{} }
+func (*Silence) ProtoMessage() {}
+func (*Silence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{54}
+}
+func (m *Silence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Silence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Silence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Silence.Merge(m, src)
+}
+func (m *Silence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Silence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Silence.DiscardUnknown(m)
 }
 
-func (m *MessageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_Silence proto.InternalMessageInfo
 
-func (m *MessageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SilenceList) Reset()      { *m = SilenceList{} }
+func (*SilenceList) ProtoMessage() {}
+func (*SilenceList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{55}
+}
+func (m *SilenceList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SilenceList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SilenceList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SilenceList.Merge(m, src)
 }
-
-func (m *MessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *SilenceList) XXX_Size() int {
+	return m.Size()
+}
+func (m *SilenceList) XXX_DiscardUnknown() {
+	xxx_messageInfo_SilenceList.DiscardUnknown(m)
 }
 
-func (m *MessageRequestList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SilenceList proto.InternalMessageInfo
+
+func (m *SilenceMatcher) Reset()      { *m = SilenceMatcher{} }
+func (*SilenceMatcher) ProtoMessage() {}
+func (*SilenceMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{56}
+}
+func (m *SilenceMatcher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SilenceMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageRequestList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SilenceMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SilenceMatcher.Merge(m, src)
 }
-
-func (m *MessageRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *SilenceMatcher) XXX_Size() int {
+	return m.Size()
+}
+func (m *SilenceMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_SilenceMatcher.DiscardUnknown(m)
 }
 
-func (m *MessageRequestSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SilenceMatcher proto.InternalMessageInfo
+
+func (m *SilenceSpec) Reset()      { *m = SilenceSpec{} }
+func (*SilenceSpec) ProtoMessage() {}
+func (*SilenceSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{57}
+}
+func (m *SilenceSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SilenceSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageRequestSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SilenceSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SilenceSpec.Merge(m, src)
+}
+func (m *SilenceSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *SilenceSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SilenceSpec.DiscardUnknown(m)
 }
 
-func (m *MessageRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Variables) > 0 {
-		keysForVariables := make([]string, 0, len(m.Variables))
-		for k := range m.Variables {
-			keysForVariables = append(keysForVariables, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForVariables)
-		for iNdEx := len(keysForVariables) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Variables[string(keysForVariables[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForVariables[iNdEx])
-			copy(dAtA[i:], keysForVariables[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForVariables[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.ReceiverGroups) > 0 {
-		for iNdEx := len(m.ReceiverGroups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReceiverGroups[iNdEx])
-			copy(dAtA[i:], m.ReceiverGroups[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverGroups[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Receivers) > 0 {
-		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Receivers[iNdEx])
-			copy(dAtA[i:], m.Receivers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+var xxx_messageInfo_SilenceSpec proto.InternalMessageInfo
+
+func (m *SilenceStatus) Reset()      { *m = SilenceStatus{} }
+func (*SilenceStatus) ProtoMessage() {}
+func (*SilenceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{58}
+}
+func (m *SilenceStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SilenceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i -= len(m.TemplateName)
-	copy(dAtA[i:], m.TemplateName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *SilenceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SilenceStatus.Merge(m, src)
+}
+func (m *SilenceStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SilenceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SilenceStatus.DiscardUnknown(m)
 }
 
-func (m *MessageRequestStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_SilenceStatus proto.InternalMessageInfo
+
+func (m *Template) Reset()      { *m = Template{} }
+func (*Template) ProtoMessage() {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{59}
+}
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageRequestStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(m, src)
+}
+func (m *Template) XXX_Size() int {
+	return m.Size()
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
 }
 
-func (m *MessageRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.AlertStatus)
-	copy(dAtA[i:], m.AlertStatus)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlertStatus)))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Errors) > 0 {
-		keysForErrors := make([]string, 0, len(m.Errors))
-		for k := range m.Errors {
-			keysForErrors = append(keysForErrors, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForErrors)
-		for iNdEx := len(keysForErrors) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Errors[string(keysForErrors[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForErrors[iNdEx])
-			copy(dAtA[i:], keysForErrors[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForErrors[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_Template proto.InternalMessageInfo
 
-func (m *MessageSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TemplateList) Reset()      { *m = TemplateList{} }
+func (*TemplateList) ProtoMessage() {}
+func (*TemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{60}
+}
+func (m *TemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateList.Merge(m, src)
 }
-
-func (m *MessageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.ClusterID)
-	copy(dAtA[i:], m.ClusterID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterID)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.ReceiverChannelName)
-	copy(dAtA[i:], m.ReceiverChannelName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverChannelName)))
-	i--
-	dAtA[i] = 0x5a
-	i -= len(m.AlarmPolicyType)
-	copy(dAtA[i:], m.AlarmPolicyType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlarmPolicyType)))
-	i--
-	dAtA[i] = 0x52
-	i -= len(m.AlarmPolicyName)
-	copy(dAtA[i:], m.AlarmPolicyName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlarmPolicyName)))
-	i--
-	dAtA[i] = 0x4a
-	i -= len(m.ChannelMessageID)
-	copy(dAtA[i:], m.ChannelMessageID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChannelMessageID)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.Body)
-	copy(dAtA[i:], m.Body)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.Header)
-	copy(dAtA[i:], m.Header)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Username)
-	copy(dAtA[i:], m.Username)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Identity)
-	copy(dAtA[i:], m.Identity)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Identity)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.ReceiverChannel)
-	copy(dAtA[i:], m.ReceiverChannel)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverChannel)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.ReceiverName)
-	copy(dAtA[i:], m.ReceiverName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverName)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *TemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateList.DiscardUnknown(m)
 }
 
-func (m *MessageStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TemplateList proto.InternalMessageInfo
+
+func (m *TemplateMarkdown) Reset()      { *m = TemplateMarkdown{} }
+func (*TemplateMarkdown) ProtoMessage() {}
+func (*TemplateMarkdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{61}
+}
+func (m *TemplateMarkdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateMarkdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *MessageStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TemplateMarkdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateMarkdown.Merge(m, src)
 }
-
-func (m *MessageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.AlertStatus)
-	copy(dAtA[i:], m.AlertStatus)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlertStatus)))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *TemplateMarkdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateMarkdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateMarkdown.DiscardUnknown(m)
 }
 
-func (m *Receiver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TemplateMarkdown proto.InternalMessageInfo
+
+func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
+func (*TemplateSpec) ProtoMessage() {}
+func (*TemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{62}
+}
+func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *Receiver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateSpec.Merge(m, src)
 }
-
-func (m *Receiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *TemplateSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateSpec.DiscardUnknown(m)
 }
 
-func (m *ReceiverGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
+
+func (m *TemplateTencentCloudSMS) Reset()      { *m = TemplateTencentCloudSMS{} }
+func (*TemplateTencentCloudSMS) ProtoMessage() {}
+func (*TemplateTencentCloudSMS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{63}
+}
+func (m *TemplateTencentCloudSMS) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateTencentCloudSMS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateTencentCloudSMS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateTencentCloudSMS.Merge(m, src)
+}
+func (m *TemplateTencentCloudSMS) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateTencentCloudSMS) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateTencentCloudSMS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateTencentCloudSMS proto.InternalMessageInfo
+
+func (m *TemplateText) Reset()      { *m = TemplateText{} }
+func (*TemplateText) ProtoMessage() {}
+func (*TemplateText) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{64}
+}
+func (m *TemplateText) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateText) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateText.Merge(m, src)
+}
+func (m *TemplateText) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateText) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateText.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateText proto.InternalMessageInfo
+
+func (m *TemplateWechat) Reset()      { *m = TemplateWechat{} }
+func (*TemplateWechat) ProtoMessage() {}
+func (*TemplateWechat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{65}
+}
+func (m *TemplateWechat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TemplateWechat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TemplateWechat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TemplateWechat.Merge(m, src)
+}
+func (m *TemplateWechat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TemplateWechat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TemplateWechat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TemplateWechat proto.InternalMessageInfo
+
+func (m *WebhookRequest) Reset()      { *m = WebhookRequest{} }
+func (*WebhookRequest) ProtoMessage() {}
+func (*WebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1fbd89bf08e8a478, []int{66}
+}
+func (m *WebhookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookRequest.Merge(m, src)
+}
+func (m *WebhookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookRequest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AuditRule)(nil), "tkestack.io.tke.api.notify.v1.AuditRule")
+	proto.RegisterType((*AuditRuleList)(nil), "tkestack.io.tke.api.notify.v1.AuditRuleList")
+	proto.RegisterType((*AuditRuleMatch)(nil), "tkestack.io.tke.api.notify.v1.AuditRuleMatch")
+	proto.RegisterType((*AuditRuleSpec)(nil), "tkestack.io.tke.api.notify.v1.AuditRuleSpec")
+	proto.RegisterType((*Channel)(nil), "tkestack.io.tke.api.notify.v1.Channel")
+	proto.RegisterType((*ChannelDingTalk)(nil), "tkestack.io.tke.api.notify.v1.ChannelDingTalk")
+	proto.RegisterType((*ChannelLark)(nil), "tkestack.io.tke.api.notify.v1.ChannelLark")
+	proto.RegisterType((*ChannelList)(nil), "tkestack.io.tke.api.notify.v1.ChannelList")
+	proto.RegisterType((*ChannelSMTP)(nil), "tkestack.io.tke.api.notify.v1.ChannelSMTP")
+	proto.RegisterType((*ChannelSlack)(nil), "tkestack.io.tke.api.notify.v1.ChannelSlack")
+	proto.RegisterType((*ChannelSpec)(nil), "tkestack.io.tke.api.notify.v1.ChannelSpec")
+	proto.RegisterType((*ChannelStatus)(nil), "tkestack.io.tke.api.notify.v1.ChannelStatus")
+	proto.RegisterType((*ChannelTencentCloudSMS)(nil), "tkestack.io.tke.api.notify.v1.ChannelTencentCloudSMS")
+	proto.RegisterType((*ChannelWeCom)(nil), "tkestack.io.tke.api.notify.v1.ChannelWeCom")
+	proto.RegisterType((*ChannelWebhook)(nil), "tkestack.io.tke.api.notify.v1.ChannelWebhook")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.ChannelWebhook.HeadersEntry")
+	proto.RegisterType((*ChannelWechat)(nil), "tkestack.io.tke.api.notify.v1.ChannelWechat")
+	proto.RegisterType((*ConfigMap)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap")
+	proto.RegisterMapType((map[string][]byte)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap.BinaryDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.ConfigMap.DataEntry")
+	proto.RegisterType((*ConfigMapList)(nil), "tkestack.io.tke.api.notify.v1.ConfigMapList")
+	proto.RegisterType((*EscalationPolicy)(nil), "tkestack.io.tke.api.notify.v1.EscalationPolicy")
+	proto.RegisterType((*EscalationPolicyList)(nil), "tkestack.io.tke.api.notify.v1.EscalationPolicyList")
+	proto.RegisterType((*EscalationPolicySpec)(nil), "tkestack.io.tke.api.notify.v1.EscalationPolicySpec")
+	proto.RegisterType((*EscalationRule)(nil), "tkestack.io.tke.api.notify.v1.EscalationRule")
+	proto.RegisterType((*EscalationTarget)(nil), "tkestack.io.tke.api.notify.v1.EscalationTarget")
+	proto.RegisterType((*Message)(nil), "tkestack.io.tke.api.notify.v1.Message")
+	proto.RegisterType((*MessageList)(nil), "tkestack.io.tke.api.notify.v1.MessageList")
+	proto.RegisterType((*MessageRequest)(nil), "tkestack.io.tke.api.notify.v1.MessageRequest")
+	proto.RegisterType((*MessageRequestDeferralStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestDeferralStatus")
+	proto.RegisterType((*MessageRequestEscalationStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestEscalationStatus")
+	proto.RegisterType((*MessageRequestList)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestList")
+	proto.RegisterType((*MessageRequestRetryStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestRetryStatus")
+	proto.RegisterType((*MessageRequestSpec)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestSpec")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestSpec.VariablesEntry")
+	proto.RegisterType((*MessageRequestStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus")
+	proto.RegisterMapType((map[string]MessageRequestDeferralStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus.DeferralsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus.ErrorsEntry")
+	proto.RegisterMapType((map[string]MessageRequestEscalationStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus.EscalationsEntry")
+	proto.RegisterMapType((map[string]MessageRequestRetryStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageRequestStatus.RetriesEntry")
+	proto.RegisterType((*MessageSpec)(nil), "tkestack.io.tke.api.notify.v1.MessageSpec")
+	proto.RegisterType((*MessageStatus)(nil), "tkestack.io.tke.api.notify.v1.MessageStatus")
+	proto.RegisterType((*OnCallLayer)(nil), "tkestack.io.tke.api.notify.v1.OnCallLayer")
+	proto.RegisterType((*OnCallOverride)(nil), "tkestack.io.tke.api.notify.v1.OnCallOverride")
+	proto.RegisterType((*OnCallRestriction)(nil), "tkestack.io.tke.api.notify.v1.OnCallRestriction")
+	proto.RegisterType((*OnCallSchedule)(nil), "tkestack.io.tke.api.notify.v1.OnCallSchedule")
+	proto.RegisterType((*OnCallScheduleList)(nil), "tkestack.io.tke.api.notify.v1.OnCallScheduleList")
+	proto.RegisterType((*OnCallScheduleSpec)(nil), "tkestack.io.tke.api.notify.v1.OnCallScheduleSpec")
+	proto.RegisterType((*Receiver)(nil), "tkestack.io.tke.api.notify.v1.Receiver")
+	proto.RegisterType((*ReceiverDelivery)(nil), "tkestack.io.tke.api.notify.v1.ReceiverDelivery")
+	proto.RegisterType((*ReceiverDigest)(nil), "tkestack.io.tke.api.notify.v1.ReceiverDigest")
+	proto.RegisterType((*ReceiverGroup)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroup")
+	proto.RegisterType((*ReceiverGroupList)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroupList")
+	proto.RegisterType((*ReceiverGroupSpec)(nil), "tkestack.io.tke.api.notify.v1.ReceiverGroupSpec")
+	proto.RegisterType((*ReceiverList)(nil), "tkestack.io.tke.api.notify.v1.ReceiverList")
+	proto.RegisterType((*ReceiverPreferences)(nil), "tkestack.io.tke.api.notify.v1.ReceiverPreferences")
+	proto.RegisterType((*ReceiverQuietHours)(nil), "tkestack.io.tke.api.notify.v1.ReceiverQuietHours")
+	proto.RegisterType((*ReceiverRateLimit)(nil), "tkestack.io.tke.api.notify.v1.ReceiverRateLimit")
+	proto.RegisterType((*ReceiverSpec)(nil), "tkestack.io.tke.api.notify.v1.ReceiverSpec")
+	proto.RegisterMapType((map[ReceiverChannel]string)(nil), "tkestack.io.tke.api.notify.v1.ReceiverSpec.IdentitiesEntry")
+	proto.RegisterType((*ReceiverStatus)(nil), "tkestack.io.tke.api.notify.v1.ReceiverStatus")
+	proto.RegisterType((*SecretKeySelector)(nil), "tkestack.io.tke.api.notify.v1.SecretKeySelector")
+	proto.RegisterType((*SecretReference)(nil), "tkestack.io.tke.api.notify.v1.SecretReference")
+	proto.RegisterType((*Silence)(nil), "tkestack.io.tke.api.notify.v1.Silence")
+	proto.RegisterType((*SilenceList)(nil), "tkestack.io.tke.api.notify.v1.SilenceList")
+	proto.RegisterType((*SilenceMatcher)(nil), "tkestack.io.tke.api.notify.v1.SilenceMatcher")
+	proto.RegisterType((*SilenceSpec)(nil), "tkestack.io.tke.api.notify.v1.SilenceSpec")
+	proto.RegisterType((*SilenceStatus)(nil), "tkestack.io.tke.api.notify.v1.SilenceStatus")
+	proto.RegisterType((*Template)(nil), "tkestack.io.tke.api.notify.v1.Template")
+	proto.RegisterType((*TemplateList)(nil), "tkestack.io.tke.api.notify.v1.TemplateList")
+	proto.RegisterType((*TemplateMarkdown)(nil), "tkestack.io.tke.api.notify.v1.TemplateMarkdown")
+	proto.RegisterType((*TemplateSpec)(nil), "tkestack.io.tke.api.notify.v1.TemplateSpec")
+	proto.RegisterType((*TemplateTencentCloudSMS)(nil), "tkestack.io.tke.api.notify.v1.TemplateTencentCloudSMS")
+	proto.RegisterType((*TemplateText)(nil), "tkestack.io.tke.api.notify.v1.TemplateText")
+	proto.RegisterType((*TemplateWechat)(nil), "tkestack.io.tke.api.notify.v1.TemplateWechat")
+	proto.RegisterType((*WebhookRequest)(nil), "tkestack.io.tke.api.notify.v1.WebhookRequest")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.notify.v1.WebhookRequest.HeadersEntry")
+}
+
+func init() {
+	proto.RegisterFile("tkestack.io/tke/api/notify/v1/generated.proto", fileDescriptor_1fbd89bf08e8a478)
+}
+
+var fileDescriptor_1fbd89bf08e8a478 = []byte{
+	// 4090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4b, 0x6c, 0x24, 0x49,
+	0x56, 0x9d, 0xf5, 0xb1, 0x5d, 0x61, 0xbb, 0xec, 0x8e, 0x6e, 0x76, 0x6b, 0xcd, 0x8e, 0xdd, 0xca,
+	0x81, 0xc1, 0xcc, 0x4e, 0x97, 0xa7, 0x3d, 0xec, 0xd2, 0xf4, 0xec, 0xb2, 0xb8, 0x5c, 0xcd, 0x4e,
+	0xd3, 0xae, 0x6e, 0xf7, 0xab, 0xea, 0x99, 0xdd, 0x59, 0x84, 0xc8, 0xae, 0x8a, 0x2e, 0xe7, 0x56,
+	0x56, 0x66, 0x4d, 0x66, 0x94, 0x7b, 0x0a, 0x84, 0x04, 0x2b, 0x71, 0xe5, 0x77, 0xd8, 0x03, 0x02,
+	0x84, 0x10, 0x42, 0xe2, 0xc0, 0x89, 0x0b, 0xe2, 0x73, 0xe3, 0x33, 0x02, 0x24, 0xe6, 0x38, 0xd2,
+	0x82, 0x61, 0xbc, 0x27, 0x04, 0x47, 0x2e, 0xf4, 0x69, 0x15, 0xdf, 0xcc, 0xc8, 0x72, 0xb9, 0xb2,
+	0x6a, 0xba, 0x2d, 0xdf, 0x32, 0xdf, 0x3f, 0x22, 0x5e, 0xbc, 0x88, 0xf7, 0x22, 0x02, 0xdd, 0xa4,
+	0x3d, 0x12, 0x51, 0xa7, 0xdd, 0xab, 0xba, 0xc1, 0x0e, 0xed, 0x91, 0x1d, 0x67, 0xe0, 0xee, 0xf8,
+	0x01, 0x75, 0x9f, 0x8e, 0x76, 0x8e, 0x6f, 0xed, 0x74, 0x89, 0x4f, 0x42, 0x87, 0x92, 0x4e, 0x75,
+	0x10, 0x06, 0x34, 0xc0, 0xaf, 0x24, 0xc8, 0xab, 0xb4, 0x47, 0xaa, 0xce, 0xc0, 0xad, 0x0a, 0xf2,
+	0xea, 0xf1, 0xad, 0x8d, 0x9b, 0x5d, 0x97, 0x1e, 0x0d, 0x9f, 0x54, 0xdb, 0x41, 0x7f, 0xa7, 0x1b,
+	0x74, 0x83, 0x1d, 0xce, 0xf5, 0x64, 0xf8, 0x94, 0xff, 0xf1, 0x1f, 0xfe, 0x25, 0xa4, 0x6d, 0xfc,
+	0x54, 0xef, 0x76, 0xc4, 0xf4, 0x3a, 0x03, 0xb7, 0xef, 0xb4, 0x8f, 0x5c, 0x9f, 0x84, 0xa3, 0x9d,
+	0x41, 0xaf, 0xcb, 0x00, 0xd1, 0x4e, 0x9f, 0x50, 0xe7, 0x0c, 0x1b, 0x36, 0x76, 0x26, 0x71, 0x85,
+	0x43, 0x9f, 0xba, 0x7d, 0x32, 0xc6, 0xf0, 0x95, 0x69, 0x0c, 0x51, 0xfb, 0x88, 0xf4, 0x9d, 0x34,
+	0x9f, 0xfd, 0x77, 0x16, 0x2a, 0xed, 0x0d, 0x3b, 0x2e, 0x85, 0xa1, 0x47, 0xf0, 0x2f, 0xa3, 0x25,
+	0x66, 0x51, 0xc7, 0xa1, 0x4e, 0xc5, 0xba, 0x61, 0x6d, 0x2f, 0xef, 0xbe, 0x59, 0x15, 0x82, 0xab,
+	0x49, 0xc1, 0xd5, 0x41, 0xaf, 0xcb, 0x00, 0x51, 0x95, 0x51, 0x57, 0x8f, 0x6f, 0x55, 0x1f, 0x3e,
+	0xf9, 0x0e, 0x69, 0xd3, 0x06, 0xa1, 0x4e, 0x0d, 0x7f, 0x74, 0xb2, 0x75, 0xe5, 0xf4, 0x64, 0x0b,
+	0xc5, 0x30, 0xd0, 0x52, 0xf1, 0x03, 0x54, 0x88, 0x06, 0xa4, 0x5d, 0xc9, 0x71, 0xe9, 0x6f, 0x54,
+	0xcf, 0xed, 0xeb, 0xaa, 0xb6, 0xac, 0x39, 0x20, 0xed, 0xda, 0x8a, 0x94, 0x5c, 0x60, 0x7f, 0xc0,
+	0xe5, 0xd8, 0x7f, 0x6b, 0xa1, 0x55, 0x4d, 0x75, 0xe0, 0x46, 0x14, 0xff, 0xe2, 0x58, 0x1b, 0xaa,
+	0xd9, 0xda, 0xc0, 0xb8, 0x79, 0x0b, 0xd6, 0xa5, 0x9e, 0x25, 0x05, 0x49, 0xd8, 0xdf, 0x40, 0x45,
+	0x97, 0x92, 0x7e, 0x54, 0xc9, 0xdd, 0xc8, 0x6f, 0x2f, 0xef, 0x6e, 0x67, 0x6d, 0x40, 0x6d, 0x55,
+	0x0a, 0x2d, 0xde, 0x63, 0xec, 0x20, 0xa4, 0xd8, 0xcf, 0x2d, 0x54, 0xd6, 0x34, 0x0d, 0x87, 0xb6,
+	0x8f, 0xf0, 0x16, 0x2a, 0x0e, 0x23, 0x12, 0x46, 0x15, 0xeb, 0x46, 0x7e, 0xbb, 0x54, 0x2b, 0x31,
+	0x9e, 0xc7, 0x0c, 0x00, 0x02, 0xce, 0x08, 0x8e, 0x49, 0xf8, 0x44, 0x98, 0x20, 0x09, 0xde, 0x65,
+	0x00, 0x10, 0x70, 0xfc, 0x25, 0x54, 0x0a, 0x49, 0x14, 0x0c, 0xc3, 0x36, 0x89, 0x2a, 0x79, 0x4e,
+	0xb4, 0x7a, 0x7a, 0xb2, 0x55, 0x02, 0x05, 0x84, 0x18, 0x8f, 0xb7, 0xd1, 0x52, 0xdb, 0x1b, 0x46,
+	0x94, 0x69, 0x2c, 0x70, 0xda, 0x15, 0xd6, 0xf4, 0x7d, 0x09, 0x03, 0x8d, 0xc5, 0x55, 0x84, 0x7c,
+	0xa7, 0x4f, 0xa2, 0x81, 0xc3, 0xe4, 0x16, 0x39, 0x6d, 0x99, 0x0d, 0xf4, 0x03, 0x0d, 0x85, 0x04,
+	0x05, 0xb3, 0xb3, 0x1d, 0x74, 0x48, 0x54, 0x59, 0xb8, 0x91, 0xdf, 0x2e, 0x0a, 0x3b, 0xf7, 0x19,
+	0x00, 0x04, 0xdc, 0xfe, 0x38, 0x9f, 0x18, 0x3b, 0x36, 0xa6, 0xf8, 0x0d, 0xb4, 0x44, 0x89, 0xef,
+	0xf8, 0xf4, 0x5e, 0x9d, 0x8f, 0x5d, 0x29, 0x1e, 0x8b, 0x96, 0x84, 0x83, 0xa6, 0xc0, 0x5f, 0x46,
+	0xcb, 0x1d, 0x37, 0x1a, 0x78, 0xce, 0x88, 0x59, 0xc0, 0x5d, 0xaa, 0x54, 0xbb, 0x26, 0x19, 0x96,
+	0xeb, 0x31, 0x0a, 0x92, 0x74, 0x4c, 0x49, 0xc7, 0x8d, 0x9c, 0x27, 0x1e, 0xe9, 0x54, 0xf2, 0x37,
+	0xac, 0xed, 0xa5, 0x58, 0x49, 0x5d, 0xc2, 0x41, 0x53, 0x60, 0x40, 0xc5, 0x3e, 0x1b, 0x97, 0x4a,
+	0x81, 0xfb, 0xd2, 0xcd, 0xac, 0x03, 0xce, 0x07, 0x33, 0x1e, 0x75, 0xfe, 0x0b, 0x42, 0x14, 0x33,
+	0xbc, 0x7d, 0xe4, 0xf8, 0x3e, 0xf1, 0xb8, 0xe1, 0x45, 0xd3, 0xf0, 0xfd, 0x18, 0x05, 0x49, 0x3a,
+	0x7c, 0x1b, 0xad, 0x50, 0xd2, 0x1f, 0x78, 0x0e, 0x25, 0x9c, 0x6f, 0x81, 0xf3, 0x5d, 0x97, 0x7c,
+	0x2b, 0xad, 0x04, 0x0e, 0x0c, 0x4a, 0xe1, 0x11, 0x6d, 0xe2, 0x1e, 0xb3, 0x51, 0x5e, 0x4c, 0x7a,
+	0x84, 0x04, 0x42, 0x8c, 0xc7, 0x77, 0x50, 0x59, 0xfd, 0x7c, 0x23, 0x0c, 0x86, 0x83, 0xa8, 0xb2,
+	0xc4, 0x39, 0xf0, 0xe9, 0xc9, 0x56, 0x19, 0x0c, 0x0c, 0xa4, 0x28, 0xed, 0xdf, 0xce, 0xa1, 0x45,
+	0x69, 0xff, 0x05, 0x04, 0x93, 0x03, 0x23, 0x98, 0xbc, 0x3e, 0x65, 0x68, 0xa4, 0x5d, 0x93, 0x42,
+	0x09, 0x6e, 0xa1, 0x85, 0x88, 0x3a, 0x74, 0x18, 0x55, 0xf2, 0x99, 0x82, 0x93, 0x92, 0xc7, 0x79,
+	0x6a, 0x65, 0x29, 0x71, 0x41, 0xfc, 0x83, 0x94, 0x65, 0xf7, 0xd1, 0x9a, 0x24, 0xac, 0xbb, 0x7e,
+	0xb7, 0xe5, 0x78, 0x3d, 0xbc, 0x8b, 0xd0, 0x33, 0xf2, 0xe4, 0x28, 0x08, 0x7a, 0x8f, 0xe1, 0x40,
+	0xfa, 0xb9, 0x6e, 0xe8, 0x7b, 0x1a, 0x03, 0x09, 0x2a, 0xfc, 0x1a, 0x5a, 0x88, 0x48, 0x3b, 0x24,
+	0x54, 0xba, 0x79, 0xac, 0x8e, 0x43, 0x41, 0x62, 0x6d, 0x17, 0x29, 0xff, 0x39, 0x70, 0xc2, 0x97,
+	0xab, 0xea, 0xaf, 0xac, 0x58, 0xd7, 0xcb, 0x0f, 0xbc, 0xf7, 0xcd, 0xc0, 0xfb, 0x5a, 0xb6, 0xc1,
+	0x99, 0x10, 0x76, 0xbf, 0x1f, 0x9b, 0xde, 0x6c, 0xb4, 0x0e, 0x59, 0x48, 0x88, 0xfa, 0x74, 0xf0,
+	0x4e, 0x10, 0xd1, 0x74, 0xdc, 0x61, 0x78, 0x06, 0x07, 0x4d, 0xa1, 0xa8, 0x0f, 0x83, 0x50, 0x74,
+	0x51, 0xd1, 0xa4, 0x66, 0x70, 0xd0, 0x14, 0xf8, 0x15, 0x94, 0xa7, 0x5e, 0x24, 0x23, 0xcd, 0xb2,
+	0x24, 0xcc, 0xb7, 0x0e, 0x9a, 0xc0, 0xe0, 0xf8, 0x55, 0x54, 0x24, 0x7d, 0xc7, 0xf5, 0x78, 0x7c,
+	0x29, 0xc5, 0xf6, 0xde, 0x65, 0x40, 0x10, 0x38, 0xa6, 0x71, 0xe0, 0x44, 0xd1, 0xb3, 0x20, 0xec,
+	0xc8, 0x68, 0xa1, 0x35, 0x1e, 0x4a, 0x38, 0x68, 0x0a, 0xbb, 0x86, 0x56, 0x54, 0xe3, 0x3c, 0xa7,
+	0x3d, 0x97, 0x13, 0xd8, 0xdf, 0x5f, 0x88, 0x7b, 0x88, 0x4d, 0x8e, 0xaf, 0x23, 0xf4, 0xd4, 0xf5,
+	0x1d, 0xcf, 0xfd, 0x95, 0x78, 0x69, 0xda, 0x62, 0xfc, 0x3f, 0xaf, 0xa1, 0xcf, 0x4f, 0xb6, 0x56,
+	0xf5, 0x1f, 0x0f, 0x42, 0x09, 0x16, 0x23, 0xb4, 0xe7, 0x66, 0x0d, 0xed, 0xf9, 0x8c, 0xa1, 0x9d,
+	0xa2, 0x35, 0x4a, 0xfc, 0x36, 0xf1, 0xe9, 0xbe, 0x17, 0x0c, 0x3b, 0xcd, 0x46, 0x53, 0x86, 0xed,
+	0x2f, 0x67, 0x73, 0x97, 0x96, 0xc9, 0x5c, 0xbb, 0x76, 0x7a, 0xb2, 0xb5, 0x96, 0x02, 0x42, 0x5a,
+	0x05, 0x3e, 0x44, 0x0b, 0xcf, 0x48, 0xfb, 0xc8, 0xa1, 0x95, 0xe2, 0x2c, 0x81, 0xe3, 0x3d, 0xce,
+	0x53, 0x43, 0x6c, 0x6a, 0x89, 0x6f, 0x90, 0x72, 0xf0, 0x3b, 0xa8, 0xc0, 0xfc, 0xa7, 0xb2, 0x30,
+	0x53, 0x60, 0x6b, 0xb4, 0x0e, 0x6b, 0x4b, 0x3c, 0xa8, 0x35, 0x5a, 0x87, 0xc0, 0x25, 0xe0, 0x16,
+	0x5a, 0x94, 0xa3, 0x5a, 0x59, 0xcc, 0xb4, 0x80, 0x69, 0xe3, 0x38, 0x53, 0x6d, 0xf9, 0xf4, 0x64,
+	0x6b, 0x51, 0xfe, 0x80, 0x12, 0x85, 0x0f, 0x50, 0x31, 0x62, 0xae, 0x55, 0x59, 0xe2, 0x32, 0xbf,
+	0x94, 0xd1, 0x40, 0xc6, 0x22, 0xf6, 0x01, 0xfc, 0x13, 0x84, 0x10, 0xfc, 0x4d, 0xb6, 0x20, 0x8b,
+	0xd8, 0x58, 0x29, 0xc9, 0xc0, 0x91, 0x49, 0xa0, 0x8a, 0xa8, 0x62, 0xcb, 0xa2, 0xfe, 0x40, 0x4b,
+	0x63, 0x76, 0x3e, 0x23, 0xfb, 0x41, 0xbf, 0x82, 0x66, 0xb1, 0xf3, 0x3d, 0xc6, 0x22, 0xec, 0xe4,
+	0x9f, 0x20, 0x84, 0xb0, 0x51, 0xf1, 0x9c, 0xb0, 0x57, 0x59, 0x9e, 0x65, 0x54, 0x58, 0x18, 0x16,
+	0xa3, 0xc2, 0xbe, 0x80, 0x4b, 0xb0, 0xeb, 0x68, 0xd5, 0x58, 0x3d, 0xf0, 0x5b, 0xa8, 0x38, 0x38,
+	0x72, 0x22, 0xe5, 0xe9, 0xaf, 0xa8, 0x28, 0x70, 0xc8, 0x80, 0xcf, 0x4f, 0xb6, 0xd4, 0x84, 0xe6,
+	0xff, 0x20, 0x68, 0xed, 0xef, 0x59, 0xe8, 0x73, 0x67, 0x3b, 0x2e, 0x8b, 0xe1, 0xce, 0x60, 0x70,
+	0x9f, 0x8c, 0x2a, 0x96, 0x19, 0xc3, 0xf7, 0x38, 0x14, 0x24, 0x96, 0x87, 0xb2, 0x4e, 0x6f, 0x6f,
+	0x30, 0x18, 0x9f, 0x95, 0x4d, 0x09, 0x07, 0x4d, 0xc1, 0xa4, 0x92, 0x0f, 0x29, 0xf1, 0x3b, 0x95,
+	0xbc, 0x29, 0xf5, 0x2e, 0x87, 0x82, 0xc4, 0x26, 0x02, 0x10, 0xef, 0xbf, 0xb9, 0x02, 0xd0, 0xff,
+	0x17, 0x50, 0xd9, 0xf4, 0x45, 0x16, 0x49, 0x87, 0xa1, 0x27, 0xf9, 0x75, 0x24, 0x65, 0x8c, 0x0c,
+	0x8e, 0x09, 0x5a, 0x3c, 0x22, 0x4e, 0x87, 0x84, 0x6a, 0x8d, 0xb8, 0x33, 0x93, 0xab, 0x57, 0xdf,
+	0x11, 0xcc, 0x77, 0x7d, 0x1a, 0x8e, 0x6a, 0x6b, 0x52, 0xfc, 0xa2, 0x84, 0x82, 0x92, 0xcd, 0x3a,
+	0xa1, 0x4f, 0xe8, 0x51, 0x30, 0xd6, 0x09, 0x0d, 0x0e, 0x05, 0x89, 0x65, 0xbb, 0xb5, 0x27, 0x41,
+	0x67, 0xa4, 0x76, 0x65, 0x95, 0x82, 0xb9, 0x5b, 0xab, 0x25, 0x70, 0x60, 0x50, 0xe2, 0x10, 0xad,
+	0x47, 0x6e, 0xd7, 0x77, 0xfd, 0xae, 0x5c, 0x71, 0xc9, 0x53, 0x19, 0x59, 0xde, 0x9c, 0xd2, 0x22,
+	0x41, 0x7f, 0x9f, 0x8c, 0x9a, 0xc4, 0x23, 0x6d, 0x1a, 0x84, 0xb5, 0xeb, 0xa7, 0x27, 0x5b, 0xeb,
+	0xcd, 0x94, 0x34, 0x18, 0x93, 0x8f, 0x7f, 0xcb, 0x42, 0x1b, 0x6d, 0xcf, 0x65, 0x3e, 0x44, 0x42,
+	0xea, 0x3e, 0x75, 0xdb, 0x0e, 0x25, 0xb1, 0xfa, 0x85, 0x4c, 0xd3, 0x52, 0xd3, 0x93, 0x90, 0xf9,
+	0x63, 0x6d, 0xf3, 0xf4, 0x64, 0x6b, 0x63, 0x7f, 0xa2, 0x54, 0x38, 0x47, 0x23, 0xfe, 0x59, 0x54,
+	0x66, 0x79, 0x6b, 0x30, 0xa4, 0x4d, 0xd2, 0x0e, 0xfc, 0x4e, 0xc4, 0xe3, 0x57, 0xb1, 0xf6, 0x39,
+	0xd9, 0x81, 0xe5, 0x96, 0x81, 0x85, 0x14, 0xf5, 0xc6, 0x1d, 0xb4, 0x92, 0x1c, 0x50, 0xbc, 0x8e,
+	0xf2, 0x3d, 0x35, 0x1d, 0x80, 0x7d, 0xe2, 0xeb, 0xa8, 0x78, 0xec, 0x78, 0x43, 0x99, 0x38, 0x80,
+	0xf8, 0xb9, 0x93, 0xbb, 0x6d, 0xd9, 0x44, 0x4f, 0x4f, 0x11, 0x97, 0xd9, 0x22, 0xed, 0xf0, 0x39,
+	0x62, 0x99, 0x8b, 0xb4, 0x98, 0x20, 0x02, 0x87, 0x77, 0x50, 0xc9, 0x19, 0x0c, 0x9a, 0xc9, 0xad,
+	0xd3, 0x55, 0x49, 0x58, 0xda, 0x53, 0x08, 0x88, 0x69, 0xec, 0x3f, 0xcf, 0xa3, 0xd2, 0x7e, 0xe0,
+	0x3f, 0x75, 0xbb, 0x0d, 0x67, 0x70, 0x01, 0xdb, 0xe5, 0x16, 0x2a, 0x70, 0xe9, 0x62, 0x76, 0xec,
+	0x4e, 0x9b, 0x1d, 0xca, 0xb2, 0x6a, 0xdd, 0xa1, 0x8e, 0x98, 0x15, 0x7a, 0xdb, 0xcc, 0x40, 0xc0,
+	0xa5, 0x61, 0x0f, 0xa1, 0x27, 0xae, 0xef, 0x84, 0x23, 0x06, 0xe3, 0xe9, 0xe6, 0xf2, 0xee, 0xed,
+	0xcc, 0xb2, 0x6b, 0x9a, 0x55, 0x68, 0xd0, 0x2d, 0x88, 0x11, 0x90, 0x90, 0xbf, 0xf1, 0xd3, 0xa8,
+	0xa4, 0x89, 0x67, 0x19, 0xd3, 0x8d, 0xaf, 0xa1, 0xb5, 0x94, 0xae, 0x69, 0xec, 0x2b, 0x49, 0x97,
+	0x60, 0x75, 0x06, 0x6d, 0xf5, 0xe5, 0xab, 0x33, 0x68, 0xd3, 0x26, 0x6c, 0x78, 0xff, 0xc5, 0x42,
+	0xeb, 0x77, 0xa3, 0xb6, 0xe3, 0x39, 0xd4, 0x0d, 0xfc, 0xc3, 0xc0, 0x73, 0xdb, 0xa3, 0x0b, 0xf0,
+	0xb8, 0xc7, 0x46, 0x82, 0xf6, 0xd6, 0x94, 0x46, 0xa4, 0x0d, 0x9c, 0x58, 0xf4, 0xf9, 0x67, 0x0b,
+	0x5d, 0x4f, 0x13, 0x5f, 0xc0, 0x98, 0xb4, 0xcc, 0x31, 0xd9, 0x99, 0xb1, 0x39, 0x13, 0x86, 0xe6,
+	0xdf, 0xce, 0x68, 0xcc, 0xc5, 0x15, 0x43, 0x00, 0x15, 0xc3, 0xa1, 0x27, 0xeb, 0x44, 0xd3, 0x77,
+	0x87, 0xb1, 0xa1, 0x66, 0x51, 0x8b, 0xfd, 0x45, 0x20, 0x44, 0xd9, 0x7f, 0x66, 0xa1, 0xb2, 0x49,
+	0xc8, 0x16, 0xc3, 0x0e, 0xf1, 0x9c, 0x51, 0xc3, 0xf5, 0x87, 0x94, 0x44, 0xbc, 0x3d, 0xc5, 0x78,
+	0x31, 0xac, 0x27, 0x70, 0x60, 0x50, 0xe2, 0xf7, 0xd1, 0x22, 0x75, 0xc2, 0x2e, 0xa1, 0xb3, 0x77,
+	0x7b, 0x8b, 0xf3, 0xc5, 0x4b, 0xb9, 0xf8, 0x8f, 0x40, 0x09, 0xb4, 0xfd, 0xe4, 0xa4, 0x10, 0x58,
+	0x7c, 0x1b, 0x15, 0x7a, 0xae, 0xdf, 0x91, 0x3d, 0xfe, 0x63, 0xca, 0xfb, 0xee, 0xbb, 0x7e, 0xe7,
+	0xf9, 0xc9, 0xd6, 0xf5, 0x34, 0x3d, 0x83, 0x03, 0xe7, 0xc0, 0x37, 0x50, 0xc1, 0x8f, 0xbb, 0x5e,
+	0xfb, 0x2d, 0xef, 0x73, 0x8e, 0xe1, 0xd5, 0x91, 0x06, 0x89, 0x22, 0xa7, 0x4b, 0x2e, 0x5d, 0x75,
+	0x44, 0xda, 0xf5, 0xc2, 0xaa, 0x23, 0x4a, 0xde, 0xf9, 0xd5, 0x11, 0x56, 0x43, 0x90, 0x94, 0x97,
+	0xaf, 0x86, 0x20, 0x0d, 0x9b, 0x30, 0x6f, 0xff, 0x34, 0x87, 0xca, 0x92, 0x02, 0xc8, 0x07, 0x43,
+	0x12, 0xd1, 0x0b, 0x18, 0xd3, 0xa6, 0x31, 0xa6, 0xb7, 0xb2, 0x35, 0x40, 0x9a, 0x37, 0x71, 0x68,
+	0xbf, 0x9d, 0x1a, 0xda, 0xb7, 0x66, 0x13, 0x7b, 0xfe, 0x08, 0xff, 0x5e, 0x0e, 0x7d, 0xd1, 0x64,
+	0xa8, 0xb3, 0xfd, 0x61, 0xe8, 0xa8, 0xd4, 0xa7, 0xc3, 0x42, 0x03, 0x83, 0x90, 0x0e, 0xdb, 0xd2,
+	0xc9, 0x8e, 0x7b, 0x3d, 0x5b, 0xc7, 0x31, 0x8e, 0x64, 0x18, 0x89, 0xe5, 0x80, 0x21, 0x55, 0x68,
+	0xf1, 0x58, 0xa5, 0x72, 0xef, 0x29, 0x25, 0x61, 0x25, 0xf7, 0x59, 0xb4, 0xc4, 0x72, 0xc0, 0x90,
+	0xca, 0x72, 0x83, 0x90, 0x38, 0x51, 0xe0, 0xa7, 0x73, 0x03, 0xe0, 0x50, 0x90, 0x58, 0xfb, 0x3f,
+	0x72, 0x68, 0xd3, 0xec, 0x94, 0x38, 0xae, 0xc8, 0x6e, 0x79, 0x0d, 0x2d, 0x0c, 0xf8, 0x5a, 0x90,
+	0xce, 0xe0, 0xc4, 0x0a, 0x01, 0x12, 0xcb, 0xb6, 0xa6, 0x1e, 0x39, 0x26, 0x9e, 0xac, 0x44, 0x69,
+	0x5f, 0x3d, 0x60, 0x40, 0x10, 0x38, 0x7c, 0x8c, 0xb0, 0xe7, 0x24, 0x95, 0xf0, 0x9e, 0xce, 0xcf,
+	0xdc, 0x07, 0x1b, 0x52, 0x3a, 0x3e, 0x18, 0x93, 0x06, 0x67, 0x68, 0x60, 0x7a, 0x7d, 0xf2, 0x61,
+	0x5a, 0x6f, 0x61, 0x7e, 0xbd, 0x0f, 0xc6, 0xa4, 0xc1, 0x19, 0x1a, 0xec, 0x7f, 0xb4, 0x10, 0x36,
+	0xfb, 0xf7, 0x02, 0xa2, 0x0b, 0x98, 0xd1, 0xe5, 0xe6, 0x4c, 0xb3, 0x68, 0x42, 0x90, 0xf9, 0x24,
+	0x87, 0xbe, 0x60, 0x12, 0x02, 0xa1, 0xe1, 0x48, 0xfa, 0xc8, 0x1b, 0x68, 0xc9, 0xa1, 0xac, 0xd0,
+	0x4f, 0xd5, 0x8a, 0xaa, 0xed, 0xdb, 0x93, 0x70, 0xd0, 0x14, 0xb8, 0x8f, 0xd6, 0xd8, 0x10, 0x49,
+	0x0c, 0x1f, 0x89, 0xd9, 0x67, 0xc1, 0xe7, 0xa5, 0x82, 0xb5, 0x03, 0x53, 0x14, 0xa4, 0x65, 0x33,
+	0x75, 0x6c, 0x64, 0x92, 0xea, 0xf2, 0xf3, 0xab, 0x7b, 0x60, 0x8a, 0x82, 0xb4, 0x6c, 0x96, 0x7d,
+	0x71, 0x07, 0x0c, 0xc3, 0x20, 0xac, 0x14, 0xcc, 0xec, 0xeb, 0x40, 0x21, 0x20, 0xa6, 0xb1, 0xbf,
+	0x97, 0x4f, 0xfb, 0xc8, 0x1c, 0xbb, 0xae, 0xf4, 0x91, 0x4c, 0x6e, 0xbe, 0x23, 0x99, 0xfc, 0xcc,
+	0x47, 0x32, 0x85, 0xac, 0x47, 0x32, 0xf8, 0x03, 0x54, 0x3a, 0x76, 0x42, 0x97, 0x9d, 0x66, 0x89,
+	0x53, 0xbb, 0xe5, 0xdd, 0x9f, 0x9b, 0x79, 0xdd, 0xa8, 0xbe, 0xab, 0x44, 0x88, 0x34, 0x4d, 0x77,
+	0xad, 0x86, 0x43, 0xac, 0x65, 0xe3, 0xab, 0xa8, 0x6c, 0xd2, 0xcf, 0x94, 0x7d, 0xff, 0xdf, 0x12,
+	0xba, 0x7e, 0xd6, 0x12, 0x83, 0xef, 0xa8, 0x22, 0x99, 0xb9, 0x37, 0xd3, 0x45, 0xb2, 0x6b, 0x26,
+	0x57, 0xb2, 0x56, 0xa6, 0x22, 0x60, 0x2b, 0x74, 0xfc, 0xc8, 0xd5, 0x91, 0x28, 0xf7, 0xd9, 0x22,
+	0xa0, 0x29, 0x0d, 0xce, 0xd0, 0x80, 0xbb, 0x68, 0x81, 0x30, 0x77, 0x53, 0x1b, 0xec, 0xaf, 0xcf,
+	0xb1, 0xb6, 0x56, 0xb9, 0xc3, 0xca, 0x9e, 0x8f, 0x6b, 0x6e, 0x1c, 0x08, 0x52, 0x3c, 0xdb, 0xff,
+	0x3b, 0x1e, 0x09, 0x25, 0x4b, 0xa5, 0x60, 0xee, 0xff, 0xf7, 0x62, 0x14, 0x24, 0xe9, 0x70, 0x0f,
+	0x2d, 0x86, 0x84, 0x86, 0xee, 0xbc, 0xbe, 0x21, 0x0c, 0x04, 0x21, 0x22, 0x55, 0x3a, 0x93, 0x50,
+	0x50, 0x1a, 0xf0, 0xaf, 0xa2, 0x65, 0xa2, 0x03, 0xb5, 0x38, 0x17, 0x5e, 0xde, 0xad, 0xcf, 0xd5,
+	0x23, 0xb1, 0x18, 0xa1, 0x54, 0xb7, 0x34, 0x81, 0x81, 0xa4, 0x36, 0x1c, 0xa1, 0x52, 0x47, 0xee,
+	0x3c, 0xc4, 0x19, 0xe8, 0xf2, 0x6e, 0x6d, 0x1e, 0xd5, 0x6a, 0xfb, 0x92, 0x9e, 0x09, 0x1a, 0x0e,
+	0xb1, 0x9e, 0x8d, 0x9f, 0x41, 0xcb, 0x89, 0xc1, 0x9b, 0xa9, 0x60, 0x41, 0xd1, 0x4a, 0xb2, 0x5b,
+	0xcf, 0xe0, 0x7d, 0x90, 0xe4, 0x9d, 0x5e, 0x74, 0x99, 0xb8, 0x8e, 0x24, 0xb5, 0xfe, 0x5a, 0x32,
+	0x25, 0x9a, 0xa8, 0xb9, 0x69, 0x6a, 0xfe, 0xda, 0x4c, 0x9a, 0xd3, 0x5b, 0x9d, 0xa4, 0xfa, 0x11,
+	0x2a, 0x9b, 0xfd, 0x7b, 0x86, 0xf2, 0x47, 0xa6, 0xf2, 0xb7, 0x67, 0x52, 0x6e, 0x6e, 0x3e, 0x8d,
+	0xb0, 0xb3, 0xa0, 0x53, 0x91, 0xf9, 0x16, 0x02, 0x15, 0x77, 0xcf, 0x5a, 0x08, 0x20, 0x81, 0x03,
+	0x83, 0x12, 0xb7, 0xd0, 0x9a, 0xfa, 0x97, 0x45, 0x47, 0xb9, 0x79, 0x7c, 0x5d, 0xad, 0x7d, 0x60,
+	0xa2, 0x9f, 0x8f, 0x83, 0x20, 0x2d, 0x82, 0x59, 0xef, 0x76, 0x88, 0x4f, 0x5d, 0x3a, 0xaa, 0x14,
+	0x4c, 0xeb, 0xef, 0x49, 0x38, 0x68, 0x0a, 0x46, 0xcd, 0xee, 0x96, 0xf8, 0xf1, 0x6d, 0x04, 0x4d,
+	0xfd, 0x58, 0xc2, 0x41, 0x53, 0xb0, 0xad, 0xa9, 0x28, 0x86, 0xcb, 0x1b, 0x08, 0x3a, 0x24, 0x89,
+	0x82, 0x2b, 0x48, 0x2c, 0x4b, 0x88, 0x59, 0x5d, 0xbb, 0xb2, 0x68, 0x26, 0xc4, 0xac, 0xf2, 0x0d,
+	0x1c, 0x83, 0xeb, 0x68, 0x5d, 0x5e, 0x70, 0x90, 0x3d, 0x7f, 0xaf, 0xce, 0x8f, 0x94, 0x4a, 0xb5,
+	0x8a, 0xa4, 0x5e, 0xdf, 0x4f, 0xe1, 0x61, 0x8c, 0x03, 0xef, 0xa1, 0x35, 0xc7, 0x73, 0xc2, 0xbe,
+	0xd8, 0x19, 0xf3, 0xee, 0x2f, 0x71, 0x21, 0x7a, 0xf7, 0xb0, 0x67, 0xa2, 0x21, 0x4d, 0x9f, 0x12,
+	0xd1, 0x1a, 0x0d, 0x48, 0x05, 0x4d, 0x14, 0xc1, 0xd0, 0x90, 0xa6, 0xc7, 0x0d, 0x74, 0x2d, 0x35,
+	0x08, 0xdc, 0x92, 0x65, 0x2e, 0xe6, 0x47, 0xa5, 0x98, 0x6b, 0x30, 0x4e, 0x02, 0x67, 0xf1, 0xb1,
+	0xfd, 0x8c, 0xbc, 0x79, 0x73, 0xaf, 0x5e, 0x59, 0x31, 0xf7, 0x33, 0xfb, 0x0a, 0x01, 0x31, 0x0d,
+	0x76, 0x51, 0x59, 0x1e, 0x9f, 0x48, 0x57, 0xaf, 0xac, 0x66, 0x3a, 0xf0, 0x7b, 0xcf, 0x60, 0x12,
+	0x5b, 0x0a, 0x13, 0x06, 0x29, 0xc1, 0xf8, 0x17, 0x10, 0xee, 0x1b, 0xb3, 0x8a, 0xb7, 0xb4, 0xcc,
+	0x8d, 0xd4, 0x0b, 0x64, 0x63, 0x8c, 0x02, 0xce, 0xe0, 0xb2, 0xff, 0xbb, 0x88, 0x56, 0x8d, 0x5a,
+	0x41, 0x7c, 0x16, 0x66, 0x4d, 0x38, 0x0b, 0x93, 0xe4, 0x97, 0x62, 0x7d, 0x4f, 0x2d, 0xbb, 0xf9,
+	0x8c, 0xcb, 0xee, 0x00, 0xad, 0x3b, 0xed, 0x9e, 0x1f, 0x3c, 0xf3, 0x48, 0xa7, 0x2b, 0x13, 0xdf,
+	0xd9, 0xd3, 0x22, 0x3d, 0x49, 0xf6, 0x52, 0xb2, 0x60, 0x4c, 0x3a, 0x3b, 0x4f, 0x49, 0xc2, 0x6a,
+	0x23, 0x39, 0xd1, 0xf5, 0x79, 0xca, 0x9e, 0x81, 0x85, 0x14, 0x35, 0x6e, 0xa2, 0x55, 0x99, 0xea,
+	0xf2, 0x55, 0x43, 0xdd, 0x3e, 0xba, 0x29, 0xd9, 0x57, 0xeb, 0x49, 0x24, 0xab, 0x94, 0xc9, 0x51,
+	0x32, 0xe0, 0x60, 0xca, 0x60, 0x46, 0x29, 0x80, 0xc8, 0x90, 0x2b, 0x8b, 0xa6, 0x51, 0x75, 0x03,
+	0x0b, 0x29, 0xea, 0xb1, 0xda, 0xc1, 0xd2, 0x4b, 0xa9, 0x1d, 0x7c, 0x03, 0x5d, 0xed, 0xb8, 0x5d,
+	0x12, 0x51, 0xd9, 0xa4, 0x44, 0x84, 0xf9, 0x82, 0x64, 0xbf, 0x5a, 0x4f, 0x13, 0xc0, 0x38, 0x8f,
+	0xfd, 0xc7, 0x79, 0xb4, 0xfc, 0xd0, 0xdf, 0x77, 0x3c, 0xef, 0xc0, 0x19, 0x89, 0x00, 0xc9, 0x43,
+	0xae, 0x35, 0xa9, 0x62, 0x68, 0x66, 0x09, 0xb9, 0x29, 0x59, 0xc2, 0x43, 0x54, 0x8c, 0xa8, 0x13,
+	0xd2, 0x39, 0xf2, 0x2c, 0x9d, 0x7d, 0x36, 0x99, 0x00, 0x10, 0x72, 0xf0, 0x03, 0xb4, 0x12, 0x06,
+	0x54, 0xa4, 0xd5, 0xa3, 0x81, 0xf0, 0xd0, 0x78, 0x5d, 0x5a, 0x81, 0x04, 0xee, 0xf9, 0xc9, 0x16,
+	0x16, 0x4d, 0x4b, 0x42, 0xc1, 0xe0, 0x67, 0x93, 0x25, 0x3a, 0x72, 0x9f, 0xd2, 0x03, 0xe2, 0x77,
+	0xe9, 0x11, 0x77, 0xc0, 0x62, 0x3c, 0x59, 0x9a, 0x31, 0x0a, 0x92, 0x74, 0xf8, 0x3b, 0x6c, 0x6d,
+	0x8d, 0x68, 0xe8, 0xb6, 0x93, 0xfb, 0xc6, 0x69, 0x67, 0xa1, 0xd2, 0x9a, 0x98, 0x31, 0xb9, 0x1a,
+	0xc7, 0xd2, 0xc0, 0x90, 0x6d, 0xff, 0xaf, 0x85, 0xca, 0x82, 0xf3, 0xe1, 0x31, 0x09, 0x43, 0xb7,
+	0xc3, 0xef, 0x0b, 0xaa, 0x3e, 0x4e, 0x6f, 0x04, 0xd4, 0x30, 0x80, 0xa6, 0x60, 0x97, 0x19, 0x78,
+	0xe7, 0x45, 0x7b, 0x74, 0x8e, 0xf0, 0x13, 0x9f, 0xbe, 0x4b, 0x19, 0xa0, 0xa5, 0x61, 0x40, 0x0b,
+	0xc4, 0xef, 0x44, 0x7b, 0xf3, 0x8c, 0x6f, 0x9c, 0x35, 0x70, 0x09, 0x20, 0x25, 0xd9, 0x01, 0xba,
+	0x3a, 0xd6, 0x4f, 0x6c, 0xe9, 0xe1, 0x4a, 0x75, 0x39, 0x2e, 0xb1, 0xf4, 0x34, 0x15, 0x02, 0x62,
+	0x1a, 0xfc, 0x93, 0x68, 0x91, 0xf8, 0x1d, 0x1d, 0x71, 0x4b, 0x71, 0x0a, 0x70, 0x57, 0x80, 0x41,
+	0xe1, 0xed, 0x7f, 0xd2, 0xfd, 0xdb, 0x6c, 0x1f, 0x91, 0xce, 0xc5, 0x5c, 0x3a, 0x9e, 0xad, 0x6a,
+	0x6a, 0x9a, 0x37, 0xf1, 0x10, 0x8a, 0xd5, 0x98, 0x4c, 0xd2, 0xcb, 0x57, 0x63, 0x32, 0xed, 0x9b,
+	0x74, 0x19, 0x2e, 0x97, 0x6e, 0xc8, 0x85, 0xde, 0xc5, 0xa5, 0x6e, 0x9f, 0xbc, 0x1f, 0xf8, 0xea,
+	0xea, 0x4b, 0xac, 0x44, 0xc2, 0x41, 0x53, 0xb0, 0x19, 0xe0, 0xb1, 0xc0, 0x29, 0xca, 0x1f, 0xd3,
+	0xcf, 0x34, 0x12, 0xb1, 0x36, 0x9e, 0x01, 0xfc, 0x37, 0x02, 0x29, 0x09, 0xff, 0x12, 0x2a, 0x05,
+	0x72, 0xa6, 0xab, 0x14, 0x38, 0x5b, 0xaf, 0xaa, 0xf8, 0x10, 0xcf, 0x0d, 0x05, 0x89, 0x20, 0x16,
+	0xc9, 0xea, 0xdf, 0x3a, 0x4c, 0x5c, 0x80, 0xab, 0x37, 0x0c, 0x57, 0x9f, 0x76, 0xe1, 0x49, 0x19,
+	0x36, 0xf1, 0x68, 0xe0, 0x71, 0xea, 0x68, 0xe0, 0x66, 0x56, 0x81, 0xe7, 0x1f, 0x0a, 0xfc, 0x91,
+	0x85, 0xd6, 0x15, 0xa9, 0x5a, 0xe2, 0xf1, 0x57, 0xd1, 0xa2, 0xdc, 0xda, 0x4b, 0x7f, 0xb3, 0x55,
+	0x14, 0x39, 0x27, 0xf1, 0x51, 0x2c, 0xec, 0xb4, 0x8b, 0xce, 0xb7, 0xe5, 0xd3, 0xed, 0x66, 0x7f,
+	0xc0, 0xa5, 0xd8, 0xff, 0x6e, 0x21, 0x5d, 0x57, 0x13, 0x4b, 0x3b, 0xbb, 0xfe, 0x1e, 0x91, 0x63,
+	0x12, 0xba, 0xd4, 0x25, 0xea, 0x06, 0x24, 0xbf, 0xfe, 0xde, 0xd4, 0x50, 0x48, 0x50, 0xb0, 0x94,
+	0xc2, 0xf5, 0x29, 0x09, 0x8f, 0x1d, 0x4f, 0x9d, 0x7a, 0x8a, 0x12, 0xbd, 0x4e, 0x29, 0xee, 0x99,
+	0x68, 0x48, 0xd3, 0xb3, 0xb8, 0xda, 0x36, 0x52, 0xc2, 0xb5, 0x54, 0x8f, 0xc4, 0xcd, 0xe7, 0xb3,
+	0xd5, 0xb8, 0x69, 0x94, 0x98, 0xad, 0x02, 0x0e, 0x9a, 0xc2, 0xfe, 0x07, 0x0b, 0xad, 0x1a, 0x65,
+	0xc3, 0x0b, 0xf0, 0x4c, 0x30, 0x3c, 0xf3, 0xcd, 0x8c, 0x8e, 0xc4, 0xad, 0x9b, 0x18, 0x83, 0xff,
+	0xde, 0x42, 0x57, 0x0d, 0xca, 0x0b, 0x08, 0xc1, 0x8f, 0xcc, 0x10, 0xfc, 0xc6, 0x2c, 0x0d, 0x99,
+	0x10, 0x81, 0xff, 0x27, 0xdd, 0x8c, 0x8b, 0x0b, 0xc0, 0x33, 0x95, 0xa1, 0xeb, 0x68, 0x9d, 0xa4,
+	0x6e, 0x2a, 0x48, 0x67, 0xd3, 0x99, 0x48, 0xfa, 0x26, 0x03, 0x8c, 0x71, 0xd8, 0x7f, 0x6d, 0x21,
+	0x5d, 0x0f, 0xb9, 0x80, 0xf1, 0x3a, 0x30, 0xc7, 0xeb, 0x27, 0x32, 0x8e, 0xd7, 0x84, 0xa1, 0xfa,
+	0x41, 0x0e, 0xe9, 0x1c, 0xfe, 0x30, 0x54, 0x37, 0xdd, 0x22, 0x63, 0x21, 0xb3, 0xa6, 0x2e, 0x64,
+	0x04, 0xa1, 0x0f, 0x86, 0x2e, 0xa1, 0xef, 0x04, 0x43, 0x7d, 0x5b, 0xf1, 0x56, 0x46, 0xc3, 0x1e,
+	0x69, 0xc6, 0x78, 0xc2, 0xc5, 0x30, 0x48, 0x08, 0xc6, 0x1d, 0x84, 0x42, 0x87, 0x92, 0x03, 0xb7,
+	0xef, 0x52, 0x55, 0x80, 0xce, 0x3a, 0xf1, 0x40, 0x31, 0xc6, 0x5a, 0x34, 0x28, 0x82, 0x84, 0x5c,
+	0xfc, 0x08, 0x2d, 0x88, 0x54, 0x27, 0xe3, 0x13, 0x19, 0x33, 0xae, 0x8a, 0xfb, 0xcf, 0xe2, 0x1b,
+	0xa4, 0x20, 0xfb, 0x2f, 0x2c, 0x84, 0xc7, 0xdb, 0x8b, 0xdf, 0x46, 0x4b, 0x32, 0xde, 0x25, 0xaf,
+	0xa0, 0x2f, 0xc9, 0x60, 0x18, 0x9d, 0xb5, 0x3e, 0x68, 0x06, 0x73, 0x57, 0x9b, 0x9b, 0x6d, 0x57,
+	0x9b, 0x9f, 0xb2, 0xab, 0xfd, 0xcb, 0xc4, 0x04, 0xd6, 0xbd, 0xf4, 0x19, 0x17, 0x34, 0x76, 0xb0,
+	0xcb, 0xc4, 0x8c, 0x1d, 0xec, 0x32, 0x20, 0x08, 0x1c, 0x7e, 0x1b, 0xad, 0x0e, 0x48, 0xe8, 0x06,
+	0x1d, 0xb5, 0xc4, 0xe4, 0x39, 0xf1, 0x8f, 0xa8, 0xac, 0xfc, 0x30, 0x89, 0x04, 0x93, 0xd6, 0xfe,
+	0xd7, 0x7c, 0x3c, 0x11, 0x2f, 0x74, 0xcb, 0xa7, 0x6b, 0x8d, 0xf9, 0xa9, 0xb5, 0xc6, 0xef, 0x5a,
+	0x08, 0xc9, 0x32, 0xa5, 0x4b, 0xd4, 0xbe, 0xef, 0xed, 0x19, 0xb6, 0x35, 0xd5, 0x7b, 0x9a, 0x5b,
+	0x14, 0xec, 0x7f, 0x5c, 0xb9, 0x73, 0x8c, 0xf8, 0xee, 0x7f, 0x8e, 0x8f, 0x43, 0x42, 0x2b, 0x26,
+	0x68, 0x79, 0x10, 0xcf, 0x75, 0x79, 0x17, 0x77, 0x37, 0xa3, 0x11, 0x89, 0x28, 0x51, 0x5b, 0x63,
+	0x3d, 0x93, 0x00, 0x40, 0x52, 0x2e, 0xbb, 0xa2, 0x98, 0x32, 0x76, 0xa6, 0x73, 0xb3, 0x61, 0xbc,
+	0x65, 0x91, 0x55, 0xa6, 0x36, 0x42, 0xb2, 0x60, 0xa2, 0xb6, 0x2c, 0xd3, 0xaf, 0x4f, 0xa5, 0xb7,
+	0x65, 0xf1, 0xf4, 0xaf, 0x6b, 0x51, 0x90, 0x10, 0x6b, 0xff, 0xa6, 0x85, 0xae, 0x8e, 0xdd, 0x3b,
+	0x66, 0xb3, 0x4d, 0x3f, 0x05, 0x4c, 0xe7, 0x90, 0xfa, 0xbd, 0x20, 0xc4, 0x34, 0xd3, 0x6f, 0x4f,
+	0xe1, 0x57, 0x44, 0x5f, 0xe4, 0xcd, 0xeb, 0xdf, 0xec, 0x36, 0x3b, 0x83, 0xdb, 0x1d, 0xb4, 0x96,
+	0xba, 0x7f, 0xfc, 0x12, 0x8c, 0xe0, 0x57, 0xb8, 0x9a, 0xae, 0xc7, 0xc5, 0x5f, 0xb6, 0x2b, 0x5c,
+	0xd2, 0xae, 0x17, 0x76, 0x85, 0x4b, 0xc9, 0x9b, 0x7e, 0x85, 0x4b, 0x52, 0x5e, 0xbe, 0x2b, 0x5c,
+	0xd2, 0xb0, 0x09, 0x8b, 0xf9, 0x1f, 0x5a, 0xa8, 0x2c, 0x29, 0xf8, 0xfb, 0xcc, 0x4c, 0x25, 0xb9,
+	0x57, 0x8d, 0x09, 0x18, 0x4b, 0x7e, 0x97, 0x01, 0xe5, 0x7c, 0xc4, 0x75, 0xb4, 0x14, 0x0c, 0x48,
+	0xe8, 0xd0, 0x20, 0x94, 0x0e, 0xbb, 0xad, 0x1a, 0xf5, 0x50, 0xc2, 0x59, 0x8d, 0x34, 0xa9, 0x5c,
+	0xc1, 0x41, 0x73, 0xda, 0x7f, 0x92, 0xd7, 0x5d, 0x3b, 0x47, 0x7c, 0xfe, 0x36, 0x5a, 0xea, 0x8b,
+	0x56, 0x65, 0x2d, 0x17, 0x98, 0x7d, 0x11, 0x0b, 0x97, 0x80, 0x08, 0xb4, 0x40, 0xa3, 0xcc, 0x95,
+	0x7f, 0x49, 0x65, 0xae, 0xc2, 0x8b, 0x2a, 0x73, 0xf1, 0xc3, 0x94, 0x90, 0x38, 0x34, 0x51, 0xf7,
+	0x8e, 0x0f, 0x53, 0x14, 0x02, 0x62, 0x1a, 0x9e, 0x79, 0x05, 0xfd, 0x3e, 0xf1, 0x69, 0x65, 0xc1,
+	0x5c, 0xfb, 0xf7, 0x05, 0x18, 0x14, 0x9e, 0x5d, 0x46, 0x5e, 0x35, 0x66, 0x0a, 0xcb, 0xfc, 0xa2,
+	0xe1, 0x60, 0x10, 0x92, 0x28, 0x22, 0x9d, 0xfd, 0x60, 0xe8, 0x8b, 0x47, 0x85, 0xf9, 0x38, 0xf3,
+	0x6b, 0x9a, 0x68, 0x48, 0xd3, 0xab, 0xe3, 0x8c, 0x98, 0xee, 0x45, 0x1c, 0x67, 0x98, 0xd2, 0xe0,
+	0x0c, 0x0d, 0xf6, 0xdf, 0x58, 0x48, 0xe7, 0x8b, 0x97, 0xae, 0x5a, 0xa1, 0x0c, 0x9b, 0x98, 0x0e,
+	0xb2, 0xcc, 0x42, 0x11, 0x5d, 0xbe, 0xcc, 0x42, 0x59, 0x36, 0x21, 0x18, 0x7d, 0x0b, 0xad, 0x2b,
+	0x8a, 0x86, 0x13, 0xf6, 0x3a, 0xc1, 0x33, 0x5f, 0x9f, 0xa0, 0x5a, 0x13, 0x4f, 0x50, 0x5f, 0x45,
+	0x45, 0xea, 0x52, 0x6f, 0x2c, 0x1a, 0xb5, 0x18, 0x10, 0x04, 0xce, 0xfe, 0x8d, 0x42, 0xdc, 0x2f,
+	0x17, 0xb7, 0xd1, 0xfb, 0x22, 0x2a, 0xf4, 0xc8, 0x48, 0x65, 0x95, 0xfc, 0x09, 0xdc, 0x7d, 0x32,
+	0x8a, 0x80, 0x43, 0xf1, 0x70, 0xd2, 0x53, 0xcd, 0xaf, 0x64, 0xec, 0xc6, 0xf9, 0xde, 0x6a, 0x3e,
+	0x4a, 0xbd, 0xd5, 0xbc, 0x99, 0x51, 0xdb, 0x39, 0x8f, 0x35, 0xef, 0xa1, 0x02, 0x25, 0x1f, 0xd2,
+	0xca, 0xc2, 0x4c, 0x4e, 0xdc, 0x22, 0x1f, 0x52, 0xd1, 0x29, 0xec, 0x0b, 0xb8, 0x08, 0xfc, 0x2d,
+	0x16, 0xb2, 0xc5, 0xd8, 0xcb, 0xe7, 0x9a, 0x3b, 0x19, 0xc5, 0x29, 0x97, 0x11, 0x4f, 0x21, 0xd5,
+	0x1f, 0x68, 0x71, 0xf6, 0xef, 0x5a, 0xe8, 0xf3, 0x13, 0xba, 0x8e, 0xbd, 0xcf, 0x53, 0xa5, 0x21,
+	0xed, 0x10, 0x7a, 0xe2, 0xb6, 0x34, 0x06, 0x12, 0x54, 0xcc, 0x35, 0xd9, 0x23, 0xb2, 0xf4, 0x56,
+	0x89, 0x3d, 0x35, 0x03, 0x8e, 0xd1, 0xce, 0x9b, 0x9f, 0xe4, 0xbc, 0xf6, 0x37, 0x63, 0xb7, 0x64,
+	0x9d, 0x90, 0xc1, 0xdd, 0xe3, 0xab, 0x07, 0xb9, 0xf3, 0xae, 0x1e, 0xd8, 0xbf, 0x9f, 0x43, 0x65,
+	0x73, 0xe8, 0xe6, 0x6a, 0xa4, 0x7c, 0x71, 0x98, 0x9b, 0xf0, 0xe2, 0xb0, 0x8e, 0xd6, 0xfb, 0xae,
+	0xef, 0x1e, 0x86, 0x41, 0x37, 0x74, 0xfa, 0xe2, 0x15, 0x65, 0xde, 0xac, 0x87, 0x34, 0x52, 0x78,
+	0x18, 0xe3, 0x60, 0x17, 0x07, 0x12, 0xb0, 0x43, 0x76, 0xb2, 0xed, 0xd0, 0xa3, 0x4a, 0xc1, 0xbc,
+	0x38, 0xd0, 0x18, 0x27, 0x81, 0xb3, 0xf8, 0x74, 0x27, 0x16, 0x27, 0x76, 0xfb, 0x1f, 0xe4, 0x50,
+	0xea, 0x84, 0x3f, 0xf1, 0xa8, 0xd1, 0x3a, 0xf7, 0x51, 0xe3, 0x94, 0x0e, 0x49, 0x3c, 0xc1, 0xcc,
+	0x67, 0x7a, 0x82, 0x69, 0x9a, 0x91, 0xf5, 0x09, 0xa6, 0x6a, 0x62, 0x61, 0x52, 0x13, 0x3f, 0xcb,
+	0xeb, 0xbf, 0xda, 0xf6, 0x47, 0x9f, 0x6e, 0x5e, 0xf9, 0xf8, 0xd3, 0xcd, 0x2b, 0x9f, 0x7c, 0xba,
+	0x79, 0xe5, 0xd7, 0x4f, 0x37, 0xad, 0x8f, 0x4e, 0x37, 0xad, 0x8f, 0x4f, 0x37, 0xad, 0x4f, 0x4e,
+	0x37, 0xad, 0xff, 0x3a, 0xdd, 0xb4, 0x7e, 0xe7, 0x07, 0x9b, 0x57, 0xde, 0xcf, 0x1d, 0xdf, 0xfa,
+	0xe1, 0x00, 0xb4, 0xb4, 0x1f, 0x07, 0x59, 0x48, 0x00, 0x00,
+}
+
+func (m *AuditRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *ReceiverGroup) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuditRule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuditRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2128,7 +2308,7 @@ func (m *ReceiverGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ReceiverGroupList) Marshal() (dAtA []byte, err error) {
+func (m *AuditRuleList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2138,12 +2318,12 @@ func (m *ReceiverGroupList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReceiverGroupList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuditRuleList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuditRuleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2175,7 +2355,7 @@ func (m *ReceiverGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ReceiverGroupSpec) Marshal() (dAtA []byte, err error) {
+func (m *AuditRuleMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2185,39 +2365,72 @@ func (m *ReceiverGroupSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReceiverGroupSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuditRuleMatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverGroupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuditRuleMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Receivers) > 0 {
-		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Receivers[iNdEx])
-			copy(dAtA[i:], m.Receivers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+	if len(m.Codes) > 0 {
+		for iNdEx := len(m.Codes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Codes[iNdEx]))
+			i--
+			dAtA[i] = 0x30
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resources[iNdEx])))
 			i--
 			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
-	dAtA[i] = 0xa
+	if len(m.Verbs) > 0 {
+		for iNdEx := len(m.Verbs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Verbs[iNdEx])
+			copy(dAtA[i:], m.Verbs[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Verbs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Users[iNdEx])
+			copy(dAtA[i:], m.Users[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Users[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReceiverList) Marshal() (dAtA []byte, err error) {
+func (m *AuditRuleSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2227,32 +2440,46 @@ func (m *ReceiverList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReceiverList) MarshalTo(dAtA []byte) (int, error) {
+func (m *AuditRuleSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuditRuleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	if len(m.ReceiverGroups) > 0 {
+		for iNdEx := len(m.ReceiverGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiverGroups[iNdEx])
+			copy(dAtA[i:], m.ReceiverGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverGroups[iNdEx])))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ChannelName)
+	copy(dAtA[i:], m.ChannelName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChannelName)))
+	i--
+	dAtA[i] = 0x2a
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Match.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2260,11 +2487,29 @@ func (m *ReceiverList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x22
+	i--
+	if m.Disabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ReceiverSpec) Marshal() (dAtA []byte, err error) {
+func (m *Channel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2274,59 +2519,50 @@ func (m *ReceiverSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReceiverSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Identities) > 0 {
-		keysForIdentities := make([]string, 0, len(m.Identities))
-		for k := range m.Identities {
-			keysForIdentities = append(keysForIdentities, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForIdentities)
-		for iNdEx := len(keysForIdentities) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Identities[ReceiverChannel(keysForIdentities[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForIdentities[iNdEx])
-			copy(dAtA[i:], keysForIdentities[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForIdentities[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i -= len(m.Username)
-	copy(dAtA[i:], m.Username)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Template) Marshal() (dAtA []byte, err error) {
+func (m *ChannelDingTalk) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2336,40 +2572,63 @@ func (m *Template) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelDingTalk) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelDingTalk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Secret)
+	copy(dAtA[i:], m.Secret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Secret)))
 	i--
 	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i -= len(m.WebhookURL)
+	copy(dAtA[i:], m.WebhookURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelLark) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelLark) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelLark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Secret)
+	copy(dAtA[i:], m.Secret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Secret)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.WebhookURL)
+	copy(dAtA[i:], m.WebhookURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookURL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateList) Marshal() (dAtA []byte, err error) {
+func (m *ChannelList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2379,12 +2638,12 @@ func (m *TemplateList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2416,7 +2675,7 @@ func (m *TemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateSpec) Marshal() (dAtA []byte, err error) {
+func (m *ChannelSMTP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2426,19 +2685,156 @@ func (m *TemplateSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelSMTP) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelSMTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Text != nil {
+	i -= len(m.Password)
+	copy(dAtA[i:], m.Password)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Password)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Email)
+	copy(dAtA[i:], m.Email)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Email)))
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.TLS {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SMTPPort))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.SMTPHost)
+	copy(dAtA[i:], m.SMTPHost)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SMTPHost)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSlack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSlack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSlack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.WebhookURL)
+	copy(dAtA[i:], m.WebhookURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lark != nil {
 		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Lark.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.WeCom != nil {
+		{
+			size, err := m.WeCom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.DingTalk != nil {
+		{
+			size, err := m.DingTalk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Slack != nil {
+		{
+			size, err := m.Slack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Webhook != nil {
+		{
+			size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SMTP != nil {
+		{
+			size, err := m.SMTP.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2472,29 +2868,29 @@ func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
 	i -= len(m.DisplayName)
 	copy(dAtA[i:], m.DisplayName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	i -= len(m.TenantID)
 	copy(dAtA[i:], m.TenantID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateTencentCloudSMS) Marshal() (dAtA []byte, err error) {
+func (m *ChannelStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2504,35 +2900,25 @@ func (m *TemplateTencentCloudSMS) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateTencentCloudSMS) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateTencentCloudSMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Body)
-	copy(dAtA[i:], m.Body)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
 	dAtA[i] = 0x1a
-	i -= len(m.Sign)
-	copy(dAtA[i:], m.Sign)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sign)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.TemplateID)
-	copy(dAtA[i:], m.TemplateID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateID)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateText) Marshal() (dAtA []byte, err error) {
+func (m *ChannelTencentCloudSMS) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2542,30 +2928,35 @@ func (m *TemplateText) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateText) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelTencentCloudSMS) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelTencentCloudSMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Header)
-	copy(dAtA[i:], m.Header)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i -= len(m.Extend)
+	copy(dAtA[i:], m.Extend)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Extend)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.SdkAppID)
+	copy(dAtA[i:], m.SdkAppID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SdkAppID)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Body)
-	copy(dAtA[i:], m.Body)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i -= len(m.AppKey)
+	copy(dAtA[i:], m.AppKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppKey)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TemplateWechat) Marshal() (dAtA []byte, err error) {
+func (m *ChannelWeCom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2575,1102 +2966,12138 @@ func (m *TemplateWechat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TemplateWechat) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChannelWeCom) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateWechat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChannelWeCom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Body)
-	copy(dAtA[i:], m.Body)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i -= len(m.WebhookURL)
+	copy(dAtA[i:], m.WebhookURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WebhookURL)))
 	i--
-	dAtA[i] = 0x2a
-	i -= len(m.MiniProgramPagePath)
-	copy(dAtA[i:], m.MiniProgramPagePath)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MiniProgramPagePath)))
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.InsecureSkipVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x38
+	if m.ClientCertificateSecretRef != nil {
+		{
+			size, err := m.ClientCertificateSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SigningSecretRef != nil {
+		{
+			size, err := m.SigningSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.BodyTemplate)
+	copy(dAtA[i:], m.BodyTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.BodyTemplate)))
 	i--
 	dAtA[i] = 0x22
-	i -= len(m.MiniProgramAppID)
-	copy(dAtA[i:], m.MiniProgramAppID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MiniProgramAppID)))
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
 	i--
 	dAtA[i] = 0x1a
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	i -= len(m.URL)
 	copy(dAtA[i:], m.URL)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.TemplateID)
-	copy(dAtA[i:], m.TemplateID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateID)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Channel) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChannelWechat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChannelList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *ChannelWechat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChannelSMTP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ChannelWechat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SMTPHost)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.SMTPPort))
-	n += 2
-	l = len(m.Email)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Password)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.AppSecret)
+	copy(dAtA[i:], m.AppSecret)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppSecret)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.AppID)
+	copy(dAtA[i:], m.AppID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AppID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChannelSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.TencentCloudSMS != nil {
-		l = m.TencentCloudSMS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Wechat != nil {
-		l = m.Wechat.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.SMTP != nil {
-		l = m.SMTP.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Webhook != nil {
-		l = m.Webhook.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChannelStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChannelTencentCloudSMS) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AppKey)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SdkAppID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Extend)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ChannelWebhook) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BinaryData) > 0 {
+		keysForBinaryData := make([]string, 0, len(m.BinaryData))
+		for k := range m.BinaryData {
+			keysForBinaryData = append(keysForBinaryData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
+		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
+			baseI := i
+			if v != nil {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForBinaryData[iNdEx])
+			copy(dAtA[i:], keysForBinaryData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Headers) > 0 {
-		for k, v := range m.Headers {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Data) > 0 {
+		keysForData := make([]string, 0, len(m.Data))
+		for k := range m.Data {
+			keysForData = append(keysForData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Data[string(keysForData[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForData[iNdEx])
+			copy(dAtA[i:], keysForData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ChannelWechat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConfigMapList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AppID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AppSecret)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ConfigMap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ConfigMapList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigMapList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.BinaryData) > 0 {
-		for k, v := range m.BinaryData {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = 1 + len(v) + sovGenerated(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ConfigMapList) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EscalationPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EscalationPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscalationPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Message) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EscalationPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MessageList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EscalationPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscalationPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MessageRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EscalationPolicySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EscalationPolicySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscalationPolicySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *MessageRequestList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MessageRequestSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TemplateName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Receivers) > 0 {
-		for _, s := range m.Receivers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.ReceiverGroups) > 0 {
-		for _, s := range m.ReceiverGroups {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Variables) > 0 {
-		for k, v := range m.Variables {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
+func (m *EscalationRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MessageRequestStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EscalationRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscalationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Errors) > 0 {
-		for k, v := range m.Errors {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.AlertStatus)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DelayMinutes))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *MessageSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EscalationTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ReceiverName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ReceiverChannel)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Identity)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Username)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Header)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Body)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ChannelMessageID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AlarmPolicyName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AlarmPolicyType)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ReceiverChannelName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ClusterID)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MessageStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AlertStatus)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *EscalationTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Receiver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EscalationTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ReceiverGroup) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReceiverGroupList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReceiverGroupSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Receivers) > 0 {
-		for _, s := range m.Receivers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ReceiverList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *ReceiverSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Username)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Identities) > 0 {
-		for k, v := range m.Identities {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Template) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TemplateList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MessageList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TemplateSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.TencentCloudSMS != nil {
-		l = m.TencentCloudSMS.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Wechat != nil {
-		l = m.Wechat.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *TemplateTencentCloudSMS) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageRequestDeferralStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TemplateID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Sign)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Body)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TemplateText) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Body)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Header)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *MessageRequestDeferralStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TemplateWechat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MessageRequestDeferralStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TemplateID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.URL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MiniProgramAppID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.MiniProgramPagePath)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Body)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DeliverAfter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DeferredTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MessageRequestEscalationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MessageRequestEscalationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Channel) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageRequestEscalationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NextEscalationTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&Channel{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChannelSpec", "ChannelSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChannelStatus", "ChannelStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChannelList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Channel{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Channel", "Channel", 1), `&`, ``, 1) + ","
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LastEscalationTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ChannelList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Level))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ChannelSMTP) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageRequestList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ChannelSMTP{`,
-		`SMTPHost:` + fmt.Sprintf("%v", this.SMTPHost) + `,`,
-		`SMTPPort:` + fmt.Sprintf("%v", this.SMTPPort) + `,`,
-		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
-		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ChannelSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ChannelSpec{`,
-		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`TencentCloudSMS:` + strings.Replace(this.TencentCloudSMS.String(), "ChannelTencentCloudSMS", "ChannelTencentCloudSMS", 1) + `,`,
-		`Wechat:` + strings.Replace(this.Wechat.String(), "ChannelWechat", "ChannelWechat", 1) + `,`,
-		`SMTP:` + strings.Replace(this.SMTP.String(), "ChannelSMTP", "ChannelSMTP", 1) + `,`,
-		`Webhook:` + strings.Replace(this.Webhook.String(), "ChannelWebhook", "ChannelWebhook", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MessageRequestList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ChannelStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&ChannelStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChannelTencentCloudSMS) String() string {
-	if this == nil {
-		return "nil"
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&ChannelTencentCloudSMS{`,
-		`AppKey:` + fmt.Sprintf("%v", this.AppKey) + `,`,
-		`SdkAppID:` + fmt.Sprintf("%v", this.SdkAppID) + `,`,
-		`Extend:` + fmt.Sprintf("%v", this.Extend) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ChannelWebhook) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForHeaders := make([]string, 0, len(this.Headers))
-	for k := range this.Headers {
-		keysForHeaders = append(keysForHeaders, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
-	mapStringForHeaders := "map[string]string{"
-	for _, k := range keysForHeaders {
-		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+
+func (m *MessageRequestRetryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForHeaders += "}"
-	s := strings.Join([]string{`&ChannelWebhook{`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`Headers:` + mapStringForHeaders + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ChannelWechat) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ChannelWechat{`,
-		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
-		`AppSecret:` + fmt.Sprintf("%v", this.AppSecret) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MessageRequestRetryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ConfigMap) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForData := make([]string, 0, len(this.Data))
-	for k := range this.Data {
-		keysForData = append(keysForData, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-	mapStringForData := "map[string]string{"
-	for _, k := range keysForData {
-		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
-	}
-	mapStringForData += "}"
-	keysForBinaryData := make([]string, 0, len(this.BinaryData))
-	for k := range this.BinaryData {
-		keysForBinaryData = append(keysForBinaryData, k)
+
+func (m *MessageRequestRetryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.LastError)
+	copy(dAtA[i:], m.LastError)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastError)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.NextAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
-	mapStringForBinaryData := "map[string][]byte{"
-	for _, k := range keysForBinaryData {
-		mapStringForBinaryData += fmt.Sprintf("%v: %v,", k, this.BinaryData[k])
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastAttemptTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForBinaryData += "}"
-	s := strings.Join([]string{`&ConfigMap{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Data:` + mapStringForData + `,`,
-		`BinaryData:` + mapStringForBinaryData + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
-func (this *ConfigMapList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ConfigMap{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ConfigMap", "ConfigMap", 1), `&`, ``, 1) + ","
+
+func (m *MessageRequestSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ConfigMapList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Message) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Message{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MessageSpec", "MessageSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MessageStatus", "MessageStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MessageRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *MessageList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Variables) > 0 {
+		keysForVariables := make([]string, 0, len(m.Variables))
+		for k := range m.Variables {
+			keysForVariables = append(keysForVariables, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForVariables)
+		for iNdEx := len(keysForVariables) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Variables[string(keysForVariables[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForVariables[iNdEx])
+			copy(dAtA[i:], keysForVariables[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForVariables[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	repeatedStringForItems := "[]Message{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Message", "Message", 1), `&`, ``, 1) + ","
+	if len(m.ReceiverGroups) > 0 {
+		for iNdEx := len(m.ReceiverGroups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReceiverGroups[iNdEx])
+			copy(dAtA[i:], m.ReceiverGroups[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverGroups[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MessageList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MessageRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	s := strings.Join([]string{`&MessageRequest{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MessageRequestSpec", "MessageRequestSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MessageRequestStatus", "MessageRequestStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.TemplateName)
+	copy(dAtA[i:], m.TemplateName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MessageRequestList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]MessageRequest{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MessageRequest", "MessageRequest", 1), `&`, ``, 1) + ","
+
+func (m *MessageRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&MessageRequestList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MessageRequestSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForVariables := make([]string, 0, len(this.Variables))
-	for k := range this.Variables {
-		keysForVariables = append(keysForVariables, k)
+
+func (m *MessageRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deferrals) > 0 {
+		keysForDeferrals := make([]string, 0, len(m.Deferrals))
+		for k := range m.Deferrals {
+			keysForDeferrals = append(keysForDeferrals, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForDeferrals)
+		for iNdEx := len(keysForDeferrals) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Deferrals[string(keysForDeferrals[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForDeferrals[iNdEx])
+			copy(dAtA[i:], keysForDeferrals[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForDeferrals[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVariables)
-	mapStringForVariables := "map[string]string{"
-	for _, k := range keysForVariables {
-		mapStringForVariables += fmt.Sprintf("%v: %v,", k, this.Variables[k])
+	if len(m.Escalations) > 0 {
+		keysForEscalations := make([]string, 0, len(m.Escalations))
+		for k := range m.Escalations {
+			keysForEscalations = append(keysForEscalations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForEscalations)
+		for iNdEx := len(keysForEscalations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Escalations[string(keysForEscalations[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForEscalations[iNdEx])
+			copy(dAtA[i:], keysForEscalations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForEscalations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	mapStringForVariables += "}"
-	s := strings.Join([]string{`&MessageRequestSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
-		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
-		`ReceiverGroups:` + fmt.Sprintf("%v", this.ReceiverGroups) + `,`,
-		`Variables:` + mapStringForVariables + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *MessageRequestStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Retries) > 0 {
+		keysForRetries := make([]string, 0, len(m.Retries))
+		for k := range m.Retries {
+			keysForRetries = append(keysForRetries, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForRetries)
+		for iNdEx := len(keysForRetries) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Retries[string(keysForRetries[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForRetries[iNdEx])
+			copy(dAtA[i:], keysForRetries[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForRetries[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	keysForErrors := make([]string, 0, len(this.Errors))
-	for k := range this.Errors {
-		keysForErrors = append(keysForErrors, k)
+	i -= len(m.AlertStatus)
+	copy(dAtA[i:], m.AlertStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlertStatus)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Errors) > 0 {
+		keysForErrors := make([]string, 0, len(m.Errors))
+		for k := range m.Errors {
+			keysForErrors = append(keysForErrors, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForErrors)
+		for iNdEx := len(keysForErrors) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Errors[string(keysForErrors[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForErrors[iNdEx])
+			copy(dAtA[i:], keysForErrors[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForErrors[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForErrors)
-	mapStringForErrors := "map[string]string{"
-	for _, k := range keysForErrors {
-		mapStringForErrors += fmt.Sprintf("%v: %v,", k, this.Errors[k])
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForErrors += "}"
-	s := strings.Join([]string{`&MessageRequestStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Errors:` + mapStringForErrors + `,`,
-		`AlertStatus:` + fmt.Sprintf("%v", this.AlertStatus) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *MessageSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&MessageSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`ReceiverName:` + fmt.Sprintf("%v", this.ReceiverName) + `,`,
-		`ReceiverChannel:` + fmt.Sprintf("%v", this.ReceiverChannel) + `,`,
-		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`ChannelMessageID:` + fmt.Sprintf("%v", this.ChannelMessageID) + `,`,
-		`AlarmPolicyName:` + fmt.Sprintf("%v", this.AlarmPolicyName) + `,`,
-		`AlarmPolicyType:` + fmt.Sprintf("%v", this.AlarmPolicyType) + `,`,
-		`ReceiverChannelName:` + fmt.Sprintf("%v", this.ReceiverChannelName) + `,`,
-		`ClusterID:` + fmt.Sprintf("%v", this.ClusterID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *MessageStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&MessageStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`AlertStatus:` + fmt.Sprintf("%v", this.AlertStatus) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MessageSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Receiver) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MessageRequestName)
+	copy(dAtA[i:], m.MessageRequestName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessageRequestName)))
+	i--
+	dAtA[i] = 0x72
+	if m.WebhookRequest != nil {
+		{
+			size, err := m.WebhookRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&Receiver{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ReceiverSpec", "ReceiverSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.ClusterID)
+	copy(dAtA[i:], m.ClusterID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterID)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.ReceiverChannelName)
+	copy(dAtA[i:], m.ReceiverChannelName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverChannelName)))
+	i--
+	dAtA[i] = 0x5a
+	i -= len(m.AlarmPolicyType)
+	copy(dAtA[i:], m.AlarmPolicyType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlarmPolicyType)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.AlarmPolicyName)
+	copy(dAtA[i:], m.AlarmPolicyName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlarmPolicyName)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.ChannelMessageID)
+	copy(dAtA[i:], m.ChannelMessageID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChannelMessageID)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Identity)
+	copy(dAtA[i:], m.Identity)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Identity)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.ReceiverChannel)
+	copy(dAtA[i:], m.ReceiverChannel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverChannel)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ReceiverName)
+	copy(dAtA[i:], m.ReceiverName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReceiverName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ReceiverGroup) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ReceiverGroup{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ReceiverGroupSpec", "ReceiverGroupSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ReceiverGroupList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ReceiverGroup{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ReceiverGroup", "ReceiverGroup", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ReceiverGroupList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *MessageStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ReceiverGroupSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *MessageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DigestMessageName)
+	copy(dAtA[i:], m.DigestMessageName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DigestMessageName)))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.DeferredTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&ReceiverGroupSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ReceiverList) String() string {
-	if this == nil {
-		return "nil"
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.DeliveryReason)
+	copy(dAtA[i:], m.DeliveryReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliveryReason)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.DeliveryState)
+	copy(dAtA[i:], m.DeliveryState)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeliveryState)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.AcknowledgedBy)
+	copy(dAtA[i:], m.AcknowledgedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AcknowledgedBy)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AcknowledgedTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems := "[]Receiver{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Receiver", "Receiver", 1), `&`, ``, 1) + ","
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.AlertStatus)
+	copy(dAtA[i:], m.AlertStatus)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlertStatus)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ReceiverList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ReceiverSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForIdentities := make([]string, 0, len(this.Identities))
-	for k := range this.Identities {
-		keysForIdentities = append(keysForIdentities, string(k))
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForIdentities)
-	mapStringForIdentities := "map[ReceiverChannel]string{"
-	for _, k := range keysForIdentities {
-		mapStringForIdentities += fmt.Sprintf("%v: %v,", k, this.Identities[ReceiverChannel(k)])
+
+func (m *OnCallLayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	mapStringForIdentities += "}"
-	s := strings.Join([]string{`&ReceiverSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Identities:` + mapStringForIdentities + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Template) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&Template{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "TemplateSpec", "TemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *OnCallLayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *TemplateList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OnCallLayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Restrictions) > 0 {
+		for iNdEx := len(m.Restrictions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Restrictions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	repeatedStringForItems := "[]Template{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ShiftLength))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.RotationType)
+	copy(dAtA[i:], m.RotationType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RotationType)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&TemplateList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *TemplateSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OnCallOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TemplateSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
-		`TencentCloudSMS:` + strings.Replace(this.TencentCloudSMS.String(), "TemplateTencentCloudSMS", "TemplateTencentCloudSMS", 1) + `,`,
-		`Wechat:` + strings.Replace(this.Wechat.String(), "TemplateWechat", "TemplateWechat", 1) + `,`,
-		`Text:` + strings.Replace(this.Text.String(), "TemplateText", "TemplateText", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *TemplateTencentCloudSMS) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OnCallOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnCallOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EndsAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&TemplateTencentCloudSMS{`,
-		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
-		`Sign:` + fmt.Sprintf("%v", this.Sign) + `,`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StartsAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Receiver)
+	copy(dAtA[i:], m.Receiver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receiver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *TemplateText) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OnCallRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&TemplateText{`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *TemplateWechat) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OnCallRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnCallRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EndTime)
+	copy(dAtA[i:], m.EndTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndTime)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.StartTime)
+	copy(dAtA[i:], m.StartTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartTime)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OnCallSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnCallSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnCallSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OnCallScheduleList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnCallScheduleList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnCallScheduleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OnCallScheduleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnCallScheduleSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnCallScheduleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Overrides) > 0 {
+		for iNdEx := len(m.Overrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Overrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Layers) > 0 {
+		for iNdEx := len(m.Layers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Layers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Receiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverDelivery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverDelivery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverDigest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverDigest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverDigest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.IntervalMinutes))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Severities) > 0 {
+		for iNdEx := len(m.Severities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Severities[iNdEx])
+			copy(dAtA[i:], m.Severities[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Severities[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverGroupList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverGroupList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverGroupSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverGroupSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverGroupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EscalationPolicy)
+	copy(dAtA[i:], m.EscalationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EscalationPolicy)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Receivers[iNdEx])
+			copy(dAtA[i:], m.Receivers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Receivers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverPreferences) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverPreferences) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverPreferences) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Digest != nil {
+		{
+			size, err := m.Digest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.QuietHours) > 0 {
+		for iNdEx := len(m.QuietHours) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuietHours[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverQuietHours) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverQuietHours) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverQuietHours) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EndTime)
+	copy(dAtA[i:], m.EndTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndTime)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StartTime)
+	copy(dAtA[i:], m.StartTime)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StartTime)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Channels[iNdEx])
+			copy(dAtA[i:], m.Channels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PeriodMinutes))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preferences != nil {
+		{
+			size, err := m.Preferences.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identities) > 0 {
+		keysForIdentities := make([]string, 0, len(m.Identities))
+		for k := range m.Identities {
+			keysForIdentities = append(keysForIdentities, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForIdentities)
+		for iNdEx := len(keysForIdentities) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Identities[ReceiverChannel(keysForIdentities[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForIdentities[iNdEx])
+			copy(dAtA[i:], keysForIdentities[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForIdentities[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiverStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiverStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiverStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretKeySelector) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretKeySelector) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretKeySelector) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Silence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Silence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Silence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SilenceList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SilenceList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SilenceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SilenceMatcher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SilenceMatcher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SilenceMatcher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Operator)
+	copy(dAtA[i:], m.Operator)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operator)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SilenceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SilenceSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SilenceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Comment)
+	copy(dAtA[i:], m.Comment)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Comment)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.CreatedBy)
+	copy(dAtA[i:], m.CreatedBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CreatedBy)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.EndsAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StartsAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Matchers) > 0 {
+		for iNdEx := len(m.Matchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SilenceStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SilenceStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SilenceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastSuppressedTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SuppressedCount))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Template) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Template) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateMarkdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateMarkdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateMarkdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Title)
+	copy(dAtA[i:], m.Title)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Title)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Markdown != nil {
+		{
+			size, err := m.Markdown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Text != nil {
+		{
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Wechat != nil {
+		{
+			size, err := m.Wechat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TencentCloudSMS != nil {
+		{
+			size, err := m.TencentCloudSMS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateTencentCloudSMS) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateTencentCloudSMS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateTencentCloudSMS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Sign)
+	copy(dAtA[i:], m.Sign)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Sign)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TemplateID)
+	copy(dAtA[i:], m.TemplateID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateText) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateText) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Header)
+	copy(dAtA[i:], m.Header)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Header)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TemplateWechat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TemplateWechat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TemplateWechat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.MiniProgramPagePath)
+	copy(dAtA[i:], m.MiniProgramPagePath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MiniProgramPagePath)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.MiniProgramAppID)
+	copy(dAtA[i:], m.MiniProgramAppID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MiniProgramAppID)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TemplateID)
+	copy(dAtA[i:], m.TemplateID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplateID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Headers) > 0 {
+		keysForHeaders := make([]string, 0, len(m.Headers))
+		for k := range m.Headers {
+			keysForHeaders = append(keysForHeaders, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+		for iNdEx := len(keysForHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Headers[string(keysForHeaders[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHeaders[iNdEx])
+			copy(dAtA[i:], keysForHeaders[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHeaders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AuditRuleList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuditRuleMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, s := range m.Users {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Verbs) > 0 {
+		for _, s := range m.Verbs {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Codes) > 0 {
+		for _, e := range m.Codes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *AuditRuleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.Match.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChannelName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ReceiverGroups) > 0 {
+		for _, s := range m.ReceiverGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Channel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelDingTalk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WebhookURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Secret)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelLark) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WebhookURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Secret)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelSMTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SMTPHost)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SMTPPort))
+	n += 2
+	l = len(m.Email)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Password)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelSlack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WebhookURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TencentCloudSMS != nil {
+		l = m.TencentCloudSMS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Wechat != nil {
+		l = m.Wechat.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SMTP != nil {
+		l = m.SMTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Webhook != nil {
+		l = m.Webhook.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Slack != nil {
+		l = m.Slack.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DingTalk != nil {
+		l = m.DingTalk.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WeCom != nil {
+		l = m.WeCom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Lark != nil {
+		l = m.Lark.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelTencentCloudSMS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SdkAppID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Extend)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelWeCom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WebhookURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChannelWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.BodyTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.SigningSecretRef != nil {
+		l = m.SigningSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ClientCertificateSecretRef != nil {
+		l = m.ClientCertificateSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	n += 2
+	return n
+}
+
+func (m *ChannelWechat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AppID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AppSecret)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ConfigMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BinaryData) > 0 {
+		for k, v := range m.BinaryData {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovGenerated(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConfigMapList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscalationPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *EscalationPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscalationPolicySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscalationRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.DelayMinutes))
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscalationTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageRequestDeferralStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeferredTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DeliverAfter.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageRequestEscalationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Level))
+	l = m.LastEscalationTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NextEscalationTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageRequestList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageRequestRetryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	l = m.LastAttemptTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NextAttemptTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LastError)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageRequestSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TemplateName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ReceiverGroups) > 0 {
+		for _, s := range m.ReceiverGroups {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Variables) > 0 {
+		for k, v := range m.Variables {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MessageRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Errors) > 0 {
+		for k, v := range m.Errors {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.AlertStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Retries) > 0 {
+		for k, v := range m.Retries {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Escalations) > 0 {
+		for k, v := range m.Escalations {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Deferrals) > 0 {
+		for k, v := range m.Deferrals {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MessageSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ReceiverName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ReceiverChannel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Identity)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChannelMessageID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AlarmPolicyName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AlarmPolicyType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ReceiverChannelName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.WebhookRequest != nil {
+		l = m.WebhookRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.MessageRequestName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *MessageStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AlertStatus)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.AcknowledgedTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AcknowledgedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DeliveryState)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DeliveryReason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.DeferredTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DigestMessageName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OnCallLayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Start.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RotationType)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ShiftLength))
+	if len(m.Restrictions) > 0 {
+		for _, e := range m.Restrictions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OnCallOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartsAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.EndsAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OnCallRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OnCallSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OnCallScheduleList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OnCallScheduleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Layers) > 0 {
+		for _, e := range m.Layers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Overrides) > 0 {
+		for _, e := range m.Overrides {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Receiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverDigest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Severities) > 0 {
+		for _, s := range m.Severities {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.IntervalMinutes))
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverGroupList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReceiverGroupSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Receivers) > 0 {
+		for _, s := range m.Receivers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.EscalationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReceiverPreferences) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.QuietHours) > 0 {
+		for _, e := range m.QuietHours {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Digest != nil {
+		l = m.Digest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiverQuietHours) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, s := range m.Channels {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.StartTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndTime)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReceiverRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Limit))
+	n += 1 + sovGenerated(uint64(m.PeriodMinutes))
+	return n
+}
+
+func (m *ReceiverSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Identities) > 0 {
+		for k, v := range m.Identities {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Preferences != nil {
+		l = m.Preferences.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiverStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SecretKeySelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SecretReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Silence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SilenceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SilenceMatcher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Operator)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SilenceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Matchers) > 0 {
+		for _, e := range m.Matchers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.StartsAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.EndsAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CreatedBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Comment)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SilenceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.SuppressedCount))
+	l = m.LastSuppressedTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Template) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TemplateMarkdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Title)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TencentCloudSMS != nil {
+		l = m.TencentCloudSMS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Wechat != nil {
+		l = m.Wechat.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Markdown != nil {
+		l = m.Markdown.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *TemplateTencentCloudSMS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TemplateID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Sign)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateText) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Header)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TemplateWechat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TemplateID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MiniProgramAppID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MiniProgramPagePath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WebhookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AuditRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditRule{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "AuditRuleSpec", "AuditRuleSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditRuleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]AuditRule{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "AuditRule", "AuditRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&AuditRuleList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditRuleMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditRuleMatch{`,
+		`Users:` + fmt.Sprintf("%v", this.Users) + `,`,
+		`Verbs:` + fmt.Sprintf("%v", this.Verbs) + `,`,
+		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`Codes:` + fmt.Sprintf("%v", this.Codes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditRuleSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditRuleSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Disabled:` + fmt.Sprintf("%v", this.Disabled) + `,`,
+		`Match:` + strings.Replace(strings.Replace(this.Match.String(), "AuditRuleMatch", "AuditRuleMatch", 1), `&`, ``, 1) + `,`,
+		`ChannelName:` + fmt.Sprintf("%v", this.ChannelName) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
+		`ReceiverGroups:` + fmt.Sprintf("%v", this.ReceiverGroups) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Channel) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Channel{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChannelSpec", "ChannelSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChannelStatus", "ChannelStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelDingTalk) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelDingTalk{`,
+		`WebhookURL:` + fmt.Sprintf("%v", this.WebhookURL) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelLark) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelLark{`,
+		`WebhookURL:` + fmt.Sprintf("%v", this.WebhookURL) + `,`,
+		`Secret:` + fmt.Sprintf("%v", this.Secret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Channel{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Channel", "Channel", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ChannelList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelSMTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelSMTP{`,
+		`SMTPHost:` + fmt.Sprintf("%v", this.SMTPHost) + `,`,
+		`SMTPPort:` + fmt.Sprintf("%v", this.SMTPPort) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`Email:` + fmt.Sprintf("%v", this.Email) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelSlack) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelSlack{`,
+		`WebhookURL:` + fmt.Sprintf("%v", this.WebhookURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelSpec{`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`TencentCloudSMS:` + strings.Replace(this.TencentCloudSMS.String(), "ChannelTencentCloudSMS", "ChannelTencentCloudSMS", 1) + `,`,
+		`Wechat:` + strings.Replace(this.Wechat.String(), "ChannelWechat", "ChannelWechat", 1) + `,`,
+		`SMTP:` + strings.Replace(this.SMTP.String(), "ChannelSMTP", "ChannelSMTP", 1) + `,`,
+		`Webhook:` + strings.Replace(this.Webhook.String(), "ChannelWebhook", "ChannelWebhook", 1) + `,`,
+		`Slack:` + strings.Replace(this.Slack.String(), "ChannelSlack", "ChannelSlack", 1) + `,`,
+		`DingTalk:` + strings.Replace(this.DingTalk.String(), "ChannelDingTalk", "ChannelDingTalk", 1) + `,`,
+		`WeCom:` + strings.Replace(this.WeCom.String(), "ChannelWeCom", "ChannelWeCom", 1) + `,`,
+		`Lark:` + strings.Replace(this.Lark.String(), "ChannelLark", "ChannelLark", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelTencentCloudSMS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelTencentCloudSMS{`,
+		`AppKey:` + fmt.Sprintf("%v", this.AppKey) + `,`,
+		`SdkAppID:` + fmt.Sprintf("%v", this.SdkAppID) + `,`,
+		`Extend:` + fmt.Sprintf("%v", this.Extend) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelWeCom) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelWeCom{`,
+		`WebhookURL:` + fmt.Sprintf("%v", this.WebhookURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelWebhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&ChannelWebhook{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`BodyTemplate:` + fmt.Sprintf("%v", this.BodyTemplate) + `,`,
+		`SigningSecretRef:` + strings.Replace(this.SigningSecretRef.String(), "SecretKeySelector", "SecretKeySelector", 1) + `,`,
+		`ClientCertificateSecretRef:` + strings.Replace(this.ClientCertificateSecretRef.String(), "SecretReference", "SecretReference", 1) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`InsecureSkipVerify:` + fmt.Sprintf("%v", this.InsecureSkipVerify) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChannelWechat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChannelWechat{`,
+		`AppID:` + fmt.Sprintf("%v", this.AppID) + `,`,
+		`AppSecret:` + fmt.Sprintf("%v", this.AppSecret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigMap) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForData := make([]string, 0, len(this.Data))
+	for k := range this.Data {
+		keysForData = append(keysForData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+	mapStringForData := "map[string]string{"
+	for _, k := range keysForData {
+		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
+	}
+	mapStringForData += "}"
+	keysForBinaryData := make([]string, 0, len(this.BinaryData))
+	for k := range this.BinaryData {
+		keysForBinaryData = append(keysForBinaryData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
+	mapStringForBinaryData := "map[string][]byte{"
+	for _, k := range keysForBinaryData {
+		mapStringForBinaryData += fmt.Sprintf("%v: %v,", k, this.BinaryData[k])
+	}
+	mapStringForBinaryData += "}"
+	s := strings.Join([]string{`&ConfigMap{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Data:` + mapStringForData + `,`,
+		`BinaryData:` + mapStringForBinaryData + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigMapList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ConfigMap{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ConfigMap", "ConfigMap", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ConfigMapList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EscalationPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "EscalationPolicySpec", "EscalationPolicySpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationPolicyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]EscalationPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "EscalationPolicy", "EscalationPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&EscalationPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationPolicySpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRules := "[]EscalationRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(strings.Replace(f.String(), "EscalationRule", "EscalationRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRules += "}"
+	s := strings.Join([]string{`&EscalationPolicySpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Rules:` + repeatedStringForRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTargets := "[]EscalationTarget{"
+	for _, f := range this.Targets {
+		repeatedStringForTargets += strings.Replace(strings.Replace(f.String(), "EscalationTarget", "EscalationTarget", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTargets += "}"
+	s := strings.Join([]string{`&EscalationRule{`,
+		`DelayMinutes:` + fmt.Sprintf("%v", this.DelayMinutes) + `,`,
+		`Targets:` + repeatedStringForTargets + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EscalationTarget) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EscalationTarget{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Message{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MessageSpec", "MessageSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MessageStatus", "MessageStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Message{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Message", "Message", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MessageList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "MessageRequestSpec", "MessageRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "MessageRequestStatus", "MessageRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestDeferralStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageRequestDeferralStatus{`,
+		`DeferredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeferredTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DeliverAfter:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeliverAfter), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestEscalationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageRequestEscalationStatus{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`LastEscalationTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastEscalationTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`NextEscalationTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NextEscalationTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]MessageRequest{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "MessageRequest", "MessageRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&MessageRequestList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestRetryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageRequestRetryStatus{`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastAttemptTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastAttemptTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`NextAttemptTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NextAttemptTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVariables := make([]string, 0, len(this.Variables))
+	for k := range this.Variables {
+		keysForVariables = append(keysForVariables, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVariables)
+	mapStringForVariables := "map[string]string{"
+	for _, k := range keysForVariables {
+		mapStringForVariables += fmt.Sprintf("%v: %v,", k, this.Variables[k])
+	}
+	mapStringForVariables += "}"
+	s := strings.Join([]string{`&MessageRequestSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`TemplateName:` + fmt.Sprintf("%v", this.TemplateName) + `,`,
+		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
+		`ReceiverGroups:` + fmt.Sprintf("%v", this.ReceiverGroups) + `,`,
+		`Variables:` + mapStringForVariables + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForErrors := make([]string, 0, len(this.Errors))
+	for k := range this.Errors {
+		keysForErrors = append(keysForErrors, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForErrors)
+	mapStringForErrors := "map[string]string{"
+	for _, k := range keysForErrors {
+		mapStringForErrors += fmt.Sprintf("%v: %v,", k, this.Errors[k])
+	}
+	mapStringForErrors += "}"
+	keysForRetries := make([]string, 0, len(this.Retries))
+	for k := range this.Retries {
+		keysForRetries = append(keysForRetries, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForRetries)
+	mapStringForRetries := "map[string]MessageRequestRetryStatus{"
+	for _, k := range keysForRetries {
+		mapStringForRetries += fmt.Sprintf("%v: %v,", k, this.Retries[k])
+	}
+	mapStringForRetries += "}"
+	keysForEscalations := make([]string, 0, len(this.Escalations))
+	for k := range this.Escalations {
+		keysForEscalations = append(keysForEscalations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEscalations)
+	mapStringForEscalations := "map[string]MessageRequestEscalationStatus{"
+	for _, k := range keysForEscalations {
+		mapStringForEscalations += fmt.Sprintf("%v: %v,", k, this.Escalations[k])
+	}
+	mapStringForEscalations += "}"
+	keysForDeferrals := make([]string, 0, len(this.Deferrals))
+	for k := range this.Deferrals {
+		keysForDeferrals = append(keysForDeferrals, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForDeferrals)
+	mapStringForDeferrals := "map[string]MessageRequestDeferralStatus{"
+	for _, k := range keysForDeferrals {
+		mapStringForDeferrals += fmt.Sprintf("%v: %v,", k, this.Deferrals[k])
+	}
+	mapStringForDeferrals += "}"
+	s := strings.Join([]string{`&MessageRequestStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Errors:` + mapStringForErrors + `,`,
+		`AlertStatus:` + fmt.Sprintf("%v", this.AlertStatus) + `,`,
+		`Retries:` + mapStringForRetries + `,`,
+		`Escalations:` + mapStringForEscalations + `,`,
+		`Deferrals:` + mapStringForDeferrals + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`ReceiverName:` + fmt.Sprintf("%v", this.ReceiverName) + `,`,
+		`ReceiverChannel:` + fmt.Sprintf("%v", this.ReceiverChannel) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`ChannelMessageID:` + fmt.Sprintf("%v", this.ChannelMessageID) + `,`,
+		`AlarmPolicyName:` + fmt.Sprintf("%v", this.AlarmPolicyName) + `,`,
+		`AlarmPolicyType:` + fmt.Sprintf("%v", this.AlarmPolicyType) + `,`,
+		`ReceiverChannelName:` + fmt.Sprintf("%v", this.ReceiverChannelName) + `,`,
+		`ClusterID:` + fmt.Sprintf("%v", this.ClusterID) + `,`,
+		`WebhookRequest:` + strings.Replace(this.WebhookRequest.String(), "WebhookRequest", "WebhookRequest", 1) + `,`,
+		`MessageRequestName:` + fmt.Sprintf("%v", this.MessageRequestName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`AlertStatus:` + fmt.Sprintf("%v", this.AlertStatus) + `,`,
+		`AcknowledgedTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AcknowledgedTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`AcknowledgedBy:` + fmt.Sprintf("%v", this.AcknowledgedBy) + `,`,
+		`DeliveryState:` + fmt.Sprintf("%v", this.DeliveryState) + `,`,
+		`DeliveryReason:` + fmt.Sprintf("%v", this.DeliveryReason) + `,`,
+		`DeferredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeferredTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`DigestMessageName:` + fmt.Sprintf("%v", this.DigestMessageName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallLayer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRestrictions := "[]OnCallRestriction{"
+	for _, f := range this.Restrictions {
+		repeatedStringForRestrictions += strings.Replace(strings.Replace(f.String(), "OnCallRestriction", "OnCallRestriction", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRestrictions += "}"
+	s := strings.Join([]string{`&OnCallLayer{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`RotationType:` + fmt.Sprintf("%v", this.RotationType) + `,`,
+		`ShiftLength:` + fmt.Sprintf("%v", this.ShiftLength) + `,`,
+		`Restrictions:` + repeatedStringForRestrictions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallOverride) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnCallOverride{`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`StartsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartsAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`EndsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndsAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallRestriction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnCallRestriction{`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OnCallSchedule{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "OnCallScheduleSpec", "OnCallScheduleSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallScheduleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]OnCallSchedule{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "OnCallSchedule", "OnCallSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&OnCallScheduleList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OnCallScheduleSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLayers := "[]OnCallLayer{"
+	for _, f := range this.Layers {
+		repeatedStringForLayers += strings.Replace(strings.Replace(f.String(), "OnCallLayer", "OnCallLayer", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForLayers += "}"
+	repeatedStringForOverrides := "[]OnCallOverride{"
+	for _, f := range this.Overrides {
+		repeatedStringForOverrides += strings.Replace(strings.Replace(f.String(), "OnCallOverride", "OnCallOverride", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOverrides += "}"
+	s := strings.Join([]string{`&OnCallScheduleSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`Layers:` + repeatedStringForLayers + `,`,
+		`Overrides:` + repeatedStringForOverrides + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Receiver) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Receiver{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ReceiverSpec", "ReceiverSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ReceiverStatus", "ReceiverStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverDelivery{`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverDigest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverDigest{`,
+		`Severities:` + fmt.Sprintf("%v", this.Severities) + `,`,
+		`IntervalMinutes:` + fmt.Sprintf("%v", this.IntervalMinutes) + `,`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverGroup{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ReceiverGroupSpec", "ReceiverGroupSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverGroupList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ReceiverGroup{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ReceiverGroup", "ReceiverGroup", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ReceiverGroupList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverGroupSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverGroupSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Receivers:` + fmt.Sprintf("%v", this.Receivers) + `,`,
+		`EscalationPolicy:` + fmt.Sprintf("%v", this.EscalationPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Receiver{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Receiver", "Receiver", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ReceiverList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverPreferences) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQuietHours := "[]ReceiverQuietHours{"
+	for _, f := range this.QuietHours {
+		repeatedStringForQuietHours += strings.Replace(strings.Replace(f.String(), "ReceiverQuietHours", "ReceiverQuietHours", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQuietHours += "}"
+	repeatedStringForRateLimits := "[]ReceiverRateLimit{"
+	for _, f := range this.RateLimits {
+		repeatedStringForRateLimits += strings.Replace(strings.Replace(f.String(), "ReceiverRateLimit", "ReceiverRateLimit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRateLimits += "}"
+	s := strings.Join([]string{`&ReceiverPreferences{`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`QuietHours:` + repeatedStringForQuietHours + `,`,
+		`RateLimits:` + repeatedStringForRateLimits + `,`,
+		`Digest:` + strings.Replace(this.Digest.String(), "ReceiverDigest", "ReceiverDigest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverQuietHours) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverQuietHours{`,
+		`Channels:` + fmt.Sprintf("%v", this.Channels) + `,`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`EndTime:` + fmt.Sprintf("%v", this.EndTime) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReceiverRateLimit{`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`PeriodMinutes:` + fmt.Sprintf("%v", this.PeriodMinutes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForIdentities := make([]string, 0, len(this.Identities))
+	for k := range this.Identities {
+		keysForIdentities = append(keysForIdentities, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForIdentities)
+	mapStringForIdentities := "map[ReceiverChannel]string{"
+	for _, k := range keysForIdentities {
+		mapStringForIdentities += fmt.Sprintf("%v: %v,", k, this.Identities[ReceiverChannel(k)])
+	}
+	mapStringForIdentities += "}"
+	s := strings.Join([]string{`&ReceiverSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Identities:` + mapStringForIdentities + `,`,
+		`Preferences:` + strings.Replace(this.Preferences.String(), "ReceiverPreferences", "ReceiverPreferences", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReceiverStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeliveries := "[]ReceiverDelivery{"
+	for _, f := range this.Deliveries {
+		repeatedStringForDeliveries += strings.Replace(strings.Replace(f.String(), "ReceiverDelivery", "ReceiverDelivery", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDeliveries += "}"
+	s := strings.Join([]string{`&ReceiverStatus{`,
+		`Deliveries:` + repeatedStringForDeliveries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretKeySelector) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretKeySelector{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Silence) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Silence{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "SilenceSpec", "SilenceSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "SilenceStatus", "SilenceStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SilenceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Silence{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Silence", "Silence", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&SilenceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SilenceMatcher) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SilenceMatcher{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SilenceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMatchers := "[]SilenceMatcher{"
+	for _, f := range this.Matchers {
+		repeatedStringForMatchers += strings.Replace(strings.Replace(f.String(), "SilenceMatcher", "SilenceMatcher", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMatchers += "}"
+	s := strings.Join([]string{`&SilenceSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`Matchers:` + repeatedStringForMatchers + `,`,
+		`StartsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartsAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`EndsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndsAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`CreatedBy:` + fmt.Sprintf("%v", this.CreatedBy) + `,`,
+		`Comment:` + fmt.Sprintf("%v", this.Comment) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SilenceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SilenceStatus{`,
+		`SuppressedCount:` + fmt.Sprintf("%v", this.SuppressedCount) + `,`,
+		`LastSuppressedTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastSuppressedTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Template) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Template{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "TemplateSpec", "TemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Template{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Template", "Template", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&TemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateMarkdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateMarkdown{`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Title:` + fmt.Sprintf("%v", this.Title) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Keys:` + fmt.Sprintf("%v", this.Keys) + `,`,
+		`TencentCloudSMS:` + strings.Replace(this.TencentCloudSMS.String(), "TemplateTencentCloudSMS", "TemplateTencentCloudSMS", 1) + `,`,
+		`Wechat:` + strings.Replace(this.Wechat.String(), "TemplateWechat", "TemplateWechat", 1) + `,`,
+		`Text:` + strings.Replace(this.Text.String(), "TemplateText", "TemplateText", 1) + `,`,
+		`Markdown:` + strings.Replace(this.Markdown.String(), "TemplateMarkdown", "TemplateMarkdown", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateTencentCloudSMS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateTencentCloudSMS{`,
+		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
+		`Sign:` + fmt.Sprintf("%v", this.Sign) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateText) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateText{`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`Header:` + fmt.Sprintf("%v", this.Header) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TemplateWechat) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TemplateWechat{`,
+		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`MiniProgramAppID:` + fmt.Sprintf("%v", this.MiniProgramAppID) + `,`,
+		`MiniProgramPagePath:` + fmt.Sprintf("%v", this.MiniProgramPagePath) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WebhookRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHeaders := make([]string, 0, len(this.Headers))
+	for k := range this.Headers {
+		keysForHeaders = append(keysForHeaders, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHeaders)
+	mapStringForHeaders := "map[string]string{"
+	for _, k := range keysForHeaders {
+		mapStringForHeaders += fmt.Sprintf("%v: %v,", k, this.Headers[k])
+	}
+	mapStringForHeaders += "}"
+	s := strings.Join([]string{`&WebhookRequest{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + mapStringForHeaders + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuditRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRuleList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRuleList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRuleList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AuditRule{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRuleMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRuleMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRuleMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verbs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verbs = append(m.Verbs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Codes = append(m.Codes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Codes) == 0 {
+					m.Codes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Codes = append(m.Codes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codes", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRuleSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRuleSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRuleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Match.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverGroups = append(m.ReceiverGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Channel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelDingTalk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelDingTalk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelDingTalk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelLark) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelLark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelLark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Channel{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSMTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSMTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTPHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SMTPHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTPPort", wireType)
+			}
+			m.SMTPPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SMTPPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Email = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSlack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSlack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSlack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TencentCloudSMS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TencentCloudSMS == nil {
+				m.TencentCloudSMS = &ChannelTencentCloudSMS{}
+			}
+			if err := m.TencentCloudSMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wechat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wechat == nil {
+				m.Wechat = &ChannelWechat{}
+			}
+			if err := m.Wechat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SMTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SMTP == nil {
+				m.SMTP = &ChannelSMTP{}
+			}
+			if err := m.SMTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Webhook == nil {
+				m.Webhook = &ChannelWebhook{}
+			}
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Slack == nil {
+				m.Slack = &ChannelSlack{}
+			}
+			if err := m.Slack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DingTalk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DingTalk == nil {
+				m.DingTalk = &ChannelDingTalk{}
+			}
+			if err := m.DingTalk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeCom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeCom == nil {
+				m.WeCom = &ChannelWeCom{}
+			}
+			if err := m.WeCom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lark == nil {
+				m.Lark = &ChannelLark{}
+			}
+			if err := m.Lark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ChannelPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTencentCloudSMS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTencentCloudSMS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SdkAppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SdkAppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelWeCom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelWeCom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelWeCom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebhookURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelWebhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SigningSecretRef == nil {
+				m.SigningSecretRef = &SecretKeySelector{}
+			}
+			if err := m.SigningSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificateSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientCertificateSecretRef == nil {
+				m.ClientCertificateSecretRef = &SecretReference{}
+			}
+			if err := m.ClientCertificateSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipVerify = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelWechat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelWechat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BinaryData == nil {
+				m.BinaryData = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BinaryData[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ConfigMap{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, EscalationPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationPolicySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationPolicySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationPolicySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, EscalationRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayMinutes", wireType)
+			}
+			m.DelayMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayMinutes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, EscalationTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscalationTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscalationTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscalationTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = EscalationTargetKind(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Message) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Message{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestDeferralStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestDeferralStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestDeferralStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeferredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliverAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeliverAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestEscalationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestEscalationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestEscalationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEscalationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastEscalationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEscalationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextEscalationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MessageRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestRetryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestRetryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestRetryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextAttemptTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGroups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverGroups = append(m.ReceiverGroups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Variables == nil {
+				m.Variables = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Variables[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageRequestStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = MessageRequestPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Errors == nil {
+				m.Errors = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Errors[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlertStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retries == nil {
+				m.Retries = make(map[string]MessageRequestRetryStatus)
+			}
+			var mapkey string
+			mapvalue := &MessageRequestRetryStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageRequestRetryStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Retries[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escalations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Escalations == nil {
+				m.Escalations = make(map[string]MessageRequestEscalationStatus)
+			}
+			var mapkey string
+			mapvalue := &MessageRequestEscalationStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageRequestEscalationStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Escalations[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deferrals == nil {
+				m.Deferrals = make(map[string]MessageRequestDeferralStatus)
+			}
+			var mapkey string
+			mapvalue := &MessageRequestDeferralStatus{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageRequestDeferralStatus{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Deferrals[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChannel = ReceiverChannel(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMessageID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelMessageID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlarmPolicyName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlarmPolicyName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlarmPolicyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlarmPolicyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChannelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChannelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebhookRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WebhookRequest == nil {
+				m.WebhookRequest = &WebhookRequest{}
+			}
+			if err := m.WebhookRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageRequestName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageRequestName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = MessagePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AlertStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AcknowledgedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcknowledgedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcknowledgedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryState = MessageDeliveryState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeliveryReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeferredTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DigestMessageName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DigestMessageName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnCallLayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnCallLayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnCallLayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationType = OnCallRotationType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShiftLength", wireType)
+			}
+			m.ShiftLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShiftLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restrictions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Restrictions = append(m.Restrictions, OnCallRestriction{})
+			if err := m.Restrictions[len(m.Restrictions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&TemplateWechat{`,
-		`TemplateID:` + fmt.Sprintf("%v", this.TemplateID) + `,`,
-		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
-		`MiniProgramAppID:` + fmt.Sprintf("%v", this.MiniProgramAppID) + `,`,
-		`MiniProgramPagePath:` + fmt.Sprintf("%v", this.MiniProgramPagePath) + `,`,
-		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *Channel) Unmarshal(dAtA []byte) error {
+func (m *OnCallOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3693,17 +15120,17 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnCallOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnCallOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3713,28 +15140,27 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3761,13 +15187,13 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StartsAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3794,7 +15220,7 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndsAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3819,7 +15245,7 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelList) Unmarshal(dAtA []byte) error {
+func (m *OnCallRestriction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3842,15 +15268,129 @@ func (m *ChannelList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelList: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnCallRestriction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnCallRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnCallSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnCallSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnCallSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3877,13 +15417,13 @@ func (m *ChannelList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3910,8 +15450,7 @@ func (m *ChannelList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Channel{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3936,7 +15475,7 @@ func (m *ChannelList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
+func (m *OnCallScheduleList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3959,17 +15498,17 @@ func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelSMTP: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnCallScheduleList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelSMTP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnCallScheduleList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMTPHost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3979,68 +15518,30 @@ func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SMTPHost = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMTPPort", wireType)
-			}
-			m.SMTPPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SMTPPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TLS = bool(v != 0)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Email", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4050,55 +15551,25 @@ func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Email = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, OnCallSchedule{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4121,7 +15592,7 @@ func (m *ChannelSMTP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
+func (m *OnCallScheduleSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4144,15 +15615,15 @@ func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnCallScheduleSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnCallScheduleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4180,11 +15651,11 @@ func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4212,11 +15683,11 @@ func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4244,119 +15715,11 @@ func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TencentCloudSMS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TencentCloudSMS == nil {
-				m.TencentCloudSMS = &ChannelTencentCloudSMS{}
-			}
-			if err := m.TencentCloudSMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wechat", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Wechat == nil {
-				m.Wechat = &ChannelWechat{}
-			}
-			if err := m.Wechat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMTP", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SMTP == nil {
-				m.SMTP = &ChannelSMTP{}
-			}
-			if err := m.SMTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Layers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4373,78 +15736,26 @@ func (m *ChannelSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Webhook == nil {
-				m.Webhook = &ChannelWebhook{}
-			}
-			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChannelStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Layers = append(m.Layers, OnCallLayer{})
+			if err := m.Layers[len(m.Layers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4454,23 +15765,25 @@ func (m *ChannelStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ChannelPhase(dAtA[iNdEx:postIndex])
+			m.Overrides = append(m.Overrides, OnCallOverride{})
+			if err := m.Overrides[len(m.Overrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4493,7 +15806,7 @@ func (m *ChannelStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
+func (m *Receiver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4516,17 +15829,17 @@ func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelTencentCloudSMS: wiretype end group for non-group")
+			return fmt.Errorf("proto: Receiver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelTencentCloudSMS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Receiver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4536,29 +15849,30 @@ func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppKey = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SdkAppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4568,29 +15882,30 @@ func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SdkAppID = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4600,23 +15915,24 @@ func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extend = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4639,7 +15955,7 @@ func (m *ChannelTencentCloudSMS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
+func (m *ReceiverDelivery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4662,15 +15978,15 @@ func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelWebhook: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverDelivery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4698,11 +16014,11 @@ func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Channel = ReceiverChannel(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4729,103 +16045,9 @@ func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4848,7 +16070,7 @@ func (m *ChannelWebhook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
+func (m *ReceiverDigest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4871,15 +16093,66 @@ func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelWechat: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverDigest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelWechat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverDigest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Severities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Severities = append(m.Severities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalMinutes", wireType)
+			}
+			m.IntervalMinutes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalMinutes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4907,11 +16180,11 @@ func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppID = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4939,7 +16212,7 @@ func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppSecret = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4962,7 +16235,7 @@ func (m *ChannelWechat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+func (m *ReceiverGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4985,10 +16258,10 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5026,134 +16299,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Data[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5180,104 +16326,9 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BinaryData == nil {
-				m.BinaryData = make(map[string][]byte)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.BinaryData[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5300,7 +16351,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
+func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5323,10 +16374,10 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverGroupList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5391,7 +16442,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ConfigMap{})
+			m.Items = append(m.Items, ReceiverGroup{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5417,7 +16468,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Message) Unmarshal(dAtA []byte) error {
+func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5440,17 +16491,17 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Message: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverGroupSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Message: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverGroupSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5460,30 +16511,29 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5493,30 +16543,29 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5526,24 +16575,55 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscalationPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EscalationPolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5566,7 +16646,7 @@ func (m *Message) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageList) Unmarshal(dAtA []byte) error {
+func (m *ReceiverList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5589,10 +16669,10 @@ func (m *MessageList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5657,7 +16737,7 @@ func (m *MessageList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Message{})
+			m.Items = append(m.Items, Receiver{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5683,7 +16763,7 @@ func (m *MessageList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageRequest) Unmarshal(dAtA []byte) error {
+func (m *ReceiverPreferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5706,15 +16786,47 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverPreferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverPreferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuietHours", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5741,13 +16853,14 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuietHours = append(m.QuietHours, ReceiverQuietHours{})
+			if err := m.QuietHours[len(m.QuietHours)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5774,13 +16887,14 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, ReceiverRateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5807,7 +16921,10 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Digest == nil {
+				m.Digest = &ReceiverDigest{}
+			}
+			if err := m.Digest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5832,7 +16949,7 @@ func (m *MessageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
+func (m *ReceiverQuietHours) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5855,17 +16972,17 @@ func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageRequestList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverQuietHours: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverQuietHours: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5875,30 +16992,29 @@ func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channels = append(m.Channels, ReceiverChannel(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5908,25 +17024,55 @@ func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, MessageRequest{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StartTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.EndTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5949,7 +17095,7 @@ func (m *MessageRequestList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
+func (m *ReceiverRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5972,79 +17118,15 @@ func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageRequestSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6072,13 +17154,13 @@ func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
+			m.Channel = ReceiverChannel(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverGroups", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6088,29 +17170,16 @@ func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiverGroups = append(m.ReceiverGroups, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Variables", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMinutes", wireType)
 			}
-			var msglen int
+			m.PeriodMinutes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6120,119 +17189,11 @@ func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PeriodMinutes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Variables == nil {
-				m.Variables = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Variables[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6254,7 +17215,7 @@ func (m *MessageRequestSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
+func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6277,15 +17238,15 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageRequestStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReceiverSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReceiverSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6313,13 +17274,13 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = MessageRequestPhase(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6329,28 +17290,59 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6377,10 +17369,10 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Errors == nil {
-				m.Errors = make(map[string]string)
+			if m.Identities == nil {
+				m.Identities = make(map[ReceiverChannel]string)
 			}
-			var mapkey string
+			var mapkey ReceiverChannel
 			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
@@ -6427,7 +17419,7 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					mapkey = ReceiverChannel(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var stringLenmapvalue uint64
@@ -6473,13 +17465,99 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Errors[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+			m.Identities[ReceiverChannel(mapkey)] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preferences == nil {
+				m.Preferences = &ReceiverPreferences{}
+			}
+			if err := m.Preferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlertStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6489,23 +17567,25 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlertStatus = string(dAtA[iNdEx:postIndex])
+			m.Deliveries = append(m.Deliveries, ReceiverDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6528,7 +17608,7 @@ func (m *MessageRequestStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageSpec) Unmarshal(dAtA []byte) error {
+func (m *SecretKeySelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6551,15 +17631,15 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretKeySelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretKeySelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6587,11 +17667,11 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6619,11 +17699,11 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6651,43 +17731,61 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChannel = ReceiverChannel(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6715,11 +17813,11 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6747,13 +17845,63 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Header = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Silence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Silence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Silence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6763,29 +17911,30 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelMessageID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6795,29 +17944,30 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelMessageID = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlarmPolicyName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6827,61 +17977,80 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlarmPolicyName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlarmPolicyType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlarmPolicyType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SilenceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SilenceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SilenceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChannelName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6891,29 +18060,30 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverChannelName = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6923,23 +18093,25 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, Silence{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6962,7 +18134,7 @@ func (m *MessageSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageStatus) Unmarshal(dAtA []byte) error {
+func (m *SilenceMatcher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6985,15 +18157,15 @@ func (m *MessageStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: SilenceMatcher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SilenceMatcher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7021,13 +18193,13 @@ func (m *MessageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = MessagePhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7037,28 +18209,27 @@ func (m *MessageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlertStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7086,7 +18257,7 @@ func (m *MessageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlertStatus = string(dAtA[iNdEx:postIndex])
+			m.Operator = SilenceMatchOperator(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7109,7 +18280,7 @@ func (m *MessageStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Receiver) Unmarshal(dAtA []byte) error {
+func (m *SilenceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7132,17 +18303,17 @@ func (m *Receiver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Receiver: wiretype end group for non-group")
+			return fmt.Errorf("proto: SilenceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Receiver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SilenceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7152,28 +18323,27 @@ func (m *Receiver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matchers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7200,63 +18370,47 @@ func (m *Receiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Matchers = append(m.Matchers, SilenceMatcher{})
+			if err := m.Matchers[len(m.Matchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReceiverGroup) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StartsAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7283,15 +18437,15 @@ func (m *ReceiverGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EndsAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7301,24 +18455,55 @@ func (m *ReceiverGroup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CreatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7341,7 +18526,7 @@ func (m *ReceiverGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
+func (m *SilenceStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7364,17 +18549,17 @@ func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverGroupList: wiretype end group for non-group")
+			return fmt.Errorf("proto: SilenceStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SilenceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuppressedCount", wireType)
 			}
-			var msglen int
+			m.SuppressedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7384,28 +18569,14 @@ func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SuppressedCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuppressedTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7431,9 +18602,8 @@ func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, ReceiverGroup{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			}
+			if err := m.LastSuppressedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7458,7 +18628,7 @@ func (m *ReceiverGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7481,17 +18651,17 @@ func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverGroupSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverGroupSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7501,29 +18671,30 @@ func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7533,55 +18704,24 @@ func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Receivers = append(m.Receivers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7604,7 +18744,7 @@ func (m *ReceiverGroupSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReceiverList) Unmarshal(dAtA []byte) error {
+func (m *TemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7627,10 +18767,10 @@ func (m *ReceiverList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7695,7 +18835,7 @@ func (m *ReceiverList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Receiver{})
+			m.Items = append(m.Items, Template{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -7721,7 +18861,7 @@ func (m *ReceiverList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
+func (m *TemplateMarkdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7744,15 +18884,15 @@ func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateMarkdown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateMarkdown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7780,11 +18920,11 @@ func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7812,11 +18952,61 @@ func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7844,13 +19034,13 @@ func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7860,172 +19050,59 @@ func (m *ReceiverSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Identities == nil {
-				m.Identities = make(map[ReceiverChannel]string)
-			}
-			var mapkey ReceiverChannel
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = ReceiverChannel(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Identities[ReceiverChannel(mapkey)] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Template) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TencentCloudSMS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8052,13 +19129,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TencentCloudSMS == nil {
+				m.TencentCloudSMS = &TemplateTencentCloudSMS{}
+			}
+			if err := m.TencentCloudSMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wechat", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8085,63 +19165,16 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Wechat == nil {
+				m.Wechat = &TemplateWechat{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Wechat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TemplateList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8168,13 +19201,16 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Text == nil {
+				m.Text = &TemplateText{}
+			}
+			if err := m.Text.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Markdown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8201,8 +19237,10 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Template{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Markdown == nil {
+				m.Markdown = &TemplateMarkdown{}
+			}
+			if err := m.Markdown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8227,7 +19265,7 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8250,15 +19288,15 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateTencentCloudSMS: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateTencentCloudSMS: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8286,11 +19324,11 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.TemplateID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sign", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8318,11 +19356,11 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Sign = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8350,49 +19388,63 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TencentCloudSMS", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TencentCloudSMS == nil {
-				m.TencentCloudSMS = &TemplateTencentCloudSMS{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateText) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.TencentCloudSMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateText: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateText: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wechat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8402,33 +19454,29 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wechat == nil {
-				m.Wechat = &TemplateWechat{}
-			}
-			if err := m.Wechat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8438,27 +19486,23 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Text == nil {
-				m.Text = &TemplateText{}
-			}
-			if err := m.Text.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Header = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8481,7 +19525,7 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
+func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8504,10 +19548,10 @@ func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateTencentCloudSMS: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateWechat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateTencentCloudSMS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateWechat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8544,7 +19588,7 @@ func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8572,11 +19616,11 @@ func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sign = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniProgramAppID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8604,61 +19648,11 @@ func (m *TemplateTencentCloudSMS) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.MiniProgramAppID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TemplateText) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateText: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateText: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniProgramPagePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8686,11 +19680,11 @@ func (m *TemplateText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.MiniProgramPagePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8718,7 +19712,7 @@ func (m *TemplateText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Header = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8741,7 +19735,7 @@ func (m *TemplateText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
+func (m *WebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8764,15 +19758,15 @@ func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateWechat: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebhookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateWechat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebhookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8800,7 +19794,7 @@ func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateID = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -8836,9 +19830,9 @@ func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiniProgramAppID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8848,57 +19842,120 @@ func (m *TemplateWechat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MiniProgramAppID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MiniProgramPagePath", wireType)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MiniProgramPagePath = string(dAtA[iNdEx:postIndex])
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
@@ -67,7 +67,19 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMapList{},
 
 		&Message{},
-		&MessageList{})
+		&MessageList{},
+
+		&Silence{},
+		&SilenceList{},
+
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
+
+		&EscalationPolicy{},
+		&EscalationPolicyList{},
+
+		&AuditRule{},
+		&AuditRuleList{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
@@ -29,6 +29,45 @@ package v1
 // Those methods can be generated by using hack/update-generated-swagger-docs.sh
 
 // AUTO-GENERATED FUNCTIONS START HERE. DO NOT EDIT.
+var map_AuditRule = map[string]string{
+	"":     "AuditRule creates a message request for every audit event matched by it when the event is stored by the audit server.",
+	"spec": "Spec defines the desired audit rule.",
+}
+
+func (AuditRule) SwaggerDoc() map[string]string {
+	return map_AuditRule
+}
+
+var map_AuditRuleList = map[string]string{
+	"":      "AuditRuleList is the whole list of all audit rules which owned by a tenant.",
+	"items": "List of audit rules.",
+}
+
+func (AuditRuleList) SwaggerDoc() map[string]string {
+	return map_AuditRuleList
+}
+
+var map_AuditRuleMatch = map[string]string{
+	"":          "AuditRuleMatch selects the audit events of the tenant of the rule by their fields. An event belongs to the tenant of its user, or of its cluster if the user has no tenant. An empty list matches any value, and a value of a list may use * to match any characters.",
+	"resources": "Resources are the resources of the events, a subresource is matched as the resource followed by a slash and the subresource, such as pods/exec.",
+	"clusters":  "Clusters are the names of the clusters of the tenant, which do not use *.",
+	"codes":     "Codes are the response codes of the events.",
+}
+
+func (AuditRuleMatch) SwaggerDoc() map[string]string {
+	return map_AuditRuleMatch
+}
+
+var map_AuditRuleSpec = map[string]string{
+	"":            "AuditRuleSpec is a description of an audit rule.",
+	"disabled":    "Disabled stops the rule from matching any event.",
+	"channelName": "ChannelName is the channel of the message requests, which are created in the namespace named after it.",
+}
+
+func (AuditRuleSpec) SwaggerDoc() map[string]string {
+	return map_AuditRuleSpec
+}
+
 var map_Channel = map[string]string{
 	"":     "Channel represents a message transmission channel in TKE.",
 	"spec": "Spec defines the desired channel.",
@@ -38,6 +77,24 @@ func (Channel) SwaggerDoc() map[string]string {
 	return map_Channel
 }
 
+var map_ChannelDingTalk = map[string]string{
+	"":       "ChannelDingTalk indicates a channel configuration for sending notifications to a DingTalk group using a custom robot. See: https://open.dingtalk.com/document/robots/custom-robot-access",
+	"secret": "Secret indicates the signing secret of the robot, the requests are signed if it is specified.",
+}
+
+func (ChannelDingTalk) SwaggerDoc() map[string]string {
+	return map_ChannelDingTalk
+}
+
+var map_ChannelLark = map[string]string{
+	"":       "ChannelLark indicates a channel configuration for sending notifications to a Lark (Feishu) group using a custom bot. See: https://open.feishu.cn/document/ukTMukTMukTM/ucTM5YjL3ETO24yNxkjN",
+	"secret": "Secret indicates the signing secret of the bot, the requests are signed if it is specified.",
+}
+
+func (ChannelLark) SwaggerDoc() map[string]string {
+	return map_ChannelLark
+}
+
 var map_ChannelList = map[string]string{
 	"":      "ChannelList is the whole list of all channels which owned by a tenant.",
 	"items": "List of channels.",
@@ -55,6 +112,14 @@ func (ChannelSMTP) SwaggerDoc() map[string]string {
 	return map_ChannelSMTP
 }
 
+var map_ChannelSlack = map[string]string{
+	"": "ChannelSlack indicates a channel configuration for sending notifications to a Slack channel using an incoming webhook. See: https://api.slack.com/messaging/webhooks",
+}
+
+func (ChannelSlack) SwaggerDoc() map[string]string {
+	return map_ChannelSlack
+}
+
 var map_ChannelSpec = map[string]string{
 	"":           "ChannelSpec is a description of a channel.",
 	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
@@ -80,8 +145,22 @@ func (ChannelTencentCloudSMS) SwaggerDoc() map[string]string {
 	return map_ChannelTencentCloudSMS
 }
 
+var map_ChannelWeCom = map[string]string{
+	"": "ChannelWeCom indicates a channel configuration for sending notifications to a WeCom (WeChat Work) group using a group robot. See: https://developer.work.weixin.qq.com/document/path/91770",
+}
+
+func (ChannelWeCom) SwaggerDoc() map[string]string {
+	return map_ChannelWeCom
+}
+
 var map_ChannelWebhook = map[string]string{
-	"": "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
+	"":                           "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
+	"method":                     "Method indicates the HTTP method used to request the webhook server, defaults to POST.",
+	"bodyTemplate":               "BodyTemplate indicates a go template used to render the request body, the message variables, `content`, `alertStatus` and `receivers` are available in the template, and the `json` function quotes a value as a json string. The default body contains the receivers and content.",
+	"signingSecretRef":           "SigningSecretRef references the key of secret used to sign the request with HMAC-SHA256, the signature is sent in the X-TKE-Signature header.",
+	"clientCertificateSecretRef": "ClientCertificateSecretRef references a kubernetes.io/tls secret which holds the client certificate and key used for mutual TLS, the ca.crt of secret is used to verify the webhook server if it exists.",
+	"timeoutSeconds":             "TimeoutSeconds indicates the timeout of the request, defaults to 10.",
+	"insecureSkipVerify":         "InsecureSkipVerify indicates whether to skip the verification of the webhook server certificate, the server is verified against the system roots or the ca.crt of the client certificate secret by default.",
 }
 
 func (ChannelWebhook) SwaggerDoc() map[string]string {
@@ -116,6 +195,50 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_EscalationPolicy = map[string]string{
+	"":     "EscalationPolicy describes who to notify in turn until a message is acknowledged.",
+	"spec": "Spec defines the desired escalation policy.",
+}
+
+func (EscalationPolicy) SwaggerDoc() map[string]string {
+	return map_EscalationPolicy
+}
+
+var map_EscalationPolicyList = map[string]string{
+	"":      "EscalationPolicyList is the whole list of all escalation policies which owned by a tenant.",
+	"items": "List of escalation policies.",
+}
+
+func (EscalationPolicyList) SwaggerDoc() map[string]string {
+	return map_EscalationPolicyList
+}
+
+var map_EscalationPolicySpec = map[string]string{
+	"":      "EscalationPolicySpec is a description of an escalation policy.",
+	"rules": "Rules are the escalation levels, the first rule is notified when the message is sent.",
+}
+
+func (EscalationPolicySpec) SwaggerDoc() map[string]string {
+	return map_EscalationPolicySpec
+}
+
+var map_EscalationRule = map[string]string{
+	"":             "EscalationRule is a level of the escalation policy.",
+	"delayMinutes": "DelayMinutes is how long to wait for an acknowledgement before escalating to the next rule.",
+}
+
+func (EscalationRule) SwaggerDoc() map[string]string {
+	return map_EscalationRule
+}
+
+var map_EscalationTarget = map[string]string{
+	"": "EscalationTarget references the receivers notified by an escalation rule.",
+}
+
+func (EscalationTarget) SwaggerDoc() map[string]string {
+	return map_EscalationTarget
+}
+
 var map_Message = map[string]string{
 	"":     "Message indicates a message in the notification system.",
 	"spec": "Spec defines the desired message.",
@@ -143,6 +266,29 @@ func (MessageRequest) SwaggerDoc() map[string]string {
 	return map_MessageRequest
 }
 
+var map_MessageRequestDeferralStatus = map[string]string{
+	"":             "MessageRequestDeferralStatus describes a deferred delivery.",
+	"deferredTime": "The time at which the delivery was deferred.",
+	"deliverAfter": "The time after which the delivery will be attempted again.",
+	"reason":       "A human readable message indicating why the delivery was deferred.",
+}
+
+func (MessageRequestDeferralStatus) SwaggerDoc() map[string]string {
+	return map_MessageRequestDeferralStatus
+}
+
+var map_MessageRequestEscalationStatus = map[string]string{
+	"":                   "MessageRequestEscalationStatus describes the escalation state of a receiver group.",
+	"policy":             "Policy is the name of the escalation policy being followed.",
+	"level":              "Level is the index of the escalation rule notified most recently.",
+	"lastEscalationTime": "The last time the receivers of a level were notified.",
+	"nextEscalationTime": "The time after which the next level will be notified if no message has been acknowledged, it is empty if the escalation has finished.",
+}
+
+func (MessageRequestEscalationStatus) SwaggerDoc() map[string]string {
+	return map_MessageRequestEscalationStatus
+}
+
 var map_MessageRequestList = map[string]string{
 	"":      "MessageRequestList is the whole list of all message which owned by a tenant.",
 	"items": "List of message requests.",
@@ -152,6 +298,18 @@ func (MessageRequestList) SwaggerDoc() map[string]string {
 	return map_MessageRequestList
 }
 
+var map_MessageRequestRetryStatus = map[string]string{
+	"":                "MessageRequestRetryStatus describes the delivery retry state of a receiver.",
+	"attempts":        "Attempts is the number of delivery attempts made so far.",
+	"lastAttemptTime": "The last time a delivery was attempted.",
+	"nextAttemptTime": "The time after which the next delivery will be attempted.",
+	"lastError":       "The error returned by the last delivery attempt.",
+}
+
+func (MessageRequestRetryStatus) SwaggerDoc() map[string]string {
+	return map_MessageRequestRetryStatus
+}
+
 var map_MessageRequestSpec = map[string]string{
 	"": "MessageRequestSpec is a description of a message request.",
 }
@@ -165,6 +323,9 @@ var map_MessageRequestStatus = map[string]string{
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
 	"errors":             "A human readable message indicating details about the transition.",
 	"alertStatus":        "alert's status in notification sending from alertmanager",
+	"retries":            "Retries records the delivery retry state of each failed receiver, keyed the same way as Errors.",
+	"escalations":        "Escalations records the escalation state of each receiver group which has an escalation policy, keyed by the name of the receiver group.",
+	"deferrals":          "Deferrals records the deliveries deferred by the preferences of the receivers, keyed by the receiver name and the receiver channel joined by a slash.",
 }
 
 func (MessageRequestStatus) SwaggerDoc() map[string]string {
@@ -172,7 +333,9 @@ func (MessageRequestStatus) SwaggerDoc() map[string]string {
 }
 
 var map_MessageSpec = map[string]string{
-	"": "MessageSpec is a description of a message.",
+	"":                   "MessageSpec is a description of a message.",
+	"webhookRequest":     "WebhookRequest records the request sent to the webhook server.",
+	"messageRequestName": "MessageRequestName is the name of the message request which sent the message, the message request is in the namespace of ReceiverChannelName.",
 }
 
 func (MessageSpec) SwaggerDoc() map[string]string {
@@ -183,21 +346,107 @@ var map_MessageStatus = map[string]string{
 	"":                   "MessageStatus represents information about the status of a message.",
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
 	"alertStatus":        "alert's status in notification sending from alertmanager",
+	"acknowledgedTime":   "The time at which the message was acknowledged.",
+	"acknowledgedBy":     "The name of the user who acknowledged the message.",
+	"deliveryState":      "DeliveryState indicates how the message was delivered, empty means the message was delivered immediately.",
+	"deliveryReason":     "A human readable message indicating why the delivery was deferred.",
+	"deferredTime":       "The time at which the delivery was deferred.",
+	"digestMessageName":  "DigestMessageName is the name of the digest message which the message has been merged into.",
 }
 
 func (MessageStatus) SwaggerDoc() map[string]string {
 	return map_MessageStatus
 }
 
+var map_OnCallLayer = map[string]string{
+	"":             "OnCallLayer rotates the on-call duty among the receivers.",
+	"receivers":    "Receivers are the names of the receivers in rotation order.",
+	"start":        "Start is the time at which the first receiver starts the duty, the hand-offs happen at the same local time of day.",
+	"shiftLength":  "ShiftLength is the number of rotation units of one shift, defaults to 1.",
+	"restrictions": "Restrictions limit the layer to the given time windows of each day, the layer is always active if it is empty.",
+}
+
+func (OnCallLayer) SwaggerDoc() map[string]string {
+	return map_OnCallLayer
+}
+
+var map_OnCallOverride = map[string]string{
+	"": "OnCallOverride puts the receiver on call during the time range regardless of the layers.",
+}
+
+func (OnCallOverride) SwaggerDoc() map[string]string {
+	return map_OnCallOverride
+}
+
+var map_OnCallRestriction = map[string]string{
+	"":          "OnCallRestriction is a daily time window in the time zone of the schedule, the window crosses midnight if the end time is not after the start time.",
+	"startTime": "StartTime is the local time of day in the format of HH:MM.",
+	"endTime":   "EndTime is the local time of day in the format of HH:MM.",
+}
+
+func (OnCallRestriction) SwaggerDoc() map[string]string {
+	return map_OnCallRestriction
+}
+
+var map_OnCallSchedule = map[string]string{
+	"":     "OnCallSchedule describes who is on call at any time by rotating receivers in layers.",
+	"spec": "Spec defines the desired on-call schedule.",
+}
+
+func (OnCallSchedule) SwaggerDoc() map[string]string {
+	return map_OnCallSchedule
+}
+
+var map_OnCallScheduleList = map[string]string{
+	"":      "OnCallScheduleList is the whole list of all on-call schedules which owned by a tenant.",
+	"items": "List of on-call schedules.",
+}
+
+func (OnCallScheduleList) SwaggerDoc() map[string]string {
+	return map_OnCallScheduleList
+}
+
+var map_OnCallScheduleSpec = map[string]string{
+	"":          "OnCallScheduleSpec is a description of an on-call schedule.",
+	"timeZone":  "TimeZone is the IANA time zone name in which the hand-offs and the restrictions of the layers are evaluated, defaults to UTC.",
+	"layers":    "Layers are evaluated from the last to the first, the receiver of the last layer which is active at the time is on call.",
+	"overrides": "Overrides take precedence over all of the layers.",
+}
+
+func (OnCallScheduleSpec) SwaggerDoc() map[string]string {
+	return map_OnCallScheduleSpec
+}
+
 var map_Receiver = map[string]string{
-	"":     "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
-	"spec": "Spec defines the desired receiver.",
+	"":       "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
+	"spec":   "Spec defines the desired receiver.",
+	"status": "Status defines the deliveries counted by the rate limits of the receiver.",
 }
 
 func (Receiver) SwaggerDoc() map[string]string {
 	return map_Receiver
 }
 
+var map_ReceiverDelivery = map[string]string{
+	"": "ReceiverDelivery is a message delivered to the receiver.",
+}
+
+func (ReceiverDelivery) SwaggerDoc() map[string]string {
+	return map_ReceiverDelivery
+}
+
+var map_ReceiverDigest = map[string]string{
+	"":                "ReceiverDigest batches the messages of the given severities into a digest email sent periodically.",
+	"severities":      "Severities are the values of the severity variable of the messages which are batched into the digest.",
+	"intervalMinutes": "IntervalMinutes is how long the first batched message waits before the digest is sent, defaults to 60.",
+	"channel":         "Channel is the name of the SMTP channel which sends the digest.",
+	"template":        "Template is the name of the text template in the channel which renders the digest.",
+}
+
+func (ReceiverDigest) SwaggerDoc() map[string]string {
+	return map_ReceiverDigest
+}
+
 var map_ReceiverGroup = map[string]string{
 	"":     "ReceiverGroup indicates multiple message recipients.",
 	"spec": "Spec defines the desired receiver group.",
@@ -217,7 +466,8 @@ func (ReceiverGroupList) SwaggerDoc() map[string]string {
 }
 
 var map_ReceiverGroupSpec = map[string]string{
-	"": "ReceiverGroupSpec is a description of a receiver group.",
+	"":                 "ReceiverGroupSpec is a description of a receiver group.",
+	"escalationPolicy": "EscalationPolicy is the name of the escalation policy which resolves the receivers to notify at send time, the static receivers are not notified if it is specified.",
 }
 
 func (ReceiverGroupSpec) SwaggerDoc() map[string]string {
@@ -233,15 +483,127 @@ func (ReceiverList) SwaggerDoc() map[string]string {
 	return map_ReceiverList
 }
 
+var map_ReceiverPreferences = map[string]string{
+	"":           "ReceiverPreferences describes the notification preferences of a receiver, they apply to the messages delivered to the receiver individually, which are the messages of mobile, wechat and email channels.",
+	"timeZone":   "TimeZone is the IANA time zone name in which the quiet hours are evaluated, defaults to UTC.",
+	"quietHours": "QuietHours defer the messages until the end of the quiet hours.",
+	"rateLimits": "RateLimits defer the messages exceeding the limits until the limits allow them.",
+	"digest":     "Digest batches the messages of low severity into a periodic digest email.",
+}
+
+func (ReceiverPreferences) SwaggerDoc() map[string]string {
+	return map_ReceiverPreferences
+}
+
+var map_ReceiverQuietHours = map[string]string{
+	"":          "ReceiverQuietHours is a daily time window during which no message is delivered, the window crosses midnight if the end time is not after the start time.",
+	"channels":  "Channels are the receiver channels the quiet hours apply to, all of the channels if it is empty.",
+	"startTime": "StartTime is the local time of day in the format of HH:MM.",
+	"endTime":   "EndTime is the local time of day in the format of HH:MM.",
+}
+
+func (ReceiverQuietHours) SwaggerDoc() map[string]string {
+	return map_ReceiverQuietHours
+}
+
+var map_ReceiverRateLimit = map[string]string{
+	"":              "ReceiverRateLimit limits the number of messages delivered to the receiver within a period.",
+	"channel":       "Channel is the receiver channel the limit applies to, the limit counts the messages of all channels if it is empty.",
+	"limit":         "Limit is the maximum number of messages delivered within the period.",
+	"periodMinutes": "PeriodMinutes is the length of the sliding period.",
+}
+
+func (ReceiverRateLimit) SwaggerDoc() map[string]string {
+	return map_ReceiverRateLimit
+}
+
 var map_ReceiverSpec = map[string]string{
-	"":           "ReceiverSpec is a description of a receiver.",
-	"identities": "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+	"":            "ReceiverSpec is a description of a receiver.",
+	"identities":  "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+	"preferences": "Preferences controls when and how often the messages are delivered to the receiver.",
 }
 
 func (ReceiverSpec) SwaggerDoc() map[string]string {
 	return map_ReceiverSpec
 }
 
+var map_ReceiverStatus = map[string]string{
+	"":           "ReceiverStatus represents information about the status of a receiver.",
+	"deliveries": "Deliveries are the messages recently delivered to the receiver on the channels limited by the rate limits, the deliveries which are older than the longest period of the rate limits are removed.",
+}
+
+func (ReceiverStatus) SwaggerDoc() map[string]string {
+	return map_ReceiverStatus
+}
+
+var map_SecretKeySelector = map[string]string{
+	"":          "SecretKeySelector selects a key of a Secret in the cluster where TKE is deployed.",
+	"namespace": "Namespace of the secret, which must be and defaults to notify- followed by the tenant ID of the channel, so that a channel only reads the secrets of its own tenant.",
+}
+
+func (SecretKeySelector) SwaggerDoc() map[string]string {
+	return map_SecretKeySelector
+}
+
+var map_SecretReference = map[string]string{
+	"":          "SecretReference references a Secret in the cluster where TKE is deployed.",
+	"namespace": "Namespace of the secret, which must be and defaults to notify- followed by the tenant ID of the channel, so that a channel only reads the secrets of its own tenant.",
+}
+
+func (SecretReference) SwaggerDoc() map[string]string {
+	return map_SecretReference
+}
+
+var map_Silence = map[string]string{
+	"":     "Silence suppresses the alerts matched by its matchers during the specified time range before any message request is created for them.",
+	"spec": "Spec defines the desired silence.",
+}
+
+func (Silence) SwaggerDoc() map[string]string {
+	return map_Silence
+}
+
+var map_SilenceList = map[string]string{
+	"":      "SilenceList is the whole list of all silences which owned by a tenant.",
+	"items": "List of silences.",
+}
+
+func (SilenceList) SwaggerDoc() map[string]string {
+	return map_SilenceList
+}
+
+var map_SilenceMatcher = map[string]string{
+	"":      "SilenceMatcher matches an alert label by its value.",
+	"name":  "Name is the name of the alert label.",
+	"value": "Value is the literal value or the regular expression, depending on the operator.",
+}
+
+func (SilenceMatcher) SwaggerDoc() map[string]string {
+	return map_SilenceMatcher
+}
+
+var map_SilenceSpec = map[string]string{
+	"":          "SilenceSpec is a description of a silence.",
+	"matchers":  "Matchers are ANDed together, an alert is silenced only if all of them match its labels.",
+	"startsAt":  "StartsAt is the time from which the silence takes effect.",
+	"endsAt":    "EndsAt is the time after which the silence no longer takes effect.",
+	"createdBy": "CreatedBy is the name of the user who created the silence.",
+}
+
+func (SilenceSpec) SwaggerDoc() map[string]string {
+	return map_SilenceSpec
+}
+
+var map_SilenceStatus = map[string]string{
+	"":                   "SilenceStatus represents information about the status of a silence.",
+	"suppressedCount":    "SuppressedCount is the number of alerts suppressed by the silence.",
+	"lastSuppressedTime": "The last time an alert was suppressed by the silence.",
+}
+
+func (SilenceStatus) SwaggerDoc() map[string]string {
+	return map_SilenceStatus
+}
+
 var map_Template = map[string]string{
 	"":     "Template indicates the template used to send notifications under this channel.",
 	"spec": "Spec defines the desired template.",
@@ -260,6 +622,15 @@ func (TemplateList) SwaggerDoc() map[string]string {
 	return map_TemplateList
 }
 
+var map_TemplateMarkdown = map[string]string{
+	"":      "TemplateMarkdown indicates the template used to send markdown notifications to the chat tool channels, such as Slack, DingTalk, WeCom and Lark.",
+	"title": "Title indicates the title of the message card.",
+}
+
+func (TemplateMarkdown) SwaggerDoc() map[string]string {
+	return map_TemplateMarkdown
+}
+
 var map_TemplateSpec = map[string]string{
 	"": "TemplateSpec is a description of a template.",
 }
@@ -295,4 +666,13 @@ func (TemplateWechat) SwaggerDoc() map[string]string {
 	return map_TemplateWechat
 }
 
+var map_WebhookRequest = map[string]string{
+	"":        "WebhookRequest describes a request sent to the webhook server.",
+	"headers": "Headers are the headers of the request, the values of the headers of the channel are redacted as they may hold credentials.",
+}
+
+func (WebhookRequest) SwaggerDoc() map[string]string {
+	return map_WebhookRequest
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
@@ -37,6 +37,46 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AuditRule)(nil), (*notify.AuditRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditRule_To_notify_AuditRule(a.(*AuditRule), b.(*notify.AuditRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.AuditRule)(nil), (*AuditRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_AuditRule_To_v1_AuditRule(a.(*notify.AuditRule), b.(*AuditRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditRuleList)(nil), (*notify.AuditRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditRuleList_To_notify_AuditRuleList(a.(*AuditRuleList), b.(*notify.AuditRuleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.AuditRuleList)(nil), (*AuditRuleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_AuditRuleList_To_v1_AuditRuleList(a.(*notify.AuditRuleList), b.(*AuditRuleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditRuleMatch)(nil), (*notify.AuditRuleMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditRuleMatch_To_notify_AuditRuleMatch(a.(*AuditRuleMatch), b.(*notify.AuditRuleMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.AuditRuleMatch)(nil), (*AuditRuleMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_AuditRuleMatch_To_v1_AuditRuleMatch(a.(*notify.AuditRuleMatch), b.(*AuditRuleMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditRuleSpec)(nil), (*notify.AuditRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditRuleSpec_To_notify_AuditRuleSpec(a.(*AuditRuleSpec), b.(*notify.AuditRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.AuditRuleSpec)(nil), (*AuditRuleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_AuditRuleSpec_To_v1_AuditRuleSpec(a.(*notify.AuditRuleSpec), b.(*AuditRuleSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Channel)(nil), (*notify.Channel)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Channel_To_notify_Channel(a.(*Channel), b.(*notify.Channel), scope)
 	}); err != nil {
@@ -47,6 +87,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelDingTalk)(nil), (*notify.ChannelDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(a.(*ChannelDingTalk), b.(*notify.ChannelDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelDingTalk)(nil), (*ChannelDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(a.(*notify.ChannelDingTalk), b.(*ChannelDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelLark)(nil), (*notify.ChannelLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelLark_To_notify_ChannelLark(a.(*ChannelLark), b.(*notify.ChannelLark), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelLark)(nil), (*ChannelLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelLark_To_v1_ChannelLark(a.(*notify.ChannelLark), b.(*ChannelLark), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelList)(nil), (*notify.ChannelList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelList_To_notify_ChannelList(a.(*ChannelList), b.(*notify.ChannelList), scope)
 	}); err != nil {
@@ -67,6 +127,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelSlack)(nil), (*notify.ChannelSlack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelSlack_To_notify_ChannelSlack(a.(*ChannelSlack), b.(*notify.ChannelSlack), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelSlack)(nil), (*ChannelSlack)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelSlack_To_v1_ChannelSlack(a.(*notify.ChannelSlack), b.(*ChannelSlack), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelSpec)(nil), (*notify.ChannelSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelSpec_To_notify_ChannelSpec(a.(*ChannelSpec), b.(*notify.ChannelSpec), scope)
 	}); err != nil {
@@ -97,6 +167,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelWeCom)(nil), (*notify.ChannelWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelWeCom_To_notify_ChannelWeCom(a.(*ChannelWeCom), b.(*notify.ChannelWeCom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelWeCom)(nil), (*ChannelWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelWeCom_To_v1_ChannelWeCom(a.(*notify.ChannelWeCom), b.(*ChannelWeCom), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelWebhook)(nil), (*notify.ChannelWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelWebhook_To_notify_ChannelWebhook(a.(*ChannelWebhook), b.(*notify.ChannelWebhook), scope)
 	}); err != nil {
@@ -137,6 +217,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicy)(nil), (*notify.EscalationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicy_To_notify_EscalationPolicy(a.(*EscalationPolicy), b.(*notify.EscalationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicy)(nil), (*EscalationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicy_To_v1_EscalationPolicy(a.(*notify.EscalationPolicy), b.(*EscalationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicyList)(nil), (*notify.EscalationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(a.(*EscalationPolicyList), b.(*notify.EscalationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicyList)(nil), (*EscalationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(a.(*notify.EscalationPolicyList), b.(*EscalationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicySpec)(nil), (*notify.EscalationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(a.(*EscalationPolicySpec), b.(*notify.EscalationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicySpec)(nil), (*EscalationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(a.(*notify.EscalationPolicySpec), b.(*EscalationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationRule)(nil), (*notify.EscalationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationRule_To_notify_EscalationRule(a.(*EscalationRule), b.(*notify.EscalationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationRule)(nil), (*EscalationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationRule_To_v1_EscalationRule(a.(*notify.EscalationRule), b.(*EscalationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationTarget)(nil), (*notify.EscalationTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationTarget_To_notify_EscalationTarget(a.(*EscalationTarget), b.(*notify.EscalationTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationTarget)(nil), (*EscalationTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationTarget_To_v1_EscalationTarget(a.(*notify.EscalationTarget), b.(*EscalationTarget), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Message)(nil), (*notify.Message)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Message_To_notify_Message(a.(*Message), b.(*notify.Message), scope)
 	}); err != nil {
@@ -167,6 +297,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MessageRequestDeferralStatus)(nil), (*notify.MessageRequestDeferralStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MessageRequestDeferralStatus_To_notify_MessageRequestDeferralStatus(a.(*MessageRequestDeferralStatus), b.(*notify.MessageRequestDeferralStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.MessageRequestDeferralStatus)(nil), (*MessageRequestDeferralStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_MessageRequestDeferralStatus_To_v1_MessageRequestDeferralStatus(a.(*notify.MessageRequestDeferralStatus), b.(*MessageRequestDeferralStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MessageRequestEscalationStatus)(nil), (*notify.MessageRequestEscalationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MessageRequestEscalationStatus_To_notify_MessageRequestEscalationStatus(a.(*MessageRequestEscalationStatus), b.(*notify.MessageRequestEscalationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.MessageRequestEscalationStatus)(nil), (*MessageRequestEscalationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_MessageRequestEscalationStatus_To_v1_MessageRequestEscalationStatus(a.(*notify.MessageRequestEscalationStatus), b.(*MessageRequestEscalationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MessageRequestList)(nil), (*notify.MessageRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_MessageRequestList_To_notify_MessageRequestList(a.(*MessageRequestList), b.(*notify.MessageRequestList), scope)
 	}); err != nil {
@@ -177,6 +327,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MessageRequestRetryStatus)(nil), (*notify.MessageRequestRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MessageRequestRetryStatus_To_notify_MessageRequestRetryStatus(a.(*MessageRequestRetryStatus), b.(*notify.MessageRequestRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.MessageRequestRetryStatus)(nil), (*MessageRequestRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_MessageRequestRetryStatus_To_v1_MessageRequestRetryStatus(a.(*notify.MessageRequestRetryStatus), b.(*MessageRequestRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MessageRequestSpec)(nil), (*notify.MessageRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_MessageRequestSpec_To_notify_MessageRequestSpec(a.(*MessageRequestSpec), b.(*notify.MessageRequestSpec), scope)
 	}); err != nil {
@@ -217,6 +377,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OnCallLayer)(nil), (*notify.OnCallLayer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallLayer_To_notify_OnCallLayer(a.(*OnCallLayer), b.(*notify.OnCallLayer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallLayer)(nil), (*OnCallLayer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallLayer_To_v1_OnCallLayer(a.(*notify.OnCallLayer), b.(*OnCallLayer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallOverride)(nil), (*notify.OnCallOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallOverride_To_notify_OnCallOverride(a.(*OnCallOverride), b.(*notify.OnCallOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallOverride)(nil), (*OnCallOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallOverride_To_v1_OnCallOverride(a.(*notify.OnCallOverride), b.(*OnCallOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallRestriction)(nil), (*notify.OnCallRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallRestriction_To_notify_OnCallRestriction(a.(*OnCallRestriction), b.(*notify.OnCallRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallRestriction)(nil), (*OnCallRestriction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallRestriction_To_v1_OnCallRestriction(a.(*notify.OnCallRestriction), b.(*OnCallRestriction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallSchedule)(nil), (*notify.OnCallSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallSchedule_To_notify_OnCallSchedule(a.(*OnCallSchedule), b.(*notify.OnCallSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallSchedule)(nil), (*OnCallSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallSchedule_To_v1_OnCallSchedule(a.(*notify.OnCallSchedule), b.(*OnCallSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallScheduleList)(nil), (*notify.OnCallScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(a.(*OnCallScheduleList), b.(*notify.OnCallScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallScheduleList)(nil), (*OnCallScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(a.(*notify.OnCallScheduleList), b.(*OnCallScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallScheduleSpec)(nil), (*notify.OnCallScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(a.(*OnCallScheduleSpec), b.(*notify.OnCallScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallScheduleSpec)(nil), (*OnCallScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(a.(*notify.OnCallScheduleSpec), b.(*OnCallScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Receiver)(nil), (*notify.Receiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Receiver_To_notify_Receiver(a.(*Receiver), b.(*notify.Receiver), scope)
 	}); err != nil {
@@ -227,6 +447,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverDelivery)(nil), (*notify.ReceiverDelivery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverDelivery_To_notify_ReceiverDelivery(a.(*ReceiverDelivery), b.(*notify.ReceiverDelivery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverDelivery)(nil), (*ReceiverDelivery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverDelivery_To_v1_ReceiverDelivery(a.(*notify.ReceiverDelivery), b.(*ReceiverDelivery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverDigest)(nil), (*notify.ReceiverDigest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverDigest_To_notify_ReceiverDigest(a.(*ReceiverDigest), b.(*notify.ReceiverDigest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverDigest)(nil), (*ReceiverDigest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverDigest_To_v1_ReceiverDigest(a.(*notify.ReceiverDigest), b.(*ReceiverDigest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ReceiverGroup)(nil), (*notify.ReceiverGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ReceiverGroup_To_notify_ReceiverGroup(a.(*ReceiverGroup), b.(*notify.ReceiverGroup), scope)
 	}); err != nil {
@@ -267,6 +507,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverPreferences)(nil), (*notify.ReceiverPreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverPreferences_To_notify_ReceiverPreferences(a.(*ReceiverPreferences), b.(*notify.ReceiverPreferences), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverPreferences)(nil), (*ReceiverPreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverPreferences_To_v1_ReceiverPreferences(a.(*notify.ReceiverPreferences), b.(*ReceiverPreferences), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverQuietHours)(nil), (*notify.ReceiverQuietHours)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverQuietHours_To_notify_ReceiverQuietHours(a.(*ReceiverQuietHours), b.(*notify.ReceiverQuietHours), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverQuietHours)(nil), (*ReceiverQuietHours)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverQuietHours_To_v1_ReceiverQuietHours(a.(*notify.ReceiverQuietHours), b.(*ReceiverQuietHours), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverRateLimit)(nil), (*notify.ReceiverRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverRateLimit_To_notify_ReceiverRateLimit(a.(*ReceiverRateLimit), b.(*notify.ReceiverRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverRateLimit)(nil), (*ReceiverRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverRateLimit_To_v1_ReceiverRateLimit(a.(*notify.ReceiverRateLimit), b.(*ReceiverRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ReceiverSpec)(nil), (*notify.ReceiverSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ReceiverSpec_To_notify_ReceiverSpec(a.(*ReceiverSpec), b.(*notify.ReceiverSpec), scope)
 	}); err != nil {
@@ -277,6 +547,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReceiverStatus)(nil), (*notify.ReceiverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReceiverStatus_To_notify_ReceiverStatus(a.(*ReceiverStatus), b.(*notify.ReceiverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ReceiverStatus)(nil), (*ReceiverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ReceiverStatus_To_v1_ReceiverStatus(a.(*notify.ReceiverStatus), b.(*ReceiverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretKeySelector)(nil), (*notify.SecretKeySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretKeySelector_To_notify_SecretKeySelector(a.(*SecretKeySelector), b.(*notify.SecretKeySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SecretKeySelector)(nil), (*SecretKeySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SecretKeySelector_To_v1_SecretKeySelector(a.(*notify.SecretKeySelector), b.(*SecretKeySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretReference)(nil), (*notify.SecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretReference_To_notify_SecretReference(a.(*SecretReference), b.(*notify.SecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SecretReference)(nil), (*SecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SecretReference_To_v1_SecretReference(a.(*notify.SecretReference), b.(*SecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Silence)(nil), (*notify.Silence)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Silence_To_notify_Silence(a.(*Silence), b.(*notify.Silence), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.Silence)(nil), (*Silence)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_Silence_To_v1_Silence(a.(*notify.Silence), b.(*Silence), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SilenceList)(nil), (*notify.SilenceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SilenceList_To_notify_SilenceList(a.(*SilenceList), b.(*notify.SilenceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SilenceList)(nil), (*SilenceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SilenceList_To_v1_SilenceList(a.(*notify.SilenceList), b.(*SilenceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SilenceMatcher)(nil), (*notify.SilenceMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SilenceMatcher_To_notify_SilenceMatcher(a.(*SilenceMatcher), b.(*notify.SilenceMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SilenceMatcher)(nil), (*SilenceMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SilenceMatcher_To_v1_SilenceMatcher(a.(*notify.SilenceMatcher), b.(*SilenceMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SilenceSpec)(nil), (*notify.SilenceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SilenceSpec_To_notify_SilenceSpec(a.(*SilenceSpec), b.(*notify.SilenceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SilenceSpec)(nil), (*SilenceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SilenceSpec_To_v1_SilenceSpec(a.(*notify.SilenceSpec), b.(*SilenceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SilenceStatus)(nil), (*notify.SilenceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SilenceStatus_To_notify_SilenceStatus(a.(*SilenceStatus), b.(*notify.SilenceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.SilenceStatus)(nil), (*SilenceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_SilenceStatus_To_v1_SilenceStatus(a.(*notify.SilenceStatus), b.(*SilenceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Template)(nil), (*notify.Template)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Template_To_notify_Template(a.(*Template), b.(*notify.Template), scope)
 	}); err != nil {
@@ -297,6 +647,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateMarkdown)(nil), (*notify.TemplateMarkdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateMarkdown_To_notify_TemplateMarkdown(a.(*TemplateMarkdown), b.(*notify.TemplateMarkdown), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateMarkdown)(nil), (*TemplateMarkdown)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateMarkdown_To_v1_TemplateMarkdown(a.(*notify.TemplateMarkdown), b.(*TemplateMarkdown), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TemplateSpec)(nil), (*notify.TemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TemplateSpec_To_notify_TemplateSpec(a.(*TemplateSpec), b.(*notify.TemplateSpec), scope)
 	}); err != nil {
@@ -337,9 +697,135 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WebhookRequest)(nil), (*notify.WebhookRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_WebhookRequest_To_notify_WebhookRequest(a.(*WebhookRequest), b.(*notify.WebhookRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.WebhookRequest)(nil), (*WebhookRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_WebhookRequest_To_v1_WebhookRequest(a.(*notify.WebhookRequest), b.(*WebhookRequest), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1_AuditRule_To_notify_AuditRule(in *AuditRule, out *notify.AuditRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_AuditRuleSpec_To_notify_AuditRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_AuditRule_To_notify_AuditRule is an autogenerated conversion function.
+func Convert_v1_AuditRule_To_notify_AuditRule(in *AuditRule, out *notify.AuditRule, s conversion.Scope) error {
+	return autoConvert_v1_AuditRule_To_notify_AuditRule(in, out, s)
+}
+
+func autoConvert_notify_AuditRule_To_v1_AuditRule(in *notify.AuditRule, out *AuditRule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_AuditRuleSpec_To_v1_AuditRuleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_AuditRule_To_v1_AuditRule is an autogenerated conversion function.
+func Convert_notify_AuditRule_To_v1_AuditRule(in *notify.AuditRule, out *AuditRule, s conversion.Scope) error {
+	return autoConvert_notify_AuditRule_To_v1_AuditRule(in, out, s)
+}
+
+func autoConvert_v1_AuditRuleList_To_notify_AuditRuleList(in *AuditRuleList, out *notify.AuditRuleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.AuditRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_AuditRuleList_To_notify_AuditRuleList is an autogenerated conversion function.
+func Convert_v1_AuditRuleList_To_notify_AuditRuleList(in *AuditRuleList, out *notify.AuditRuleList, s conversion.Scope) error {
+	return autoConvert_v1_AuditRuleList_To_notify_AuditRuleList(in, out, s)
+}
+
+func autoConvert_notify_AuditRuleList_To_v1_AuditRuleList(in *notify.AuditRuleList, out *AuditRuleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AuditRule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_AuditRuleList_To_v1_AuditRuleList is an autogenerated conversion function.
+func Convert_notify_AuditRuleList_To_v1_AuditRuleList(in *notify.AuditRuleList, out *AuditRuleList, s conversion.Scope) error {
+	return autoConvert_notify_AuditRuleList_To_v1_AuditRuleList(in, out, s)
+}
+
+func autoConvert_v1_AuditRuleMatch_To_notify_AuditRuleMatch(in *AuditRuleMatch, out *notify.AuditRuleMatch, s conversion.Scope) error {
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Codes = *(*[]int32)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_v1_AuditRuleMatch_To_notify_AuditRuleMatch is an autogenerated conversion function.
+func Convert_v1_AuditRuleMatch_To_notify_AuditRuleMatch(in *AuditRuleMatch, out *notify.AuditRuleMatch, s conversion.Scope) error {
+	return autoConvert_v1_AuditRuleMatch_To_notify_AuditRuleMatch(in, out, s)
+}
+
+func autoConvert_notify_AuditRuleMatch_To_v1_AuditRuleMatch(in *notify.AuditRuleMatch, out *AuditRuleMatch, s conversion.Scope) error {
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Codes = *(*[]int32)(unsafe.Pointer(&in.Codes))
+	return nil
+}
+
+// Convert_notify_AuditRuleMatch_To_v1_AuditRuleMatch is an autogenerated conversion function.
+func Convert_notify_AuditRuleMatch_To_v1_AuditRuleMatch(in *notify.AuditRuleMatch, out *AuditRuleMatch, s conversion.Scope) error {
+	return autoConvert_notify_AuditRuleMatch_To_v1_AuditRuleMatch(in, out, s)
+}
+
+func autoConvert_v1_AuditRuleSpec_To_notify_AuditRuleSpec(in *AuditRuleSpec, out *notify.AuditRuleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Disabled = in.Disabled
+	if err := Convert_v1_AuditRuleMatch_To_notify_AuditRuleMatch(&in.Match, &out.Match, s); err != nil {
+		return err
+	}
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
 	return nil
 }
 
+// Convert_v1_AuditRuleSpec_To_notify_AuditRuleSpec is an autogenerated conversion function.
+func Convert_v1_AuditRuleSpec_To_notify_AuditRuleSpec(in *AuditRuleSpec, out *notify.AuditRuleSpec, s conversion.Scope) error {
+	return autoConvert_v1_AuditRuleSpec_To_notify_AuditRuleSpec(in, out, s)
+}
+
+func autoConvert_notify_AuditRuleSpec_To_v1_AuditRuleSpec(in *notify.AuditRuleSpec, out *AuditRuleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Disabled = in.Disabled
+	if err := Convert_notify_AuditRuleMatch_To_v1_AuditRuleMatch(&in.Match, &out.Match, s); err != nil {
+		return err
+	}
+	out.ChannelName = in.ChannelName
+	out.TemplateName = in.TemplateName
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_notify_AuditRuleSpec_To_v1_AuditRuleSpec is an autogenerated conversion function.
+func Convert_notify_AuditRuleSpec_To_v1_AuditRuleSpec(in *notify.AuditRuleSpec, out *AuditRuleSpec, s conversion.Scope) error {
+	return autoConvert_notify_AuditRuleSpec_To_v1_AuditRuleSpec(in, out, s)
+}
+
 func autoConvert_v1_Channel_To_notify_Channel(in *Channel, out *notify.Channel, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ChannelSpec_To_notify_ChannelSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -372,6 +858,50 @@ func Convert_notify_Channel_To_v1_Channel(in *notify.Channel, out *Channel, s co
 	return autoConvert_notify_Channel_To_v1_Channel(in, out, s)
 }
 
+func autoConvert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in *ChannelDingTalk, out *notify.ChannelDingTalk, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk is an autogenerated conversion function.
+func Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in *ChannelDingTalk, out *notify.ChannelDingTalk, s conversion.Scope) error {
+	return autoConvert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in, out, s)
+}
+
+func autoConvert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in *notify.ChannelDingTalk, out *ChannelDingTalk, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk is an autogenerated conversion function.
+func Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in *notify.ChannelDingTalk, out *ChannelDingTalk, s conversion.Scope) error {
+	return autoConvert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in, out, s)
+}
+
+func autoConvert_v1_ChannelLark_To_notify_ChannelLark(in *ChannelLark, out *notify.ChannelLark, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_v1_ChannelLark_To_notify_ChannelLark is an autogenerated conversion function.
+func Convert_v1_ChannelLark_To_notify_ChannelLark(in *ChannelLark, out *notify.ChannelLark, s conversion.Scope) error {
+	return autoConvert_v1_ChannelLark_To_notify_ChannelLark(in, out, s)
+}
+
+func autoConvert_notify_ChannelLark_To_v1_ChannelLark(in *notify.ChannelLark, out *ChannelLark, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_notify_ChannelLark_To_v1_ChannelLark is an autogenerated conversion function.
+func Convert_notify_ChannelLark_To_v1_ChannelLark(in *notify.ChannelLark, out *ChannelLark, s conversion.Scope) error {
+	return autoConvert_notify_ChannelLark_To_v1_ChannelLark(in, out, s)
+}
+
 func autoConvert_v1_ChannelList_To_notify_ChannelList(in *ChannelList, out *notify.ChannelList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]notify.Channel)(unsafe.Pointer(&in.Items))
@@ -422,6 +952,26 @@ func Convert_notify_ChannelSMTP_To_v1_ChannelSMTP(in *notify.ChannelSMTP, out *C
 	return autoConvert_notify_ChannelSMTP_To_v1_ChannelSMTP(in, out, s)
 }
 
+func autoConvert_v1_ChannelSlack_To_notify_ChannelSlack(in *ChannelSlack, out *notify.ChannelSlack, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
+
+// Convert_v1_ChannelSlack_To_notify_ChannelSlack is an autogenerated conversion function.
+func Convert_v1_ChannelSlack_To_notify_ChannelSlack(in *ChannelSlack, out *notify.ChannelSlack, s conversion.Scope) error {
+	return autoConvert_v1_ChannelSlack_To_notify_ChannelSlack(in, out, s)
+}
+
+func autoConvert_notify_ChannelSlack_To_v1_ChannelSlack(in *notify.ChannelSlack, out *ChannelSlack, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
+
+// Convert_notify_ChannelSlack_To_v1_ChannelSlack is an autogenerated conversion function.
+func Convert_notify_ChannelSlack_To_v1_ChannelSlack(in *notify.ChannelSlack, out *ChannelSlack, s conversion.Scope) error {
+	return autoConvert_notify_ChannelSlack_To_v1_ChannelSlack(in, out, s)
+}
+
 func autoConvert_v1_ChannelSpec_To_notify_ChannelSpec(in *ChannelSpec, out *notify.ChannelSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]notify.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
@@ -430,6 +980,10 @@ func autoConvert_v1_ChannelSpec_To_notify_ChannelSpec(in *ChannelSpec, out *noti
 	out.Wechat = (*notify.ChannelWechat)(unsafe.Pointer(in.Wechat))
 	out.SMTP = (*notify.ChannelSMTP)(unsafe.Pointer(in.SMTP))
 	out.Webhook = (*notify.ChannelWebhook)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*notify.ChannelSlack)(unsafe.Pointer(in.Slack))
+	out.DingTalk = (*notify.ChannelDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.WeCom = (*notify.ChannelWeCom)(unsafe.Pointer(in.WeCom))
+	out.Lark = (*notify.ChannelLark)(unsafe.Pointer(in.Lark))
 	return nil
 }
 
@@ -446,6 +1000,10 @@ func autoConvert_notify_ChannelSpec_To_v1_ChannelSpec(in *notify.ChannelSpec, ou
 	out.Wechat = (*ChannelWechat)(unsafe.Pointer(in.Wechat))
 	out.SMTP = (*ChannelSMTP)(unsafe.Pointer(in.SMTP))
 	out.Webhook = (*ChannelWebhook)(unsafe.Pointer(in.Webhook))
+	out.Slack = (*ChannelSlack)(unsafe.Pointer(in.Slack))
+	out.DingTalk = (*ChannelDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.WeCom = (*ChannelWeCom)(unsafe.Pointer(in.WeCom))
+	out.Lark = (*ChannelLark)(unsafe.Pointer(in.Lark))
 	return nil
 }
 
@@ -498,9 +1056,35 @@ func Convert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in *noti
 	return autoConvert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in, out, s)
 }
 
+func autoConvert_v1_ChannelWeCom_To_notify_ChannelWeCom(in *ChannelWeCom, out *notify.ChannelWeCom, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
+
+// Convert_v1_ChannelWeCom_To_notify_ChannelWeCom is an autogenerated conversion function.
+func Convert_v1_ChannelWeCom_To_notify_ChannelWeCom(in *ChannelWeCom, out *notify.ChannelWeCom, s conversion.Scope) error {
+	return autoConvert_v1_ChannelWeCom_To_notify_ChannelWeCom(in, out, s)
+}
+
+func autoConvert_notify_ChannelWeCom_To_v1_ChannelWeCom(in *notify.ChannelWeCom, out *ChannelWeCom, s conversion.Scope) error {
+	out.WebhookURL = in.WebhookURL
+	return nil
+}
+
+// Convert_notify_ChannelWeCom_To_v1_ChannelWeCom is an autogenerated conversion function.
+func Convert_notify_ChannelWeCom_To_v1_ChannelWeCom(in *notify.ChannelWeCom, out *ChannelWeCom, s conversion.Scope) error {
+	return autoConvert_notify_ChannelWeCom_To_v1_ChannelWeCom(in, out, s)
+}
+
 func autoConvert_v1_ChannelWebhook_To_notify_ChannelWebhook(in *ChannelWebhook, out *notify.ChannelWebhook, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	out.Method = in.Method
+	out.BodyTemplate = in.BodyTemplate
+	out.SigningSecretRef = (*notify.SecretKeySelector)(unsafe.Pointer(in.SigningSecretRef))
+	out.ClientCertificateSecretRef = (*notify.SecretReference)(unsafe.Pointer(in.ClientCertificateSecretRef))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.InsecureSkipVerify = in.InsecureSkipVerify
 	return nil
 }
 
@@ -512,6 +1096,12 @@ func Convert_v1_ChannelWebhook_To_notify_ChannelWebhook(in *ChannelWebhook, out
 func autoConvert_notify_ChannelWebhook_To_v1_ChannelWebhook(in *notify.ChannelWebhook, out *ChannelWebhook, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	out.Method = in.Method
+	out.BodyTemplate = in.BodyTemplate
+	out.SigningSecretRef = (*SecretKeySelector)(unsafe.Pointer(in.SigningSecretRef))
+	out.ClientCertificateSecretRef = (*SecretReference)(unsafe.Pointer(in.ClientCertificateSecretRef))
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.InsecureSkipVerify = in.InsecureSkipVerify
 	return nil
 }
 
@@ -588,6 +1178,122 @@ func Convert_notify_ConfigMapList_To_v1_ConfigMapList(in *notify.ConfigMapList,
 	return autoConvert_notify_ConfigMapList_To_v1_ConfigMapList(in, out, s)
 }
 
+func autoConvert_v1_EscalationPolicy_To_notify_EscalationPolicy(in *EscalationPolicy, out *notify.EscalationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_EscalationPolicy_To_notify_EscalationPolicy is an autogenerated conversion function.
+func Convert_v1_EscalationPolicy_To_notify_EscalationPolicy(in *EscalationPolicy, out *notify.EscalationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicy_To_notify_EscalationPolicy(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicy_To_v1_EscalationPolicy(in *notify.EscalationPolicy, out *EscalationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_EscalationPolicy_To_v1_EscalationPolicy is an autogenerated conversion function.
+func Convert_notify_EscalationPolicy_To_v1_EscalationPolicy(in *notify.EscalationPolicy, out *EscalationPolicy, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicy_To_v1_EscalationPolicy(in, out, s)
+}
+
+func autoConvert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in *EscalationPolicyList, out *notify.EscalationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.EscalationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList is an autogenerated conversion function.
+func Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in *EscalationPolicyList, out *notify.EscalationPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in *notify.EscalationPolicyList, out *EscalationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]EscalationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList is an autogenerated conversion function.
+func Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in *notify.EscalationPolicyList, out *EscalationPolicyList, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in, out, s)
+}
+
+func autoConvert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in *EscalationPolicySpec, out *notify.EscalationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Rules = *(*[]notify.EscalationRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec is an autogenerated conversion function.
+func Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in *EscalationPolicySpec, out *notify.EscalationPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in *notify.EscalationPolicySpec, out *EscalationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Rules = *(*[]EscalationRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec is an autogenerated conversion function.
+func Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in *notify.EscalationPolicySpec, out *EscalationPolicySpec, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_EscalationRule_To_notify_EscalationRule(in *EscalationRule, out *notify.EscalationRule, s conversion.Scope) error {
+	out.DelayMinutes = in.DelayMinutes
+	out.Targets = *(*[]notify.EscalationTarget)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_v1_EscalationRule_To_notify_EscalationRule is an autogenerated conversion function.
+func Convert_v1_EscalationRule_To_notify_EscalationRule(in *EscalationRule, out *notify.EscalationRule, s conversion.Scope) error {
+	return autoConvert_v1_EscalationRule_To_notify_EscalationRule(in, out, s)
+}
+
+func autoConvert_notify_EscalationRule_To_v1_EscalationRule(in *notify.EscalationRule, out *EscalationRule, s conversion.Scope) error {
+	out.DelayMinutes = in.DelayMinutes
+	out.Targets = *(*[]EscalationTarget)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_notify_EscalationRule_To_v1_EscalationRule is an autogenerated conversion function.
+func Convert_notify_EscalationRule_To_v1_EscalationRule(in *notify.EscalationRule, out *EscalationRule, s conversion.Scope) error {
+	return autoConvert_notify_EscalationRule_To_v1_EscalationRule(in, out, s)
+}
+
+func autoConvert_v1_EscalationTarget_To_notify_EscalationTarget(in *EscalationTarget, out *notify.EscalationTarget, s conversion.Scope) error {
+	out.Kind = notify.EscalationTargetKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_EscalationTarget_To_notify_EscalationTarget is an autogenerated conversion function.
+func Convert_v1_EscalationTarget_To_notify_EscalationTarget(in *EscalationTarget, out *notify.EscalationTarget, s conversion.Scope) error {
+	return autoConvert_v1_EscalationTarget_To_notify_EscalationTarget(in, out, s)
+}
+
+func autoConvert_notify_EscalationTarget_To_v1_EscalationTarget(in *notify.EscalationTarget, out *EscalationTarget, s conversion.Scope) error {
+	out.Kind = EscalationTargetKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_notify_EscalationTarget_To_v1_EscalationTarget is an autogenerated conversion function.
+func Convert_notify_EscalationTarget_To_v1_EscalationTarget(in *notify.EscalationTarget, out *EscalationTarget, s conversion.Scope) error {
+	return autoConvert_notify_EscalationTarget_To_v1_EscalationTarget(in, out, s)
+}
+
 func autoConvert_v1_Message_To_notify_Message(in *Message, out *notify.Message, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_MessageSpec_To_notify_MessageSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -674,6 +1380,56 @@ func Convert_notify_MessageRequest_To_v1_MessageRequest(in *notify.MessageReques
 	return autoConvert_notify_MessageRequest_To_v1_MessageRequest(in, out, s)
 }
 
+func autoConvert_v1_MessageRequestDeferralStatus_To_notify_MessageRequestDeferralStatus(in *MessageRequestDeferralStatus, out *notify.MessageRequestDeferralStatus, s conversion.Scope) error {
+	out.DeferredTime = in.DeferredTime
+	out.DeliverAfter = in.DeliverAfter
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_MessageRequestDeferralStatus_To_notify_MessageRequestDeferralStatus is an autogenerated conversion function.
+func Convert_v1_MessageRequestDeferralStatus_To_notify_MessageRequestDeferralStatus(in *MessageRequestDeferralStatus, out *notify.MessageRequestDeferralStatus, s conversion.Scope) error {
+	return autoConvert_v1_MessageRequestDeferralStatus_To_notify_MessageRequestDeferralStatus(in, out, s)
+}
+
+func autoConvert_notify_MessageRequestDeferralStatus_To_v1_MessageRequestDeferralStatus(in *notify.MessageRequestDeferralStatus, out *MessageRequestDeferralStatus, s conversion.Scope) error {
+	out.DeferredTime = in.DeferredTime
+	out.DeliverAfter = in.DeliverAfter
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_notify_MessageRequestDeferralStatus_To_v1_MessageRequestDeferralStatus is an autogenerated conversion function.
+func Convert_notify_MessageRequestDeferralStatus_To_v1_MessageRequestDeferralStatus(in *notify.MessageRequestDeferralStatus, out *MessageRequestDeferralStatus, s conversion.Scope) error {
+	return autoConvert_notify_MessageRequestDeferralStatus_To_v1_MessageRequestDeferralStatus(in, out, s)
+}
+
+func autoConvert_v1_MessageRequestEscalationStatus_To_notify_MessageRequestEscalationStatus(in *MessageRequestEscalationStatus, out *notify.MessageRequestEscalationStatus, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Level = in.Level
+	out.LastEscalationTime = in.LastEscalationTime
+	out.NextEscalationTime = in.NextEscalationTime
+	return nil
+}
+
+// Convert_v1_MessageRequestEscalationStatus_To_notify_MessageRequestEscalationStatus is an autogenerated conversion function.
+func Convert_v1_MessageRequestEscalationStatus_To_notify_MessageRequestEscalationStatus(in *MessageRequestEscalationStatus, out *notify.MessageRequestEscalationStatus, s conversion.Scope) error {
+	return autoConvert_v1_MessageRequestEscalationStatus_To_notify_MessageRequestEscalationStatus(in, out, s)
+}
+
+func autoConvert_notify_MessageRequestEscalationStatus_To_v1_MessageRequestEscalationStatus(in *notify.MessageRequestEscalationStatus, out *MessageRequestEscalationStatus, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Level = in.Level
+	out.LastEscalationTime = in.LastEscalationTime
+	out.NextEscalationTime = in.NextEscalationTime
+	return nil
+}
+
+// Convert_notify_MessageRequestEscalationStatus_To_v1_MessageRequestEscalationStatus is an autogenerated conversion function.
+func Convert_notify_MessageRequestEscalationStatus_To_v1_MessageRequestEscalationStatus(in *notify.MessageRequestEscalationStatus, out *MessageRequestEscalationStatus, s conversion.Scope) error {
+	return autoConvert_notify_MessageRequestEscalationStatus_To_v1_MessageRequestEscalationStatus(in, out, s)
+}
+
 func autoConvert_v1_MessageRequestList_To_notify_MessageRequestList(in *MessageRequestList, out *notify.MessageRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]notify.MessageRequest)(unsafe.Pointer(&in.Items))
@@ -685,15 +1441,41 @@ func Convert_v1_MessageRequestList_To_notify_MessageRequestList(in *MessageReque
 	return autoConvert_v1_MessageRequestList_To_notify_MessageRequestList(in, out, s)
 }
 
-func autoConvert_notify_MessageRequestList_To_v1_MessageRequestList(in *notify.MessageRequestList, out *MessageRequestList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]MessageRequest)(unsafe.Pointer(&in.Items))
+func autoConvert_notify_MessageRequestList_To_v1_MessageRequestList(in *notify.MessageRequestList, out *MessageRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]MessageRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_MessageRequestList_To_v1_MessageRequestList is an autogenerated conversion function.
+func Convert_notify_MessageRequestList_To_v1_MessageRequestList(in *notify.MessageRequestList, out *MessageRequestList, s conversion.Scope) error {
+	return autoConvert_notify_MessageRequestList_To_v1_MessageRequestList(in, out, s)
+}
+
+func autoConvert_v1_MessageRequestRetryStatus_To_notify_MessageRequestRetryStatus(in *MessageRequestRetryStatus, out *notify.MessageRequestRetryStatus, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = in.LastAttemptTime
+	out.NextAttemptTime = in.NextAttemptTime
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_v1_MessageRequestRetryStatus_To_notify_MessageRequestRetryStatus is an autogenerated conversion function.
+func Convert_v1_MessageRequestRetryStatus_To_notify_MessageRequestRetryStatus(in *MessageRequestRetryStatus, out *notify.MessageRequestRetryStatus, s conversion.Scope) error {
+	return autoConvert_v1_MessageRequestRetryStatus_To_notify_MessageRequestRetryStatus(in, out, s)
+}
+
+func autoConvert_notify_MessageRequestRetryStatus_To_v1_MessageRequestRetryStatus(in *notify.MessageRequestRetryStatus, out *MessageRequestRetryStatus, s conversion.Scope) error {
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = in.LastAttemptTime
+	out.NextAttemptTime = in.NextAttemptTime
+	out.LastError = in.LastError
 	return nil
 }
 
-// Convert_notify_MessageRequestList_To_v1_MessageRequestList is an autogenerated conversion function.
-func Convert_notify_MessageRequestList_To_v1_MessageRequestList(in *notify.MessageRequestList, out *MessageRequestList, s conversion.Scope) error {
-	return autoConvert_notify_MessageRequestList_To_v1_MessageRequestList(in, out, s)
+// Convert_notify_MessageRequestRetryStatus_To_v1_MessageRequestRetryStatus is an autogenerated conversion function.
+func Convert_notify_MessageRequestRetryStatus_To_v1_MessageRequestRetryStatus(in *notify.MessageRequestRetryStatus, out *MessageRequestRetryStatus, s conversion.Scope) error {
+	return autoConvert_notify_MessageRequestRetryStatus_To_v1_MessageRequestRetryStatus(in, out, s)
 }
 
 func autoConvert_v1_MessageRequestSpec_To_notify_MessageRequestSpec(in *MessageRequestSpec, out *notify.MessageRequestSpec, s conversion.Scope) error {
@@ -729,6 +1511,9 @@ func autoConvert_v1_MessageRequestStatus_To_notify_MessageRequestStatus(in *Mess
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Errors = *(*map[string]string)(unsafe.Pointer(&in.Errors))
 	out.AlertStatus = in.AlertStatus
+	out.Retries = *(*map[string]notify.MessageRequestRetryStatus)(unsafe.Pointer(&in.Retries))
+	out.Escalations = *(*map[string]notify.MessageRequestEscalationStatus)(unsafe.Pointer(&in.Escalations))
+	out.Deferrals = *(*map[string]notify.MessageRequestDeferralStatus)(unsafe.Pointer(&in.Deferrals))
 	return nil
 }
 
@@ -742,6 +1527,9 @@ func autoConvert_notify_MessageRequestStatus_To_v1_MessageRequestStatus(in *noti
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Errors = *(*map[string]string)(unsafe.Pointer(&in.Errors))
 	out.AlertStatus = in.AlertStatus
+	out.Retries = *(*map[string]MessageRequestRetryStatus)(unsafe.Pointer(&in.Retries))
+	out.Escalations = *(*map[string]MessageRequestEscalationStatus)(unsafe.Pointer(&in.Escalations))
+	out.Deferrals = *(*map[string]MessageRequestDeferralStatus)(unsafe.Pointer(&in.Deferrals))
 	return nil
 }
 
@@ -763,6 +1551,8 @@ func autoConvert_v1_MessageSpec_To_notify_MessageSpec(in *MessageSpec, out *noti
 	out.AlarmPolicyType = in.AlarmPolicyType
 	out.ReceiverChannelName = in.ReceiverChannelName
 	out.ClusterID = in.ClusterID
+	out.WebhookRequest = (*notify.WebhookRequest)(unsafe.Pointer(in.WebhookRequest))
+	out.MessageRequestName = in.MessageRequestName
 	return nil
 }
 
@@ -784,6 +1574,8 @@ func autoConvert_notify_MessageSpec_To_v1_MessageSpec(in *notify.MessageSpec, ou
 	out.AlarmPolicyType = in.AlarmPolicyType
 	out.ReceiverChannelName = in.ReceiverChannelName
 	out.ClusterID = in.ClusterID
+	out.WebhookRequest = (*WebhookRequest)(unsafe.Pointer(in.WebhookRequest))
+	out.MessageRequestName = in.MessageRequestName
 	return nil
 }
 
@@ -796,6 +1588,12 @@ func autoConvert_v1_MessageStatus_To_notify_MessageStatus(in *MessageStatus, out
 	out.Phase = notify.MessagePhase(in.Phase)
 	out.LastTransitionTime = in.LastTransitionTime
 	out.AlertStatus = in.AlertStatus
+	out.AcknowledgedTime = in.AcknowledgedTime
+	out.AcknowledgedBy = in.AcknowledgedBy
+	out.DeliveryState = notify.MessageDeliveryState(in.DeliveryState)
+	out.DeliveryReason = in.DeliveryReason
+	out.DeferredTime = in.DeferredTime
+	out.DigestMessageName = in.DigestMessageName
 	return nil
 }
 
@@ -808,6 +1606,12 @@ func autoConvert_notify_MessageStatus_To_v1_MessageStatus(in *notify.MessageStat
 	out.Phase = MessagePhase(in.Phase)
 	out.LastTransitionTime = in.LastTransitionTime
 	out.AlertStatus = in.AlertStatus
+	out.AcknowledgedTime = in.AcknowledgedTime
+	out.AcknowledgedBy = in.AcknowledgedBy
+	out.DeliveryState = MessageDeliveryState(in.DeliveryState)
+	out.DeliveryReason = in.DeliveryReason
+	out.DeferredTime = in.DeferredTime
+	out.DigestMessageName = in.DigestMessageName
 	return nil
 }
 
@@ -816,11 +1620,166 @@ func Convert_notify_MessageStatus_To_v1_MessageStatus(in *notify.MessageStatus,
 	return autoConvert_notify_MessageStatus_To_v1_MessageStatus(in, out, s)
 }
 
+func autoConvert_v1_OnCallLayer_To_notify_OnCallLayer(in *OnCallLayer, out *notify.OnCallLayer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.Start = in.Start
+	out.RotationType = notify.OnCallRotationType(in.RotationType)
+	out.ShiftLength = in.ShiftLength
+	out.Restrictions = *(*[]notify.OnCallRestriction)(unsafe.Pointer(&in.Restrictions))
+	return nil
+}
+
+// Convert_v1_OnCallLayer_To_notify_OnCallLayer is an autogenerated conversion function.
+func Convert_v1_OnCallLayer_To_notify_OnCallLayer(in *OnCallLayer, out *notify.OnCallLayer, s conversion.Scope) error {
+	return autoConvert_v1_OnCallLayer_To_notify_OnCallLayer(in, out, s)
+}
+
+func autoConvert_notify_OnCallLayer_To_v1_OnCallLayer(in *notify.OnCallLayer, out *OnCallLayer, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.Start = in.Start
+	out.RotationType = OnCallRotationType(in.RotationType)
+	out.ShiftLength = in.ShiftLength
+	out.Restrictions = *(*[]OnCallRestriction)(unsafe.Pointer(&in.Restrictions))
+	return nil
+}
+
+// Convert_notify_OnCallLayer_To_v1_OnCallLayer is an autogenerated conversion function.
+func Convert_notify_OnCallLayer_To_v1_OnCallLayer(in *notify.OnCallLayer, out *OnCallLayer, s conversion.Scope) error {
+	return autoConvert_notify_OnCallLayer_To_v1_OnCallLayer(in, out, s)
+}
+
+func autoConvert_v1_OnCallOverride_To_notify_OnCallOverride(in *OnCallOverride, out *notify.OnCallOverride, s conversion.Scope) error {
+	out.Receiver = in.Receiver
+	out.StartsAt = in.StartsAt
+	out.EndsAt = in.EndsAt
+	return nil
+}
+
+// Convert_v1_OnCallOverride_To_notify_OnCallOverride is an autogenerated conversion function.
+func Convert_v1_OnCallOverride_To_notify_OnCallOverride(in *OnCallOverride, out *notify.OnCallOverride, s conversion.Scope) error {
+	return autoConvert_v1_OnCallOverride_To_notify_OnCallOverride(in, out, s)
+}
+
+func autoConvert_notify_OnCallOverride_To_v1_OnCallOverride(in *notify.OnCallOverride, out *OnCallOverride, s conversion.Scope) error {
+	out.Receiver = in.Receiver
+	out.StartsAt = in.StartsAt
+	out.EndsAt = in.EndsAt
+	return nil
+}
+
+// Convert_notify_OnCallOverride_To_v1_OnCallOverride is an autogenerated conversion function.
+func Convert_notify_OnCallOverride_To_v1_OnCallOverride(in *notify.OnCallOverride, out *OnCallOverride, s conversion.Scope) error {
+	return autoConvert_notify_OnCallOverride_To_v1_OnCallOverride(in, out, s)
+}
+
+func autoConvert_v1_OnCallRestriction_To_notify_OnCallRestriction(in *OnCallRestriction, out *notify.OnCallRestriction, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	return nil
+}
+
+// Convert_v1_OnCallRestriction_To_notify_OnCallRestriction is an autogenerated conversion function.
+func Convert_v1_OnCallRestriction_To_notify_OnCallRestriction(in *OnCallRestriction, out *notify.OnCallRestriction, s conversion.Scope) error {
+	return autoConvert_v1_OnCallRestriction_To_notify_OnCallRestriction(in, out, s)
+}
+
+func autoConvert_notify_OnCallRestriction_To_v1_OnCallRestriction(in *notify.OnCallRestriction, out *OnCallRestriction, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	return nil
+}
+
+// Convert_notify_OnCallRestriction_To_v1_OnCallRestriction is an autogenerated conversion function.
+func Convert_notify_OnCallRestriction_To_v1_OnCallRestriction(in *notify.OnCallRestriction, out *OnCallRestriction, s conversion.Scope) error {
+	return autoConvert_notify_OnCallRestriction_To_v1_OnCallRestriction(in, out, s)
+}
+
+func autoConvert_v1_OnCallSchedule_To_notify_OnCallSchedule(in *OnCallSchedule, out *notify.OnCallSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_OnCallSchedule_To_notify_OnCallSchedule is an autogenerated conversion function.
+func Convert_v1_OnCallSchedule_To_notify_OnCallSchedule(in *OnCallSchedule, out *notify.OnCallSchedule, s conversion.Scope) error {
+	return autoConvert_v1_OnCallSchedule_To_notify_OnCallSchedule(in, out, s)
+}
+
+func autoConvert_notify_OnCallSchedule_To_v1_OnCallSchedule(in *notify.OnCallSchedule, out *OnCallSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_OnCallSchedule_To_v1_OnCallSchedule is an autogenerated conversion function.
+func Convert_notify_OnCallSchedule_To_v1_OnCallSchedule(in *notify.OnCallSchedule, out *OnCallSchedule, s conversion.Scope) error {
+	return autoConvert_notify_OnCallSchedule_To_v1_OnCallSchedule(in, out, s)
+}
+
+func autoConvert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in *OnCallScheduleList, out *notify.OnCallScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.OnCallSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList is an autogenerated conversion function.
+func Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in *OnCallScheduleList, out *notify.OnCallScheduleList, s conversion.Scope) error {
+	return autoConvert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in, out, s)
+}
+
+func autoConvert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in *notify.OnCallScheduleList, out *OnCallScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]OnCallSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList is an autogenerated conversion function.
+func Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in *notify.OnCallScheduleList, out *OnCallScheduleList, s conversion.Scope) error {
+	return autoConvert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in, out, s)
+}
+
+func autoConvert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in *OnCallScheduleSpec, out *notify.OnCallScheduleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.TimeZone = in.TimeZone
+	out.Layers = *(*[]notify.OnCallLayer)(unsafe.Pointer(&in.Layers))
+	out.Overrides = *(*[]notify.OnCallOverride)(unsafe.Pointer(&in.Overrides))
+	return nil
+}
+
+// Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec is an autogenerated conversion function.
+func Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in *OnCallScheduleSpec, out *notify.OnCallScheduleSpec, s conversion.Scope) error {
+	return autoConvert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in, out, s)
+}
+
+func autoConvert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in *notify.OnCallScheduleSpec, out *OnCallScheduleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.TimeZone = in.TimeZone
+	out.Layers = *(*[]OnCallLayer)(unsafe.Pointer(&in.Layers))
+	out.Overrides = *(*[]OnCallOverride)(unsafe.Pointer(&in.Overrides))
+	return nil
+}
+
+// Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec is an autogenerated conversion function.
+func Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in *notify.OnCallScheduleSpec, out *OnCallScheduleSpec, s conversion.Scope) error {
+	return autoConvert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in, out, s)
+}
+
 func autoConvert_v1_Receiver_To_notify_Receiver(in *Receiver, out *notify.Receiver, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ReceiverSpec_To_notify_ReceiverSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1_ReceiverStatus_To_notify_ReceiverStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -834,6 +1793,9 @@ func autoConvert_notify_Receiver_To_v1_Receiver(in *notify.Receiver, out *Receiv
 	if err := Convert_notify_ReceiverSpec_To_v1_ReceiverSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_notify_ReceiverStatus_To_v1_ReceiverStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -842,6 +1804,54 @@ func Convert_notify_Receiver_To_v1_Receiver(in *notify.Receiver, out *Receiver,
 	return autoConvert_notify_Receiver_To_v1_Receiver(in, out, s)
 }
 
+func autoConvert_v1_ReceiverDelivery_To_notify_ReceiverDelivery(in *ReceiverDelivery, out *notify.ReceiverDelivery, s conversion.Scope) error {
+	out.Channel = notify.ReceiverChannel(in.Channel)
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_v1_ReceiverDelivery_To_notify_ReceiverDelivery is an autogenerated conversion function.
+func Convert_v1_ReceiverDelivery_To_notify_ReceiverDelivery(in *ReceiverDelivery, out *notify.ReceiverDelivery, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverDelivery_To_notify_ReceiverDelivery(in, out, s)
+}
+
+func autoConvert_notify_ReceiverDelivery_To_v1_ReceiverDelivery(in *notify.ReceiverDelivery, out *ReceiverDelivery, s conversion.Scope) error {
+	out.Channel = ReceiverChannel(in.Channel)
+	out.Time = in.Time
+	return nil
+}
+
+// Convert_notify_ReceiverDelivery_To_v1_ReceiverDelivery is an autogenerated conversion function.
+func Convert_notify_ReceiverDelivery_To_v1_ReceiverDelivery(in *notify.ReceiverDelivery, out *ReceiverDelivery, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverDelivery_To_v1_ReceiverDelivery(in, out, s)
+}
+
+func autoConvert_v1_ReceiverDigest_To_notify_ReceiverDigest(in *ReceiverDigest, out *notify.ReceiverDigest, s conversion.Scope) error {
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.IntervalMinutes = in.IntervalMinutes
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1_ReceiverDigest_To_notify_ReceiverDigest is an autogenerated conversion function.
+func Convert_v1_ReceiverDigest_To_notify_ReceiverDigest(in *ReceiverDigest, out *notify.ReceiverDigest, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverDigest_To_notify_ReceiverDigest(in, out, s)
+}
+
+func autoConvert_notify_ReceiverDigest_To_v1_ReceiverDigest(in *notify.ReceiverDigest, out *ReceiverDigest, s conversion.Scope) error {
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.IntervalMinutes = in.IntervalMinutes
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_notify_ReceiverDigest_To_v1_ReceiverDigest is an autogenerated conversion function.
+func Convert_notify_ReceiverDigest_To_v1_ReceiverDigest(in *notify.ReceiverDigest, out *ReceiverDigest, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverDigest_To_v1_ReceiverDigest(in, out, s)
+}
+
 func autoConvert_v1_ReceiverGroup_To_notify_ReceiverGroup(in *ReceiverGroup, out *notify.ReceiverGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ReceiverGroupSpec_To_notify_ReceiverGroupSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -894,6 +1904,7 @@ func autoConvert_v1_ReceiverGroupSpec_To_notify_ReceiverGroupSpec(in *ReceiverGr
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.EscalationPolicy = in.EscalationPolicy
 	return nil
 }
 
@@ -906,6 +1917,7 @@ func autoConvert_notify_ReceiverGroupSpec_To_v1_ReceiverGroupSpec(in *notify.Rec
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.EscalationPolicy = in.EscalationPolicy
 	return nil
 }
 
@@ -936,11 +1948,86 @@ func Convert_notify_ReceiverList_To_v1_ReceiverList(in *notify.ReceiverList, out
 	return autoConvert_notify_ReceiverList_To_v1_ReceiverList(in, out, s)
 }
 
+func autoConvert_v1_ReceiverPreferences_To_notify_ReceiverPreferences(in *ReceiverPreferences, out *notify.ReceiverPreferences, s conversion.Scope) error {
+	out.TimeZone = in.TimeZone
+	out.QuietHours = *(*[]notify.ReceiverQuietHours)(unsafe.Pointer(&in.QuietHours))
+	out.RateLimits = *(*[]notify.ReceiverRateLimit)(unsafe.Pointer(&in.RateLimits))
+	out.Digest = (*notify.ReceiverDigest)(unsafe.Pointer(in.Digest))
+	return nil
+}
+
+// Convert_v1_ReceiverPreferences_To_notify_ReceiverPreferences is an autogenerated conversion function.
+func Convert_v1_ReceiverPreferences_To_notify_ReceiverPreferences(in *ReceiverPreferences, out *notify.ReceiverPreferences, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverPreferences_To_notify_ReceiverPreferences(in, out, s)
+}
+
+func autoConvert_notify_ReceiverPreferences_To_v1_ReceiverPreferences(in *notify.ReceiverPreferences, out *ReceiverPreferences, s conversion.Scope) error {
+	out.TimeZone = in.TimeZone
+	out.QuietHours = *(*[]ReceiverQuietHours)(unsafe.Pointer(&in.QuietHours))
+	out.RateLimits = *(*[]ReceiverRateLimit)(unsafe.Pointer(&in.RateLimits))
+	out.Digest = (*ReceiverDigest)(unsafe.Pointer(in.Digest))
+	return nil
+}
+
+// Convert_notify_ReceiverPreferences_To_v1_ReceiverPreferences is an autogenerated conversion function.
+func Convert_notify_ReceiverPreferences_To_v1_ReceiverPreferences(in *notify.ReceiverPreferences, out *ReceiverPreferences, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverPreferences_To_v1_ReceiverPreferences(in, out, s)
+}
+
+func autoConvert_v1_ReceiverQuietHours_To_notify_ReceiverQuietHours(in *ReceiverQuietHours, out *notify.ReceiverQuietHours, s conversion.Scope) error {
+	out.Channels = *(*[]notify.ReceiverChannel)(unsafe.Pointer(&in.Channels))
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	return nil
+}
+
+// Convert_v1_ReceiverQuietHours_To_notify_ReceiverQuietHours is an autogenerated conversion function.
+func Convert_v1_ReceiverQuietHours_To_notify_ReceiverQuietHours(in *ReceiverQuietHours, out *notify.ReceiverQuietHours, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverQuietHours_To_notify_ReceiverQuietHours(in, out, s)
+}
+
+func autoConvert_notify_ReceiverQuietHours_To_v1_ReceiverQuietHours(in *notify.ReceiverQuietHours, out *ReceiverQuietHours, s conversion.Scope) error {
+	out.Channels = *(*[]ReceiverChannel)(unsafe.Pointer(&in.Channels))
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	return nil
+}
+
+// Convert_notify_ReceiverQuietHours_To_v1_ReceiverQuietHours is an autogenerated conversion function.
+func Convert_notify_ReceiverQuietHours_To_v1_ReceiverQuietHours(in *notify.ReceiverQuietHours, out *ReceiverQuietHours, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverQuietHours_To_v1_ReceiverQuietHours(in, out, s)
+}
+
+func autoConvert_v1_ReceiverRateLimit_To_notify_ReceiverRateLimit(in *ReceiverRateLimit, out *notify.ReceiverRateLimit, s conversion.Scope) error {
+	out.Channel = notify.ReceiverChannel(in.Channel)
+	out.Limit = in.Limit
+	out.PeriodMinutes = in.PeriodMinutes
+	return nil
+}
+
+// Convert_v1_ReceiverRateLimit_To_notify_ReceiverRateLimit is an autogenerated conversion function.
+func Convert_v1_ReceiverRateLimit_To_notify_ReceiverRateLimit(in *ReceiverRateLimit, out *notify.ReceiverRateLimit, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverRateLimit_To_notify_ReceiverRateLimit(in, out, s)
+}
+
+func autoConvert_notify_ReceiverRateLimit_To_v1_ReceiverRateLimit(in *notify.ReceiverRateLimit, out *ReceiverRateLimit, s conversion.Scope) error {
+	out.Channel = ReceiverChannel(in.Channel)
+	out.Limit = in.Limit
+	out.PeriodMinutes = in.PeriodMinutes
+	return nil
+}
+
+// Convert_notify_ReceiverRateLimit_To_v1_ReceiverRateLimit is an autogenerated conversion function.
+func Convert_notify_ReceiverRateLimit_To_v1_ReceiverRateLimit(in *notify.ReceiverRateLimit, out *ReceiverRateLimit, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverRateLimit_To_v1_ReceiverRateLimit(in, out, s)
+}
+
 func autoConvert_v1_ReceiverSpec_To_notify_ReceiverSpec(in *ReceiverSpec, out *notify.ReceiverSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Username = in.Username
 	out.Identities = *(*map[notify.ReceiverChannel]string)(unsafe.Pointer(&in.Identities))
+	out.Preferences = (*notify.ReceiverPreferences)(unsafe.Pointer(in.Preferences))
 	return nil
 }
 
@@ -954,6 +2041,7 @@ func autoConvert_notify_ReceiverSpec_To_v1_ReceiverSpec(in *notify.ReceiverSpec,
 	out.DisplayName = in.DisplayName
 	out.Username = in.Username
 	out.Identities = *(*map[ReceiverChannel]string)(unsafe.Pointer(&in.Identities))
+	out.Preferences = (*ReceiverPreferences)(unsafe.Pointer(in.Preferences))
 	return nil
 }
 
@@ -962,6 +2050,202 @@ func Convert_notify_ReceiverSpec_To_v1_ReceiverSpec(in *notify.ReceiverSpec, out
 	return autoConvert_notify_ReceiverSpec_To_v1_ReceiverSpec(in, out, s)
 }
 
+func autoConvert_v1_ReceiverStatus_To_notify_ReceiverStatus(in *ReceiverStatus, out *notify.ReceiverStatus, s conversion.Scope) error {
+	out.Deliveries = *(*[]notify.ReceiverDelivery)(unsafe.Pointer(&in.Deliveries))
+	return nil
+}
+
+// Convert_v1_ReceiverStatus_To_notify_ReceiverStatus is an autogenerated conversion function.
+func Convert_v1_ReceiverStatus_To_notify_ReceiverStatus(in *ReceiverStatus, out *notify.ReceiverStatus, s conversion.Scope) error {
+	return autoConvert_v1_ReceiverStatus_To_notify_ReceiverStatus(in, out, s)
+}
+
+func autoConvert_notify_ReceiverStatus_To_v1_ReceiverStatus(in *notify.ReceiverStatus, out *ReceiverStatus, s conversion.Scope) error {
+	out.Deliveries = *(*[]ReceiverDelivery)(unsafe.Pointer(&in.Deliveries))
+	return nil
+}
+
+// Convert_notify_ReceiverStatus_To_v1_ReceiverStatus is an autogenerated conversion function.
+func Convert_notify_ReceiverStatus_To_v1_ReceiverStatus(in *notify.ReceiverStatus, out *ReceiverStatus, s conversion.Scope) error {
+	return autoConvert_notify_ReceiverStatus_To_v1_ReceiverStatus(in, out, s)
+}
+
+func autoConvert_v1_SecretKeySelector_To_notify_SecretKeySelector(in *SecretKeySelector, out *notify.SecretKeySelector, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_SecretKeySelector_To_notify_SecretKeySelector is an autogenerated conversion function.
+func Convert_v1_SecretKeySelector_To_notify_SecretKeySelector(in *SecretKeySelector, out *notify.SecretKeySelector, s conversion.Scope) error {
+	return autoConvert_v1_SecretKeySelector_To_notify_SecretKeySelector(in, out, s)
+}
+
+func autoConvert_notify_SecretKeySelector_To_v1_SecretKeySelector(in *notify.SecretKeySelector, out *SecretKeySelector, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_notify_SecretKeySelector_To_v1_SecretKeySelector is an autogenerated conversion function.
+func Convert_notify_SecretKeySelector_To_v1_SecretKeySelector(in *notify.SecretKeySelector, out *SecretKeySelector, s conversion.Scope) error {
+	return autoConvert_notify_SecretKeySelector_To_v1_SecretKeySelector(in, out, s)
+}
+
+func autoConvert_v1_SecretReference_To_notify_SecretReference(in *SecretReference, out *notify.SecretReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_SecretReference_To_notify_SecretReference is an autogenerated conversion function.
+func Convert_v1_SecretReference_To_notify_SecretReference(in *SecretReference, out *notify.SecretReference, s conversion.Scope) error {
+	return autoConvert_v1_SecretReference_To_notify_SecretReference(in, out, s)
+}
+
+func autoConvert_notify_SecretReference_To_v1_SecretReference(in *notify.SecretReference, out *SecretReference, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_notify_SecretReference_To_v1_SecretReference is an autogenerated conversion function.
+func Convert_notify_SecretReference_To_v1_SecretReference(in *notify.SecretReference, out *SecretReference, s conversion.Scope) error {
+	return autoConvert_notify_SecretReference_To_v1_SecretReference(in, out, s)
+}
+
+func autoConvert_v1_Silence_To_notify_Silence(in *Silence, out *notify.Silence, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SilenceSpec_To_notify_SilenceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_SilenceStatus_To_notify_SilenceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Silence_To_notify_Silence is an autogenerated conversion function.
+func Convert_v1_Silence_To_notify_Silence(in *Silence, out *notify.Silence, s conversion.Scope) error {
+	return autoConvert_v1_Silence_To_notify_Silence(in, out, s)
+}
+
+func autoConvert_notify_Silence_To_v1_Silence(in *notify.Silence, out *Silence, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_SilenceSpec_To_v1_SilenceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_notify_SilenceStatus_To_v1_SilenceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_Silence_To_v1_Silence is an autogenerated conversion function.
+func Convert_notify_Silence_To_v1_Silence(in *notify.Silence, out *Silence, s conversion.Scope) error {
+	return autoConvert_notify_Silence_To_v1_Silence(in, out, s)
+}
+
+func autoConvert_v1_SilenceList_To_notify_SilenceList(in *SilenceList, out *notify.SilenceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.Silence)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_SilenceList_To_notify_SilenceList is an autogenerated conversion function.
+func Convert_v1_SilenceList_To_notify_SilenceList(in *SilenceList, out *notify.SilenceList, s conversion.Scope) error {
+	return autoConvert_v1_SilenceList_To_notify_SilenceList(in, out, s)
+}
+
+func autoConvert_notify_SilenceList_To_v1_SilenceList(in *notify.SilenceList, out *SilenceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Silence)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_SilenceList_To_v1_SilenceList is an autogenerated conversion function.
+func Convert_notify_SilenceList_To_v1_SilenceList(in *notify.SilenceList, out *SilenceList, s conversion.Scope) error {
+	return autoConvert_notify_SilenceList_To_v1_SilenceList(in, out, s)
+}
+
+func autoConvert_v1_SilenceMatcher_To_notify_SilenceMatcher(in *SilenceMatcher, out *notify.SilenceMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Operator = notify.SilenceMatchOperator(in.Operator)
+	return nil
+}
+
+// Convert_v1_SilenceMatcher_To_notify_SilenceMatcher is an autogenerated conversion function.
+func Convert_v1_SilenceMatcher_To_notify_SilenceMatcher(in *SilenceMatcher, out *notify.SilenceMatcher, s conversion.Scope) error {
+	return autoConvert_v1_SilenceMatcher_To_notify_SilenceMatcher(in, out, s)
+}
+
+func autoConvert_notify_SilenceMatcher_To_v1_SilenceMatcher(in *notify.SilenceMatcher, out *SilenceMatcher, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.Operator = SilenceMatchOperator(in.Operator)
+	return nil
+}
+
+// Convert_notify_SilenceMatcher_To_v1_SilenceMatcher is an autogenerated conversion function.
+func Convert_notify_SilenceMatcher_To_v1_SilenceMatcher(in *notify.SilenceMatcher, out *SilenceMatcher, s conversion.Scope) error {
+	return autoConvert_notify_SilenceMatcher_To_v1_SilenceMatcher(in, out, s)
+}
+
+func autoConvert_v1_SilenceSpec_To_notify_SilenceSpec(in *SilenceSpec, out *notify.SilenceSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Matchers = *(*[]notify.SilenceMatcher)(unsafe.Pointer(&in.Matchers))
+	out.StartsAt = in.StartsAt
+	out.EndsAt = in.EndsAt
+	out.CreatedBy = in.CreatedBy
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_v1_SilenceSpec_To_notify_SilenceSpec is an autogenerated conversion function.
+func Convert_v1_SilenceSpec_To_notify_SilenceSpec(in *SilenceSpec, out *notify.SilenceSpec, s conversion.Scope) error {
+	return autoConvert_v1_SilenceSpec_To_notify_SilenceSpec(in, out, s)
+}
+
+func autoConvert_notify_SilenceSpec_To_v1_SilenceSpec(in *notify.SilenceSpec, out *SilenceSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Matchers = *(*[]SilenceMatcher)(unsafe.Pointer(&in.Matchers))
+	out.StartsAt = in.StartsAt
+	out.EndsAt = in.EndsAt
+	out.CreatedBy = in.CreatedBy
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_notify_SilenceSpec_To_v1_SilenceSpec is an autogenerated conversion function.
+func Convert_notify_SilenceSpec_To_v1_SilenceSpec(in *notify.SilenceSpec, out *SilenceSpec, s conversion.Scope) error {
+	return autoConvert_notify_SilenceSpec_To_v1_SilenceSpec(in, out, s)
+}
+
+func autoConvert_v1_SilenceStatus_To_notify_SilenceStatus(in *SilenceStatus, out *notify.SilenceStatus, s conversion.Scope) error {
+	out.SuppressedCount = in.SuppressedCount
+	out.LastSuppressedTime = in.LastSuppressedTime
+	return nil
+}
+
+// Convert_v1_SilenceStatus_To_notify_SilenceStatus is an autogenerated conversion function.
+func Convert_v1_SilenceStatus_To_notify_SilenceStatus(in *SilenceStatus, out *notify.SilenceStatus, s conversion.Scope) error {
+	return autoConvert_v1_SilenceStatus_To_notify_SilenceStatus(in, out, s)
+}
+
+func autoConvert_notify_SilenceStatus_To_v1_SilenceStatus(in *notify.SilenceStatus, out *SilenceStatus, s conversion.Scope) error {
+	out.SuppressedCount = in.SuppressedCount
+	out.LastSuppressedTime = in.LastSuppressedTime
+	return nil
+}
+
+// Convert_notify_SilenceStatus_To_v1_SilenceStatus is an autogenerated conversion function.
+func Convert_notify_SilenceStatus_To_v1_SilenceStatus(in *notify.SilenceStatus, out *SilenceStatus, s conversion.Scope) error {
+	return autoConvert_notify_SilenceStatus_To_v1_SilenceStatus(in, out, s)
+}
+
 func autoConvert_v1_Template_To_notify_Template(in *Template, out *notify.Template, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_TemplateSpec_To_notify_TemplateSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1010,6 +2294,28 @@ func Convert_notify_TemplateList_To_v1_TemplateList(in *notify.TemplateList, out
 	return autoConvert_notify_TemplateList_To_v1_TemplateList(in, out, s)
 }
 
+func autoConvert_v1_TemplateMarkdown_To_notify_TemplateMarkdown(in *TemplateMarkdown, out *notify.TemplateMarkdown, s conversion.Scope) error {
+	out.Body = in.Body
+	out.Title = in.Title
+	return nil
+}
+
+// Convert_v1_TemplateMarkdown_To_notify_TemplateMarkdown is an autogenerated conversion function.
+func Convert_v1_TemplateMarkdown_To_notify_TemplateMarkdown(in *TemplateMarkdown, out *notify.TemplateMarkdown, s conversion.Scope) error {
+	return autoConvert_v1_TemplateMarkdown_To_notify_TemplateMarkdown(in, out, s)
+}
+
+func autoConvert_notify_TemplateMarkdown_To_v1_TemplateMarkdown(in *notify.TemplateMarkdown, out *TemplateMarkdown, s conversion.Scope) error {
+	out.Body = in.Body
+	out.Title = in.Title
+	return nil
+}
+
+// Convert_notify_TemplateMarkdown_To_v1_TemplateMarkdown is an autogenerated conversion function.
+func Convert_notify_TemplateMarkdown_To_v1_TemplateMarkdown(in *notify.TemplateMarkdown, out *TemplateMarkdown, s conversion.Scope) error {
+	return autoConvert_notify_TemplateMarkdown_To_v1_TemplateMarkdown(in, out, s)
+}
+
 func autoConvert_v1_TemplateSpec_To_notify_TemplateSpec(in *TemplateSpec, out *notify.TemplateSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
@@ -1017,6 +2323,7 @@ func autoConvert_v1_TemplateSpec_To_notify_TemplateSpec(in *TemplateSpec, out *n
 	out.TencentCloudSMS = (*notify.TemplateTencentCloudSMS)(unsafe.Pointer(in.TencentCloudSMS))
 	out.Wechat = (*notify.TemplateWechat)(unsafe.Pointer(in.Wechat))
 	out.Text = (*notify.TemplateText)(unsafe.Pointer(in.Text))
+	out.Markdown = (*notify.TemplateMarkdown)(unsafe.Pointer(in.Markdown))
 	return nil
 }
 
@@ -1032,6 +2339,7 @@ func autoConvert_notify_TemplateSpec_To_v1_TemplateSpec(in *notify.TemplateSpec,
 	out.TencentCloudSMS = (*TemplateTencentCloudSMS)(unsafe.Pointer(in.TencentCloudSMS))
 	out.Wechat = (*TemplateWechat)(unsafe.Pointer(in.Wechat))
 	out.Text = (*TemplateText)(unsafe.Pointer(in.Text))
+	out.Markdown = (*TemplateMarkdown)(unsafe.Pointer(in.Markdown))
 	return nil
 }
 
@@ -1113,3 +2421,29 @@ func autoConvert_notify_TemplateWechat_To_v1_TemplateWechat(in *notify.TemplateW
 func Convert_notify_TemplateWechat_To_v1_TemplateWechat(in *notify.TemplateWechat, out *TemplateWechat, s conversion.Scope) error {
 	return autoConvert_notify_TemplateWechat_To_v1_TemplateWechat(in, out, s)
 }
+
+func autoConvert_v1_WebhookRequest_To_notify_WebhookRequest(in *WebhookRequest, out *notify.WebhookRequest, s conversion.Scope) error {
+	out.Method = in.Method
+	out.URL = in.URL
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	out.Body = in.Body
+	return nil
+}
+
+// Convert_v1_WebhookRequest_To_notify_WebhookRequest is an autogenerated conversion function.
+func Convert_v1_WebhookRequest_To_notify_WebhookRequest(in *WebhookRequest, out *notify.WebhookRequest, s conversion.Scope) error {
+	return autoConvert_v1_WebhookRequest_To_notify_WebhookRequest(in, out, s)
+}
+
+func autoConvert_notify_WebhookRequest_To_v1_WebhookRequest(in *notify.WebhookRequest, out *WebhookRequest, s conversion.Scope) error {
+	out.Method = in.Method
+	out.URL = in.URL
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	out.Body = in.Body
+	return nil
+}
+
+// Convert_notify_WebhookRequest_To_v1_WebhookRequest is an autogenerated conversion function.
+func Convert_notify_WebhookRequest_To_v1_WebhookRequest(in *notify.WebhookRequest, out *WebhookRequest, s conversion.Scope) error {
+	return autoConvert_notify_WebhookRequest_To_v1_WebhookRequest(in, out, s)
+}
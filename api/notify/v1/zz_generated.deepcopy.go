@@ -26,6 +26,139 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRule) DeepCopyInto(out *AuditRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRule.
+func (in *AuditRule) DeepCopy() *AuditRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuditRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRuleList) DeepCopyInto(out *AuditRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuditRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRuleList.
+func (in *AuditRuleList) DeepCopy() *AuditRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuditRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRuleMatch) DeepCopyInto(out *AuditRuleMatch) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verbs != nil {
+		in, out := &in.Verbs, &out.Verbs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRuleMatch.
+func (in *AuditRuleMatch) DeepCopy() *AuditRuleMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditRuleMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditRuleSpec) DeepCopyInto(out *AuditRuleSpec) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditRuleSpec.
+func (in *AuditRuleSpec) DeepCopy() *AuditRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Channel) DeepCopyInto(out *Channel) {
 	*out = *in
@@ -54,6 +187,38 @@ func (in *Channel) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelDingTalk) DeepCopyInto(out *ChannelDingTalk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelDingTalk.
+func (in *ChannelDingTalk) DeepCopy() *ChannelDingTalk {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelDingTalk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelLark) DeepCopyInto(out *ChannelLark) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelLark.
+func (in *ChannelLark) DeepCopy() *ChannelLark {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelLark)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelList) DeepCopyInto(out *ChannelList) {
 	*out = *in
@@ -103,6 +268,22 @@ func (in *ChannelSMTP) DeepCopy() *ChannelSMTP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelSlack) DeepCopyInto(out *ChannelSlack) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelSlack.
+func (in *ChannelSlack) DeepCopy() *ChannelSlack {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelSlack)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelSpec) DeepCopyInto(out *ChannelSpec) {
 	*out = *in
@@ -131,6 +312,26 @@ func (in *ChannelSpec) DeepCopyInto(out *ChannelSpec) {
 		*out = new(ChannelWebhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Slack != nil {
+		in, out := &in.Slack, &out.Slack
+		*out = new(ChannelSlack)
+		**out = **in
+	}
+	if in.DingTalk != nil {
+		in, out := &in.DingTalk, &out.DingTalk
+		*out = new(ChannelDingTalk)
+		**out = **in
+	}
+	if in.WeCom != nil {
+		in, out := &in.WeCom, &out.WeCom
+		*out = new(ChannelWeCom)
+		**out = **in
+	}
+	if in.Lark != nil {
+		in, out := &in.Lark, &out.Lark
+		*out = new(ChannelLark)
+		**out = **in
+	}
 	return
 }
 
@@ -176,6 +377,22 @@ func (in *ChannelTencentCloudSMS) DeepCopy() *ChannelTencentCloudSMS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelWeCom) DeepCopyInto(out *ChannelWeCom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelWeCom.
+func (in *ChannelWeCom) DeepCopy() *ChannelWeCom {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelWeCom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelWebhook) DeepCopyInto(out *ChannelWebhook) {
 	*out = *in
@@ -186,6 +403,16 @@ func (in *ChannelWebhook) DeepCopyInto(out *ChannelWebhook) {
 			(*out)[key] = val
 		}
 	}
+	if in.SigningSecretRef != nil {
+		in, out := &in.SigningSecretRef, &out.SigningSecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.ClientCertificateSecretRef != nil {
+		in, out := &in.ClientCertificateSecretRef, &out.ClientCertificateSecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
 	return
 }
 
@@ -245,18 +472,709 @@ func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
-func (in *ConfigMap) DeepCopy() *ConfigMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
+func (in *ConfigMap) DeepCopy() *ConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
+func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicy) DeepCopyInto(out *EscalationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicy.
+func (in *EscalationPolicy) DeepCopy() *EscalationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EscalationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicyList) DeepCopyInto(out *EscalationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EscalationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicyList.
+func (in *EscalationPolicyList) DeepCopy() *EscalationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EscalationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicySpec) DeepCopyInto(out *EscalationPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]EscalationRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicySpec.
+func (in *EscalationPolicySpec) DeepCopy() *EscalationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationRule) DeepCopyInto(out *EscalationRule) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]EscalationTarget, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationRule.
+func (in *EscalationRule) DeepCopy() *EscalationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationTarget) DeepCopyInto(out *EscalationTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationTarget.
+func (in *EscalationTarget) DeepCopy() *EscalationTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Message) DeepCopyInto(out *Message) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Message.
+func (in *Message) DeepCopy() *Message {
+	if in == nil {
+		return nil
+	}
+	out := new(Message)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Message) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageList) DeepCopyInto(out *MessageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Message, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageList.
+func (in *MessageList) DeepCopy() *MessageList {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequest) DeepCopyInto(out *MessageRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequest.
+func (in *MessageRequest) DeepCopy() *MessageRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessageRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestDeferralStatus) DeepCopyInto(out *MessageRequestDeferralStatus) {
+	*out = *in
+	in.DeferredTime.DeepCopyInto(&out.DeferredTime)
+	in.DeliverAfter.DeepCopyInto(&out.DeliverAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestDeferralStatus.
+func (in *MessageRequestDeferralStatus) DeepCopy() *MessageRequestDeferralStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestDeferralStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestEscalationStatus) DeepCopyInto(out *MessageRequestEscalationStatus) {
+	*out = *in
+	in.LastEscalationTime.DeepCopyInto(&out.LastEscalationTime)
+	in.NextEscalationTime.DeepCopyInto(&out.NextEscalationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestEscalationStatus.
+func (in *MessageRequestEscalationStatus) DeepCopy() *MessageRequestEscalationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestEscalationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestList) DeepCopyInto(out *MessageRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MessageRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestList.
+func (in *MessageRequestList) DeepCopy() *MessageRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessageRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestRetryStatus) DeepCopyInto(out *MessageRequestRetryStatus) {
+	*out = *in
+	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
+	in.NextAttemptTime.DeepCopyInto(&out.NextAttemptTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestRetryStatus.
+func (in *MessageRequestRetryStatus) DeepCopy() *MessageRequestRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestSpec) DeepCopyInto(out *MessageRequestSpec) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestSpec.
+func (in *MessageRequestSpec) DeepCopy() *MessageRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageRequestStatus) DeepCopyInto(out *MessageRequestStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Retries != nil {
+		in, out := &in.Retries, &out.Retries
+		*out = make(map[string]MessageRequestRetryStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Escalations != nil {
+		in, out := &in.Escalations, &out.Escalations
+		*out = make(map[string]MessageRequestEscalationStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Deferrals != nil {
+		in, out := &in.Deferrals, &out.Deferrals
+		*out = make(map[string]MessageRequestDeferralStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestStatus.
+func (in *MessageRequestStatus) DeepCopy() *MessageRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageSpec) DeepCopyInto(out *MessageSpec) {
+	*out = *in
+	if in.WebhookRequest != nil {
+		in, out := &in.WebhookRequest, &out.WebhookRequest
+		*out = new(WebhookRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageSpec.
+func (in *MessageSpec) DeepCopy() *MessageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageStatus) DeepCopyInto(out *MessageStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.AcknowledgedTime.DeepCopyInto(&out.AcknowledgedTime)
+	in.DeferredTime.DeepCopyInto(&out.DeferredTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageStatus.
+func (in *MessageStatus) DeepCopy() *MessageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallLayer) DeepCopyInto(out *OnCallLayer) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	if in.Restrictions != nil {
+		in, out := &in.Restrictions, &out.Restrictions
+		*out = make([]OnCallRestriction, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallLayer.
+func (in *OnCallLayer) DeepCopy() *OnCallLayer {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallLayer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallOverride) DeepCopyInto(out *OnCallOverride) {
+	*out = *in
+	in.StartsAt.DeepCopyInto(&out.StartsAt)
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallOverride.
+func (in *OnCallOverride) DeepCopy() *OnCallOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallRestriction) DeepCopyInto(out *OnCallRestriction) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallRestriction.
+func (in *OnCallRestriction) DeepCopy() *OnCallRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallSchedule) DeepCopyInto(out *OnCallSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallSchedule.
+func (in *OnCallSchedule) DeepCopy() *OnCallSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleList) DeepCopyInto(out *OnCallScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OnCallSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleList.
+func (in *OnCallScheduleList) DeepCopy() *OnCallScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleSpec) DeepCopyInto(out *OnCallScheduleSpec) {
+	*out = *in
+	if in.Layers != nil {
+		in, out := &in.Layers, &out.Layers
+		*out = make([]OnCallLayer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]OnCallOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleSpec.
+func (in *OnCallScheduleSpec) DeepCopy() *OnCallScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Receiver) DeepCopyInto(out *Receiver) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
+func (in *Receiver) DeepCopy() *Receiver {
+	if in == nil {
+		return nil
+	}
+	out := new(Receiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Receiver) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverDelivery) DeepCopyInto(out *ReceiverDelivery) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverDelivery.
+func (in *ReceiverDelivery) DeepCopy() *ReceiverDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverDigest) DeepCopyInto(out *ReceiverDigest) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverDigest.
+func (in *ReceiverDigest) DeepCopy() *ReceiverDigest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReceiverDigest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReceiverGroup) DeepCopyInto(out *ReceiverGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroup.
+func (in *ReceiverGroup) DeepCopy() *ReceiverGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMap)
+	out := new(ReceiverGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMap) DeepCopyObject() runtime.Object {
+func (in *ReceiverGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -264,13 +1182,13 @@ func (in *ConfigMap) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+func (in *ReceiverGroupList) DeepCopyInto(out *ReceiverGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ConfigMap, len(*in))
+		*out = make([]ReceiverGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -278,18 +1196,18 @@ func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
-func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroupList.
+func (in *ReceiverGroupList) DeepCopy() *ReceiverGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMapList)
+	out := new(ReceiverGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMapList) DeepCopyObject() runtime.Object {
+func (in *ReceiverGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -297,41 +1215,34 @@ func (in *ConfigMapList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Message) DeepCopyInto(out *Message) {
+func (in *ReceiverGroupSpec) DeepCopyInto(out *ReceiverGroupSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Message.
-func (in *Message) DeepCopy() *Message {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroupSpec.
+func (in *ReceiverGroupSpec) DeepCopy() *ReceiverGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Message)
+	out := new(ReceiverGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Message) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageList) DeepCopyInto(out *MessageList) {
+func (in *ReceiverList) DeepCopyInto(out *ReceiverList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Message, len(*in))
+		*out = make([]Receiver, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -339,18 +1250,18 @@ func (in *MessageList) DeepCopyInto(out *MessageList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageList.
-func (in *MessageList) DeepCopy() *MessageList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverList.
+func (in *ReceiverList) DeepCopy() *ReceiverList {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageList)
+	out := new(ReceiverList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MessageList) DeepCopyObject() runtime.Object {
+func (in *ReceiverList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -358,204 +1269,180 @@ func (in *MessageList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageRequest) DeepCopyInto(out *MessageRequest) {
+func (in *ReceiverPreferences) DeepCopyInto(out *ReceiverPreferences) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.QuietHours != nil {
+		in, out := &in.QuietHours, &out.QuietHours
+		*out = make([]ReceiverQuietHours, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]ReceiverRateLimit, len(*in))
+		copy(*out, *in)
+	}
+	if in.Digest != nil {
+		in, out := &in.Digest, &out.Digest
+		*out = new(ReceiverDigest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequest.
-func (in *MessageRequest) DeepCopy() *MessageRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverPreferences.
+func (in *ReceiverPreferences) DeepCopy() *ReceiverPreferences {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageRequest)
+	out := new(ReceiverPreferences)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MessageRequest) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageRequestList) DeepCopyInto(out *MessageRequestList) {
+func (in *ReceiverQuietHours) DeepCopyInto(out *ReceiverQuietHours) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]MessageRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ReceiverChannel, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestList.
-func (in *MessageRequestList) DeepCopy() *MessageRequestList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverQuietHours.
+func (in *ReceiverQuietHours) DeepCopy() *ReceiverQuietHours {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageRequestList)
+	out := new(ReceiverQuietHours)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MessageRequestList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageRequestSpec) DeepCopyInto(out *MessageRequestSpec) {
+func (in *ReceiverRateLimit) DeepCopyInto(out *ReceiverRateLimit) {
 	*out = *in
-	if in.Receivers != nil {
-		in, out := &in.Receivers, &out.Receivers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ReceiverGroups != nil {
-		in, out := &in.ReceiverGroups, &out.ReceiverGroups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Variables != nil {
-		in, out := &in.Variables, &out.Variables
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestSpec.
-func (in *MessageRequestSpec) DeepCopy() *MessageRequestSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverRateLimit.
+func (in *ReceiverRateLimit) DeepCopy() *ReceiverRateLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageRequestSpec)
+	out := new(ReceiverRateLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageRequestStatus) DeepCopyInto(out *MessageRequestStatus) {
+func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
-	if in.Errors != nil {
-		in, out := &in.Errors, &out.Errors
-		*out = make(map[string]string, len(*in))
+	if in.Identities != nil {
+		in, out := &in.Identities, &out.Identities
+		*out = make(map[ReceiverChannel]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.Preferences != nil {
+		in, out := &in.Preferences, &out.Preferences
+		*out = new(ReceiverPreferences)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageRequestStatus.
-func (in *MessageRequestStatus) DeepCopy() *MessageRequestStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
+func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageRequestStatus)
+	out := new(ReceiverSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageSpec) DeepCopyInto(out *MessageSpec) {
+func (in *ReceiverStatus) DeepCopyInto(out *ReceiverStatus) {
 	*out = *in
+	if in.Deliveries != nil {
+		in, out := &in.Deliveries, &out.Deliveries
+		*out = make([]ReceiverDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageSpec.
-func (in *MessageSpec) DeepCopy() *MessageSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverStatus.
+func (in *ReceiverStatus) DeepCopy() *ReceiverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageSpec)
+	out := new(ReceiverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MessageStatus) DeepCopyInto(out *MessageStatus) {
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageStatus.
-func (in *MessageStatus) DeepCopy() *MessageStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
 	if in == nil {
 		return nil
 	}
-	out := new(MessageStatus)
+	out := new(SecretKeySelector)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Receiver) DeepCopyInto(out *Receiver) {
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
-func (in *Receiver) DeepCopy() *Receiver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(Receiver)
+	out := new(SecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Receiver) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverGroup) DeepCopyInto(out *ReceiverGroup) {
+func (in *Silence) DeepCopyInto(out *Silence) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroup.
-func (in *ReceiverGroup) DeepCopy() *ReceiverGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Silence.
+func (in *Silence) DeepCopy() *Silence {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverGroup)
+	out := new(Silence)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReceiverGroup) DeepCopyObject() runtime.Object {
+func (in *Silence) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -563,13 +1450,13 @@ func (in *ReceiverGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverGroupList) DeepCopyInto(out *ReceiverGroupList) {
+func (in *SilenceList) DeepCopyInto(out *SilenceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ReceiverGroup, len(*in))
+		*out = make([]Silence, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -577,18 +1464,18 @@ func (in *ReceiverGroupList) DeepCopyInto(out *ReceiverGroupList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroupList.
-func (in *ReceiverGroupList) DeepCopy() *ReceiverGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceList.
+func (in *SilenceList) DeepCopy() *SilenceList {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverGroupList)
+	out := new(SilenceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReceiverGroupList) DeepCopyObject() runtime.Object {
+func (in *SilenceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -596,78 +1483,57 @@ func (in *ReceiverGroupList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverGroupSpec) DeepCopyInto(out *ReceiverGroupSpec) {
+func (in *SilenceMatcher) DeepCopyInto(out *SilenceMatcher) {
 	*out = *in
-	if in.Receivers != nil {
-		in, out := &in.Receivers, &out.Receivers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverGroupSpec.
-func (in *ReceiverGroupSpec) DeepCopy() *ReceiverGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceMatcher.
+func (in *SilenceMatcher) DeepCopy() *SilenceMatcher {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverGroupSpec)
+	out := new(SilenceMatcher)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverList) DeepCopyInto(out *ReceiverList) {
+func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Receiver, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]SilenceMatcher, len(*in))
+		copy(*out, *in)
 	}
+	in.StartsAt.DeepCopyInto(&out.StartsAt)
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverList.
-func (in *ReceiverList) DeepCopy() *ReceiverList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSpec.
+func (in *SilenceSpec) DeepCopy() *SilenceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverList)
+	out := new(SilenceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ReceiverList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
+func (in *SilenceStatus) DeepCopyInto(out *SilenceStatus) {
 	*out = *in
-	if in.Identities != nil {
-		in, out := &in.Identities, &out.Identities
-		*out = make(map[ReceiverChannel]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
+	in.LastSuppressedTime.DeepCopyInto(&out.LastSuppressedTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
-func (in *ReceiverSpec) DeepCopy() *ReceiverSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceStatus.
+func (in *SilenceStatus) DeepCopy() *SilenceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ReceiverSpec)
+	out := new(SilenceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -732,6 +1598,22 @@ func (in *TemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateMarkdown) DeepCopyInto(out *TemplateMarkdown) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateMarkdown.
+func (in *TemplateMarkdown) DeepCopy() *TemplateMarkdown {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateMarkdown)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 	*out = *in
@@ -755,6 +1637,11 @@ func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 		*out = new(TemplateText)
 		**out = **in
 	}
+	if in.Markdown != nil {
+		in, out := &in.Markdown, &out.Markdown
+		*out = new(TemplateMarkdown)
+		**out = **in
+	}
 	return
 }
 
@@ -815,3 +1702,26 @@ func (in *TemplateWechat) DeepCopy() *TemplateWechat {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookRequest) DeepCopyInto(out *WebhookRequest) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookRequest.
+func (in *WebhookRequest) DeepCopy() *WebhookRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookRequest)
+	in.DeepCopyInto(out)
+	return out
+}
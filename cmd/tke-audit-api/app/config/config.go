@@ -23,7 +23,11 @@ import (
 	"path/filepath"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/common"
+	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	generatedopenapi "tkestack.io/tke/api/openapi"
 	"tkestack.io/tke/cmd/tke-audit-api/app/options"
 	"tkestack.io/tke/pkg/apiserver"
@@ -36,6 +40,7 @@ import (
 	"tkestack.io/tke/pkg/audit/apis/config/validation"
 	"tkestack.io/tke/pkg/audit/config/configfiles"
 	auditopenapi "tkestack.io/tke/pkg/audit/openapi"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	utilfs "tkestack.io/tke/pkg/util/filesystem"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -50,6 +55,8 @@ type Config struct {
 	ServerName             string
 	GenericAPIServerConfig *genericapiserver.Config
 	AuditConfig            *auditconfig.AuditConfiguration
+	NotifyClient           notifyversionedclient.NotifyV1Interface
+	PlatformClient         platformversionedclient.PlatformV1Interface
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -110,11 +117,39 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
-	return &Config{
+	cfg := &Config{
 		ServerName:             serverName,
 		GenericAPIServerConfig: genericAPIServerConfig,
 		AuditConfig:            auditConfig,
-	}, nil
+	}
+
+	// client config for notify apiserver
+	notifyAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && notifyAPIServerClientConfig != nil {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(notifyAPIServerClientConfig, "tke-audit-api"))
+		if err != nil {
+			return nil, err
+		}
+		cfg.NotifyClient = notifyClient.NotifyV1()
+	}
+
+	// client config for platform apiserver
+	platformAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.PlatformAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && platformAPIServerClientConfig != nil {
+		platformClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(platformAPIServerClientConfig, "tke-audit-api"))
+		if err != nil {
+			return nil, err
+		}
+		cfg.PlatformClient = platformClient.PlatformV1()
+	}
+
+	return cfg, nil
 }
 
 func loadConfigFile(name string) (*auditconfig.AuditConfiguration, error) {
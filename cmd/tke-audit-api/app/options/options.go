@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -43,6 +44,13 @@ type Options struct {
 	Generic        *apiserveroptions.GenericOptions
 	Authentication *apiserveroptions.AuthenticationWithAPIOptions
 	Authorization  *apiserveroptions.AuthorizationOptions
+	// NotifyAPIClient is used to create the message requests of the audit
+	// rules, the audit rules are not evaluated without it.
+	NotifyAPIClient *controlleroptions.APIServerClientOptions
+	// PlatformAPIClient is used to find the tenants of the clusters, the
+	// audit rules only match the events of the users with a tenant without
+	// it.
+	PlatformAPIClient *controlleroptions.APIServerClientOptions
 	// The Audit will load its initial configuration from this file.
 	// The path may be absolute or relative; relative paths are under the Audit's current working directory.
 	AuditConfig string
@@ -51,11 +59,13 @@ type Options struct {
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string) *Options {
 	return &Options{
-		Log:            log.NewOptions(),
-		SecureServing:  apiserveroptions.NewSecureServingOptions(serverName, 9461),
-		Generic:        apiserveroptions.NewGenericOptions(),
-		Authentication: apiserveroptions.NewAuthenticationWithAPIOptions(),
-		Authorization:  apiserveroptions.NewAuthorizationOptions(),
+		Log:               log.NewOptions(),
+		SecureServing:     apiserveroptions.NewSecureServingOptions(serverName, 9461),
+		Generic:           apiserveroptions.NewGenericOptions(),
+		Authentication:    apiserveroptions.NewAuthenticationWithAPIOptions(),
+		Authorization:     apiserveroptions.NewAuthorizationOptions(),
+		NotifyAPIClient:   controlleroptions.NewAPIServerClientOptions("notify", false),
+		PlatformAPIClient: controlleroptions.NewAPIServerClientOptions("platform", false),
 	}
 }
 
@@ -66,6 +76,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Generic.AddFlags(fs)
 	o.Authentication.AddFlags(fs)
 	o.Authorization.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
+	o.PlatformAPIClient.AddFlags(fs)
 
 	fs.String(flagAuditConfig, o.AuditConfig,
 		"The Audit will load its initial configuration from this file. The path may be absolute or relative; relative paths start at the Audit's current working directory. Omit this flag to use the built-in default configuration values.")
@@ -82,6 +94,8 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Generic.ApplyFlags()...)
 	errs = append(errs, o.Authentication.ApplyFlags()...)
 	errs = append(errs, o.Authorization.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.PlatformAPIClient.ApplyFlags()...)
 
 	o.AuditConfig = viper.GetString(configAuditConfig)
 
@@ -23,7 +23,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"time"
 
@@ -32,7 +31,6 @@ import (
 	"github.com/casbin/casbin/v2"
 	casbinlog "github.com/casbin/casbin/v2/log"
 	"github.com/casbin/casbin/v2/model"
-	casbinutil "github.com/casbin/casbin/v2/util"
 	dexldap "github.com/dexidp/dex/connector/ldap"
 	dexserver "github.com/dexidp/dex/server"
 	dexstorage "github.com/dexidp/dex/storage"
@@ -48,11 +46,13 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/validation/spec"
 
 	authapi "tkestack.io/tke/api/auth"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	generatedopenapi "tkestack.io/tke/api/openapi"
 	"tkestack.io/tke/cmd/tke-auth-api/app/options"
@@ -66,12 +66,16 @@ import (
 	"tkestack.io/tke/pkg/apiserver/util"
 	"tkestack.io/tke/pkg/auth/apiserver"
 	"tkestack.io/tke/pkg/auth/authentication/authenticator"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/federation"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
 	"tkestack.io/tke/pkg/auth/authorization/aggregation"
+	authutil "tkestack.io/tke/pkg/auth/util"
 	dexutil "tkestack.io/tke/pkg/auth/util/dex"
 	casbinlogger "tkestack.io/tke/pkg/auth/util/logger"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/log/dex"
 )
@@ -164,7 +168,21 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
-	aggregateAuthz, err := aggregation.NewAuthorizer(authClient, opts.Authorization, opts.Auth, enforcer, opts.Authentication.PrivilegedUsername)
+	// client config for business apiserver
+	var businessClient businessversionedclient.BusinessV1Interface
+	businessAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.BusinessAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && businessAPIServerClientConfig != nil {
+		client, err := versionedclientset.NewForConfig(rest.AddUserAgent(businessAPIServerClientConfig, "tke-auth-api"))
+		if err != nil {
+			return nil, err
+		}
+		businessClient = client.BusinessV1()
+	}
+
+	aggregateAuthz, err := aggregation.NewAuthorizer(authClient, businessClient, opts.Authorization, opts.Auth, enforcer, versionedInformers, opts.Authentication.PrivilegedUsername)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +193,11 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
-	local.SetupRestClient(authClient)
+	local.SetupRestClient(authClient, genericAPIServerConfig.LoopbackClientConfig)
+	federation.SetupRestClient(authClient)
+	if err := mfa.SetupRelyingParty(dexConfig.Issuer); err != nil {
+		return nil, err
+	}
 	log.Info("init tenant type", log.String("type", opts.Auth.InitTenantType))
 	switch opts.Auth.InitTenantType {
 	case local.ConnectorType:
@@ -444,33 +466,10 @@ func setupDefaultClient(store dexstorage.Storage, auth *options.AuthOptions) err
 	return nil
 }
 
-// CustomFunctionWrapper wraps keyMatchCustomFunction
+// CustomFunctionWrapper wraps KeyMatchCustom
 func CustomFunctionWrapper(args ...interface{}) (interface{}, error) {
 	key1 := args[0].(string)
 	key2 := args[1].(string)
 
-	return keyMatchCustomFunction(key1, key2), nil
-}
-
-// keyMatchCustomFunction determines whether key1 matches the pattern of key2 , key2 can contain a * and :*.
-// For example, "/project:123/cluster:456" matches "/project:*/cluster:456", "registry:123/*" matches "registry:123/456"
-func keyMatchCustomFunction(key1 string, key2 string) bool {
-	// case insensitive
-	key1 = strings.ToLower(key1)
-	key2 = strings.ToLower(key2)
-
-	key2 = strings.Replace(key2, "*", ".*", -1)
-
-	re := regexp.MustCompile(`(.*):[^/]+(.*)`)
-	i := 2
-	for {
-		if !strings.Contains(key2, "/:") {
-			break
-		}
-
-		key2 = re.ReplaceAllString(key2, "$1[^/]+$2")
-		i = i + 1
-	}
-
-	return casbinutil.RegexMatch(key1, "^"+key2+"$")
+	return authutil.KeyMatchCustom(key1, key2), nil
 }
@@ -26,6 +26,7 @@ import (
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 	storageoptions "tkestack.io/tke/pkg/apiserver/storage/options"
 	"tkestack.io/tke/pkg/auth/apiserver"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/cachesize"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -42,6 +43,9 @@ type Options struct {
 	Auth           *AuthOptions
 	Audit          *genericapiserveroptions.AuditOptions
 	ConsoleConfig  *apiserver.ConsoleConfig
+	// BusinessAPIClient is optional, the conditions on request labels and
+	// resource tags never hold for allow statements without it.
+	BusinessAPIClient *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -57,6 +61,8 @@ func NewOptions(serverName string) *Options {
 		Auth:           NewAuthOptions(),
 		Audit:          genericapiserveroptions.NewAuditOptions(),
 		ConsoleConfig:  apiserver.NewConsoleConfigOptions(),
+
+		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", false),
 	}
 }
 
@@ -72,6 +78,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Auth.AddFlags(fs)
 	o.Audit.AddFlags(fs)
 	o.ConsoleConfig.AddFlags(fs)
+	o.BusinessAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -88,6 +95,7 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Authorization.ApplyFlags()...)
 	errs = append(errs, o.Auth.ApplyFlags()...)
 	errs = append(errs, o.ConsoleConfig.ApplyFlags()...)
+	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
 
 	return errs
 }
@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"time"
 
+	"tkestack.io/tke/pkg/auth/controller/accessrequest"
 	"tkestack.io/tke/pkg/auth/controller/config"
 	"tkestack.io/tke/pkg/auth/controller/custompolicybinding"
 	"tkestack.io/tke/pkg/auth/controller/group"
@@ -55,6 +56,9 @@ const (
 
 	idpSyncPeriod      = 5 * time.Minute
 	concurrentIDPSyncs = 5
+
+	accessRequestSyncPeriod      = 5 * time.Minute
+	concurrentAccessRequestSyncs = 5
 )
 
 func startPolicyController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -198,3 +202,21 @@ func startConfigController(ctx ControllerContext) (http.Handler, bool, error) {
 
 	return nil, true, nil
 }
+
+func startAccessRequestController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: v1.Version, Resource: "accessrequests"}] {
+		return nil, false, nil
+	}
+
+	ctrl := accessrequest.NewController(
+		ctx.ClientBuilder.ClientOrDie("accessrequest-controller"),
+		ctx.AuthzClient,
+		ctx.NotifyClient,
+		ctx.InformerFactory.Auth().V1().AccessRequests(),
+		accessRequestSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentAccessRequestSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
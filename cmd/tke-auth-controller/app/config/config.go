@@ -45,7 +45,11 @@ type Config struct {
 	LeaderElectionClient *versionedclientset.Clientset
 	// the rest config for the auth apiserver
 	AuthAPIServerClientConfig *restclient.Config
-	Component                 controlleroptions.ComponentConfiguration
+	// the rest config for the notify apiserver
+	NotifyAPIServerClientConfig *restclient.Config
+	// the rest config for the authz apiserver
+	AuthzAPIServerClientConfig *restclient.Config
+	Component                  controlleroptions.ComponentConfiguration
 
 	PolicyPath           string
 	CategoryPath         string
@@ -94,6 +98,22 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		CasbinReloadInterval: opts.FeatureOptions.CasbinReloadInterval,
 	}
 
+	notifyAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && notifyAPIServerClientConfig != nil {
+		controllerManagerConfig.NotifyAPIServerClientConfig = notifyAPIServerClientConfig
+	}
+
+	authzAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.AuthzAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && authzAPIServerClientConfig != nil {
+		controllerManagerConfig.AuthzAPIServerClientConfig = authzAPIServerClientConfig
+	}
+
 	if err := opts.Component.ApplyTo(&controllerManagerConfig.Component); err != nil {
 		return nil, err
 	}
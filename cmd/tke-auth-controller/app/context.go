@@ -34,6 +34,8 @@ import (
 	"tkestack.io/tke/api/auth"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	v1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	authzv1 "tkestack.io/tke/api/client/clientset/versioned/typed/authz/v1"
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-auth-controller/app/config"
 	util2 "tkestack.io/tke/pkg/auth/util"
@@ -83,7 +85,9 @@ type ControllerContext struct {
 	TenantAdmin       string
 	TenantAdminSecret string
 
-	AuthClient v1.AuthV1Interface
+	AuthClient   v1.AuthV1Interface
+	NotifyClient notifyv1.NotifyV1Interface
+	AuthzClient  authzv1.AuthzV1Interface
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -162,5 +166,22 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 
 		AuthClient: client.AuthV1(),
 	}
+
+	if cfg.NotifyAPIServerClientConfig != nil {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.NotifyAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the notify client: %v", err)
+		}
+		ctx.NotifyClient = notifyClient.NotifyV1()
+	}
+
+	if cfg.AuthzAPIServerClientConfig != nil {
+		authzClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.AuthzAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the authz client: %v", err)
+		}
+		ctx.AuthzClient = authzClient.AuthzV1()
+	}
+
 	return ctx, nil
 }
@@ -57,6 +57,7 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["groups"] = startGroupController
 	controllers["roles"] = startRoleController
 	controllers["configs"] = startConfigController
+	controllers["accessrequests"] = startAccessRequestController
 	return controllers
 }
 
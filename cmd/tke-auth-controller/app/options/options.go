@@ -27,23 +27,27 @@ import (
 
 // Options is the main context object for the TKE controller manager.
 type Options struct {
-	Log            *log.Options
-	Debug          *apiserveroptions.DebugOptions
-	SecureServing  *apiserveroptions.SecureServingOptions
-	Component      *controlleroptions.ComponentOptions
-	AuthAPIClient  *controlleroptions.APIServerClientOptions
-	FeatureOptions *FeatureOptions
+	Log             *log.Options
+	Debug           *apiserveroptions.DebugOptions
+	SecureServing   *apiserveroptions.SecureServingOptions
+	Component       *controlleroptions.ComponentOptions
+	AuthAPIClient   *controlleroptions.APIServerClientOptions
+	NotifyAPIClient *controlleroptions.APIServerClientOptions
+	AuthzAPIClient  *controlleroptions.APIServerClientOptions
+	FeatureOptions  *FeatureOptions
 }
 
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string, allControllers []string, disabledByDefaultControllers []string) *Options {
 	return &Options{
-		Log:            log.NewOptions(),
-		Debug:          apiserveroptions.NewDebugOptions(),
-		SecureServing:  apiserveroptions.NewSecureServingOptions(serverName, 9458),
-		Component:      controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
-		AuthAPIClient:  controlleroptions.NewAPIServerClientOptions("auth", true),
-		FeatureOptions: NewFeatureOptions(),
+		Log:             log.NewOptions(),
+		Debug:           apiserveroptions.NewDebugOptions(),
+		SecureServing:   apiserveroptions.NewSecureServingOptions(serverName, 9458),
+		Component:       controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
+		AuthAPIClient:   controlleroptions.NewAPIServerClientOptions("auth", true),
+		NotifyAPIClient: controlleroptions.NewAPIServerClientOptions("notify", false),
+		AuthzAPIClient:  controlleroptions.NewAPIServerClientOptions("authz", false),
+		FeatureOptions:  NewFeatureOptions(),
 	}
 }
 
@@ -54,6 +58,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.SecureServing.AddFlags(fs)
 	o.Component.AddFlags(fs)
 	o.AuthAPIClient.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
+	o.AuthzAPIClient.AddFlags(fs)
 	o.FeatureOptions.AddFlags(fs)
 }
 
@@ -67,6 +73,8 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.SecureServing.ApplyFlags()...)
 	errs = append(errs, o.Component.ApplyFlags()...)
 	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.AuthzAPIClient.ApplyFlags()...)
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
 
 	return errs
@@ -43,6 +43,8 @@ import (
 	"tkestack.io/tke/pkg/apiserver/util"
 	"tkestack.io/tke/pkg/auth/filter"
 	"tkestack.io/tke/pkg/business/apiserver"
+	"tkestack.io/tke/pkg/business/billing"
+	billinginfluxdb "tkestack.io/tke/pkg/business/billing/influxdb"
 	controllerconfig "tkestack.io/tke/pkg/controller/config"
 )
 
@@ -60,8 +62,11 @@ type Config struct {
 	PlatformClient                 platformversionedclient.PlatformV1Interface
 	RegistryClient                 registryversionedclient.RegistryV1Interface
 	AuthClient                     authversionedclient.AuthV1Interface
+	AuthClientConfig               *rest.Config
 	PrivilegedUsername             string
 	FeatureOptions                 *options.FeatureOptions
+	BillingStore                   billing.Store
+	PriceSheet                     *billing.PriceSheet
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -155,6 +160,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 			return nil, err
 		}
 		cfg.AuthClient = authClient.AuthV1()
+		cfg.AuthClientConfig = authAPIServerClientConfig
 	}
 
 	// client config for registry apiserver
@@ -170,5 +176,18 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		cfg.RegistryClient = registryClient.RegistryV1()
 	}
 
+	if opts.Billing.Enabled() {
+		priceSheet, err := billing.LoadPriceSheet(opts.Billing.PriceSheetFile)
+		if err != nil {
+			return nil, err
+		}
+		billingStore, err := billinginfluxdb.NewStore(opts.Billing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the billing store: %v", err)
+		}
+		cfg.PriceSheet = priceSheet
+		cfg.BillingStore = billingStore
+	}
+
 	return cfg, nil
 }
@@ -25,6 +25,7 @@ import (
 	genericapiserveroptions "k8s.io/apiserver/pkg/server/options"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 	storageoptions "tkestack.io/tke/pkg/apiserver/storage/options"
+	"tkestack.io/tke/pkg/business/billing"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/cachesize"
 	"tkestack.io/tke/pkg/util/log"
@@ -44,6 +45,7 @@ type Options struct {
 	AuthAPIClient     *controlleroptions.APIServerClientOptions
 	FeatureOptions    *FeatureOptions
 	Audit             *genericapiserveroptions.AuditOptions
+	Billing           *billing.Options
 }
 
 // NewOptions creates a new Options with a default config.
@@ -61,6 +63,7 @@ func NewOptions(serverName string) *Options {
 		AuthAPIClient:     controlleroptions.NewAPIServerClientOptions("auth", false),
 		FeatureOptions:    NewFeatureOptions(),
 		Audit:             genericapiserveroptions.NewAuditOptions(),
+		Billing:           billing.NewOptions(true),
 	}
 }
 
@@ -78,6 +81,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.AuthAPIClient.AddFlags(fs)
 	o.FeatureOptions.AddFlags(fs)
 	o.Audit.AddFlags(fs)
+	o.Billing.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -96,6 +100,7 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.RegistryAPIClient.ApplyFlags()...)
 	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
+	errs = append(errs, o.Billing.ApplyFlags()...)
 
 	return errs
 }
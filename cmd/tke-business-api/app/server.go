@@ -58,8 +58,11 @@ func createAPIServerConfig(cfg *config.Config) *apiserver.Config {
 			PlatformClient:          cfg.PlatformClient,
 			RegistryClient:          cfg.RegistryClient,
 			AuthClient:              cfg.AuthClient,
+			AuthClientConfig:        cfg.AuthClientConfig,
 			PrivilegedUsername:      cfg.PrivilegedUsername,
 			FeatureOptions:          cfg.FeatureOptions,
+			BillingStore:            cfg.BillingStore,
+			PriceSheet:              cfg.PriceSheet,
 		},
 	}
 }
@@ -27,9 +27,12 @@ import (
 	"tkestack.io/tke/pkg/business/controller/chartgroup"
 	"tkestack.io/tke/pkg/business/controller/emigration"
 	"tkestack.io/tke/pkg/business/controller/imagenamespace"
+	"tkestack.io/tke/pkg/business/controller/lifecycle"
 	"tkestack.io/tke/pkg/business/controller/namespace"
 	"tkestack.io/tke/pkg/business/controller/platform"
 	"tkestack.io/tke/pkg/business/controller/project"
+	"tkestack.io/tke/pkg/business/controller/quotarequest"
+	"tkestack.io/tke/pkg/business/controller/usage"
 )
 
 const (
@@ -50,6 +53,13 @@ const (
 
 	emigrationSyncPeriod      = 30 * time.Second
 	concurrentEmigrationSyncs = 10
+
+	usageSamplePeriod = 5 * time.Minute
+
+	quotaRequestSyncPeriod      = 5 * time.Minute
+	concurrentQuotaRequestSyncs = 5
+
+	projectLifecycleCheckPeriod = time.Minute
 )
 
 func startNamespaceController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -169,3 +179,62 @@ func startNsEmigrationController(ctx ControllerContext) (http.Handler, bool, err
 
 	return nil, true, nil
 }
+
+func startUsageController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.BillingStore == nil {
+		return nil, false, nil
+	}
+
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "namespaces"}] {
+		return nil, false, nil
+	}
+
+	ctrl := usage.NewController(
+		ctx.InformerFactory.Business().V1().Namespaces(),
+		ctx.BillingStore,
+		usageSamplePeriod,
+	)
+
+	go ctrl.Run(ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startQuotaRequestController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.NotifyClient == nil {
+		return nil, false, nil
+	}
+
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "quotarequests"}] {
+		return nil, false, nil
+	}
+
+	ctrl := quotarequest.NewController(
+		ctx.ClientBuilder.ClientOrDie("quotarequest-controller"),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().QuotaRequests(),
+		quotaRequestSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentQuotaRequestSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startProjectLifecycleController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "projects"}] {
+		return nil, false, nil
+	}
+
+	ctrl := lifecycle.NewController(
+		ctx.ClientBuilder.ClientOrDie("project-lifecycle-controller"),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().Projects(),
+		ctx.ProjectLifecycle,
+		projectLifecycleCheckPeriod,
+	)
+
+	go ctrl.Run(ctx.Stop)
+
+	return nil, true, nil
+}
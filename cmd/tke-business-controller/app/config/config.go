@@ -28,6 +28,9 @@ import (
 	restclient "k8s.io/client-go/rest"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	"tkestack.io/tke/cmd/tke-business-controller/app/options"
+	"tkestack.io/tke/pkg/business/billing"
+	billinginfluxdb "tkestack.io/tke/pkg/business/billing/influxdb"
+	"tkestack.io/tke/pkg/business/controller/lifecycle"
 	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
 )
@@ -50,6 +53,12 @@ type Config struct {
 	RegistryAPIServerClientConfig *restclient.Config
 	// the rest config for the auth apiserver
 	AuthAPIServerClientConfig *restclient.Config
+	// the rest config for the notify apiserver
+	NotifyAPIServerClientConfig *restclient.Config
+	// the store of the usage history of namespaces, nil if billing is disabled
+	BillingStore billing.Store
+	// the options of the enforcement of the lifecycle of projects
+	ProjectLifecycle *lifecycle.Options
 
 	Component controlleroptions.ComponentConfiguration
 }
@@ -87,6 +96,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		LeaderElectionClient:          leaderElectionClient,
 		PlatformAPIServerClientConfig: platformAPIServerClientConfig,
 		BusinessAPIServerClientConfig: businessAPIServerClientConfig,
+		ProjectLifecycle:              opts.ProjectLifecycle,
 		Authorization: apiserver.AuthorizationInfo{
 			Authorizer: authorizerfactory.NewAlwaysAllowAuthorizer(),
 		},
@@ -111,6 +121,22 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		controllerManagerConfig.AuthAPIServerClientConfig = authAPIServerClientConfig
 	}
 
+	notifyAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && notifyAPIServerClientConfig != nil {
+		controllerManagerConfig.NotifyAPIServerClientConfig = notifyAPIServerClientConfig
+	}
+
+	if opts.Billing.Enabled() {
+		billingStore, err := billinginfluxdb.NewStore(opts.Billing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the billing store: %v", err)
+		}
+		controllerManagerConfig.BillingStore = billingStore
+	}
+
 	if err := opts.Component.ApplyTo(&controllerManagerConfig.Component); err != nil {
 		return nil, err
 	}
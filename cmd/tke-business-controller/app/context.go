@@ -30,10 +30,13 @@ import (
 	"k8s.io/client-go/restmapper"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	registryv1 "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-business-controller/app/config"
+	"tkestack.io/tke/pkg/business/billing"
+	"tkestack.io/tke/pkg/business/controller/lifecycle"
 	"tkestack.io/tke/pkg/controller"
 	"tkestack.io/tke/pkg/controller/util"
 )
@@ -73,8 +76,12 @@ type ControllerContext struct {
 	ControllerStartInterval time.Duration
 
 	AuthClient     authv1.AuthV1Interface
+	NotifyClient   notifyv1.NotifyV1Interface
 	PlatformClient platformv1.PlatformV1Interface
 	RegistryClient registryv1.RegistryV1Interface
+	BillingStore   billing.Store
+
+	ProjectLifecycle *lifecycle.Options
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -124,6 +131,9 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		ControllerStartInterval: cfg.Component.ControllerStartInterval,
 
 		PlatformClient: platformClient.PlatformV1(),
+		BillingStore:   cfg.BillingStore,
+
+		ProjectLifecycle: cfg.ProjectLifecycle,
 	}
 
 	if cfg.RegistryAPIServerClientConfig != nil {
@@ -142,5 +152,13 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		ctx.AuthClient = authClient.AuthV1()
 	}
 
+	if cfg.NotifyAPIServerClientConfig != nil {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.NotifyAPIServerClientConfig, "tke-business-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the notify client: %v", err)
+		}
+		ctx.NotifyClient = notifyClient.NotifyV1()
+	}
+
 	return ctx, nil
 }
@@ -54,6 +54,9 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["chartgroup"] = startChartGroupController
 	controllers["platform"] = startPlatformController
 	controllers["nsemigration"] = startNsEmigrationController
+	controllers["usage"] = startUsageController
+	controllers["quotarequest"] = startQuotaRequestController
+	controllers["projectlifecycle"] = startProjectLifecycleController
 	return controllers
 }
 
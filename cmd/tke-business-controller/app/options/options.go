@@ -21,6 +21,8 @@ package options
 import (
 	"github.com/spf13/pflag"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
+	"tkestack.io/tke/pkg/business/billing"
+	"tkestack.io/tke/pkg/business/controller/lifecycle"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -35,6 +37,9 @@ type Options struct {
 	BusinessAPIClient *controlleroptions.APIServerClientOptions
 	RegistryAPIClient *controlleroptions.APIServerClientOptions
 	AuthAPIClient     *controlleroptions.APIServerClientOptions
+	NotifyAPIClient   *controlleroptions.APIServerClientOptions
+	Billing           *billing.Options
+	ProjectLifecycle  *lifecycle.Options
 }
 
 // NewOptions creates a new Options with a default config.
@@ -48,6 +53,9 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", true),
 		RegistryAPIClient: controlleroptions.NewAPIServerClientOptions("registry", false),
 		AuthAPIClient:     controlleroptions.NewAPIServerClientOptions("auth", false),
+		NotifyAPIClient:   controlleroptions.NewAPIServerClientOptions("notify", false),
+		Billing:           billing.NewOptions(false),
+		ProjectLifecycle:  lifecycle.NewOptions(),
 	}
 }
 
@@ -61,6 +69,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.BusinessAPIClient.AddFlags(fs)
 	o.RegistryAPIClient.AddFlags(fs)
 	o.AuthAPIClient.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
+	o.Billing.AddFlags(fs)
+	o.ProjectLifecycle.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -76,6 +87,9 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
 	errs = append(errs, o.RegistryAPIClient.ApplyFlags()...)
 	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.Billing.ApplyFlags()...)
+	errs = append(errs, o.ProjectLifecycle.ApplyFlags()...)
 
 	return errs
 }
@@ -59,6 +59,7 @@ type Config struct {
 	ExternalPort                   int
 	MessageRequestTTL              time.Duration
 	MessageTTL                     time.Duration
+	AlertConfig                    apiserver.AlertConfig
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -142,5 +143,10 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		ExternalPort:                   opts.Generic.ExternalPort,
 		MessageRequestTTL:              opts.FeatureOptions.MessageRequestTTL,
 		MessageTTL:                     opts.FeatureOptions.MessageTTL,
+		AlertConfig: apiserver.AlertConfig{
+			GroupBy:        opts.FeatureOptions.AlertGroupBy,
+			GroupWait:      opts.FeatureOptions.AlertGroupWait,
+			RepeatInterval: opts.FeatureOptions.AlertRepeatInterval,
+		},
 	}, nil
 }
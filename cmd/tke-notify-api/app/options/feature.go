@@ -19,6 +19,7 @@
 package options
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/spf13/pflag"
@@ -32,11 +33,22 @@ const (
 	configMessageTTL         = "features.message_ttl"
 	defaultMessageRequestTTL = time.Hour
 	defaultMessageTTL        = time.Hour * 24 * 30
+
+	flagAlertGroupBy          = "alert-group-by"
+	flagAlertGroupWait        = "alert-group-wait"
+	flagAlertRepeatInterval   = "alert-repeat-interval"
+	configAlertGroupBy        = "features.alert_group_by"
+	configAlertGroupWait      = "features.alert_group_wait"
+	configAlertRepeatInterval = "features.alert_repeat_interval"
 )
 
 type FeatureOptions struct {
 	MessageRequestTTL time.Duration
 	MessageTTL        time.Duration
+
+	AlertGroupBy        []string
+	AlertGroupWait      time.Duration
+	AlertRepeatInterval time.Duration
 }
 
 func NewFeatureOptions() *FeatureOptions {
@@ -51,8 +63,17 @@ func (o *FeatureOptions) AddFlags(fs *pflag.FlagSet) {
 		"How long to retain messagerequests")
 	fs.DurationVar(&o.MessageTTL, flagMessageTTL, o.MessageTTL,
 		"How long to retain messages")
+	fs.StringSliceVar(&o.AlertGroupBy, flagAlertGroupBy, o.AlertGroupBy,
+		"The alert labels by which the alarm webhook groups alerts into one message request")
+	fs.DurationVar(&o.AlertGroupWait, flagAlertGroupWait, o.AlertGroupWait,
+		"The length of the clock aligned windows within which alerts of the same group are sent together, 0 means sending each alert immediately")
+	fs.DurationVar(&o.AlertRepeatInterval, flagAlertRepeatInterval, o.AlertRepeatInterval,
+		"How long to drop an alert that fires again after it has been sent, 0 means no deduplication. The sent alerts are found by their message requests, so it should not exceed the message request ttl")
 	_ = viper.BindPFlag(configMessageRequestTTL, fs.Lookup(flagMessageRequestTTL))
 	_ = viper.BindPFlag(configMessageTTL, fs.Lookup(flagMessageTTL))
+	_ = viper.BindPFlag(configAlertGroupBy, fs.Lookup(flagAlertGroupBy))
+	_ = viper.BindPFlag(configAlertGroupWait, fs.Lookup(flagAlertGroupWait))
+	_ = viper.BindPFlag(configAlertRepeatInterval, fs.Lookup(flagAlertRepeatInterval))
 
 }
 
@@ -62,6 +83,15 @@ func (o *FeatureOptions) ApplyFlags() []error {
 	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
 	o.MessageRequestTTL = viper.GetDuration(configMessageRequestTTL)
 	o.MessageTTL = viper.GetDuration(configMessageTTL)
+	o.AlertGroupBy = viper.GetStringSlice(configAlertGroupBy)
+	o.AlertGroupWait = viper.GetDuration(configAlertGroupWait)
+	o.AlertRepeatInterval = viper.GetDuration(configAlertRepeatInterval)
+	if o.AlertGroupWait < 0 {
+		errs = append(errs, fmt.Errorf("--%s cannot be negative", flagAlertGroupWait))
+	}
+	if o.AlertRepeatInterval < 0 {
+		errs = append(errs, fmt.Errorf("--%s cannot be negative", flagAlertRepeatInterval))
+	}
 
 	return errs
 }
@@ -96,11 +96,13 @@ func createAPIServerConfig(cfg *config.Config) *apiserver.Config {
 		ExtraConfig: apiserver.ExtraConfig{
 			ServerName:              cfg.ServerName,
 			VersionedInformers:      cfg.VersionedSharedInformerFactory,
+			PlatformClient:          cfg.PlatformClient,
 			StorageFactory:          cfg.StorageFactory,
 			APIResourceConfigSource: cfg.StorageFactory.APIResourceConfigSource,
 			PrivilegedUsername:      cfg.PrivilegedUsername,
 			MessageRequestTTL:       cfg.MessageRequestTTL,
 			MessageTTL:              cfg.MessageTTL,
+			AlertConfig:             cfg.AlertConfig,
 		},
 	}
 }
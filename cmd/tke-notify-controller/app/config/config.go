@@ -29,6 +29,7 @@ import (
 	"tkestack.io/tke/cmd/tke-notify-controller/app/options"
 	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
+	messagerequestconfig "tkestack.io/tke/pkg/notify/controller/messagerequest/config"
 )
 
 // Config is the running configuration structure of the TKE controller manager.
@@ -43,7 +44,13 @@ type Config struct {
 	LeaderElectionClient *versionedclientset.Clientset
 	// the rest config for the notify apiserver
 	NotifyAPIServerClientConfig *restclient.Config
-	Component                   controlleroptions.ComponentConfiguration
+	// the rest config for the kubernetes apiserver where tke is deployed,
+	// it is used to read the secrets referenced by channels
+	KubeAPIServerClientConfig *restclient.Config
+	Component                 controlleroptions.ComponentConfiguration
+	// MessageRequestController holds configuration for MessageRequestController
+	// related features.
+	MessageRequestController messagerequestconfig.MessageRequestControllerConfiguration
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -61,6 +68,11 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, fmt.Errorf("failed to initialize client config of notify API server")
 	}
 
+	kubeAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.KubeAPIClient)
+	if err != nil {
+		return nil, err
+	}
+
 	// shallow copy, do not modify the apiServerClientConfig.Timeout.
 	config := *notifyAPIServerClientConfig
 	config.Timeout = opts.Component.LeaderElection.RenewDeadline
@@ -70,6 +82,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		ServerName:                  serverName,
 		LeaderElectionClient:        leaderElectionClient,
 		NotifyAPIServerClientConfig: notifyAPIServerClientConfig,
+		KubeAPIServerClientConfig:   kubeAPIServerClientConfig,
 		Authorization: apiserver.AuthorizationInfo{
 			Authorizer: authorizerfactory.NewAlwaysAllowAuthorizer(),
 		},
@@ -87,5 +100,8 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	if err := opts.MessageRequestController.ApplyTo(&controllerManagerConfig.MessageRequestController); err != nil {
+		return nil, err
+	}
 	return controllerManagerConfig, nil
 }
@@ -20,6 +20,8 @@ package app
 
 import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
 	"net/http"
 	"time"
 	"tkestack.io/tke/api/notify/v1"
@@ -57,10 +59,17 @@ func startMessageRequestController(ctx ControllerContext) (http.Handler, bool, e
 		return nil, false, nil
 	}
 
+	var kubeClient kubernetes.Interface
+	if ctx.Config.KubeAPIServerClientConfig != nil {
+		kubeClient = kubernetes.NewForConfigOrDie(restclient.AddUserAgent(ctx.Config.KubeAPIServerClientConfig, "message-request-controller"))
+	}
+
 	ctrl := messagerequest.NewController(
 		ctx.ClientBuilder.ClientOrDie("message-request-controller"),
+		kubeClient,
 		ctx.InformerFactory.Notify().V1().MessageRequests(),
 		messageRequestSyncPeriod,
+		&ctx.Config.MessageRequestController,
 	)
 
 	go ctrl.Run(concurrentMessageRequestSyncs, ctx.Stop)
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	messagerequestconfig "tkestack.io/tke/pkg/notify/controller/messagerequest/config"
+)
+
+const (
+	defaultMessageRequestRetryBaseDelay = 30 * time.Second
+	defaultMessageRequestRetryMaxDelay  = 30 * time.Minute
+	defaultMessageRequestMaxAttempts    = 5
+)
+
+const (
+	flagMessageRequestRetryBaseDelay = "message-request-retry-base-delay"
+	flagMessageRequestRetryMaxDelay  = "message-request-retry-max-delay"
+	flagMessageRequestMaxAttempts    = "message-request-max-attempts"
+)
+
+const (
+	configMessageRequestRetryBaseDelay = "controller.message_request_retry_base_delay"
+	configMessageRequestRetryMaxDelay  = "controller.message_request_retry_max_delay"
+	configMessageRequestMaxAttempts    = "controller.message_request_max_attempts"
+)
+
+// MessageRequestControllerOptions holds the MessageRequestController options.
+type MessageRequestControllerOptions struct {
+	*messagerequestconfig.MessageRequestControllerConfiguration
+}
+
+// NewMessageRequestControllerOptions creates a new Options with a default config.
+func NewMessageRequestControllerOptions() *MessageRequestControllerOptions {
+	return &MessageRequestControllerOptions{
+		&messagerequestconfig.MessageRequestControllerConfiguration{
+			RetryBaseDelay: defaultMessageRequestRetryBaseDelay,
+			RetryMaxDelay:  defaultMessageRequestRetryMaxDelay,
+			MaxAttempts:    defaultMessageRequestMaxAttempts,
+		},
+	}
+}
+
+// AddFlags adds flags related to MessageRequestController for controller manager to the specified FlagSet.
+func (o *MessageRequestControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.DurationVar(&o.RetryBaseDelay, flagMessageRequestRetryBaseDelay, o.RetryBaseDelay, "The delay before retrying a failed message receiver, doubled on every subsequent attempt")
+	_ = viper.BindPFlag(configMessageRequestRetryBaseDelay, fs.Lookup(flagMessageRequestRetryBaseDelay))
+	fs.DurationVar(&o.RetryMaxDelay, flagMessageRequestRetryMaxDelay, o.RetryMaxDelay, "The maximum delay between two delivery attempts of a message receiver")
+	_ = viper.BindPFlag(configMessageRequestRetryMaxDelay, fs.Lookup(flagMessageRequestRetryMaxDelay))
+	fs.Int32Var(&o.MaxAttempts, flagMessageRequestMaxAttempts, o.MaxAttempts, "The maximum number of delivery attempts per message receiver before the message request is dead-lettered, 1 disables retrying")
+	_ = viper.BindPFlag(configMessageRequestMaxAttempts, fs.Lookup(flagMessageRequestMaxAttempts))
+}
+
+// ApplyTo fills up MessageRequestController config with options.
+func (o *MessageRequestControllerOptions) ApplyTo(cfg *messagerequestconfig.MessageRequestControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.RetryBaseDelay = o.RetryBaseDelay
+	cfg.RetryMaxDelay = o.RetryMaxDelay
+	cfg.MaxAttempts = o.MaxAttempts
+
+	return nil
+}
+
+// Validate checks validation of MessageRequestControllerOptions.
+func (o *MessageRequestControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	var errs []error
+	if o.RetryBaseDelay <= 0 {
+		errs = append(errs, fmt.Errorf("--%s must be greater than 0", flagMessageRequestRetryBaseDelay))
+	}
+	if o.RetryMaxDelay < o.RetryBaseDelay {
+		errs = append(errs, fmt.Errorf("--%s must not be less than --%s", flagMessageRequestRetryMaxDelay, flagMessageRequestRetryBaseDelay))
+	}
+	if o.MaxAttempts < 1 {
+		errs = append(errs, fmt.Errorf("--%s must be at least 1", flagMessageRequestMaxAttempts))
+	}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *MessageRequestControllerOptions) ApplyFlags() []error {
+	o.RetryBaseDelay = viper.GetDuration(configMessageRequestRetryBaseDelay)
+	o.RetryMaxDelay = viper.GetDuration(configMessageRequestRetryMaxDelay)
+	o.MaxAttempts = viper.GetInt32(configMessageRequestMaxAttempts)
+	return o.Validate()
+}
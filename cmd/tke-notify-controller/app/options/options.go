@@ -27,21 +27,25 @@ import (
 
 // Options is the main context object for the TKE controller manager.
 type Options struct {
-	Log             *log.Options
-	Debug           *apiserveroptions.DebugOptions
-	SecureServing   *apiserveroptions.SecureServingOptions
-	Component       *controlleroptions.ComponentOptions
-	NotifyAPIClient *controlleroptions.APIServerClientOptions
+	Log                      *log.Options
+	Debug                    *apiserveroptions.DebugOptions
+	SecureServing            *apiserveroptions.SecureServingOptions
+	Component                *controlleroptions.ComponentOptions
+	NotifyAPIClient          *controlleroptions.APIServerClientOptions
+	KubeAPIClient            *controlleroptions.APIServerClientOptions
+	MessageRequestController *MessageRequestControllerOptions
 }
 
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string, allControllers []string, disabledByDefaultControllers []string) *Options {
 	return &Options{
-		Log:             log.NewOptions(),
-		Debug:           apiserveroptions.NewDebugOptions(),
-		SecureServing:   apiserveroptions.NewSecureServingOptions(serverName, 9459),
-		Component:       controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
-		NotifyAPIClient: controlleroptions.NewAPIServerClientOptions("notify", true),
+		Log:                      log.NewOptions(),
+		Debug:                    apiserveroptions.NewDebugOptions(),
+		SecureServing:            apiserveroptions.NewSecureServingOptions(serverName, 9459),
+		Component:                controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
+		NotifyAPIClient:          controlleroptions.NewAPIServerClientOptions("notify", true),
+		KubeAPIClient:            controlleroptions.NewAPIServerClientOptions("kube", false),
+		MessageRequestController: NewMessageRequestControllerOptions(),
 	}
 }
 
@@ -52,6 +56,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.SecureServing.AddFlags(fs)
 	o.Component.AddFlags(fs)
 	o.NotifyAPIClient.AddFlags(fs)
+	o.KubeAPIClient.AddFlags(fs)
+	o.MessageRequestController.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -64,6 +70,8 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.SecureServing.ApplyFlags()...)
 	errs = append(errs, o.Component.ApplyFlags()...)
 	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.KubeAPIClient.ApplyFlags()...)
+	errs = append(errs, o.MessageRequestController.ApplyFlags()...)
 
 	return errs
 }
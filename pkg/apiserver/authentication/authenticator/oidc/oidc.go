@@ -53,8 +53,47 @@ const (
 	// DisplayNameKey defines the key representing the user display name in the additional
 	// information mapping table of the user information.
 	DisplayNameKey = "displayname"
+
+	// MultiFactorKey defines the key representing whether the user logged in
+	// with a second factor in the additional information mapping table of the
+	// user information. It is only set from the MultiFactorGroup of tokens.
+	MultiFactorKey = "mfa"
+
+	// ReservedGroupPrefix is the prefix of the groups reserved for the token
+	// issuer, which are stripped from the groups of upstream providers.
+	ReservedGroupPrefix = MultiFactorKey + ":"
+
+	// MultiFactorGroup is the group the token issuer puts the users who logged
+	// in with a second factor in.
+	MultiFactorGroup = ReservedGroupPrefix + "true"
 )
 
+// StripReservedGroups returns the groups without the ones reserved for the
+// token issuer.
+func StripReservedGroups(groups []string) []string {
+	stripped, _ := SplitReservedGroups(groups)
+	return stripped
+}
+
+// SplitReservedGroups returns the groups without the ones reserved for the
+// token issuer, and whether the user is in the MultiFactorGroup.
+func SplitReservedGroups(groups []string) ([]string, bool) {
+	var (
+		stripped    = make([]string, 0, len(groups))
+		multiFactor bool
+	)
+	for _, group := range groups {
+		if !strings.HasPrefix(group, ReservedGroupPrefix) {
+			stripped = append(stripped, group)
+			continue
+		}
+		if group == MultiFactorGroup {
+			multiFactor = true
+		}
+	}
+	return stripped, multiFactor
+}
+
 // Options defines the configuration options needed to initialize OpenID
 // Connect authentication.
 type Options struct {
@@ -574,7 +613,11 @@ func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*a
 			if err := c.unmarshalClaim(a.groupsClaim, &groups); err != nil {
 				return nil, false, fmt.Errorf("oidc: parse groups claim %q: %v", a.groupsClaim, err)
 			}
-			info.Groups = groups
+			var multiFactor bool
+			info.Groups, multiFactor = SplitReservedGroups(groups)
+			if multiFactor {
+				info.Extra[MultiFactorKey] = []string{"true"}
+			}
 		}
 	}
 
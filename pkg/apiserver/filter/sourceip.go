@@ -0,0 +1,161 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package filter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+const (
+	sourceIPContextKey = "sourceIP"
+
+	// SourceIPExtraKey is the key of the user extra carrying the source ip of
+	// the request, which is set by the server for the webhook authorizers.
+	SourceIPExtraKey = "sourceip"
+)
+
+var (
+	trustedProxiesLock sync.RWMutex
+	trustedProxies     []*net.IPNet
+)
+
+// SetTrustedProxies sets the cidrs of the proxies whose X-Forwarded-For and
+// X-Real-Ip headers are trusted to carry the ip address of the client.
+func SetTrustedProxies(cidrs []string) error {
+	var nets []*net.IPNet
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy cidr %q: %v", cidr, err)
+		}
+		nets = append(nets, ipNet)
+	}
+
+	trustedProxiesLock.Lock()
+	defer trustedProxiesLock.Unlock()
+	trustedProxies = nets
+	return nil
+}
+
+// WithSourceIP adds the ip address of the client to the context of the http
+// access chain.
+func WithSourceIP(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if ip := clientIP(req); ip != nil {
+			req = req.WithContext(WithSourceIPValue(req.Context(), ip))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// WithSourceIPExtra replaces the source ip extra of the request user with the
+// source ip of the request, so that the webhook authorizers reviewing the
+// access of the user know the address the request comes from, and the extra
+// can not be supplied by the client.
+func WithSourceIPExtra(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		if u, ok := genericrequest.UserFrom(ctx); ok {
+			extra := make(map[string][]string, len(u.GetExtra())+1)
+			for k, v := range u.GetExtra() {
+				if k != SourceIPExtraKey {
+					extra[k] = v
+				}
+			}
+			if ip := SourceIPFrom(ctx); ip != nil {
+				extra[SourceIPExtraKey] = []string{ip.String()}
+			}
+			req = req.WithContext(genericrequest.WithUser(ctx, &user.DefaultInfo{
+				Name:   u.GetName(),
+				UID:    u.GetUID(),
+				Groups: u.GetGroups(),
+				Extra:  extra,
+			}))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// clientIP returns the remote address of the request. The forwarded headers
+// are only honoured if the request comes from a trusted proxy, in which case
+// the X-Forwarded-For is walked from the right to the first hop which is not
+// a trusted proxy.
+func clientIP(req *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !trustedProxy(ip) {
+		return ip
+	}
+
+	if forwardedFor := req.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := net.ParseIP(strings.TrimSpace(hops[i]))
+			if hop == nil {
+				break
+			}
+			ip = hop
+			if !trustedProxy(hop) {
+				break
+			}
+		}
+		return ip
+	}
+
+	if realIP := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-Ip"))); realIP != nil {
+		return realIP
+	}
+	return ip
+}
+
+func trustedProxy(ip net.IP) bool {
+	trustedProxiesLock.RLock()
+	defer trustedProxiesLock.RUnlock()
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithSourceIPValue returns a copy of the context carrying the source ip.
+func WithSourceIPValue(ctx context.Context, ip net.IP) context.Context {
+	return genericrequest.WithValue(ctx, sourceIPContextKey, ip)
+}
+
+// SourceIPFrom get the ip address of the client from request context.
+func SourceIPFrom(ctx context.Context) net.IP {
+	ip, ok := ctx.Value(sourceIPContextKey).(net.IP)
+	if !ok {
+		return nil
+	}
+	return ip
+}
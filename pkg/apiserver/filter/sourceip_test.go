@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package filter
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+func TestWithSourceIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		remoteAddr     string
+		headers        map[string]string
+		expected       string
+	}{
+		{
+			name:       "remote address",
+			remoteAddr: "10.0.0.1:34567",
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "spoofed forwarded for",
+			remoteAddr: "10.0.0.1:34567",
+			headers:    map[string]string{"X-Forwarded-For": "192.168.1.1"},
+			expected:   "10.0.0.1",
+		},
+		{
+			name:       "spoofed real ip",
+			remoteAddr: "10.0.0.1:34567",
+			headers:    map[string]string{"X-Real-Ip": "192.168.1.1"},
+			expected:   "10.0.0.1",
+		},
+		{
+			name:           "forwarded by untrusted proxy",
+			trustedProxies: []string{"172.16.0.0/16"},
+			remoteAddr:     "10.0.0.1:34567",
+			headers:        map[string]string{"X-Forwarded-For": "192.168.1.1"},
+			expected:       "10.0.0.1",
+		},
+		{
+			name:           "forwarded by trusted proxy",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.1:34567",
+			headers:        map[string]string{"X-Forwarded-For": "192.168.1.1"},
+			expected:       "192.168.1.1",
+		},
+		{
+			name:           "spoofed hops before trusted proxies",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.1:34567",
+			headers:        map[string]string{"X-Forwarded-For": "1.1.1.1, 192.168.1.1, 10.0.0.2"},
+			expected:       "192.168.1.1",
+		},
+		{
+			name:           "real ip of trusted proxy",
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.1:34567",
+			headers:        map[string]string{"X-Real-Ip": "192.168.1.1"},
+			expected:       "192.168.1.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetTrustedProxies(tt.trustedProxies); err != nil {
+				t.Fatal(err)
+			}
+			defer func() { _ = SetTrustedProxies(nil) }()
+
+			var actual net.IP
+			handler := WithSourceIP(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				actual = SourceIPFrom(req.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			if actual.String() != tt.expected {
+				t.Errorf("expected source ip %s, got %s", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWithSourceIPExtra(t *testing.T) {
+	var actual user.Info
+	handler := WithSourceIPExtra(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		actual, _ = genericrequest.UserFrom(req.Context())
+	}))
+	handler = WithSourceIP(handler)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:34567"
+	req = req.WithContext(genericrequest.WithUser(req.Context(), &user.DefaultInfo{
+		Name:  "alice",
+		Extra: map[string][]string{SourceIPExtraKey: {"192.168.1.1"}, "tenantid": {"default"}},
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	expected := map[string][]string{SourceIPExtraKey: {"10.0.0.1"}, "tenantid": {"default"}}
+	if actual == nil || !reflect.DeepEqual(actual.GetExtra(), expected) {
+		t.Errorf("expected extra %v, got %v", expected, actual)
+	}
+}
+
+func TestSetTrustedProxiesInvalid(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.1"}); err == nil {
+		t.Error("expected error for an address without prefix length")
+	}
+}
@@ -39,7 +39,11 @@ func BuildHandlerChain(ignoreAuthPathPrefixes []string, ignoreAuthzPathPrefixes
 		handler := authfilter.WithInspectors(apiHandler, inspectors, c)
 		handler = authfilter.WithTKEAuthorization(handler, c.Authorization.Authorizer, c.Serializer, append(ignoreAuthPathPrefixes, ignoreAuthzPathPrefixes...))
 		handler = genericfilters.WithMaxInFlightLimit(handler, c.MaxRequestsInFlight, c.MaxMutatingRequestsInFlight, c.LongRunningFunc)
+		// The source ip extra is set for both the impersonated user and the
+		// impersonating user, whose accesses are reviewed by the authorizer.
+		handler = apiserverfilter.WithSourceIPExtra(handler)
 		handler = genericapifilters.WithImpersonation(handler, c.Authorization.Authorizer, c.Serializer)
+		handler = apiserverfilter.WithSourceIPExtra(handler)
 		handler = genericapifilters.WithAudit(handler, c.AuditBackend, c.AuditPolicyRuleEvaluator, c.LongRunningFunc)
 		failedHandler := genericapifilters.Unauthorized(c.Serializer)
 		failedHandler = genericapifilters.WithFailedAuthenticationAudit(failedHandler, c.AuditBackend, c.AuditPolicyRuleEvaluator)
@@ -88,6 +92,7 @@ func BuildHandlerChain(ignoreAuthPathPrefixes []string, ignoreAuthzPathPrefixes
 		handler = genericapifilters.WithRequestInfo(handler, c.RequestInfoResolver)
 		handler = apiserverfilter.WithLocal(handler)
 		handler = apiserverfilter.WithRequestID(handler)
+		handler = apiserverfilter.WithSourceIP(handler)
 		handler = apiserverfilter.WithProject(handler)
 		handler = genericfilters.WithPanicRecovery(handler, c.RequestInfoResolver)
 		return handler
@@ -28,6 +28,7 @@ import (
 	netutil "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
 	genericserveroptions "k8s.io/apiserver/pkg/server/options"
+	"tkestack.io/tke/pkg/apiserver/filter"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -43,6 +44,7 @@ const (
 	flagRequestTimeout              = "request-timeout"
 	flagMaxMutatingRequestsInflight = "max-mutating-requests-inflight"
 	flagMaxRequestsInflight         = "max-requests-inflight"
+	flagTrustedProxyCIDRs           = "trusted-proxy-cidrs"
 )
 
 const (
@@ -56,6 +58,7 @@ const (
 	configRequestTimeout              = "generic.request_timeout"
 	configMaxMutatingRequestsInflight = "generic.max_mutating_requests_inflight"
 	configMaxRequestsInflight         = "generic.max_requests_inflight"
+	configTrustedProxyCIDRs           = "generic.trusted_proxy_cidrs"
 )
 
 // GenericOptions contains the options while running a generic api server.
@@ -64,6 +67,9 @@ type GenericOptions struct {
 	ExternalPort   int
 	ExternalScheme string
 	ExternalCAFile string
+	// TrustedProxyCIDRs are the cidrs of the proxies whose forwarded headers
+	// are trusted to carry the ip address of the client.
+	TrustedProxyCIDRs []string
 }
 
 // NewGenericOptions creates a Options object with default parameters.
@@ -92,6 +98,11 @@ func (o *GenericOptions) AddFlags(fs *pflag.FlagSet) {
 		"The CA file to use when generating externalized URLs for this server.")
 	_ = viper.BindPFlag(configExternalCAFile, fs.Lookup(flagExternalCAFile))
 
+	fs.StringSlice(flagTrustedProxyCIDRs, o.TrustedProxyCIDRs,
+		"The CIDRs of the proxies in front of this server whose X-Forwarded-For and X-Real-Ip headers are trusted to carry the client IP. "+
+			"If empty, the client IP is always the remote address of the connection.")
+	_ = viper.BindPFlag(configTrustedProxyCIDRs, fs.Lookup(flagTrustedProxyCIDRs))
+
 	_ = viper.BindPFlag(configAdvertiseAddress, fs.Lookup(flagAdvertiseAddress))
 	_ = viper.BindPFlag(configCORSAllowedOrigins, fs.Lookup(flagCORSAllowedOrigins))
 	_ = viper.BindPFlag(configExternalHostname, fs.Lookup(flagExternalHostname))
@@ -116,6 +127,11 @@ func (o *GenericOptions) ApplyFlags() []error {
 	o.MaxMutatingRequestsInFlight = viper.GetInt(configMaxMutatingRequestsInflight)
 	o.MaxRequestsInFlight = viper.GetInt(configMaxRequestsInflight)
 	o.MinRequestTimeout = viper.GetInt(configMinRequestTimeout)
+	o.TrustedProxyCIDRs = viper.GetStringSlice(configTrustedProxyCIDRs)
+
+	if err := filter.SetTrustedProxies(o.TrustedProxyCIDRs); err != nil {
+		errs = append(errs, err)
+	}
 
 	if validateErrs := o.Validate(); len(validateErrs) > 0 {
 		errs = append(errs, validateErrs...)
@@ -21,6 +21,7 @@ package api
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
@@ -29,28 +30,32 @@ import (
 	"io/ioutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/apis/audit"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
-	"tkestack.io/tke/api/auth"
-	"tkestack.io/tke/api/business"
-	"tkestack.io/tke/api/monitor"
-	"tkestack.io/tke/api/notify"
-	"tkestack.io/tke/api/platform"
-	"tkestack.io/tke/api/registry"
-	"tkestack.io/tke/api/application"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
 	auditconfigv1 "tkestack.io/tke/pkg/audit/apis/config/v1"
+	"tkestack.io/tke/pkg/audit/apis/config/validation"
 	"tkestack.io/tke/pkg/audit/config/codec"
 	"tkestack.io/tke/pkg/audit/config/configfiles"
+	"tkestack.io/tke/pkg/audit/policy"
+	"tkestack.io/tke/pkg/audit/rule"
 	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/chain"
+	"tkestack.io/tke/pkg/audit/storage/clickhouse"
 	"tkestack.io/tke/pkg/audit/storage/es"
+	"tkestack.io/tke/pkg/audit/storage/file"
+	"tkestack.io/tke/pkg/audit/storage/loki"
 	"tkestack.io/tke/pkg/audit/storage/types"
 	utilfs "tkestack.io/tke/pkg/util/filesystem"
 	"tkestack.io/tke/pkg/util/log"
@@ -64,10 +69,16 @@ const Version = "v1"
 
 const blockKey = "block-clusters.txt"
 
+const checkpointConfigMap = "tke-audit-checkpoints"
+
+// defaultMaxDelay is how long an event may be received before it is stored,
+// the storages which only index the received time search the events received
+// earlier than the verified range by up to it.
+const defaultMaxDelay = 10 * time.Minute
+
 // ClusterControlPlane is the cluster name the tkestack control-planes like tke-platform-api will use to report audit events
-const ClusterControlPlane = "control-plane"
+const ClusterControlPlane = policy.ClusterControlPlane
 
-var controlPlaneGroups sets.String
 var k8sClient kubernetes.Interface
 
 var (
@@ -75,18 +86,14 @@ var (
 	storeCli      storage.AuditStorage
 	blockClusters sets.String
 	storeConf     auditconfig.Storage
+	integrity     auditconfig.Integrity
+	signingKey    []byte
+	ruleEvaluator *rule.Evaluator
+	policyConf    *auditconfig.Policy
+	auditPolicy   *policy.Policy
 )
 
 func init() {
-	controlPlaneGroups = sets.NewString(
-		platform.GroupName,
-		registry.GroupName,
-		notify.GroupName,
-		monitor.GroupName,
-		business.GroupName,
-		auth.GroupName,
-		application.GroupName,
-	)
 	k8sClient = initK8sClient()
 	initWatcher()
 
@@ -125,28 +132,27 @@ func watchEvent(w *fsnotify.Watcher) {
 
 			kc := loadConfig()
 			if kc != nil {
-				var changed = func(a, b *auditconfig.ElasticSearchStorage) bool {
-					if a.Username == b.Username && a.Password == b.Password &&
-						a.ReserveDays == b.ReserveDays && a.Address == b.Address &&
-						a.Indices == b.Indices {
-						return false
-					}
-					return true
-				}
-				if changed(kc.Storage.ElasticSearch, storeConf.ElasticSearch) {
+				if !reflect.DeepEqual(kc.Storage, storeConf) {
 					klog.Infof("store config changed: %v", kc.Storage)
-					esCli, err := es.NewStorage(kc.Storage.ElasticSearch)
+					cli, err := newChainedStorage(&kc.Storage)
 					if err != nil {
-						klog.Errorf("failed init es client: %v", err)
+						klog.Errorf("failed init store client: %v", err)
 						continue
 					}
 					storeConf = kc.Storage
 					storeCli.Stop()
-					storeCli = esCli
+					storeCli = cli
 					storeCli.Start()
 				} else {
 					klog.Infof("store config not changed")
 				}
+				if !reflect.DeepEqual(kc.Policy, policyConf) {
+					if err := reloadPolicy(kc.Policy); err != nil {
+						klog.Errorf("failed reload audit policy: %v", err)
+					} else {
+						klog.Infof("audit policy reloaded")
+					}
+				}
 			} else {
 				klog.Errorf("load store config failed")
 			}
@@ -177,6 +183,56 @@ func loadConfig() *auditconfig.AuditConfiguration {
 	return kc
 }
 
+// reloadPolicy validates and compiles the audit policy, the policy in use is
+// kept if it is invalid.
+func reloadPolicy(conf *auditconfig.Policy) error {
+	if conf != nil {
+		if err := validation.ValidatePolicy(conf, field.NewPath("policy")); err != nil {
+			return err
+		}
+	}
+	compiled := policy.New(conf)
+	l.Lock()
+	defer l.Unlock()
+	policyConf = conf
+	auditPolicy = compiled
+	return nil
+}
+
+// newStorage creates the audit storage of the backend selected by the store
+// config.
+func newStorage(store *auditconfig.Storage) (storage.AuditStorage, error) {
+	if err := validation.ValidateStorage(store, field.NewPath("storage")); err != nil {
+		return nil, err
+	}
+	switch {
+	case store.File != nil:
+		return file.NewStorage(store.File)
+	case store.Loki != nil:
+		return loki.NewStorage(store.Loki)
+	case store.ClickHouse != nil:
+		return clickhouse.NewStorage(store.ClickHouse)
+	default:
+		return es.NewStorage(store.ElasticSearch)
+	}
+}
+
+// newChainedStorage creates the audit storage whose saved events are hash
+// chained, the events are not chained if there is no signing key.
+func newChainedStorage(store *auditconfig.Storage) (storage.AuditStorage, error) {
+	cli, err := newStorage(store)
+	if err != nil {
+		return nil, err
+	}
+	if len(signingKey) == 0 {
+		log.Warn("The integrity signing key is not configured, the audit events are not chained")
+		return cli, nil
+	}
+	checkpoints := chain.NewConfigMapStore(k8sClient, "tke", checkpointConfigMap)
+	interval := time.Duration(integrity.CheckpointIntervalMinutes) * time.Minute
+	return chain.NewStorage(cli, checkpoints, signingKey, interval), nil
+}
+
 func loadBlockClusters() []string {
 	data, err := ioutil.ReadFile(fmt.Sprintf("/app/conf/%s", blockKey))
 	if err != nil {
@@ -187,22 +243,35 @@ func loadBlockClusters() []string {
 
 // RegisterRoute is used to register prefix path routing matches for all
 // configured backend components.
-func RegisterRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration) error {
-	return registerAuditRoute(container, cfg)
+func RegisterRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration, notifyClient notifyversionedclient.NotifyV1Interface, platformClient platformversionedclient.PlatformV1Interface) error {
+	return registerAuditRoute(container, cfg, notifyClient, platformClient)
 }
 
-func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration) error {
+func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration, notifyClient notifyversionedclient.NotifyV1Interface, platformClient platformversionedclient.PlatformV1Interface) error {
 	ws := new(restful.WebService)
 	ws.Path(fmt.Sprintf("/apis/%s/%s/events", GroupName, Version))
 	ws.Produces(restful.MIME_JSON)
 	ws.Consumes(restful.MIME_JSON, "text/csv")
 	var err error
 	storeConf = cfg.Storage
-	storeCli, err = es.NewStorage(cfg.Storage.ElasticSearch)
+	if cfg.Integrity != nil {
+		integrity = *cfg.Integrity
+		if signingKey, err = base64.StdEncoding.DecodeString(integrity.SigningKey); err != nil {
+			return fmt.Errorf("decode signing key failed: %v", err)
+		}
+	}
+	storeCli, err = newChainedStorage(&cfg.Storage)
 	if err != nil {
 		return err
 	}
 	storeCli.Start()
+	if err := reloadPolicy(cfg.Policy); err != nil {
+		return err
+	}
+	if notifyClient != nil {
+		ruleEvaluator = rule.NewEvaluator(notifyClient, platformClient)
+		ruleEvaluator.Start()
+	}
 	ws.Route(ws.POST("/sink/{clusterName}").To(sinkEvents).
 		Operation("createEventsByCluster").
 		Doc("Create new audit events").
@@ -213,6 +282,14 @@ func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConf
 		Doc("Create new audit events").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON))
+	ws.Route(ws.GET("/verify").To(verifyEvents).
+		Operation("verifyEvents").
+		Doc("verify the hash chain of the audit events stored within a time range").
+		Param(restful.QueryParameter("startTime", "start of the range in milliseconds, defaults to one day ago")).
+		Param(restful.QueryParameter("endTime", "end of the range in milliseconds, at most and defaults to now")).
+		Param(restful.QueryParameter("maxDelaySeconds", "how long an event may be received before it is stored, defaults to 600")).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON))
 	ws.Route(ws.GET("/listFieldValues").To(listFieldValues).
 		Operation("listFieldValues2").
 		Consumes(restful.MIME_JSON).
@@ -266,7 +343,12 @@ func sinkEvents(request *restful.Request, response *restful.Response) {
 		event.ClusterName = clusterName
 	}
 	events = eventsFilter(events)
-	err = storeCli.Save(events)
+	// the rules see every event of the clusters which are not blocked, the
+	// exclusions and the sampling of the policy only reduce what is stored
+	if ruleEvaluator != nil {
+		ruleEvaluator.Evaluate(events)
+	}
+	err = storeCli.Save(applyPolicy(events))
 	if err != nil {
 		log.Errorf("failed save events: %v", err)
 	}
@@ -276,36 +358,6 @@ func sinkEvents(request *restful.Request, response *restful.Response) {
 
 type filterFunc func(e *types.Event) bool
 
-func controlPlaneFilter(e *types.Event) bool {
-	if e.ClusterName == ClusterControlPlane {
-		if !controlPlaneGroups.Has(e.APIGroup) {
-			return false
-		}
-	}
-	return true
-}
-
-func userKubeletFilter(e *types.Event) bool {
-	if strings.HasPrefix(e.UserName, "system:node:") {
-		return false
-	}
-	return true
-}
-
-func kubesystemServiceAccountFilter(e *types.Event) bool {
-	if strings.HasPrefix(e.UserName, "system:serviceaccount:kube-system:") {
-		return false
-	}
-	return true
-}
-
-func tkePlatformControllerFilter(e *types.Event) bool {
-	if e.UserName == "admin" && e.Verb == "update" && e.Resource == "clusters" && strings.HasPrefix(e.UserAgent, "tke-platform-controller") {
-		return false
-	}
-	return true
-}
-
 func blockClustersFilter(e *types.Event) bool {
 	l.RLock()
 	defer l.RUnlock()
@@ -316,10 +368,6 @@ func blockClustersFilter(e *types.Event) bool {
 }
 
 var eventFilters = []filterFunc{
-	controlPlaneFilter,
-	userKubeletFilter,
-	kubesystemServiceAccountFilter,
-	tkePlatformControllerFilter,
 	blockClustersFilter,
 }
 
@@ -332,6 +380,7 @@ func eventFilter(e *types.Event) bool {
 	return true
 }
 
+// eventsFilter drops the events of the blocked clusters.
 func eventsFilter(events []*types.Event) []*types.Event {
 	var result []*types.Event
 	for i := range events {
@@ -342,6 +391,15 @@ func eventsFilter(events []*types.Event) []*types.Event {
 	return result
 }
 
+// applyPolicy applies the exclusions, the sampling and the retention of the
+// audit policy to the events to be stored.
+func applyPolicy(events []*types.Event) []*types.Event {
+	l.RLock()
+	p := auditPolicy
+	l.RUnlock()
+	return p.Apply(events)
+}
+
 func parseQueryParam(request *restful.Request) *storage.QueryParameter {
 	params := storage.QueryParameter{
 		ClusterName: request.QueryParameter("cluster"),
@@ -462,11 +520,7 @@ func listBlockClusters(request *restful.Request, response *restful.Response) {
 func configStore(request *restful.Request, response *restful.Response) {
 	store := auditconfig.Storage{}
 	request.ReadEntity(&store)
-	if store.ElasticSearch == nil {
-		writeStatusResponse(response, fmt.Errorf("store config not set"))
-		return
-	}
-	_, err := es.NewStorage(store.ElasticSearch)
+	_, err := newStorage(&store)
 	if err != nil {
 		writeStatusResponse(response, err)
 		return
@@ -494,20 +548,26 @@ func configStore(request *restful.Request, response *restful.Response) {
 }
 
 func getStoreConfig(request *restful.Request, response *restful.Response) {
-	conf := storeConf
-	conf.ElasticSearch.Username = "***"
-	conf.ElasticSearch.Password = "***"
+	conf := storeConf.DeepCopy()
+	if conf.ElasticSearch != nil {
+		conf.ElasticSearch.Username = "***"
+		conf.ElasticSearch.Password = "***"
+	}
+	if conf.Loki != nil {
+		conf.Loki.Username = "***"
+		conf.Loki.Password = "***"
+	}
+	if conf.ClickHouse != nil {
+		conf.ClickHouse.Username = "***"
+		conf.ClickHouse.Password = "***"
+	}
 	response.WriteAsJson(conf)
 }
 
 func testStoreConfig(request *restful.Request, response *restful.Response) {
 	store := auditconfig.Storage{}
 	request.ReadEntity(&store)
-	if store.ElasticSearch == nil {
-		writeStatusResponse(response, fmt.Errorf("store config not set"))
-		return
-	}
-	_, err := es.NewStorage(store.ElasticSearch)
+	_, err := newStorage(&store)
 	writeStatusResponse(response, err)
 }
 
@@ -555,6 +615,99 @@ func downloadEvents(request *restful.Request, response *restful.Response) {
 	}
 }
 
+// verifyBatchSize is the page size used to fetch the events to verify.
+const verifyBatchSize = 1000
+
+// defaultReserveDays is the default reserve days of the storages.
+const defaultReserveDays = 7
+
+// storageRetention returns the retention of the events removed by the
+// storage, nothing is known to expire in Loki whose retention is configured
+// in Loki itself.
+func storageRetention(store *auditconfig.Storage, now int64) chain.Retention {
+	reserveDays := func(days int) int {
+		if days <= 0 {
+			return defaultReserveDays
+		}
+		return days
+	}
+	switch {
+	case store.File != nil:
+		return chain.Retention{Now: now, ReserveDays: reserveDays(store.File.ReserveDays)}
+	case store.Loki != nil:
+		return chain.Retention{}
+	case store.ClickHouse != nil:
+		return chain.Retention{Now: now, ReserveDays: reserveDays(store.ClickHouse.ReserveDays)}
+	case store.ElasticSearch != nil && store.ElasticSearch.ReserveDays < 0:
+		return chain.Retention{Now: now, ReserveDays: defaultReserveDays}
+	case store.ElasticSearch != nil:
+		return chain.Retention{Now: now, ReserveDays: store.ElasticSearch.ReserveDays}
+	default:
+		return chain.Retention{}
+	}
+}
+
+func verifyEvents(request *restful.Request, response *restful.Response) {
+	if len(signingKey) == 0 {
+		writeStatusResponse(response, fmt.Errorf("the integrity signing key is not configured, the audit events are not chained"))
+		return
+	}
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	startTime := now - int64(24*time.Hour/time.Millisecond)
+	endTime := now
+	maxDelay := int64(defaultMaxDelay / time.Millisecond)
+	for name, value := range map[string]*int64{"startTime": &startTime, "endTime": &endTime, "maxDelaySeconds": &maxDelay} {
+		if v := request.QueryParameter(name); v != "" {
+			parsed, err := strconv.ParseInt(v, 10, 64)
+			if err != nil || parsed < 0 {
+				writeStatusResponse(response, fmt.Errorf("invalid %s: %s", name, v))
+				return
+			}
+			if name == "maxDelaySeconds" {
+				parsed *= 1000
+			}
+			*value = parsed
+		}
+	}
+	if endTime < startTime {
+		writeStatusResponse(response, fmt.Errorf("endTime must not be before startTime"))
+		return
+	}
+
+	// the range ends at the time of the request, so that the events saved
+	// while the pages are read are not verified
+	if endTime > now {
+		endTime = now
+	}
+	// the checkpoints recorded after the range still record the first events
+	// of the chains, which may have been saved within the range
+	checkpoints, err := chain.NewConfigMapStore(k8sClient, "tke", checkpointConfigMap).List(startTime, 0)
+	if err != nil {
+		writeStatusResponse(response, err)
+		return
+	}
+	l.RLock()
+	retention := storageRetention(&storeConf, now)
+	l.RUnlock()
+	verifier := chain.NewVerifier(checkpoints, signingKey, startTime, endTime, retention)
+	params := &storage.ChainParameter{StartTime: startTime, EndTime: endTime, MaxDelay: maxDelay, Size: verifyBatchSize}
+	for {
+		page, next, err := storeCli.ListChained(params)
+		if err != nil {
+			log.Errorf("failed to list chained events: %v", err)
+			writeStatusResponse(response, err)
+			return
+		}
+		verifier.Add(page)
+		if next == "" {
+			break
+		}
+		params.Continue = next
+	}
+	report := verifier.Report()
+	response.WriteEntity(VerifyResult{ResultStatus: ResultStatus{Code: 0, Message: ""}, Report: report})
+}
+
 func listFieldValues(request *restful.Request, response *restful.Response) {
 	result := storeCli.FieldValues()
 	response.WriteEntity(result)
@@ -582,6 +735,11 @@ type ResultStatus struct {
 	Message string `json:"message"`
 }
 
+type VerifyResult struct {
+	ResultStatus  `json:",inline"`
+	*chain.Report `json:",inline"`
+}
+
 type Pagination struct {
 	ResultStatus `json:",inline"`
 	Total        int            `json:"total"`
@@ -29,10 +29,94 @@ type AuditConfiguration struct {
 	metav1.TypeMeta
 
 	Storage Storage `json:"storage"`
+	// +optional
+	Integrity *Integrity `json:"integrity,omitempty"`
+	// Policy decides which audit events are stored and how long they are
+	// kept, the default policy is used if it is not specified.
+	// +optional
+	Policy *Policy `json:"policy,omitempty"`
 }
 
+// Policy filters and samples the audit events before they are stored.
+type Policy struct {
+	// Rules are evaluated in order and the first rule matching an event
+	// decides whether it is stored, an event matched by no rule is stored.
+	// +optional
+	Rules []PolicyRule `json:"rules"`
+	// ClusterRetentionDays keeps the events of the clusters for the days
+	// instead of the reserve days of the storage.
+	// +optional
+	ClusterRetentionDays map[string]int `json:"clusterRetentionDays,omitempty"`
+}
+
+// PolicyAction is the action of a policy rule.
+type PolicyAction string
+
+const (
+	// PolicyInclude stores the events matched by the rule.
+	PolicyInclude PolicyAction = "Include"
+	// PolicyExclude drops the events matched by the rule.
+	PolicyExclude PolicyAction = "Exclude"
+)
+
+// PolicyRule matches the audit events by their fields. An empty list matches
+// any value, and a value of a list may use * to match any characters.
+type PolicyRule struct {
+	// +optional
+	Name   string       `json:"name"`
+	Action PolicyAction `json:"action"`
+	// +optional
+	Users []string `json:"users,omitempty"`
+	// Groups match the events of the users in any of the groups.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+	// +optional
+	Verbs []string `json:"verbs,omitempty"`
+	// Resources are the resources of the events, a subresource is matched as
+	// the resource followed by a slash and the subresource, such as pods/exec.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// +optional
+	APIGroups []string `json:"apiGroups,omitempty"`
+	// +optional
+	Clusters []string `json:"clusters,omitempty"`
+	// +optional
+	UserAgents []string `json:"userAgents,omitempty"`
+	// RetentionDays keeps the included events for the days instead of the
+	// retention of their cluster or the storage.
+	// +optional
+	RetentionDays int `json:"retentionDays"`
+	// SamplingPercent is the percent of the included events which are
+	// stored, all of them are stored if it is 0.
+	// +optional
+	SamplingPercent int `json:"samplingPercent"`
+}
+
+// Integrity configures the checkpoints of the hash chain of the stored audit
+// events.
+type Integrity struct {
+	// SigningKey is the base64 encoded HMAC key which signs the checkpoints.
+	// The checkpoints are kept in a configmap, without the key anyone who can
+	// edit it could recompute the whole chain, so the events are only chained
+	// and verified if the integrity is configured with a key.
+	SigningKey string `json:"signingKey"`
+	// CheckpointIntervalMinutes is how often a checkpoint of the chain is
+	// recorded, defaults to 5.
+	// +optional
+	CheckpointIntervalMinutes int `json:"checkpointIntervalMinutes"`
+}
+
+// Storage selects the backend which stores the audit events, exactly one of
+// the backends must be specified.
 type Storage struct {
-	ElasticSearch *ElasticSearchStorage `json:"elasticSearch"`
+	// +optional
+	ElasticSearch *ElasticSearchStorage `json:"elasticSearch,omitempty"`
+	// +optional
+	File *FileStorage `json:"file,omitempty"`
+	// +optional
+	Loki *LokiStorage `json:"loki,omitempty"`
+	// +optional
+	ClickHouse *ClickHouseStorage `json:"clickHouse,omitempty"`
 }
 
 type ElasticSearchStorage struct {
@@ -46,3 +130,47 @@ type ElasticSearchStorage struct {
 	// +optional
 	Password string `json:"password"`
 }
+
+// FileStorage stores the audit events in rotating JSON lines files under a
+// local directory, which is usually a mounted persistent volume.
+type FileStorage struct {
+	Path string `json:"path"`
+	// +optional
+	ReserveDays int `json:"reserveDays"`
+	// MaxFileSizeMB is the size at which the current file is rotated.
+	// +optional
+	MaxFileSizeMB int `json:"maxFileSizeMB"`
+}
+
+// LokiStorage pushes the audit events to Loki and queries them with LogQL.
+type LokiStorage struct {
+	Address string `json:"address"`
+	// TenantID is sent as the X-Scope-OrgID header if Loki is multi-tenant.
+	// +optional
+	TenantID string `json:"tenantID"`
+	// ReserveDays is the time range searched by queries without a start
+	// time, the retention is configured in Loki itself so the retention days
+	// of the audit policy do not apply.
+	// +optional
+	ReserveDays int `json:"reserveDays"`
+	// +optional
+	Username string `json:"username"`
+	// +optional
+	Password string `json:"password"`
+}
+
+// ClickHouseStorage stores the audit events in a ClickHouse table through the
+// HTTP interface.
+type ClickHouseStorage struct {
+	Address string `json:"address"`
+	// +optional
+	Database string `json:"database"`
+	// +optional
+	Table string `json:"table"`
+	// +optional
+	ReserveDays int `json:"reserveDays"`
+	// +optional
+	Username string `json:"username"`
+	// +optional
+	Password string `json:"password"`
+}
@@ -47,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClickHouseStorage)(nil), (*config.ClickHouseStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage(a.(*ClickHouseStorage), b.(*config.ClickHouseStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClickHouseStorage)(nil), (*ClickHouseStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage(a.(*config.ClickHouseStorage), b.(*ClickHouseStorage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ElasticSearchStorage)(nil), (*config.ElasticSearchStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ElasticSearchStorage_To_config_ElasticSearchStorage(a.(*ElasticSearchStorage), b.(*config.ElasticSearchStorage), scope)
 	}); err != nil {
@@ -57,6 +67,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FileStorage)(nil), (*config.FileStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FileStorage_To_config_FileStorage(a.(*FileStorage), b.(*config.FileStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FileStorage)(nil), (*FileStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FileStorage_To_v1_FileStorage(a.(*config.FileStorage), b.(*FileStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Integrity)(nil), (*config.Integrity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Integrity_To_config_Integrity(a.(*Integrity), b.(*config.Integrity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Integrity)(nil), (*Integrity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Integrity_To_v1_Integrity(a.(*config.Integrity), b.(*Integrity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LokiStorage)(nil), (*config.LokiStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LokiStorage_To_config_LokiStorage(a.(*LokiStorage), b.(*config.LokiStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LokiStorage)(nil), (*LokiStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LokiStorage_To_v1_LokiStorage(a.(*config.LokiStorage), b.(*LokiStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Policy)(nil), (*config.Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Policy_To_config_Policy(a.(*Policy), b.(*config.Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Policy)(nil), (*Policy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Policy_To_v1_Policy(a.(*config.Policy), b.(*Policy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PolicyRule)(nil), (*config.PolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PolicyRule_To_config_PolicyRule(a.(*PolicyRule), b.(*config.PolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PolicyRule)(nil), (*PolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PolicyRule_To_v1_PolicyRule(a.(*config.PolicyRule), b.(*PolicyRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*config.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Storage_To_config_Storage(a.(*Storage), b.(*config.Storage), scope)
 	}); err != nil {
@@ -74,6 +134,8 @@ func autoConvert_v1_AuditConfiguration_To_config_AuditConfiguration(in *AuditCon
 	if err := Convert_v1_Storage_To_config_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Integrity = (*config.Integrity)(unsafe.Pointer(in.Integrity))
+	out.Policy = (*config.Policy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -86,6 +148,8 @@ func autoConvert_config_AuditConfiguration_To_v1_AuditConfiguration(in *config.A
 	if err := Convert_config_Storage_To_v1_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Integrity = (*Integrity)(unsafe.Pointer(in.Integrity))
+	out.Policy = (*Policy)(unsafe.Pointer(in.Policy))
 	return nil
 }
 
@@ -94,6 +158,36 @@ func Convert_config_AuditConfiguration_To_v1_AuditConfiguration(in *config.Audit
 	return autoConvert_config_AuditConfiguration_To_v1_AuditConfiguration(in, out, s)
 }
 
+func autoConvert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in *ClickHouseStorage, out *config.ClickHouseStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage is an autogenerated conversion function.
+func Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in *ClickHouseStorage, out *config.ClickHouseStorage, s conversion.Scope) error {
+	return autoConvert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in, out, s)
+}
+
+func autoConvert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in *config.ClickHouseStorage, out *ClickHouseStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage is an autogenerated conversion function.
+func Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in *config.ClickHouseStorage, out *ClickHouseStorage, s conversion.Scope) error {
+	return autoConvert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in, out, s)
+}
+
 func autoConvert_v1_ElasticSearchStorage_To_config_ElasticSearchStorage(in *ElasticSearchStorage, out *config.ElasticSearchStorage, s conversion.Scope) error {
 	out.Address = in.Address
 	out.Indices = in.Indices
@@ -122,8 +216,147 @@ func Convert_config_ElasticSearchStorage_To_v1_ElasticSearchStorage(in *config.E
 	return autoConvert_config_ElasticSearchStorage_To_v1_ElasticSearchStorage(in, out, s)
 }
 
+func autoConvert_v1_FileStorage_To_config_FileStorage(in *FileStorage, out *config.FileStorage, s conversion.Scope) error {
+	out.Path = in.Path
+	out.ReserveDays = in.ReserveDays
+	out.MaxFileSizeMB = in.MaxFileSizeMB
+	return nil
+}
+
+// Convert_v1_FileStorage_To_config_FileStorage is an autogenerated conversion function.
+func Convert_v1_FileStorage_To_config_FileStorage(in *FileStorage, out *config.FileStorage, s conversion.Scope) error {
+	return autoConvert_v1_FileStorage_To_config_FileStorage(in, out, s)
+}
+
+func autoConvert_config_FileStorage_To_v1_FileStorage(in *config.FileStorage, out *FileStorage, s conversion.Scope) error {
+	out.Path = in.Path
+	out.ReserveDays = in.ReserveDays
+	out.MaxFileSizeMB = in.MaxFileSizeMB
+	return nil
+}
+
+// Convert_config_FileStorage_To_v1_FileStorage is an autogenerated conversion function.
+func Convert_config_FileStorage_To_v1_FileStorage(in *config.FileStorage, out *FileStorage, s conversion.Scope) error {
+	return autoConvert_config_FileStorage_To_v1_FileStorage(in, out, s)
+}
+
+func autoConvert_v1_Integrity_To_config_Integrity(in *Integrity, out *config.Integrity, s conversion.Scope) error {
+	out.SigningKey = in.SigningKey
+	out.CheckpointIntervalMinutes = in.CheckpointIntervalMinutes
+	return nil
+}
+
+// Convert_v1_Integrity_To_config_Integrity is an autogenerated conversion function.
+func Convert_v1_Integrity_To_config_Integrity(in *Integrity, out *config.Integrity, s conversion.Scope) error {
+	return autoConvert_v1_Integrity_To_config_Integrity(in, out, s)
+}
+
+func autoConvert_config_Integrity_To_v1_Integrity(in *config.Integrity, out *Integrity, s conversion.Scope) error {
+	out.SigningKey = in.SigningKey
+	out.CheckpointIntervalMinutes = in.CheckpointIntervalMinutes
+	return nil
+}
+
+// Convert_config_Integrity_To_v1_Integrity is an autogenerated conversion function.
+func Convert_config_Integrity_To_v1_Integrity(in *config.Integrity, out *Integrity, s conversion.Scope) error {
+	return autoConvert_config_Integrity_To_v1_Integrity(in, out, s)
+}
+
+func autoConvert_v1_LokiStorage_To_config_LokiStorage(in *LokiStorage, out *config.LokiStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TenantID = in.TenantID
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_LokiStorage_To_config_LokiStorage is an autogenerated conversion function.
+func Convert_v1_LokiStorage_To_config_LokiStorage(in *LokiStorage, out *config.LokiStorage, s conversion.Scope) error {
+	return autoConvert_v1_LokiStorage_To_config_LokiStorage(in, out, s)
+}
+
+func autoConvert_config_LokiStorage_To_v1_LokiStorage(in *config.LokiStorage, out *LokiStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TenantID = in.TenantID
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_config_LokiStorage_To_v1_LokiStorage is an autogenerated conversion function.
+func Convert_config_LokiStorage_To_v1_LokiStorage(in *config.LokiStorage, out *LokiStorage, s conversion.Scope) error {
+	return autoConvert_config_LokiStorage_To_v1_LokiStorage(in, out, s)
+}
+
+func autoConvert_v1_Policy_To_config_Policy(in *Policy, out *config.Policy, s conversion.Scope) error {
+	out.Rules = *(*[]config.PolicyRule)(unsafe.Pointer(&in.Rules))
+	out.ClusterRetentionDays = *(*map[string]int)(unsafe.Pointer(&in.ClusterRetentionDays))
+	return nil
+}
+
+// Convert_v1_Policy_To_config_Policy is an autogenerated conversion function.
+func Convert_v1_Policy_To_config_Policy(in *Policy, out *config.Policy, s conversion.Scope) error {
+	return autoConvert_v1_Policy_To_config_Policy(in, out, s)
+}
+
+func autoConvert_config_Policy_To_v1_Policy(in *config.Policy, out *Policy, s conversion.Scope) error {
+	out.Rules = *(*[]PolicyRule)(unsafe.Pointer(&in.Rules))
+	out.ClusterRetentionDays = *(*map[string]int)(unsafe.Pointer(&in.ClusterRetentionDays))
+	return nil
+}
+
+// Convert_config_Policy_To_v1_Policy is an autogenerated conversion function.
+func Convert_config_Policy_To_v1_Policy(in *config.Policy, out *Policy, s conversion.Scope) error {
+	return autoConvert_config_Policy_To_v1_Policy(in, out, s)
+}
+
+func autoConvert_v1_PolicyRule_To_config_PolicyRule(in *PolicyRule, out *config.PolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = config.PolicyAction(in.Action)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.UserAgents = *(*[]string)(unsafe.Pointer(&in.UserAgents))
+	out.RetentionDays = in.RetentionDays
+	out.SamplingPercent = in.SamplingPercent
+	return nil
+}
+
+// Convert_v1_PolicyRule_To_config_PolicyRule is an autogenerated conversion function.
+func Convert_v1_PolicyRule_To_config_PolicyRule(in *PolicyRule, out *config.PolicyRule, s conversion.Scope) error {
+	return autoConvert_v1_PolicyRule_To_config_PolicyRule(in, out, s)
+}
+
+func autoConvert_config_PolicyRule_To_v1_PolicyRule(in *config.PolicyRule, out *PolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Action = PolicyAction(in.Action)
+	out.Users = *(*[]string)(unsafe.Pointer(&in.Users))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Verbs = *(*[]string)(unsafe.Pointer(&in.Verbs))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.APIGroups = *(*[]string)(unsafe.Pointer(&in.APIGroups))
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.UserAgents = *(*[]string)(unsafe.Pointer(&in.UserAgents))
+	out.RetentionDays = in.RetentionDays
+	out.SamplingPercent = in.SamplingPercent
+	return nil
+}
+
+// Convert_config_PolicyRule_To_v1_PolicyRule is an autogenerated conversion function.
+func Convert_config_PolicyRule_To_v1_PolicyRule(in *config.PolicyRule, out *PolicyRule, s conversion.Scope) error {
+	return autoConvert_config_PolicyRule_To_v1_PolicyRule(in, out, s)
+}
+
 func autoConvert_v1_Storage_To_config_Storage(in *Storage, out *config.Storage, s conversion.Scope) error {
 	out.ElasticSearch = (*config.ElasticSearchStorage)(unsafe.Pointer(in.ElasticSearch))
+	out.File = (*config.FileStorage)(unsafe.Pointer(in.File))
+	out.Loki = (*config.LokiStorage)(unsafe.Pointer(in.Loki))
+	out.ClickHouse = (*config.ClickHouseStorage)(unsafe.Pointer(in.ClickHouse))
 	return nil
 }
 
@@ -134,6 +367,9 @@ func Convert_v1_Storage_To_config_Storage(in *Storage, out *config.Storage, s co
 
 func autoConvert_config_Storage_To_v1_Storage(in *config.Storage, out *Storage, s conversion.Scope) error {
 	out.ElasticSearch = (*ElasticSearchStorage)(unsafe.Pointer(in.ElasticSearch))
+	out.File = (*FileStorage)(unsafe.Pointer(in.File))
+	out.Loki = (*LokiStorage)(unsafe.Pointer(in.Loki))
+	out.ClickHouse = (*ClickHouseStorage)(unsafe.Pointer(in.ClickHouse))
 	return nil
 }
 
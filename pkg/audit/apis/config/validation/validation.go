@@ -1,18 +1,108 @@
 package validation
 
 import (
+	"encoding/base64"
+	"regexp"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/pkg/audit/apis/config"
 )
 
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 func ValidateAuditConfiguration(ac *config.AuditConfiguration) error {
-	if ac.Storage.ElasticSearch == nil {
-		fld := field.NewPath("components").Child("elasticSearch")
-		return field.Required(fld, "must be specified")
+	if err := ValidateStorage(&ac.Storage, field.NewPath("storage")); err != nil {
+		return err
+	}
+	if ac.Integrity != nil {
+		fld := field.NewPath("integrity")
+		if ac.Integrity.SigningKey == "" {
+			return field.Required(fld.Child("signingKey"), "must specify signingKey")
+		}
+		if _, err := base64.StdEncoding.DecodeString(ac.Integrity.SigningKey); err != nil {
+			return field.Invalid(fld.Child("signingKey"), "", "must be base64 encoded")
+		}
+		if ac.Integrity.CheckpointIntervalMinutes < 0 {
+			return field.Invalid(fld.Child("checkpointIntervalMinutes"), ac.Integrity.CheckpointIntervalMinutes, "must not be negative")
+		}
+	}
+	if ac.Policy != nil {
+		if err := ValidatePolicy(ac.Policy, field.NewPath("policy")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePolicy checks the actions, retention days and sampling percents of
+// the policy.
+func ValidatePolicy(policy *config.Policy, fld *field.Path) error {
+	for i, rule := range policy.Rules {
+		ruleFld := fld.Child("rules").Index(i)
+		if rule.Action != config.PolicyInclude && rule.Action != config.PolicyExclude {
+			return field.NotSupported(ruleFld.Child("action"), rule.Action, []string{string(config.PolicyInclude), string(config.PolicyExclude)})
+		}
+		if rule.RetentionDays < 0 {
+			return field.Invalid(ruleFld.Child("retentionDays"), rule.RetentionDays, "must not be negative")
+		}
+		if rule.SamplingPercent < 0 || rule.SamplingPercent > 100 {
+			return field.Invalid(ruleFld.Child("samplingPercent"), rule.SamplingPercent, "must be between 0 and 100")
+		}
+		if rule.Action == config.PolicyExclude && (rule.RetentionDays != 0 || rule.SamplingPercent != 0) {
+			return field.Invalid(ruleFld, rule.Name, "retention days and sampling percent only apply to included events")
+		}
 	}
-	if ac.Storage.ElasticSearch.Address == "" {
-		fld := field.NewPath("components").Child("elasticSearch").Child("address")
-		return field.Required(fld, "must be specified")
+	for cluster, days := range policy.ClusterRetentionDays {
+		if days <= 0 {
+			return field.Invalid(fld.Child("clusterRetentionDays").Key(cluster), days, "must be positive")
+		}
 	}
 	return nil
 }
+
+// ValidateStorage checks that exactly one storage backend is specified and
+// that it is usable.
+func ValidateStorage(store *config.Storage, fld *field.Path) error {
+	count := 0
+	if store.ElasticSearch != nil {
+		count++
+		if store.ElasticSearch.Address == "" {
+			return field.Required(fld.Child("elasticSearch").Child("address"), "must be specified")
+		}
+	}
+	if store.File != nil {
+		count++
+		if store.File.Path == "" {
+			return field.Required(fld.Child("file").Child("path"), "must be specified")
+		}
+		if store.File.MaxFileSizeMB < 0 {
+			return field.Invalid(fld.Child("file").Child("maxFileSizeMB"), store.File.MaxFileSizeMB, "must not be negative")
+		}
+	}
+	if store.Loki != nil {
+		count++
+		if store.Loki.Address == "" {
+			return field.Required(fld.Child("loki").Child("address"), "must be specified")
+		}
+	}
+	if store.ClickHouse != nil {
+		count++
+		if store.ClickHouse.Address == "" {
+			return field.Required(fld.Child("clickHouse").Child("address"), "must be specified")
+		}
+		if store.ClickHouse.Database != "" && !identifierRegexp.MatchString(store.ClickHouse.Database) {
+			return field.Invalid(fld.Child("clickHouse").Child("database"), store.ClickHouse.Database, "must be a valid identifier")
+		}
+		if store.ClickHouse.Table != "" && !identifierRegexp.MatchString(store.ClickHouse.Table) {
+			return field.Invalid(fld.Child("clickHouse").Child("table"), store.ClickHouse.Table, "must be a valid identifier")
+		}
+	}
+	switch count {
+	case 0:
+		return field.Required(fld, "one of elasticSearch, file, loki and clickHouse must be specified")
+	case 1:
+		return nil
+	default:
+		return field.Invalid(fld, "", "only one of elasticSearch, file, loki and clickHouse may be specified")
+	}
+}
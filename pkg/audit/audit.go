@@ -21,6 +21,8 @@ package audit
 import (
 	"golang.org/x/oauth2"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/pkg/audit/api"
 	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
 	"tkestack.io/tke/pkg/util/log"
@@ -32,6 +34,10 @@ type ExtraConfig struct {
 	OAuthConfig   *oauth2.Config
 	AuditConfig   *auditconfig.AuditConfiguration
 	HeaderRequest bool
+	NotifyClient  notifyversionedclient.NotifyV1Interface
+	// PlatformClient is used to find the tenants of the clusters of the
+	// events matched by the audit rules.
+	PlatformClient platformversionedclient.PlatformV1Interface
 }
 
 // Config contains the core configuration instance of server and additional
@@ -75,7 +81,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		return nil, err
 	}
 
-	if err := api.RegisterRoute(s.Handler.GoRestfulContainer, c.ExtraConfig.AuditConfig); err != nil {
+	if err := api.RegisterRoute(s.Handler.GoRestfulContainer, c.ExtraConfig.AuditConfig, c.ExtraConfig.NotifyClient, c.ExtraConfig.PlatformClient); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package policy
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Patterns match a value against a list of values in which * matches any
+// characters, an empty list matches any value.
+type Patterns []*regexp.Regexp
+
+// CompilePatterns turns the values into anchored regular expressions in which
+// * is the only special character.
+func CompilePatterns(values []string) Patterns {
+	var patterns Patterns
+	for _, value := range values {
+		quoted := strings.ReplaceAll(regexp.QuoteMeta(value), `\*`, ".*")
+		patterns = append(patterns, regexp.MustCompile("^"+quoted+"$"))
+	}
+	return patterns
+}
+
+// Match returns true if the value matches any of the patterns.
+func (p Patterns) Match(value string) bool {
+	if len(p) == 0 {
+		return true
+	}
+	for _, pattern := range p {
+		if pattern.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAny returns true if any of the values matches any of the patterns.
+func (p Patterns) MatchAny(values []string) bool {
+	if len(p) == 0 {
+		return true
+	}
+	for _, value := range values {
+		if p.Match(value) {
+			return true
+		}
+	}
+	return false
+}
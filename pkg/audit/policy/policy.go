@@ -0,0 +1,180 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package policy decides which audit events are stored, how many of them are
+// sampled and how long they are kept.
+package policy
+
+import (
+	"hash/fnv"
+	"time"
+
+	"tkestack.io/tke/api/application"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/business"
+	"tkestack.io/tke/api/monitor"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/registry"
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+// ClusterControlPlane is the cluster name the tkestack control-planes like tke-platform-api will use to report audit events
+const ClusterControlPlane = "control-plane"
+
+const day = int64(24 * time.Hour / time.Millisecond)
+
+// DefaultPolicy returns the policy used when none is configured. It drops the
+// events of the kubelets, of the service accounts in kube-system and of the
+// cluster status updates by tke-platform-controller, and only keeps the events
+// of the tkestack api groups reported by the control-planes.
+func DefaultPolicy() *auditconfig.Policy {
+	return &auditconfig.Policy{
+		Rules: []auditconfig.PolicyRule{
+			{
+				Name:   "kubelet",
+				Action: auditconfig.PolicyExclude,
+				Users:  []string{"system:node:*"},
+			},
+			{
+				Name:   "kube-system-service-accounts",
+				Action: auditconfig.PolicyExclude,
+				Users:  []string{"system:serviceaccount:kube-system:*"},
+			},
+			{
+				Name:       "platform-controller-cluster-updates",
+				Action:     auditconfig.PolicyExclude,
+				Users:      []string{"admin"},
+				Verbs:      []string{"update"},
+				Resources:  []string{"clusters", "clusters/*"},
+				UserAgents: []string{"tke-platform-controller*"},
+			},
+			{
+				Name:     "control-plane-tkestack-groups",
+				Action:   auditconfig.PolicyInclude,
+				Clusters: []string{ClusterControlPlane},
+				APIGroups: []string{
+					platform.GroupName,
+					registry.GroupName,
+					notify.GroupName,
+					monitor.GroupName,
+					business.GroupName,
+					auth.GroupName,
+					application.GroupName,
+				},
+			},
+			{
+				Name:     "control-plane-other-groups",
+				Action:   auditconfig.PolicyExclude,
+				Clusters: []string{ClusterControlPlane},
+			},
+		},
+	}
+}
+
+// Policy is a compiled audit policy.
+type Policy struct {
+	rules                []*rule
+	clusterRetentionDays map[string]int
+}
+
+type rule struct {
+	auditconfig.PolicyRule
+	users      Patterns
+	groups     Patterns
+	verbs      Patterns
+	resources  Patterns
+	apiGroups  Patterns
+	clusters   Patterns
+	userAgents Patterns
+}
+
+// New compiles the policy, the default policy is compiled if it is nil.
+func New(conf *auditconfig.Policy) *Policy {
+	if conf == nil {
+		conf = DefaultPolicy()
+	}
+	p := &Policy{clusterRetentionDays: conf.ClusterRetentionDays}
+	for _, r := range conf.Rules {
+		p.rules = append(p.rules, &rule{
+			PolicyRule: r,
+			users:      CompilePatterns(r.Users),
+			groups:     CompilePatterns(r.Groups),
+			verbs:      CompilePatterns(r.Verbs),
+			resources:  CompilePatterns(r.Resources),
+			apiGroups:  CompilePatterns(r.APIGroups),
+			clusters:   CompilePatterns(r.Clusters),
+			userAgents: CompilePatterns(r.UserAgents),
+		})
+	}
+	return p
+}
+
+func (r *rule) matches(event *types.Event) bool {
+	return r.users.Match(event.UserName) &&
+		r.groups.MatchAny(event.Groups) &&
+		r.verbs.Match(event.Verb) &&
+		r.resources.Match(event.ResourcePath()) &&
+		r.apiGroups.Match(event.APIGroup) &&
+		r.clusters.Match(event.ClusterName) &&
+		r.userAgents.Match(event.UserAgent)
+}
+
+// sampled returns true if the event is within the sampling percent of the
+// rule. The decision is made on the audit id so that every stage of a request
+// is sampled alike.
+func (r *rule) sampled(event *types.Event) bool {
+	if r.SamplingPercent == 0 || r.SamplingPercent >= 100 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(event.AuditID))
+	return int(h.Sum32()%100) < r.SamplingPercent
+}
+
+func (p *Policy) match(event *types.Event) *rule {
+	for _, r := range p.rules {
+		if r.matches(event) {
+			return r
+		}
+	}
+	return nil
+}
+
+// Apply returns the events which are stored and sets the expiry of those
+// retained by a rule or a cluster for other than the reserve days of the
+// storage.
+func (p *Policy) Apply(events []*types.Event) []*types.Event {
+	var result []*types.Event
+	for _, event := range events {
+		r := p.match(event)
+		if r != nil && (r.Action == auditconfig.PolicyExclude || !r.sampled(event)) {
+			continue
+		}
+		days := p.clusterRetentionDays[event.ClusterName]
+		if r != nil && r.RetentionDays > 0 {
+			days = r.RetentionDays
+		}
+		if days > 0 {
+			event.ExpireTimestamp = event.RequestReceivedTimestamp + int64(days)*day
+		}
+		result = append(result, event)
+	}
+	return result
+}
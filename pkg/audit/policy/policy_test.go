@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package policy
+
+import (
+	"fmt"
+	"testing"
+
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	tests := []struct {
+		name  string
+		event types.Event
+		want  bool
+	}{
+		{"user", types.Event{UserName: "alice", Verb: "create", Resource: "pods", ClusterName: "cls-a"}, true},
+		{"kubelet", types.Event{UserName: "system:node:node-1", Verb: "update", Resource: "nodes", ClusterName: "cls-a"}, false},
+		{"kube-system service account", types.Event{UserName: "system:serviceaccount:kube-system:coredns", ClusterName: "cls-a"}, false},
+		{"other service account", types.Event{UserName: "system:serviceaccount:default:app", ClusterName: "cls-a"}, true},
+		{"platform controller", types.Event{UserName: "admin", Verb: "update", Resource: "clusters", UserAgent: "tke-platform-controller/v1.0", ClusterName: ClusterControlPlane, APIGroup: "platform.tkestack.io"}, false},
+		{"platform controller status", types.Event{UserName: "admin", Verb: "update", Resource: "clusters", Name: "cls-a", RequestURI: "/apis/platform.tkestack.io/v1/clusters/cls-a/status", UserAgent: "tke-platform-controller/v1.0", ClusterName: ClusterControlPlane, APIGroup: "platform.tkestack.io"}, false},
+		{"control plane tkestack group", types.Event{UserName: "admin", Verb: "update", Resource: "clusters", ClusterName: ClusterControlPlane, APIGroup: "platform.tkestack.io"}, true},
+		{"control plane other group", types.Event{UserName: "admin", Verb: "update", Resource: "configmaps", ClusterName: ClusterControlPlane}, false},
+	}
+	p := New(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			if got := len(p.Apply([]*types.Event{&event})) == 1; got != tt.want {
+				t.Errorf("Apply() stored = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	p := New(&auditconfig.Policy{
+		Rules: []auditconfig.PolicyRule{
+			{Action: auditconfig.PolicyInclude, Groups: []string{"system:masters"}, RetentionDays: 90},
+			{Action: auditconfig.PolicyExclude, Verbs: []string{"get", "list", "watch"}},
+			{Action: auditconfig.PolicyInclude, Resources: []string{"events"}, SamplingPercent: 10},
+		},
+		ClusterRetentionDays: map[string]int{"cls-prod": 30},
+	})
+	const received = 1000
+	tests := []struct {
+		name       string
+		event      types.Event
+		wantStored bool
+		wantExpire int64
+	}{
+		{"first rule wins", types.Event{Verb: "get", Groups: []string{"system:authenticated", "system:masters"}, RequestReceivedTimestamp: received, ClusterName: "cls-prod"}, true, received + 90*day},
+		{"excluded", types.Event{Verb: "list", Groups: []string{"system:authenticated"}}, false, 0},
+		{"cluster retention", types.Event{Verb: "delete", RequestReceivedTimestamp: received, ClusterName: "cls-prod"}, true, received + 30*day},
+		{"storage retention", types.Event{Verb: "delete", ClusterName: "cls-test"}, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := tt.event
+			stored := len(p.Apply([]*types.Event{&event})) == 1
+			if stored != tt.wantStored || event.ExpireTimestamp != tt.wantExpire {
+				t.Errorf("Apply() stored = %v, expire %d, want %v, expire %d", stored, event.ExpireTimestamp, tt.wantStored, tt.wantExpire)
+			}
+		})
+	}
+
+	t.Run("sampling", func(t *testing.T) {
+		stored := 0
+		for i := 0; i < 1000; i++ {
+			id := fmt.Sprintf("audit-%d", i)
+			received := &types.Event{AuditID: id, Stage: "RequestReceived", Verb: "create", Resource: "events"}
+			completed := &types.Event{AuditID: id, Stage: "ResponseComplete", Verb: "create", Resource: "events"}
+			switch len(p.Apply([]*types.Event{received, completed})) {
+			case 2:
+				stored++
+			case 1:
+				t.Fatalf("Apply() sampled the stages of %s differently", id)
+			}
+		}
+		if stored < 50 || stored > 150 {
+			t.Errorf("Apply() stored %d of 1000 sampled events, want about 100", stored)
+		}
+	})
+}
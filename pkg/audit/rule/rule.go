@@ -0,0 +1,263 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package rule evaluates the audit rules of the notify service against the
+// audit events and creates a message request for every matched event.
+package rule
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/audit/policy"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// resyncPeriod is how long a change of the audit rules takes to be
+	// evaluated.
+	resyncPeriod = 30 * time.Second
+	// queueSize bounds the message requests waiting to be created, the
+	// requests beyond it are dropped rather than blocking the sink of events.
+	queueSize = 1000
+)
+
+// completedStages are the stages of the events which are matched, so that
+// every request is matched once with its response code.
+var completedStages = map[string]bool{
+	"ResponseComplete": true,
+	"Panic":            true,
+}
+
+// Evaluator keeps the audit rules in sync and creates the message requests
+// of the events matched by them.
+type Evaluator struct {
+	client         notifyversionedclient.NotifyV1Interface
+	platformClient platformversionedclient.PlatformV1Interface
+	queue          chan *notifyv1.MessageRequest
+	stop           chan struct{}
+
+	lock  sync.RWMutex
+	rules []*rule
+	// clusterTenants are the tenants of the clusters by name.
+	clusterTenants map[string]string
+}
+
+// NewEvaluator creates the evaluator of the audit rules. The platform client
+// is used to find the tenants of the clusters, without it only the events of
+// the users with a tenant are matched.
+func NewEvaluator(client notifyversionedclient.NotifyV1Interface, platformClient platformversionedclient.PlatformV1Interface) *Evaluator {
+	return &Evaluator{
+		client:         client,
+		platformClient: platformClient,
+		queue:          make(chan *notifyv1.MessageRequest, queueSize),
+		stop:           make(chan struct{}),
+	}
+}
+
+// Start begins syncing the audit rules and creating the message requests.
+func (e *Evaluator) Start() {
+	go wait.Until(e.sync, resyncPeriod, e.stop)
+	go wait.Until(e.create, time.Second, e.stop)
+}
+
+// Stop stops the evaluator, the message requests not created yet are dropped.
+func (e *Evaluator) Stop() {
+	close(e.stop)
+}
+
+func (e *Evaluator) sync() {
+	list, err := e.client.AuditRules().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("failed list audit rules: %v", err)
+		return
+	}
+	var rules []*rule
+	for i := range list.Items {
+		if list.Items[i].Spec.Disabled {
+			continue
+		}
+		rules = append(rules, newRule(&list.Items[i]))
+	}
+	clusterTenants := e.syncClusterTenants()
+	e.lock.Lock()
+	e.rules = rules
+	if clusterTenants != nil {
+		e.clusterTenants = clusterTenants
+	}
+	e.lock.Unlock()
+}
+
+// syncClusterTenants returns the tenants of the clusters, or nil if they
+// could not be listed so that the last known ones are kept.
+func (e *Evaluator) syncClusterTenants() map[string]string {
+	if e.platformClient == nil {
+		return nil
+	}
+	list, err := e.platformClient.Clusters().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("failed list clusters: %v", err)
+		return nil
+	}
+	clusterTenants := make(map[string]string, len(list.Items))
+	for _, cluster := range list.Items {
+		clusterTenants[cluster.Name] = cluster.Spec.TenantID
+	}
+	return clusterTenants
+}
+
+func (e *Evaluator) create() {
+	for {
+		select {
+		case <-e.stop:
+			return
+		case messageRequest := <-e.queue:
+			if _, err := e.client.MessageRequests(messageRequest.Namespace).Create(context.Background(), messageRequest, metav1.CreateOptions{}); err != nil {
+				log.Errorf("failed create message request of audit rule %s: %v", messageRequest.Spec.Variables[ruleNameKey], err)
+			}
+		}
+	}
+}
+
+// Evaluate queues a message request for every pair of event and audit rule
+// matching it. An event is only matched by the audit rules of its tenant,
+// which is the tenant of the user or, for the users without one, the tenant
+// of the cluster.
+func (e *Evaluator) Evaluate(events []*types.Event) {
+	e.lock.RLock()
+	rules := e.rules
+	clusterTenants := e.clusterTenants
+	e.lock.RUnlock()
+	for _, event := range events {
+		if !completedStages[event.Stage] {
+			continue
+		}
+		tenantID := event.TenantID
+		if tenantID == "" {
+			tenantID = clusterTenants[event.ClusterName]
+		}
+		for _, r := range rules {
+			if !r.matches(event, tenantID) {
+				continue
+			}
+			select {
+			case e.queue <- r.messageRequest(event):
+			default:
+				log.Errorf("failed queue message request of audit rule %s for event %s: the queue is full", r.auditRule.Name, event.AuditID)
+			}
+		}
+	}
+}
+
+type rule struct {
+	auditRule  *notifyv1.AuditRule
+	users      policy.Patterns
+	verbs      policy.Patterns
+	resources  policy.Patterns
+	clusters   policy.Patterns
+	namespaces policy.Patterns
+	codes      map[int32]bool
+}
+
+func newRule(auditRule *notifyv1.AuditRule) *rule {
+	match := auditRule.Spec.Match
+	r := &rule{
+		auditRule:  auditRule,
+		users:      policy.CompilePatterns(match.Users),
+		verbs:      policy.CompilePatterns(match.Verbs),
+		resources:  policy.CompilePatterns(match.Resources),
+		clusters:   policy.CompilePatterns(match.Clusters),
+		namespaces: policy.CompilePatterns(match.Namespaces),
+	}
+	if len(match.Codes) > 0 {
+		r.codes = make(map[int32]bool)
+		for _, code := range match.Codes {
+			r.codes[code] = true
+		}
+	}
+	return r
+}
+
+func (r *rule) matches(event *types.Event, tenantID string) bool {
+	if tenantID == "" || tenantID != r.auditRule.Spec.TenantID {
+		return false
+	}
+	if r.codes != nil && !r.codes[event.Code] {
+		return false
+	}
+	return r.users.Match(event.UserName) &&
+		r.verbs.Match(event.Verb) &&
+		r.resources.Match(event.ResourcePath()) &&
+		r.clusters.Match(event.ClusterName) &&
+		r.namespaces.Match(event.Namespace)
+}
+
+const (
+	ruleNameKey        = "ruleName"
+	ruleDisplayNameKey = "ruleDisplayName"
+)
+
+// Variables returns the template variables of the event matched by the audit
+// rule.
+func Variables(auditRule *notifyv1.AuditRule, event *types.Event) map[string]string {
+	return map[string]string{
+		ruleNameKey:           auditRule.Name,
+		ruleDisplayNameKey:    auditRule.Spec.DisplayName,
+		"auditID":             event.AuditID,
+		"stage":               event.Stage,
+		"requestURI":          event.RequestURI,
+		"verb":                event.Verb,
+		"userName":            event.UserName,
+		"userAgent":           event.UserAgent,
+		"resource":            event.ResourcePath(),
+		"namespace":           event.Namespace,
+		"name":                event.Name,
+		"apiGroup":            event.APIGroup,
+		"apiVersion":          event.APIVersion,
+		"sourceIPs":           event.SourceIPs,
+		"status":              event.Status,
+		"message":             event.Message,
+		"reason":              event.Reason,
+		"code":                fmt.Sprint(event.Code),
+		"requestReceivedTime": time.Unix(event.RequestReceivedTimestamp/1000, 0).Format(time.RFC3339),
+		"clusterName":         event.ClusterName,
+	}
+}
+
+func (r *rule) messageRequest(event *types.Event) *notifyv1.MessageRequest {
+	return &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: r.auditRule.Spec.ChannelName,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:       r.auditRule.Spec.TenantID,
+			TemplateName:   r.auditRule.Spec.TemplateName,
+			Receivers:      r.auditRule.Spec.Receivers,
+			ReceiverGroups: r.auditRule.Spec.ReceiverGroups,
+			Variables:      Variables(r.auditRule, event),
+		},
+	}
+}
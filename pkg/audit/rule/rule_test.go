@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package rule
+
+import (
+	"sort"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/notify/registry/auditrule"
+)
+
+func TestMatches(t *testing.T) {
+	exec := &types.Event{
+		Stage:       "ResponseComplete",
+		RequestURI:  "/api/v1/namespaces/default/pods/web-0/exec?command=sh&stdin=true",
+		Verb:        "create",
+		UserName:    "alice",
+		Resource:    "pods",
+		Namespace:   "default",
+		Name:        "web-0",
+		Code:        101,
+		ClusterName: "cls-prod",
+	}
+	deleteNamespace := &types.Event{
+		Stage:       "ResponseComplete",
+		RequestURI:  "/api/v1/namespaces/payment",
+		Verb:        "delete",
+		UserName:    "bob",
+		Resource:    "namespaces",
+		Name:        "payment",
+		Code:        200,
+		ClusterName: "cls-prod",
+	}
+	tests := []struct {
+		name  string
+		match notifyv1.AuditRuleMatch
+		event *types.Event
+		want  bool
+	}{
+		{"empty matches any", notifyv1.AuditRuleMatch{}, exec, true},
+		{"subresource", notifyv1.AuditRuleMatch{Resources: []string{"pods/exec"}}, exec, true},
+		{"resource does not match subresource", notifyv1.AuditRuleMatch{Resources: []string{"pods"}}, exec, false},
+		{"wildcard subresource", notifyv1.AuditRuleMatch{Resources: []string{"pods/*"}}, exec, true},
+		{"resource without subresource", notifyv1.AuditRuleMatch{Resources: []string{"namespaces"}, Verbs: []string{"delete"}}, deleteNamespace, true},
+		{"wildcard cluster", notifyv1.AuditRuleMatch{Clusters: []string{"cls-prod*"}}, deleteNamespace, true},
+		{"wildcard is anchored", notifyv1.AuditRuleMatch{Clusters: []string{"prod*"}}, deleteNamespace, false},
+		{"regexp characters are literal", notifyv1.AuditRuleMatch{Users: []string{"a.ice"}}, exec, false},
+		{"any of the values", notifyv1.AuditRuleMatch{Users: []string{"carol", "bob"}}, deleteNamespace, true},
+		{"code", notifyv1.AuditRuleMatch{Codes: []int32{200, 201}}, deleteNamespace, true},
+		{"code mismatch", notifyv1.AuditRuleMatch{Codes: []int32{403}}, deleteNamespace, false},
+		{"all fields must match", notifyv1.AuditRuleMatch{Verbs: []string{"delete"}, Namespaces: []string{"default"}}, deleteNamespace, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRule(&notifyv1.AuditRule{Spec: notifyv1.AuditRuleSpec{TenantID: "default", Match: tt.match}})
+			if got := r.matches(tt.event, "default"); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	auditRule := &notifyv1.AuditRule{
+		ObjectMeta: metav1.ObjectMeta{Name: "exec"},
+		Spec: notifyv1.AuditRuleSpec{
+			TenantID:     "default",
+			DisplayName:  "Exec into pods",
+			Match:        notifyv1.AuditRuleMatch{Resources: []string{"pods/exec"}},
+			ChannelName:  "security",
+			TemplateName: "audit",
+			Receivers:    []string{"alice"},
+		},
+	}
+	e := NewEvaluator(nil, nil)
+	e.rules = []*rule{newRule(auditRule)}
+	e.clusterTenants = map[string]string{"cls-prod": "default", "cls-other": "other"}
+	event := &types.Event{
+		AuditID:     "a1",
+		RequestURI:  "/api/v1/namespaces/default/pods/web-0/exec",
+		Verb:        "create",
+		Resource:    "pods",
+		Namespace:   "default",
+		Name:        "web-0",
+		Code:        101,
+		ClusterName: "cls-prod",
+	}
+	received := *event
+	received.Stage = "RequestReceived"
+	completed := *event
+	completed.Stage = "ResponseComplete"
+	otherCluster := completed
+	otherCluster.ClusterName = "cls-other"
+	otherUser := completed
+	otherUser.TenantID = "other"
+	controlPlane := completed
+	controlPlane.ClusterName = "control-plane"
+	controlPlane.TenantID = "default"
+	e.Evaluate([]*types.Event{&received, &completed, &otherCluster, &otherUser, &controlPlane})
+
+	if len(e.queue) != 2 {
+		t.Fatalf("Evaluate() queued %d message requests, want 2 of the tenant of the rule", len(e.queue))
+	}
+	messageRequest := <-e.queue
+	if messageRequest.Namespace != "security" || messageRequest.Spec.TemplateName != "audit" || messageRequest.Spec.TenantID != "default" {
+		t.Errorf("Evaluate() queued %+v, want the channel, template and tenant of the rule", messageRequest)
+	}
+	variables := messageRequest.Spec.Variables
+	if variables["ruleName"] != "exec" || variables["resource"] != "pods/exec" || variables["code"] != "101" || variables["clusterName"] != "cls-prod" {
+		t.Errorf("Evaluate() variables = %v, want the fields of the event", variables)
+	}
+}
+
+func TestVariables(t *testing.T) {
+	var keys []string
+	for key := range Variables(&notifyv1.AuditRule{}, &types.Event{}) {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	want := auditrule.AuditVariables.List()
+	if len(keys) != len(want) {
+		t.Fatalf("Variables() keys = %v, want %v", keys, want)
+	}
+	for i := range keys {
+		if keys[i] != want[i] {
+			t.Fatalf("Variables() keys = %v, want %v", keys, want)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+// Package chain makes the stored audit events tamper evident. Every event is
+// hashed together with the hash of the event stored before it, so the last
+// hash of a batch commits to the batch and to all of the previous batches.
+// The head of the chain is recorded by signed checkpoints periodically, which
+// can not be forged without the signing key even if the whole chain is
+// recomputed. The events retained for different days by the audit policy are
+// chained apart, so that the expired events are only removed from the start
+// of their chains and are told apart from the deleted ones by the
+// checkpoints.
+package chain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultCheckpointInterval = 5 * time.Minute
+	day                       = int64(24 * time.Hour / time.Millisecond)
+)
+
+type chain struct {
+	storage.AuditStorage
+	checkpoints CheckpointStore
+	key         []byte
+	interval    time.Duration
+	stop        chan struct{}
+	id          string
+
+	lock sync.Mutex
+	// links are the chains of the retention classes by their retention days,
+	// the events of a class expire in about the order they are chained in.
+	links map[int]*link
+}
+
+// link is the state of the chain of a retention class.
+type link struct {
+	id            string
+	retentionDays int
+	sequence      int64
+	hash          string
+	// headTimestamp and firstTimestamp are the saved time of the head event
+	// and of the first event of the chain.
+	headTimestamp  int64
+	firstTimestamp int64
+	// receivedTimestamp is the latest time the events of the chain were
+	// received at.
+	receivedTimestamp int64
+	// checkpointed is the sequence of the last checkpoint.
+	checkpointed int64
+}
+
+// NewStorage wraps the audit storage so that the saved events are chained,
+// each audit server process starts its own chains so that the replicas never
+// fork a chain.
+func NewStorage(s storage.AuditStorage, checkpoints CheckpointStore, key []byte, interval time.Duration) storage.AuditStorage {
+	if interval <= 0 {
+		interval = defaultCheckpointInterval
+	}
+	hostname, _ := os.Hostname()
+	return &chain{
+		AuditStorage: s,
+		checkpoints:  checkpoints,
+		key:          key,
+		interval:     interval,
+		stop:         make(chan struct{}),
+		id:           fmt.Sprintf("%s-%d", hostname, time.Now().UnixNano()),
+		links:        make(map[int]*link),
+	}
+}
+
+func (c *chain) Start() {
+	c.AuditStorage.Start()
+	go wait.Until(c.checkpoint, c.interval, c.stop)
+}
+
+func (c *chain) Stop() {
+	close(c.stop)
+	c.checkpoint()
+	c.AuditStorage.Stop()
+}
+
+// RetentionDays returns the days the event is retained for by the audit
+// policy, zero is returned if the reserve days of the storage apply.
+func RetentionDays(event *types.Event) int {
+	if event.ExpireTimestamp == 0 {
+		return 0
+	}
+	return int((event.ExpireTimestamp - event.RequestReceivedTimestamp) / day)
+}
+
+// Save links the events to the chains of their retention classes before
+// storing them, the chains are rolled back if they fail to be stored so that
+// no gap is left.
+func (c *chain) Save(events []*types.Event) error {
+	if len(events) == 0 {
+		return c.AuditStorage.Save(events)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	advanced := make(map[int]*link)
+	for _, event := range events {
+		days := RetentionDays(event)
+		l, ok := advanced[days]
+		if !ok {
+			if current, ok := c.links[days]; ok {
+				copied := *current
+				l = &copied
+			} else {
+				l = &link{id: fmt.Sprintf("%s-%dd", c.id, days), retentionDays: days}
+			}
+			advanced[days] = l
+		}
+		l.sequence++
+		event.ChainID = l.id
+		event.Sequence = l.sequence
+		event.SavedTimestamp = now
+		event.PrevHash = l.hash
+		event.Hash = Hash(event)
+		l.hash = event.Hash
+		if l.firstTimestamp == 0 {
+			l.firstTimestamp = now
+		}
+		l.headTimestamp = now
+		if event.RequestReceivedTimestamp > l.receivedTimestamp {
+			l.receivedTimestamp = event.RequestReceivedTimestamp
+		}
+	}
+	if err := c.AuditStorage.Save(events); err != nil {
+		return err
+	}
+	for days, l := range advanced {
+		c.links[days] = l
+	}
+	return nil
+}
+
+// Hash returns the hash of the event content and its previous hash.
+func Hash(event *types.Event) string {
+	content := *event
+	content.Hash = ""
+	data, _ := json.Marshal(&content)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// checkpoint records the heads of the chains which have advanced.
+func (c *chain) checkpoint() {
+	c.lock.Lock()
+	var checkpoints []Checkpoint
+	for _, l := range c.links {
+		if l.sequence == l.checkpointed {
+			continue
+		}
+		checkpoints = append(checkpoints, Checkpoint{
+			ChainID:           l.id,
+			Sequence:          l.sequence,
+			Hash:              l.hash,
+			Timestamp:         time.Now().UnixNano() / int64(time.Millisecond),
+			HeadTimestamp:     l.headTimestamp,
+			FirstSequence:     1,
+			FirstTimestamp:    l.firstTimestamp,
+			RetentionDays:     l.retentionDays,
+			ReceivedTimestamp: l.receivedTimestamp,
+		})
+	}
+	c.lock.Unlock()
+
+	for _, checkpoint := range checkpoints {
+		checkpoint.Signature = checkpoint.Sign(c.key)
+		if err := c.checkpoints.Save(checkpoint); err != nil {
+			log.Errorf("failed save audit chain checkpoint: %v", err)
+			continue
+		}
+		c.lock.Lock()
+		for _, l := range c.links {
+			if l.id == checkpoint.ChainID && checkpoint.Sequence > l.checkpointed {
+				l.checkpointed = checkpoint.Sequence
+			}
+		}
+		c.lock.Unlock()
+	}
+}
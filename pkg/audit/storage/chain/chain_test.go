@@ -0,0 +1,254 @@
+package chain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/fake"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/file"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+type memoryStore struct {
+	checkpoints []Checkpoint
+}
+
+func (s *memoryStore) Save(checkpoint Checkpoint) error {
+	s.checkpoints = append(s.checkpoints, checkpoint)
+	return nil
+}
+
+func (s *memoryStore) List(startTime, endTime int64) ([]Checkpoint, error) {
+	return s.checkpoints, nil
+}
+
+type failingStorage struct {
+	storage.AuditStorage
+}
+
+func (failingStorage) Save([]*types.Event) error {
+	return errors.New("unavailable")
+}
+
+func newEvents(names ...string) []*types.Event {
+	var events []*types.Event
+	for _, name := range names {
+		events = append(events, &types.Event{AuditID: name, Name: name, RequestReceivedTimestamp: time.Now().Unix() * 1000})
+	}
+	return events
+}
+
+func TestVerify(t *testing.T) {
+	key := []byte("secret")
+	inner, err := file.NewStorage(&config.FileStorage{Path: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkpoints := &memoryStore{}
+	s := NewStorage(inner, checkpoints, key, time.Hour).(*chain)
+	start := time.Now().UnixNano()/int64(time.Millisecond) - 1
+	if err := s.Save(newEvents("a", "b", "c")); err != nil {
+		t.Fatal(err)
+	}
+	s.checkpoint()
+	if err := s.Save(newEvents("d", "e")); err != nil {
+		t.Fatal(err)
+	}
+	end := time.Now().UnixNano()/int64(time.Millisecond) + 1
+	stored, _, err := inner.Query(&storage.QueryParameter{Size: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := Retention{Now: end + 2*day, ReserveDays: 1}
+	tests := []struct {
+		name      string
+		tamper    func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint)
+		retention Retention
+		want      []ProblemType
+	}{
+		{"intact", nil, Retention{}, nil},
+		{"modified", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			events[1].Name = "forged"
+			return events, checkpoints
+		}, Retention{}, []ProblemType{ProblemMismatch}},
+		{"rehashed", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			// recomputing the chain after a change is caught by the checkpoint
+			for _, event := range events {
+				if event.Sequence == 2 {
+					event.Name = "forged"
+				}
+			}
+			prev := ""
+			for _, seq := range []int64{1, 2, 3, 4, 5} {
+				for _, event := range events {
+					if event.Sequence == seq {
+						event.PrevHash = prev
+						event.Hash = Hash(event)
+						prev = event.Hash
+					}
+				}
+			}
+			return events, checkpoints
+		}, Retention{}, []ProblemType{ProblemCheckpoint}},
+		{"deleted", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			var kept []*types.Event
+			for _, event := range events {
+				if event.Sequence != 4 {
+					kept = append(kept, event)
+				}
+			}
+			return kept, checkpoints
+		}, Retention{}, []ProblemType{ProblemGap}},
+		{"deleted prefix", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			var kept []*types.Event
+			for _, event := range events {
+				if event.Sequence > 2 {
+					kept = append(kept, event)
+				}
+			}
+			return kept, checkpoints
+		}, Retention{}, []ProblemType{ProblemGap}},
+		{"expired prefix", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			var kept []*types.Event
+			for _, event := range events {
+				if event.Sequence > 3 {
+					kept = append(kept, event)
+				}
+			}
+			return kept, checkpoints
+		}, expired, nil},
+		{"deleted after expired prefix", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			var kept []*types.Event
+			for _, event := range events {
+				if event.Sequence > 4 {
+					kept = append(kept, event)
+				}
+			}
+			return kept, checkpoints
+		}, expired, []ProblemType{ProblemGap}},
+		{"deleted chain", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			return nil, checkpoints
+		}, Retention{}, []ProblemType{ProblemGap}},
+		{"forged checkpoint", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			checkpoints[0].Sequence = 2
+			return events, checkpoints
+		}, Retention{}, []ProblemType{ProblemCheckpoint}},
+		{"unsigned checkpoint", func(events []*types.Event, checkpoints []Checkpoint) ([]*types.Event, []Checkpoint) {
+			checkpoints[0].Signature = ""
+			return events, checkpoints
+		}, Retention{}, []ProblemType{ProblemCheckpoint}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := make([]*types.Event, 0, len(stored))
+			for _, event := range stored {
+				copied := *event
+				events = append(events, &copied)
+			}
+			cps := append([]Checkpoint(nil), checkpoints.checkpoints...)
+			if tt.tamper != nil {
+				events, cps = tt.tamper(events, cps)
+			}
+			report := Verify(events, cps, key, start, end, tt.retention)
+			var got []ProblemType
+			for _, problem := range report.Problems {
+				got = append(got, problem.Type)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("Verify() problems = %+v, want %v", report.Problems, tt.want)
+			}
+			if report.Verified != (len(tt.want) == 0) {
+				t.Errorf("Verify() verified = %v", report.Verified)
+			}
+		})
+	}
+
+	t.Run("pages", func(t *testing.T) {
+		verifier := NewVerifier(checkpoints.checkpoints, key, start, end, Retention{})
+		param := &storage.ChainParameter{StartTime: start, EndTime: end, Size: 2}
+		for {
+			page, next, err := inner.ListChained(param)
+			if err != nil {
+				t.Fatal(err)
+			}
+			verifier.Add(page)
+			if next == "" {
+				break
+			}
+			param.Continue = next
+		}
+		if report := verifier.Report(); !report.Verified || report.Events != 5 {
+			t.Errorf("Report() = %+v, want 5 verified events", report)
+		}
+	})
+
+	t.Run("out of order", func(t *testing.T) {
+		verifier := NewVerifier(checkpoints.checkpoints, key, start, end, Retention{})
+		for _, i := range []int{3, 0, 4, 2, 1} {
+			verifier.Add(stored[i : i+1])
+		}
+		report := verifier.Report()
+		if !report.Verified || len(report.Chains) != 1 || report.Chains[0].FirstSequence != 1 || report.Chains[0].LastSequence != 5 {
+			t.Errorf("Report() = %+v, want the chain of 5 events verified", report)
+		}
+	})
+
+	t.Run("no signing key", func(t *testing.T) {
+		report := Verify(stored, checkpoints.checkpoints, nil, start, end, Retention{})
+		if report.Verified || len(report.Problems) != 1 || report.Problems[0].Type != ProblemCheckpoint {
+			t.Errorf("Verify() = %+v, want the unsigned checkpoint reported", report)
+		}
+	})
+}
+
+func TestSaveRollback(t *testing.T) {
+	s := NewStorage(failingStorage{}, &memoryStore{}, nil, time.Hour).(*chain)
+	if err := s.Save(newEvents("a")); err == nil {
+		t.Fatal("Save() error = nil, want the error of the storage")
+	}
+	if len(s.links) != 0 {
+		t.Errorf("Save() advanced the chains to %+v after a failure", s.links)
+	}
+}
+
+func TestSaveRetentionClasses(t *testing.T) {
+	inner, err := file.NewStorage(&config.FileStorage{Path: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewStorage(inner, &memoryStore{}, nil, time.Hour).(*chain)
+	events := newEvents("a", "b", "c")
+	events[1].ExpireTimestamp = events[1].RequestReceivedTimestamp + 3*day
+	if err := s.Save(events); err != nil {
+		t.Fatal(err)
+	}
+	if events[0].ChainID != events[2].ChainID || events[2].Sequence != 2 || events[2].PrevHash != events[0].Hash {
+		t.Errorf("Save() did not chain the events of the reserve days together: %+v, %+v", events[0], events[2])
+	}
+	if events[1].ChainID == events[0].ChainID || events[1].Sequence != 1 {
+		t.Errorf("Save() chained the event retained for 3 days with the others: %+v", events[1])
+	}
+	if RetentionDays(events[1]) != 3 {
+		t.Errorf("RetentionDays() = %d, want 3", RetentionDays(events[1]))
+	}
+}
+
+func TestConfigMapStore(t *testing.T) {
+	store := NewConfigMapStore(fake.NewSimpleClientset(), "tke", "tke-audit-checkpoints")
+	for i, timestamp := range []int64{100, 200, 300} {
+		if err := store.Save(Checkpoint{ChainID: "chain", Sequence: int64(i + 1), Hash: "h", Timestamp: timestamp}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkpoints, err := store.List(150, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 2 || checkpoints[0].Sequence != 2 || checkpoints[1].Sequence != 3 {
+		t.Errorf("List() = %+v, want the checkpoints 2 and 3", checkpoints)
+	}
+}
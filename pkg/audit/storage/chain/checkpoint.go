@@ -0,0 +1,173 @@
+package chain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// maxCheckpoints keeps the configmap well below its size limit.
+const maxCheckpoints = 5000
+
+// Checkpoint records the head of a chain at a point in time, together with
+// the first event of the chain so that the deletion of a whole chain or of its
+// leading events can be detected.
+type Checkpoint struct {
+	ChainID   string `json:"chainID"`
+	Sequence  int64  `json:"sequence"`
+	Hash      string `json:"hash"`
+	Timestamp int64  `json:"timestamp"`
+	// HeadTimestamp is the time the head event was saved at.
+	HeadTimestamp int64 `json:"headTimestamp,omitempty"`
+	// FirstSequence and FirstTimestamp are the sequence and the saved time
+	// of the first event of the chain. They are not set by the checkpoints
+	// recorded before, whose signatures do not cover them.
+	FirstSequence  int64 `json:"firstSequence,omitempty"`
+	FirstTimestamp int64 `json:"firstTimestamp,omitempty"`
+	// RetentionDays are the days the events of the chain are retained for,
+	// zero means the reserve days of the storage. ReceivedTimestamp is the
+	// latest time the events up to the head were received at, once they
+	// have expired the storage may remove all of them. They are not set by
+	// the checkpoints recorded before, whose signatures do not cover them.
+	RetentionDays     int    `json:"retentionDays,omitempty"`
+	ReceivedTimestamp int64  `json:"receivedTimestamp,omitempty"`
+	Signature         string `json:"signature,omitempty"`
+}
+
+// expired returns whether all of the events up to the head have passed their
+// retention, so that the storage may have removed them. The reserve days of
+// the storage are not known if they are not positive.
+func (c *Checkpoint) expired(retention Retention) bool {
+	days := c.RetentionDays
+	if days == 0 {
+		days = retention.ReserveDays
+	}
+	return c.ReceivedTimestamp != 0 && days > 0 && c.ReceivedTimestamp+int64(days)*day <= retention.Now
+}
+
+// Sign returns the HMAC signature of the checkpoint, an empty string is
+// returned if there is no key.
+func (c *Checkpoint) Sign(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s|%d|%s|%d", c.ChainID, c.Sequence, c.Hash, c.Timestamp)
+	if c.FirstSequence != 0 {
+		fmt.Fprintf(mac, "|%d|%d|%d", c.HeadTimestamp, c.FirstSequence, c.FirstTimestamp)
+	}
+	if c.ReceivedTimestamp != 0 {
+		fmt.Fprintf(mac, "|%d|%d", c.RetentionDays, c.ReceivedTimestamp)
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CheckpointStore persists the checkpoints of the chains.
+type CheckpointStore interface {
+	Save(checkpoint Checkpoint) error
+	// List returns the checkpoints recorded within the time range in
+	// milliseconds, ordered by time.
+	List(startTime, endTime int64) ([]Checkpoint, error)
+}
+
+type configMapStore struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewConfigMapStore creates the checkpoint store which keeps the latest
+// checkpoints in a configmap, one key per checkpoint.
+func NewConfigMapStore(client kubernetes.Interface, namespace, name string) CheckpointStore {
+	return &configMapStore{client: client, namespace: namespace, name: name}
+}
+
+func (s *configMapStore) Save(checkpoint Checkpoint) error {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s.%020d", checkpoint.ChainID, checkpoint.Sequence)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(context.Background(), s.name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: s.namespace, Name: s.name},
+				Data:       map[string]string{key: string(data)},
+			}
+			_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(context.Background(), cm, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[key] = string(data)
+		trim(cm.Data)
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(context.Background(), cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// trim removes the oldest checkpoints beyond maxCheckpoints.
+func trim(data map[string]string) {
+	if len(data) <= maxCheckpoints {
+		return
+	}
+	checkpoints := decode(data)
+	for _, checkpoint := range checkpoints[:len(checkpoints)-maxCheckpoints] {
+		delete(data, checkpoint.key)
+	}
+}
+
+type keyedCheckpoint struct {
+	Checkpoint
+	key string
+}
+
+func decode(data map[string]string) []keyedCheckpoint {
+	var checkpoints []keyedCheckpoint
+	for key, value := range data {
+		checkpoint := keyedCheckpoint{key: key}
+		if err := json.Unmarshal([]byte(value), &checkpoint.Checkpoint); err != nil {
+			continue
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	sort.Slice(checkpoints, func(i, j int) bool {
+		if checkpoints[i].Timestamp != checkpoints[j].Timestamp {
+			return checkpoints[i].Timestamp < checkpoints[j].Timestamp
+		}
+		return checkpoints[i].key < checkpoints[j].key
+	})
+	return checkpoints
+}
+
+func (s *configMapStore) List(startTime, endTime int64) ([]Checkpoint, error) {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(context.Background(), s.name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var checkpoints []Checkpoint
+	for _, checkpoint := range decode(cm.Data) {
+		if checkpoint.Timestamp >= startTime && (endTime <= 0 || checkpoint.Timestamp <= endTime) {
+			checkpoints = append(checkpoints, checkpoint.Checkpoint)
+		}
+	}
+	return checkpoints, nil
+}
@@ -0,0 +1,325 @@
+package chain
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"sort"
+
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+// ProblemType is the kind of the integrity problem found by Verify.
+type ProblemType string
+
+const (
+	// ProblemGap means that events are missing from a chain.
+	ProblemGap ProblemType = "Gap"
+	// ProblemMismatch means that an event does not match its hash or the
+	// hash of the event before it.
+	ProblemMismatch ProblemType = "Mismatch"
+	// ProblemCheckpoint means that a checkpoint is not signed by the key or
+	// does not match the event it records.
+	ProblemCheckpoint ProblemType = "Checkpoint"
+)
+
+// Problem describes an integrity problem of a chain.
+type Problem struct {
+	Type     ProblemType `json:"type"`
+	ChainID  string      `json:"chainID"`
+	Sequence int64       `json:"sequence"`
+	Message  string      `json:"message"`
+}
+
+// Summary describes the part of a chain which is verified.
+type Summary struct {
+	ChainID       string `json:"chainID"`
+	FirstSequence int64  `json:"firstSequence"`
+	LastSequence  int64  `json:"lastSequence"`
+	Events        int    `json:"events"`
+}
+
+// Report is the result of Verify.
+type Report struct {
+	Verified    bool      `json:"verified"`
+	Events      int       `json:"events"`
+	Checkpoints int       `json:"checkpoints"`
+	Chains      []Summary `json:"chains"`
+	Problems    []Problem `json:"problems"`
+}
+
+// Retention tells Verify which events the storage may have removed after
+// they expired.
+type Retention struct {
+	// Now is the current time in milliseconds.
+	Now int64
+	// ReserveDays are the days the storage keeps the events for which the
+	// audit policy sets no retention.
+	ReserveDays int
+}
+
+// Verifier checks the chains of the events saved within a time range as the
+// pages of the events are read. The events of a chain may be read in any
+// order, only the ends of the runs of consecutive sequences read so far are
+// kept, so the memory does not grow with the events read in about the order
+// of their sequences.
+type Verifier struct {
+	key       []byte
+	startTime int64
+	endTime   int64
+	retention Retention
+	report    *Report
+	// verified are the checkpoints signed by the key, recorded are the
+	// hashes they record by the chain and the sequence.
+	verified []Checkpoint
+	recorded map[string]map[int64][]string
+	chains   map[string]*chainState
+}
+
+// chainState is what is known of a chain from the events read.
+type chainState struct {
+	summary Summary
+	// runs are the sorted runs of consecutive sequences read.
+	runs []run
+}
+
+// run is a run of consecutive sequences, with the previous hash of its first
+// event and the hash of its last event.
+type run struct {
+	first    int64
+	last     int64
+	prevHash string
+	hash     string
+}
+
+// NewVerifier creates the verifier of the events saved within the time range
+// in milliseconds. The events recorded by the checkpoints, the first and the
+// head events of the chains, must exist if they were saved within the range
+// even if no other event of their chains is left, unless the checkpoints tell
+// that they have expired. The events are never verified without the signing
+// key, since unsigned checkpoints can be recomputed together with the chain.
+func NewVerifier(checkpoints []Checkpoint, key []byte, startTime, endTime int64, retention Retention) *Verifier {
+	v := &Verifier{
+		key:       key,
+		startTime: startTime,
+		endTime:   endTime,
+		retention: retention,
+		report:    &Report{Chains: []Summary{}, Problems: []Problem{}},
+		recorded:  make(map[string]map[int64][]string),
+		chains:    make(map[string]*chainState),
+	}
+	for _, checkpoint := range checkpoints {
+		v.report.Checkpoints++
+		// anyone who can write the checkpoints can recompute an unsigned one
+		if len(key) == 0 || checkpoint.Signature == "" {
+			v.report.Problems = append(v.report.Problems, Problem{
+				Type:     ProblemCheckpoint,
+				ChainID:  checkpoint.ChainID,
+				Sequence: checkpoint.Sequence,
+				Message:  "the checkpoint is not signed",
+			})
+			continue
+		}
+		if !hmac.Equal([]byte(checkpoint.Signature), []byte(checkpoint.Sign(key))) {
+			v.report.Problems = append(v.report.Problems, Problem{
+				Type:     ProblemCheckpoint,
+				ChainID:  checkpoint.ChainID,
+				Sequence: checkpoint.Sequence,
+				Message:  "the checkpoint is not signed by the signing key",
+			})
+			continue
+		}
+		v.verified = append(v.verified, checkpoint)
+		if v.recorded[checkpoint.ChainID] == nil {
+			v.recorded[checkpoint.ChainID] = make(map[int64][]string)
+		}
+		v.recorded[checkpoint.ChainID][checkpoint.Sequence] = append(v.recorded[checkpoint.ChainID][checkpoint.Sequence], checkpoint.Hash)
+		v.chain(checkpoint.ChainID)
+	}
+	return v
+}
+
+func (v *Verifier) inRange(timestamp int64) bool {
+	return timestamp >= v.startTime && (v.endTime <= 0 || timestamp <= v.endTime)
+}
+
+func (v *Verifier) chain(id string) *chainState {
+	state, ok := v.chains[id]
+	if !ok {
+		state = &chainState{summary: Summary{ChainID: id}}
+		v.chains[id] = state
+	}
+	return state
+}
+
+// Add checks a page of the events, the events which are not chained or were
+// not saved within the range are skipped.
+func (v *Verifier) Add(events []*types.Event) {
+	for _, event := range events {
+		if event.ChainID == "" || !v.inRange(event.SavedTimestamp) {
+			continue
+		}
+		v.add(event)
+	}
+}
+
+func (v *Verifier) add(event *types.Event) {
+	state := v.chain(event.ChainID)
+	v.report.Events++
+	state.summary.Events++
+	if state.summary.FirstSequence == 0 || event.Sequence < state.summary.FirstSequence {
+		state.summary.FirstSequence = event.Sequence
+	}
+	if event.Sequence > state.summary.LastSequence {
+		state.summary.LastSequence = event.Sequence
+	}
+	if Hash(event) != event.Hash {
+		v.report.problem(ProblemMismatch, event, "the event does not match its hash")
+	}
+	for _, hash := range v.recorded[event.ChainID][event.Sequence] {
+		if hash != event.Hash {
+			v.report.problem(ProblemCheckpoint, event, "the event does not match the hash recorded by the checkpoint")
+		}
+	}
+
+	runs := state.runs
+	// i is the first run which does not end before the event
+	i := sort.Search(len(runs), func(i int) bool { return runs[i].last >= event.Sequence })
+	if i < len(runs) && runs[i].first <= event.Sequence {
+		v.report.problem(ProblemMismatch, event, "the sequence is duplicated")
+		return
+	}
+	r := run{first: event.Sequence, last: event.Sequence, prevHash: event.PrevHash, hash: event.Hash}
+	before := i > 0 && runs[i-1].last == event.Sequence-1
+	after := i < len(runs) && runs[i].first == event.Sequence+1
+	if before && event.PrevHash != runs[i-1].hash {
+		v.report.problem(ProblemMismatch, event, "the previous hash does not match the event before it")
+	}
+	if after && runs[i].prevHash != event.Hash {
+		v.report.Problems = append(v.report.Problems, Problem{
+			Type:     ProblemMismatch,
+			ChainID:  event.ChainID,
+			Sequence: runs[i].first,
+			Message:  "the previous hash does not match the event before it",
+		})
+	}
+	switch {
+	case before && after:
+		runs[i-1].last, runs[i-1].hash = runs[i].last, runs[i].hash
+		state.runs = append(runs[:i], runs[i+1:]...)
+	case before:
+		runs[i-1].last, runs[i-1].hash = r.last, r.hash
+	case after:
+		runs[i].first, runs[i].prevHash = r.first, r.prevHash
+	default:
+		runs = append(runs, run{})
+		copy(runs[i+1:], runs[i:])
+		runs[i] = r
+		state.runs = runs
+	}
+}
+
+// Report returns the result after all of the events have been added.
+func (v *Verifier) Report() *Report {
+	var ids []string
+	for id := range v.chains {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		state := v.chains[id]
+		if state.summary.Events > 0 {
+			v.report.Chains = append(v.report.Chains, state.summary)
+		}
+		v.report.gaps(id, state.runs, v.verified, v.inRange, v.retention)
+	}
+	v.report.Verified = len(v.key) > 0 && len(v.report.Problems) == 0
+	return v.report
+}
+
+// Verify checks the chains of the events saved within the time range in
+// milliseconds against their hashes and the checkpoints, see NewVerifier.
+func Verify(events []*types.Event, checkpoints []Checkpoint, key []byte, startTime, endTime int64, retention Retention) *Report {
+	v := NewVerifier(checkpoints, key, startTime, endTime, retention)
+	v.Add(events)
+	return v.Report()
+}
+
+// gaps reports the events missing from the runs of the chain. Since the
+// events of a chain are saved in the order of their sequences, every event
+// between two events saved within the range, known either from the chain or
+// from the checkpoints, must have been saved within the range too. The events
+// up to the head of a checkpoint whose events have all expired are not
+// reported, as the storage removes them in about the order they are chained
+// in.
+func (r *Report) gaps(id string, runs []run, checkpoints []Checkpoint, inRange func(int64) bool, retention Retention) {
+	var first, last, expired int64
+	known := func(sequence int64) {
+		if first == 0 || sequence < first {
+			first = sequence
+		}
+		if sequence > last {
+			last = sequence
+		}
+	}
+	if len(runs) > 0 {
+		known(runs[0].first)
+		known(runs[len(runs)-1].last)
+	}
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ChainID == id && checkpoint.Sequence > expired && checkpoint.expired(retention) {
+			expired = checkpoint.Sequence
+		}
+	}
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ChainID != id || checkpoint.FirstSequence == 0 {
+			continue
+		}
+		if inRange(checkpoint.FirstTimestamp) {
+			known(checkpoint.FirstSequence)
+		}
+		if inRange(checkpoint.HeadTimestamp) {
+			known(checkpoint.Sequence)
+		}
+	}
+	// The older checkpoints only tell that the head was saved before them, so
+	// it is within the range if both an earlier event and the checkpoint are.
+	for _, checkpoint := range checkpoints {
+		if checkpoint.ChainID == id && checkpoint.FirstSequence == 0 && first != 0 && first < checkpoint.Sequence && inRange(checkpoint.Timestamp) {
+			known(checkpoint.Sequence)
+		}
+	}
+	if first == 0 {
+		return
+	}
+
+	next := first
+	if next <= expired {
+		next = expired + 1
+	}
+	for _, run := range runs {
+		if run.last < next || run.first > last {
+			continue
+		}
+		if run.first > next {
+			r.Problems = append(r.Problems, Problem{
+				Type:     ProblemGap,
+				ChainID:  id,
+				Sequence: run.first,
+				Message:  fmt.Sprintf("%d events before the event are missing", run.first-next),
+			})
+		}
+		next = run.last + 1
+	}
+	if next <= last {
+		r.Problems = append(r.Problems, Problem{
+			Type:     ProblemGap,
+			ChainID:  id,
+			Sequence: last,
+			Message:  fmt.Sprintf("%d events up to the sequence recorded by the checkpoint are missing", last-next+1),
+		})
+	}
+}
+
+func (r *Report) problem(typ ProblemType, event *types.Event, message string) {
+	r.Problems = append(r.Problems, Problem{Type: typ, ChainID: event.ChainID, Sequence: event.Sequence, Message: message})
+}
@@ -0,0 +1,359 @@
+package clickhouse
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/parnurzeal/gorequest"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultDatabase    = "default"
+	defaultTable       = "auditevent"
+	defaultReserveDays = 7
+	maxFieldValues     = 1000
+)
+
+var fields = []string{"userName", "clusterName", "namespace", "resource"}
+
+// columns are the columns of the table, named after the json fields of the
+// event so that the rows are inserted and selected as JSONEachRow.
+const columns = `
+	auditID String,
+	stage LowCardinality(String),
+	requestURI String,
+	verb LowCardinality(String),
+	userName String,
+	userAgent String,
+	resource LowCardinality(String),
+	namespace String,
+	name String,
+	uid String,
+	apiGroup String,
+	apiVersion String,
+	sourceIPs String,
+	status LowCardinality(String),
+	message String,
+	reason String,
+	details String,
+	code Int32,
+	requestObject String,
+	responseObject String,
+	requestReceivedTimestamp Int64,
+	stageTimestamp Int64,
+	clusterName LowCardinality(String),
+	chainID String,
+	sequence Int64,
+	savedTimestamp Int64,
+	prevHash String,
+	hash String,
+	expireTimestamp Int64`
+
+// addedColumns are added to the tables created before the events were hash
+// chained or could expire.
+var addedColumns = []string{"chainID String", "sequence Int64", "savedTimestamp Int64", "prevHash String", "hash String", "expireTimestamp Int64"}
+
+type clickhouse struct {
+	addr        string
+	table       string
+	reserveDays int
+	username    string
+	password    string
+	stop        chan struct{}
+
+	lock        sync.Mutex
+	fieldValues map[string][]string
+}
+
+// NewStorage creates the audit storage which keeps the events in a ClickHouse
+// table, the table is created if it does not exist.
+func NewStorage(conf *config.ClickHouseStorage) (storage.AuditStorage, error) {
+	cli := &clickhouse{
+		addr:        strings.TrimSuffix(conf.Address, "/"),
+		reserveDays: conf.ReserveDays,
+		username:    conf.Username,
+		stop:        make(chan struct{}),
+		fieldValues: map[string][]string{},
+	}
+	database := conf.Database
+	if database == "" {
+		database = defaultDatabase
+	}
+	table := conf.Table
+	if table == "" {
+		table = defaultTable
+	}
+	cli.table = fmt.Sprintf("`%s`.`%s`", database, table)
+	if conf.Password != "" {
+		password, err := base64.StdEncoding.DecodeString(conf.Password)
+		if err != nil {
+			return nil, fmt.Errorf("decode password failed: %v", err)
+		}
+		cli.password = string(password)
+	}
+	if cli.reserveDays <= 0 {
+		cli.reserveDays = defaultReserveDays
+	}
+	if err := cli.init(); err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
+func (s *clickhouse) Start() {
+	go wait.Until(s.updateFieldValues, time.Minute, s.stop)
+}
+
+func (s *clickhouse) Stop() {
+	close(s.stop)
+}
+
+// init creates the table and keeps its TTL in line with the reserve days and
+// the expiry of the events, ClickHouse removes the expired rows itself.
+func (s *clickhouse) init() error {
+	ttl := fmt.Sprintf("if(expireTimestamp > 0, toDateTime(intDiv(expireTimestamp, 1000)), "+
+		"toDateTime(intDiv(requestReceivedTimestamp, 1000)) + INTERVAL %d DAY)", s.reserveDays)
+	create := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (%s\n) ENGINE = MergeTree() "+
+		"PARTITION BY toYYYYMMDD(toDateTime(intDiv(requestReceivedTimestamp, 1000))) "+
+		"ORDER BY (clusterName, requestReceivedTimestamp)", s.table, columns)
+	if _, err := s.exec(create, nil, ""); err != nil {
+		return fmt.Errorf("create audit table failed: %v", err)
+	}
+	for _, column := range addedColumns {
+		if _, err := s.exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s", s.table, column), nil, ""); err != nil {
+			return fmt.Errorf("add audit table column failed: %v", err)
+		}
+	}
+	if _, err := s.exec(fmt.Sprintf("ALTER TABLE %s MODIFY TTL %s", s.table, ttl), nil, ""); err != nil {
+		return fmt.Errorf("update audit table ttl failed: %v", err)
+	}
+	return nil
+}
+
+// exec runs the statement through the HTTP interface, the query parameters
+// are bound by ClickHouse so that the values are never spliced into the SQL.
+func (s *clickhouse) exec(query string, params map[string]string, body string) (string, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	values.Set("output_format_json_quote_64bit_integers", "0")
+	for name, value := range params {
+		values.Set("param_"+name, value)
+	}
+	req := gorequest.New().Post(s.addr + "/?" + values.Encode()).Timeout(30 * time.Second)
+	if s.username != "" {
+		req.Set("X-ClickHouse-User", s.username)
+		req.Set("X-ClickHouse-Key", s.password)
+	}
+	req.BounceToRawString = true
+	resp, respBody, errs := req.Type("text").SendString(body).End()
+	if len(errs) > 0 {
+		return "", fmt.Errorf("%v", errs)
+	}
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("code %d, body %s", resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+func (s *clickhouse) Save(events []*types.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	buf := bytes.NewBuffer(nil)
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		buf.Write(data)
+		buf.WriteString("\n")
+	}
+	if _, err := s.exec(fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", s.table), nil, buf.String()); err != nil {
+		return fmt.Errorf("insert audit events failed: %v", err)
+	}
+	return nil
+}
+
+// where builds the condition of the parameter with bound query parameters.
+// ListChained pages through the chained events ordered by the saved time, the
+// chain and the sequence, the token is the position of the last event.
+func (s *clickhouse) ListChained(param *storage.ChainParameter) ([]*types.Event, string, error) {
+	conditions := []string{"chainID != ''", "savedTimestamp >= {startTime:Int64}"}
+	params := map[string]string{"startTime": fmt.Sprint(param.StartTime)}
+	if param.EndTime > 0 {
+		conditions = append(conditions, "savedTimestamp <= {endTime:Int64}")
+		params["endTime"] = fmt.Sprint(param.EndTime)
+	}
+	if param.Continue != "" {
+		var after struct {
+			SavedTimestamp int64  `json:"savedTimestamp"`
+			ChainID        string `json:"chainID"`
+			Sequence       int64  `json:"sequence"`
+		}
+		if err := json.Unmarshal([]byte(param.Continue), &after); err != nil {
+			return nil, "", fmt.Errorf("invalid continue token %q", param.Continue)
+		}
+		conditions = append(conditions, "(savedTimestamp, chainID, sequence) > ({afterSaved:Int64}, {afterChain:String}, {afterSequence:Int64})")
+		params["afterSaved"] = fmt.Sprint(after.SavedTimestamp)
+		params["afterChain"] = after.ChainID
+		params["afterSequence"] = fmt.Sprint(after.Sequence)
+	}
+	limit := ""
+	if param.Size > 0 {
+		limit = fmt.Sprintf(" LIMIT %d", param.Size)
+	}
+	body, err := s.exec(fmt.Sprintf("SELECT * FROM %s WHERE %s ORDER BY savedTimestamp, chainID, sequence%s FORMAT JSONEachRow",
+		s.table, strings.Join(conditions, " AND "), limit), params, "")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed list chained audit events: %v", err)
+	}
+	events := make([]*types.Event, 0)
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		event := &types.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, "", err
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, "", err
+	}
+	if len(events) == 0 || len(events) < param.Size {
+		return events, "", nil
+	}
+	last := events[len(events)-1]
+	next, err := json.Marshal(map[string]interface{}{"savedTimestamp": last.SavedTimestamp, "chainID": last.ChainID, "sequence": last.Sequence})
+	if err != nil {
+		return nil, "", err
+	}
+	return events, string(next), nil
+}
+
+func where(param *storage.QueryParameter) (string, map[string]string) {
+	conditions := []string{"1 = 1"}
+	params := make(map[string]string)
+	for _, f := range []struct {
+		column string
+		value  string
+	}{
+		{"clusterName", param.ClusterName},
+		{"namespace", param.Namespace},
+		{"resource", param.Resource},
+		{"name", param.Name},
+		{"userName", param.UserName},
+	} {
+		if f.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s = {%s:String}", f.column, f.column))
+			params[f.column] = f.value
+		}
+	}
+	if param.StartTime > 0 {
+		conditions = append(conditions, "requestReceivedTimestamp >= {startTime:Int64}")
+		params["startTime"] = fmt.Sprint(param.StartTime)
+	}
+	if param.EndTime > 0 {
+		conditions = append(conditions, "requestReceivedTimestamp <= {endTime:Int64}")
+		params["endTime"] = fmt.Sprint(param.EndTime)
+	}
+	if param.Query != "" {
+		var matches []string
+		for _, column := range []string{"message", "details", "requestObject", "responseObject"} {
+			matches = append(matches, fmt.Sprintf("positionCaseInsensitiveUTF8(%s, {query:String}) > 0", column))
+		}
+		conditions = append(conditions, "("+strings.Join(matches, " OR ")+")")
+		params["query"] = param.Query
+	}
+	return strings.Join(conditions, " AND "), params
+}
+
+func (s *clickhouse) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
+	if param == nil {
+		param = &storage.QueryParameter{Size: 10}
+	}
+	condition, params := where(param)
+	body, err := s.exec(fmt.Sprintf("SELECT count() AS total FROM %s WHERE %s FORMAT JSONEachRow", s.table, condition), params, "")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed count audit events: %v", err)
+	}
+	count := struct {
+		Total int `json:"total"`
+	}{}
+	if err := json.Unmarshal([]byte(body), &count); err != nil {
+		return nil, 0, err
+	}
+	limit := ""
+	if param.Size > 0 {
+		limit = fmt.Sprintf(" LIMIT %d OFFSET %d", param.Size, param.Offset)
+	}
+	body, err = s.exec(fmt.Sprintf("SELECT * FROM %s WHERE %s ORDER BY requestReceivedTimestamp DESC%s FORMAT JSONEachRow",
+		s.table, condition, limit), params, "")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed search audit events: %v", err)
+	}
+	events := make([]*types.Event, 0)
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		event := &types.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, err
+	}
+	return events, count.Total, nil
+}
+
+func (s *clickhouse) FieldValues() map[string][]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make(map[string][]string)
+	for _, field := range fields {
+		result[field] = s.fieldValues[field]
+	}
+	return result
+}
+
+func (s *clickhouse) updateFieldValues() {
+	tmpMap := make(map[string][]string)
+	for _, field := range fields {
+		body, err := s.exec(fmt.Sprintf("SELECT DISTINCT %s AS value FROM %s WHERE %s != '' ORDER BY value LIMIT %d FORMAT JSONEachRow",
+			field, s.table, field, maxFieldValues), nil, "")
+		if err != nil {
+			log.Errorf("failed update field %s values: %v", field, err)
+			continue
+		}
+		var values []string
+		scanner := bufio.NewScanner(strings.NewReader(body))
+		for scanner.Scan() {
+			row := struct {
+				Value string `json:"value"`
+			}{}
+			if err := json.Unmarshal(scanner.Bytes(), &row); err == nil {
+				values = append(values, row.Value)
+			}
+		}
+		tmpMap[field] = values
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for field, values := range tmpMap {
+		s.fieldValues[field] = values
+	}
+}
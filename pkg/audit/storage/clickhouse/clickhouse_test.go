@@ -0,0 +1,39 @@
+package clickhouse
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/storagetest"
+)
+
+// TestConformance runs against the clickhouse HTTP interface given by
+// AUDIT_CLICKHOUSE_ADDRESS.
+func TestConformance(t *testing.T) {
+	addr := os.Getenv("AUDIT_CLICKHOUSE_ADDRESS")
+	if addr == "" {
+		t.Skip("AUDIT_CLICKHOUSE_ADDRESS is not set")
+	}
+	s, err := NewStorage(&config.ClickHouseStorage{Address: addr, Table: "auditevent_conformance"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storagetest.RunConformanceTests(t, s)
+}
+
+func TestWhere(t *testing.T) {
+	condition, params := where(&storage.QueryParameter{ClusterName: "global'; DROP TABLE x", StartTime: 1, Query: "boom"})
+	wantCondition := "1 = 1 AND clusterName = {clusterName:String} AND requestReceivedTimestamp >= {startTime:Int64} AND " +
+		"(positionCaseInsensitiveUTF8(message, {query:String}) > 0 OR positionCaseInsensitiveUTF8(details, {query:String}) > 0 OR " +
+		"positionCaseInsensitiveUTF8(requestObject, {query:String}) > 0 OR positionCaseInsensitiveUTF8(responseObject, {query:String}) > 0)"
+	if condition != wantCondition {
+		t.Errorf("where() condition = %s, want %s", condition, wantCondition)
+	}
+	wantParams := map[string]string{"clusterName": "global'; DROP TABLE x", "startTime": "1", "query": "boom"}
+	if !reflect.DeepEqual(params, wantParams) {
+		t.Errorf("where() params = %v, want %v", params, wantParams)
+	}
+}
@@ -127,7 +127,7 @@ func (s *es) indicesTypeExist() bool {
 }
 
 func (s *es) indicesTypeCreate() error {
-	keywords := []string{"stage", "verb", "userName", "resource", "namespace", "name", "status", "clusterName"}
+	keywords := []string{"stage", "verb", "userName", "resource", "namespace", "name", "status", "clusterName", "chainID", "prevHash", "hash"}
 	texts := []string{"auditID", "requestURI", "userAgent", "uid", "apiGroup", "apiVersion", "message", "reason", "details", "requestObject", "responseObject", "sourceIPs"}
 	req := gorequest.New().Put(fmt.Sprintf("%s/%s", s.Addr, s.Indices)).SetBasicAuth(s.username, s.password)
 	req.Header["content-type"] = "application/json"
@@ -141,6 +141,15 @@ func (s *es) indicesTypeCreate() error {
 		"stageTimestamp": {
 			"type": "long",
 		},
+		"sequence": {
+			"type": "long",
+		},
+		"savedTimestamp": {
+			"type": "long",
+		},
+		"expireTimestamp": {
+			"type": "long",
+		},
 	}
 	for _, keyword := range keywords {
 		properties[keyword] = map[string]string{
@@ -224,6 +233,67 @@ func (s *es) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
 			"bool": {"filter": terms},
 		}
 	}
+	docs, total, err := s.search(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	events := make([]*types.Event, 0)
+	for _, doc := range docs {
+		events = append(events, doc.Event)
+	}
+	return events, total, nil
+}
+
+// ListChained pages through the chained events sorted by the saved time, the
+// chain and the sequence with search_after, which is neither limited by the
+// max result window nor moved by the events saved meanwhile. The token is the
+// sort values of the last event.
+func (s *es) ListChained(param *storage.ChainParameter) ([]*types.Event, string, error) {
+	savedRange := map[string]int64{"gte": param.StartTime}
+	if param.EndTime > 0 {
+		savedRange["lte"] = param.EndTime
+	}
+	query := map[string]interface{}{
+		"size": param.Size,
+		"sort": []interface{}{
+			map[string]string{"savedTimestamp": "asc"},
+			map[string]string{"chainID": "asc"},
+			map[string]string{"sequence": "asc"},
+		},
+		"query": map[string]map[string]interface{}{
+			"bool": {"filter": []interface{}{
+				map[string]map[string]string{"exists": {"field": "chainID"}},
+				map[string]map[string]map[string]int64{"range": {"savedTimestamp": savedRange}},
+			}},
+		},
+	}
+	if param.Continue != "" {
+		var after []interface{}
+		if err := json.Unmarshal([]byte(param.Continue), &after); err != nil {
+			return nil, "", fmt.Errorf("invalid continue token %q", param.Continue)
+		}
+		query["search_after"] = after
+	}
+	docs, _, err := s.search(query)
+	if err != nil {
+		return nil, "", err
+	}
+	events := make([]*types.Event, 0, len(docs))
+	for _, doc := range docs {
+		events = append(events, doc.Event)
+	}
+	if len(docs) == 0 || len(docs) < param.Size {
+		return events, "", nil
+	}
+	next, err := json.Marshal(docs[len(docs)-1].Sort)
+	if err != nil {
+		return nil, "", err
+	}
+	return events, string(next), nil
+}
+
+// search returns the documents matched by the query and the total of them.
+func (s *es) search(query map[string]interface{}) ([]*Document, int, error) {
 	url := fmt.Sprintf("%s/%s/%s/_search", s.Addr, s.Indices, typ)
 	if s.v7 {
 		url = fmt.Sprintf("%s/%s/_search", s.Addr, s.Indices)
@@ -236,27 +306,18 @@ func (s *es) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
 	} else if resp.StatusCode >= 300 {
 		return nil, 0, fmt.Errorf("failed search document: %s", body)
 	}
-	var res interface{}
 	if s.v7 {
-		res = &ResultV7{}
-	} else {
-		res = &Result{}
-	}
-	err := json.Unmarshal([]byte(body), res)
-	if err != nil {
-		return nil, 0, err
-	}
-	events := make([]*types.Event, 0)
-	if s.v7 {
-		for _, ev := range res.(*ResultV7).Hits.Hits {
-			events = append(events, ev.Event)
+		res := &ResultV7{}
+		if err := json.Unmarshal([]byte(body), res); err != nil {
+			return nil, 0, err
 		}
-		return events, res.(*ResultV7).Hits.Total.Value, nil
+		return res.Hits.Hits, res.Hits.Total.Value, nil
 	}
-	for _, ev := range res.(*Result).Hits.Hits {
-		events = append(events, ev.Event)
+	res := &Result{}
+	if err := json.Unmarshal([]byte(body), res); err != nil {
+		return nil, 0, err
 	}
-	return events, res.(*Result).Hits.Total, nil
+	return res.Hits.Hits, res.Hits.Total, nil
 }
 
 type Result struct {
@@ -282,7 +343,8 @@ type TotalV7 struct {
 }
 
 type Document struct {
-	Event *types.Event `json:"_source"`
+	Event *types.Event  `json:"_source"`
+	Sort  []interface{} `json:"sort,omitempty"`
 }
 
 func (s *es) Save(events []*types.Event) error {
@@ -345,8 +407,14 @@ func (s *es) cleanup() {
 	}
 	req := gorequest.New().Post(url).SetBasicAuth(s.username, s.password)
 	req.Header["content-type"] = "application/json"
-	t := time.Now().Unix()*1000 - int64(s.ReserveDays*24*60*60*1000)
-	query := fmt.Sprintf(`{"query":{"bool":{"filter":{"range":{"requestReceivedTimestamp":{"lte":%d}}}}}}`, t)
+	now := time.Now().Unix() * 1000
+	t := now - int64(s.ReserveDays*24*60*60*1000)
+	// the events with an expiry are kept until then regardless of the
+	// reserve days
+	query := fmt.Sprintf(`{"query":{"bool":{"should":[`+
+		`{"range":{"expireTimestamp":{"lte":%d}}},`+
+		`{"bool":{"must_not":{"exists":{"field":"expireTimestamp"}},"filter":{"range":{"requestReceivedTimestamp":{"lte":%d}}}}}`+
+		`],"minimum_should_match":1}}}`, now, t)
 	_, _, errs := req.SendString(query).End()
 	if len(errs) != 0 {
 		log.Errorf("failed cleanup older audit events: %v", errs)
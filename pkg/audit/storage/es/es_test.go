@@ -0,0 +1,22 @@
+package es
+
+import (
+	"os"
+	"testing"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/storagetest"
+)
+
+// TestConformance runs against the elasticsearch given by AUDIT_ES_ADDRESS.
+func TestConformance(t *testing.T) {
+	addr := os.Getenv("AUDIT_ES_ADDRESS")
+	if addr == "" {
+		t.Skip("AUDIT_ES_ADDRESS is not set")
+	}
+	s, err := NewStorage(&config.ElasticSearchStorage{Address: addr, Indices: "auditevent-conformance"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storagetest.RunConformanceTests(t, s)
+}
@@ -0,0 +1,478 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	segmentPrefix      = "audit-"
+	segmentSuffix      = ".jsonl"
+	defaultReserveDays = 7
+	defaultMaxFileSize = 100
+	maxFieldValues     = 1000
+)
+
+// entry indexes an event stored in a segment by the fields which can be
+// filtered on, so that queries only read the events they return.
+type entry struct {
+	segment     *segment
+	offset      int64
+	length      int
+	timestamp   int64
+	expire      int64
+	saved       int64
+	chained     bool
+	clusterName string
+	namespace   string
+	resource    string
+	name        string
+	userName    string
+}
+
+// segment is one of the rotated JSON lines files.
+type segment struct {
+	path   string
+	size   int64
+	day    string
+	newest int64
+	// expire is the latest expiry of the events in the segment.
+	expire  int64
+	entries []*entry
+}
+
+type file struct {
+	dir         string
+	reserveDays int
+	maxFileSize int64
+
+	lock     sync.RWMutex
+	segments []*segment
+	active   *os.File
+	stop     chan struct{}
+}
+
+// NewStorage creates the audit storage which keeps the events in rotating JSON
+// lines files under the configured directory, the index of the existing files
+// is rebuilt in memory.
+func NewStorage(conf *config.FileStorage) (storage.AuditStorage, error) {
+	s := &file{
+		dir:         conf.Path,
+		reserveDays: conf.ReserveDays,
+		maxFileSize: int64(conf.MaxFileSizeMB) << 20,
+		stop:        make(chan struct{}),
+	}
+	if s.reserveDays <= 0 {
+		s.reserveDays = defaultReserveDays
+	}
+	if s.maxFileSize <= 0 {
+		s.maxFileSize = defaultMaxFileSize << 20
+	}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, fmt.Errorf("create audit directory failed: %v", err)
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *file) Start() {
+	go wait.Until(s.cleanup, time.Hour, s.stop)
+}
+
+func (s *file) Stop() {
+	close(s.stop)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.active != nil {
+		s.active.Close()
+		s.active = nil
+	}
+}
+
+func (s *file) load() error {
+	paths, err := filepath.Glob(filepath.Join(s.dir, segmentPrefix+"*"+segmentSuffix))
+	if err != nil {
+		return err
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		seg, err := loadSegment(path)
+		if err != nil {
+			return fmt.Errorf("load audit file %s failed: %v", path, err)
+		}
+		s.segments = append(s.segments, seg)
+	}
+	return nil
+}
+
+func loadSegment(path string) (*segment, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	seg := &segment{path: path}
+	reader := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && line[len(line)-1] == '\n' {
+			event := &types.Event{}
+			if jsonErr := json.Unmarshal(line, event); jsonErr != nil {
+				log.Errorf("skip corrupted audit event in %s at %d: %v", path, offset, jsonErr)
+			} else {
+				seg.add(event, offset, len(line))
+			}
+		}
+		// an incomplete last line is left by a crash and is ignored
+		offset += int64(len(line))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	seg.size = offset
+	return seg, nil
+}
+
+func (seg *segment) add(event *types.Event, offset int64, length int) {
+	seg.entries = append(seg.entries, &entry{
+		segment:     seg,
+		offset:      offset,
+		length:      length,
+		timestamp:   event.RequestReceivedTimestamp,
+		expire:      event.ExpireTimestamp,
+		saved:       event.SavedTimestamp,
+		chained:     event.ChainID != "",
+		clusterName: event.ClusterName,
+		namespace:   event.Namespace,
+		resource:    event.Resource,
+		name:        event.Name,
+		userName:    event.UserName,
+	})
+	if event.RequestReceivedTimestamp > seg.newest {
+		seg.newest = event.RequestReceivedTimestamp
+	}
+	if event.ExpireTimestamp > seg.expire {
+		seg.expire = event.ExpireTimestamp
+	}
+}
+
+func (s *file) Save(events []*types.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if err := s.rotate(time.Now()); err != nil {
+		return err
+	}
+	seg := s.segments[len(s.segments)-1]
+	buf := bytes.NewBuffer(nil)
+	type pending struct {
+		event  *types.Event
+		offset int64
+		length int
+	}
+	var added []pending
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+		added = append(added, pending{event: event, offset: seg.size + int64(buf.Len()), length: len(data)})
+		buf.Write(data)
+	}
+	n, err := s.active.Write(buf.Bytes())
+	seg.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("write audit events failed: %v", err)
+	}
+	for _, p := range added {
+		seg.add(p.event, p.offset, p.length)
+	}
+	return nil
+}
+
+// rotate opens a new segment if there is no active one, the active one has
+// reached the size limit or it was created on another day.
+func (s *file) rotate(now time.Time) error {
+	day := now.UTC().Format("20060102")
+	if s.active != nil {
+		seg := s.segments[len(s.segments)-1]
+		if seg.size < s.maxFileSize && seg.day == day {
+			return nil
+		}
+		s.active.Close()
+		s.active = nil
+	}
+	path := filepath.Join(s.dir, fmt.Sprintf("%s%019d%s", segmentPrefix, now.UnixNano(), segmentSuffix))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("create audit file failed: %v", err)
+	}
+	s.active = f
+	s.segments = append(s.segments, &segment{path: path, day: day})
+	return nil
+}
+
+func (s *file) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
+	if param == nil {
+		param = &storage.QueryParameter{Size: 10}
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	// the expired events are kept in their segment until it is removed
+	now := time.Now().Unix() * 1000
+	var matched []*entry
+	for _, seg := range s.segments {
+		for _, e := range seg.entries {
+			if (e.expire == 0 || e.expire > now) && e.matches(param) {
+				matched = append(matched, e)
+			}
+		}
+	}
+	// newer events were appended later, keep that order for equal timestamps
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].timestamp > matched[j].timestamp })
+
+	var loaded map[*entry]*types.Event
+	if param.Query != "" {
+		events, err := s.read(matched)
+		if err != nil {
+			return nil, 0, err
+		}
+		query := strings.ToLower(param.Query)
+		loaded = make(map[*entry]*types.Event)
+		filtered := matched[:0]
+		for i, e := range matched {
+			if containsText(events[i], query) {
+				filtered = append(filtered, e)
+				loaded[e] = events[i]
+			}
+		}
+		matched = filtered
+	}
+
+	total := len(matched)
+	page := paginate(matched, param.Offset, param.Size)
+	if loaded != nil {
+		events := make([]*types.Event, 0, len(page))
+		for _, e := range page {
+			events = append(events, loaded[e])
+		}
+		return events, total, nil
+	}
+	events, err := s.read(page)
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+// ListChained pages through the chained events in the order they were
+// appended to the files, the token is the name of the file and the index of
+// the next event in it.
+func (s *file) ListChained(param *storage.ChainParameter) ([]*types.Event, string, error) {
+	var (
+		name  string
+		index int
+	)
+	if param.Continue != "" {
+		i := strings.LastIndex(param.Continue, "/")
+		if i < 0 {
+			return nil, "", fmt.Errorf("invalid continue token %q", param.Continue)
+		}
+		n, err := strconv.Atoi(param.Continue[i+1:])
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid continue token %q", param.Continue)
+		}
+		name, index = param.Continue[:i], n
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	now := time.Now().Unix() * 1000
+	var (
+		page []*entry
+		next string
+	)
+	for _, seg := range s.segments {
+		segName := filepath.Base(seg.path)
+		if segName < name {
+			continue
+		}
+		start := 0
+		if segName == name {
+			start = index
+		}
+		for i := start; i < len(seg.entries); i++ {
+			if param.Size > 0 && len(page) == param.Size {
+				next = fmt.Sprintf("%s/%d", segName, i)
+				break
+			}
+			e := seg.entries[i]
+			if e.chained && (e.expire == 0 || e.expire > now) &&
+				e.saved >= param.StartTime && (param.EndTime <= 0 || e.saved <= param.EndTime) {
+				page = append(page, e)
+			}
+		}
+		if next != "" {
+			break
+		}
+	}
+	events, err := s.read(page)
+	if err != nil {
+		return nil, "", err
+	}
+	return events, next, nil
+}
+
+func (e *entry) matches(param *storage.QueryParameter) bool {
+	switch {
+	case param.ClusterName != "" && e.clusterName != param.ClusterName:
+		return false
+	case param.Namespace != "" && e.namespace != param.Namespace:
+		return false
+	case param.Resource != "" && e.resource != param.Resource:
+		return false
+	case param.Name != "" && e.name != param.Name:
+		return false
+	case param.UserName != "" && e.userName != param.UserName:
+		return false
+	case param.StartTime > 0 && e.timestamp < param.StartTime:
+		return false
+	case param.EndTime > 0 && e.timestamp > param.EndTime:
+		return false
+	}
+	return true
+}
+
+// containsText matches the lower cased query against the same fields as the
+// full text search of elasticsearch storage.
+func containsText(event *types.Event, query string) bool {
+	for _, text := range []string{event.Message, event.Details, event.RequestObject, event.ResponseObject} {
+		if strings.Contains(strings.ToLower(text), query) {
+			return true
+		}
+	}
+	return false
+}
+
+func paginate(entries []*entry, offset, size int) []*entry {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset >= len(entries) {
+		return nil
+	}
+	end := len(entries)
+	if size > 0 && offset+size < end {
+		end = offset + size
+	}
+	return entries[offset:end]
+}
+
+// read loads the events of the entries in order.
+func (s *file) read(entries []*entry) ([]*types.Event, error) {
+	events := make([]*types.Event, len(entries))
+	files := make(map[*segment]*os.File)
+	defer func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}()
+	for i, e := range entries {
+		f, ok := files[e.segment]
+		if !ok {
+			var err error
+			if f, err = os.Open(e.segment.path); err != nil {
+				return nil, fmt.Errorf("open audit file failed: %v", err)
+			}
+			files[e.segment] = f
+		}
+		data := make([]byte, e.length)
+		if _, err := f.ReadAt(data, e.offset); err != nil {
+			return nil, fmt.Errorf("read audit event failed: %v", err)
+		}
+		event := &types.Event{}
+		if err := json.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("decode audit event failed: %v", err)
+		}
+		events[i] = event
+	}
+	return events, nil
+}
+
+func (s *file) FieldValues() map[string][]string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	values := map[string]sets.String{
+		"userName":    sets.NewString(),
+		"clusterName": sets.NewString(),
+		"namespace":   sets.NewString(),
+		"resource":    sets.NewString(),
+	}
+	for _, seg := range s.segments {
+		for _, e := range seg.entries {
+			values["userName"].Insert(e.userName)
+			values["clusterName"].Insert(e.clusterName)
+			values["namespace"].Insert(e.namespace)
+			values["resource"].Insert(e.resource)
+		}
+	}
+	result := make(map[string][]string)
+	for field, set := range values {
+		list := set.Delete("").List()
+		if len(list) > maxFieldValues {
+			list = list[:maxFieldValues]
+		}
+		result[field] = list
+	}
+	return result
+}
+
+// cleanup removes the files whose newest event is older than the reserve
+// days and whose events have all expired, the active file is never removed.
+func (s *file) cleanup() {
+	log.Infof("trigger file audit event cleanup")
+	now := time.Now().Unix() * 1000
+	cutoff := now - int64(s.reserveDays*24*60*60*1000)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var kept []*segment
+	for i, seg := range s.segments {
+		isActive := s.active != nil && i == len(s.segments)-1
+		if !isActive && seg.newest < cutoff && seg.expire <= now {
+			if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+				log.Errorf("failed remove older audit file %s: %v", seg.path, err)
+				kept = append(kept, seg)
+			}
+			continue
+		}
+		kept = append(kept, seg)
+	}
+	s.segments = kept
+}
@@ -0,0 +1,87 @@
+package file
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/storagetest"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+func TestConformance(t *testing.T) {
+	s, err := NewStorage(&config.FileStorage{Path: t.TempDir()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storagetest.RunConformanceTests(t, s)
+}
+
+func TestReopenAndCleanup(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStorage(&config.FileStorage{Path: dir, ReserveDays: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-48*time.Hour).Unix() * 1000
+	if err := s.Save([]*types.Event{{AuditID: "old", RequestReceivedTimestamp: old}}); err != nil {
+		t.Fatal(err)
+	}
+	// force a rotation so that the old events are in a closed file
+	s.(*file).segments[0].size = s.(*file).maxFileSize
+	if err := s.Save([]*types.Event{{AuditID: "new", RequestReceivedTimestamp: time.Now().Unix() * 1000}}); err != nil {
+		t.Fatal(err)
+	}
+	s.Stop()
+	if paths, _ := filepath.Glob(filepath.Join(dir, "audit-*.jsonl")); len(paths) != 2 {
+		t.Fatalf("got files %v, want 2 rotated files", paths)
+	}
+
+	s, err = NewStorage(&config.FileStorage{Path: dir, ReserveDays: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, total, err := s.Query(&storage.QueryParameter{Size: 10}); err != nil || total != 2 {
+		t.Fatalf("Query() after reopen total = %d, error %v, want 2", total, err)
+	}
+	s.(*file).cleanup()
+	events, total, err := s.Query(&storage.QueryParameter{Size: 10})
+	if err != nil || total != 1 || events[0].AuditID != "new" {
+		t.Errorf("Query() after cleanup = %v, total %d, error %v, want the new event", events, total, err)
+	}
+	if paths, _ := filepath.Glob(filepath.Join(dir, "audit-*.jsonl")); len(paths) != 1 {
+		t.Errorf("got files %v after cleanup, want 1", paths)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	dir := t.TempDir()
+	s, err := NewStorage(&config.FileStorage{Path: dir, ReserveDays: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Stop()
+	now := time.Now().Unix() * 1000
+	old := time.Now().Add(-48*time.Hour).Unix() * 1000
+	if err := s.Save([]*types.Event{
+		{AuditID: "retained", RequestReceivedTimestamp: old, ExpireTimestamp: now + 1000*60*60},
+		{AuditID: "expired", RequestReceivedTimestamp: now, ExpireTimestamp: now - 1000},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	s.(*file).segments[0].size = s.(*file).maxFileSize
+	if err := s.Save([]*types.Event{{AuditID: "new", RequestReceivedTimestamp: now}}); err != nil {
+		t.Fatal(err)
+	}
+
+	s.(*file).cleanup()
+	events, total, err := s.Query(&storage.QueryParameter{Size: 10})
+	if err != nil || total != 2 || events[0].AuditID != "new" || events[1].AuditID != "retained" {
+		t.Errorf("Query() = %v, total %d, error %v, want the new and the retained events", events, total, err)
+	}
+	if paths, _ := filepath.Glob(filepath.Join(dir, "audit-*.jsonl")); len(paths) != 2 {
+		t.Errorf("got files %v after cleanup, want the file of the retained event kept", paths)
+	}
+}
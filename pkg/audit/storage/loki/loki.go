@@ -0,0 +1,529 @@
+package loki
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/parnurzeal/gorequest"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	appLabel           = "tke-audit"
+	defaultReserveDays = 7
+	// maxEntries is the default max_entries_limit_per_query of Loki, queries
+	// matching more events are paged through with the time cursor.
+	maxEntries = 5000
+	// userNameField is kept in the log line rather than a stream label, every
+	// user would create its own streams otherwise.
+	userNameField = "userName"
+)
+
+// streamLabels are the fields of the event indexed as labels of the stream,
+// they are used for filtering and listing field values.
+var streamLabels = []string{"clusterName", "namespace", "resource"}
+
+type loki struct {
+	addr        string
+	tenantID    string
+	reserveDays int
+	username    string
+	password    string
+	stop        chan struct{}
+
+	lock        sync.Mutex
+	fieldValues map[string][]string
+}
+
+// NewStorage creates the audit storage which pushes the events to Loki.
+func NewStorage(conf *config.LokiStorage) (storage.AuditStorage, error) {
+	cli := &loki{
+		addr:        strings.TrimSuffix(conf.Address, "/"),
+		tenantID:    conf.TenantID,
+		reserveDays: conf.ReserveDays,
+		username:    conf.Username,
+		stop:        make(chan struct{}),
+		fieldValues: map[string][]string{},
+	}
+	if conf.Password != "" {
+		password, err := base64.StdEncoding.DecodeString(conf.Password)
+		if err != nil {
+			return nil, fmt.Errorf("decode password failed: %v", err)
+		}
+		cli.password = string(password)
+	}
+	if cli.reserveDays <= 0 {
+		cli.reserveDays = defaultReserveDays
+	}
+	if err := cli.ready(); err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
+func (s *loki) Start() {
+	go wait.Until(s.updateFieldValues, time.Minute, s.stop)
+}
+
+func (s *loki) Stop() {
+	close(s.stop)
+}
+
+func (s *loki) request(method, path string) *gorequest.SuperAgent {
+	req := gorequest.New().CustomMethod(method, s.addr+path).Timeout(30 * time.Second)
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+	if s.tenantID != "" {
+		req.Set("X-Scope-OrgID", s.tenantID)
+	}
+	return req
+}
+
+func (s *loki) ready() error {
+	resp, body, errs := s.request("GET", "/ready").End()
+	if len(errs) > 0 {
+		return fmt.Errorf("check loki ready failed: %v", errs)
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("loki is not ready: %s", body)
+	}
+	return nil
+}
+
+type pushRequest struct {
+	Streams []stream `json:"streams"`
+}
+
+type stream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+func (s *loki) Save(events []*types.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	sorted := make([]*types.Event, len(events))
+	copy(sorted, events)
+	// entries of a stream are pushed in time order
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RequestReceivedTimestamp < sorted[j].RequestReceivedTimestamp
+	})
+	streams := make(map[string]*stream)
+	var keys []string
+	for _, event := range sorted {
+		labels := eventLabels(event)
+		key := fmt.Sprint(labels)
+		st, ok := streams[key]
+		if !ok {
+			st = &stream{Stream: labels}
+			streams[key] = st
+			keys = append(keys, key)
+		}
+		line, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		ts := strconv.FormatInt(event.RequestReceivedTimestamp*int64(time.Millisecond), 10)
+		st.Values = append(st.Values, [2]string{ts, string(line)})
+	}
+	push := pushRequest{}
+	for _, key := range keys {
+		push.Streams = append(push.Streams, *streams[key])
+	}
+	req := s.request("POST", "/loki/api/v1/push")
+	req.Header["content-type"] = "application/json"
+	resp, body, errs := req.SendStruct(push).End()
+	if len(errs) > 0 {
+		return fmt.Errorf("push audit events failed: %v", errs)
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("push audit events failed: %s", body)
+	}
+	return nil
+}
+
+func eventLabels(event *types.Event) map[string]string {
+	labels := map[string]string{"app": appLabel}
+	values := []string{event.ClusterName, event.Namespace, event.Resource}
+	for i, name := range streamLabels {
+		// loki drops the labels with empty value
+		if values[i] != "" {
+			labels[name] = values[i]
+		}
+	}
+	return labels
+}
+
+// logQL builds the query of the parameter, the labels are matched by the
+// stream selector, the user name and the name by the json parser and the full
+// text search by a case insensitive line filter.
+func logQL(param *storage.QueryParameter) string {
+	selectors := []string{fmt.Sprintf("app=%s", strconv.Quote(appLabel))}
+	values := []string{param.ClusterName, param.Namespace, param.Resource}
+	for i, name := range streamLabels {
+		if values[i] != "" {
+			selectors = append(selectors, fmt.Sprintf("%s=%s", name, strconv.Quote(values[i])))
+		}
+	}
+	query := "{" + strings.Join(selectors, ",") + "}"
+	if param.Query != "" {
+		query += fmt.Sprintf(" |~ %s", strconv.Quote("(?i)"+regexp.QuoteMeta(param.Query)))
+	}
+	if param.UserName != "" || param.Name != "" {
+		query += " | json"
+	}
+	if param.UserName != "" {
+		query += fmt.Sprintf(` | %s=%s`, userNameField, strconv.Quote(param.UserName))
+	}
+	if param.Name != "" {
+		query += fmt.Sprintf(` | name=%s`, strconv.Quote(param.Name))
+	}
+	return query
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []stream `json:"result"`
+	} `json:"data"`
+}
+
+type metricResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  [2]interface{}    `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (s *loki) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
+	if param == nil {
+		param = &storage.QueryParameter{Size: 10}
+	}
+	now := time.Now()
+	start := now.Add(-time.Duration(s.reserveDays) * 24 * time.Hour).UnixNano()
+	end := now.UnixNano()
+	if param.StartTime > 0 {
+		start = param.StartTime * int64(time.Millisecond)
+	}
+	if param.EndTime > 0 {
+		// the end of loki is exclusive
+		end = (param.EndTime + 1) * int64(time.Millisecond)
+	}
+	query := logQL(param)
+	total, err := s.count(query, start, end)
+	if err != nil {
+		return nil, 0, err
+	}
+	want := total
+	if param.Size > 0 && param.Offset+param.Size < want {
+		want = param.Offset + param.Size
+	}
+	events, err := s.queryRange(query, start, end, want)
+	if err != nil {
+		return nil, 0, err
+	}
+	if param.Offset >= len(events) {
+		return []*types.Event{}, total, nil
+	}
+	events = events[param.Offset:]
+	if param.Size > 0 && param.Size < len(events) {
+		events = events[:param.Size]
+	}
+	return events, total, nil
+}
+
+// count returns the number of events matched by the query in [start, end),
+// loki limits the entries returned by a query but not the entries counted.
+func (s *loki) count(query string, start, end int64) (int, error) {
+	if end <= start {
+		return 0, nil
+	}
+	// the range of the instant query is (time-range, time]
+	rangeMillis := (end - start + int64(time.Millisecond) - 1) / int64(time.Millisecond)
+	values := url.Values{}
+	values.Set("query", fmt.Sprintf("sum(count_over_time(%s [%dms]))", query, rangeMillis))
+	values.Set("time", strconv.FormatInt(end-1, 10))
+	result := metricResponse{}
+	if err := s.get("/loki/api/v1/query", values, &result); err != nil {
+		return 0, fmt.Errorf("failed count audit events: %v", err)
+	}
+	if len(result.Data.Result) == 0 {
+		return 0, nil
+	}
+	value, _ := result.Data.Result[0].Value[1].(string)
+	total, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed count audit events: %v", err)
+	}
+	return int(total), nil
+}
+
+// queryRange returns the newest events matched by the query in [start, end)
+// up to the limit, newest first. Loki returns at most maxEntries entries per
+// query, so the range is paged through backward with the time cursor.
+func (s *loki) queryRange(query string, start, end int64, limit int) ([]*types.Event, error) {
+	var events []*types.Event
+	// the entries at the cursor are queried again by the next page, the
+	// ones already returned are skipped.
+	seen := map[string]bool{}
+	for len(events) < limit && end > start {
+		values := url.Values{}
+		values.Set("query", query)
+		values.Set("start", strconv.FormatInt(start, 10))
+		values.Set("end", strconv.FormatInt(end, 10))
+		values.Set("limit", strconv.Itoa(maxEntries))
+		values.Set("direction", "backward")
+		result := queryResponse{}
+		if err := s.get("/loki/api/v1/query_range", values, &result); err != nil {
+			return nil, fmt.Errorf("failed query audit events: %v", err)
+		}
+
+		var (
+			entries int
+			oldest  = end
+			page    []*types.Event
+			current = map[string]bool{}
+		)
+		for _, st := range result.Data.Result {
+			for _, value := range st.Values {
+				entries++
+				ts, err := strconv.ParseInt(value[0], 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("failed query audit events: %v", err)
+				}
+				if ts < oldest {
+					oldest = ts
+				}
+				key := value[0] + value[1]
+				if seen[key] || current[key] {
+					continue
+				}
+				current[key] = true
+				event := &types.Event{}
+				if err := json.Unmarshal([]byte(value[1]), event); err != nil {
+					log.Errorf("skip undecodable audit event: %v", err)
+					continue
+				}
+				page = append(page, event)
+			}
+		}
+		sort.SliceStable(page, func(i, j int) bool {
+			return page[i].RequestReceivedTimestamp > page[j].RequestReceivedTimestamp
+		})
+		events = append(events, page...)
+		if entries < maxEntries {
+			break
+		}
+		if len(current) == 0 {
+			// more than maxEntries events share the timestamp of the cursor
+			log.Warnf("skip audit events exceeding %d at %d", maxEntries, oldest)
+			end = oldest
+			continue
+		}
+		seen = current
+		end = oldest + 1
+	}
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	return events, nil
+}
+
+// chainCursor is the continue token of ListChained, the time of the last
+// entry and the hashes of the entries returned at that time.
+type chainCursor struct {
+	Timestamp int64    `json:"timestamp"`
+	Hashes    []string `json:"hashes"`
+}
+
+// ListChained pages forward through the chained events by the time of the
+// entries, which is the time the events were received at, so the events
+// received earlier than the range by up to the max delay are searched too.
+func (s *loki) ListChained(param *storage.ChainParameter) ([]*types.Event, string, error) {
+	start := (param.StartTime - param.MaxDelay) * int64(time.Millisecond)
+	end := time.Now().UnixNano()
+	query := fmt.Sprintf(`{app=%s} | json | chainID!="" | savedTimestamp>=%d`, strconv.Quote(appLabel), param.StartTime)
+	if param.EndTime > 0 {
+		end = (param.EndTime + 1) * int64(time.Millisecond)
+		query += fmt.Sprintf(" | savedTimestamp<=%d", param.EndTime)
+	}
+	cursor := chainCursor{}
+	if param.Continue != "" {
+		if err := json.Unmarshal([]byte(param.Continue), &cursor); err != nil {
+			return nil, "", fmt.Errorf("invalid continue token %q", param.Continue)
+		}
+		start = cursor.Timestamp
+	}
+	size := param.Size
+	if size <= 0 || size > maxEntries {
+		size = maxEntries
+	}
+	for start < end {
+		values := url.Values{}
+		values.Set("query", query)
+		values.Set("start", strconv.FormatInt(start, 10))
+		values.Set("end", strconv.FormatInt(end, 10))
+		values.Set("limit", strconv.Itoa(size))
+		values.Set("direction", "forward")
+		result := queryResponse{}
+		if err := s.get("/loki/api/v1/query_range", values, &result); err != nil {
+			return nil, "", fmt.Errorf("failed list chained audit events: %v", err)
+		}
+
+		type entry struct {
+			timestamp int64
+			event     *types.Event
+		}
+		var entries []entry
+		for _, st := range result.Data.Result {
+			for _, value := range st.Values {
+				ts, err := strconv.ParseInt(value[0], 10, 64)
+				if err != nil {
+					return nil, "", fmt.Errorf("failed list chained audit events: %v", err)
+				}
+				event := &types.Event{}
+				if err := json.Unmarshal([]byte(value[1]), event); err != nil {
+					log.Errorf("skip undecodable audit event: %v", err)
+					continue
+				}
+				entries = append(entries, entry{timestamp: ts, event: event})
+			}
+		}
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].timestamp < entries[j].timestamp })
+
+		skip := map[string]bool{}
+		for _, hash := range cursor.Hashes {
+			skip[hash] = true
+		}
+		var events []*types.Event
+		next := chainCursor{}
+		for _, e := range entries {
+			if e.timestamp == cursor.Timestamp && skip[e.event.Hash] {
+				continue
+			}
+			events = append(events, e.event)
+			if e.timestamp != next.Timestamp {
+				next = chainCursor{Timestamp: e.timestamp}
+			}
+			next.Hashes = append(next.Hashes, e.event.Hash)
+		}
+		if len(entries) < size {
+			return events, "", nil
+		}
+		if len(events) == 0 {
+			// more than a page of entries share the time of the cursor
+			log.Warnf("skip chained audit events exceeding %d at %d", size, cursor.Timestamp)
+			start, cursor = cursor.Timestamp+1, chainCursor{}
+			continue
+		}
+		if next.Timestamp == cursor.Timestamp {
+			next.Hashes = append(next.Hashes, cursor.Hashes...)
+		}
+		token, err := json.Marshal(next)
+		if err != nil {
+			return nil, "", err
+		}
+		return events, string(token), nil
+	}
+	return []*types.Event{}, "", nil
+}
+
+func (s *loki) get(path string, values url.Values, result interface{}) error {
+	resp, body, errs := s.request("GET", path+"?"+values.Encode()).End()
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s", body)
+	}
+	return json.Unmarshal([]byte(body), result)
+}
+
+func (s *loki) FieldValues() map[string][]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make(map[string][]string)
+	for _, field := range streamLabels {
+		result[field] = s.fieldValues[field]
+	}
+	result[userNameField] = s.fieldValues[userNameField]
+	return result
+}
+
+func (s *loki) updateFieldValues() {
+	start := time.Now().Add(-time.Duration(s.reserveDays) * 24 * time.Hour).UnixNano()
+	tmpMap := make(map[string][]string)
+	for _, field := range streamLabels {
+		values := url.Values{}
+		values.Set("start", strconv.FormatInt(start, 10))
+		// restricts the values to the audit streams since loki 2.8
+		values.Set("query", fmt.Sprintf("{app=%s}", strconv.Quote(appLabel)))
+		resp, body, errs := s.request("GET", fmt.Sprintf("/loki/api/v1/label/%s/values?%s", field, values.Encode())).End()
+		if len(errs) > 0 {
+			log.Errorf("failed update field %s values: %v", field, errs)
+			continue
+		}
+		if resp.StatusCode >= 300 {
+			log.Errorf("failed update field %s values: %s", field, body)
+			continue
+		}
+		result := struct {
+			Data []string `json:"data"`
+		}{}
+		if err := json.Unmarshal([]byte(body), &result); err != nil {
+			log.Errorf("can't get field %s values: %v", field, err)
+			continue
+		}
+		tmpMap[field] = result.Data
+	}
+	if userNames, err := s.userNames(start); err != nil {
+		log.Errorf("failed update field %s values: %v", userNameField, err)
+	} else {
+		tmpMap[userNameField] = userNames
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for field, values := range tmpMap {
+		s.fieldValues[field] = values
+	}
+}
+
+// userNames returns the user names of the events since start, they are not
+// stream labels and are grouped from the log lines by a metric query.
+func (s *loki) userNames(start int64) ([]string, error) {
+	now := time.Now().UnixNano()
+	rangeMillis := (now - start) / int64(time.Millisecond)
+	values := url.Values{}
+	values.Set("query", fmt.Sprintf(`count by (%s) (count_over_time({app=%s} | json %s=%s [%dms]))`,
+		userNameField, strconv.Quote(appLabel), userNameField, strconv.Quote(userNameField), rangeMillis))
+	values.Set("time", strconv.FormatInt(now, 10))
+	result := metricResponse{}
+	if err := s.get("/loki/api/v1/query", values, &result); err != nil {
+		return nil, err
+	}
+	var userNames []string
+	for _, series := range result.Data.Result {
+		if userName := series.Metric[userNameField]; userName != "" {
+			userNames = append(userNames, userName)
+		}
+	}
+	sort.Strings(userNames)
+	return userNames, nil
+}
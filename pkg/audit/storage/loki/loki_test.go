@@ -0,0 +1,43 @@
+package loki
+
+import (
+	"os"
+	"testing"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/storagetest"
+)
+
+// TestConformance runs against the loki given by AUDIT_LOKI_ADDRESS.
+func TestConformance(t *testing.T) {
+	addr := os.Getenv("AUDIT_LOKI_ADDRESS")
+	if addr == "" {
+		t.Skip("AUDIT_LOKI_ADDRESS is not set")
+	}
+	s, err := NewStorage(&config.LokiStorage{Address: addr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	storagetest.RunConformanceTests(t, s)
+}
+
+func TestLogQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		param storage.QueryParameter
+		want  string
+	}{
+		{"all", storage.QueryParameter{}, `{app="tke-audit"}`},
+		{"labels", storage.QueryParameter{ClusterName: "global", Namespace: `a"b`}, `{app="tke-audit",clusterName="global",namespace="a\"b"}`},
+		{"user name", storage.QueryParameter{ClusterName: "global", UserName: "carol"}, `{app="tke-audit",clusterName="global"} | json | userName="carol"`},
+		{"full text and name", storage.QueryParameter{Query: "a.b", Name: "web"}, `{app="tke-audit"} |~ "(?i)a\\.b" | json | name="web"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := logQL(&tt.param); got != tt.want {
+				t.Errorf("logQL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,8 +15,29 @@ type QueryParameter struct {
 	Query       string
 }
 
+// ChainParameter selects a page of the chained events saved within a time
+// range.
+type ChainParameter struct {
+	// StartTime and EndTime are the range of the saved time in milliseconds.
+	StartTime int64
+	EndTime   int64
+	// MaxDelay is how long in milliseconds an event may be received before
+	// it is saved, for the storages which only index the received time.
+	MaxDelay int64
+	// Continue is the token returned with the previous page, it is empty for
+	// the first page.
+	Continue string
+	Size     int
+}
+
 type AuditStorage interface {
 	Query(param *QueryParameter) ([]*types.Event, int, error)
+	// ListChained returns a page of the chained events in a stable order, in
+	// which the events of a chain are in about the order of their sequences,
+	// and the token of the next page, which is empty after the last page.
+	// Unlike the offset of Query, the token is not moved by the events saved
+	// meanwhile.
+	ListChained(param *ChainParameter) ([]*types.Event, string, error)
 	Save([]*types.Event) error
 	// list option values for field
 	FieldValues() map[string][]string
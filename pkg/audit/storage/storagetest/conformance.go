@@ -0,0 +1,193 @@
+// Package storagetest provides the conformance tests which every audit storage
+// backend must pass.
+package storagetest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+// Timeout is how long the suite waits for the saved events to be searchable,
+// some backends index the events asynchronously.
+var Timeout = 2 * time.Minute
+
+// RunConformanceTests saves a set of events to the storage and checks the
+// filtering, ordering, pagination and field values of the queries. The events
+// carry a cluster name unique to the run so that the suite can share a
+// backend with other data.
+func RunConformanceTests(t *testing.T, s storage.AuditStorage) {
+	s.Start()
+	defer s.Stop()
+
+	cluster := fmt.Sprintf("conformance-%d", time.Now().UnixNano())
+	// events are stored with a second precision by the audit api
+	base := time.Now().Add(-time.Hour).Unix() * 1000
+	events := []*types.Event{
+		newEvent(cluster, "e0", base, "alice", "default", "pods", "web", ""),
+		newEvent(cluster, "e1", base+1000, "bob", "default", "deployments", "web", ""),
+		newEvent(cluster, "e2", base+2000, "alice", "kube-system", "pods", "dns", "ConformanceNeedle in the message"),
+		newEvent(cluster, "e3", base+3000, "carol", "", "namespaces", "default", ""),
+		newEvent(cluster, "e4", base+4000, "alice", "default", "pods", "api", ""),
+	}
+	if err := s.Save(events); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		param     storage.QueryParameter
+		wantIDs   []string
+		wantTotal int
+	}{
+		{"all newest first", storage.QueryParameter{Size: 10}, []string{"e4", "e3", "e2", "e1", "e0"}, 5},
+		{"page", storage.QueryParameter{Offset: 1, Size: 2}, []string{"e3", "e2"}, 5},
+		{"page beyond end", storage.QueryParameter{Offset: 10, Size: 2}, nil, 5},
+		{"user", storage.QueryParameter{UserName: "alice", Size: 10}, []string{"e4", "e2", "e0"}, 3},
+		{"namespace", storage.QueryParameter{Namespace: "default", Size: 10}, []string{"e4", "e1", "e0"}, 3},
+		{"resource", storage.QueryParameter{Resource: "deployments", Size: 10}, []string{"e1"}, 1},
+		{"name", storage.QueryParameter{Name: "web", Size: 10}, []string{"e1", "e0"}, 2},
+		{"combined", storage.QueryParameter{UserName: "alice", Resource: "pods", Namespace: "default", Size: 10}, []string{"e4", "e0"}, 2},
+		{"time range", storage.QueryParameter{StartTime: base + 1000, EndTime: base + 3000, Size: 10}, []string{"e3", "e2", "e1"}, 3},
+		{"start time", storage.QueryParameter{StartTime: base + 3000, Size: 10}, []string{"e4", "e3"}, 2},
+		{"end time", storage.QueryParameter{EndTime: base + 1000, Size: 10}, []string{"e1", "e0"}, 2},
+		{"full text", storage.QueryParameter{Query: "conformanceneedle", Size: 10}, []string{"e2"}, 1},
+		{"no match", storage.QueryParameter{UserName: "nobody", Size: 10}, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param := tt.param
+			param.ClusterName = cluster
+			var (
+				gotIDs   []string
+				gotTotal int
+				lastErr  error
+			)
+			err := wait.PollImmediate(time.Second, Timeout, func() (bool, error) {
+				got, total, err := s.Query(&param)
+				if err != nil {
+					lastErr = err
+					return false, nil
+				}
+				gotIDs, gotTotal = auditIDs(got), total
+				return reflect.DeepEqual(gotIDs, tt.wantIDs) && gotTotal == tt.wantTotal, nil
+			})
+			if err != nil {
+				t.Errorf("Query() = %v, total %d, error %v, want %v, total %d", gotIDs, gotTotal, lastErr, tt.wantIDs, tt.wantTotal)
+			}
+		})
+	}
+
+	t.Run("events are returned intact", func(t *testing.T) {
+		got, _, err := s.Query(&storage.QueryParameter{ClusterName: cluster, Resource: "namespaces", Size: 1})
+		if err != nil || len(got) != 1 {
+			t.Fatalf("Query() = %v, error %v, want one event", got, err)
+		}
+		if !reflect.DeepEqual(got[0], events[3]) {
+			t.Errorf("Query() = %+v, want %+v", got[0], events[3])
+		}
+	})
+
+	t.Run("chained events", func(t *testing.T) {
+		chained := make([]*types.Event, 0, 6)
+		for i := 0; i < 6; i++ {
+			event := newEvent(cluster, fmt.Sprintf("c%d", i), base+int64(i)*1000, "alice", "default", "pods", "web", "")
+			event.ChainID = fmt.Sprintf("%s-%d", cluster, i%2)
+			event.Sequence = int64(i/2 + 1)
+			event.SavedTimestamp = base + int64(i/2)*1000
+			event.Hash = event.AuditID
+			chained = append(chained, event)
+		}
+		if err := s.Save(chained); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+		want := []string{"c0", "c1", "c2", "c3", "c4", "c5"}
+		var got []string
+		err := wait.PollImmediate(time.Second, Timeout, func() (bool, error) {
+			got = nil
+			param := &storage.ChainParameter{StartTime: base, EndTime: base + 2000, Size: 2}
+			for pages := 0; pages < 100; pages++ {
+				page, next, err := s.ListChained(param)
+				if err != nil {
+					return false, nil
+				}
+				for _, event := range page {
+					if strings.HasPrefix(event.ChainID, cluster) {
+						got = append(got, event.AuditID)
+					}
+				}
+				if next == "" {
+					break
+				}
+				param.Continue = next
+			}
+			sort.Strings(got)
+			return reflect.DeepEqual(got, want), nil
+		})
+		if err != nil {
+			t.Errorf("ListChained() = %v, want %v once each", got, want)
+		}
+	})
+
+	t.Run("field values", func(t *testing.T) {
+		var values map[string][]string
+		err := wait.PollImmediate(time.Second, Timeout, func() (bool, error) {
+			values = s.FieldValues()
+			return contains(values["clusterName"], cluster) &&
+				contains(values["userName"], "carol") &&
+				contains(values["namespace"], "kube-system") &&
+				contains(values["resource"], "deployments") &&
+				!contains(values["namespace"], ""), nil
+		})
+		if err != nil {
+			t.Errorf("FieldValues() = %v, want the values of the saved events", values)
+		}
+	})
+}
+
+func newEvent(cluster, auditID string, timestamp int64, userName, namespace, resource, name, message string) *types.Event {
+	return &types.Event{
+		AuditID:                  auditID,
+		Stage:                    "ResponseComplete",
+		RequestURI:               fmt.Sprintf("/api/v1/namespaces/%s/%s/%s", namespace, resource, name),
+		Verb:                     "update",
+		UserName:                 userName,
+		UserAgent:                "conformance",
+		Resource:                 resource,
+		Namespace:                namespace,
+		Name:                     name,
+		APIVersion:               "v1",
+		SourceIPs:                "10.0.0.1",
+		Status:                   "Success",
+		Message:                  message,
+		Code:                     200,
+		RequestObject:            `{"kind":"Pod"}`,
+		RequestReceivedTimestamp: timestamp,
+		StageTimestamp:           timestamp,
+		ClusterName:              cluster,
+	}
+}
+
+func auditIDs(events []*types.Event) []string {
+	var ids []string
+	for _, event := range events {
+		ids = append(ids, event.AuditID)
+	}
+	return ids
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
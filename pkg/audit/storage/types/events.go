@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	authnv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -9,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apiserver/pkg/apis/audit"
 	"strings"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -43,6 +45,40 @@ type Event struct {
 	StageTimestamp           int64 `json:"stageTimestamp"`
 
 	ClusterName string `json:"clusterName"`
+
+	// The position of the event in the hash chain of the audit server which
+	// stored it, see pkg/audit/storage/chain.
+	ChainID        string `json:"chainID,omitempty"`
+	Sequence       int64  `json:"sequence,omitempty"`
+	SavedTimestamp int64  `json:"savedTimestamp,omitempty"`
+	PrevHash       string `json:"prevHash,omitempty"`
+	Hash           string `json:"hash,omitempty"`
+	// ExpireTimestamp is when the event may be removed, the reserve days of
+	// the storage apply if it is not set.
+	ExpireTimestamp int64 `json:"expireTimestamp,omitempty"`
+
+	// Groups are the groups of the user, which are only used to filter the
+	// events and are not stored.
+	Groups []string `json:"-"`
+	// TenantID is the tenant of the user, which is only used to match the
+	// audit rules of the tenant and is not stored.
+	TenantID string `json:"-"`
+}
+
+// ResourcePath returns the resource of the event followed by its subresource,
+// such as pods/exec. The subresource is only found in the request uri.
+func (e *Event) ResourcePath() string {
+	if e.Resource == "" || e.Name == "" {
+		return e.Resource
+	}
+	path := strings.SplitN(e.RequestURI, "?", 2)[0]
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+2 < len(segments); i++ {
+		if segments[i] == e.Resource && segments[i+1] == e.Name {
+			return e.Resource + "/" + segments[i+2]
+		}
+	}
+	return e.Resource
 }
 
 func convertK8sEvent(event audit.Event) ([]*Event, error) {
@@ -52,6 +88,8 @@ func convertK8sEvent(event audit.Event) ([]*Event, error) {
 		RequestURI:               event.RequestURI,
 		Verb:                     event.Verb,
 		UserName:                 event.User.Username,
+		Groups:                   event.User.Groups,
+		TenantID:                 tenantID(event.User.Extra),
 		SourceIPs:                strings.Join(event.SourceIPs, ","),
 		UserAgent:                event.UserAgent,
 		RequestObject:            convertUnknown(event.RequestObject),
@@ -108,6 +146,13 @@ func ConvertEvents(events []audit.Event) []*Event {
 	return result
 }
 
+func tenantID(extra map[string]authnv1.ExtraValue) string {
+	if values := extra[genericoidc.TenantIDKey]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
 func extractMetadata(obj runtime.Object) (apiVersion, kind, namespace, name string, uid types.UID) {
 	metaAccessor := meta.NewAccessor()
 	name, _ = metaAccessor.Name(obj)
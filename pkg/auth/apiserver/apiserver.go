@@ -32,6 +32,8 @@ import (
 	"tkestack.io/tke/api/auth"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/oauth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
 	local2 "tkestack.io/tke/pkg/auth/authorization/local"
 
 	dexstorage "github.com/dexidp/dex/storage"
@@ -46,6 +48,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	restclient "k8s.io/client-go/rest"
 
 	authv1 "tkestack.io/tke/api/auth/v1"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
@@ -53,9 +56,13 @@ import (
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/pkg/apiserver/storage"
 	"tkestack.io/tke/pkg/auth/authentication/authenticator"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
 	authnhandler "tkestack.io/tke/pkg/auth/handler/authn"
 	authzhandler "tkestack.io/tke/pkg/auth/handler/authz"
+	mfahandler "tkestack.io/tke/pkg/auth/handler/mfa"
+	scimhandler "tkestack.io/tke/pkg/auth/handler/scim"
 	authrest "tkestack.io/tke/pkg/auth/registry/rest"
 	"tkestack.io/tke/pkg/auth/route"
 	"tkestack.io/tke/pkg/util/log"
@@ -66,6 +73,7 @@ import (
 const (
 	OIDCPath           = "/oidc/"
 	AuthPath           = "/auth/"
+	SCIMPath           = "/scim/"
 	APIKeyPasswordPath = "/apis/auth.tkestack.io/v1/apikeys/default/password"
 
 	APIKeyPath           = "/apis/auth.tkestack.io/v1/apikeys"
@@ -228,7 +236,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	installHooks(s, hooks)
 	installCasbinPreStopHook(s, c.ExtraConfig.CasbinEnforcer)
 
-	c.registerRoute(&dexHandler, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux)
+	c.registerRoute(&dexHandler, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux, s.LoopbackClientConfig)
 
 	m := &APIServer{
 		GenericAPIServer: s,
@@ -295,12 +303,16 @@ func DefaultAPIResourceConfigSource() *serverstorage.ResourceConfig {
 }
 
 // registerRoute is used to register routes with the api server of project.
-func (c completedConfig) registerRoute(dexHandler http.Handler, container *restful.Container, mux *mux.PathRecorderMux) {
-	mux.HandlePrefix("/"+auth.IssuerName+"/", dexHandler)
+func (c completedConfig) registerRoute(dexHandler http.Handler, container *restful.Container, mux *mux.PathRecorderMux, loopbackClientConfig *restclient.Config) {
+	mux.HandlePrefix("/"+auth.IssuerName+"/", mfa.WithFactorForm(passwordpolicy.WithNewPasswordForm(dexHandler)))
 
+	authClient := authinternalclient.NewForConfigOrDie(loopbackClientConfig)
 	token := authnhandler.NewHandler(c.ExtraConfig.TokenAuthn, c.ExtraConfig.APIKeyAuthn)
 	authz := authzhandler.NewHandler(c.ExtraConfig.Authorizer)
-	route.RegisterAuthRoute(container, token, authz)
+	route.RegisterAuthRoute(container, token, authz, mfahandler.NewHandler(authClient))
+
+	scim := scimhandler.NewHandler(loopbackClientConfig, authClient, c.ExtraConfig.CasbinEnforcer)
+	route.RegisterSCIMRoute(container, scim)
 }
 
 // registerHooks is used to register postStart hook to create authn provider with local oidc server.
@@ -316,11 +328,13 @@ func (c completedConfig) registerHooks(dexHandler *identityprovider.DexHander, s
 	localIdpHook := local.NewLocalHookHandler(authClient)
 	ldapIdpHook := ldap.NewLdapHookHandler(authClient)
 	cloudIndustryIdpHook := cloudindustry.NewCloudIndustryHookHandler(authClient)
+	samlIdpHook := saml.NewSAMLHookHandler(authClient)
+	oauthIdpHook := oauth.NewOAuthHookHandler(authClient)
 
 	authVersionedClient := versionedclientset.NewForConfigOrDie(s.LoopbackClientConfig)
 	adapterHook := local2.NewAdapterHookHandler(authVersionedClient, c.ExtraConfig.CasbinEnforcer, c.ExtraConfig.VersionedInformers, c.ExtraConfig.CasbinReloadInterval)
 
-	return []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, cloudIndustryIdpHook, adapterHook}
+	return []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, cloudIndustryIdpHook, samlIdpHook, oauthIdpHook, adapterHook}
 }
 
 // installCasbinPreStopHook is used to register preStop hook to stop casbin enforcer sync.
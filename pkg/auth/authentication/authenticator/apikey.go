@@ -30,6 +30,7 @@ import (
 
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -80,6 +81,22 @@ func (h *APIKeyAuthenticator) AuthenticateToken(ctx context.Context, token strin
 		return nil, false, fmt.Errorf("api key has been disabled")
 	}
 
+	if util.APIKeyRetired(&apiKey, startTime) {
+		log.Info("Api key has been rotated and retired", log.String("api key", apiKey.Name), log.String("successor", apiKey.Status.Successor))
+		return nil, false, fmt.Errorf("api key has been rotated to %s and retired", apiKey.Status.Successor)
+	}
+
+	// The source ip is unknown when the token is reviewed for other api
+	// servers, the local authorizer checks it with the ip of the review then.
+	sourceIP := genericfilter.SourceIPFrom(ctx)
+	if sourceIP != nil {
+		if err := util.CheckAPIKeySourceIP(&apiKey, sourceIP); err != nil {
+			log.Info("Api key is used from a disallowed address", log.String("api key", apiKey.Name), log.Err(err))
+			return nil, false, err
+		}
+	}
+	util.RecordAPIKeyUsage(ctx, h.authClient, &apiKey, sourceIP, startTime)
+
 	info := &user.DefaultInfo{Name: tokenInfo.UserName}
 
 	user, err := util.GetUserByName(ctx, h.authClient, tokenInfo.TenantID, info.Name)
@@ -100,6 +117,7 @@ func (h *APIKeyAuthenticator) AuthenticateToken(ctx context.Context, token strin
 	info.Extra["expireAt"] = []string{time.Unix(tokenInfo.ExpiresAt, 0).String()}
 	info.Extra["issueAt"] = []string{time.Unix(tokenInfo.IssuedAt, 0).String()}
 	info.Extra["description"] = []string{apiKey.Spec.Description}
+	info.Extra[util.APIKeyExtraKey] = []string{apiKey.Name}
 
 	log.Debug("APIkey authenticateToken result", log.Any("user info", info))
 	return &genericauthenticator.Response{User: info}, true, nil
@@ -67,12 +67,16 @@ func (h *TokenAuthenticator) AuthenticateToken(ctx context.Context, token string
 	}
 
 	info := &user.DefaultInfo{Name: claims.Name, UID: claims.FederatedIDClaims.UserID}
-	info.Groups = claims.Groups
+	groups, multiFactor := genericoidc.SplitReservedGroups(claims.Groups)
+	info.Groups = groups
 
 	info.Extra = map[string][]string{}
 	info.Extra[genericoidc.TenantIDKey] = []string{claims.FederatedIDClaims.ConnectorID}
 	info.Extra["expireAt"] = []string{time.Unix(claims.Expiry, 0).String()}
 	info.Extra["issueAt"] = []string{time.Unix(claims.IssuedAt, 0).String()}
+	if multiFactor {
+		info.Extra[genericoidc.MultiFactorKey] = []string{"true"}
+	}
 	log.Debug("OIDC authenticateToken result", log.Any("user info", info))
 	return &genericauthenticator.Response{User: info}, true, nil
 }
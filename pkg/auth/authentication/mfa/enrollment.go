@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+// The names of the second factors.
+const (
+	FactorTOTP         = "TOTP"
+	FactorWebAuthn     = "WebAuthn"
+	FactorRecoveryCode = "RecoveryCode"
+)
+
+// ChallengeTimeout is the duration a webauthn challenge is valid for.
+const ChallengeTimeout = 5 * time.Minute
+
+// maxChallenges is the maximum number of pending challenges of an enrollment.
+const maxChallenges = 10
+
+// Factors returns the names of the second factors usable to log in.
+func Factors(enrollment *auth.MultiFactorEnrollment) []string {
+	var factors []string
+	if enrollment == nil {
+		return factors
+	}
+	if enrollment.Spec.TOTP != nil && enrollment.Spec.TOTP.Verified {
+		factors = append(factors, FactorTOTP)
+	}
+	if len(enrollment.Spec.WebAuthn) > 0 {
+		factors = append(factors, FactorWebAuthn)
+	}
+	if len(enrollment.Spec.RecoveryCodes) > 0 {
+		factors = append(factors, FactorRecoveryCode)
+	}
+	return factors
+}
+
+// Enrolled returns whether the enrollment has a second factor other than
+// recovery codes, which are only a fallback of the others.
+func Enrolled(enrollment *auth.MultiFactorEnrollment) bool {
+	if enrollment == nil {
+		return false
+	}
+	return (enrollment.Spec.TOTP != nil && enrollment.Spec.TOTP.Verified) || len(enrollment.Spec.WebAuthn) > 0
+}
+
+// AddChallenge issues a new webauthn challenge for the purpose, expired
+// challenges are removed.
+func AddChallenge(enrollment *auth.MultiFactorEnrollment, purpose auth.WebAuthnPurpose, now time.Time) (string, error) {
+	challenge, err := NewChallenge()
+	if err != nil {
+		return "", err
+	}
+
+	var challenges []auth.WebAuthnChallenge
+	for _, c := range enrollment.Status.Challenges {
+		if c.ExpireTime.Time.After(now) {
+			challenges = append(challenges, c)
+		}
+	}
+	if len(challenges) >= maxChallenges {
+		challenges = challenges[len(challenges)-maxChallenges+1:]
+	}
+	enrollment.Status.Challenges = append(challenges, auth.WebAuthnChallenge{
+		Challenge:  challenge,
+		Purpose:    purpose,
+		ExpireTime: metav1.NewTime(now.Add(ChallengeTimeout)),
+	})
+	return challenge, nil
+}
+
+// TakeChallenge removes the challenge from the enrollment, and returns whether
+// it is a pending challenge of the purpose.
+func TakeChallenge(enrollment *auth.MultiFactorEnrollment, purpose auth.WebAuthnPurpose, challenge string, now time.Time) bool {
+	found := false
+	var challenges []auth.WebAuthnChallenge
+	for _, c := range enrollment.Status.Challenges {
+		if c.Challenge == challenge && c.Purpose == purpose {
+			found = c.ExpireTime.Time.After(now)
+			continue
+		}
+		challenges = append(challenges, c)
+	}
+	enrollment.Status.Challenges = challenges
+	return found
+}
+
+// Verify verifies the second factor against the enrollment. The enrollment is
+// modified on success, such as consuming the recovery code, and must be saved
+// by the caller.
+func Verify(enrollment *auth.MultiFactorEnrollment, factor *Factor, rp *RelyingParty, now time.Time) error {
+	if factor.IsEmpty() {
+		return fmt.Errorf("second factor is required")
+	}
+
+	if factor.WebAuthn != nil {
+		if rp == nil {
+			return fmt.Errorf("webauthn is not configured")
+		}
+		challenge, err := ChallengeOf(factor.WebAuthn)
+		if err != nil {
+			return err
+		}
+		if !TakeChallenge(enrollment, auth.WebAuthnPurposeAuthentication, challenge, now) {
+			return fmt.Errorf("challenge is expired or unknown")
+		}
+		for i := range enrollment.Spec.WebAuthn {
+			credential := &enrollment.Spec.WebAuthn[i]
+			if credential.ID != factor.WebAuthn.ID {
+				continue
+			}
+			signCount, err := rp.VerifyAssertion(credential, factor.WebAuthn, challenge)
+			if err != nil {
+				return err
+			}
+			credential.SignCount = signCount
+			credential.LastUsedTime = metav1.NewTime(now)
+			enrollment.Status.LastVerifiedTime = metav1.NewTime(now)
+			return nil
+		}
+		return fmt.Errorf("unknown webauthn credential %q", factor.WebAuthn.ID)
+	}
+
+	if totp := enrollment.Spec.TOTP; totp != nil && totp.Verified && ValidateTOTP(totp.Secret, factor.Code, now) {
+		enrollment.Status.LastVerifiedTime = metav1.NewTime(now)
+		return nil
+	}
+
+	if i := MatchRecoveryCode(enrollment.Spec.RecoveryCodes, factor.Code); i >= 0 {
+		codes := enrollment.Spec.RecoveryCodes
+		enrollment.Spec.RecoveryCodes = append(codes[:i:i], codes[i+1:]...)
+		enrollment.Status.LastVerifiedTime = metav1.NewTime(now)
+		return nil
+	}
+	return fmt.Errorf("invalid one-time password or recovery code")
+}
@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	codes, hashes, err := GenerateRecoveryCodes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("GenerateRecoveryCodes() returned %d codes", len(codes))
+	}
+
+	enrollment := &auth.MultiFactorEnrollment{
+		Spec: auth.MultiFactorEnrollmentSpec{
+			TOTP:          &auth.TOTPCredential{Secret: rfc6238Secret},
+			RecoveryCodes: hashes,
+		},
+	}
+	if Enrolled(enrollment) {
+		t.Errorf("Enrolled() of an unverified totp secret = true")
+	}
+	if err := Verify(enrollment, &Factor{Code: "005924"}, nil, now); err == nil {
+		t.Errorf("Verify() of an unverified totp secret expected an error")
+	}
+
+	enrollment.Spec.TOTP.Verified = true
+	if !reflect.DeepEqual(Factors(enrollment), []string{FactorTOTP, FactorRecoveryCode}) {
+		t.Errorf("Factors() = %v", Factors(enrollment))
+	}
+	if err := Verify(enrollment, &Factor{Code: "005924"}, nil, now); err != nil {
+		t.Errorf("Verify() of the totp code error = %v", err)
+	}
+	if err := Verify(enrollment, &Factor{}, nil, now); err == nil {
+		t.Errorf("Verify() without factor expected an error")
+	}
+
+	if err := Verify(enrollment, &Factor{Code: " " + codes[3] + " "}, nil, now); err != nil {
+		t.Errorf("Verify() of a recovery code error = %v", err)
+	}
+	if len(enrollment.Spec.RecoveryCodes) != RecoveryCodeCount-1 {
+		t.Errorf("Verify() must consume the recovery code")
+	}
+	if err := Verify(enrollment, &Factor{Code: codes[3]}, nil, now); err == nil {
+		t.Errorf("Verify() of a used recovery code expected an error")
+	}
+	if err := Verify(enrollment, &Factor{Code: codes[4]}, nil, now); err != nil {
+		t.Errorf("Verify() of another recovery code error = %v", err)
+	}
+}
+
+func TestChallenge(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	enrollment := &auth.MultiFactorEnrollment{}
+
+	expired, _ := AddChallenge(enrollment, auth.WebAuthnPurposeAuthentication, now.Add(-ChallengeTimeout-time.Second))
+	challenge, err := AddChallenge(enrollment, auth.WebAuthnPurposeAuthentication, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enrollment.Status.Challenges) != 1 {
+		t.Errorf("AddChallenge() must remove expired challenges, got %d", len(enrollment.Status.Challenges))
+	}
+	if TakeChallenge(enrollment, auth.WebAuthnPurposeAuthentication, expired, now) {
+		t.Errorf("TakeChallenge() of an expired challenge = true")
+	}
+	if TakeChallenge(enrollment, auth.WebAuthnPurposeRegistration, challenge, now) {
+		t.Errorf("TakeChallenge() of another purpose = true")
+	}
+
+	challenge, _ = AddChallenge(enrollment, auth.WebAuthnPurposeAuthentication, now)
+	if !TakeChallenge(enrollment, auth.WebAuthnPurposeAuthentication, challenge, now) {
+		t.Errorf("TakeChallenge() = false")
+	}
+	if TakeChallenge(enrollment, auth.WebAuthnPurposeAuthentication, challenge, now) {
+		t.Errorf("TakeChallenge() of a used challenge = true")
+	}
+}
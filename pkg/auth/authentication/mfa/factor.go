@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// CodeFormKey is the form field of the login page holding the one-time
+	// password or a recovery code.
+	CodeFormKey = "otp"
+	// WebAuthnFormKey is the form field of the login page holding the json
+	// encoded webauthn assertion.
+	WebAuthnFormKey = "webauthn"
+)
+
+// Factor is the second factor submitted together with the password.
+type Factor struct {
+	// Code is the one-time password or a recovery code.
+	Code string
+	// WebAuthn is the assertion of a webauthn authenticator.
+	WebAuthn *Assertion
+}
+
+// IsEmpty returns whether no second factor is submitted.
+func (f *Factor) IsEmpty() bool {
+	return f == nil || (f.Code == "" && f.WebAuthn == nil)
+}
+
+type factorKey struct{}
+
+// WithFactor returns a copy of the context carrying the second factor.
+func WithFactor(ctx context.Context, factor *Factor) context.Context {
+	return context.WithValue(ctx, factorKey{}, factor)
+}
+
+// FactorFrom returns the second factor carried by the context.
+func FactorFrom(ctx context.Context) *Factor {
+	factor, _ := ctx.Value(factorKey{}).(*Factor)
+	return factor
+}
+
+// WithFactorForm reads the second factor from the posted login form and puts
+// it into the request context, where the password connectors find it.
+func WithFactorForm(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if err := req.ParseForm(); err != nil {
+			handler.ServeHTTP(w, req)
+			return
+		}
+
+		factor := &Factor{Code: req.PostForm.Get(CodeFormKey)}
+		if v := req.PostForm.Get(WebAuthnFormKey); v != "" {
+			assertion := &Assertion{}
+			if err := json.Unmarshal([]byte(v), assertion); err != nil {
+				log.Warn("Invalid webauthn assertion of the login form", log.Err(err))
+			} else {
+				factor.WebAuthn = assertion
+			}
+		}
+		if !factor.IsEmpty() {
+			req = req.WithContext(WithFactor(req.Context(), factor))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
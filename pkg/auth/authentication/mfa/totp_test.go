@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfc6238Secret is the base32 encoding of the sha1 secret of RFC 6238 test vectors.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := TOTPCode(rfc6238Secret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("TOTPCode() error = %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("TOTPCode(%d) = %v, want %v", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	if !ValidateTOTP(rfc6238Secret, "005924", now) {
+		t.Errorf("ValidateTOTP() of the current code = false")
+	}
+	if !ValidateTOTP(rfc6238Secret, "005924", now.Add(30*time.Second)) {
+		t.Errorf("ValidateTOTP() of the previous code = false")
+	}
+	if ValidateTOTP(rfc6238Secret, "005924", now.Add(90*time.Second)) {
+		t.Errorf("ValidateTOTP() of an expired code = true")
+	}
+	if ValidateTOTP(rfc6238Secret, "", now) || ValidateTOTP("invalid!", "005924", now) {
+		t.Errorf("ValidateTOTP() of invalid input = true")
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := TOTPCode(secret, time.Now())
+	if err != nil {
+		t.Fatalf("TOTPCode() of the generated secret error = %v", err)
+	}
+	if !ValidateTOTP(secret, code, time.Now()) {
+		t.Errorf("ValidateTOTP() of the generated secret = false")
+	}
+	uri := TOTPURI("TKEStack", "admin", secret)
+	if !strings.HasPrefix(uri, "otpauth://totp/TKEStack:admin?") || !strings.Contains(uri, "secret="+secret) {
+		t.Errorf("TOTPURI() = %v", uri)
+	}
+}
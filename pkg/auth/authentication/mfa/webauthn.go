@@ -0,0 +1,268 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// The COSE algorithm identifiers of the supported public keys.
+const (
+	AlgorithmES256 int64 = -7
+	AlgorithmEdDSA int64 = -8
+	AlgorithmRS256 int64 = -257
+)
+
+const (
+	clientDataCreate = "webauthn.create"
+	clientDataGet    = "webauthn.get"
+
+	// flagUserPresent is the flag of authenticator data indicating the user
+	// touched the authenticator.
+	flagUserPresent = 0x01
+	// authenticatorDataMinLength is the length of the rp id hash, the flags
+	// and the signature counter.
+	authenticatorDataMinLength = 37
+)
+
+// RelyingParty is the webauthn relying party, which is the platform serving
+// the login page.
+type RelyingParty struct {
+	// ID is the effective domain of the platform.
+	ID string
+	// Origin is the origin of the pages calling the webauthn api.
+	Origin string
+}
+
+// NewRelyingParty creates the relying party of the external address of the
+// platform.
+func NewRelyingParty(externalAddress string) (*RelyingParty, error) {
+	u, err := url.Parse(externalAddress)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid external address %q", externalAddress)
+	}
+	return &RelyingParty{
+		ID:     u.Hostname(),
+		Origin: fmt.Sprintf("%s://%s", u.Scheme, u.Host),
+	}, nil
+}
+
+var relyingParty *RelyingParty
+
+// SetupRelyingParty sets the relying party used by the login flow and the
+// mfa subresource of local identities.
+func SetupRelyingParty(externalAddress string) error {
+	rp, err := NewRelyingParty(externalAddress)
+	if err != nil {
+		return err
+	}
+	relyingParty = rp
+	return nil
+}
+
+// DefaultRelyingParty returns the relying party set up by SetupRelyingParty,
+// webauthn is not available if it returns nil.
+func DefaultRelyingParty() *RelyingParty {
+	return relyingParty
+}
+
+// Assertion is the response of the authenticator for a login, all fields are
+// base64url encoded.
+type Assertion struct {
+	ID                string `json:"id"`
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// NewChallenge generates a base64url encoded random challenge.
+func NewChallenge() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// VerifyRegistration verifies the credential is created for the challenge by
+// the platform, and its public key is supported.
+func (rp *RelyingParty) VerifyRegistration(registration *auth.WebAuthnRegistration, challenge string) error {
+	if registration.ID == "" {
+		return fmt.Errorf("credential id is required")
+	}
+	data, err := decodeBase64(registration.ClientDataJSON)
+	if err != nil {
+		return fmt.Errorf("invalid client data: %v", err)
+	}
+	if err := rp.verifyClientData(data, clientDataCreate, challenge); err != nil {
+		return err
+	}
+	_, err = parsePublicKey(registration.PublicKey, registration.Algorithm)
+	return err
+}
+
+// ChallengeOf returns the challenge the client data of the assertion is
+// created for.
+func ChallengeOf(assertion *Assertion) (string, error) {
+	data, err := decodeBase64(assertion.ClientDataJSON)
+	if err != nil {
+		return "", fmt.Errorf("invalid client data: %v", err)
+	}
+	c := clientData{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return "", fmt.Errorf("invalid client data: %v", err)
+	}
+	return c.Challenge, nil
+}
+
+// VerifyAssertion verifies the assertion is signed by the credential for the
+// challenge, and returns the signature counter of the authenticator.
+func (rp *RelyingParty) VerifyAssertion(credential *auth.WebAuthnCredential, assertion *Assertion, challenge string) (int64, error) {
+	data, err := decodeBase64(assertion.ClientDataJSON)
+	if err != nil {
+		return 0, fmt.Errorf("invalid client data: %v", err)
+	}
+	if err := rp.verifyClientData(data, clientDataGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := decodeBase64(assertion.AuthenticatorData)
+	if err != nil || len(authData) < authenticatorDataMinLength {
+		return 0, fmt.Errorf("invalid authenticator data")
+	}
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, fmt.Errorf("authenticator data is not created for %s", rp.ID)
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return 0, fmt.Errorf("user is not present")
+	}
+	signCount := int64(binary.BigEndian.Uint32(authData[33:37]))
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return 0, fmt.Errorf("signature counter goes backwards, the authenticator may be cloned")
+	}
+
+	signature, err := decodeBase64(assertion.Signature)
+	if err != nil {
+		return 0, fmt.Errorf("invalid signature: %v", err)
+	}
+	clientDataHash := sha256.Sum256(data)
+	signed := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	publicKey, err := parsePublicKey(credential.PublicKey, credential.Algorithm)
+	if err != nil {
+		return 0, err
+	}
+	if err := verifySignature(publicKey, signed, signature); err != nil {
+		return 0, err
+	}
+	return signCount, nil
+}
+
+func (rp *RelyingParty) verifyClientData(data []byte, typ string, challenge string) error {
+	c := clientData{}
+	if err := json.Unmarshal(data, &c); err != nil {
+		return fmt.Errorf("invalid client data: %v", err)
+	}
+	if c.Type != typ {
+		return fmt.Errorf("unexpected client data type %q", c.Type)
+	}
+	if challenge == "" || strings.TrimRight(c.Challenge, "=") != strings.TrimRight(challenge, "=") {
+		return fmt.Errorf("challenge mismatched")
+	}
+	if c.Origin != rp.Origin {
+		return fmt.Errorf("unexpected origin %q", c.Origin)
+	}
+	return nil
+}
+
+func parsePublicKey(publicKey string, algorithm int64) (crypto.PublicKey, error) {
+	der, err := decodeBase64(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %v", err)
+	}
+
+	ok := false
+	switch key.(type) {
+	case *ecdsa.PublicKey:
+		ok = algorithm == AlgorithmES256
+	case ed25519.PublicKey:
+		ok = algorithm == AlgorithmEdDSA
+	case *rsa.PublicKey:
+		ok = algorithm == AlgorithmRS256
+	}
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key algorithm %d", algorithm)
+	}
+	return key, nil
+}
+
+func verifySignature(publicKey crypto.PublicKey, signed, signature []byte) error {
+	digest := sha256.Sum256(signed)
+	valid := false
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(key, signed, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+	if !valid {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+// decodeBase64 decodes both the base64url encoding used by webauthn and the
+// standard encoding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "+/") {
+		return base64.RawStdEncoding.DecodeString(s)
+	}
+	return base64.RawURLEncoding.DecodeString(s)
+}
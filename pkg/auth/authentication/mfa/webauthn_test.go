@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"tkestack.io/tke/api/auth"
+)
+
+type testAuthenticator struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+	rp  *RelyingParty
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rp, err := NewRelyingParty("https://console.example.com:8443/oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testAuthenticator{t: t, key: key, rp: rp}
+}
+
+func (a *testAuthenticator) clientData(typ, challenge, origin string) string {
+	data, _ := json.Marshal(clientData{Type: typ, Challenge: challenge, Origin: origin})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (a *testAuthenticator) register(challenge string) *auth.WebAuthnRegistration {
+	der, err := x509.MarshalPKIXPublicKey(&a.key.PublicKey)
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return &auth.WebAuthnRegistration{
+		ID:             "cred-1",
+		PublicKey:      base64.StdEncoding.EncodeToString(der),
+		Algorithm:      AlgorithmES256,
+		ClientDataJSON: a.clientData(clientDataCreate, challenge, a.rp.Origin),
+	}
+}
+
+func (a *testAuthenticator) assert(challenge, origin string, signCount uint32) *Assertion {
+	rpIDHash := sha256.Sum256([]byte(a.rp.ID))
+	authData := append(rpIDHash[:], flagUserPresent, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(authData[33:], signCount)
+
+	clientDataJSON := a.clientData(clientDataGet, challenge, origin)
+	raw, _ := base64.RawURLEncoding.DecodeString(clientDataJSON)
+	clientDataHash := sha256.Sum256(raw)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatal(err)
+	}
+	return &Assertion{
+		ID:                "cred-1",
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: base64.RawURLEncoding.EncodeToString(authData),
+		Signature:         base64.RawURLEncoding.EncodeToString(signature),
+	}
+}
+
+func TestNewRelyingParty(t *testing.T) {
+	rp, err := NewRelyingParty("https://console.example.com:8443/oidc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rp.ID != "console.example.com" || rp.Origin != "https://console.example.com:8443" {
+		t.Errorf("NewRelyingParty() = %+v", rp)
+	}
+	if _, err := NewRelyingParty("console.example.com"); err == nil {
+		t.Errorf("NewRelyingParty() of an address without scheme expected an error")
+	}
+}
+
+func TestWebAuthn(t *testing.T) {
+	a := newTestAuthenticator(t)
+
+	registration := a.register("challenge-1")
+	if err := a.rp.VerifyRegistration(registration, "challenge-1"); err != nil {
+		t.Fatalf("VerifyRegistration() error = %v", err)
+	}
+	if err := a.rp.VerifyRegistration(registration, "challenge-2"); err == nil {
+		t.Errorf("VerifyRegistration() of another challenge expected an error")
+	}
+	registration.Algorithm = AlgorithmRS256
+	if err := a.rp.VerifyRegistration(registration, "challenge-1"); err == nil {
+		t.Errorf("VerifyRegistration() of mismatched algorithm expected an error")
+	}
+
+	credential := &auth.WebAuthnCredential{
+		ID:        "cred-1",
+		PublicKey: a.register("").PublicKey,
+		Algorithm: AlgorithmES256,
+		SignCount: 1,
+	}
+	signCount, err := a.rp.VerifyAssertion(credential, a.assert("challenge-3", a.rp.Origin, 2), "challenge-3")
+	if err != nil {
+		t.Fatalf("VerifyAssertion() error = %v", err)
+	}
+	if signCount != 2 {
+		t.Errorf("VerifyAssertion() signCount = %v, want 2", signCount)
+	}
+
+	tests := []struct {
+		name      string
+		assertion *Assertion
+		challenge string
+	}{
+		{"another challenge", a.assert("challenge-3", a.rp.Origin, 2), "challenge-4"},
+		{"another origin", a.assert("challenge-3", "https://evil.example.com", 2), "challenge-3"},
+		{"replayed counter", a.assert("challenge-3", a.rp.Origin, 1), "challenge-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := a.rp.VerifyAssertion(credential, tt.assertion, tt.challenge); err == nil {
+				t.Errorf("VerifyAssertion() expected an error")
+			}
+		})
+	}
+
+	tampered := a.assert("challenge-3", a.rp.Origin, 2)
+	tampered.Signature = a.assert("challenge-5", a.rp.Origin, 2).Signature
+	if _, err := a.rp.VerifyAssertion(credential, tampered, "challenge-3"); err == nil {
+		t.Errorf("VerifyAssertion() of a tampered signature expected an error")
+	}
+}
@@ -0,0 +1,204 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package federation
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/auth/util"
+)
+
+// Directory serves the users and groups of an upstream identity provider from
+// the federated identities recorded at login, since upstream providers such
+// as saml can not be queried for them.
+type Directory struct {
+	TenantID      string
+	ConnectorType string
+}
+
+var (
+	_ identityprovider.UserGetter  = &Directory{}
+	_ identityprovider.UserLister  = &Directory{}
+	_ identityprovider.GroupGetter = &Directory{}
+	_ identityprovider.GroupLister = &Directory{}
+)
+
+// GetUser is an object that can get the user that match the provided field and label criteria.
+func (d *Directory) GetUser(ctx context.Context, name string, options *metav1.GetOptions) (*auth.User, error) {
+	if err := d.checkTenant(ctx); err != nil {
+		return nil, err
+	}
+	if authClient == nil {
+		return nil, apierrors.NewInternalError(errClientNotReady)
+	}
+
+	identity, err := authClient.FederatedIdentities().Get(ctx, name, *options)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(auth.Resource("user"), name)
+		}
+		return nil, err
+	}
+	if identity.Spec.TenantID != d.TenantID || identity.Spec.ConnectorType != d.ConnectorType {
+		return nil, apierrors.NewNotFound(auth.Resource("user"), name)
+	}
+
+	user := convertToUser(identity)
+	return &user, nil
+}
+
+// ListUsers is an object that can list users that match the provided field and label criteria.
+func (d *Directory) ListUsers(ctx context.Context, options *metainternal.ListOptions) (*auth.UserList, error) {
+	keyword, limit := util.ParseQueryKeywordAndLimit(options)
+	identities, err := d.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userList := auth.UserList{}
+	for i := range identities {
+		identity := &identities[i]
+		if keyword != "" && !strings.Contains(identity.Spec.Username, keyword) && !strings.Contains(identity.Spec.DisplayName, keyword) {
+			continue
+		}
+		userList.Items = append(userList.Items, convertToUser(identity))
+		if limit > 0 && len(userList.Items) >= limit {
+			break
+		}
+	}
+
+	return &userList, nil
+}
+
+// GetGroup is an object that can get the group that match the provided field and label criteria.
+func (d *Directory) GetGroup(ctx context.Context, name string, options *metav1.GetOptions) (*auth.Group, error) {
+	identities, err := d.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := Groups(d.TenantID, identities)
+	for i := range groups {
+		if groups[i].Name == name {
+			return &groups[i], nil
+		}
+	}
+
+	return nil, apierrors.NewNotFound(auth.Resource("group"), name)
+}
+
+// ListGroups is an object that can list groups that match the provided field and label criteria.
+func (d *Directory) ListGroups(ctx context.Context, options *metainternal.ListOptions) (*auth.GroupList, error) {
+	keyword, limit := util.ParseQueryKeywordAndLimit(options)
+	identities, err := d.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groupList := auth.GroupList{}
+	for _, group := range Groups(d.TenantID, identities) {
+		if keyword != "" && !strings.Contains(group.Name, keyword) {
+			continue
+		}
+		groupList.Items = append(groupList.Items, group)
+		if limit > 0 && len(groupList.Items) >= limit {
+			break
+		}
+	}
+
+	return &groupList, nil
+}
+
+func (d *Directory) checkTenant(ctx context.Context) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" && tenantID != d.TenantID {
+		return apierrors.NewBadRequest("must in the same tenant")
+	}
+	return nil
+}
+
+func (d *Directory) list(ctx context.Context) ([]auth.FederatedIdentity, error) {
+	if err := d.checkTenant(ctx); err != nil {
+		return nil, err
+	}
+	if authClient == nil {
+		return nil, apierrors.NewInternalError(errClientNotReady)
+	}
+
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", d.TenantID),
+		fields.OneTermEqualSelector("spec.connectorType", d.ConnectorType),
+	)
+	identityList, err := authClient.FederatedIdentities().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	return identityList.Items, nil
+}
+
+// Groups aggregates the groups claimed by the federated identities, each group
+// contains the users claiming it as members.
+func Groups(tenantID string, identities []auth.FederatedIdentity) []auth.Group {
+	members := make(map[string][]auth.Subject)
+	for _, identity := range identities {
+		for _, group := range identity.Spec.Groups {
+			members[group] = append(members[group], auth.Subject{ID: identity.Name, Name: identity.Spec.Username})
+		}
+	}
+
+	groups := make([]auth.Group, 0, len(members))
+	for name, users := range members {
+		sort.Slice(users, func(i, j int) bool { return users[i].Name < users[j].Name })
+		groups = append(groups, auth.Group{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: auth.GroupSpec{
+				ID:          name,
+				DisplayName: name,
+				TenantID:    tenantID,
+			},
+			Status: auth.GroupStatus{Users: users},
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	return groups
+}
+
+func convertToUser(identity *auth.FederatedIdentity) auth.User {
+	return auth.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: identity.Name,
+		},
+		Spec: auth.UserSpec{
+			ID:          identity.Name,
+			Name:        identity.Spec.Username,
+			DisplayName: identity.Spec.DisplayName,
+			Email:       identity.Spec.Email,
+			TenantID:    identity.Spec.TenantID,
+		},
+	}
+}
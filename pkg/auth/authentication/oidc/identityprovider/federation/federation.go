@@ -0,0 +1,145 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package federation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/dexidp/dex/connector"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+var (
+	authClient authinternalclient.AuthInterface
+
+	errClientNotReady = errors.New("kubernetes client config is nil")
+)
+
+// SetupRestClient sets the client used to record federated identities.
+func SetupRestClient(authInterface authinternalclient.AuthInterface) {
+	authClient = authInterface
+}
+
+// Name returns the name of the federated identity for the upstream user of
+// the tenant.
+func Name(tenantID, userID string) string {
+	sum := sha256.Sum256([]byte(tenantID + "/" + userID))
+	return "fid-" + hex.EncodeToString(sum[:])[:20]
+}
+
+// MapGroups translates the group claims of the upstream identity provider
+// into tke group names. Groups present in the mapping are renamed, the
+// others keep their upstream name. Groups reserved for the token issuer are
+// dropped.
+func MapGroups(groups []string, mapping map[string]string) []string {
+	set := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		if mapped, ok := mapping[group]; ok {
+			group = mapped
+		}
+		if group != "" && !strings.HasPrefix(group, oidc.ReservedGroupPrefix) {
+			set[group] = true
+		}
+	}
+
+	result := make([]string, 0, len(set))
+	for group := range set {
+		result = append(result, group)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Username returns the name the user is known as in tke, upstream providers
+// usually put the display name into username, so prefer the preferred username.
+// The name is prefixed with the connector type, upstream users choose their
+// own names and must not be taken for local users of the same name.
+func Username(connectorType string, identity connector.Identity) string {
+	var username string
+	switch {
+	case identity.PreferredUsername != "":
+		username = identity.PreferredUsername
+	case identity.Username != "":
+		username = identity.Username
+	case identity.Email != "":
+		username = identity.Email
+	default:
+		username = identity.UserID
+	}
+	return connectorType + ":" + username
+}
+
+// Record creates or updates the federated identity of the logged in user with
+// the mapped groups, and returns the identity to be issued by dex.
+func Record(ctx context.Context, tenantID, connectorType string, identity connector.Identity, mapping map[string]string) (connector.Identity, error) {
+	if authClient == nil {
+		return identity, errClientNotReady
+	}
+
+	displayName := identity.Username
+	identity.Username = Username(connectorType, identity)
+	identity.PreferredUsername = identity.Username
+	identity.Groups = MapGroups(identity.Groups, mapping)
+
+	name := Name(tenantID, identity.UserID)
+	spec := auth.FederatedIdentitySpec{
+		TenantID:      tenantID,
+		ConnectorType: connectorType,
+		UserID:        identity.UserID,
+		Username:      identity.Username,
+		DisplayName:   displayName,
+		Email:         identity.Email,
+		Groups:        identity.Groups,
+	}
+	status := auth.FederatedIdentityStatus{LastLoginTime: metav1.Now()}
+
+	existing, err := authClient.FederatedIdentities().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return identity, err
+		}
+		_, err = authClient.FederatedIdentities().Create(ctx, &auth.FederatedIdentity{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+			Status:     status,
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return identity, err
+		}
+		log.Info("Federated identity created", log.String("tenantID", tenantID), log.String("user", identity.Username))
+		return identity, nil
+	}
+
+	existing.Spec = spec
+	existing.Status = status
+	if _, err = authClient.FederatedIdentities().Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return identity, err
+	}
+	return identity, nil
+}
@@ -0,0 +1,145 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package federation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/dexidp/dex/connector"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestMapGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		groups  []string
+		mapping map[string]string
+		want    []string
+	}{
+		{
+			name:   "no mapping",
+			groups: []string{"dev", "ops"},
+			want:   []string{"dev", "ops"},
+		},
+		{
+			name:    "mapped and unmapped",
+			groups:  []string{"cn=dev,ou=groups", "ops"},
+			mapping: map[string]string{"cn=dev,ou=groups": "dev"},
+			want:    []string{"dev", "ops"},
+		},
+		{
+			name:    "duplicated after mapping",
+			groups:  []string{"Developers", "dev", "dev"},
+			mapping: map[string]string{"Developers": "dev"},
+			want:    []string{"dev"},
+		},
+		{
+			name:    "mapped to empty is dropped",
+			groups:  []string{"everyone", "ops"},
+			mapping: map[string]string{"everyone": ""},
+			want:    []string{"ops"},
+		},
+		{
+			name:    "reserved groups are dropped",
+			groups:  []string{"mfa:true", "admins", "ops"},
+			mapping: map[string]string{"admins": "mfa:true"},
+			want:    []string{"ops"},
+		},
+		{
+			name: "no groups",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapGroups(tt.groups, tt.mapping); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsername(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity connector.Identity
+		want     string
+	}{
+		{"preferred username", connector.Identity{UserID: "1", Username: "Jane Doe", PreferredUsername: "jane", Email: "jane@example.com"}, "github:jane"},
+		{"username", connector.Identity{UserID: "1", Username: "jane", Email: "jane@example.com"}, "github:jane"},
+		{"email", connector.Identity{UserID: "1", Email: "jane@example.com"}, "github:jane@example.com"},
+		{"user id", connector.Identity{UserID: "1"}, "github:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Username("github", tt.identity); got != tt.want {
+				t.Errorf("Username() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestName(t *testing.T) {
+	if Name("default", "jane") != Name("default", "jane") {
+		t.Errorf("Name() is not deterministic")
+	}
+	if Name("default", "jane") == Name("other", "jane") {
+		t.Errorf("Name() must differ between tenants")
+	}
+}
+
+func TestGroups(t *testing.T) {
+	identities := []auth.FederatedIdentity{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fid-b"},
+			Spec:       auth.FederatedIdentitySpec{TenantID: "default", Username: "bob", Groups: []string{"dev"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fid-a"},
+			Spec:       auth.FederatedIdentitySpec{TenantID: "default", Username: "alice", Groups: []string{"ops", "dev"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "fid-c"},
+			Spec:       auth.FederatedIdentitySpec{TenantID: "default", Username: "carol"},
+		},
+	}
+
+	groups := Groups("default", identities)
+	if len(groups) != 2 {
+		t.Fatalf("Groups() returned %d groups, want 2", len(groups))
+	}
+
+	dev := groups[0]
+	if dev.Name != "dev" || dev.Spec.TenantID != "default" {
+		t.Errorf("Groups()[0] = %s in %s, want dev in default", dev.Name, dev.Spec.TenantID)
+	}
+	wantUsers := []auth.Subject{{ID: "fid-a", Name: "alice"}, {ID: "fid-b", Name: "bob"}}
+	if !reflect.DeepEqual(dev.Status.Users, wantUsers) {
+		t.Errorf("Groups()[0].Status.Users = %v, want %v", dev.Status.Users, wantUsers)
+	}
+
+	ops := groups[1]
+	if ops.Name != "ops" || !reflect.DeepEqual(ops.Status.Users, []auth.Subject{{ID: "fid-a", Name: "alice"}}) {
+		t.Errorf("Groups()[1] = %v, want ops with alice", ops)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ldap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dexidp/dex/connector"
+	dexlog "github.com/dexidp/dex/pkg/log"
+	dexserver "github.com/dexidp/dex/server"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+func init() {
+	// wrap dex ldap connector to drop the groups reserved for the token issuer.
+	upstream, ok := dexserver.ConnectorsConfig[ConnectorType]
+	if !ok {
+		return
+	}
+	dexserver.ConnectorsConfig[ConnectorType] = func() dexserver.ConnectorConfig {
+		return &connectorConfig{config: upstream()}
+	}
+}
+
+// connectorConfig is the dex ldap connector config whose connectors drop the
+// reserved groups of ldap users.
+type connectorConfig struct {
+	config dexserver.ConnectorConfig
+}
+
+// UnmarshalJSON parses the dex ldap connector config.
+func (c *connectorConfig) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, c.config)
+}
+
+// Open returns a strategy for logging in through the ldap server.
+func (c *connectorConfig) Open(id string, logger dexlog.Logger) (connector.Connector, error) {
+	conn, err := c.config.Open(id, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordConn, ok := conn.(connector.PasswordConnector)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected connector %T", ConnectorType, conn)
+	}
+	refreshConn, _ := conn.(connector.RefreshConnector)
+
+	return &ldapConnector{
+		PasswordConnector: passwordConn,
+		refresher:         refreshConn,
+	}, nil
+}
+
+// ldapConnector drops the reserved groups of the users authenticated by dex
+// ldap connector.
+type ldapConnector struct {
+	connector.PasswordConnector
+
+	refresher connector.RefreshConnector
+}
+
+func (c *ldapConnector) Login(ctx context.Context, s connector.Scopes, username, password string) (connector.Identity, bool, error) {
+	ident, valid, err := c.PasswordConnector.Login(ctx, s, username, password)
+	ident.Groups = oidc.StripReservedGroups(ident.Groups)
+	return ident, valid, err
+}
+
+func (c *ldapConnector) Refresh(ctx context.Context, s connector.Scopes, identity connector.Identity) (connector.Identity, error) {
+	if c.refresher == nil {
+		return identity, nil
+	}
+
+	ident, err := c.refresher.Refresh(ctx, s, identity)
+	ident.Groups = oidc.StripReservedGroups(ident.Groups)
+	return ident, err
+}
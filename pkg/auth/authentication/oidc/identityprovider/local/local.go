@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dexidp/dex/connector"
 	dexlog "github.com/dexidp/dex/pkg/log"
@@ -35,11 +36,13 @@ import (
 	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
 	"tkestack.io/tke/api/auth"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	"tkestack.io/tke/pkg/apiserver/authentication"
 	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -50,7 +53,8 @@ const (
 )
 
 var (
-	authClient authinternalclient.AuthInterface
+	authClient           authinternalclient.AuthInterface
+	loopbackClientConfig *restclient.Config
 )
 
 func init() {
@@ -103,8 +107,9 @@ func (c *identityProvider) Store() (*auth.IdentityProvider, error) {
 
 }
 
-func SetupRestClient(authInterface authinternalclient.AuthInterface) {
+func SetupRestClient(authInterface authinternalclient.AuthInterface, clientConfig *restclient.Config) {
 	authClient = authInterface
+	loopbackClientConfig = clientConfig
 }
 
 type localConnector struct {
@@ -129,6 +134,23 @@ func (p *localConnector) Login(ctx context.Context, scopes connector.Scopes, use
 		return ident, false, nil
 	}
 
+	if localIdentity.Status.Locked {
+		log.Warn("User is locked", log.String("tenantID", p.tenantID), log.String("username", username))
+		return ident, false, nil
+	}
+
+	now := time.Now()
+	if passwordpolicy.LockedOut(&localIdentity.Status, now) {
+		log.Warn("User is locked out", log.String("tenantID", p.tenantID), log.String("username", username), log.Time("lockoutExpireTime", localIdentity.Status.LockoutExpireTime.Time))
+		return ident, false, nil
+	}
+
+	policy, err := util.GetPasswordPolicy(ctx, authClient, p.tenantID)
+	if err != nil {
+		log.Error("Get password policy failed", log.String("tenantID", p.tenantID), log.Err(err))
+		return ident, false, nil
+	}
+
 	hashBytes, err := base64.StdEncoding.DecodeString(localIdentity.Spec.HashedPassword)
 	if err != nil {
 		log.Error("Parse hash password failed", log.String("hashedPassword", localIdentity.Spec.HashedPassword), log.Err(err))
@@ -136,9 +158,26 @@ func (p *localConnector) Login(ctx context.Context, scopes connector.Scopes, use
 	}
 	if err := bcrypt.CompareHashAndPassword(hashBytes, []byte(password)); err != nil {
 		log.Error("Invalid password", log.ByteString("input password", []byte(password)), log.ByteString("store password", hashBytes))
+		util.RecordLoginFailure(ctx, authClient, &localIdentity, policy)
 		return ident, false, nil
 	}
 
+	passed, multiFactor := p.verifySecondFactor(ctx, &localIdentity)
+	if !passed {
+		util.RecordLoginFailure(ctx, authClient, &localIdentity, policy)
+		return ident, false, nil
+	}
+
+	if passwordpolicy.Expired(policy, &localIdentity, now) {
+		updated, err := changeExpiredPassword(ctx, &localIdentity, password)
+		if err != nil {
+			log.Warn("Password has expired", log.String("tenantID", p.tenantID), log.String("username", username), log.Err(err))
+			return ident, false, err
+		}
+		localIdentity = *updated
+	}
+	util.RecordLoginSuccess(ctx, authClient, &localIdentity)
+
 	extra := map[string]string{
 		oidc.TenantIDKey: localIdentity.Spec.TenantID,
 	}
@@ -158,6 +197,9 @@ func (p *localConnector) Login(ctx context.Context, scopes connector.Scopes, use
 			ident.Groups = append(ident.Groups, g.ObjectMeta.Name)
 		}
 	}
+	if multiFactor {
+		ident.Groups = append(ident.Groups, oidc.MultiFactorGroup)
+	}
 
 	ident.Email = localIdentity.Spec.Email
 	ident.PreferredUsername = localIdentity.Spec.DisplayName
@@ -185,6 +227,11 @@ func (p *localConnector) Refresh(ctx context.Context, s connector.Scopes, identi
 		return connector.Identity{}, errors.New("user not found")
 	}
 
+	// User deprovisioned or locked after the login.
+	if ident.Status.Locked {
+		return connector.Identity{}, errors.New("user is locked")
+	}
+
 	return identity, nil
 }
 
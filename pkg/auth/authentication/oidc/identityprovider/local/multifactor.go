@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package local
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// verifySecondFactor checks the second factor of the local identity whose
+// password has been verified, and returns whether the check passed and
+// whether a second factor was verified.
+func (p *localConnector) verifySecondFactor(ctx context.Context, localIdentity *auth.LocalIdentity) (bool, bool) {
+	enrollment, required, err := util.MultiFactorRequired(ctx, authClient, localIdentity)
+	if err != nil {
+		log.Error("Check multi-factor authentication failed", log.String("user", localIdentity.Spec.Username), log.Err(err))
+		return false, false
+	}
+	if !required {
+		return true, false
+	}
+	if enrollment == nil || !mfa.Enrolled(enrollment) {
+		// The user is not able to enroll a second factor before logging in,
+		// so the multi-factor policy applies once it has enrolled one.
+		log.Warn("User required to use a second factor has not enrolled any, log in with the password only", log.String("tenantID", p.tenantID), log.String("username", localIdentity.Spec.Username))
+		return true, false
+	}
+
+	factor := mfa.FactorFrom(ctx)
+	if err := mfa.Verify(enrollment, factor, mfa.DefaultRelyingParty(), time.Now()); err != nil {
+		log.Warn("Verify second factor failed", log.String("tenantID", p.tenantID), log.String("username", localIdentity.Spec.Username), log.Err(err))
+		return false, false
+	}
+
+	// The consumed recovery code, challenge and sign counter must be saved,
+	// otherwise they could be replayed.
+	if _, err := authClient.MultiFactorEnrollments().UpdateStatus(ctx, enrollment, metav1.UpdateOptions{}); err != nil {
+		log.Error("Update multi-factor enrollment failed", log.String("user", localIdentity.Spec.Username), log.Err(err))
+		return false, false
+	}
+	return true, true
+}
@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package local
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// changeExpiredPassword replaces the expired password with the new password
+// submitted together with it by the login page, the new password is checked
+// against the password policy by the api server.
+func changeExpiredPassword(ctx context.Context, localIdentity *auth.LocalIdentity, password string) (*auth.LocalIdentity, error) {
+	newPassword := passwordpolicy.NewPasswordFrom(ctx)
+	if newPassword == "" {
+		return nil, errors.New("password has expired, please log in with a new password")
+	}
+	if newPassword == password {
+		return nil, errors.New("new password must be different from the expired one")
+	}
+
+	client, err := userClient(localIdentity)
+	if err != nil {
+		return nil, err
+	}
+	passwordReq := &auth.PasswordReq{
+		HashedPassword:   base64.StdEncoding.EncodeToString([]byte(newPassword)),
+		OriginalPassword: base64.StdEncoding.EncodeToString([]byte(password)),
+	}
+	updated := &auth.LocalIdentity{}
+	err = client.RESTClient().Post().
+		Resource("localidentities").
+		Name(localIdentity.Name).
+		SubResource("password").
+		Body(passwordReq).
+		Do(ctx).
+		Into(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Expired password is changed", log.String("tenantID", localIdentity.Spec.TenantID), log.String("username", localIdentity.Spec.Username))
+	return updated, nil
+}
+
+// userClient returns the client acting as the owner of the local identity,
+// so that the api server only allows it to change the password of its own
+// after checking the expired password.
+func userClient(localIdentity *auth.LocalIdentity) (authinternalclient.AuthInterface, error) {
+	config := restclient.CopyConfig(loopbackClientConfig)
+	config.Impersonate = restclient.ImpersonationConfig{
+		UserName: localIdentity.Spec.Username,
+		Extra: map[string][]string{
+			oidc.TenantIDKey: {localIdentity.Spec.TenantID},
+		},
+	}
+	return authinternalclient.NewForConfig(config)
+}
@@ -0,0 +1,281 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dexidp/dex/connector"
+	dexlog "github.com/dexidp/dex/pkg/log"
+	dexserver "github.com/dexidp/dex/server"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/federation"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// OIDCConnectorType is the type of generic upstream oidc provider, such as keycloak.
+	OIDCConnectorType = "oidc"
+	// GitHubConnectorType is the type of github oauth2 provider.
+	GitHubConnectorType = "github"
+	// GitLabConnectorType is the type of gitlab oauth2 provider.
+	GitLabConnectorType = "gitlab"
+)
+
+// ConnectorTypes is the upstream oauth2 provider types supported.
+var ConnectorTypes = []string{OIDCConnectorType, GitHubConnectorType, GitLabConnectorType}
+
+func init() {
+	// wrap dex upstream connectors to record federated identities and groups.
+	for _, connectorType := range ConnectorTypes {
+		upstream, ok := dexserver.ConnectorsConfig[connectorType]
+		if !ok {
+			continue
+		}
+		connectorType := connectorType
+		dexserver.ConnectorsConfig[connectorType] = func() dexserver.ConnectorConfig {
+			return &identityProvider{connectorType: connectorType, config: upstream()}
+		}
+	}
+}
+
+// IsConnectorType returns whether the connector type is an upstream oauth2 provider.
+func IsConnectorType(connectorType string) bool {
+	for _, t := range ConnectorTypes {
+		if t == connectorType {
+			return true
+		}
+	}
+	return false
+}
+
+// commonConfig is the fields shared by the upstream oauth2 provider configs.
+type commonConfig struct {
+	Issuer       string `json:"issuer,omitempty"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	RedirectURI  string `json:"redirectURI"`
+
+	// Org and Orgs restrict github logins to the members of the organizations.
+	Org  string            `json:"org,omitempty"`
+	Orgs []json.RawMessage `json:"orgs,omitempty"`
+	// Groups restricts gitlab logins to the members of the groups.
+	Groups []string `json:"groups,omitempty"`
+
+	// GroupMapping maps the groups claimed by the upstream provider to tke group
+	// names, groups not in the mapping are used as group names directly.
+	GroupMapping map[string]string `json:"groupMapping,omitempty"`
+}
+
+// identityProvider is the third-party idp that support oidc or oauth2, the
+// connector is opened by the dex config of the connector type.
+type identityProvider struct {
+	*federation.Directory
+
+	connectorType string
+	config        dexserver.ConnectorConfig
+	groupMapping  map[string]string
+	rawConfig     string
+
+	administrators []string
+	tenantID       string
+}
+
+var (
+	_ identityprovider.IdentityProvider = &identityProvider{}
+	_ identityprovider.UserGetter       = &identityProvider{}
+	_ identityprovider.UserLister       = &identityProvider{}
+	_ identityprovider.GroupGetter      = &identityProvider{}
+	_ identityprovider.GroupLister      = &identityProvider{}
+)
+
+// NewOAuthIdentityProvider creates an upstream oidc or oauth2 idp for tke login.
+func NewOAuthIdentityProvider(connectorType string, config string, administrators []string, tenantID string) (identityprovider.IdentityProvider, error) {
+	f, ok := dexserver.ConnectorsConfig[connectorType]
+	if !ok || !IsConnectorType(connectorType) {
+		return nil, fmt.Errorf("%s: unsupported connector type", connectorType)
+	}
+
+	idp, ok := f().(*identityProvider)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected connector config", connectorType)
+	}
+	if err := json.Unmarshal([]byte(config), idp); err != nil {
+		return nil, fmt.Errorf("%s: parse config failed: %v", connectorType, err)
+	}
+
+	var c commonConfig
+	if err := json.Unmarshal([]byte(config), &c); err != nil {
+		return nil, fmt.Errorf("%s: parse config failed: %v", connectorType, err)
+	}
+	if err := validate(connectorType, c); err != nil {
+		return nil, err
+	}
+
+	idp.Directory = &federation.Directory{TenantID: tenantID, ConnectorType: connectorType}
+	idp.rawConfig = config
+	idp.administrators = administrators
+	idp.tenantID = tenantID
+	return idp, nil
+}
+
+// validate checks the required fields of the upstream provider config.
+func validate(connectorType string, c commonConfig) error {
+	requiredFields := []struct {
+		name string
+		val  string
+	}{
+		{"clientID", c.ClientID},
+		{"clientSecret", c.ClientSecret},
+		{"redirectURI", c.RedirectURI},
+	}
+
+	for _, field := range requiredFields {
+		if field.val == "" {
+			return fmt.Errorf("%s: missing required field %q", connectorType, field.name)
+		}
+	}
+	// Everyone with an account on github or gitlab could log in to the
+	// tenant without an organization or group restriction.
+	switch connectorType {
+	case OIDCConnectorType:
+		if c.Issuer == "" {
+			return fmt.Errorf("%s: missing required field %q", connectorType, "issuer")
+		}
+	case GitHubConnectorType:
+		if c.Org == "" && len(c.Orgs) == 0 {
+			return fmt.Errorf("%s: must specify at least one of %q or %q", connectorType, "org", "orgs")
+		}
+	case GitLabConnectorType:
+		if len(c.Groups) == 0 {
+			return fmt.Errorf("%s: missing required field %q", connectorType, "groups")
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes the dex config of the connector type and the group
+// mapping from the same document.
+func (c *identityProvider) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, c.config); err != nil {
+		return err
+	}
+
+	var common commonConfig
+	if err := json.Unmarshal(data, &common); err != nil {
+		return err
+	}
+	c.groupMapping = common.GroupMapping
+	return nil
+}
+
+// Open returns a strategy for logging in through the upstream provider.
+func (c *identityProvider) Open(id string, logger dexlog.Logger) (connector.Connector, error) {
+	conn, err := c.config.Open(id, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	callbackConn, ok := conn.(connector.CallbackConnector)
+	if !ok {
+		return nil, fmt.Errorf("%s: unexpected connector %T", c.connectorType, conn)
+	}
+	refreshConn, _ := conn.(connector.RefreshConnector)
+
+	return &oauthConnector{
+		CallbackConnector: callbackConn,
+		refresher:         refreshConn,
+		connectorType:     c.connectorType,
+		tenantID:          id,
+		groupMapping:      c.groupMapping,
+	}, nil
+}
+
+func (c *identityProvider) Store() (*auth.IdentityProvider, error) {
+	if c.tenantID == "" {
+		return nil, fmt.Errorf("must specify tenantID")
+	}
+
+	return &auth.IdentityProvider{
+		ObjectMeta: v1.ObjectMeta{Name: c.tenantID},
+		Spec: auth.IdentityProviderSpec{
+			Name:           c.tenantID,
+			Type:           c.connectorType,
+			Administrators: c.administrators,
+			Config:         c.rawConfig,
+		},
+	}, nil
+}
+
+// oauthConnector records the federated identity after the user is
+// authenticated by dex upstream connector.
+type oauthConnector struct {
+	connector.CallbackConnector
+
+	refresher     connector.RefreshConnector
+	connectorType string
+	tenantID      string
+	groupMapping  map[string]string
+}
+
+func (c *oauthConnector) LoginURL(s connector.Scopes, callbackURL, state string) (string, error) {
+	// groups are always required to be synced into tke groups.
+	s.Groups = true
+	return c.CallbackConnector.LoginURL(s, callbackURL, state)
+}
+
+func (c *oauthConnector) HandleCallback(s connector.Scopes, r *http.Request) (connector.Identity, error) {
+	s.Groups = true
+	ident, err := c.CallbackConnector.HandleCallback(s, r)
+	if err != nil {
+		return ident, err
+	}
+
+	return c.record(r.Context(), ident)
+}
+
+func (c *oauthConnector) Refresh(ctx context.Context, s connector.Scopes, identity connector.Identity) (connector.Identity, error) {
+	if c.refresher == nil {
+		return identity, nil
+	}
+
+	s.Groups = true
+	ident, err := c.refresher.Refresh(ctx, s, identity)
+	if err != nil {
+		return ident, err
+	}
+
+	return c.record(ctx, ident)
+}
+
+func (c *oauthConnector) record(ctx context.Context, ident connector.Identity) (connector.Identity, error) {
+	ident, err := federation.Record(ctx, c.tenantID, c.connectorType, ident, c.groupMapping)
+	if err != nil {
+		log.Error("Record federated identity failed", log.String("type", c.connectorType), log.String("tenantID", c.tenantID), log.String("user", ident.Username), log.Err(err))
+		return ident, err
+	}
+
+	log.Info("user login successful", log.String("type", c.connectorType), log.String("tenantID", c.tenantID), log.String("user", ident.Username))
+	return ident, nil
+}
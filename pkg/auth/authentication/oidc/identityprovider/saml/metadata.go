@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package saml
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+const (
+	// MetadataContentType is the content type of the service provider metadata.
+	MetadataContentType = "application/samlmetadata+xml"
+
+	protocolNamespace   = "urn:oasis:names:tc:SAML:2.0:protocol"
+	bindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	nameIDFormatPersist = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	defaultACSIndex     = 1
+)
+
+type entityDescriptor struct {
+	XMLName         xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string          `xml:"entityID,attr"`
+	SPSSODescriptor spSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type spSSODescriptor struct {
+	AuthnRequestsSigned        bool                       `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool                       `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string                     `xml:"protocolSupportEnumeration,attr"`
+	NameIDFormat               string                     `xml:"NameIDFormat"`
+	AssertionConsumerService   []assertionConsumerService `xml:"AssertionConsumerService"`
+}
+
+type assertionConsumerService struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
+
+// Metadata generates the service provider metadata of tke, which is uploaded
+// to the saml identity provider to register tke as a service provider.
+func Metadata(c Config) ([]byte, error) {
+	if c.RedirectURI == "" {
+		return nil, fmt.Errorf("saml: missing required field %q", "redirectURI")
+	}
+
+	entityID := c.EntityIssuer
+	if entityID == "" {
+		entityID = c.RedirectURI
+	}
+	nameIDFormat := c.NameIDPolicyFormat
+	if nameIDFormat == "" {
+		nameIDFormat = nameIDFormatPersist
+	}
+
+	descriptor := entityDescriptor{
+		EntityID: entityID,
+		SPSSODescriptor: spSSODescriptor{
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: protocolNamespace,
+			NameIDFormat:               nameIDFormat,
+			AssertionConsumerService: []assertionConsumerService{
+				{
+					Binding:  bindingHTTPPost,
+					Location: c.RedirectURI,
+					Index:    defaultACSIndex,
+				},
+			},
+		},
+	}
+
+	data, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,188 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dexidp/dex/connector"
+	dexsaml "github.com/dexidp/dex/connector/saml"
+	dexlog "github.com/dexidp/dex/pkg/log"
+	dexserver "github.com/dexidp/dex/server"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/federation"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	ConnectorType = "saml"
+)
+
+func init() {
+	// replace dex saml connector to record federated identities and groups.
+	dexserver.ConnectorsConfig[ConnectorType] = func() dexserver.ConnectorConfig {
+		return new(identityProvider)
+	}
+}
+
+// Config is the configuration of saml identity provider, it extends the dex
+// saml config with the mapping from saml group attribute values to tke groups.
+type Config struct {
+	dexsaml.Config
+
+	// GroupMapping maps the values of the groups attribute to tke group names,
+	// values not in the mapping are used as group names directly.
+	GroupMapping map[string]string `json:"groupMapping,omitempty"`
+}
+
+// identityProvider is the third-party idp that support SAML 2.0.
+type identityProvider struct {
+	Config
+
+	*federation.Directory `json:"-"`
+
+	administrators []string
+	tenantID       string
+}
+
+var (
+	_ identityprovider.IdentityProvider = &identityProvider{}
+	_ identityprovider.UserGetter       = &identityProvider{}
+	_ identityprovider.UserLister       = &identityProvider{}
+	_ identityprovider.GroupGetter      = &identityProvider{}
+	_ identityprovider.GroupLister      = &identityProvider{}
+)
+
+// NewSAMLIdentityProvider creates a saml idp for tke login.
+func NewSAMLIdentityProvider(c Config, administrators []string, tenantID string) (identityprovider.IdentityProvider, error) {
+	if err := Validate(c); err != nil {
+		return nil, err
+	}
+
+	return &identityProvider{
+		Config:         c,
+		Directory:      &federation.Directory{TenantID: tenantID, ConnectorType: ConnectorType},
+		administrators: administrators,
+		tenantID:       tenantID,
+	}, nil
+}
+
+// Validate checks the saml config, assertions must be signed by the
+// certificate authority of the identity provider.
+func Validate(c Config) error {
+	requiredFields := []struct {
+		name string
+		val  string
+	}{
+		{"ssoURL", c.SSOURL},
+		{"usernameAttr", c.UsernameAttr},
+		{"emailAttr", c.EmailAttr},
+		{"redirectURI", c.RedirectURI},
+	}
+
+	for _, field := range requiredFields {
+		if field.val == "" {
+			return fmt.Errorf("saml: missing required field %q", field.name)
+		}
+	}
+
+	if c.InsecureSkipSignatureValidation {
+		return fmt.Errorf("saml: insecureSkipSignatureValidation is not allowed, assertions must be signed")
+	}
+	if c.CA == "" && len(c.CAData) == 0 {
+		return fmt.Errorf("saml: must specify ca or caData to verify the signature of assertions")
+	}
+	if len(c.GroupMapping) != 0 && c.GroupsAttr == "" {
+		return fmt.Errorf("saml: groupMapping requires groupsAttr")
+	}
+
+	return nil
+}
+
+// Open returns a strategy for logging in through saml.
+func (c *identityProvider) Open(id string, logger dexlog.Logger) (connector.Connector, error) {
+	conn, err := c.Config.Config.Open(id, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	samlConn, ok := conn.(connector.SAMLConnector)
+	if !ok {
+		return nil, fmt.Errorf("saml: unexpected connector %T", conn)
+	}
+
+	return &samlConnector{SAMLConnector: samlConn, tenantID: id, groupMapping: c.GroupMapping}, nil
+}
+
+func (c *identityProvider) Store() (*auth.IdentityProvider, error) {
+	if c.tenantID == "" {
+		return nil, fmt.Errorf("must specify tenantID")
+	}
+
+	bytes, err := json.Marshal(c.Config)
+	if err != nil {
+		return nil, fmt.Errorf("mashal saml config failed: %+v", err)
+	}
+
+	return &auth.IdentityProvider{
+		ObjectMeta: v1.ObjectMeta{Name: c.tenantID},
+		Spec: auth.IdentityProviderSpec{
+			Name:           c.tenantID,
+			Type:           ConnectorType,
+			Administrators: c.administrators,
+			Config:         string(bytes),
+		},
+	}, nil
+}
+
+// samlConnector records the federated identity after the assertion is
+// verified by dex saml connector.
+type samlConnector struct {
+	connector.SAMLConnector
+
+	tenantID     string
+	groupMapping map[string]string
+}
+
+func (c *samlConnector) POSTData(s connector.Scopes, requestID string) (ssoURL, samlRequest string, err error) {
+	s.Groups = true
+	return c.SAMLConnector.POSTData(s, requestID)
+}
+
+func (c *samlConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+	// groups are always required to be synced into tke groups.
+	s.Groups = true
+	ident, err := c.SAMLConnector.HandlePOST(s, samlResponse, inResponseTo)
+	if err != nil {
+		return ident, err
+	}
+
+	ident, err = federation.Record(context.Background(), c.tenantID, ConnectorType, ident, c.groupMapping)
+	if err != nil {
+		log.Error("Record saml federated identity failed", log.String("tenantID", c.tenantID), log.String("user", ident.Username), log.Err(err))
+		return ident, err
+	}
+
+	log.Info("saml user login successful", log.String("tenantID", c.tenantID), log.String("user", ident.Username))
+	return ident, nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package passwordpolicy
+
+import (
+	"context"
+	"net/http"
+)
+
+// NewPasswordFormKey is the form field of the login page holding the new
+// password, which is required when the password has expired.
+const NewPasswordFormKey = "new_password"
+
+type newPasswordKey struct{}
+
+// WithNewPassword returns a copy of the context carrying the new password.
+func WithNewPassword(ctx context.Context, password string) context.Context {
+	return context.WithValue(ctx, newPasswordKey{}, password)
+}
+
+// NewPasswordFrom returns the new password carried by the context.
+func NewPasswordFrom(ctx context.Context) string {
+	password, _ := ctx.Value(newPasswordKey{}).(string)
+	return password
+}
+
+// WithNewPasswordForm reads the new password from the posted login form and
+// puts it into the request context, where the local connector finds it.
+func WithNewPasswordForm(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPost && req.ParseForm() == nil {
+			if password := req.PostForm.Get(NewPasswordFormKey); password != "" {
+				req = req.WithContext(WithNewPassword(req.Context(), password))
+			}
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
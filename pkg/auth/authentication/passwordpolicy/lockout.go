@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package passwordpolicy
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// LockedOut returns whether the local identity is temporarily locked out
+// after too many failed logins.
+func LockedOut(status *auth.LocalIdentityStatus, now time.Time) bool {
+	return !status.LockoutExpireTime.IsZero() && now.Before(status.LockoutExpireTime.Time)
+}
+
+// RecordFailure counts a failed login, and locks out the local identity when
+// the lockout threshold of the policy is reached. It returns whether the local
+// identity is locked.
+func RecordFailure(policy *auth.PasswordPolicy, status *auth.LocalIdentityStatus, now time.Time) bool {
+	if !status.LockoutExpireTime.IsZero() && !now.Before(status.LockoutExpireTime.Time) {
+		// The previous lockout has expired, start counting again.
+		status.FailedLoginCount = 0
+		status.LockoutExpireTime = metav1.Time{}
+	}
+
+	status.FailedLoginCount++
+	status.LastFailedLoginTime = metav1.NewTime(now)
+	if policy == nil || policy.LockoutThreshold <= 0 || status.FailedLoginCount < policy.LockoutThreshold {
+		return false
+	}
+
+	if policy.LockoutDuration.Duration <= 0 {
+		status.Locked = true
+	} else {
+		status.LockoutExpireTime = metav1.NewTime(now.Add(policy.LockoutDuration.Duration))
+	}
+	return true
+}
+
+// RecordSuccess resets the failed logins after a successful login, it returns
+// whether the status is changed.
+func RecordSuccess(status *auth.LocalIdentityStatus) bool {
+	if status.FailedLoginCount == 0 && status.LockoutExpireTime.IsZero() {
+		return false
+	}
+	status.FailedLoginCount = 0
+	status.LockoutExpireTime = metav1.Time{}
+	return true
+}
+
+// Unlock clears both the lock set by administrators and the lockout.
+func Unlock(status *auth.LocalIdentityStatus) {
+	status.Locked = false
+	status.FailedLoginCount = 0
+	status.LockoutExpireTime = metav1.Time{}
+}
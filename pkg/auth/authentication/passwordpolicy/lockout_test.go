@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package passwordpolicy
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestLockout(t *testing.T) {
+	now := time.Now()
+	policy := &auth.PasswordPolicy{
+		LockoutThreshold: 3,
+		LockoutDuration:  metav1.Duration{Duration: 10 * time.Minute},
+	}
+	status := &auth.LocalIdentityStatus{}
+
+	for i := 0; i < 2; i++ {
+		if RecordFailure(policy, status, now) {
+			t.Fatalf("RecordFailure() locked out after %d failures", i+1)
+		}
+	}
+	if !RecordFailure(policy, status, now) {
+		t.Fatalf("RecordFailure() did not lock out at the threshold")
+	}
+	if !LockedOut(status, now.Add(time.Minute)) {
+		t.Errorf("LockedOut() during the lockout = false")
+	}
+	if LockedOut(status, now.Add(11*time.Minute)) {
+		t.Errorf("LockedOut() after the lockout = true")
+	}
+
+	// Failures after the lockout expired are counted again.
+	if RecordFailure(policy, status, now.Add(11*time.Minute)) || status.FailedLoginCount != 1 {
+		t.Errorf("RecordFailure() after the lockout, count = %d", status.FailedLoginCount)
+	}
+
+	if !RecordSuccess(status) || status.FailedLoginCount != 0 {
+		t.Errorf("RecordSuccess() must reset the failures")
+	}
+	if RecordSuccess(status) {
+		t.Errorf("RecordSuccess() without failures must not change the status")
+	}
+}
+
+func TestLockoutUntilUnlocked(t *testing.T) {
+	now := time.Now()
+	policy := &auth.PasswordPolicy{LockoutThreshold: 1}
+	status := &auth.LocalIdentityStatus{}
+
+	if !RecordFailure(policy, status, now) || !status.Locked {
+		t.Fatalf("RecordFailure() without lockout duration must lock the local identity")
+	}
+	Unlock(status)
+	if status.Locked || status.FailedLoginCount != 0 || LockedOut(status, now) {
+		t.Errorf("Unlock() = %+v", status)
+	}
+
+	if RecordFailure(nil, status, now) || status.FailedLoginCount != 1 {
+		t.Errorf("RecordFailure() without policy must only count the failure")
+	}
+}
@@ -0,0 +1,185 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+// Package passwordpolicy implements the password policy and the lockout of
+// local identities.
+package passwordpolicy
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// Validate checks the length and the complexity of the password.
+func Validate(policy *auth.PasswordPolicy, password string) error {
+	if policy == nil {
+		return nil
+	}
+
+	if n := len([]rune(password)); n < int(policy.MinLength) {
+		return fmt.Errorf("password must be at least %d characters", policy.MinLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	var missing []string
+	if policy.RequireUppercase && !upper {
+		missing = append(missing, "an uppercase letter")
+	}
+	if policy.RequireLowercase && !lower {
+		missing = append(missing, "a lowercase letter")
+	}
+	if policy.RequireDigit && !digit {
+		missing = append(missing, "a digit")
+	}
+	if policy.RequireSymbol && !symbol {
+		missing = append(missing, "a symbol")
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("password must contain %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// generatedLength is the length of the generated passwords unless the policy
+// requires longer ones.
+const generatedLength = 24
+
+// passwordClasses are the characters of the generated passwords, one of each
+// class is always included so that any policy is satisfied.
+var passwordClasses = []string{
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"abcdefghijklmnopqrstuvwxyz",
+	"0123456789",
+	"!#$%&*+-.=?@^_~",
+}
+
+// Generate returns a random password satisfying the policy, which may be nil.
+func Generate(policy *auth.PasswordPolicy) (string, error) {
+	length := generatedLength
+	if policy != nil && int(policy.MinLength) > length {
+		length = int(policy.MinLength)
+	}
+	password := make([]byte, 0, length)
+	for _, class := range passwordClasses {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	all := strings.Join(passwordClasses, "")
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[i.Int64()], nil
+}
+
+// Reused returns whether the password matches one of the hashes of the
+// previous passwords, which are base64 encoded bcrypt hashes like the hashed
+// password of local identities.
+func Reused(previous []string, password string) bool {
+	for _, hashed := range previous {
+		hashBytes, err := base64.StdEncoding.DecodeString(hashed)
+		if err != nil {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword(hashBytes, []byte(password)) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Previous returns the hashes of the passwords which can not be reused,
+// starting with the current one.
+func Previous(policy *auth.PasswordPolicy, current string, history []string) []string {
+	if policy == nil || policy.HistoryCount <= 0 {
+		return nil
+	}
+	previous := append([]string{current}, history...)
+	if len(previous) > int(policy.HistoryCount) {
+		previous = previous[:policy.HistoryCount]
+	}
+	return previous
+}
+
+// PushHistory records the replaced password in the history, which keeps
+// enough hashes for the reuse check of the policy.
+func PushHistory(policy *auth.PasswordPolicy, history []string, replaced string) []string {
+	if policy == nil || policy.HistoryCount <= 1 || replaced == "" {
+		return nil
+	}
+	history = append([]string{replaced}, history...)
+	if len(history) > int(policy.HistoryCount)-1 {
+		history = history[:policy.HistoryCount-1]
+	}
+	return history
+}
+
+// Expired returns whether the password of the local identity is older than
+// the maximum age of the policy.
+func Expired(policy *auth.PasswordPolicy, localIdentity *auth.LocalIdentity, now time.Time) bool {
+	if policy == nil || policy.MaxAge.Duration <= 0 {
+		return false
+	}
+	changeTime := localIdentity.Status.PasswordChangeTime
+	if changeTime.IsZero() {
+		changeTime = localIdentity.ObjectMeta.CreationTimestamp
+	}
+	return now.Sub(changeTime.Time) > policy.MaxAge.Duration
+}
@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package passwordpolicy
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func hash(t *testing.T, password string) string {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(hashed)
+}
+
+func TestValidate(t *testing.T) {
+	policy := &auth.PasswordPolicy{
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+	tests := []struct {
+		password string
+		wantErr  bool
+	}{
+		{"Passw0rd!", false},
+		{"Pa0!", true},
+		{"password0!", true},
+		{"PASSWORD0!", true},
+		{"Password!!", true},
+		{"Password00", true},
+		{"Pässwörd0€", false},
+	}
+	for _, tt := range tests {
+		if err := Validate(policy, tt.password); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%q) error = %v, wantErr %v", tt.password, err, tt.wantErr)
+		}
+	}
+	if err := Validate(nil, ""); err != nil {
+		t.Errorf("Validate() without policy error = %v", err)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	policy := &auth.PasswordPolicy{HistoryCount: 3}
+	current := hash(t, "current")
+
+	var history []string
+	for _, p := range []string{"first", "second", "third"} {
+		history = PushHistory(policy, history, hash(t, p))
+	}
+	if len(history) != 2 {
+		t.Fatalf("PushHistory() kept %d hashes, want 2", len(history))
+	}
+
+	previous := Previous(policy, current, history)
+	for _, p := range []string{"current", "third", "second"} {
+		if !Reused(previous, p) {
+			t.Errorf("Reused(%q) = false", p)
+		}
+	}
+	if Reused(previous, "first") {
+		t.Errorf("Reused() of a password out of the history = true")
+	}
+
+	if Previous(nil, current, history) != nil || PushHistory(&auth.PasswordPolicy{HistoryCount: 1}, history, current) != nil {
+		t.Errorf("history must not be kept if the policy does not require it")
+	}
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Now()
+	policy := &auth.PasswordPolicy{MaxAge: metav1.Duration{Duration: 24 * time.Hour}}
+	localIdentity := &auth.LocalIdentity{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour))},
+	}
+	if !Expired(policy, localIdentity, now) {
+		t.Errorf("Expired() of a password never changed = false")
+	}
+	localIdentity.Status.PasswordChangeTime = metav1.NewTime(now.Add(-time.Hour))
+	if Expired(policy, localIdentity, now) {
+		t.Errorf("Expired() of a changed password = true")
+	}
+	if Expired(&auth.PasswordPolicy{}, localIdentity, now.Add(365*24*time.Hour)) {
+		t.Errorf("Expired() without max age = true")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	policy := &auth.PasswordPolicy{
+		MinLength:        32,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+	for i := 0; i < 100; i++ {
+		password, err := Generate(policy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(password) != 32 {
+			t.Fatalf("Generate() = %q, want 32 characters", password)
+		}
+		if err := Validate(policy, password); err != nil {
+			t.Fatalf("Generate() = %q does not satisfy the policy: %v", password, err)
+		}
+	}
+	if password, err := Generate(nil); err != nil || len(password) != generatedLength {
+		t.Errorf("Generate(nil) = %q, %v", password, err)
+	}
+}
@@ -25,13 +25,15 @@ import (
 	"k8s.io/apiserver/plugin/pkg/authorizer/webhook"
 	"k8s.io/client-go/tools/clientcmd"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-auth-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/authorization/abac"
 	"tkestack.io/tke/pkg/auth/authorization/local"
 )
 
 // NewAuthorizer creates a authorizer for subject access review and returns it.
-func NewAuthorizer(authClient authinternalclient.AuthInterface, authorizationOpts *options.AuthorizationOptions, authOpts *options.AuthOptions, enforcer *casbin.SyncedEnforcer, privilegedUsername string) (authorizer.Authorizer, error) {
+func NewAuthorizer(authClient authinternalclient.AuthInterface, businessClient businessversionedclient.BusinessV1Interface, authorizationOpts *options.AuthorizationOptions, authOpts *options.AuthOptions, enforcer *casbin.SyncedEnforcer, versionedInformers versionedinformers.SharedInformerFactory, privilegedUsername string) (authorizer.Authorizer, error) {
 	var (
 		authorizers []authorizer.Authorizer
 	)
@@ -61,7 +63,7 @@ func NewAuthorizer(authClient authinternalclient.AuthInterface, authorizationOpt
 		authorizers = append(authorizers, abacAuthorizer)
 	}
 
-	authorizers = append(authorizers, local.NewAuthorizer(authClient, enforcer, privilegedUsername))
+	authorizers = append(authorizers, local.NewAuthorizer(authClient, businessClient, enforcer, versionedInformers.Auth().V1().Policies(), privilegedUsername))
 
 	return union.New(authorizers...), nil
 }
@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package condition
+
+import (
+	"context"
+	"net"
+	"time"
+)
+
+type attributesContextKey struct{}
+
+// AttributesFrom builds the attributes of a request made at now from the
+// source ip and the user extra. The presence of a second factor is read from
+// the MultiFactorKey extra, which is only set by the authenticators of tke
+// tokens. The request labels and the resource tags are unknown.
+func AttributesFrom(sourceIP net.IP, extra map[string][]string, now time.Time) *Attributes {
+	return &Attributes{
+		SourceIP:    sourceIP,
+		Time:        now,
+		MultiFactor: first(extra[MultiFactorKey]) == "true",
+		UserExtra:   extra,
+	}
+}
+
+// SimulatedAttributesFrom builds the attributes of a simulated request from
+// the extra describing it. The source ip, the request time, the request
+// labels, the resource tags and the presence of a second factor are all read
+// from the extra, the request time defaults to now.
+func SimulatedAttributesFrom(extra map[string][]string, now time.Time) *Attributes {
+	attrs := AttributesFrom(SourceIPFrom(extra), extra, now)
+	if v := first(extra[RequestTimeKey]); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			attrs.Time = t
+		}
+	}
+	if v, ok := extra[RequestLabelsKey]; ok {
+		attrs.RequestLabels = ParseLabels(v)
+	}
+	if v, ok := extra[ResourceTagsKey]; ok {
+		attrs.ResourceTags = ParseLabels(v)
+	}
+	return attrs
+}
+
+// WithAttributes returns a copy of the context carrying the attributes the
+// conditions are evaluated on instead of the ones of the request.
+func WithAttributes(ctx context.Context, attrs *Attributes) context.Context {
+	return context.WithValue(ctx, attributesContextKey{}, attrs)
+}
+
+// AttributesFromContext returns the attributes set by WithAttributes.
+func AttributesFromContext(ctx context.Context) (*Attributes, bool) {
+	attrs, ok := ctx.Value(attributesContextKey{}).(*Attributes)
+	return attrs, ok
+}
+
+// SourceIPFrom parses the source ip carried by the user extra.
+func SourceIPFrom(extra map[string][]string) net.IP {
+	return net.ParseIP(first(extra[SourceIPKey]))
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,397 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package condition implements the conditions of policy statements, which
+// restrict when a statement takes effect by the attributes of the request.
+package condition
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+)
+
+const (
+	// SourceIPKey is the key of the user extra carrying the source ip of the
+	// request being reviewed.
+	SourceIPKey = genericfilter.SourceIPExtraKey
+	// RequestTimeKey is the key of the simulation extra carrying the RFC3339
+	// time of the simulated request.
+	RequestTimeKey = "requesttime"
+	// RequestLabelsKey is the key of the simulation extra carrying the labels
+	// of the simulated request, each in the form of key=value.
+	RequestLabelsKey = "requestlabels"
+	// ResourceTagsKey is the key of the simulation extra carrying the tags of
+	// the simulated resource, each in the form of key=value.
+	ResourceTagsKey = "resourcetags"
+	// MultiFactorKey is the key of the user extra which is "true" if the user
+	// authenticated with a second factor.
+	MultiFactorKey = genericoidc.MultiFactorKey
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Conditions restricts when a policy statement takes effect. Every condition
+// set must hold, and a condition listing several values holds if any of them
+// matches.
+type Conditions struct {
+	// SourceIP lists the CIDRs or the addresses the request must come from.
+	SourceIP []string `json:"sourceIP,omitempty"`
+	// TimeOfDay lists the windows the request must be made in.
+	TimeOfDay []TimeWindow `json:"timeOfDay,omitempty"`
+	// RequestLabels maps the label keys the request must carry to the
+	// accepted values. An empty list accepts any value. The labels of a
+	// request are the ones of the project it is made in.
+	RequestLabels map[string][]string `json:"requestLabels,omitempty"`
+	// ResourceTags maps the tag keys the requested resource must carry to the
+	// accepted values. An empty list accepts any value. The tags of a
+	// resource are the labels of the namespace of the project it lives in.
+	ResourceTags map[string][]string `json:"resourceTags,omitempty"`
+	// MultiFactor requires the user to have, or not to have, authenticated
+	// with a second factor.
+	MultiFactor *bool `json:"mfaPresent,omitempty"`
+	// UserExtra maps the extra keys of the user to the accepted values. An
+	// empty list accepts any value.
+	UserExtra map[string][]string `json:"userExtra,omitempty"`
+}
+
+// TimeWindow is a daily time window, such as 09:00 to 18:00. A window whose
+// end is before its start spans midnight.
+type TimeWindow struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Weekdays limits the window to the days, such as "Mon" and "Fri".
+	Weekdays []string `json:"weekdays,omitempty"`
+	// TimeZone is the IANA name of the time zone of the window, UTC if
+	// empty.
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Attributes are the attributes of a request conditions are evaluated on. A
+// nil source ip or a nil map means the attribute is unknown.
+type Attributes struct {
+	SourceIP      net.IP
+	Time          time.Time
+	RequestLabels map[string]string
+	ResourceTags  map[string]string
+	MultiFactor   bool
+	UserExtra     map[string][]string
+}
+
+// Result is the outcome of evaluating conditions.
+type Result struct {
+	// Satisfied is true if all the conditions hold.
+	Satisfied bool
+	// Indeterminate is true if some condition could not be evaluated since
+	// the attribute is unknown, and the others hold.
+	Indeterminate bool
+	// Reason explains the outcome.
+	Reason string
+}
+
+// Parse decodes the conditions of a policy. It returns nil if there is no
+// condition.
+func Parse(data []byte) (*Conditions, error) {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, nil
+	}
+	c := &Conditions{}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(c); err != nil {
+		return nil, fmt.Errorf("invalid conditions: %v", err)
+	}
+	if c.IsEmpty() {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// IsEmpty returns whether no condition is set.
+func (c *Conditions) IsEmpty() bool {
+	return c == nil || (len(c.SourceIP) == 0 && len(c.TimeOfDay) == 0 && len(c.RequestLabels) == 0 &&
+		len(c.ResourceTags) == 0 && c.MultiFactor == nil && len(c.UserExtra) == 0)
+}
+
+// Validate decodes and validates the conditions of a policy.
+func Validate(data []byte, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	c, err := Parse(data)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, string(data), err.Error()))
+	}
+	if c == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, ValidateSourceIPs(c.SourceIP, fldPath.Child("sourceIP"))...)
+	for i, w := range c.TimeOfDay {
+		wPath := fldPath.Child("timeOfDay").Index(i)
+		if _, err := parseClock(w.Start); err != nil {
+			allErrs = append(allErrs, field.Invalid(wPath.Child("start"), w.Start, err.Error()))
+		}
+		if _, err := parseClock(w.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(wPath.Child("end"), w.End, err.Error()))
+		}
+		for j, d := range w.Weekdays {
+			if _, ok := weekdays[strings.ToLower(d)]; !ok {
+				allErrs = append(allErrs, field.NotSupported(wPath.Child("weekdays").Index(j), d, []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}))
+			}
+		}
+		if _, err := time.LoadLocation(w.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(wPath.Child("timeZone"), w.TimeZone, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, validateKeys(c.RequestLabels, fldPath.Child("requestLabels"))...)
+	allErrs = append(allErrs, validateKeys(c.ResourceTags, fldPath.Child("resourceTags"))...)
+	allErrs = append(allErrs, validateKeys(c.UserExtra, fldPath.Child("userExtra"))...)
+	return allErrs
+}
+
+// ValidateSourceIPs validates a list of CIDRs or addresses.
+func ValidateSourceIPs(sourceIPs []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, s := range sourceIPs {
+		if _, err := parseCIDR(s); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), s, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+func validateKeys(m map[string][]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for k := range m {
+		if strings.TrimSpace(k) == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, k, "key must not be empty"))
+		}
+	}
+	return allErrs
+}
+
+// Evaluate evaluates the conditions on the attributes of a request.
+func (c *Conditions) Evaluate(attrs *Attributes) Result {
+	if c.IsEmpty() {
+		return Result{Satisfied: true, Reason: "no condition"}
+	}
+
+	var unknown []string
+	if len(c.SourceIP) != 0 {
+		if attrs.SourceIP == nil {
+			unknown = append(unknown, "source ip")
+		} else if !MatchSourceIP(c.SourceIP, attrs.SourceIP) {
+			return failed("source ip %s is not in %v", attrs.SourceIP, c.SourceIP)
+		}
+	}
+	if len(c.TimeOfDay) != 0 && !c.matchTime(attrs.Time) {
+		return failed("time %s is not in the allowed windows", attrs.Time.Format(time.RFC3339))
+	}
+	if len(c.RequestLabels) != 0 {
+		if attrs.RequestLabels == nil {
+			unknown = append(unknown, "request labels")
+		} else if key, ok := matchLabels(c.RequestLabels, attrs.RequestLabels); !ok {
+			return failed("request label %q does not match %v", key, c.RequestLabels[key])
+		}
+	}
+	if len(c.ResourceTags) != 0 {
+		if attrs.ResourceTags == nil {
+			unknown = append(unknown, "resource tags")
+		} else if key, ok := matchLabels(c.ResourceTags, attrs.ResourceTags); !ok {
+			return failed("resource tag %q does not match %v", key, c.ResourceTags[key])
+		}
+	}
+	if c.MultiFactor != nil && *c.MultiFactor != attrs.MultiFactor {
+		if *c.MultiFactor {
+			return failed("multi-factor authentication is required")
+		}
+		return failed("multi-factor authentication must not be present")
+	}
+	for _, key := range sortedKeys(c.UserExtra) {
+		if !matchValues(c.UserExtra[key], attrs.UserExtra[key]) {
+			return failed("user extra %q does not match %v", key, c.UserExtra[key])
+		}
+	}
+
+	if len(unknown) != 0 {
+		return Result{Indeterminate: true, Reason: fmt.Sprintf("unknown %s", strings.Join(unknown, ", "))}
+	}
+	return Result{Satisfied: true, Reason: "conditions satisfied"}
+}
+
+func failed(format string, args ...interface{}) Result {
+	return Result{Reason: fmt.Sprintf(format, args...)}
+}
+
+// MatchSourceIP returns whether the ip is in one of the CIDRs or addresses.
+func MatchSourceIP(sourceIPs []string, ip net.IP) bool {
+	for _, s := range sourceIPs {
+		if network, err := parseCIDR(s); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Conditions) matchTime(t time.Time) bool {
+	for _, w := range c.TimeOfDay {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *TimeWindow) contains(t time.Time) bool {
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return false
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	clock := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+
+	day := t.Weekday()
+	var in bool
+	if start <= end {
+		in = clock >= start && clock < end
+	} else {
+		// The window spans midnight, so the part after midnight belongs to
+		// the window started the day before.
+		in = clock >= start || clock < end
+		if clock < end {
+			day = (day + 6) % 7
+		}
+	}
+	if !in || len(w.Weekdays) == 0 {
+		return in
+	}
+	for _, d := range w.Weekdays {
+		if weekdays[strings.ToLower(d)] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// matchLabels returns the first key whose value does not match, and whether
+// all the keys match.
+func matchLabels(want map[string][]string, got map[string]string) (string, bool) {
+	for _, key := range sortedKeys(want) {
+		value, ok := got[key]
+		if !ok {
+			return key, false
+		}
+		if !matchValues(want[key], []string{value}) {
+			return key, false
+		}
+	}
+	return "", true
+}
+
+func matchValues(want []string, got []string) bool {
+	if len(got) == 0 {
+		return false
+	}
+	if len(want) == 0 {
+		return true
+	}
+	for _, w := range want {
+		for _, g := range got {
+			if w == g {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func parseCIDR(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid ip address")
+		}
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, network, err := net.ParseCIDR(s)
+	return network, err
+}
+
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("must be in the form of HH:MM")
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParseLabels parses the labels in the form of key=value. A value without
+// "=" is a key with an empty value.
+func ParseLabels(values []string) map[string]string {
+	labels := make(map[string]string, len(values))
+	for _, v := range values {
+		for _, kv := range strings.Split(v, ",") {
+			kv = strings.TrimSpace(kv)
+			if kv == "" {
+				continue
+			}
+			parts := strings.SplitN(kv, "=", 2)
+			if len(parts) == 2 {
+				labels[parts[0]] = parts[1]
+			} else {
+				labels[parts[0]] = ""
+			}
+		}
+	}
+	return labels
+}
@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package condition
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{"empty", "", false},
+		{"empty object", "{}", false},
+		{"valid", `{"sourceIP":["10.0.0.0/8","192.168.1.1"],"timeOfDay":[{"start":"09:00","end":"18:00","weekdays":["Mon","fri"],"timeZone":"Asia/Shanghai"}],"mfaPresent":true,"userExtra":{"department":["ops"]}}`, false},
+		{"malformed", `{"sourceIP":`, true},
+		{"unknown field", `{"sourceAddress":["10.0.0.0/8"]}`, true},
+		{"invalid cidr", `{"sourceIP":["10.0.0.0/33"]}`, true},
+		{"invalid clock", `{"timeOfDay":[{"start":"9am","end":"18:00"}]}`, true},
+		{"invalid weekday", `{"timeOfDay":[{"start":"09:00","end":"18:00","weekdays":["Someday"]}]}`, true},
+		{"invalid time zone", `{"timeOfDay":[{"start":"09:00","end":"18:00","timeZone":"Nowhere/City"}]}`, true},
+		{"empty extra key", `{"userExtra":{"":["a"]}}`, true},
+		{"empty label key", `{"requestLabels":{"":["a"]}}`, true},
+		{"empty tag key", `{"resourceTags":{"":["a"]}}`, true},
+		{"request labels", `{"requestLabels":{"env":["dev"]}}`, false},
+		{"resource tags", `{"resourceTags":{"team":[]}}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := Validate([]byte(tt.data), field.NewPath("spec", "conditions"))
+			if (len(errs) != 0) != tt.wantErr {
+				t.Errorf("Validate() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// Wednesday.
+	noon := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name              string
+		conditions        string
+		attrs             Attributes
+		wantSatisfied     bool
+		wantIndeterminate bool
+	}{
+		{"source ip in cidr", `{"sourceIP":["10.0.0.0/8"]}`, Attributes{SourceIP: net.ParseIP("10.1.2.3")}, true, false},
+		{"source ip not in cidr", `{"sourceIP":["10.0.0.0/8","192.168.1.1"]}`, Attributes{SourceIP: net.ParseIP("192.168.1.2")}, false, false},
+		{"source ip address", `{"sourceIP":["192.168.1.1"]}`, Attributes{SourceIP: net.ParseIP("192.168.1.1")}, true, false},
+		{"source ip unknown", `{"sourceIP":["10.0.0.0/8"]}`, Attributes{}, false, true},
+		{"in window", `{"timeOfDay":[{"start":"09:00","end":"18:00"}]}`, Attributes{Time: noon}, true, false},
+		{"out of window", `{"timeOfDay":[{"start":"13:00","end":"18:00"}]}`, Attributes{Time: noon}, false, false},
+		{"window time zone", `{"timeOfDay":[{"start":"09:00","end":"18:00","timeZone":"Asia/Shanghai"}]}`, Attributes{Time: noon}, false, false},
+		{"window weekday", `{"timeOfDay":[{"start":"09:00","end":"18:00","weekdays":["Mon","Tue"]}]}`, Attributes{Time: noon}, false, false},
+		{"window over midnight", `{"timeOfDay":[{"start":"22:00","end":"02:00","weekdays":["Tue"]}]}`, Attributes{Time: noon.Add(-11 * time.Hour)}, true, false},
+		{"request label", `{"requestLabels":{"env":["dev","test"]}}`, Attributes{RequestLabels: map[string]string{"env": "dev"}}, true, false},
+		{"request label mismatch", `{"requestLabels":{"env":["dev"]}}`, Attributes{RequestLabels: map[string]string{"env": "prod"}}, false, false},
+		{"request label any value", `{"requestLabels":{"env":[]}}`, Attributes{RequestLabels: map[string]string{"env": "prod"}}, true, false},
+		{"request label missing", `{"requestLabels":{"env":[]}}`, Attributes{RequestLabels: map[string]string{}}, false, false},
+		{"request labels unknown", `{"requestLabels":{"env":["dev"]}}`, Attributes{}, false, true},
+		{"resource tag", `{"resourceTags":{"team":["a"]}}`, Attributes{ResourceTags: map[string]string{"team": "a"}}, true, false},
+		{"resource tag mismatch", `{"resourceTags":{"team":["a"]}}`, Attributes{ResourceTags: map[string]string{"team": "b"}}, false, false},
+		{"mfa present", `{"mfaPresent":true}`, Attributes{MultiFactor: true}, true, false},
+		{"mfa absent", `{"mfaPresent":true}`, Attributes{}, false, false},
+		{"user extra", `{"userExtra":{"department":["ops"]}}`, Attributes{UserExtra: map[string][]string{"department": {"dev", "ops"}}}, true, false},
+		{"user extra mismatch", `{"userExtra":{"department":["ops"]}}`, Attributes{UserExtra: map[string][]string{"department": {"dev"}}}, false, false},
+		{"failure wins over unknown", `{"sourceIP":["10.0.0.0/8"],"mfaPresent":true}`, Attributes{}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse([]byte(tt.conditions))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := c.Evaluate(&tt.attrs)
+			if got.Satisfied != tt.wantSatisfied || got.Indeterminate != tt.wantIndeterminate {
+				t.Errorf("Evaluate() = %+v, want satisfied %v indeterminate %v", got, tt.wantSatisfied, tt.wantIndeterminate)
+			}
+		})
+	}
+}
+
+func TestAttributesFrom(t *testing.T) {
+	now := time.Now()
+	extra := map[string][]string{
+		RequestTimeKey:   {"2020-07-01T12:00:00Z"},
+		RequestLabelsKey: {"env=dev,team=a", "flag"},
+		MultiFactorKey:   {"true"},
+	}
+	attrs := AttributesFrom(net.ParseIP("10.0.0.1"), extra, now)
+	if !attrs.SourceIP.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("SourceIP = %v", attrs.SourceIP)
+	}
+	if !attrs.Time.Equal(now) {
+		t.Errorf("Time = %v, want the server clock", attrs.Time)
+	}
+	if attrs.RequestLabels != nil || attrs.ResourceTags != nil {
+		t.Errorf("RequestLabels = %v, ResourceTags = %v, want unknown", attrs.RequestLabels, attrs.ResourceTags)
+	}
+	if !attrs.MultiFactor {
+		t.Error("MultiFactor = false")
+	}
+
+	if attrs := AttributesFrom(nil, map[string][]string{MultiFactorKey: {"false"}}, now); attrs.MultiFactor {
+		t.Error("MultiFactor = true without the extra")
+	}
+}
+
+func TestSimulatedAttributesFrom(t *testing.T) {
+	now := time.Now()
+	extra := map[string][]string{
+		SourceIPKey:      {"10.0.0.1"},
+		RequestTimeKey:   {"2020-07-01T12:00:00Z"},
+		RequestLabelsKey: {"env=dev,team=a", "flag"},
+		MultiFactorKey:   {"true"},
+	}
+	attrs := SimulatedAttributesFrom(extra, now)
+	if !attrs.SourceIP.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("SourceIP = %v", attrs.SourceIP)
+	}
+	if !attrs.Time.Equal(time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Time = %v", attrs.Time)
+	}
+	if len(attrs.RequestLabels) != 3 || attrs.RequestLabels["team"] != "a" {
+		t.Errorf("RequestLabels = %v", attrs.RequestLabels)
+	}
+	if attrs.ResourceTags != nil {
+		t.Errorf("ResourceTags = %v, want unknown", attrs.ResourceTags)
+	}
+	if !attrs.MultiFactor {
+		t.Error("MultiFactor = false")
+	}
+}
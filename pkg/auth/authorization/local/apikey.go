@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package local
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// checkAPIKey returns the reason to deny the request made with the api key,
+// which is empty if the api key is not restricted from it. The request is
+// denied if the api key is no longer usable, it comes from an address out of
+// the source ips of the api key, or it is out of the scope of the api key.
+func (a *Authorizer) checkAPIKey(ctx context.Context, attr authorizer.Attributes, name, tenantID, projectID string) (string, error) {
+	apiKey, err := a.authClient.APIKeys().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Get api key failed", log.String("apiKey", name), log.Err(err))
+		return "", err
+	}
+
+	// Reviews may be cached by other api servers after the api key is
+	// disabled or retired.
+	if apiKey.Status.Disabled {
+		return fmt.Sprintf("api key %s has been disabled", name), nil
+	}
+	if authutil.APIKeyRetired(apiKey, time.Now()) {
+		return fmt.Sprintf("api key %s has been rotated to %s and retired", name, apiKey.Status.Successor), nil
+	}
+
+	if err := authutil.CheckAPIKeySourceIP(apiKey, genericfilter.SourceIPFrom(ctx)); err != nil {
+		return err.Error(), nil
+	}
+
+	// The scope is given in actions and resources of policies.
+	action, resource := attr.GetVerb(), attr.GetResource()
+	if tenantID != "" && verbMap.Has(action) {
+		tkeAttributes := convertTKEAttributes(ctx, attr)
+		action, resource = tkeAttributes.GetVerb(), tkeAttributes.GetResource()
+	}
+	if err := authutil.CheckAPIKeyScope(apiKey, action, resource, projectID); err != nil {
+		return err.Error(), nil
+	}
+	return "", nil
+}
@@ -30,8 +30,12 @@ import (
 	"github.com/casbin/casbin/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/cache"
 
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
 	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
 	"tkestack.io/tke/pkg/auth/filter"
 	authutil "tkestack.io/tke/pkg/auth/util"
@@ -52,16 +56,26 @@ var verbMap = sets.NewString("get", "list", "watch", "create", "update", "patch"
 type Authorizer struct {
 	privilegedUsername string
 
-	authClient authinternalclient.AuthInterface
-	enforcer   *casbin.SyncedEnforcer
+	authClient   authinternalclient.AuthInterface
+	enforcer     *casbin.SyncedEnforcer
+	policyLister authv1lister.PolicyLister
+	policySynced cache.InformerSynced
+
+	// businessClient looks up the labels of projects and namespaces for the
+	// conditions on request labels and resource tags, which are unknown if
+	// it is nil.
+	businessClient businessversionedclient.BusinessV1Interface
 }
 
 // NewAuthorizer creates a local repository authorizer and returns it.
-func NewAuthorizer(authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer, privilegedUsername string) *Authorizer {
+func NewAuthorizer(authClient authinternalclient.AuthInterface, businessClient businessversionedclient.BusinessV1Interface, enforcer *casbin.SyncedEnforcer, policyInformer authv1informer.PolicyInformer, privilegedUsername string) *Authorizer {
 	return &Authorizer{
 		privilegedUsername: privilegedUsername,
 		authClient:         authClient,
+		businessClient:     businessClient,
 		enforcer:           enforcer,
+		policyLister:       policyInformer.Lister(),
+		policySynced:       policyInformer.Informer().HasSynced,
 	}
 }
 
@@ -108,6 +122,14 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 		return authorizer.DecisionAllow, "", nil
 	}
 
+	// Api keys are restricted on top of the permissions of their owners, even
+	// the ones of administrators.
+	if apiKeys := extra[authutil.APIKeyExtraKey]; len(apiKeys) > 0 {
+		if reason, err := a.checkAPIKey(ctx, attr, apiKeys[0], tenantID, projectID); reason != "" || err != nil {
+			return authorizer.DecisionDeny, reason, err
+		}
+	}
+
 	// Second check if user is a admin of the identity provider for tenant.
 	if tenantID != "" {
 		idp, err := a.authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
@@ -151,43 +173,62 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 	}
 
 	if tenantID != "" && verbMap.Has(action) {
-		record := &authorizer.AttributesRecord{
-			User:            attr.GetUser(),
-			Verb:            attr.GetVerb(),
-			Namespace:       attr.GetNamespace(),
-			APIGroup:        attr.GetAPIGroup(),
-			Resource:        attr.GetResource(),
-			Subresource:     attr.GetSubresource(),
-			Name:            attr.GetName(),
-			ResourceRequest: attr.IsResourceRequest(),
-			Path:            attr.GetPath(),
-		}
-		tkeAttributes := filter.ConvertTKEAttributes(ctx, record)
+		tkeAttributes := convertTKEAttributes(ctx, attr)
 		attrStr, _ := json.Marshal(attr)
 		tkeAttributesStr, _ := json.Marshal(tkeAttributes)
 		log.Debugf("Attribute '%s' converted to TKEAttributes '%s'", string(attrStr), string(tkeAttributesStr))
 		attr = tkeAttributes
 	}
-	return a.casbinDecision(attr, tenantID, subject, projectID, attr.GetResource(), attr.GetVerb(), reason, debug)
+	return a.casbinDecision(ctx, attr, tenantID, subject, projectID, attr.GetResource(), attr.GetVerb(), reason, debug)
 }
 
-func (a *Authorizer) casbinDecision(attr authorizer.Attributes, tenantID, subject, projectID, resource, action, reason string, debug bool) (authorizer.Decision, string, error) {
+func (a *Authorizer) casbinDecision(ctx context.Context, attr authorizer.Attributes, tenantID, subject, projectID, resource, action, reason string, debug bool) (authorizer.Decision, string, error) {
 	allow, err := a.enforcer.Enforce(authutil.UserKey(tenantID, subject), projectID, resource, action)
 	if err != nil {
 		log.Error("Casbin enforcer failed", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.Err(err))
 		return authorizer.DecisionDeny, "", err
 	}
+	allow, explanation := a.applyConditions(ctx, attr, authutil.UserKey(tenantID, subject), projectID, resource, action, allow)
 	if !allow {
 		allowAll, err := a.enforcer.Enforce(authutil.UserKey(tenantID, authutil.DefaultAll), projectID, resource, action)
-		if err == nil && allowAll {
-			return authorizer.DecisionAllow, reason, nil
+		if err == nil {
+			var allExplanation string
+			allowAll, allExplanation = a.applyConditions(ctx, attr, authutil.UserKey(tenantID, authutil.DefaultAll), projectID, resource, action, allowAll)
+			if allowAll {
+				return authorizer.DecisionAllow, joinReason(reason, allExplanation), nil
+			}
 		}
-		log.Info("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "false"))
+		log.Info("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "false"), log.String("conditions", explanation))
 		if debug {
-			return authorizer.DecisionDeny, reason, nil
+			return authorizer.DecisionDeny, joinReason(reason, explanation), nil
 		}
-		return authorizer.DecisionDeny, fmt.Sprintf("permission for %s on %s not verify", action, resource), nil
+		return authorizer.DecisionDeny, joinReason(fmt.Sprintf("permission for %s on %s not verify", action, resource), explanation), nil
+	}
+	log.Debug("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "true"), log.String("conditions", explanation))
+	return authorizer.DecisionAllow, joinReason(reason, explanation), nil
+}
+
+// convertTKEAttributes converts the kubernetes attributes to the tke ones,
+// whose verb and resource are the action and the resource of policies.
+func convertTKEAttributes(ctx context.Context, attr authorizer.Attributes) authorizer.Attributes {
+	record := &authorizer.AttributesRecord{
+		User:            attr.GetUser(),
+		Verb:            attr.GetVerb(),
+		Namespace:       attr.GetNamespace(),
+		APIGroup:        attr.GetAPIGroup(),
+		Resource:        attr.GetResource(),
+		Subresource:     attr.GetSubresource(),
+		Name:            attr.GetName(),
+		ResourceRequest: attr.IsResourceRequest(),
+		Path:            attr.GetPath(),
+	}
+	return filter.ConvertTKEAttributes(ctx, record)
+}
+
+// joinReason appends the explanation of the conditions to the reason.
+func joinReason(reason, explanation string) string {
+	if reason == "" || explanation == "" {
+		return reason + explanation
 	}
-	log.Debug("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "true"))
-	return authorizer.DecisionAllow, reason, nil
+	return reason + ": " + explanation
 }
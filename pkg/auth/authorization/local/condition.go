@@ -0,0 +1,239 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	"tkestack.io/tke/api/auth"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// applyConditions corrects the casbin decision of the subject when policies
+// matching the request carry conditions, since the casbin rules of a policy
+// take effect regardless of its conditions. It returns the decision and the
+// explanation of the conditions evaluated, which is empty if no conditional
+// policy is involved.
+func (a *Authorizer) applyConditions(ctx context.Context, attr authorizer.Attributes, subjectKey, projectID, resource, action string, allow bool) (bool, string) {
+	roles, err := a.implicitRoles(subjectKey, projectID)
+	if err != nil {
+		log.Error("Get roles for user failed", log.String("user", subjectKey), log.String("projectID", projectID), log.Err(err))
+		return false, fmt.Sprintf("evaluate conditions failed: %v", err)
+	}
+
+	var (
+		attrs        *condition.Attributes
+		lookup       func(*condition.Conditions)
+		allowed      bool
+		denied       bool
+		explanations []string
+	)
+	for _, name := range append([]string{subjectKey}, roles...) {
+		rules := matchedRules(a.enforcer.GetFilteredPolicy(0, name), resource, action)
+		if len(rules) == 0 {
+			continue
+		}
+
+		if attrs == nil {
+			attrs, lookup = a.requestAttributes(ctx, attr, projectID)
+		}
+		applies, explanation := a.evaluateConditions(ctx, name, rules[0][4], attrs, lookup)
+		if explanation != "" {
+			explanations = append(explanations, fmt.Sprintf("policy %s (%s): %s", name, rules[0][4], explanation))
+		}
+		if !applies {
+			continue
+		}
+		for _, rule := range rules {
+			switch rule[4] {
+			case string(auth.Allow):
+				allowed = true
+			case string(auth.Deny):
+				denied = true
+			}
+		}
+	}
+
+	if len(explanations) == 0 {
+		return allow, ""
+	}
+	return allowed && !denied, strings.Join(explanations, "; ")
+}
+
+// requestAttributes returns the attributes the conditions are evaluated on,
+// which are the simulated ones if the context carries them, and the function
+// looking up the request labels and the resource tags of a real request the
+// first time a condition needs them, which is nil for simulated requests.
+func (a *Authorizer) requestAttributes(ctx context.Context, attr authorizer.Attributes, projectID string) (*condition.Attributes, func(*condition.Conditions)) {
+	if attrs, ok := condition.AttributesFromContext(ctx); ok {
+		return attrs, nil
+	}
+	attrs := condition.AttributesFrom(genericfilter.SourceIPFrom(ctx), attr.GetUser().GetExtra(), time.Now())
+	var requestLabels, resourceTags bool
+	return attrs, func(conditions *condition.Conditions) {
+		if len(conditions.RequestLabels) != 0 && !requestLabels {
+			requestLabels = true
+			attrs.RequestLabels = a.projectLabels(ctx, projectID)
+		}
+		if len(conditions.ResourceTags) != 0 && !resourceTags {
+			resourceTags = true
+			cluster := genericfilter.GetClusterFromGroups(attr.GetUser().GetGroups())
+			attrs.ResourceTags = a.namespaceLabels(ctx, projectID, cluster, attr.GetNamespace())
+		}
+	}
+}
+
+// projectLabels returns the labels of the project the request is made in,
+// which are the request labels of the conditions. It returns nil if they are
+// unknown.
+func (a *Authorizer) projectLabels(ctx context.Context, projectID string) map[string]string {
+	if a.businessClient == nil || projectID == "" || projectID == authutil.DefaultDomain {
+		return nil
+	}
+	project, err := a.businessClient.Projects().Get(ctx, projectID, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Get project for conditions failed", log.String("project", projectID), log.Err(err))
+		return nil
+	}
+	return labelsOrEmpty(project.Labels)
+}
+
+// namespaceLabels returns the labels of the namespace of the project the
+// requested resource lives in, which are the resource tags of the conditions.
+// It returns nil if they are unknown.
+func (a *Authorizer) namespaceLabels(ctx context.Context, projectID, cluster, namespace string) map[string]string {
+	if a.businessClient == nil || projectID == "" || projectID == authutil.DefaultDomain || cluster == "" || namespace == "" {
+		return nil
+	}
+	ns, err := a.businessClient.Namespaces(projectID).Get(ctx, fmt.Sprintf("%s-%s", cluster, namespace), metav1.GetOptions{})
+	if err != nil {
+		log.Error("Get namespace for conditions failed", log.String("project", projectID), log.String("cluster", cluster), log.String("namespace", namespace), log.Err(err))
+		return nil
+	}
+	return labelsOrEmpty(ns.Labels)
+}
+
+// labelsOrEmpty returns the labels, or an empty map telling the labels are
+// known but there is none.
+func labelsOrEmpty(labels map[string]string) map[string]string {
+	if labels == nil {
+		return map[string]string{}
+	}
+	return labels
+}
+
+// evaluateConditions returns whether the rules of the policy with the effect
+// take effect for the attributes, and the explanation of the conditions,
+// which is empty if the policy has no condition. The lookup, if not nil,
+// fills the attributes the conditions need before they are evaluated.
+func (a *Authorizer) evaluateConditions(ctx context.Context, name, effect string, attrs *condition.Attributes, lookup func(*condition.Conditions)) (bool, string) {
+	conditions, err := a.policyConditions(ctx, name)
+	if err != nil {
+		log.Error("Get conditions of policy failed", log.String("policy", name), log.Err(err))
+		// Conditions which can not be evaluated only leave deny statements
+		// in effect.
+		return effect == string(auth.Deny), err.Error()
+	}
+	if conditions == nil {
+		return true, ""
+	}
+	if lookup != nil {
+		lookup(conditions)
+	}
+	result := conditions.Evaluate(attrs)
+	return result.Satisfied || (result.Indeterminate && effect == string(auth.Deny)), result.Reason
+}
+
+// implicitRoles returns the roles the subject inherits in the project. Like
+// the role manager, the links in the project and the links in all projects
+// are followed separately.
+func (a *Authorizer) implicitRoles(subjectKey, projectID string) ([]string, error) {
+	domains := []string{authutil.DefaultDomain}
+	if projectID != "" && projectID != authutil.DefaultDomain {
+		domains = append([]string{projectID}, domains...)
+	}
+
+	seen := sets.NewString()
+	var roles []string
+	for _, domain := range domains {
+		domainRoles, err := a.enforcer.GetImplicitRolesForUser(subjectKey, domain)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range domainRoles {
+			if !seen.Has(role) {
+				seen.Insert(role)
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles, nil
+}
+
+// matchedRules returns the rules matching the resource and the action, in the
+// same way as the matchers of the default rule model.
+func matchedRules(rules [][]string, resource, action string) [][]string {
+	var matched [][]string
+	for _, rule := range rules {
+		if len(rule) < 5 {
+			continue
+		}
+		if authutil.KeyMatchCustom(resource, rule[2]) && authutil.KeyMatchCustom(action, rule[3]) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// policyConditions returns the conditions of the policy, or nil if the policy
+// does not exist or has no condition.
+func (a *Authorizer) policyConditions(ctx context.Context, name string) (*condition.Conditions, error) {
+	var data []byte
+	if a.policySynced != nil && a.policySynced() {
+		policy, err := a.policyLister.Get(name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		data = policy.Spec.Conditions
+	} else {
+		policy, err := a.authClient.Policies().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		data = policy.Spec.Conditions
+	}
+	return condition.Parse(data)
+}
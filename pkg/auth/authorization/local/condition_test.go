@@ -0,0 +1,175 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/auth"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+const testTenantID = "default"
+
+// testPolicy returns a policy of the tenant allowing or denying the actions
+// on the resources under the conditions.
+func testPolicy(name string, effect auth.Effect, actions, resources []string, conditions string) *auth.Policy {
+	return &auth.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: auth.PolicySpec{
+			TenantID:    testTenantID,
+			DisplayName: name,
+			Statement: auth.Statement{
+				Actions:   actions,
+				Resources: resources,
+				Effect:    effect,
+			},
+			Conditions: []byte(conditions),
+		},
+	}
+}
+
+// newTestAuthorizer builds an authorizer whose casbin enforcer holds the
+// rules of the policies and the links, each of which is a subject, a role
+// and a domain. The business objects are served by the business client.
+func newTestAuthorizer(t *testing.T, policies []*auth.Policy, links [][]string, businessObjects ...runtime.Object) (*Authorizer, *fake.Clientset) {
+	m, err := model.NewModelFromString(auth.DefaultRuleModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the role manager of the auth api server
+	enforcer.SetRoleManager(authutil.NewRoleManager(10))
+	enforcer.AddFunction("keyMatchCustom", func(args ...interface{}) (interface{}, error) {
+		return authutil.KeyMatchCustom(args[0].(string), args[1].(string)), nil
+	})
+
+	client := fake.NewSimpleClientset()
+	for _, policy := range policies {
+		if _, err := client.Auth().Policies().Create(context.Background(), policy, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		for _, rule := range authutil.ConvertPolicyToRuleArrayUsingRuleName(policy.Name, policy) {
+			if _, err := enforcer.AddPolicy(rule); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for _, link := range links {
+		if _, err := enforcer.AddGroupingPolicy(link); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the informer is never started, so that the policies are read from the
+	// client
+	informers := versionedinformers.NewSharedInformerFactory(versionedfake.NewSimpleClientset(), 0)
+	businessClient := versionedfake.NewSimpleClientset(businessObjects...).BusinessV1()
+	return NewAuthorizer(client.Auth(), businessClient, enforcer, informers.Auth().V1().Policies(), "admin"), client
+}
+
+func testAttributes(username, projectID, cluster, namespace string) authorizer.Attributes {
+	groups := []string{"tenant:" + testTenantID}
+	if projectID != "" {
+		groups = append(groups, "project:"+projectID)
+	}
+	if cluster != "" {
+		groups = append(groups, "cluster:"+cluster)
+	}
+	return &authorizer.AttributesRecord{
+		User:            &user.DefaultInfo{Name: username, Groups: groups},
+		Verb:            "get",
+		Namespace:       namespace,
+		Resource:        "deployments",
+		Name:            "nginx",
+		ResourceRequest: true,
+	}
+}
+
+func TestConditionsOnLabels(t *testing.T) {
+	project := &businessv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj-1", Labels: map[string]string{"env": "dev"}},
+	}
+	namespace := &businessv1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "cls-1-ns-1", Namespace: "prj-1", Labels: map[string]string{"team": "a"}},
+		Spec:       businessv1.NamespaceSpec{ClusterName: "cls-1", Namespace: "ns-1"},
+	}
+	policies := []*auth.Policy{
+		testPolicy("pol-dev", auth.Allow, []string{"getDeployment"}, []string{"*"}, `{"requestLabels":{"env":["dev"]}}`),
+		testPolicy("pol-team", auth.Allow, []string{"getDeployment"}, []string{"*"}, `{"resourceTags":{"team":["a"]}}`),
+		testPolicy("pol-read", auth.Allow, []string{"getDeployment"}, []string{"*"}, ""),
+		testPolicy("pol-prod", auth.Deny, []string{"getDeployment"}, []string{"*"}, `{"requestLabels":{"env":["prod"]}}`),
+	}
+	links := [][]string{
+		{authutil.UserKey(testTenantID, "alice"), "pol-dev", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "bob"), "pol-team", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "carol"), "pol-read", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "carol"), "pol-prod", authutil.DefaultDomain},
+	}
+
+	tests := []struct {
+		name           string
+		username       string
+		projectID      string
+		cluster        string
+		namespace      string
+		businessClient bool
+		allow          bool
+	}{
+		{"project label matches", "alice", "prj-1", "", "", true, true},
+		{"project label unknown without project", "alice", "", "", "", true, false},
+		{"project does not exist", "alice", "prj-2", "", "", true, false},
+		{"no business client", "alice", "prj-1", "", "", false, false},
+		{"namespace label matches", "bob", "prj-1", "cls-1", "ns-1", true, true},
+		{"namespace label unknown without cluster", "bob", "prj-1", "", "ns-1", true, false},
+		{"namespace does not exist", "bob", "prj-1", "cls-1", "ns-2", true, false},
+		{"deny on other project label does not apply", "carol", "prj-1", "cls-1", "ns-1", true, true},
+		{"deny applies if the project label is unknown", "carol", "prj-1", "cls-1", "ns-1", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := newTestAuthorizer(t, policies, links, project, namespace)
+			if !tt.businessClient {
+				a.businessClient = nil
+			}
+			decision, reason, err := a.casbinDecision(context.Background(), testAttributes(tt.username, tt.projectID, tt.cluster, tt.namespace),
+				testTenantID, tt.username, tt.projectID, "namespace:"+tt.namespace+"/deployment:nginx", "getDeployment", "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allow := decision == authorizer.DecisionAllow; allow != tt.allow {
+				t.Errorf("expected allow %t, got %t: %s", tt.allow, allow, reason)
+			}
+		})
+	}
+}
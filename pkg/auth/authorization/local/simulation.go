@@ -0,0 +1,357 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// LinkKindUser is the kind of links to users.
+	LinkKindUser = "User"
+	// LinkKindGroup is the kind of links to groups.
+	LinkKindGroup = "Group"
+	// LinkKindRole is the kind of links to roles.
+	LinkKindRole = "Role"
+	// LinkKindPolicy is the kind of links to policies.
+	LinkKindPolicy = "Policy"
+	// LinkKindProjectPolicyBinding is the kind of links to project policy
+	// bindings.
+	LinkKindProjectPolicyBinding = "ProjectPolicyBinding"
+	// LinkKindCustomPolicyBinding is the kind of links to custom policy
+	// bindings.
+	LinkKindCustomPolicyBinding = "CustomPolicyBinding"
+)
+
+// path is a casbin subject inherited by a subject, with the names linking
+// them in the domain.
+type path struct {
+	names  []string
+	domain string
+}
+
+func (p path) last() string {
+	return p.names[len(p.names)-1]
+}
+
+// Simulate authorizes the action on the resource in the project for the user
+// of the tenant, or for the group if user is empty, and returns the policies
+// of the subject matching the action on the resource.
+func (a *Authorizer) Simulate(ctx context.Context, tenantID, username, groupID, projectID, resource, action string, extra map[string][]string) (authorizer.Decision, string, []auth.PolicyMatch, error) {
+	var (
+		decision authorizer.Decision
+		reason   string
+		err      error
+		subjects []string
+	)
+	attr := simulationAttributes(tenantID, username, projectID, resource, action, extra)
+	if username != "" {
+		decision, reason, err = a.Authorize(ctx, attr)
+		subjects = []string{authutil.UserKey(tenantID, username), authutil.UserKey(tenantID, authutil.DefaultAll)}
+	} else {
+		subjectKey := authutil.GroupKey(tenantID, groupID)
+		decision, reason, err = a.decide(ctx, attr, tenantID, subjectKey, projectID, resource, action)
+		subjects = []string{subjectKey, authutil.UserKey(tenantID, authutil.DefaultAll)}
+	}
+	if err != nil {
+		return decision, reason, nil, err
+	}
+
+	attrs, lookup := a.requestAttributes(ctx, attr, projectID)
+	l := &linker{authClient: a.authClient, tenantID: tenantID}
+	var matches []auth.PolicyMatch
+	for _, subjectKey := range subjects {
+		for _, p := range a.paths(subjectKey, projectID) {
+			rules := matchedRules(a.enforcer.GetFilteredPolicy(0, p.last()), resource, action)
+			if len(rules) == 0 {
+				continue
+			}
+			effect := rules[0][4]
+			applied, explanation := a.evaluateConditions(ctx, p.last(), effect, attrs, lookup)
+			matches = append(matches, auth.PolicyMatch{
+				Policy:     p.last(),
+				Effect:     auth.Effect(effect),
+				Applied:    applied,
+				Conditions: explanation,
+				Chain:      l.chain(ctx, p),
+			})
+		}
+	}
+	return decision, reason, matches, nil
+}
+
+// WhoCan returns the users and the groups of the tenant allowed to perform the
+// action on the resource in the project. The policies bound to all users do
+// not list any user, since the users are not known to casbin.
+func (a *Authorizer) WhoCan(ctx context.Context, tenantID, projectID, resource, action string, extra map[string][]string) ([]auth.Subject, []auth.Subject, error) {
+	// Only the subjects linked to a policy allowing the action on the resource
+	// can be allowed, the decision of each of them is made afterwards for the
+	// conditions and the denying policies.
+	allKey := authutil.UserKey(tenantID, authutil.DefaultAll)
+	userKeys := sets.NewString()
+	groupKeys := sets.NewString()
+	for _, rule := range matchedRules(a.enforcer.GetPolicy(), resource, action) {
+		if rule[4] != string(auth.Allow) {
+			continue
+		}
+		for _, subjectKey := range a.inheritors(rule[0], projectID) {
+			switch {
+			case subjectKey == allKey:
+				// all users are not a user to list
+			case strings.HasPrefix(subjectKey, authutil.UserPrefix(tenantID)):
+				userKeys.Insert(subjectKey)
+			case strings.HasPrefix(subjectKey, authutil.GroupPrefix(tenantID)):
+				groupKeys.Insert(subjectKey)
+			}
+		}
+	}
+
+	idp, err := a.authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+	if err == nil {
+		for _, admin := range idp.Spec.Administrators {
+			userKeys.Insert(authutil.UserKey(tenantID, admin))
+		}
+	}
+
+	var users, groups []auth.Subject
+	for _, subjectKey := range userKeys.List() {
+		username := strings.TrimPrefix(subjectKey, authutil.UserPrefix(tenantID))
+		decision, _, err := a.Authorize(ctx, simulationAttributes(tenantID, username, projectID, resource, action, extra))
+		if err != nil {
+			return nil, nil, err
+		}
+		if decision == authorizer.DecisionAllow {
+			users = append(users, auth.Subject{Name: username})
+		}
+	}
+	for _, subjectKey := range groupKeys.List() {
+		attr := simulationAttributes(tenantID, "", projectID, resource, action, extra)
+		decision, _, err := a.decide(ctx, attr, tenantID, subjectKey, projectID, resource, action)
+		if err != nil {
+			return nil, nil, err
+		}
+		if decision == authorizer.DecisionAllow {
+			groups = append(groups, auth.Subject{ID: strings.TrimPrefix(subjectKey, authutil.GroupPrefix(tenantID))})
+		}
+	}
+	return users, groups, nil
+}
+
+// decide makes the decision of the casbin subject of the tenant, which may be
+// a group. Like users, the subject is allowed by the policies bound to all
+// users.
+func (a *Authorizer) decide(ctx context.Context, attr authorizer.Attributes, tenantID, subjectKey, projectID, resource, action string) (authorizer.Decision, string, error) {
+	allow, err := a.enforcer.Enforce(subjectKey, projectID, resource, action)
+	if err != nil {
+		return authorizer.DecisionDeny, "", err
+	}
+	allow, explanation := a.applyConditions(ctx, attr, subjectKey, projectID, resource, action, allow)
+	if !allow {
+		allKey := authutil.UserKey(tenantID, authutil.DefaultAll)
+		allowAll, err := a.enforcer.Enforce(allKey, projectID, resource, action)
+		if err != nil {
+			return authorizer.DecisionDeny, "", err
+		}
+		allowAll, allExplanation := a.applyConditions(ctx, attr, allKey, projectID, resource, action, allowAll)
+		if allowAll {
+			return authorizer.DecisionAllow, allExplanation, nil
+		}
+		return authorizer.DecisionDeny, joinReason(fmt.Sprintf("permission for %s on %s not verify", action, resource), explanation), nil
+	}
+	return authorizer.DecisionAllow, explanation, nil
+}
+
+// simulationAttributes returns the attributes the user of the tenant would be
+// authorized with, the project is carried by the groups like the requests
+// forwarded by the gateway.
+func simulationAttributes(tenantID, username, projectID, resource, action string, extra map[string][]string) authorizer.AttributesRecord {
+	userExtra := make(map[string][]string, len(extra)+1)
+	for k, v := range extra {
+		userExtra[k] = v
+	}
+	userExtra[genericoidc.TenantIDKey] = []string{tenantID}
+
+	var groups []string
+	if projectID != "" {
+		groups = append(groups, fmt.Sprintf("project:%s", projectID))
+	}
+	return authorizer.AttributesRecord{
+		User: &user.DefaultInfo{
+			Name:   username,
+			Groups: groups,
+			Extra:  userExtra,
+		},
+		Verb:            action,
+		Resource:        resource,
+		ResourceRequest: true,
+	}
+}
+
+// paths returns the casbin subjects the subject inherits in the project with
+// the names linking them, including the subject itself. Like the role
+// manager, the links in the project and the links in all projects are
+// followed separately.
+func (a *Authorizer) paths(subjectKey, projectID string) []path {
+	var paths []path
+	for _, domain := range domainsOf(projectID) {
+		visited := sets.NewString(subjectKey)
+		queue := []path{{names: []string{subjectKey}, domain: domain}}
+		for len(queue) > 0 {
+			p := queue[0]
+			queue = queue[1:]
+			paths = append(paths, p)
+			for _, rule := range a.enforcer.GetFilteredGroupingPolicy(0, p.last(), "", domain) {
+				if len(rule) < 3 || visited.Has(rule[1]) {
+					continue
+				}
+				visited.Insert(rule[1])
+				names := append(append([]string{}, p.names...), rule[1])
+				queue = append(queue, path{names: names, domain: domain})
+			}
+		}
+	}
+	return paths
+}
+
+// inheritors returns the casbin subjects inheriting the name in the project.
+func (a *Authorizer) inheritors(name, projectID string) []string {
+	var inheritors []string
+	for _, domain := range domainsOf(projectID) {
+		visited := sets.NewString(name)
+		queue := []string{name}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			inheritors = append(inheritors, current)
+			for _, rule := range a.enforcer.GetFilteredGroupingPolicy(1, current, domain) {
+				if len(rule) < 3 || visited.Has(rule[0]) {
+					continue
+				}
+				visited.Insert(rule[0])
+				queue = append(queue, rule[0])
+			}
+		}
+	}
+	return inheritors
+}
+
+func domainsOf(projectID string) []string {
+	if projectID == "" || projectID == authutil.DefaultDomain {
+		return []string{authutil.DefaultDomain}
+	}
+	return []string{projectID, authutil.DefaultDomain}
+}
+
+// linker converts casbin subjects of the tenant to the objects they stand
+// for. The custom policy bindings of the tenant are listed at most once.
+type linker struct {
+	authClient authinternalclient.AuthInterface
+	tenantID   string
+	// bindings maps the role keys of the custom policy bindings to their
+	// namespaced names, nil until listed.
+	bindings map[string]string
+}
+
+// chain converts the names of the path to the objects they stand for. A user
+// or a group linked to a policy in a project is bound by a project policy
+// binding.
+func (l *linker) chain(ctx context.Context, p path) []auth.PolicyLink {
+	var projectID string
+	if p.domain != authutil.DefaultDomain {
+		projectID = p.domain
+	}
+
+	var links []auth.PolicyLink
+	var previous string
+	for i, name := range p.names {
+		link := l.link(ctx, name)
+		if i > 0 {
+			link.ProjectID = projectID
+		}
+		if link.Kind == LinkKindPolicy && projectID != "" && (previous == LinkKindUser || previous == LinkKindGroup) {
+			links = append(links, auth.PolicyLink{
+				Kind:      LinkKindProjectPolicyBinding,
+				Name:      authutil.ProjectPolicyName(projectID, name),
+				ProjectID: projectID,
+			})
+		}
+		links = append(links, link)
+		previous = link.Kind
+	}
+	return links
+}
+
+// link returns the object the casbin subject stands for.
+func (l *linker) link(ctx context.Context, name string) auth.PolicyLink {
+	switch {
+	case strings.HasPrefix(name, authutil.UserPrefix(l.tenantID)):
+		return auth.PolicyLink{Kind: LinkKindUser, Name: strings.TrimPrefix(name, authutil.UserPrefix(l.tenantID))}
+	case strings.HasPrefix(name, authutil.GroupPrefix(l.tenantID)):
+		return auth.PolicyLink{Kind: LinkKindGroup, Name: strings.TrimPrefix(name, authutil.GroupPrefix(l.tenantID))}
+	}
+
+	if _, err := l.authClient.Policies().Get(ctx, name, metav1.GetOptions{}); err == nil {
+		return auth.PolicyLink{Kind: LinkKindPolicy, Name: name}
+	}
+	if _, err := l.authClient.Roles().Get(ctx, name, metav1.GetOptions{}); err == nil {
+		return auth.PolicyLink{Kind: LinkKindRole, Name: name}
+	}
+	if binding, ok := l.customPolicyBinding(ctx, name); ok {
+		return auth.PolicyLink{Kind: LinkKindCustomPolicyBinding, Name: binding}
+	}
+	return auth.PolicyLink{Name: name}
+}
+
+// customPolicyBinding returns the namespaced name of the custom policy binding
+// the casbin role belongs to. The rules of custom policy bindings belong to
+// roles named after the rule prefix and the policy.
+func (l *linker) customPolicyBinding(ctx context.Context, name string) (string, bool) {
+	if l.bindings == nil {
+		l.bindings = make(map[string]string)
+		bindings, err := l.authClient.CustomPolicyBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: fmt.Sprintf("spec.tenantID=%s", l.tenantID),
+		})
+		if err != nil {
+			log.Error("List custom policy bindings failed", log.String("tenant", l.tenantID), log.Err(err))
+		} else {
+			for _, binding := range bindings.Items {
+				l.bindings[authutil.RoleKey(binding.Spec.RulePrefix, binding.Spec.PolicyID)] = binding.Namespace + "/" + binding.Name
+			}
+		}
+	}
+	binding, ok := l.bindings[name]
+	return binding, ok
+}
@@ -0,0 +1,340 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+// newSimulationAuthorizer builds an authorizer from the fixture policies:
+//
+//   - alice is bound to pol-read and pol-deny-ns2
+//   - bob is in the group dev, which is bound to the role rol-dev holding
+//     pol-read
+//   - carol and the group ops are bound to pol-prj in the project prj-1
+//   - dave is bound to pol-ip, which only applies from 10.0.0.0/8
+//   - erin is bound to the roles of the custom policy bindings cpb-1 and
+//     cpb-2
+//   - all users are bound to pol-all
+//   - root is an administrator of the tenant
+func newSimulationAuthorizer(t *testing.T) (*Authorizer, *fake.Clientset) {
+	deployment := []string{"getDeployment"}
+	policies := []*auth.Policy{
+		testPolicy("pol-read", auth.Allow, deployment, []string{"namespace:*"}, ""),
+		testPolicy("pol-deny-ns2", auth.Deny, deployment, []string{"namespace:ns-2/*"}, ""),
+		testPolicy("pol-prj", auth.Allow, deployment, []string{"namespace:*"}, ""),
+		testPolicy("pol-ip", auth.Allow, deployment, []string{"namespace:*"}, `{"sourceIP":["10.0.0.0/8"]}`),
+		testPolicy("pol-all", auth.Allow, []string{"listClusters"}, []string{"*"}, ""),
+	}
+	cpb1 := authutil.RoleKey("cpb", "pol-secret-1")
+	cpb2 := authutil.RoleKey("cpb", "pol-secret-2")
+	links := [][]string{
+		{authutil.UserKey(testTenantID, "alice"), "pol-read", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "alice"), "pol-deny-ns2", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "bob"), authutil.GroupKey(testTenantID, "dev"), authutil.DefaultDomain},
+		{authutil.GroupKey(testTenantID, "dev"), "rol-dev", authutil.DefaultDomain},
+		{"rol-dev", "pol-read", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "carol"), "pol-prj", "prj-1"},
+		{authutil.GroupKey(testTenantID, "ops"), "pol-prj", "prj-1"},
+		{authutil.UserKey(testTenantID, "dave"), "pol-ip", authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "erin"), cpb1, authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, "erin"), cpb2, authutil.DefaultDomain},
+		{authutil.UserKey(testTenantID, authutil.DefaultAll), "pol-all", authutil.DefaultDomain},
+	}
+	a, client := newTestAuthorizer(t, policies, links)
+	for _, rule := range [][]string{
+		{cpb1, "*", "*", "getSecret", string(auth.Allow)},
+		{cpb2, "*", "*", "*Secret", string(auth.Allow)},
+	} {
+		if _, err := a.enforcer.AddPolicy(rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.Background()
+	if _, err := client.Auth().IdentityProviders().Create(ctx, &auth.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: testTenantID},
+		Spec:       auth.IdentityProviderSpec{Name: testTenantID, Administrators: []string{"root"}},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Auth().Roles().Create(ctx, &auth.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "rol-dev"},
+		Spec:       auth.RoleSpec{TenantID: testTenantID, DisplayName: "dev", Policies: []string{"pol-read"}},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for i, policyID := range []string{"pol-secret-1", "pol-secret-2"} {
+		if _, err := client.Auth().CustomPolicyBindings("default").Create(ctx, &auth.CustomPolicyBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("cpb-%d", i+1)},
+			Spec:       auth.CustomPolicyBindingSpec{TenantID: testTenantID, PolicyID: policyID, RulePrefix: "cpb"},
+		}, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client.ClearActions()
+	return a, client
+}
+
+// simulationContext carries the simulated attributes, like the policy
+// simulation storage.
+func simulationContext(extra map[string][]string) context.Context {
+	return condition.WithAttributes(context.Background(), condition.SimulatedAttributesFrom(extra, time.Now()))
+}
+
+// formatMatch formats the match as policy/effect/applied followed by the
+// chain of kind:name@project links.
+func formatMatch(m auth.PolicyMatch) string {
+	var links []string
+	for _, link := range m.Chain {
+		s := link.Kind + ":" + link.Name
+		if link.ProjectID != "" {
+			s += "@" + link.ProjectID
+		}
+		links = append(links, s)
+	}
+	return fmt.Sprintf("%s/%s/%t: %s", m.Policy, m.Effect, m.Applied, strings.Join(links, " > "))
+}
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name      string
+		username  string
+		groupID   string
+		projectID string
+		resource  string
+		action    string
+		extra     map[string][]string
+		allow     bool
+		matches   []string
+	}{
+		{
+			name:     "user bound to policy",
+			username: "alice",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			allow:    true,
+			matches:  []string{"pol-read/allow/true: User:alice > Policy:pol-read"},
+		},
+		{
+			name:     "deny overrides allow",
+			username: "alice",
+			resource: "namespace:ns-2/deployment:nginx",
+			action:   "getDeployment",
+			matches: []string{
+				"pol-read/allow/true: User:alice > Policy:pol-read",
+				"pol-deny-ns2/deny/true: User:alice > Policy:pol-deny-ns2",
+			},
+		},
+		{
+			name:     "user inheriting group and role",
+			username: "bob",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			allow:    true,
+			matches:  []string{"pol-read/allow/true: User:bob > Group:dev > Role:rol-dev > Policy:pol-read"},
+		},
+		{
+			name:      "user bound in project",
+			username:  "carol",
+			projectID: "prj-1",
+			resource:  "namespace:ns-1/deployment:nginx",
+			action:    "getDeployment",
+			allow:     true,
+			matches:   []string{"pol-prj/allow/true: User:carol > ProjectPolicyBinding:prj-1-pol-prj@prj-1 > Policy:pol-prj@prj-1"},
+		},
+		{
+			name:     "user bound in project outside of it",
+			username: "carol",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+		},
+		{
+			name:      "group bound in project",
+			groupID:   "ops",
+			projectID: "prj-1",
+			resource:  "namespace:ns-1/deployment:nginx",
+			action:    "getDeployment",
+			allow:     true,
+			matches:   []string{"pol-prj/allow/true: Group:ops > ProjectPolicyBinding:prj-1-pol-prj@prj-1 > Policy:pol-prj@prj-1"},
+		},
+		{
+			name:     "user allowed by policy of all users",
+			username: "bob",
+			resource: "cluster:*",
+			action:   "listClusters",
+			allow:    true,
+			matches:  []string{"pol-all/allow/true: User:* > Policy:pol-all"},
+		},
+		{
+			name:     "group allowed by policy of all users",
+			groupID:  "dev",
+			resource: "cluster:*",
+			action:   "listClusters",
+			allow:    true,
+			matches:  []string{"pol-all/allow/true: User:* > Policy:pol-all"},
+		},
+		{
+			name:     "condition satisfied",
+			username: "dave",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			extra:    map[string][]string{condition.SourceIPKey: {"10.0.0.1"}},
+			allow:    true,
+			matches:  []string{"pol-ip/allow/true: User:dave > Policy:pol-ip"},
+		},
+		{
+			name:     "condition not satisfied",
+			username: "dave",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			extra:    map[string][]string{condition.SourceIPKey: {"192.168.0.1"}},
+			matches:  []string{"pol-ip/allow/false: User:dave > Policy:pol-ip"},
+		},
+		{
+			name:     "custom policy binding",
+			username: "erin",
+			resource: "secret:*",
+			action:   "getSecret",
+			allow:    true,
+			matches: []string{
+				"cpb-pol-secret-1/allow/true: User:erin > CustomPolicyBinding:default/cpb-1",
+				"cpb-pol-secret-2/allow/true: User:erin > CustomPolicyBinding:default/cpb-2",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := newSimulationAuthorizer(t)
+			decision, reason, matches, err := a.Simulate(simulationContext(tt.extra), testTenantID, tt.username, tt.groupID, tt.projectID, tt.resource, tt.action, tt.extra)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allow := decision == authorizer.DecisionAllow; allow != tt.allow {
+				t.Errorf("expected allow %t, got %t: %s", tt.allow, allow, reason)
+			}
+			var got []string
+			for _, m := range matches {
+				got = append(got, formatMatch(m))
+			}
+			if !reflect.DeepEqual(got, tt.matches) {
+				t.Errorf("expected matches %q, got %q", tt.matches, got)
+			}
+		})
+	}
+}
+
+func TestSimulateListsCustomPolicyBindingsOnce(t *testing.T) {
+	a, client := newSimulationAuthorizer(t)
+	if _, _, _, err := a.Simulate(simulationContext(nil), testTenantID, "erin", "", "", "secret:*", "getSecret", nil); err != nil {
+		t.Fatal(err)
+	}
+	var lists int
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "list" && action.GetResource().Resource == "custompolicybindings" {
+			lists++
+		}
+	}
+	if lists != 1 {
+		t.Errorf("expected custom policy bindings to be listed once, got %d", lists)
+	}
+}
+
+func TestWhoCan(t *testing.T) {
+	tests := []struct {
+		name      string
+		projectID string
+		resource  string
+		action    string
+		extra     map[string][]string
+		users     []string
+		groups    []string
+	}{
+		{
+			name:     "users and groups inheriting policy",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			users:    []string{"alice", "bob", "root"},
+			groups:   []string{"dev"},
+		},
+		{
+			name:      "subjects bound in project",
+			projectID: "prj-1",
+			resource:  "namespace:ns-1/deployment:nginx",
+			action:    "getDeployment",
+			users:     []string{"alice", "bob", "carol", "root"},
+			groups:    []string{"dev", "ops"},
+		},
+		{
+			name:     "denied user",
+			resource: "namespace:ns-2/deployment:nginx",
+			action:   "getDeployment",
+			users:    []string{"bob", "root"},
+			groups:   []string{"dev"},
+		},
+		{
+			name:     "condition satisfied",
+			resource: "namespace:ns-1/deployment:nginx",
+			action:   "getDeployment",
+			extra:    map[string][]string{condition.SourceIPKey: {"10.0.0.1"}},
+			users:    []string{"alice", "bob", "dave", "root"},
+			groups:   []string{"dev"},
+		},
+		{
+			name:     "policy of all users",
+			resource: "cluster:*",
+			action:   "listClusters",
+			users:    []string{"root"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, _ := newSimulationAuthorizer(t)
+			users, groups, err := a.WhoCan(simulationContext(tt.extra), testTenantID, tt.projectID, tt.resource, tt.action, tt.extra)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotUsers, gotGroups []string
+			for _, u := range users {
+				gotUsers = append(gotUsers, u.Name)
+			}
+			for _, g := range groups {
+				gotGroups = append(gotGroups, g.ID)
+			}
+			if !reflect.DeepEqual(gotUsers, tt.users) {
+				t.Errorf("expected users %q, got %q", tt.users, gotUsers)
+			}
+			if !reflect.DeepEqual(gotGroups, tt.groups) {
+				t.Errorf("expected groups %q, got %q", tt.groups, gotGroups)
+			}
+		})
+	}
+}
@@ -0,0 +1,597 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "tkestack.io/tke/api/auth/v1"
+	authzv1 "tkestack.io/tke/api/authz/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	authzversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/authz/v1"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/auth/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	accessRequestEnqueueGracePeriod = 1 * time.Second
+)
+
+const (
+	controllerName = "accessrequest-controller"
+)
+
+// Controller is responsible for binding the grants of approved access
+// requests, removing them at expiry or revocation, and notifying every change
+// of the requests.
+type Controller struct {
+	client       clientset.Interface
+	authzClient  authzversionedclient.AuthzV1Interface
+	notifyClient notifyversionedclient.NotifyV1Interface
+	queue        workqueue.RateLimitingInterface
+	lister       authv1lister.AccessRequestLister
+	listerSynced cache.InformerSynced
+}
+
+// NewController creates a new access request controller. The authz client is
+// needed for the cluster roles and the notify client for the notifications,
+// the requests that need them fail or are not notified if they are nil.
+func NewController(client clientset.Interface, authzClient authzversionedclient.AuthzV1Interface,
+	notifyClient notifyversionedclient.NotifyV1Interface, accessRequestInformer authv1informer.AccessRequestInformer,
+	resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:       client,
+		authzClient:  authzClient,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.AuthV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("accessrequest_controller", client.AuthV1().RESTClient().GetRateLimiter())
+	}
+
+	accessRequestInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueue(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = accessRequestInformer.Lister()
+	controller.listerSynced = accessRequestInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.AddAfter(key, accessRequestEnqueueGracePeriod)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting access request controller")
+	defer log.Info("Shutting down access request controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for access request caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of access request objects.
+// Each access request can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same access request at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the access request to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the access request with the given key. This function is
+// not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+
+	defer func() {
+		log.Info("Finished syncing access request", log.String("accessRequest", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	request, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Access request has been deleted.", log.String("accessRequest", key))
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve access request from store", log.String("accessRequest", key), log.Err(err))
+		return err
+	}
+
+	ctx := context.Background()
+	request = request.DeepCopy()
+	if request.DeletionTimestamp != nil {
+		return c.finalize(ctx, request)
+	}
+	return c.reconcile(ctx, request)
+}
+
+// reconcile moves the access request forward in its lifecycle, and records
+// the changes in its status.
+func (c *Controller) reconcile(ctx context.Context, request *v1.AccessRequest) error {
+	changed := false
+	now := metav1.Now()
+
+	switch request.Status.Phase {
+	case v1.AccessRequestApproved:
+		// The binding is recorded before it is made, so that a request revoked
+		// meanwhile still removes it.
+		if request.Status.BindingName == "" {
+			bindingName, bound, err := c.prepare(ctx, request)
+			if err != nil {
+				log.Error("Failed to prepare grant of access request", log.String("accessRequest", request.Name), log.Err(err))
+				return c.fail(ctx, request, err)
+			}
+			request.Status.BindingName = bindingName
+			request.Status.Granted = !bound
+			_, err = c.client.AuthV1().AccessRequests().UpdateStatus(ctx, request, metav1.UpdateOptions{})
+			return err
+		}
+		if request.Status.Granted {
+			if err := c.grant(ctx, request); err != nil {
+				log.Error("Failed to grant access request", log.String("accessRequest", request.Name), log.Err(err))
+				return c.fail(ctx, request, err)
+			}
+		}
+		request.Status.Phase = v1.AccessRequestActive
+		request.Status.StartTime = now
+		request.Status.ExpireTime = metav1.NewTime(now.Add(time.Duration(request.Spec.Hours) * time.Hour))
+		message := fmt.Sprintf("granted %s until %s", grantOf(request), request.Status.ExpireTime.Format(time.RFC3339))
+		if !request.Status.Granted {
+			message = fmt.Sprintf("user already has %s", grantOf(request))
+		}
+		c.record(request, message, now)
+		changed = true
+	case v1.AccessRequestActive:
+		if now.Before(&request.Status.ExpireTime) {
+			c.queue.AddAfter(request.Name, request.Status.ExpireTime.Sub(now.Time))
+			break
+		}
+		if err := c.revoke(ctx, request); err != nil {
+			log.Error("Failed to remove expired grant of access request", log.String("accessRequest", request.Name), log.Err(err))
+			return c.fail(ctx, request, err)
+		}
+		request.Status.Phase = v1.AccessRequestExpired
+		c.record(request, fmt.Sprintf("removed %s at expiry", grantOf(request)), now)
+		changed = true
+	case v1.AccessRequestRevoked:
+		if request.Status.Granted {
+			if err := c.revoke(ctx, request); err != nil {
+				log.Error("Failed to remove revoked grant of access request", log.String("accessRequest", request.Name), log.Err(err))
+				return c.fail(ctx, request, err)
+			}
+			changed = true
+		}
+	}
+
+	if c.notify(ctx, request) {
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	request.Status.Message = ""
+	_, err := c.client.AuthV1().AccessRequests().UpdateStatus(ctx, request, metav1.UpdateOptions{})
+	return err
+}
+
+// finalize removes the grant of a deleted access request, and then the
+// finalizer which holds the request in the storage.
+func (c *Controller) finalize(ctx context.Context, request *v1.AccessRequest) error {
+	if request.Status.Granted {
+		if err := c.revoke(ctx, request); err != nil {
+			log.Error("Failed to remove grant of deleted access request", log.String("accessRequest", request.Name), log.Err(err))
+			return err
+		}
+		log.Info("Removed grant of deleted access request",
+			log.String("accessRequest", request.Name),
+			log.String("tenantID", request.Spec.TenantID),
+			log.String("username", request.Spec.Username),
+			log.String("grant", grantOf(request)))
+	}
+
+	var finalizers []string
+	for _, finalizer := range request.Finalizers {
+		if finalizer != string(v1.AccessRequestFinalize) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	if len(finalizers) == len(request.Finalizers) {
+		return nil
+	}
+	request.Finalizers = finalizers
+	_, err := c.client.AuthV1().AccessRequests().Update(ctx, request, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// record appends an event made by the controller to the history of the
+// request, and logs it as the audit trail of the grant.
+func (c *Controller) record(request *v1.AccessRequest, message string, now metav1.Time) {
+	request.Status.History = append(request.Status.History, v1.AccessRequestEvent{
+		Phase:   request.Status.Phase,
+		Time:    now,
+		Message: message,
+	})
+	log.Info("Access request changed",
+		log.String("accessRequest", request.Name),
+		log.String("tenantID", request.Spec.TenantID),
+		log.String("username", request.Spec.Username),
+		log.String("phase", string(request.Status.Phase)),
+		log.String("reviewer", request.Status.Reviewer),
+		log.String("message", message))
+}
+
+// fail records the failure in the status of the request, the request is
+// retried with the returned error.
+func (c *Controller) fail(ctx context.Context, request *v1.AccessRequest, err error) error {
+	request.Status.Message = err.Error()
+	if _, updateErr := c.client.AuthV1().AccessRequests().UpdateStatus(ctx, request, metav1.UpdateOptions{}); updateErr != nil {
+		log.Warn("Failed to update status of access request", log.String("accessRequest", request.Name), log.Err(updateErr))
+	}
+	return err
+}
+
+// prepare returns the binding of the grant of the request, and whether the
+// user has the grant already, in which case it is left in place at expiry.
+func (c *Controller) prepare(ctx context.Context, request *v1.AccessRequest) (string, bool, error) {
+	if request.Spec.PolicyID == "" {
+		namespace, err := bindingNamespace(request)
+		if err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("%s/%s", namespace, request.Name), false, nil
+	}
+
+	bindingName := util.ProjectPolicyName(request.Spec.ProjectID, request.Spec.PolicyID)
+	user, err := c.userOf(ctx, request)
+	if err != nil {
+		return "", false, err
+	}
+	binding, err := c.client.AuthV1().ProjectPolicyBindings().Get(ctx, bindingName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return bindingName, false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	for _, subject := range binding.Spec.Users {
+		if subject.ID == user.Spec.ID {
+			return bindingName, true, nil
+		}
+	}
+	return bindingName, false, nil
+}
+
+// grant binds the requested grant to the user.
+func (c *Controller) grant(ctx context.Context, request *v1.AccessRequest) error {
+	if request.Spec.PolicyID != "" {
+		return c.grantPolicy(ctx, request)
+	}
+	return c.grantClusterRole(ctx, request)
+}
+
+// revoke removes the grant bound by the request, if any.
+func (c *Controller) revoke(ctx context.Context, request *v1.AccessRequest) error {
+	if request.Status.Granted {
+		var err error
+		if request.Spec.PolicyID != "" {
+			err = c.revokePolicy(ctx, request)
+		} else {
+			err = c.revokeClusterRole(ctx, request)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	request.Status.Granted = false
+	return nil
+}
+
+// grantPolicy adds the user to the binding of the policy in the project.
+func (c *Controller) grantPolicy(ctx context.Context, request *v1.AccessRequest) error {
+	user, err := c.userOf(ctx, request)
+	if err != nil {
+		return err
+	}
+	subject := v1.Subject{ID: user.Spec.ID, Name: user.Spec.Name}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding, err := c.client.AuthV1().ProjectPolicyBindings().Get(ctx, request.Status.BindingName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			binding = &v1.ProjectPolicyBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: request.Status.BindingName},
+				Spec: v1.ProjectPolicyBindingSpec{
+					TenantID:  request.Spec.TenantID,
+					ProjectID: request.Spec.ProjectID,
+					PolicyID:  request.Spec.PolicyID,
+					Users:     []v1.Subject{subject},
+				},
+			}
+			_, err = c.client.AuthV1().ProjectPolicyBindings().Create(ctx, binding, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		for _, s := range binding.Spec.Users {
+			if s.ID == user.Spec.ID {
+				return nil
+			}
+		}
+		binding.Spec.Users = append(binding.Spec.Users, subject)
+		_, err = c.client.AuthV1().ProjectPolicyBindings().Update(ctx, binding, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// revokePolicy removes the user from the binding of the policy in the
+// project, the binding itself is kept.
+func (c *Controller) revokePolicy(ctx context.Context, request *v1.AccessRequest) error {
+	user, err := c.userOf(ctx, request)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		binding, err := c.client.AuthV1().ProjectPolicyBindings().Get(ctx, request.Status.BindingName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var users []v1.Subject
+		for _, subject := range binding.Spec.Users {
+			if subject.ID != user.Spec.ID {
+				users = append(users, subject)
+			}
+		}
+		if len(users) == len(binding.Spec.Users) {
+			return nil
+		}
+		binding.Spec.Users = users
+		_, err = c.client.AuthV1().ProjectPolicyBindings().Update(ctx, binding, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// grantClusterRole creates a multi cluster role binding of the user, named
+// after the request.
+func (c *Controller) grantClusterRole(ctx context.Context, request *v1.AccessRequest) error {
+	if c.authzClient == nil {
+		return fmt.Errorf("authz api client is not configured")
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(request.Status.BindingName)
+	if err != nil {
+		return err
+	}
+	binding := &authzv1.MultiClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: authzv1.MultiClusterRoleBindingSpec{
+			TenantID: request.Spec.TenantID,
+			Username: request.Spec.Username,
+			RoleName: request.Spec.ClusterRole,
+			Clusters: request.Spec.Clusters,
+		},
+	}
+	_, err = c.authzClient.MultiClusterRoleBindings(namespace).Create(ctx, binding, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// revokeClusterRole deletes the multi cluster role binding of the request.
+func (c *Controller) revokeClusterRole(ctx context.Context, request *v1.AccessRequest) error {
+	if c.authzClient == nil {
+		return fmt.Errorf("authz api client is not configured")
+	}
+	namespace, name, err := cache.SplitMetaNamespaceKey(request.Status.BindingName)
+	if err != nil {
+		return err
+	}
+	err = c.authzClient.MultiClusterRoleBindings(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// bindingNamespace returns the namespace of the multi cluster role binding of
+// the request, the namespace of a role of the default namespace is the
+// project of the request.
+func bindingNamespace(request *v1.AccessRequest) (string, error) {
+	namespace, _, err := cache.SplitMetaNamespaceKey(request.Spec.ClusterRole)
+	if err != nil {
+		return "", err
+	}
+	if namespace == "default" && request.Spec.ProjectID != "" {
+		return request.Spec.ProjectID, nil
+	}
+	return namespace, nil
+}
+
+func (c *Controller) userOf(ctx context.Context, request *v1.AccessRequest) (*v1.User, error) {
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", request.Spec.TenantID),
+		fields.OneTermEqualSelector("spec.username", request.Spec.Username))
+	users, err := c.client.AuthV1().Users().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(users.Items) == 0 {
+		return nil, errors.NewNotFound(v1.Resource("users"), request.Spec.Username)
+	}
+	return &users.Items[0], nil
+}
+
+// notify sends the events of the history which have not been notified yet,
+// and returns whether any event has been marked as notified.
+func (c *Controller) notify(ctx context.Context, request *v1.AccessRequest) bool {
+	notification := request.Spec.Notification
+	if notification == nil || c.notifyClient == nil {
+		return false
+	}
+
+	notified := false
+	for i := range request.Status.History {
+		event := &request.Status.History[i]
+		if event.Notified {
+			continue
+		}
+		_, err := c.notifyClient.MessageRequests(notification.ChannelName).Create(ctx, messageRequest(request, event), metav1.CreateOptions{})
+		if err != nil {
+			log.Error("Failed to create message request of access request",
+				log.String("accessRequest", request.Name), log.Err(err))
+			return notified
+		}
+		event.Notified = true
+		notified = true
+	}
+	return notified
+}
+
+func messageRequest(request *v1.AccessRequest, event *v1.AccessRequestEvent) *notifyv1.MessageRequest {
+	notification := request.Spec.Notification
+	return &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: notification.ChannelName,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:       request.Spec.TenantID,
+			TemplateName:   notification.TemplateName,
+			Receivers:      notification.Receivers,
+			ReceiverGroups: notification.ReceiverGroups,
+			Variables:      Variables(request, event),
+		},
+	}
+}
+
+// Variables returns the template variables of an event of the access
+// request.
+func Variables(request *v1.AccessRequest, event *v1.AccessRequestEvent) map[string]string {
+	return map[string]string{
+		"accessRequest": request.Name,
+		"username":      request.Spec.Username,
+		"project":       request.Spec.ProjectID,
+		"policy":        request.Spec.PolicyID,
+		"clusterRole":   request.Spec.ClusterRole,
+		"clusters":      strings.Join(request.Spec.Clusters, ","),
+		"hours":         fmt.Sprintf("%d", request.Spec.Hours),
+		"reason":        request.Spec.Reason,
+		"phase":         string(event.Phase),
+		"actor":         event.Actor,
+		"message":       event.Message,
+		"reviewer":      request.Status.Reviewer,
+		"expireTime":    expireTimeOf(request),
+	}
+}
+
+func expireTimeOf(request *v1.AccessRequest) string {
+	if request.Status.ExpireTime.IsZero() {
+		return ""
+	}
+	return request.Status.ExpireTime.Format(time.RFC3339)
+}
+
+// grantOf describes the grant of the request.
+func grantOf(request *v1.AccessRequest) string {
+	if request.Spec.PolicyID != "" {
+		return fmt.Sprintf("policy %s on project %s", request.Spec.PolicyID, request.Spec.ProjectID)
+	}
+	return fmt.Sprintf("cluster role %s on clusters %s", request.Spec.ClusterRole, strings.Join(request.Spec.Clusters, ","))
+}
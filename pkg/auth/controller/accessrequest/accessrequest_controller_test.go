@@ -0,0 +1,333 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	v1 "tkestack.io/tke/api/auth/v1"
+	authzv1 "tkestack.io/tke/api/authz/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
+	"tkestack.io/tke/pkg/auth/util"
+)
+
+const (
+	testTenantID = "default"
+	testUserID   = "usr-1"
+)
+
+func newTestUser() *v1.User {
+	return &v1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: testUserID},
+		Spec:       v1.UserSpec{ID: testUserID, Name: "alice", TenantID: testTenantID},
+	}
+}
+
+func newPolicyRequest(phase v1.AccessRequestPhase) *v1.AccessRequest {
+	return &v1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "ar-1", Finalizers: []string{string(v1.AccessRequestFinalize)}},
+		Spec: v1.AccessRequestSpec{
+			TenantID:  testTenantID,
+			Username:  "alice",
+			ProjectID: "prj-1",
+			PolicyID:  "pol-1",
+			Hours:     2,
+			Reason:    "on call",
+			Notification: &v1.AccessRequestNotification{
+				ChannelName:  "chl-1",
+				TemplateName: "tmpl-1",
+				Receivers:    []string{"rcv-1"},
+			},
+		},
+		Status: v1.AccessRequestStatus{Phase: phase, Reviewer: "bob"},
+	}
+}
+
+func newClusterRoleRequest(phase v1.AccessRequestPhase) *v1.AccessRequest {
+	return &v1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "ar-2", Finalizers: []string{string(v1.AccessRequestFinalize)}},
+		Spec: v1.AccessRequestSpec{
+			TenantID:    testTenantID,
+			Username:    "alice",
+			ProjectID:   "prj-1",
+			ClusterRole: "default/rol-1",
+			Clusters:    []string{"cls-1"},
+			Hours:       1,
+		},
+		Status: v1.AccessRequestStatus{Phase: phase, Reviewer: "bob"},
+	}
+}
+
+func newPolicyBinding(users ...v1.Subject) *v1.ProjectPolicyBinding {
+	return &v1.ProjectPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: util.ProjectPolicyName("prj-1", "pol-1")},
+		Spec: v1.ProjectPolicyBindingSpec{
+			TenantID:  testTenantID,
+			ProjectID: "prj-1",
+			PolicyID:  "pol-1",
+			Users:     users,
+		},
+	}
+}
+
+type fixture struct {
+	t          *testing.T
+	client     *fake.Clientset
+	controller *Controller
+	informers  versionedinformers.SharedInformerFactory
+}
+
+func newFixture(t *testing.T, objects ...runtime.Object) *fixture {
+	client := fake.NewSimpleClientset(objects...)
+	informers := versionedinformers.NewSharedInformerFactory(client, 0)
+	controller := NewController(client, client.AuthzV1(), client.NotifyV1(), informers.Auth().V1().AccessRequests(), 0)
+	t.Cleanup(controller.queue.ShutDown)
+	return &fixture{t: t, client: client, controller: controller, informers: informers}
+}
+
+// sync feeds the stored access request to the lister, like the informer,
+// syncs it and returns the stored request afterwards, nil if it is gone.
+func (f *fixture) sync(name string) *v1.AccessRequest {
+	ctx := context.Background()
+	request, err := f.client.AuthV1().AccessRequests().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	if err := f.informers.Auth().V1().AccessRequests().Informer().GetIndexer().Add(request); err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	if err := f.controller.syncItem(name); err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	request, err = f.client.AuthV1().AccessRequests().Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	return request
+}
+
+// update stores the access request changed by the mutation.
+func (f *fixture) update(request *v1.AccessRequest, mutate func(*v1.AccessRequest)) {
+	mutate(request)
+	if _, err := f.client.AuthV1().AccessRequests().Update(context.Background(), request, metav1.UpdateOptions{}); err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func (f *fixture) bindingUsers() []v1.Subject {
+	binding, err := f.client.AuthV1().ProjectPolicyBindings().Get(context.Background(), util.ProjectPolicyName("prj-1", "pol-1"), metav1.GetOptions{})
+	if err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	return binding.Spec.Users
+}
+
+func (f *fixture) clusterRoleBinding(namespace, name string) (*authzv1.MultiClusterRoleBinding, bool) {
+	binding, err := f.client.AuthzV1().MultiClusterRoleBindings(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, false
+	}
+	if err != nil {
+		f.t.Fatalf("unexpected error: %v", err)
+	}
+	return binding, true
+}
+
+func TestApprovePolicy(t *testing.T) {
+	f := newFixture(t, newTestUser(), newPolicyRequest(v1.AccessRequestApproved))
+
+	// The binding is recorded before it is made.
+	request := f.sync("ar-1")
+	if request.Status.BindingName != "prj-1-pol-1" || !request.Status.Granted || request.Status.Phase != v1.AccessRequestApproved {
+		t.Fatalf("unexpected status %+v", request.Status)
+	}
+
+	request = f.sync("ar-1")
+	if request.Status.Phase != v1.AccessRequestActive {
+		t.Fatalf("expected active, got %+v", request.Status)
+	}
+	if d := request.Status.ExpireTime.Sub(request.Status.StartTime.Time); d != 2*time.Hour {
+		t.Errorf("expected to expire in 2h, got %v", d)
+	}
+	if users := f.bindingUsers(); len(users) != 1 || users[0].ID != testUserID {
+		t.Errorf("expected the user to be bound, got %+v", users)
+	}
+	if len(request.Status.History) != 1 || !request.Status.History[0].Notified {
+		t.Errorf("expected a notified event, got %+v", request.Status.History)
+	}
+	messageRequests, _ := f.client.NotifyV1().MessageRequests("chl-1").List(context.Background(), metav1.ListOptions{})
+	if len(messageRequests.Items) != 1 || messageRequests.Items[0].Spec.Variables["phase"] != string(v1.AccessRequestActive) {
+		t.Errorf("expected a message request of the active request, got %+v", messageRequests.Items)
+	}
+
+	// Syncing again before expiry changes nothing.
+	f.client.ClearActions()
+	f.sync("ar-1")
+	for _, action := range f.client.Actions() {
+		if action.GetVerb() != "get" && action.GetVerb() != "list" {
+			t.Errorf("unexpected action %s %s", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+}
+
+func TestApprovePolicyAlreadyBound(t *testing.T) {
+	other := v1.Subject{ID: "usr-2", Name: "carol"}
+	user := v1.Subject{ID: testUserID, Name: "alice"}
+	f := newFixture(t, newTestUser(), newPolicyBinding(other, user), newPolicyRequest(v1.AccessRequestApproved))
+
+	f.sync("ar-1")
+	request := f.sync("ar-1")
+	if request.Status.Phase != v1.AccessRequestActive || request.Status.Granted {
+		t.Fatalf("expected active without grant, got %+v", request.Status)
+	}
+
+	// The binding the user had before is left in place at expiry.
+	f.update(request, func(request *v1.AccessRequest) {
+		request.Status.ExpireTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	})
+	request = f.sync("ar-1")
+	if request.Status.Phase != v1.AccessRequestExpired {
+		t.Fatalf("expected expired, got %+v", request.Status)
+	}
+	if users := f.bindingUsers(); len(users) != 2 {
+		t.Errorf("expected the binding to be kept, got %+v", users)
+	}
+}
+
+func TestExpirePolicy(t *testing.T) {
+	other := v1.Subject{ID: "usr-2", Name: "carol"}
+	f := newFixture(t, newTestUser(), newPolicyBinding(other), newPolicyRequest(v1.AccessRequestApproved))
+	f.sync("ar-1")
+	request := f.sync("ar-1")
+	if users := f.bindingUsers(); len(users) != 2 {
+		t.Fatalf("expected the user to be added to the binding, got %+v", users)
+	}
+
+	f.update(request, func(request *v1.AccessRequest) {
+		request.Status.ExpireTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	})
+	request = f.sync("ar-1")
+	if request.Status.Phase != v1.AccessRequestExpired || request.Status.Granted {
+		t.Fatalf("expected expired without grant, got %+v", request.Status)
+	}
+	if users := f.bindingUsers(); len(users) != 1 || users[0].ID != "usr-2" {
+		t.Errorf("expected only the user of the request to be removed, got %+v", users)
+	}
+	if n := len(request.Status.History); n != 2 || request.Status.History[1].Phase != v1.AccessRequestExpired {
+		t.Errorf("expected the expiry in the history, got %+v", request.Status.History)
+	}
+}
+
+func TestRevokePolicyBeforeExpiry(t *testing.T) {
+	f := newFixture(t, newTestUser(), newPolicyRequest(v1.AccessRequestApproved))
+	f.sync("ar-1")
+	request := f.sync("ar-1")
+	if request.Status.ExpireTime.Before(&metav1.Time{Time: time.Now()}) {
+		t.Fatalf("expected to expire in the future, got %v", request.Status.ExpireTime)
+	}
+
+	f.update(request, func(request *v1.AccessRequest) {
+		request.Status.Phase = v1.AccessRequestRevoked
+	})
+	request = f.sync("ar-1")
+	if request.Status.Phase != v1.AccessRequestRevoked || request.Status.Granted {
+		t.Fatalf("expected revoked without grant, got %+v", request.Status)
+	}
+	if users := f.bindingUsers(); len(users) != 0 {
+		t.Errorf("expected the user to be removed from the binding, got %+v", users)
+	}
+}
+
+func TestClusterRoleLifecycle(t *testing.T) {
+	f := newFixture(t, newTestUser(), newClusterRoleRequest(v1.AccessRequestApproved))
+
+	request := f.sync("ar-2")
+	if request.Status.BindingName != "prj-1/ar-2" {
+		t.Fatalf("expected the binding in the project, got %q", request.Status.BindingName)
+	}
+	request = f.sync("ar-2")
+	if request.Status.Phase != v1.AccessRequestActive {
+		t.Fatalf("expected active, got %+v", request.Status)
+	}
+	binding, ok := f.clusterRoleBinding("prj-1", "ar-2")
+	if !ok {
+		t.Fatalf("expected the multi cluster role binding to be created")
+	}
+	if binding.Spec.Username != "alice" || binding.Spec.RoleName != "default/rol-1" || len(binding.Spec.Clusters) != 1 {
+		t.Errorf("unexpected binding %+v", binding.Spec)
+	}
+
+	f.update(request, func(request *v1.AccessRequest) {
+		request.Status.ExpireTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	})
+	request = f.sync("ar-2")
+	if request.Status.Phase != v1.AccessRequestExpired {
+		t.Fatalf("expected expired, got %+v", request.Status)
+	}
+	if _, ok := f.clusterRoleBinding("prj-1", "ar-2"); ok {
+		t.Errorf("expected the multi cluster role binding to be deleted at expiry")
+	}
+}
+
+func TestFinalizeRemovesBinding(t *testing.T) {
+	f := newFixture(t, newTestUser(), newClusterRoleRequest(v1.AccessRequestApproved))
+	f.sync("ar-2")
+	request := f.sync("ar-2")
+	if _, ok := f.clusterRoleBinding("prj-1", "ar-2"); !ok {
+		t.Fatalf("expected the multi cluster role binding to be created")
+	}
+
+	now := metav1.Now()
+	f.update(request, func(request *v1.AccessRequest) {
+		request.DeletionTimestamp = &now
+	})
+	request = f.sync("ar-2")
+	if _, ok := f.clusterRoleBinding("prj-1", "ar-2"); ok {
+		t.Errorf("expected the multi cluster role binding to be deleted")
+	}
+	if request != nil && len(request.Finalizers) != 0 {
+		t.Errorf("expected the finalizer to be removed, got %v", request.Finalizers)
+	}
+}
+
+func TestFinalizeWithoutGrant(t *testing.T) {
+	request := newPolicyRequest(v1.AccessRequestRejected)
+	now := metav1.Now()
+	request.DeletionTimestamp = &now
+	f := newFixture(t, newTestUser(), request)
+
+	request = f.sync("ar-1")
+	if request != nil && len(request.Finalizers) != 0 {
+		t.Errorf("expected the finalizer to be removed, got %v", request.Finalizers)
+	}
+	for _, action := range f.client.Actions() {
+		if action.GetResource().Resource == "projectpolicybindings" {
+			t.Errorf("unexpected action %s %s", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+}
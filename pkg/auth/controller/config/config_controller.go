@@ -410,6 +410,10 @@ func (c *Controller) loadPolicy(ctx context.Context, tenantID string) error {
 			if err != nil {
 				errs = append(errs, err)
 			}
+
+			if err := c.bindDefaultSubjects(ctx, pol); err != nil {
+				errs = append(errs, err)
+			}
 		}
 
 	}
@@ -417,6 +421,47 @@ func (c *Controller) loadPolicy(ctx context.Context, tenantID string) error {
 	return utilerrors.NewAggregate(errs)
 }
 
+// bindDefaultSubjects binds the users and groups listed in the status of the
+// default policy file to the policy, such as the self service policy which
+// is bound to all users, the subjects bound by administrators are kept.
+func (c *Controller) bindDefaultSubjects(ctx context.Context, pol *v1.Policy) error {
+	if len(pol.Status.Users) == 0 && len(pol.Status.Groups) == 0 {
+		return nil
+	}
+	exists, err := c.client.AuthV1().Policies().Get(ctx, pol.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	changed := false
+	for _, sub := range pol.Status.Users {
+		if !inSubjects(sub, exists.Status.Users) {
+			exists.Status.Users = append(exists.Status.Users, sub)
+			changed = true
+		}
+	}
+	for _, sub := range pol.Status.Groups {
+		if !inSubjects(sub, exists.Status.Groups) {
+			exists.Status.Groups = append(exists.Status.Groups, sub)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	log.Info("Bind subjects to default policy", log.String("name", pol.Name), log.Any("users", exists.Status.Users), log.Any("groups", exists.Status.Groups))
+	_, err = c.client.AuthV1().Policies().UpdateStatus(ctx, exists, metav1.UpdateOptions{})
+	return err
+}
+
+func inSubjects(subject v1.Subject, slice []v1.Subject) bool {
+	for _, s := range slice {
+		if subject.ID == s.ID {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Controller) createAdmin(ctx context.Context, tenantID string) error {
 	log.Info("Handle create admin for tenant", log.String("tenantID", tenantID))
 	tenantUserSelector := fields.AndSelectors(
@@ -249,6 +249,7 @@ var deleteResourceFuncs = []deleteResourceFunc{
 	deleteRelatedRoles,
 	deleteRelatedProjectPolicyBinding,
 	deleteApikeys,
+	deleteMultiFactorEnrollment,
 }
 
 // deleteAllContent will use the dynamic client to delete each resource identified in groupVersionResources.
@@ -400,3 +401,11 @@ func deleteApikeys(ctx context.Context, deleter *loalIdentitiedResourcesDeleter,
 
 	return deleter.authClient.APIKeys().DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{FieldSelector: policySelector.String()})
 }
+
+func deleteMultiFactorEnrollment(ctx context.Context, deleter *loalIdentitiedResourcesDeleter, localIdentity *v1.LocalIdentity) error {
+	err := deleter.authClient.MultiFactorEnrollments().Delete(ctx, localIdentity.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
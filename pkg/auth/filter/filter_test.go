@@ -28,6 +28,7 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
 
+	"tkestack.io/tke/api/auth"
 	"tkestack.io/tke/api/business"
 	"tkestack.io/tke/api/registry"
 )
@@ -328,6 +329,26 @@ func compare(a authorizer.Attributes, b authorizer.Attributes) bool {
 	return false
 }
 
+func TestUnprotectedAuthorized(t *testing.T) {
+	alice := &user.DefaultInfo{Name: "alice"}
+	tests := []struct {
+		name   string
+		attr   authorizer.AttributesRecord
+		expect authorizer.Decision
+	}{
+		{"list portal", authorizer.AttributesRecord{User: alice, Verb: "listPortal", APIGroup: auth.GroupName, Resource: "portal", ResourceRequest: true}, authorizer.DecisionAllow},
+		{"change password", authorizer.AttributesRecord{User: alice, Verb: "create", APIGroup: auth.GroupName, Resource: "localidentities", Subresource: "password", Name: "usr-1", ResourceRequest: true}, authorizer.DecisionNoOpinion},
+		{"no user", authorizer.AttributesRecord{Verb: "listPortal", APIGroup: auth.GroupName, Resource: "portal", ResourceRequest: true}, authorizer.DecisionNoOpinion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnprotectedAuthorized(&tt.attr); got != tt.expect {
+				t.Errorf("UnprotectedAuthorized() = %v, want %v", got, tt.expect)
+			}
+		})
+	}
+}
+
 const (
 	clusterContextKey = "clusterName"
 	clusterName       = "cls-82qkvzgp"
@@ -30,6 +30,7 @@ import (
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -52,7 +53,10 @@ func (h *Handler) AuthenticateToken(request *restful.Request, response *restful.
 		return
 	}
 
-	authResp, valid, err := h.tokenAuthenticator.AuthenticateToken(request.Request.Context(), tokenReview.Spec.Token)
+	// The source ip of the reviewer is not the one the token is used from,
+	// api keys are checked against the source ip of the access reviews then.
+	ctx := genericfilter.WithSourceIPValue(request.Request.Context(), nil)
+	authResp, valid, err := h.tokenAuthenticator.AuthenticateToken(ctx, tokenReview.Spec.Token)
 	if !valid || err != nil {
 		log.Error("Failed to authenticate token", log.String("token", tokenReview.Spec.Token), log.Bool("valid", valid), log.Err(err))
 		tokenReview.Status = authv1.TokenReviewStatus{Authenticated: false}
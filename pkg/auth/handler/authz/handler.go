@@ -19,6 +19,7 @@
 package authz
 
 import (
+	"context"
 	"net/http"
 
 	"tkestack.io/tke/pkg/auth/filter"
@@ -28,6 +29,8 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	authv1 "tkestack.io/tke/api/auth/v1"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
 	"tkestack.io/tke/pkg/auth/authorization/util"
 	apiserverfilter "tkestack.io/tke/pkg/platform/apiserver/filter"
 
@@ -59,7 +62,8 @@ func (h *Handler) Authorize(request *restful.Request, response *restful.Response
 	}
 	log.Debug("Receive subjectAccessReview request", log.Any("access review", accessReview))
 	authorizationAttributes := util.AuthorizationAttributesFrom(accessReview.Spec)
-	decision, reason, evaluationErr := h.authorizer.Authorize(request.Request.Context(), authorizationAttributes)
+	ctx := reviewContext(request.Request.Context(), authorizationAttributes)
+	decision, reason, evaluationErr := h.authorizer.Authorize(ctx, authorizationAttributes)
 
 	accessReview.Status = authv1.SubjectAccessReviewStatus{
 		Allowed: decision == authorizer.DecisionAllow,
@@ -89,9 +93,10 @@ func (h *Handler) RestAuthorize(request *restful.Request, response *restful.Resp
 	}
 
 	authorizationAttributes := util.AuthorizationAttributesFrom(accessReview.Spec)
-	tkeAttributes := filter.ConvertTKEAttributes(request.Request.Context(), &authorizationAttributes)
+	ctx := reviewContext(request.Request.Context(), authorizationAttributes)
+	tkeAttributes := filter.ConvertTKEAttributes(ctx, &authorizationAttributes)
 	log.Debug("RestAuthorize accessReview ", log.Any("spec", accessReview.Spec), log.Any("authorizationAttributes", authorizationAttributes), log.Any("tke attribute", tkeAttributes))
-	decision, reason, evaluationErr := h.authorizer.Authorize(request.Request.Context(), tkeAttributes)
+	decision, reason, evaluationErr := h.authorizer.Authorize(ctx, tkeAttributes)
 	accessReview.Status = authv1.SubjectAccessReviewStatus{
 		Allowed: decision == authorizer.DecisionAllow,
 		Denied:  decision == authorizer.DecisionDeny,
@@ -122,7 +127,7 @@ func (h *Handler) BatchAuthorize(request *restful.Request, response *restful.Res
 
 	accessReview.Status = authv1.SubjectAccessReviewStatus{AllowedList: []*authv1.AllowedStatus{}}
 	for index, resAttr := range accessReview.Spec.ResourceAttributesList {
-		decision, reason, _ := h.authorizer.Authorize(request.Request.Context(), attributesList[index])
+		decision, reason, _ := h.authorizer.Authorize(reviewContext(request.Request.Context(), attributesList[index]), attributesList[index])
 		accessReview.Status.AllowedList = append(accessReview.Status.AllowedList, &authv1.AllowedStatus{
 			Resource: resAttr.Resource,
 			Verb:     resAttr.Verb,
@@ -135,3 +140,10 @@ func (h *Handler) BatchAuthorize(request *restful.Request, response *restful.Res
 	log.Info("Receive rest authz request", log.Any("attribute", attributesList), log.Any("response", accessReview.Status))
 	responsewriters.WriteRawJSON(http.StatusOK, accessReview, response.ResponseWriter)
 }
+
+// reviewContext returns the context to authorize the review in, whose source
+// ip is the one reported by the review instead of the ip of the reviewer. The
+// api servers set it in the user extra by WithSourceIPExtra.
+func reviewContext(ctx context.Context, attr authorizer.Attributes) context.Context {
+	return genericfilter.WithSourceIPValue(ctx, condition.SourceIPFrom(attr.GetUser().GetExtra()))
+}
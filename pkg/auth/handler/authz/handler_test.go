@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authz
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/emicklei/go-restful"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/plugin/pkg/authorizer/webhook"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	"tkestack.io/tke/pkg/auth/authorization/local"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+// TestAPIKeySourceIPThroughWebhook authorizes the requests made with an api
+// key restricted to source ips on an api server, which reviews the access by
+// the authz webhook of the auth api server.
+func TestAPIKeySourceIPThroughWebhook(t *testing.T) {
+	authClient := fake.NewSimpleClientset().Auth()
+	if _, err := authClient.APIKeys().Create(context.Background(), &auth.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "apikey-1"},
+		Spec: auth.APIKeySpec{
+			TenantID:  "default",
+			Username:  "alice",
+			SourceIPs: []string{"10.0.0.0/8"},
+		},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := authClient.IdentityProviders().Create(context.Background(), &auth.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: auth.IdentityProviderSpec{
+			Name:           "default",
+			Administrators: []string{"alice"},
+		},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	m, err := model.NewModelFromString(auth.DefaultRuleModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	informers := versionedinformers.NewSharedInformerFactory(versionedfake.NewSimpleClientset(), 0)
+	localAuthorizer := local.NewAuthorizer(authClient, nil, enforcer, informers.Auth().V1().Policies(), "admin")
+
+	// the authz webhook of the auth api server
+	container := restful.NewContainer()
+	ws := new(restful.WebService)
+	ws.Route(ws.POST("/auth/authz").Consumes(restful.MIME_JSON).Produces(restful.MIME_JSON).To(NewHandler(localAuthorizer).Authorize))
+	container.Add(ws)
+	authServer := httptest.NewServer(container)
+	defer authServer.Close()
+
+	webhookAuthorizer, err := webhook.New(&restclient.Config{Host: authServer.URL + "/auth/authz"}, "v1", time.Minute, time.Minute, *webhook.DefaultRetryBackoff())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the handler chain of the api server authenticating the api key, with a
+	// client supplied source ip extra.
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		u, _ := genericrequest.UserFrom(req.Context())
+		decision, reason, err := webhookAuthorizer.Authorize(req.Context(), authorizer.AttributesRecord{
+			User:            u,
+			Verb:            "get",
+			APIGroup:        "platform.tkestack.io",
+			Resource:        "clusters",
+			Name:            "cls-1",
+			ResourceRequest: true,
+		})
+		if err != nil || decision != authorizer.DecisionAllow {
+			http.Error(w, reason, http.StatusForbidden)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	handler = genericfilter.WithSourceIPExtra(handler)
+	handler = withUser(handler, &user.DefaultInfo{
+		Name: "alice",
+		Extra: map[string][]string{
+			genericoidc.TenantIDKey:        {"default"},
+			authutil.APIKeyExtraKey:        {"apikey-1"},
+			genericfilter.SourceIPExtraKey: {"10.0.0.2"},
+		},
+	})
+	handler = genericfilter.WithSourceIP(handler)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		expected   int
+	}{
+		{
+			name:       "allowed address",
+			remoteAddr: "10.0.0.1:34567",
+			expected:   http.StatusOK,
+		},
+		{
+			name:       "disallowed address",
+			remoteAddr: "192.168.0.1:34567",
+			expected:   http.StatusForbidden,
+		},
+		{
+			name:       "disallowed address with spoofed forwarded for",
+			remoteAddr: "192.168.0.1:34567",
+			headers:    map[string]string{"X-Forwarded-For": "10.0.0.3"},
+			expected:   http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/apis/platform.tkestack.io/v1/clusters/cls-1", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			if recorder.Code != tt.expected {
+				t.Errorf("expected status %d, got %d: %s", tt.expected, recorder.Code, recorder.Body.String())
+			}
+		})
+	}
+}
+
+func withUser(handler http.Handler, u user.Info) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		handler.ServeHTTP(w, req.WithContext(genericrequest.WithUser(req.Context(), u)))
+	})
+}
@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package mfa
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// ChallengeRequest is the request to log in by a webauthn credential.
+type ChallengeRequest struct {
+	TenantID string `json:"tenantID"`
+	Username string `json:"username"`
+}
+
+// ChallengeResponse is the options passed to navigator.credentials.get() by
+// the login page.
+type ChallengeResponse struct {
+	Challenge        string   `json:"challenge"`
+	RelyingPartyID   string   `json:"rpId"`
+	AllowCredentials []string `json:"allowCredentials"`
+	// Timeout is the timeout of the challenge in milliseconds.
+	Timeout int64 `json:"timeout"`
+}
+
+// Handler handle the webauthn challenge http request of the login page.
+type Handler struct {
+	authClient authinternalclient.AuthInterface
+}
+
+// NewHandler creates new multi-factor authentication handler object.
+func NewHandler(authClient authinternalclient.AuthInterface) *Handler {
+	return &Handler{authClient: authClient}
+}
+
+// Challenge issues a challenge to log in by the webauthn credentials of the
+// local identity. The request is not authenticated, so a challenge without
+// credentials is returned for unknown users instead of an error.
+func (h *Handler) Challenge(request *restful.Request, response *restful.Response) {
+	rp := mfa.DefaultRelyingParty()
+	if rp == nil {
+		responsewriters.WriteRawJSON(http.StatusNotFound, errors.NewNotFound(auth.Resource("multifactorenrollments"), "webauthn").Status(), response.ResponseWriter)
+		return
+	}
+
+	challengeReq := &ChallengeRequest{}
+	if err := request.ReadEntity(challengeReq); err != nil || challengeReq.TenantID == "" || challengeReq.Username == "" {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest("tenantID and username are required").Status(), response.ResponseWriter)
+		return
+	}
+
+	ctx := request.Request.Context()
+	now := time.Now()
+	challengeResp := &ChallengeResponse{
+		RelyingPartyID:   rp.ID,
+		AllowCredentials: []string{},
+		Timeout:          mfa.ChallengeTimeout.Milliseconds(),
+	}
+
+	enrollment, err := h.getEnrollment(ctx, challengeReq)
+	if err == nil && len(enrollment.Spec.WebAuthn) != 0 {
+		challengeResp.Challenge, err = mfa.AddChallenge(enrollment, auth.WebAuthnPurposeAuthentication, now)
+		if err == nil {
+			_, err = h.authClient.MultiFactorEnrollments().UpdateStatus(ctx, enrollment, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			log.Error("Failed to issue webauthn challenge", log.String("tenantID", challengeReq.TenantID), log.String("username", challengeReq.Username), log.Err(err))
+			responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), response.ResponseWriter)
+			return
+		}
+		for _, credential := range enrollment.Spec.WebAuthn {
+			challengeResp.AllowCredentials = append(challengeResp.AllowCredentials, credential.ID)
+		}
+	} else if challengeResp.Challenge, err = mfa.NewChallenge(); err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), response.ResponseWriter)
+		return
+	}
+
+	responsewriters.WriteRawJSON(http.StatusOK, challengeResp, response.ResponseWriter)
+}
+
+func (h *Handler) getEnrollment(ctx context.Context, challengeReq *ChallengeRequest) (*auth.MultiFactorEnrollment, error) {
+	localIdentity, err := util.GetLocalIdentity(ctx, h.authClient, challengeReq.TenantID, challengeReq.Username)
+	if err != nil {
+		return nil, err
+	}
+	return h.authClient.MultiFactorEnrollments().Get(ctx, localIdentity.ObjectMeta.Name, metav1.GetOptions{})
+}
@@ -0,0 +1,272 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"tkestack.io/tke/api/auth"
+	scimapi "tkestack.io/tke/pkg/auth/scim"
+)
+
+// ListGroups lists the groups of the tenant matching the filter.
+func (h *Handler) ListGroups(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	tenantID, err := tenantID(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	expr, startIndex, count, err := queryParams(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	groups, err := h.listGroups(ctx, tenantID)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	resources := []interface{}{}
+	for i := range groups {
+		group := toGroup(request, &groups[i])
+		matched, err := matches(expr, group)
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+		if matched {
+			resources = append(resources, group)
+		}
+	}
+	writeResponse(response, http.StatusOK, scimapi.NewListResponse(resources, startIndex, count))
+}
+
+// GetGroup returns the group of the tenant.
+func (h *Handler) GetGroup(request *restful.Request, response *restful.Response) {
+	group, err := h.getGroup(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	writeResponse(response, http.StatusOK, toGroup(request, group))
+}
+
+// CreateGroup provisions a local group with the members.
+func (h *Handler) CreateGroup(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	tenantID, err := tenantID(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	scimGroup := &scimapi.Group{}
+	if err := readEntity(request, scimGroup); err != nil {
+		writeError(response, err)
+		return
+	}
+	if scimGroup.DisplayName == "" {
+		writeError(response, scimapi.NewBadRequest(scimapi.ErrInvalidValue, "displayName is required"))
+		return
+	}
+
+	existing, err := clientFrom(ctx).LocalGroups().List(ctx, listOptions(tenantID, fields.OneTermEqualSelector("spec.displayName", scimGroup.DisplayName)))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if len(existing.Items) > 0 {
+		writeError(response, scimapi.NewError(http.StatusConflict, scimapi.ErrUniqueness, "group %q already exists", scimGroup.DisplayName))
+		return
+	}
+
+	users, err := h.members(ctx, tenantID, scimGroup.Members)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	group := &auth.LocalGroup{
+		Spec: auth.LocalGroupSpec{
+			DisplayName: scimGroup.DisplayName,
+			TenantID:    tenantID,
+			Extra:       setExternalID(nil, scimGroup.ExternalID),
+		},
+		Status: auth.LocalGroupStatus{
+			Users: users,
+		},
+	}
+	group, err = clientFrom(ctx).LocalGroups().Create(ctx, group, metav1.CreateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	result := toGroup(request, group)
+	response.Header().Set("Location", result.Meta.Location)
+	writeResponse(response, http.StatusCreated, result)
+}
+
+// ReplaceGroup replaces the attributes and the members of the group.
+func (h *Handler) ReplaceGroup(request *restful.Request, response *restful.Response) {
+	group, err := h.getGroup(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	scimGroup := &scimapi.Group{}
+	if err := readEntity(request, scimGroup); err != nil {
+		writeError(response, err)
+		return
+	}
+	h.updateGroup(request, response, group, scimGroup)
+}
+
+// PatchGroup modifies the attributes and the members of the group by the
+// patch operations.
+func (h *Handler) PatchGroup(request *restful.Request, response *restful.Response) {
+	group, err := h.getGroup(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	scimGroup := &scimapi.Group{}
+	if err := patch(request, toGroup(request, group), scimGroup); err != nil {
+		writeError(response, err)
+		return
+	}
+	h.updateGroup(request, response, group, scimGroup)
+}
+
+// DeleteGroup deletes the group.
+func (h *Handler) DeleteGroup(request *restful.Request, response *restful.Response) {
+	group, err := h.getGroup(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if err := clientFrom(request.Request.Context()).LocalGroups().Delete(request.Request.Context(), group.Name, metav1.DeleteOptions{}); err != nil {
+		writeError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) updateGroup(request *restful.Request, response *restful.Response, group *auth.LocalGroup, scimGroup *scimapi.Group) {
+	ctx := request.Request.Context()
+	if scimGroup.DisplayName == "" {
+		writeError(response, scimapi.NewBadRequest(scimapi.ErrInvalidValue, "displayName is required"))
+		return
+	}
+	users, err := h.members(ctx, group.Spec.TenantID, scimGroup.Members)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	group.Spec.DisplayName = scimGroup.DisplayName
+	group.Spec.Extra = setExternalID(group.Spec.Extra, scimGroup.ExternalID)
+	group, err = clientFrom(ctx).LocalGroups().Update(ctx, group, metav1.UpdateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	// Members are kept by the update of the group, they are changed by the
+	// status.
+	if !reflect.DeepEqual(group.Status.Users, users) {
+		group.Status.Users = users
+		group, err = clientFrom(ctx).LocalGroups().UpdateStatus(ctx, group, metav1.UpdateOptions{})
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+	}
+	writeResponse(response, http.StatusOK, toGroup(request, group))
+}
+
+// members returns the subjects of the group members, which must be the users
+// of the tenant.
+func (h *Handler) members(ctx context.Context, tenantID string, members []scimapi.MultiValue) ([]auth.Subject, error) {
+	var users []auth.Subject
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if member.Type != "" && !strings.EqualFold(member.Type, "User") {
+			return nil, scimapi.NewBadRequest(scimapi.ErrInvalidValue, "member %q of type %q is not supported", member.Value, member.Type)
+		}
+		if seen[member.Value] {
+			continue
+		}
+		seen[member.Value] = true
+
+		identity, err := clientFrom(ctx).LocalIdentities().Get(ctx, member.Value, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err != nil || identity.Spec.TenantID != tenantID {
+			return nil, scimapi.NewBadRequest(scimapi.ErrInvalidValue, "member %q is not found", member.Value)
+		}
+		users = append(users, auth.Subject{ID: identity.Name, Name: identity.Spec.Username})
+	}
+	return users, nil
+}
+
+// getGroup returns the local group in the request path, groups of other
+// tenants are treated as not found.
+func (h *Handler) getGroup(request *restful.Request) (*auth.LocalGroup, error) {
+	tenantID, err := tenantID(request)
+	if err != nil {
+		return nil, err
+	}
+	id := request.PathParameter("id")
+	group, err := clientFrom(request.Request.Context()).LocalGroups().Get(request.Request.Context(), id, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if group.Spec.TenantID != tenantID || group.Status.Phase == auth.GroupTerminating {
+		return nil, notFound("Group", id)
+	}
+	return group, nil
+}
+
+// toGroup converts the local group to the scim group.
+func toGroup(request *restful.Request, group *auth.LocalGroup) *scimapi.Group {
+	scimGroup := &scimapi.Group{
+		Schemas:     []string{scimapi.GroupSchema},
+		ID:          group.Name,
+		ExternalID:  group.Spec.Extra[externalIDKey],
+		DisplayName: group.Spec.DisplayName,
+		Meta:        newMeta("Group", location(request, "Groups/"+group.Name), group.ObjectMeta, metav1.Time{}),
+	}
+	for _, user := range group.Status.Users {
+		scimGroup.Members = append(scimGroup.Members, scimapi.MultiValue{
+			Value:   user.ID,
+			Display: user.Name,
+			Type:    "User",
+			Ref:     location(request, "Users/"+user.ID),
+		})
+	}
+	return scimGroup
+}
@@ -0,0 +1,352 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/emicklei/go-restful"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	scimapi "tkestack.io/tke/pkg/auth/scim"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// BasePath is the base path of the scim endpoint.
+	BasePath = "/scim/v2"
+
+	// externalIDKey is the key in the extra of local identities and groups
+	// holding the id of the resource in the provisioning client.
+	externalIDKey = "scimExternalID"
+)
+
+// Handler handles the scim 2.0 provisioning requests of users and groups,
+// which are backed by the local identities and local groups of the tenant.
+type Handler struct {
+	loopbackClientConfig *restclient.Config
+	authClient           authinternalclient.AuthInterface
+	enforcer             *casbin.SyncedEnforcer
+}
+
+// NewHandler creates new scim handler object.
+func NewHandler(loopbackClientConfig *restclient.Config, authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer) *Handler {
+	return &Handler{
+		loopbackClientConfig: loopbackClientConfig,
+		authClient:           authClient,
+		enforcer:             enforcer,
+	}
+}
+
+type clientKey struct{}
+
+// Authorize only allows the administrators of the tenant of the request user
+// to access the scim endpoint, so tenants provision their own users, and the
+// handlers only see the resources of that tenant. A client acting as the
+// request user is passed to the handlers, so that the provisioned resources
+// are changed with the caller's identity.
+func (h *Handler) Authorize(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+	ctx := request.Request.Context()
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	if !ok || username == "" || tenantID == "" {
+		writeError(response, scimapi.NewError(http.StatusForbidden, "", "scim requests must be made by a user of a tenant"))
+		return
+	}
+
+	isTenantAdmin, err := util.IsPlatformAdmin(ctx, username, tenantID, h.authClient, h.enforcer)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if !isTenantAdmin {
+		writeError(response, scimapi.NewError(http.StatusForbidden, "", "user %q is not an administrator of tenant %q", username, tenantID))
+		return
+	}
+
+	client, err := h.clientFor(userInfo)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	request.Request = request.Request.WithContext(context.WithValue(ctx, clientKey{}, client))
+	chain.ProcessFilter(request, response)
+}
+
+// clientFor returns the client impersonating the given user.
+func (h *Handler) clientFor(userInfo user.Info) (authinternalclient.AuthInterface, error) {
+	config := restclient.CopyConfig(h.loopbackClientConfig)
+	config.Impersonate = restclient.ImpersonationConfig{
+		UserName: userInfo.GetName(),
+		Groups:   userInfo.GetGroups(),
+		Extra:    userInfo.GetExtra(),
+	}
+	return authinternalclient.NewForConfig(config)
+}
+
+// clientFrom returns the client of the request user set by Authorize.
+func clientFrom(ctx context.Context) authinternalclient.AuthInterface {
+	return ctx.Value(clientKey{}).(authinternalclient.AuthInterface)
+}
+
+// ServiceProviderConfig returns the features supported by the scim endpoint.
+func (h *Handler) ServiceProviderConfig(request *restful.Request, response *restful.Response) {
+	supported := func(v bool) map[string]interface{} {
+		return map[string]interface{}{"supported": v}
+	}
+	writeResponse(response, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{scimapi.ServiceProviderConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": scimapi.MaxCount},
+		"changePassword": supported(true),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []map[string]interface{}{
+			{
+				"type":        "oauthbearertoken",
+				"name":        "OAuth Bearer Token",
+				"description": "Authentication with the api key of a tenant administrator.",
+			},
+		},
+	})
+}
+
+// ResourceTypes returns the resource types supported by the scim endpoint.
+func (h *Handler) ResourceTypes(request *restful.Request, response *restful.Response) {
+	resourceType := func(name, endpoint, schema string) interface{} {
+		return map[string]interface{}{
+			"schemas":  []string{scimapi.ResourceTypeSchema},
+			"id":       name,
+			"name":     name,
+			"endpoint": endpoint,
+			"schema":   schema,
+			"meta": map[string]interface{}{
+				"resourceType": "ResourceType",
+				"location":     fmt.Sprintf("%s/ResourceTypes/%s", BasePath, name),
+			},
+		}
+	}
+	resources := []interface{}{
+		resourceType("User", "/Users", scimapi.UserSchema),
+		resourceType("Group", "/Groups", scimapi.GroupSchema),
+	}
+	writeResponse(response, http.StatusOK, scimapi.NewListResponse(resources, 1, len(resources)))
+}
+
+// tenantID returns the tenant of the request user.
+func tenantID(request *restful.Request) (string, error) {
+	_, tenantID := authentication.UsernameAndTenantID(request.Request.Context())
+	if tenantID == "" {
+		return "", scimapi.NewBadRequest("", "unable to determine the tenant of the request")
+	}
+	return tenantID, nil
+}
+
+// listOptions returns the list options selecting the resources of the tenant.
+func listOptions(tenantID string, selectors ...fields.Selector) metav1.ListOptions {
+	selector := fields.OneTermEqualSelector("spec.tenantID", tenantID)
+	for _, s := range selectors {
+		selector = fields.AndSelectors(selector, s)
+	}
+	return metav1.ListOptions{FieldSelector: selector.String()}
+}
+
+// queryParams returns the filter and the pagination of list requests.
+func queryParams(request *restful.Request) (scimapi.Expr, int, int, error) {
+	var expr scimapi.Expr
+	if f := request.QueryParameter("filter"); f != "" {
+		parsed, err := scimapi.ParseFilter(f)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		expr = parsed
+	}
+
+	startIndex, err := intParam(request, "startIndex", 1)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	count, err := intParam(request, "count", scimapi.DefaultCount)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > scimapi.MaxCount {
+		count = scimapi.MaxCount
+	}
+	return expr, startIndex, count, nil
+}
+
+func intParam(request *restful.Request, name string, defaultValue int) (int, error) {
+	v := request.QueryParameter(name)
+	if v == "" {
+		return defaultValue, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, scimapi.NewBadRequest(scimapi.ErrInvalidValue, "invalid %s %q", name, v)
+	}
+	return i, nil
+}
+
+// matches returns whether the resource matches the filter.
+func matches(expr scimapi.Expr, resource interface{}) (bool, error) {
+	if expr == nil {
+		return true, nil
+	}
+	m, err := scimapi.ToMap(resource)
+	if err != nil {
+		return false, err
+	}
+	return expr.Match(m), nil
+}
+
+// patch applies the patch request to the current resource and decodes the
+// result into patched.
+func patch(request *restful.Request, current interface{}, patched interface{}) error {
+	patchRequest := &scimapi.PatchRequest{}
+	if err := readEntity(request, patchRequest); err != nil {
+		return err
+	}
+	m, err := scimapi.ToMap(current)
+	if err != nil {
+		return err
+	}
+	if err := scimapi.ApplyPatch(m, patchRequest.Operations); err != nil {
+		return err
+	}
+	return scimapi.FromMap(m, patched)
+}
+
+func readEntity(request *restful.Request, obj interface{}) error {
+	if err := json.NewDecoder(request.Request.Body).Decode(obj); err != nil {
+		return scimapi.NewBadRequest(scimapi.ErrInvalidSyntax, "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func writeResponse(response *restful.Response, code int, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", scimapi.MediaType)
+	response.WriteHeader(code)
+	if _, err := response.Write(data); err != nil {
+		log.Error("Failed to write scim response", log.Err(err))
+	}
+}
+
+// writeError writes the error in the scim error format, api errors of the
+// storage are converted to the scim errors of the same meaning.
+func writeError(response *restful.Response, err error) {
+	scimErr, ok := err.(*scimapi.Error)
+	if !ok {
+		scimErr = toSCIMError(err)
+	}
+	if scimErr.Code() >= http.StatusInternalServerError {
+		log.Error("Failed to handle scim request", log.Err(err))
+	}
+	data, _ := json.Marshal(scimErr)
+	response.Header().Set("Content-Type", scimapi.MediaType)
+	response.WriteHeader(scimErr.Code())
+	_, _ = response.Write(data)
+}
+
+func toSCIMError(err error) *scimapi.Error {
+	switch {
+	case apierrors.IsNotFound(err):
+		return scimapi.NewError(http.StatusNotFound, "", "%v", err)
+	case apierrors.IsAlreadyExists(err):
+		return scimapi.NewError(http.StatusConflict, scimapi.ErrUniqueness, "%v", err)
+	case apierrors.IsConflict(err):
+		return scimapi.NewError(http.StatusPreconditionFailed, "", "%v", err)
+	case apierrors.IsInvalid(err):
+		return scimapi.NewBadRequest(scimapi.ErrInvalidValue, "%v", err)
+	case apierrors.IsBadRequest(err):
+		return scimapi.NewBadRequest("", "%v", err)
+	case apierrors.IsForbidden(err):
+		return scimapi.NewError(http.StatusForbidden, "", "%v", err)
+	}
+	return scimapi.NewError(http.StatusInternalServerError, "", "%v", err)
+}
+
+func notFound(kind, id string) *scimapi.Error {
+	return scimapi.NewError(http.StatusNotFound, "", "%s %q not found", kind, id)
+}
+
+func newMeta(resourceType, location string, objectMeta metav1.ObjectMeta, lastModified metav1.Time) *scimapi.Meta {
+	if lastModified.IsZero() {
+		lastModified = objectMeta.CreationTimestamp
+	}
+	return &scimapi.Meta{
+		ResourceType: resourceType,
+		Created:      objectMeta.CreationTimestamp.UTC().Format(time.RFC3339),
+		LastModified: lastModified.UTC().Format(time.RFC3339),
+		Location:     location,
+		Version:      fmt.Sprintf("W/%q", objectMeta.ResourceVersion),
+	}
+}
+
+func setExternalID(extra map[string]string, externalID string) map[string]string {
+	if externalID == "" {
+		delete(extra, externalIDKey)
+		return extra
+	}
+	if extra == nil {
+		extra = make(map[string]string)
+	}
+	extra[externalIDKey] = externalID
+	return extra
+}
+
+// listGroups returns the local groups of the tenant.
+func (h *Handler) listGroups(ctx context.Context, tenantID string) ([]auth.LocalGroup, error) {
+	groupList, err := clientFrom(ctx).LocalGroups().List(ctx, listOptions(tenantID))
+	if err != nil {
+		return nil, err
+	}
+	return groupList.Items, nil
+}
+
+func location(request *restful.Request, path string) string {
+	scheme := "https"
+	if request.Request.TLS == nil {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s/%s", scheme, request.Request.Host, BasePath, strings.TrimPrefix(path, "/"))
+}
@@ -0,0 +1,375 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
+	scimapi "tkestack.io/tke/pkg/auth/scim"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/validation"
+)
+
+// ListUsers lists the users of the tenant matching the filter.
+func (h *Handler) ListUsers(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	tenantID, err := tenantID(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	expr, startIndex, count, err := queryParams(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	var selectors []fields.Selector
+	if username, ok := scimapi.Equality(expr, "userName"); ok {
+		selectors = append(selectors, fields.OneTermEqualSelector("spec.username", strings.ToLower(username)))
+	}
+	identityList, err := clientFrom(ctx).LocalIdentities().List(ctx, listOptions(tenantID, selectors...))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	groups, err := h.listGroups(ctx, tenantID)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	resources := []interface{}{}
+	for i := range identityList.Items {
+		user := toUser(request, &identityList.Items[i], groups)
+		matched, err := matches(expr, user)
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+		if matched {
+			resources = append(resources, user)
+		}
+	}
+	writeResponse(response, http.StatusOK, scimapi.NewListResponse(resources, startIndex, count))
+}
+
+// GetUser returns the user of the tenant.
+func (h *Handler) GetUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	identity, err := h.getIdentity(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	groups, err := h.listGroups(ctx, identity.Spec.TenantID)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	writeResponse(response, http.StatusOK, toUser(request, identity, groups))
+}
+
+// CreateUser provisions a local identity for the user.
+func (h *Handler) CreateUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	tenantID, err := tenantID(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := &scimapi.User{}
+	if err := readEntity(request, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	if err := validateUserName(user.UserName); err != nil {
+		writeError(response, err)
+		return
+	}
+
+	existing, err := clientFrom(ctx).LocalIdentities().List(ctx, listOptions(tenantID, fields.OneTermEqualSelector("spec.username", user.UserName)))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if len(existing.Items) > 0 {
+		writeError(response, scimapi.NewError(http.StatusConflict, scimapi.ErrUniqueness, "user %q already exists", user.UserName))
+		return
+	}
+
+	if user.Password == "" {
+		// Provisioned users usually sign in with the identity provider of the
+		// client, so a random password which nobody knows is set.
+		policy, err := util.GetPasswordPolicy(ctx, h.authClient, tenantID)
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+		user.Password, err = passwordpolicy.Generate(policy)
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+	}
+	identity := &auth.LocalIdentity{
+		Spec: auth.LocalIdentitySpec{
+			Username: user.UserName,
+			TenantID: tenantID,
+		},
+	}
+	applyUser(identity, user)
+
+	identity, err = clientFrom(ctx).LocalIdentities().Create(ctx, identity, metav1.CreateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if !user.IsActive() {
+		if identity, err = h.setActive(ctx, identity, false); err != nil {
+			writeError(response, err)
+			return
+		}
+	}
+
+	result := toUser(request, identity, nil)
+	response.Header().Set("Location", result.Meta.Location)
+	writeResponse(response, http.StatusCreated, result)
+}
+
+// ReplaceUser replaces the attributes of the user.
+func (h *Handler) ReplaceUser(request *restful.Request, response *restful.Response) {
+	identity, err := h.getIdentity(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := &scimapi.User{}
+	if err := readEntity(request, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	h.updateUser(request, response, identity, user)
+}
+
+// PatchUser modifies the attributes of the user by the patch operations.
+func (h *Handler) PatchUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	identity, err := h.getIdentity(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	groups, err := h.listGroups(ctx, identity.Spec.TenantID)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := &scimapi.User{}
+	if err := patch(request, toUser(request, identity, groups), user); err != nil {
+		writeError(response, err)
+		return
+	}
+	h.updateUser(request, response, identity, user)
+}
+
+// DeleteUser deprovisions the user, whose api keys are revoked and local
+// identity is locked before deleted.
+func (h *Handler) DeleteUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	identity, err := h.getIdentity(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if identity, err = h.setActive(ctx, identity, false); err != nil {
+		writeError(response, err)
+		return
+	}
+	if err := clientFrom(ctx).LocalIdentities().Delete(ctx, identity.Name, metav1.DeleteOptions{}); err != nil {
+		writeError(response, err)
+		return
+	}
+	response.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) updateUser(request *restful.Request, response *restful.Response, identity *auth.LocalIdentity, user *scimapi.User) {
+	ctx := request.Request.Context()
+	if user.UserName != "" && user.UserName != identity.Spec.Username {
+		writeError(response, scimapi.NewBadRequest(scimapi.ErrMutability, "userName can not be changed"))
+		return
+	}
+
+	// The stored password is hashed, it is only sent if a new one is specified.
+	identity.Spec.HashedPassword = ""
+	applyUser(identity, user)
+	identity, err := clientFrom(ctx).LocalIdentities().Update(ctx, identity, metav1.UpdateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if identity, err = h.setActive(ctx, identity, user.IsActive()); err != nil {
+		writeError(response, err)
+		return
+	}
+
+	groups, err := h.listGroups(ctx, identity.Spec.TenantID)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	writeResponse(response, http.StatusOK, toUser(request, identity, groups))
+}
+
+// setActive locks or unlocks the local identity, the api keys of the user are
+// revoked when it's deactivated.
+func (h *Handler) setActive(ctx context.Context, identity *auth.LocalIdentity, active bool) (*auth.LocalIdentity, error) {
+	if !active {
+		if err := h.revokeAPIKeys(ctx, identity); err != nil {
+			return nil, err
+		}
+	}
+	if identity.Status.Locked == !active {
+		return identity, nil
+	}
+
+	identity.Status.Locked = !active
+	updated, err := clientFrom(ctx).LocalIdentities().UpdateStatus(ctx, identity, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Local identity is provisioned by scim", log.String("tenantID", identity.Spec.TenantID),
+		log.String("username", identity.Spec.Username), log.Bool("locked", updated.Status.Locked))
+	return updated, nil
+}
+
+// revokeAPIKeys disables the api keys of the user.
+func (h *Handler) revokeAPIKeys(ctx context.Context, identity *auth.LocalIdentity) error {
+	apiKeyList, err := clientFrom(ctx).APIKeys().List(ctx, listOptions(identity.Spec.TenantID, fields.OneTermEqualSelector("spec.username", identity.Spec.Username)))
+	if err != nil {
+		return err
+	}
+	for i := range apiKeyList.Items {
+		apiKey := &apiKeyList.Items[i]
+		if apiKey.Status.Disabled {
+			continue
+		}
+		apiKey.Status.Disabled = true
+		if _, err := clientFrom(ctx).APIKeys().UpdateStatus(ctx, apiKey, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		log.Info("API key of the deprovisioned user is revoked", log.String("tenantID", identity.Spec.TenantID),
+			log.String("username", identity.Spec.Username), log.String("apiKey", apiKey.Name))
+	}
+	return nil
+}
+
+// getIdentity returns the local identity of the user in the request path,
+// identities of other tenants are treated as not found.
+func (h *Handler) getIdentity(request *restful.Request) (*auth.LocalIdentity, error) {
+	tenantID, err := tenantID(request)
+	if err != nil {
+		return nil, err
+	}
+	id := request.PathParameter("id")
+	identity, err := clientFrom(request.Request.Context()).LocalIdentities().Get(request.Request.Context(), id, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if identity.Spec.TenantID != tenantID || identity.Status.Phase == auth.LocalIdentityDeleting {
+		return nil, notFound("User", id)
+	}
+	return identity, nil
+}
+
+func validateUserName(userName string) error {
+	if userName == "" {
+		return scimapi.NewBadRequest(scimapi.ErrInvalidValue, "userName is required")
+	}
+	if err := validation.IsDNS1123Name(userName); err != nil {
+		return scimapi.NewBadRequest(scimapi.ErrInvalidValue, "invalid userName %q: %v", userName, err)
+	}
+	return nil
+}
+
+// applyUser sets the attributes of the user to the local identity.
+func applyUser(identity *auth.LocalIdentity, user *scimapi.User) {
+	identity.Spec.DisplayName = displayName(user)
+	identity.Spec.Email = scimapi.PrimaryValue(user.Emails)
+	identity.Spec.PhoneNumber = scimapi.PrimaryValue(user.PhoneNumbers)
+	identity.Spec.Extra = setExternalID(identity.Spec.Extra, user.ExternalID)
+	if user.Password != "" {
+		identity.Spec.HashedPassword = base64.StdEncoding.EncodeToString([]byte(user.Password))
+	}
+}
+
+func displayName(user *scimapi.User) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if user.Name != nil {
+		if user.Name.Formatted != "" {
+			return user.Name.Formatted
+		}
+		if name := strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName); name != "" {
+			return name
+		}
+	}
+	return user.UserName
+}
+
+// toUser converts the local identity to the scim user.
+func toUser(request *restful.Request, identity *auth.LocalIdentity, groups []auth.LocalGroup) *scimapi.User {
+	user := &scimapi.User{
+		Schemas:     []string{scimapi.UserSchema},
+		ID:          identity.Name,
+		ExternalID:  identity.Spec.Extra[externalIDKey],
+		UserName:    identity.Spec.Username,
+		DisplayName: identity.Spec.DisplayName,
+		Active:      scimapi.NewBool(!identity.Status.Locked),
+		Meta:        newMeta("User", location(request, "Users/"+identity.Name), identity.ObjectMeta, identity.Status.LastUpdateTime),
+	}
+	if identity.Spec.Email != "" {
+		user.Emails = []scimapi.MultiValue{{Value: identity.Spec.Email, Type: "work", Primary: true}}
+	}
+	if identity.Spec.PhoneNumber != "" {
+		user.PhoneNumbers = []scimapi.MultiValue{{Value: identity.Spec.PhoneNumber, Type: "work", Primary: true}}
+	}
+	for _, group := range groups {
+		for _, subject := range group.Status.Users {
+			if subject.ID == identity.Name {
+				user.Groups = append(user.Groups, scimapi.MultiValue{
+					Value:   group.Name,
+					Display: group.Spec.DisplayName,
+					Ref:     location(request, "Groups/"+group.Name),
+				})
+				break
+			}
+		}
+	}
+	return user
+}
@@ -0,0 +1,242 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/auth/registry/accessrequest"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for access requests and all sub resources.
+type Storage struct {
+	AccessRequest *REST
+	Status        *StatusREST
+	Approval      *ApprovalREST
+}
+
+// NewStorage returns a Storage object that will work against access requests.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, authClient authinternalclient.AuthInterface,
+	enforcer *casbin.SyncedEnforcer, privilegedUsername string) *Storage {
+	strategy := accessrequest.NewStrategy(authClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &auth.AccessRequest{} },
+		NewListFunc:              func() runtime.Object { return &auth.AccessRequestList{} },
+		DefaultQualifiedResource: auth.Resource("accessrequests"),
+		PredicateFunc:            accessrequest.MatchAccessRequest,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		ShouldDeleteDuringUpdate: registry.ShouldDeleteDuringUpdate,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    accessrequest.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create access request etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = accessrequest.NewStatusStrategy(strategy)
+
+	approvalStore := *store
+	approvalStore.UpdateStrategy = accessrequest.NewApprovalStrategy(strategy)
+
+	return &Storage{
+		AccessRequest: &REST{store},
+		Status:        &StatusREST{&statusStore},
+		Approval: &ApprovalREST{
+			store:              &approvalStore,
+			authClient:         authClient,
+			enforcer:           enforcer,
+			privilegedUsername: privilegedUsername,
+		},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return AccessRequest
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*auth.AccessRequest)
+	if err := util.FilterAccessRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for access requests against etcd.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"ar"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete marks the access request for deletion. The request is removed once
+// the controller has removed its grant.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of an access request.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// ApprovalREST implements the REST endpoint for approving, rejecting or
+// revoking an access request.
+type ApprovalREST struct {
+	store              *registry.Store
+	authClient         authinternalclient.AuthInterface
+	enforcer           *casbin.SyncedEnforcer
+	privilegedUsername string
+}
+
+// ApprovalREST implements Patcher
+var _ = rest.Patcher(&ApprovalREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *ApprovalREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ApprovalREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update approves, rejects or revokes the access request. Only the platform
+// administrators of the tenant may decide on a request, and the requester
+// may revoke own grant before it expires.
+func (r *ApprovalREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	oldRequest := obj.(*auth.AccessRequest)
+
+	newObj, err := objInfo.UpdatedObject(ctx, oldRequest)
+	if err != nil {
+		return nil, false, err
+	}
+	request := newObj.(*auth.AccessRequest)
+
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	selfRevoke := request.Status.Phase == auth.AccessRequestRevoked && username == oldRequest.Spec.Username
+	if username != r.privilegedUsername && !selfRevoke {
+		isAdmin, err := util.IsPlatformAdmin(ctx, username, oldRequest.Spec.TenantID, r.authClient, r.enforcer)
+		if err != nil {
+			return nil, false, err
+		}
+		if !isAdmin {
+			return nil, false, errors.NewForbidden(auth.Resource("accessrequests"), name,
+				fmt.Errorf("user %s is not an approver of the access requests of tenant %s", username, oldRequest.Spec.TenantID))
+		}
+	}
+
+	log.Info("Access request decided",
+		log.String("accessRequest", name),
+		log.String("username", oldRequest.Spec.Username),
+		log.String("phase", string(request.Status.Phase)),
+		log.String("reviewer", username))
+	return r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(request), createValidation, updateValidation, false, options)
+}
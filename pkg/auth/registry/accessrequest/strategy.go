@@ -0,0 +1,274 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for access request.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	authClient authinternalclient.AuthInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating access request objects.
+func NewStrategy(authClient authinternalclient.AuthInterface) *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator, authClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object. The spec of an access request can not be changed, and its status
+// is changed only through the approval and status subresources.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldRequest := old.(*auth.AccessRequest)
+	newRequest, _ := obj.(*auth.AccessRequest)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldRequest.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update access request information",
+				log.String("oldTenantID", oldRequest.Spec.TenantID),
+				log.String("newTenantID", newRequest.Spec.TenantID),
+				log.String("userTenantID", tenantID))
+		}
+	}
+	newRequest.Spec = oldRequest.Spec
+	newRequest.Status = oldRequest.Status
+}
+
+// NamespaceScoped is false for access requests.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object. Users request access for themselves, only the requests filed
+// by the platform itself may name another user.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	request, _ := obj.(*auth.AccessRequest)
+	if len(tenantID) != 0 {
+		request.Spec.TenantID = tenantID
+		request.Spec.Username = username
+	} else if request.Spec.Username == "" {
+		request.Spec.Username = username
+	}
+
+	if request.ObjectMeta.Name == "" && request.ObjectMeta.GenerateName == "" {
+		request.ObjectMeta.GenerateName = "ar-"
+	}
+	request.ObjectMeta.Finalizers = []string{string(auth.AccessRequestFinalize)}
+
+	now := metav1.Now()
+	request.Status = auth.AccessRequestStatus{
+		Phase: auth.AccessRequestPending,
+		History: []auth.AccessRequestEvent{
+			{
+				Phase:   auth.AccessRequestPending,
+				Actor:   username,
+				Time:    now,
+				Message: request.Spec.Reason,
+			},
+		},
+	}
+}
+
+// AfterCreate implements a further operation to run after a resource is
+// created and before it is decorated, optional.
+func (s *Strategy) AfterCreate(obj runtime.Object) error {
+	return nil
+}
+
+// Validate validates a new access request.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateAccessRequestCreate(ctx, obj.(*auth.AccessRequest), s.authClient)
+}
+
+// AllowCreateOnUpdate is false for access requests.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end access request.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestUpdate(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	request, ok := obj.(*auth.AccessRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an access request")
+	}
+	return request.ObjectMeta.Labels, ToSelectableFields(request), nil
+}
+
+// MatchAccessRequest returns a generic matcher for a given label and field selector.
+func MatchAccessRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.username",
+			"spec.projectID",
+			"status.phase",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(request *auth.AccessRequest) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&request.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":  request.Spec.TenantID,
+		"spec.username":  request.Spec.Username,
+		"spec.projectID": request.Spec.ProjectID,
+		"status.phase":   string(request.Status.Phase),
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of access request.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object. The status subresource records the grant made by the
+// controller, the decision itself is made through the approval subresource.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRequest := obj.(*auth.AccessRequest)
+	oldRequest := old.(*auth.AccessRequest)
+	newRequest.Spec = oldRequest.Spec
+	newRequest.Status.Reviewer = oldRequest.Status.Reviewer
+	newRequest.Status.Comment = oldRequest.Status.Comment
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestStatusUpdate(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
+
+// ApprovalStrategy implements verification logic for approving, rejecting
+// or revoking access requests.
+type ApprovalStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &ApprovalStrategy{}
+
+// NewApprovalStrategy create the ApprovalStrategy object by given strategy.
+func NewApprovalStrategy(strategy *Strategy) *ApprovalStrategy {
+	return &ApprovalStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object. Only the phase and the comment of the decision are taken from
+// the request, the reviewer is the current user. A revocation keeps the
+// reviewer of the approval, and is recorded in the history only.
+func (ApprovalStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRequest := obj.(*auth.AccessRequest)
+	oldRequest := old.(*auth.AccessRequest)
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	phase := newRequest.Status.Phase
+	comment := newRequest.Status.Comment
+
+	newRequest.Spec = oldRequest.Spec
+	newRequest.Status = *oldRequest.Status.DeepCopy()
+	newRequest.Status.Phase = phase
+	if phase != auth.AccessRequestRevoked {
+		newRequest.Status.Reviewer = username
+		newRequest.Status.Comment = comment
+	}
+	newRequest.Status.History = append(newRequest.Status.History, auth.AccessRequestEvent{
+		Phase:   phase,
+		Actor:   username,
+		Time:    metav1.Now(),
+		Message: comment,
+	})
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *ApprovalStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestApproval(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
@@ -0,0 +1,203 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+)
+
+const (
+	// MaxHours is the longest grant an access request may ask for.
+	MaxHours = 7 * 24
+
+	// _maxReasonLength is the max length of the reason of an access request.
+	_maxReasonLength = 1024
+)
+
+// ValidateAccessRequestName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateAccessRequestName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateAccessRequestCreate tests if required fields in the access request
+// are set correctly, and if the requested policy can be granted on a project.
+func ValidateAccessRequestCreate(ctx context.Context, request *auth.AccessRequest, authClient authinternalclient.AuthInterface) field.ErrorList {
+	allErrs := ValidateAccessRequest(request)
+
+	if request.Spec.PolicyID != "" {
+		fldPolicyPath := field.NewPath("spec", "policyID")
+		policy, err := authClient.Policies().Get(ctx, request.Spec.PolicyID, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			allErrs = append(allErrs, field.NotFound(fldPolicyPath, request.Spec.PolicyID))
+		case err != nil:
+			allErrs = append(allErrs, field.InternalError(fldPolicyPath, err))
+		case policy.Spec.TenantID != request.Spec.TenantID:
+			allErrs = append(allErrs, field.NotFound(fldPolicyPath, request.Spec.PolicyID))
+		case policy.Spec.Scope != auth.PolicyProject:
+			allErrs = append(allErrs, field.Invalid(fldPolicyPath, request.Spec.PolicyID, "policy must be project scope"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAccessRequest tests if the spec of the access request is valid.
+func ValidateAccessRequest(request *auth.AccessRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&request.ObjectMeta, false, ValidateAccessRequestName, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if request.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if request.Spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("username"), "must specify username"))
+	}
+
+	switch {
+	case request.Spec.PolicyID == "" && request.Spec.ClusterRole == "":
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("policyID"), "must specify either policyID or clusterRole"))
+	case request.Spec.PolicyID != "" && request.Spec.ClusterRole != "":
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("clusterRole"), request.Spec.ClusterRole, "must not specify both policyID and clusterRole"))
+	case request.Spec.PolicyID != "":
+		if request.Spec.ProjectID == "" {
+			allErrs = append(allErrs, field.Required(fldSpecPath.Child("projectID"), "must specify projectID of the policy"))
+		}
+		if len(request.Spec.Clusters) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("clusters"), request.Spec.Clusters, "must not specify clusters of a policy"))
+		}
+	default:
+		fldRolePath := fldSpecPath.Child("clusterRole")
+		namespace, name, err := cache.SplitMetaNamespaceKey(request.Spec.ClusterRole)
+		if err != nil || namespace == "" || name == "" {
+			allErrs = append(allErrs, field.Invalid(fldRolePath, request.Spec.ClusterRole, "must be in the form of namespace/name"))
+		}
+		if len(request.Spec.Clusters) == 0 {
+			allErrs = append(allErrs, field.Required(fldSpecPath.Child("clusters"), "must specify clusters of the cluster role"))
+		}
+	}
+
+	if request.Spec.Hours <= 0 || request.Spec.Hours > MaxHours {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("hours"), request.Spec.Hours,
+			fmt.Sprintf("must be between 1 and %d", MaxHours)))
+	}
+
+	fldReasonPath := fldSpecPath.Child("reason")
+	if request.Spec.Reason == "" {
+		allErrs = append(allErrs, field.Required(fldReasonPath, "must specify reason"))
+	} else if len(request.Spec.Reason) > _maxReasonLength {
+		allErrs = append(allErrs, field.TooLong(fldReasonPath, request.Spec.Reason, _maxReasonLength))
+	}
+
+	if notification := request.Spec.Notification; notification != nil {
+		fldNotificationPath := fldSpecPath.Child("notification")
+		if notification.ChannelName == "" {
+			allErrs = append(allErrs, field.Required(fldNotificationPath.Child("channelName"), "must specify channel name"))
+		}
+		if notification.TemplateName == "" {
+			allErrs = append(allErrs, field.Required(fldNotificationPath.Child("templateName"), "must specify template name"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAccessRequestUpdate tests if required fields in the access request are set during
+// an update.
+func ValidateAccessRequestUpdate(request *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&request.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateAccessRequest(request)...)
+	allErrs = append(allErrs, validateHistory(request, old)...)
+	return allErrs
+}
+
+// ValidateAccessRequestStatusUpdate tests if the status of the access request
+// is changed by the controller as the grant is bound and removed.
+func ValidateAccessRequestStatusUpdate(request *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := ValidateAccessRequestUpdate(request, old)
+
+	if request.Status.Phase != old.Status.Phase {
+		allowed := (old.Status.Phase == auth.AccessRequestApproved && request.Status.Phase == auth.AccessRequestActive) ||
+			(old.Status.Phase == auth.AccessRequestActive && request.Status.Phase == auth.AccessRequestExpired)
+		if !allowed {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("status", "phase"),
+				fmt.Sprintf("access request can not change from %s to %s", old.Status.Phase, request.Status.Phase)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAccessRequestApproval tests if the access request can be approved,
+// rejected or revoked.
+func ValidateAccessRequestApproval(request *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := ValidateAccessRequestUpdate(request, old)
+
+	fldPhasePath := field.NewPath("status", "phase")
+	switch request.Status.Phase {
+	case auth.AccessRequestApproved, auth.AccessRequestRejected:
+		if old.Status.Phase != auth.AccessRequestPending {
+			allErrs = append(allErrs, field.Forbidden(fldPhasePath,
+				fmt.Sprintf("access request has already been %s", old.Status.Phase)))
+		}
+		if request.Status.Reviewer == request.Spec.Username {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("status", "reviewer"),
+				"requester can not review own access request"))
+		}
+	case auth.AccessRequestRevoked:
+		if old.Status.Phase != auth.AccessRequestApproved && old.Status.Phase != auth.AccessRequestActive {
+			allErrs = append(allErrs, field.Forbidden(fldPhasePath,
+				fmt.Sprintf("access request in phase %s can not be revoked", old.Status.Phase)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPhasePath, request.Status.Phase,
+			[]string{string(auth.AccessRequestApproved), string(auth.AccessRequestRejected), string(auth.AccessRequestRevoked)}))
+	}
+
+	return allErrs
+}
+
+// validateHistory tests if the events of the history are kept, only new
+// events may be appended and the events may be marked as notified.
+func validateHistory(request *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	var allErrs field.ErrorList
+
+	fldHistoryPath := field.NewPath("status", "history")
+	if len(request.Status.History) < len(old.Status.History) {
+		return append(allErrs, field.Forbidden(fldHistoryPath, "events of the history can not be removed"))
+	}
+	for i, event := range old.Status.History {
+		current := request.Status.History[i]
+		// Times are compared in seconds, the precision kept by JSON.
+		if current.Phase != event.Phase || current.Actor != event.Actor ||
+			current.Time.Unix() != event.Time.Unix() || current.Message != event.Message {
+			allErrs = append(allErrs, field.Forbidden(fldHistoryPath.Index(i), "events of the history can not be changed"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,235 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package accessrequest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func newAccessRequest() *auth.AccessRequest {
+	return &auth.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "ar-test",
+			ResourceVersion: "1",
+		},
+		Spec: auth.AccessRequestSpec{
+			TenantID:  "default",
+			Username:  "alice",
+			ProjectID: "prj-test",
+			PolicyID:  "pol-owner",
+			Hours:     4,
+			Reason:    "investigate the outage of the checkout service",
+		},
+		Status: auth.AccessRequestStatus{
+			Phase: auth.AccessRequestPending,
+			History: []auth.AccessRequestEvent{
+				{
+					Phase: auth.AccessRequestPending,
+					Actor: "alice",
+					Time:  metav1.NewTime(time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+	}
+}
+
+func TestValidateAccessRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(request *auth.AccessRequest)
+		wantErr string
+	}{
+		{
+			name:   "valid policy",
+			mutate: func(request *auth.AccessRequest) {},
+		},
+		{
+			name: "valid cluster role",
+			mutate: func(request *auth.AccessRequest) {
+				request.Spec.PolicyID = ""
+				request.Spec.ClusterRole = "default/cluster-admin"
+				request.Spec.Clusters = []string{"cls-test"}
+			},
+		},
+		{
+			name:    "nothing requested",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.PolicyID = "" },
+			wantErr: "spec.policyID",
+		},
+		{
+			name:    "policy and cluster role",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.ClusterRole = "default/cluster-admin" },
+			wantErr: "spec.clusterRole",
+		},
+		{
+			name:    "policy without project",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.ProjectID = "" },
+			wantErr: "spec.projectID",
+		},
+		{
+			name: "cluster role without namespace",
+			mutate: func(request *auth.AccessRequest) {
+				request.Spec.PolicyID = ""
+				request.Spec.ClusterRole = "cluster-admin"
+				request.Spec.Clusters = []string{"cls-test"}
+			},
+			wantErr: "spec.clusterRole",
+		},
+		{
+			name: "cluster role without clusters",
+			mutate: func(request *auth.AccessRequest) {
+				request.Spec.PolicyID = ""
+				request.Spec.ClusterRole = "default/cluster-admin"
+			},
+			wantErr: "spec.clusters",
+		},
+		{
+			name:    "no hours",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.Hours = 0 },
+			wantErr: "spec.hours",
+		},
+		{
+			name:    "too many hours",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.Hours = MaxHours + 1 },
+			wantErr: "spec.hours",
+		},
+		{
+			name:    "no reason",
+			mutate:  func(request *auth.AccessRequest) { request.Spec.Reason = "" },
+			wantErr: "spec.reason",
+		},
+		{
+			name: "notification without template",
+			mutate: func(request *auth.AccessRequest) {
+				request.Spec.Notification = &auth.AccessRequestNotification{ChannelName: "ch-test"}
+			},
+			wantErr: "spec.notification.templateName",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newAccessRequest()
+			tt.mutate(request)
+			errs := ValidateAccessRequest(request)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Fatalf("ValidateAccessRequest() unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || !strings.Contains(errs.ToAggregate().Error(), tt.wantErr) {
+				t.Fatalf("ValidateAccessRequest() errors = %v, want error on %s", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAccessRequestApproval(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldPhase auth.AccessRequestPhase
+		phase    auth.AccessRequestPhase
+		reviewer string
+		wantErr  bool
+	}{
+		{"approve", auth.AccessRequestPending, auth.AccessRequestApproved, "bob", false},
+		{"reject", auth.AccessRequestPending, auth.AccessRequestRejected, "bob", false},
+		{"revoke active", auth.AccessRequestActive, auth.AccessRequestRevoked, "bob", false},
+		{"revoke approved", auth.AccessRequestApproved, auth.AccessRequestRevoked, "bob", false},
+		{"revoke expired", auth.AccessRequestExpired, auth.AccessRequestRevoked, "bob", true},
+		{"already approved", auth.AccessRequestApproved, auth.AccessRequestRejected, "bob", true},
+		{"activate", auth.AccessRequestApproved, auth.AccessRequestActive, "bob", true},
+		{"own request", auth.AccessRequestPending, auth.AccessRequestApproved, "alice", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := newAccessRequest()
+			old.Status.Phase = tt.oldPhase
+			request := old.DeepCopy()
+			request.Status.Phase = tt.phase
+			request.Status.Reviewer = tt.reviewer
+			errs := ValidateAccessRequestApproval(request, old)
+			if (len(errs) != 0) != tt.wantErr {
+				t.Fatalf("ValidateAccessRequestApproval() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateAccessRequestStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldPhase auth.AccessRequestPhase
+		mutate   func(request *auth.AccessRequest)
+		wantErr  bool
+	}{
+		{
+			name:     "activate",
+			oldPhase: auth.AccessRequestApproved,
+			mutate: func(request *auth.AccessRequest) {
+				request.Status.Phase = auth.AccessRequestActive
+				request.Status.History = append(request.Status.History, auth.AccessRequestEvent{Phase: auth.AccessRequestActive})
+			},
+		},
+		{
+			name:     "expire",
+			oldPhase: auth.AccessRequestActive,
+			mutate:   func(request *auth.AccessRequest) { request.Status.Phase = auth.AccessRequestExpired },
+		},
+		{
+			name:     "mark notified",
+			oldPhase: auth.AccessRequestPending,
+			mutate:   func(request *auth.AccessRequest) { request.Status.History[0].Notified = true },
+		},
+		{
+			name:     "approve",
+			oldPhase: auth.AccessRequestPending,
+			mutate:   func(request *auth.AccessRequest) { request.Status.Phase = auth.AccessRequestApproved },
+			wantErr:  true,
+		},
+		{
+			name:     "remove history",
+			oldPhase: auth.AccessRequestPending,
+			mutate:   func(request *auth.AccessRequest) { request.Status.History = nil },
+			wantErr:  true,
+		},
+		{
+			name:     "change history",
+			oldPhase: auth.AccessRequestPending,
+			mutate:   func(request *auth.AccessRequest) { request.Status.History[0].Actor = "mallory" },
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := newAccessRequest()
+			old.Status.Phase = tt.oldPhase
+			request := old.DeepCopy()
+			tt.mutate(request)
+			errs := ValidateAccessRequestStatusUpdate(request, old)
+			if (len(errs) != 0) != tt.wantErr {
+				t.Fatalf("ValidateAccessRequestStatusUpdate() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
@@ -61,6 +61,8 @@ func (r *PasswordREST) Create(ctx context.Context, obj runtime.Object, createVal
 		return nil, apierrors.NewBadRequest(err.Error())
 	}
 	apiKey.Spec.Description = apikeyReq.Description
+	apiKey.Spec.Scope = apikeyReq.Scope
+	apiKey.Spec.SourceIPs = apikeyReq.SourceIPs
 
 	return r.apiKeyStore.Create(ctx, apiKey, createValidation, options)
 }
@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/registry/apikey"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// RotateREST implements the REST endpoint to rotate the api key.
+type RotateREST struct {
+	apiKeyStore *registry.Store
+	rotateStore *registry.Store
+	keySigner   util.KeySigner
+}
+
+var _ = rest.Creater(&RotateREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *RotateREST) New() runtime.Object {
+	return &auth.APIKeyRotateReq{}
+}
+
+// Create issues a successor of the api key with the same owner, scope and
+// source ips, and retires the api key once the overlap window ends. It returns
+// the successor.
+func (r *RotateREST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	userName, tenantID := authentication.UsernameAndTenantID(ctx)
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("unable to get request info from context")
+	}
+
+	rotateReq := obj.(*auth.APIKeyRotateReq)
+	if err := apikey.ValidateAPIKeyRotateReq(rotateReq); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	name := requestInfo.Name
+	apiKeyObj, err := ValidateGetObjectAndTenantID(ctx, r.apiKeyStore, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	apiKey := apiKeyObj.(*auth.APIKey)
+	if tenantID != "" && apiKey.Spec.Username != userName {
+		return nil, apierrors.NewForbidden(auth.Resource("apiKeys"), name, fmt.Errorf("forbid to rotate"))
+	}
+
+	now := time.Now()
+	switch {
+	case apiKey.Status.Successor != "":
+		return nil, apierrors.NewConflict(auth.Resource("apiKeys"), name, fmt.Errorf("api key has already been rotated to %s", apiKey.Status.Successor))
+	case apiKey.Status.Disabled:
+		return nil, apierrors.NewBadRequest("disabled api key can not be rotated")
+	case !apiKey.Spec.ExpireAt.After(now):
+		return nil, apierrors.NewBadRequest("expired api key can not be rotated")
+	}
+
+	successor, err := r.keySigner.Generate(ctx, apiKey.Spec.Username, apiKey.Spec.TenantID, rotateReq.Expire.Duration)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	successor.Spec.Description = apiKey.Spec.Description
+	if rotateReq.Description != "" {
+		successor.Spec.Description = rotateReq.Description
+	}
+	successor.Spec.Scope = apiKey.Spec.Scope.DeepCopy()
+	successor.Spec.SourceIPs = append([]string(nil), apiKey.Spec.SourceIPs...)
+	successor.Spec.Predecessor = name
+
+	successorObj, err := r.apiKeyStore.Create(ctx, successor, createValidation, options)
+	if err != nil {
+		return nil, err
+	}
+	successor = successorObj.(*auth.APIKey)
+
+	// The api key keeps working until the end of the overlap window, but not
+	// after it expires anyway.
+	retireAt := metav1.NewTime(now.Add(rotateReq.Overlap.Duration))
+	if retireAt.After(apiKey.Spec.ExpireAt.Time) {
+		retireAt = apiKey.Spec.ExpireAt
+	}
+	apiKey.Status.Successor = successor.Name
+	apiKey.Status.RetireAt = retireAt
+
+	// The update is rejected if the api key is rotated concurrently, in which
+	// case the successor is deleted again.
+	if _, _, err := r.rotateStore.Update(ctx, name, rest.DefaultUpdatedObjectInfo(apiKey), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); err != nil {
+		if _, _, deleteErr := r.apiKeyStore.Delete(ctx, successor.Name, rest.ValidateAllObjectFunc, &metav1.DeleteOptions{}); deleteErr != nil {
+			log.Error("Failed to delete successor of api key", log.String("apiKey", name), log.String("successor", successor.Name), log.Err(deleteErr))
+		}
+		return nil, err
+	}
+
+	log.Info("Rotate api key", log.String("apiKey", name), log.String("successor", successor.Name), log.String("operator", userName), log.Time("retireAt", retireAt.Time))
+	return successor, nil
+}
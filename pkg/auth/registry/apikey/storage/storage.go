@@ -46,6 +46,7 @@ type Storage struct {
 	Password *PasswordREST
 	Token    *TokenREST
 	Status   *StatusREST
+	Rotate   *RotateREST
 }
 
 // NewStorage returns a Storage object that will work against identify.
@@ -75,6 +76,9 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalcli
 	statusStore := *store
 	statusStore.UpdateStrategy = apikey.NewStatusStrategy(strategy)
 
+	rotateStore := *store
+	rotateStore.UpdateStrategy = apikey.NewRotateStrategy(strategy)
+
 	return &Storage{
 		APIKey: &REST{store, keySigner, privilegedUsername},
 		Password: &PasswordREST{
@@ -87,6 +91,11 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalcli
 			keySigner:   keySigner,
 		},
 		Status: &StatusREST{&statusStore},
+		Rotate: &RotateREST{
+			apiKeyStore: store,
+			rotateStore: &rotateStore,
+			keySigner:   keySigner,
+		},
 	}
 }
 
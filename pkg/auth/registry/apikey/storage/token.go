@@ -62,6 +62,8 @@ func (r *TokenREST) Create(ctx context.Context, obj runtime.Object, createValida
 		return nil, apierrors.NewBadRequest(err.Error())
 	}
 	apiKey.Spec.Description = apikeyReq.Description
+	apiKey.Spec.Scope = apikeyReq.Scope
+	apiKey.Spec.SourceIPs = apikeyReq.SourceIPs
 
 	return r.apiKeyStore.Create(ctx, apiKey, createValidation, options)
 }
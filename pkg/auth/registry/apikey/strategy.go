@@ -62,10 +62,19 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 // object.
 func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newAPIKey, _ := obj.(*auth.APIKey)
+	oldAPIKey := old.(*auth.APIKey)
 	_, tenantID := authentication.UsernameAndTenantID(ctx)
 	if len(tenantID) != 0 {
 		newAPIKey.Spec.TenantID = tenantID
 	}
+	keepRotation(newAPIKey, oldAPIKey)
+}
+
+// keepRotation keeps the rotation of the api key, which is only changed by
+// rotating it.
+func keepRotation(newAPIKey, oldAPIKey *auth.APIKey) {
+	newAPIKey.Status.Successor = oldAPIKey.Status.Successor
+	newAPIKey.Status.RetireAt = oldAPIKey.Status.RetireAt
 }
 
 // NamespaceScoped is false for projects.
@@ -204,6 +213,7 @@ func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Obj
 	oldAPIKey := old.(*auth.APIKey)
 	newAPIKey.Spec = oldAPIKey.Spec
 	newAPIKey.Status.Expired = oldAPIKey.Status.Expired
+	keepRotation(newAPIKey, oldAPIKey)
 }
 
 // ValidateUpdate is invoked after default fields in the object have been
@@ -212,3 +222,35 @@ func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Obj
 func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
 	return nil
 }
+
+// RotateStrategy implements the logic to record the rotation of an api key.
+type RotateStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &RotateStrategy{}
+
+// NewRotateStrategy create the RotateStrategy object by given strategy.
+func NewRotateStrategy(strategy *Strategy) *RotateStrategy {
+	return &RotateStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object. Only the successor and the retirement time of the status are
+// updated on rotation.
+func (RotateStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newAPIKey := obj.(*auth.APIKey)
+	oldAPIKey := old.(*auth.APIKey)
+	successor, retireAt := newAPIKey.Status.Successor, newAPIKey.Status.RetireAt
+	newAPIKey.Spec = oldAPIKey.Spec
+	newAPIKey.Status = oldAPIKey.Status
+	newAPIKey.Status.Successor = successor
+	newAPIKey.Status.RetireAt = retireAt
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *RotateStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAPIKeyRotation(obj.(*auth.APIKey), old.(*auth.APIKey))
+}
@@ -21,14 +21,18 @@ package apikey
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 
 	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -38,6 +42,9 @@ var (
 	maxExpire = 100 * 365 * 24 * time.Hour
 
 	defaultAPIKeyTimeout = metav1.Duration{Duration: 7 * 24 * time.Hour}
+
+	maxOverlap           = 30 * 24 * time.Hour
+	defaultRotateOverlap = metav1.Duration{Duration: 24 * time.Hour}
 )
 
 // ValidateAPIkey tests if required fields in the signing key are set.
@@ -71,6 +78,30 @@ func ValidateAPIkey(ctx context.Context, apiKey *auth.APIKey, keySigner util.Key
 		}
 	}
 
+	allErrs = append(allErrs, validateAPIKeyScope(apiKey.Spec.Scope, fldSpecPath.Child("scope"))...)
+	allErrs = append(allErrs, condition.ValidateSourceIPs(apiKey.Spec.SourceIPs, fldSpecPath.Child("sourceIPs"))...)
+
+	return allErrs
+}
+
+// validateAPIKeyScope tests if the patterns of the scope are well formed.
+func validateAPIKeyScope(scope *auth.APIKeyScope, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if scope == nil {
+		return allErrs
+	}
+
+	validatePatterns := func(patterns []string, fldPath *field.Path) {
+		for i, p := range patterns {
+			if strings.TrimSpace(p) != p || p == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), p, "must be a non-empty pattern without spaces"))
+			}
+		}
+	}
+	validatePatterns(scope.Actions, fldPath.Child("actions"))
+	validatePatterns(scope.Resources, fldPath.Child("resources"))
+	validatePatterns(scope.Projects, fldPath.Child("projects"))
+
 	return allErrs
 }
 
@@ -102,6 +133,42 @@ func ValidateAPIKeyUpdate(ctx context.Context, apiKey *auth.APIKey, oldAPIKey *a
 		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("username"), apiKey.Spec.ExpireAt, "disallowed change the username"))
 	}
 
+	if !apiequality.Semantic.DeepEqual(apiKey.Spec.Scope, oldAPIKey.Spec.Scope) {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("scope"), apiKey.Spec.Scope, "disallowed change the scope"))
+	}
+
+	if !apiequality.Semantic.DeepEqual(apiKey.Spec.SourceIPs, oldAPIKey.Spec.SourceIPs) {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("sourceIPs"), apiKey.Spec.SourceIPs, "disallowed change the sourceIPs"))
+	}
+
+	if apiKey.Spec.Predecessor != oldAPIKey.Spec.Predecessor {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("predecessor"), apiKey.Spec.Predecessor, "disallowed change the predecessor"))
+	}
+
+	return allErrs
+}
+
+// ValidateAPIKeyRotation tests if an api key is rotated only once, and only
+// while it is in use.
+func ValidateAPIKeyRotation(apiKey *auth.APIKey, oldAPIKey *auth.APIKey) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fldStatusPath := field.NewPath("status")
+	if oldAPIKey.Status.Successor != "" {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("successor"), fmt.Sprintf("api key has already been rotated to %s", oldAPIKey.Status.Successor)))
+	}
+	if oldAPIKey.Status.Disabled {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("disabled"), "disabled api key can not be rotated"))
+	}
+	if apiKey.Status.Successor == "" {
+		allErrs = append(allErrs, field.Required(fldStatusPath.Child("successor"), "must specify successor"))
+	}
+	if apiKey.Status.RetireAt.IsZero() {
+		allErrs = append(allErrs, field.Required(fldStatusPath.Child("retireAt"), "must specify retireAt"))
+	} else if apiKey.Status.RetireAt.After(oldAPIKey.Spec.ExpireAt.Time) {
+		allErrs = append(allErrs, field.Invalid(fldStatusPath.Child("retireAt"), apiKey.Status.RetireAt, "must not be after expire_at"))
+	}
+
 	return allErrs
 }
 
@@ -122,6 +189,31 @@ func ValidateAPIKeyReq(apiKeyReq *auth.APIKeyReq) error {
 		return err
 	}
 
+	fldPath := field.NewPath("")
+	allErrs := validateAPIKeyScope(apiKeyReq.Scope, fldPath.Child("scope"))
+	allErrs = append(allErrs, condition.ValidateSourceIPs(apiKeyReq.SourceIPs, fldPath.Child("sourceIPs"))...)
+
+	return allErrs.ToAggregate()
+}
+
+// ValidateAPIKeyRotateReq tests if the durations to rotate an api key are
+// valid, and defaults the ones not set.
+func ValidateAPIKeyRotateReq(rotateReq *auth.APIKeyRotateReq) error {
+	if rotateReq.Expire.Duration == 0 {
+		rotateReq.Expire = defaultAPIKeyTimeout
+	}
+	if rotateReq.Overlap.Duration == 0 {
+		rotateReq.Overlap = defaultRotateOverlap
+	}
+
+	if err := validateAPIKeyExpire(rotateReq.Expire); err != nil {
+		return err
+	}
+
+	if rotateReq.Overlap.Duration < minExpire || rotateReq.Overlap.Duration > maxOverlap {
+		return fmt.Errorf("overlap %v must not shorter than %v or longer than %v", rotateReq.Overlap, minExpire, maxOverlap)
+	}
+
 	return nil
 }
 
@@ -137,15 +229,29 @@ func ValidateAPIkeyPassword(ctx context.Context, apiKeyPass *auth.APIKeyReqPassw
 	if err := validateAPIKeyExpire(apiKeyPass.Expire); err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("expire"), apiKeyPass.Expire, err.Error()))
 	}
+	allErrs = append(allErrs, validateAPIKeyScope(apiKeyPass.Scope, fldPath.Child("scope"))...)
+	allErrs = append(allErrs, condition.ValidateSourceIPs(apiKeyPass.SourceIPs, fldPath.Child("sourceIPs"))...)
 
 	localIdentity, err := util.GetLocalIdentity(ctx, authClient, apiKeyPass.TenantID, apiKeyPass.Username)
 	if err != nil {
 		log.Error("Get localidentity failed", log.String("localIdentity", apiKeyPass.Username), log.Err(err))
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("username"), apiKeyPass.Username, err.Error()))
+	} else if localIdentity.Status.Locked || passwordpolicy.LockedOut(&localIdentity.Status, time.Now()) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("username"), "user is locked"))
+	} else if policy, err := util.GetPasswordPolicy(ctx, authClient, localIdentity.Spec.TenantID); err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath.Child("username"), err))
 	} else {
 		if err := util.VerifyDecodedPassword(apiKeyPass.Password, localIdentity.Spec.HashedPassword); err != nil {
 			log.Error("Invalid password", log.ByteString("input password", []byte(apiKeyPass.Password)), log.String("store password", localIdentity.Spec.HashedPassword), log.Err(err))
+			util.RecordLoginFailure(ctx, authClient, &localIdentity, policy)
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("password"), apiKeyPass.Password, err.Error()))
+		} else if _, required, err := util.MultiFactorRequired(ctx, authClient, &localIdentity); err != nil || required {
+			// The password alone is not enough for the users who must log in with a second factor.
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("password"), "multi-factor authentication is required, create the api key after logging in"))
+		} else if passwordpolicy.Expired(policy, &localIdentity, time.Now()) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("password"), "password has expired, change it after logging in"))
+		} else {
+			util.RecordLoginSuccess(ctx, authClient, &localIdentity)
 		}
 	}
 
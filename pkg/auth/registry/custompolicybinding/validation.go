@@ -44,13 +44,17 @@ func ValidateProjectPolicyBinding(ctx context.Context, binding *auth.CustomPolic
 	if binding.Spec.PolicyID == "" {
 		allErrs = append(allErrs, field.Required(fldSpecPath.Child("policyID"), "must specify policyID"))
 	} else {
-		_, err := authClient.Policies().Get(ctx, binding.Spec.PolicyID, metav1.GetOptions{})
+		policy, err := authClient.Policies().Get(ctx, binding.Spec.PolicyID, metav1.GetOptions{})
 		if err != nil {
 			if apierrors.IsNotFound(err) {
 				allErrs = append(allErrs, field.NotFound(fldSpecPath.Child("policyID"), binding.Spec.PolicyID))
 			} else {
 				allErrs = append(allErrs, field.InternalError(fldSpecPath.Child("policyID"), err))
 			}
+		} else if len(policy.Spec.Conditions) != 0 {
+			// The rules of custom policy bindings are copies of the policy,
+			// which the conditions of the policy do not apply to.
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("policyID"), binding.Spec.PolicyID, "policy with conditions can not be bound by custom policy bindings"))
 		}
 	}
 
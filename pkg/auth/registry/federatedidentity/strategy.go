@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package federatedidentity
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for federated identity.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating federated identity objects.
+func NewStrategy() *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	identity := obj.(*auth.FederatedIdentity)
+	oldIdentity := old.(*auth.FederatedIdentity)
+	if tenantID != "" && identity.Spec.TenantID != tenantID {
+		identity.Spec.TenantID = oldIdentity.Spec.TenantID
+	}
+}
+
+// NamespaceScoped is false for federated identity.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	identity, _ := obj.(*auth.FederatedIdentity)
+	if tenantID != "" {
+		identity.Spec.TenantID = tenantID
+	}
+	if identity.Name == "" && identity.GenerateName == "" {
+		identity.GenerateName = "fid-"
+	}
+}
+
+// Validate validates a new federated identity.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateFederatedIdentity(obj.(*auth.FederatedIdentity))
+}
+
+// AllowCreateOnUpdate is false for federated identity.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for a federated identity.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateFederatedIdentityUpdate(obj.(*auth.FederatedIdentity), old.(*auth.FederatedIdentity))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	identity, ok := obj.(*auth.FederatedIdentity)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a federated identity")
+	}
+	return labels.Set(identity.ObjectMeta.Labels), ToSelectableFields(identity), nil
+}
+
+// MatchFederatedIdentity returns a generic matcher for a given label and field selector.
+func MatchFederatedIdentity(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.tenantID", "spec.connectorType", "spec.username"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(identity *auth.FederatedIdentity) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&identity.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":      identity.Spec.TenantID,
+		"spec.connectorType": identity.Spec.ConnectorType,
+		"spec.username":      identity.Spec.Username,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package federatedidentity
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// ValidateFederatedIdentity tests if required fields in the federated identity are set.
+func ValidateFederatedIdentity(identity *auth.FederatedIdentity) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&identity.ObjectMeta, false, apiMachineryValidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if identity.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if identity.Spec.ConnectorType == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("connectorType"), "must specify connectorType"))
+	}
+	if identity.Spec.UserID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("userID"), "must specify userID"))
+	}
+	if identity.Spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("username"), "must specify username"))
+	}
+
+	return allErrs
+}
+
+// ValidateFederatedIdentityUpdate tests if required fields in the federated
+// identity are set during an update.
+func ValidateFederatedIdentityUpdate(identity *auth.FederatedIdentity, old *auth.FederatedIdentity) field.ErrorList {
+	allErrs := ValidateFederatedIdentity(identity)
+	allErrs = append(allErrs, apiMachineryValidation.ValidateObjectMetaUpdate(&identity.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+
+	fldSpecPath := field.NewPath("spec")
+	if identity.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("tenantID"), identity.Spec.TenantID, "disallowed change the tenant"))
+	}
+	if identity.Spec.UserID != old.Spec.UserID {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("userID"), identity.Spec.UserID, "disallowed change the userID"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
+)
+
+// MetadataREST implements the REST endpoint for getting the saml service
+// provider metadata of the identity provider.
+type MetadataREST struct {
+	store *registry.Store
+}
+
+var _ rest.Connecter = &MetadataREST{}
+
+// NewMetadataREST returns the metadata subresource of identity providers.
+func NewMetadataREST(store *registry.Store) *MetadataREST {
+	return &MetadataREST{store: store}
+}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *MetadataREST) New() runtime.Object {
+	return &auth.IdentityProvider{}
+}
+
+// NewConnectOptions returns an empty options object that will be used to pass
+// options to the Connect method.
+func (r *MetadataREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// ConnectMethods returns the list of HTTP methods handled by Connect
+func (r *MetadataREST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// ProducesMIMETypes returns a list of the MIME types the specified HTTP verb (GET, POST, DELETE,
+// PATCH) can respond with.
+func (r *MetadataREST) ProducesMIMETypes(_ string) []string {
+	return []string{saml.MetadataContentType}
+}
+
+// Connect returns an http.Handler writing the saml service provider metadata.
+func (r *MetadataREST) Connect(ctx context.Context, name string, _ runtime.Object, _ rest.Responder) (http.Handler, error) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" && tenantID != name {
+		return nil, apierrors.NewNotFound(auth.Resource("identityproviders"), name)
+	}
+
+	obj, err := r.store.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	idp := obj.(*auth.IdentityProvider)
+	if idp.Spec.Type != saml.ConnectorType {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("identity provider %s is not of type %s", name, saml.ConnectorType))
+	}
+
+	var samlConfig saml.Config
+	if err := json.Unmarshal([]byte(idp.Spec.Config), &samlConfig); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	metadata, err := saml.Metadata(samlConfig)
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", saml.MetadataContentType)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(metadata)
+	}), nil
+}
@@ -36,6 +36,8 @@ import (
 	oidcidp "tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/oauth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
 	"tkestack.io/tke/pkg/auth/registry/identityprovider"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -108,6 +110,20 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation
 		if err != nil {
 			return nil, errors.NewInternalError(err)
 		}
+	case saml.ConnectorType:
+		var samlConfig saml.Config
+		if err = json.Unmarshal([]byte(idpObj.Spec.Config), &samlConfig); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+		idp, err = saml.NewSAMLIdentityProvider(samlConfig, idpObj.Spec.Administrators, idpObj.Name)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	case oauth.OIDCConnectorType, oauth.GitHubConnectorType, oauth.GitLabConnectorType:
+		idp, err = oauth.NewOAuthIdentityProvider(idpObj.Spec.Type, idpObj.Spec.Config, idpObj.Spec.Administrators, idpObj.Name)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
 	case cloudindustry.ConnectorType:
 		var sdkConfig cloudindustry.SDKConfig
 		if err = json.Unmarshal([]byte(idpObj.Spec.Config), &sdkConfig); err != nil {
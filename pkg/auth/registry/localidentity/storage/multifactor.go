@@ -0,0 +1,215 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// totpIssuer is the issuer shown by the authenticator apps.
+const totpIssuer = "TKEStack"
+
+// MultiFactorREST implements the REST endpoint to manage the second factors
+// of the local identity.
+type MultiFactorREST struct {
+	localIdentityStore *registry.Store
+	authClient         authinternalclient.AuthInterface
+	enforcer           *casbin.SyncedEnforcer
+}
+
+var _ = rest.Creater(&MultiFactorREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *MultiFactorREST) New() runtime.Object {
+	return &auth.MultiFactorRequest{}
+}
+
+// Create enrolls or removes a second factor of the local identity, it is
+// allowed for the local identity itself and platform administrators.
+func (r *MultiFactorREST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	isPlatformAdmin, err := util.IsPlatformAdmin(ctx, username, tenantID, r.authClient, r.enforcer)
+	if err != nil {
+		return nil, err
+	}
+
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("unable to get request info from context")
+	}
+
+	userID := requestInfo.Name
+	localIdentityObj, err := r.localIdentityStore.Get(ctx, userID, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	localIdentity := localIdentityObj.(*auth.LocalIdentity)
+	if !isPlatformAdmin && (localIdentity.Spec.Username != username || localIdentity.Spec.TenantID != tenantID) {
+		return nil, apierrors.NewForbidden(auth.Resource("localidentities"), userID, fmt.Errorf("you are not a administrator, and you are not allowd to change other users' second factors"))
+	}
+
+	enrollment, err := r.getOrCreateEnrollment(ctx, localIdentity)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaReq := obj.(*auth.MultiFactorRequest)
+	result := &auth.MultiFactorRequest{Spec: mfaReq.Spec}
+	if err := r.apply(enrollment, mfaReq, &result.Status, time.Now()); err != nil {
+		log.Error("Failed to handle multi-factor request", log.String("localIdentity", userID), log.String("action", string(mfaReq.Spec.Action)), log.Err(err))
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	enrollment, err = r.authClient.MultiFactorEnrollments().UpdateStatus(ctx, enrollment, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	result.Status.Factors = mfa.Factors(enrollment)
+	if result.Spec.WebAuthn != nil {
+		result.Spec.WebAuthn.PublicKey = ""
+		result.Spec.WebAuthn.ClientDataJSON = ""
+	}
+	return result, nil
+}
+
+func (r *MultiFactorREST) getOrCreateEnrollment(ctx context.Context, localIdentity *auth.LocalIdentity) (*auth.MultiFactorEnrollment, error) {
+	enrollment, err := r.authClient.MultiFactorEnrollments().Get(ctx, localIdentity.Name, metav1.GetOptions{})
+	if err == nil {
+		return enrollment, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	return r.authClient.MultiFactorEnrollments().Create(ctx, &auth.MultiFactorEnrollment{
+		ObjectMeta: metav1.ObjectMeta{Name: localIdentity.Name},
+		Spec: auth.MultiFactorEnrollmentSpec{
+			TenantID: localIdentity.Spec.TenantID,
+			Username: localIdentity.Spec.Username,
+		},
+	}, metav1.CreateOptions{})
+}
+
+func (r *MultiFactorREST) apply(enrollment *auth.MultiFactorEnrollment, mfaReq *auth.MultiFactorRequest, status *auth.MultiFactorRequestStatus, now time.Time) error {
+	switch mfaReq.Spec.Action {
+	case auth.MultiFactorEnrollTOTP:
+		secret, err := mfa.GenerateTOTPSecret()
+		if err != nil {
+			return err
+		}
+		enrollment.Spec.TOTP = &auth.TOTPCredential{
+			Secret:       secret,
+			CreationTime: metav1.NewTime(now),
+		}
+		status.TOTPSecret = secret
+		status.TOTPURI = mfa.TOTPURI(totpIssuer, enrollment.Spec.Username, secret)
+	case auth.MultiFactorVerifyTOTP:
+		if enrollment.Spec.TOTP == nil {
+			return fmt.Errorf("totp is not enrolled")
+		}
+		if !mfa.ValidateTOTP(enrollment.Spec.TOTP.Secret, mfaReq.Spec.Code, now) {
+			return fmt.Errorf("invalid one-time password")
+		}
+		enrollment.Spec.TOTP.Verified = true
+	case auth.MultiFactorRemoveTOTP:
+		enrollment.Spec.TOTP = nil
+	case auth.MultiFactorBeginWebAuthn:
+		rp := mfa.DefaultRelyingParty()
+		if rp == nil {
+			return fmt.Errorf("webauthn is not configured")
+		}
+		challenge, err := mfa.AddChallenge(enrollment, auth.WebAuthnPurposeRegistration, now)
+		if err != nil {
+			return err
+		}
+		status.Challenge = challenge
+		status.RelyingPartyID = rp.ID
+	case auth.MultiFactorRegisterWebAuthn:
+		rp := mfa.DefaultRelyingParty()
+		if rp == nil {
+			return fmt.Errorf("webauthn is not configured")
+		}
+		registration := mfaReq.Spec.WebAuthn
+		if registration == nil || registration.ID == "" {
+			return fmt.Errorf("webauthn credential is required")
+		}
+		for _, credential := range enrollment.Spec.WebAuthn {
+			if credential.ID == registration.ID {
+				return fmt.Errorf("webauthn credential %s is already registered", registration.ID)
+			}
+		}
+		challenge, err := mfa.ChallengeOf(&mfa.Assertion{ClientDataJSON: registration.ClientDataJSON})
+		if err != nil {
+			return err
+		}
+		if !mfa.TakeChallenge(enrollment, auth.WebAuthnPurposeRegistration, challenge, now) {
+			return fmt.Errorf("challenge is expired or unknown")
+		}
+		if err := rp.VerifyRegistration(registration, challenge); err != nil {
+			return err
+		}
+		enrollment.Spec.WebAuthn = append(enrollment.Spec.WebAuthn, auth.WebAuthnCredential{
+			ID:           registration.ID,
+			Name:         registration.Name,
+			PublicKey:    registration.PublicKey,
+			Algorithm:    registration.Algorithm,
+			CreationTime: metav1.NewTime(now),
+		})
+	case auth.MultiFactorRemoveWebAuthn:
+		if mfaReq.Spec.WebAuthn == nil || mfaReq.Spec.WebAuthn.ID == "" {
+			return fmt.Errorf("webauthn credential id is required")
+		}
+		var credentials []auth.WebAuthnCredential
+		for _, credential := range enrollment.Spec.WebAuthn {
+			if credential.ID != mfaReq.Spec.WebAuthn.ID {
+				credentials = append(credentials, credential)
+			}
+		}
+		if len(credentials) == len(enrollment.Spec.WebAuthn) {
+			return fmt.Errorf("webauthn credential %s is not registered", mfaReq.Spec.WebAuthn.ID)
+		}
+		enrollment.Spec.WebAuthn = credentials
+	case auth.MultiFactorGenerateRecoveryCodes:
+		codes, hashes, err := mfa.GenerateRecoveryCodes()
+		if err != nil {
+			return err
+		}
+		enrollment.Spec.RecoveryCodes = hashes
+		status.RecoveryCodes = codes
+	default:
+		return fmt.Errorf("unsupported action %q", mfaReq.Spec.Action)
+	}
+	return nil
+}
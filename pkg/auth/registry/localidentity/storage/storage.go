@@ -51,6 +51,8 @@ import (
 type Storage struct {
 	LocalIdentity *REST
 	Password      *PasswordREST
+	MultiFactor   *MultiFactorREST
+	Unlock        *UnlockREST
 	Status        *StatusREST
 	Policy        *PolicyREST
 	Role          *RoleREST
@@ -90,9 +92,16 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalcli
 	finalizeStore := *store
 	finalizeStore.UpdateStrategy = localidentity.NewFinalizerStrategy(strategy)
 
+	// Passwords are only set through the spec, the status and finalize
+	// stores do not hash them.
+	store.BeginCreate = strategy.BeginCreate
+	store.BeginUpdate = strategy.BeginUpdate
+
 	return &Storage{
 		LocalIdentity: &REST{store, authClient, enforcer, privilegedUsername},
 		Password:      &PasswordREST{store, authClient, enforcer},
+		MultiFactor:   &MultiFactorREST{store, authClient, enforcer},
+		Unlock:        &UnlockREST{&statusStore, authClient, enforcer, privilegedUsername},
 		Status:        &StatusREST{&statusStore},
 		Policy:        &PolicyREST{store, authClient, enforcer},
 		Role:          &RoleREST{store, authClient, enforcer},
@@ -142,6 +151,7 @@ func ValidateListObjectAndTenantID(ctx context.Context, store *registry.Store, o
 
 	for i := range identityList.Items {
 		identityList.Items[i].Spec.HashedPassword = ""
+		identityList.Items[i].Status.PasswordHistory = nil
 	}
 
 	return identityList, nil
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// UnlockREST implements the REST endpoint to unlock the local identity.
+type UnlockREST struct {
+	statusStore        *registry.Store
+	authClient         authinternalclient.AuthInterface
+	enforcer           *casbin.SyncedEnforcer
+	privilegedUsername string
+}
+
+var _ = rest.Creater(&UnlockREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *UnlockREST) New() runtime.Object {
+	return &auth.UnlockReq{}
+}
+
+// Create clears the lock and the lockout of the local identity, it is only
+// allowed for the privileged user and platform administrators.
+func (r *UnlockREST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	requestInfo, ok := request.RequestInfoFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("unable to get request info from context")
+	}
+
+	userID := requestInfo.Name
+	if username != r.privilegedUsername {
+		isPlatformAdmin, err := util.IsPlatformAdmin(ctx, username, tenantID, r.authClient, r.enforcer)
+		if err != nil {
+			return nil, err
+		}
+		if !isPlatformAdmin {
+			return nil, apierrors.NewForbidden(auth.Resource("localidentities"), userID, fmt.Errorf("only administrators are allowed to unlock users"))
+		}
+	}
+
+	localIdentityObj, err := ValidateGetObjectAndTenantID(ctx, r.statusStore, userID, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	localIdentity := localIdentityObj.(*auth.LocalIdentity)
+	passwordpolicy.Unlock(&localIdentity.Status)
+
+	unlockReq := obj.(*auth.UnlockReq)
+	log.Info("Unlock localIdentity", log.String("localIdentity", userID), log.String("operator", username), log.String("reason", unlockReq.Reason))
+
+	objUpdated, _, err := r.statusStore.Update(ctx, userID, rest.DefaultUpdatedObjectInfo(localIdentity), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	return objUpdated, err
+}
@@ -36,6 +36,7 @@ import (
 	"tkestack.io/tke/api/auth"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 
@@ -62,6 +63,40 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 	return rest.Unsupported
 }
 
+// BeginCreate checks the password of a new local identity against the
+// password policy of the tenant and replaces it with the bcrypt hash.
+func (s *Strategy) BeginCreate(ctx context.Context, obj runtime.Object, options *metav1.CreateOptions) (registry.FinishFunc, error) {
+	localIdentity, _ := obj.(*auth.LocalIdentity)
+
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		localIdentity.Spec.TenantID = tenantID
+	}
+	// A missing password is reported by the validation.
+	if localIdentity.Spec.HashedPassword != "" {
+		if err := hashPassword(ctx, s.authClient, localIdentity, nil); err != nil {
+			return nil, err
+		}
+	}
+	return func(context.Context, bool) {}, nil
+}
+
+// BeginUpdate checks a changed password of the local identity against the
+// password policy of the tenant and replaces it with the bcrypt hash.
+func (s *Strategy) BeginUpdate(ctx context.Context, obj, old runtime.Object, options *metav1.UpdateOptions) (registry.FinishFunc, error) {
+	oldLocalIdentity := old.(*auth.LocalIdentity)
+	localIdentity, _ := obj.(*auth.LocalIdentity)
+
+	// The stored hash is sent back unchanged by clients which get and update
+	// the local identity, it must not be hashed again.
+	if localIdentity.Spec.HashedPassword != "" && localIdentity.Spec.HashedPassword != oldLocalIdentity.Spec.HashedPassword {
+		if err := hashPassword(ctx, s.authClient, localIdentity, oldLocalIdentity); err != nil {
+			return nil, err
+		}
+	}
+	return func(context.Context, bool) {}, nil
+}
+
 // PrepareForUpdate is invoked on update before validation to normalize the
 // object.
 func (s *Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
@@ -76,6 +111,24 @@ func (s *Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object
 		localIdentity.Spec.TenantID = tenantID
 	}
 
+	// The password history and the lockout are maintained by the api server.
+	localIdentity.Status.PasswordChangeTime = oldLocalIdentity.Status.PasswordChangeTime
+	localIdentity.Status.PasswordHistory = oldLocalIdentity.Status.PasswordHistory
+	// A changed password has been hashed by BeginUpdate.
+	if localIdentity.Spec.HashedPassword == "" || localIdentity.Spec.HashedPassword == oldLocalIdentity.Spec.HashedPassword {
+		localIdentity.Spec.HashedPassword = oldLocalIdentity.Spec.HashedPassword
+	} else {
+		policy, err := util.GetPasswordPolicy(ctx, s.authClient, localIdentity.Spec.TenantID)
+		if err != nil {
+			log.Error("Failed to get password policy", log.String("tenantID", localIdentity.Spec.TenantID), log.Err(err))
+		}
+		localIdentity.Status.PasswordChangeTime = metav1.Now()
+		localIdentity.Status.PasswordHistory = passwordpolicy.PushHistory(policy, oldLocalIdentity.Status.PasswordHistory, oldLocalIdentity.Spec.HashedPassword)
+	}
+	localIdentity.Status.FailedLoginCount = oldLocalIdentity.Status.FailedLoginCount
+	localIdentity.Status.LastFailedLoginTime = oldLocalIdentity.Status.LastFailedLoginTime
+	localIdentity.Status.LockoutExpireTime = oldLocalIdentity.Status.LockoutExpireTime
+
 	localIdentity.Status.LastUpdateTime = metav1.Now()
 	_ = util.HandleUserPoliciesUpdate(ctx, s.authClient, s.enforcer, localIdentity)
 }
@@ -106,6 +159,10 @@ func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	localIdentity.Spec.Finalizers = []auth.FinalizerName{
 		auth.LocalIdentityFinalize,
 	}
+	localIdentity.Status.PasswordChangeTime = metav1.Now()
+	localIdentity.Status.PasswordHistory = nil
+	localIdentity.Status.FailedLoginCount = 0
+	localIdentity.Status.LockoutExpireTime = metav1.Time{}
 }
 
 // Validate validates a new identity.
@@ -23,11 +23,13 @@ import (
 	"encoding/base64"
 	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/validation"
@@ -68,18 +70,6 @@ func ValidateLocalIdentity(ctx context.Context, authClient authinternalclient.Au
 	if !updateCheck {
 		if localIdentity.Spec.HashedPassword == "" {
 			allErrs = append(allErrs, field.Required(fldSpecPath.Child("hashedPassword"), "must specify hashedPassword"))
-		} else if bcrypted, err := util.BcryptPassword(localIdentity.Spec.HashedPassword); err != nil {
-			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("hashedPassword"), localIdentity.Spec.HashedPassword, err.Error()))
-		} else {
-			localIdentity.Spec.HashedPassword = bcrypted
-		}
-	} else {
-		if localIdentity.Spec.HashedPassword != "" {
-			if bcrypted, err := util.BcryptPassword(localIdentity.Spec.HashedPassword); err != nil {
-				allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("hashedPassword"), localIdentity.Spec.HashedPassword, err.Error()))
-			} else {
-				localIdentity.Spec.HashedPassword = bcrypted
-			}
 		}
 	}
 
@@ -119,10 +109,50 @@ func ValidateLocalIdentityUpdate(ctx context.Context, authClient authinternalcli
 		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("username"), localIdentity.Spec.Username, "disallowed change the username"))
 	}
 
-	if localIdentity.Spec.HashedPassword == "" {
-		localIdentity.Spec.HashedPassword = oldLocalIdentity.Spec.HashedPassword
+	return allErrs
+}
+
+// hashPassword checks the base64 encoded password against the password policy
+// of the tenant and replaces it with the bcrypt hash.
+func hashPassword(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity, oldLocalIdentity *auth.LocalIdentity) error {
+	if errs := validatePassword(ctx, authClient, localIdentity, oldLocalIdentity); len(errs) > 0 {
+		return apierrors.NewInvalid(auth.Kind("LocalIdentity"), localIdentity.Name, errs)
 	}
+	bcrypted, err := util.BcryptPassword(localIdentity.Spec.HashedPassword)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	localIdentity.Spec.HashedPassword = bcrypted
+	return nil
+}
 
+// validatePassword checks the base64 encoded password against the password
+// policy of the tenant.
+func validatePassword(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity, oldLocalIdentity *auth.LocalIdentity) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := field.NewPath("spec", "hashedPassword")
+
+	password, err := base64.StdEncoding.DecodeString(localIdentity.Spec.HashedPassword)
+	if err != nil {
+		return append(allErrs, field.Invalid(fldPath, "", err.Error()))
+	}
+	if util.IsBcryptPassword(localIdentity.Spec.HashedPassword) {
+		return append(allErrs, field.Invalid(fldPath, "", "must be a password rather than a bcrypted password"))
+	}
+
+	policy, err := util.GetPasswordPolicy(ctx, authClient, localIdentity.Spec.TenantID)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	if err := passwordpolicy.Validate(policy, string(password)); err != nil {
+		return append(allErrs, field.Invalid(fldPath, "", err.Error()))
+	}
+	if oldLocalIdentity != nil {
+		previous := passwordpolicy.Previous(policy, oldLocalIdentity.Spec.HashedPassword, oldLocalIdentity.Status.PasswordHistory)
+		if passwordpolicy.Reused(previous, string(password)) {
+			return append(allErrs, field.Invalid(fldPath, "", fmt.Sprintf("password must not be one of the last %d passwords", policy.HistoryCount)))
+		}
+	}
 	return allErrs
 }
 
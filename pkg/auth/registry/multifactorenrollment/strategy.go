@@ -0,0 +1,199 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package multifactorenrollment
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for multi-factor enrollment.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var _ rest.RESTCreateStrategy = &Strategy{}
+var _ rest.RESTUpdateStrategy = &Strategy{}
+var _ rest.RESTDeleteStrategy = &Strategy{}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating multi-factor enrollment objects.
+func NewStrategy() *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object. The second factors can only be changed by the mfa subresource of
+// the local identity, tenant users are only allowed to change the metadata.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	enrollment := obj.(*auth.MultiFactorEnrollment)
+	oldEnrollment := old.(*auth.MultiFactorEnrollment)
+	enrollment.Status = oldEnrollment.Status
+	if tenantID != "" {
+		enrollment.Spec = oldEnrollment.Spec
+	}
+}
+
+// NamespaceScoped is false for multi-factor enrollment.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	enrollment, _ := obj.(*auth.MultiFactorEnrollment)
+	if tenantID != "" {
+		enrollment.Spec = auth.MultiFactorEnrollmentSpec{
+			TenantID: tenantID,
+			Username: enrollment.Spec.Username,
+		}
+	}
+	enrollment.Status = auth.MultiFactorEnrollmentStatus{}
+}
+
+// Validate validates a new multi-factor enrollment.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateMultiFactorEnrollment(obj.(*auth.MultiFactorEnrollment))
+}
+
+// AllowCreateOnUpdate is false for multi-factor enrollment.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for a multi-factor enrollment.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateMultiFactorEnrollmentUpdate(obj.(*auth.MultiFactorEnrollment), old.(*auth.MultiFactorEnrollment))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// StatusStrategy implements verification logic for status of multi-factor enrollment.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object. The status subresource is used by the login flow to save the
+// challenges and the sign counters of webauthn credentials, so both spec
+// and status are accepted for system callers only.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	enrollment := obj.(*auth.MultiFactorEnrollment)
+	oldEnrollment := old.(*auth.MultiFactorEnrollment)
+	if tenantID != "" {
+		enrollment.Spec = oldEnrollment.Spec
+		enrollment.Status = oldEnrollment.Status
+	}
+	enrollment.ObjectMeta.Labels = oldEnrollment.ObjectMeta.Labels
+	enrollment.ObjectMeta.Annotations = oldEnrollment.ObjectMeta.Annotations
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateMultiFactorEnrollmentUpdate(obj.(*auth.MultiFactorEnrollment), old.(*auth.MultiFactorEnrollment))
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	enrollment, ok := obj.(*auth.MultiFactorEnrollment)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a multi-factor enrollment")
+	}
+	return labels.Set(enrollment.ObjectMeta.Labels), ToSelectableFields(enrollment), nil
+}
+
+// MatchMultiFactorEnrollment returns a generic matcher for a given label and field selector.
+func MatchMultiFactorEnrollment(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:       label,
+		Field:       field,
+		GetAttrs:    GetAttrs,
+		IndexFields: []string{"spec.tenantID", "spec.username"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(enrollment *auth.MultiFactorEnrollment) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&enrollment.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": enrollment.Spec.TenantID,
+		"spec.username": enrollment.Spec.Username,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// Redact removes the secrets of the second factors, which must never be
+// returned to tenant users.
+func Redact(enrollment *auth.MultiFactorEnrollment) {
+	if enrollment.Spec.TOTP != nil {
+		enrollment.Spec.TOTP.Secret = ""
+	}
+	enrollment.Spec.RecoveryCodes = nil
+	enrollment.Status.Challenges = nil
+}
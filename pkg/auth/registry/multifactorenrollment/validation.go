@@ -0,0 +1,75 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package multifactorenrollment
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// ValidateMultiFactorEnrollment tests if required fields in the multi-factor enrollment are set.
+func ValidateMultiFactorEnrollment(enrollment *auth.MultiFactorEnrollment) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&enrollment.ObjectMeta, false, apiMachineryValidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if enrollment.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if enrollment.Spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("username"), "must specify username"))
+	}
+	if enrollment.Spec.TOTP != nil && enrollment.Spec.TOTP.Secret == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("totp", "secret"), "must specify secret"))
+	}
+
+	ids := sets.NewString()
+	for i, credential := range enrollment.Spec.WebAuthn {
+		fldPath := fldSpecPath.Child("webAuthn").Index(i)
+		if credential.ID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("id"), "must specify id"))
+		} else if ids.Has(credential.ID) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("id"), credential.ID))
+		}
+		ids.Insert(credential.ID)
+		if credential.PublicKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("publicKey"), "must specify publicKey"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMultiFactorEnrollmentUpdate tests if required fields in the
+// multi-factor enrollment are set during an update.
+func ValidateMultiFactorEnrollmentUpdate(enrollment *auth.MultiFactorEnrollment, old *auth.MultiFactorEnrollment) field.ErrorList {
+	allErrs := ValidateMultiFactorEnrollment(enrollment)
+	allErrs = append(allErrs, apiMachineryValidation.ValidateObjectMetaUpdate(&enrollment.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+
+	fldSpecPath := field.NewPath("spec")
+	if enrollment.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("tenantID"), enrollment.Spec.TenantID, "disallowed change the tenant"))
+	}
+	if enrollment.Spec.Username != old.Spec.Username {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("username"), enrollment.Spec.Username, "disallowed change the username"))
+	}
+
+	return allErrs
+}
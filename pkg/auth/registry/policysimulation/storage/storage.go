@@ -0,0 +1,145 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
+	"tkestack.io/tke/pkg/auth/authorization/local"
+	"tkestack.io/tke/pkg/auth/registry/policysimulation"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// REST implements a RESTStorage for policy simulations, which are evaluated
+// by the local authorizer and never persisted.
+type REST struct {
+	authorizer *local.Authorizer
+	authClient authinternalclient.AuthInterface
+	enforcer   *casbin.SyncedEnforcer
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+
+// NewStorage returns a RESTStorage object that will work against policy simulations.
+func NewStorage(authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer, policyInformer authv1informer.PolicyInformer, privilegedUsername string) *REST {
+	return &REST{
+		// simulated requests carry their labels and tags, so that the
+		// authorizer never looks them up
+		authorizer: local.NewAuthorizer(authClient, nil, enforcer, policyInformer, privilegedUsername),
+		authClient: authClient,
+		enforcer:   enforcer,
+	}
+}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *REST) New() runtime.Object {
+	return &auth.PolicySimulation{}
+}
+
+// NamespaceScoped returns false because policy simulations are not namespaced.
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// Create simulates the access described by the policy simulation, it is only
+// allowed for platform administrators since the result reveals the policies
+// of other users.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	simulation := obj.(*auth.PolicySimulation)
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	// Requests without tenant come from the privileged user or the api server itself.
+	if tenantID != "" {
+		if simulation.Spec.TenantID == "" {
+			simulation.Spec.TenantID = tenantID
+		}
+		if simulation.Spec.TenantID != tenantID {
+			return nil, apierrors.NewBadRequest("must in the same tenant")
+		}
+		isPlatformAdmin, err := util.IsPlatformAdmin(ctx, username, tenantID, r.authClient, r.enforcer)
+		if err != nil {
+			return nil, err
+		}
+		if !isPlatformAdmin {
+			return nil, apierrors.NewForbidden(auth.Resource("policysimulations"), simulation.Name, fmt.Errorf("only administrators are allowed to simulate policies"))
+		}
+	}
+
+	if errs := policysimulation.ValidatePolicySimulation(simulation); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(auth.Kind("PolicySimulation"), simulation.Name, errs)
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	spec := simulation.Spec
+	extra := make(map[string][]string, len(spec.Extra))
+	for k, v := range spec.Extra {
+		extra[k] = v
+	}
+	// The conditions are evaluated on the simulated attributes instead of the
+	// ones of the requester.
+	ctx = genericfilter.WithSourceIPValue(ctx, condition.SourceIPFrom(extra))
+	ctx = condition.WithAttributes(ctx, condition.SimulatedAttributesFrom(extra, time.Now()))
+
+	simulation.Status = auth.PolicySimulationStatus{}
+	if spec.User == "" && spec.Group == "" {
+		users, groups, err := r.authorizer.WhoCan(ctx, spec.TenantID, spec.ProjectID, spec.Resource, spec.Action, extra)
+		if err != nil {
+			simulation.Status.Review.EvaluationError = err.Error()
+		}
+		simulation.Status.Users = users
+		simulation.Status.Groups = groups
+		simulation.Status.Review.Allowed = len(users) != 0 || len(groups) != 0
+		log.Info("Simulate who can", log.String("operator", username), log.Any("spec", spec), log.Int("users", len(users)), log.Int("groups", len(groups)))
+		return simulation, nil
+	}
+
+	decision, reason, matches, err := r.authorizer.Simulate(ctx, spec.TenantID, spec.User, spec.Group, spec.ProjectID, spec.Resource, spec.Action, extra)
+	simulation.Status.Review = auth.SubjectAccessReviewStatus{
+		Allowed: decision == authorizer.DecisionAllow,
+		Denied:  decision == authorizer.DecisionDeny,
+		Reason:  reason,
+	}
+	if err != nil {
+		simulation.Status.Review.EvaluationError = err.Error()
+	}
+	simulation.Status.Matches = matches
+	log.Info("Simulate policies", log.String("operator", username), log.Any("spec", spec), log.Bool("allowed", simulation.Status.Review.Allowed))
+	return simulation, nil
+}
@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package policysimulation
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// ValidatePolicySimulation tests if required fields in the policy simulation are set.
+func ValidatePolicySimulation(simulation *auth.PolicySimulation) field.ErrorList {
+	var allErrs field.ErrorList
+
+	fldSpecPath := field.NewPath("spec")
+	if simulation.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if simulation.Spec.User != "" && simulation.Spec.Group != "" {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("group"), simulation.Spec.Group, "must not specify both user and group"))
+	}
+	if simulation.Spec.Action == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("action"), "must specify action"))
+	}
+	if simulation.Spec.Resource == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("resource"), "must specify resource"))
+	}
+
+	return allErrs
+}
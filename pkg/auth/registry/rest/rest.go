@@ -31,18 +31,22 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	accessrequeststorage "tkestack.io/tke/pkg/auth/registry/accessrequest/storage"
 	apikeystorage "tkestack.io/tke/pkg/auth/registry/apikey/storage"
 	apisignstorage "tkestack.io/tke/pkg/auth/registry/apisigningkey/storage"
 	categorystorage "tkestack.io/tke/pkg/auth/registry/category/storage"
 	clistorage "tkestack.io/tke/pkg/auth/registry/client/storage"
 	configmapstorage "tkestack.io/tke/pkg/auth/registry/configmap/storage"
+	federatedidentitystorage "tkestack.io/tke/pkg/auth/registry/federatedidentity/storage"
 	groupstorage "tkestack.io/tke/pkg/auth/registry/group/storage"
 	idpstorage "tkestack.io/tke/pkg/auth/registry/identityprovider/storage"
 
 	custompolicybindingtorage "tkestack.io/tke/pkg/auth/registry/custompolicybinding/storage"
 	localgroupstorage "tkestack.io/tke/pkg/auth/registry/localgroup/storage"
 	localidentitystorage "tkestack.io/tke/pkg/auth/registry/localidentity/storage"
+	multifactorenrollmentstorage "tkestack.io/tke/pkg/auth/registry/multifactorenrollment/storage"
 	policystorage "tkestack.io/tke/pkg/auth/registry/policy/storage"
+	policysimulationstorage "tkestack.io/tke/pkg/auth/registry/policysimulation/storage"
 	projectpolicybindingtorage "tkestack.io/tke/pkg/auth/registry/projectpolicybinding/storage"
 
 	projectstorage "tkestack.io/tke/pkg/auth/registry/project/storage"
@@ -93,6 +97,8 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		localIdentityRest := localidentitystorage.NewStorage(restOptionsGetter, authClient, s.Enforcer, s.PrivilegedUsername)
 		storageMap["localidentities"] = localIdentityRest.LocalIdentity
 		storageMap["localidentities/password"] = localIdentityRest.Password
+		storageMap["localidentities/mfa"] = localIdentityRest.MultiFactor
+		storageMap["localidentities/unlock"] = localIdentityRest.Unlock
 		storageMap["localidentities/status"] = localIdentityRest.Status
 		storageMap["localidentities/policies"] = localIdentityRest.Policy
 		storageMap["localidentities/roles"] = localIdentityRest.Role
@@ -106,6 +112,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["apikeys/password"] = apiKeyRest.Password
 		storageMap["apikeys/token"] = apiKeyRest.Token
 		storageMap["apikeys/status"] = apiKeyRest.Status
+		storageMap["apikeys/rotate"] = apiKeyRest.Rotate
 
 		apiSignRest := apisignstorage.NewStorage(restOptionsGetter)
 		storageMap["apisigningkeys"] = apiSignRest
@@ -124,9 +131,17 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["policies/roles"] = policyRest.Role
 		storageMap["policies/users"] = policyRest.User
 		storageMap["policies/groups"] = policyRest.Group
+
 		storageMap["policies/projectusers"] = policyRest.ProjectUser
 		storageMap["policies/projectgroups"] = policyRest.ProjectGroup
 
+		storageMap["policysimulations"] = policysimulationstorage.NewStorage(authClient, s.Enforcer, s.VersionedInformers.Auth().V1().Policies(), s.PrivilegedUsername)
+
+		accessRequestRest := accessrequeststorage.NewStorage(restOptionsGetter, authClient, s.Enforcer, s.PrivilegedUsername)
+		storageMap["accessrequests"] = accessRequestRest.AccessRequest
+		storageMap["accessrequests/status"] = accessRequestRest.Status
+		storageMap["accessrequests/approval"] = accessRequestRest.Approval
+
 		projectPolicyRest := projectpolicybindingtorage.NewStorage(restOptionsGetter, authClient, s.Enforcer, s.PrivilegedUsername)
 		storageMap["projectpolicybindings"] = projectPolicyRest.ProjectPolicy
 		storageMap["projectpolicybindings/finalize"] = projectPolicyRest.Finalize
@@ -183,6 +198,14 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 
 		idpRest := idpstorage.NewStorage(restOptionsGetter, authClient)
 		storageMap["identityproviders"] = idpRest
+		storageMap["identityproviders/metadata"] = idpstorage.NewMetadataREST(idpRest.Store)
+
+		federatedIdentityRest := federatedidentitystorage.NewStorage(restOptionsGetter)
+		storageMap["federatedidentities"] = federatedIdentityRest.FederatedIdentity
+
+		multiFactorEnrollmentRest := multifactorenrollmentstorage.NewStorage(restOptionsGetter)
+		storageMap["multifactorenrollments"] = multiFactorEnrollmentRest.MultiFactorEnrollment
+		storageMap["multifactorenrollments/status"] = multiFactorEnrollmentRest.Status
 
 		cliRest := clistorage.NewStorage(restOptionsGetter, s.DexStorage)
 		storageMap["clients"] = cliRest.Client
@@ -26,12 +26,13 @@ import (
 	"github.com/emicklei/go-restful"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"tkestack.io/tke/pkg/auth/handler/authz"
+	"tkestack.io/tke/pkg/auth/handler/mfa"
 
 	authenticationapi "k8s.io/api/authentication/v1"
 )
 
 // RegisterAuthRoute registers the http handlers of authz webhook for kubernetes.
-func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler, authzHandler *authz.Handler) {
+func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler, authzHandler *authz.Handler, mfaHandler *mfa.Handler) {
 	ws := new(restful.WebService)
 	ws.Path("/auth")
 	ws.Produces(restful.MIME_JSON)
@@ -74,5 +75,14 @@ func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler
 		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
 		To(authzHandler.BatchAuthorize))
 
+	ws.Route(ws.
+		POST("/mfa/challenge").
+		Doc("issue a challenge to log in by the webauthn credentials of the local identity.").
+		Operation("getMultiFactorChallenge").
+		Reads(mfa.ChallengeRequest{}).
+		Returns(http.StatusOK, "Ok", mfa.ChallengeResponse{}).
+		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
+		To(mfaHandler.Challenge))
+
 	container.Add(ws)
 }
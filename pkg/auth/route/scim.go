@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package route
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	"tkestack.io/tke/pkg/auth/handler/scim"
+	scimapi "tkestack.io/tke/pkg/auth/scim"
+)
+
+// RegisterSCIMRoute registers the http handlers of scim 2.0 provisioning
+// endpoint for users and groups.
+func RegisterSCIMRoute(container *restful.Container, handler *scim.Handler) {
+	ws := new(restful.WebService)
+	ws.Path(scim.BasePath)
+	ws.Produces(scimapi.MediaType, restful.MIME_JSON)
+	ws.Consumes(scimapi.MediaType, restful.MIME_JSON)
+	ws.Filter(handler.Authorize)
+
+	ws.Route(ws.
+		GET("/ServiceProviderConfig").
+		Doc("get the features supported by the scim endpoint").
+		Operation("getSCIMServiceProviderConfig").
+		To(handler.ServiceProviderConfig))
+
+	ws.Route(ws.
+		GET("/ResourceTypes").
+		Doc("list the resource types supported by the scim endpoint").
+		Operation("listSCIMResourceTypes").
+		To(handler.ResourceTypes))
+
+	idParam := ws.PathParameter("id", "identifier of the resource").DataType("string")
+
+	ws.Route(ws.
+		GET("/Users").
+		Doc("list the users matching the filter").
+		Operation("listSCIMUsers").
+		Param(ws.QueryParameter("filter", "scim filter expression").DataType("string")).
+		Param(ws.QueryParameter("startIndex", "1-based index of the first result").DataType("integer")).
+		Param(ws.QueryParameter("count", "maximum number of results").DataType("integer")).
+		Returns(http.StatusOK, "Ok", scimapi.ListResponse{}).
+		To(handler.ListUsers))
+
+	ws.Route(ws.
+		POST("/Users").
+		Doc("provision a user").
+		Operation("createSCIMUser").
+		Reads(scimapi.User{}).
+		Returns(http.StatusCreated, "Created", scimapi.User{}).
+		To(handler.CreateUser))
+
+	ws.Route(ws.
+		GET("/Users/{id}").
+		Doc("get the user").
+		Operation("getSCIMUser").
+		Param(idParam).
+		Returns(http.StatusOK, "Ok", scimapi.User{}).
+		To(handler.GetUser))
+
+	ws.Route(ws.
+		PUT("/Users/{id}").
+		Doc("replace the attributes of the user").
+		Operation("replaceSCIMUser").
+		Param(idParam).
+		Reads(scimapi.User{}).
+		Returns(http.StatusOK, "Ok", scimapi.User{}).
+		To(handler.ReplaceUser))
+
+	ws.Route(ws.
+		PATCH("/Users/{id}").
+		Doc("modify the attributes of the user").
+		Operation("patchSCIMUser").
+		Param(idParam).
+		Reads(scimapi.PatchRequest{}).
+		Returns(http.StatusOK, "Ok", scimapi.User{}).
+		To(handler.PatchUser))
+
+	ws.Route(ws.
+		DELETE("/Users/{id}").
+		Doc("deprovision the user").
+		Operation("deleteSCIMUser").
+		Param(idParam).
+		Returns(http.StatusNoContent, "No Content", nil).
+		To(handler.DeleteUser))
+
+	ws.Route(ws.
+		GET("/Groups").
+		Doc("list the groups matching the filter").
+		Operation("listSCIMGroups").
+		Param(ws.QueryParameter("filter", "scim filter expression").DataType("string")).
+		Param(ws.QueryParameter("startIndex", "1-based index of the first result").DataType("integer")).
+		Param(ws.QueryParameter("count", "maximum number of results").DataType("integer")).
+		Returns(http.StatusOK, "Ok", scimapi.ListResponse{}).
+		To(handler.ListGroups))
+
+	ws.Route(ws.
+		POST("/Groups").
+		Doc("provision a group").
+		Operation("createSCIMGroup").
+		Reads(scimapi.Group{}).
+		Returns(http.StatusCreated, "Created", scimapi.Group{}).
+		To(handler.CreateGroup))
+
+	ws.Route(ws.
+		GET("/Groups/{id}").
+		Doc("get the group").
+		Operation("getSCIMGroup").
+		Param(idParam).
+		Returns(http.StatusOK, "Ok", scimapi.Group{}).
+		To(handler.GetGroup))
+
+	ws.Route(ws.
+		PUT("/Groups/{id}").
+		Doc("replace the attributes and members of the group").
+		Operation("replaceSCIMGroup").
+		Param(idParam).
+		Reads(scimapi.Group{}).
+		Returns(http.StatusOK, "Ok", scimapi.Group{}).
+		To(handler.ReplaceGroup))
+
+	ws.Route(ws.
+		PATCH("/Groups/{id}").
+		Doc("modify the attributes and members of the group").
+		Operation("patchSCIMGroup").
+		Param(idParam).
+		Reads(scimapi.PatchRequest{}).
+		Returns(http.StatusOK, "Ok", scimapi.Group{}).
+		To(handler.PatchGroup))
+
+	ws.Route(ws.
+		DELETE("/Groups/{id}").
+		Doc("delete the group").
+		Operation("deleteSCIMGroup").
+		Param(idParam).
+		Returns(http.StatusNoContent, "No Content", nil).
+		To(handler.DeleteGroup))
+
+	container.Add(ws)
+}
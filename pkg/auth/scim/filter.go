@@ -0,0 +1,519 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed scim filter, see RFC 7644 section 3.4.2.2.
+type Expr interface {
+	// Match returns whether the resource, in its json object form, matches
+	// the filter.
+	Match(resource map[string]interface{}) bool
+}
+
+type logicalExpr struct {
+	and         bool
+	left, right Expr
+}
+
+func (e *logicalExpr) Match(resource map[string]interface{}) bool {
+	if e.and {
+		return e.left.Match(resource) && e.right.Match(resource)
+	}
+	return e.left.Match(resource) || e.right.Match(resource)
+}
+
+type notExpr struct {
+	expr Expr
+}
+
+func (e *notExpr) Match(resource map[string]interface{}) bool {
+	return !e.expr.Match(resource)
+}
+
+type presentExpr struct {
+	attr []string
+}
+
+func (e *presentExpr) Match(resource map[string]interface{}) bool {
+	for _, v := range resolve(resource, e.attr) {
+		switch t := v.(type) {
+		case nil:
+		case string:
+			if t != "" {
+				return true
+			}
+		case []interface{}:
+			if len(t) != 0 {
+				return true
+			}
+		case map[string]interface{}:
+			if len(t) != 0 {
+				return true
+			}
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+type compareExpr struct {
+	attr  []string
+	op    string
+	value interface{}
+}
+
+func (e *compareExpr) Match(resource map[string]interface{}) bool {
+	values := resolve(resource, e.attr)
+	if e.op == "ne" {
+		for _, v := range values {
+			if compare(v, "eq", e.value) {
+				return false
+			}
+		}
+		return true
+	}
+	if len(values) == 0 {
+		return e.op == "eq" && e.value == nil
+	}
+	for _, v := range values {
+		if compare(v, e.op, e.value) {
+			return true
+		}
+	}
+	return false
+}
+
+type valuePathExpr struct {
+	attr []string
+	expr Expr
+}
+
+func (e *valuePathExpr) Match(resource map[string]interface{}) bool {
+	for _, v := range resolve(resource, e.attr) {
+		if item, ok := v.(map[string]interface{}); ok && e.expr.Match(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Equality returns the value if the filter is exactly an equality comparison
+// of the attribute with a string, it's used to narrow the query of storage.
+func Equality(expr Expr, attr string) (string, bool) {
+	e, ok := expr.(*compareExpr)
+	if !ok || e.op != "eq" || len(e.attr) != 1 || !strings.EqualFold(e.attr[0], attr) {
+		return "", false
+	}
+	value, ok := e.value.(string)
+	return value, ok
+}
+
+// compare compares the actual value of the resource with the expected value
+// of the filter, strings are compared case-insensitively.
+func compare(actual interface{}, op string, expected interface{}) bool {
+	if m, ok := actual.(map[string]interface{}); ok {
+		v, _, found := lookup(m, "value")
+		if !found {
+			return false
+		}
+		actual = v
+	}
+
+	switch e := expected.(type) {
+	case nil:
+		return op == "eq" && actual == nil
+	case string:
+		a, ok := actual.(string)
+		if !ok {
+			return false
+		}
+		a, e = strings.ToLower(a), strings.ToLower(e)
+		switch op {
+		case "eq":
+			return a == e
+		case "co":
+			return strings.Contains(a, e)
+		case "sw":
+			return strings.HasPrefix(a, e)
+		case "ew":
+			return strings.HasSuffix(a, e)
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	case bool:
+		a, ok := actual.(bool)
+		if !ok {
+			s, isString := actual.(string)
+			if !isString {
+				return false
+			}
+			parsed, err := strconv.ParseBool(s)
+			if err != nil {
+				return false
+			}
+			a = parsed
+		}
+		return op == "eq" && a == e
+	case float64:
+		a, ok := actual.(float64)
+		if !ok {
+			return false
+		}
+		switch op {
+		case "eq":
+			return a == e
+		case "gt":
+			return a > e
+		case "ge":
+			return a >= e
+		case "lt":
+			return a < e
+		case "le":
+			return a <= e
+		}
+	}
+	return false
+}
+
+// resolve returns the values of the attribute path, values of multi-valued
+// attributes are flattened.
+func resolve(v interface{}, path []string) []interface{} {
+	if len(path) == 0 {
+		if items, ok := v.([]interface{}); ok {
+			return items
+		}
+		return []interface{}{v}
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		child, _, ok := lookup(t, path[0])
+		if !ok {
+			return nil
+		}
+		return resolve(child, path[1:])
+	case []interface{}:
+		var values []interface{}
+		for _, item := range t {
+			values = append(values, resolve(item, path)...)
+		}
+		return values
+	}
+	return nil
+}
+
+// lookup returns the value of the attribute, attribute names are case-insensitive.
+func lookup(m map[string]interface{}, attr string) (interface{}, string, bool) {
+	if v, ok := m[attr]; ok {
+		return v, attr, true
+	}
+	for k, v := range m {
+		if strings.EqualFold(k, attr) {
+			return v, k, true
+		}
+	}
+	return nil, attr, false
+}
+
+// attrPath splits the attribute path into its names, the schema urn prefix of
+// core attributes is removed.
+func attrPath(path string) []string {
+	return strings.Split(trimSchema(path), ".")
+}
+
+func trimSchema(path string) string {
+	if !strings.HasPrefix(strings.ToLower(path), "urn:") {
+		return path
+	}
+	end := strings.Index(path, "[")
+	if end < 0 {
+		end = len(path)
+	}
+	if i := strings.LastIndex(path[:end], ":"); i >= 0 {
+		return path[i+1:]
+	}
+	return path
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenString
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(filter string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")"})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{kind: tokenLBracket, text: "["})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{kind: tokenRBracket, text: "]"})
+			i++
+		case c == '"':
+			j := i + 1
+			for ; j < len(filter); j++ {
+				if filter[j] == '\\' {
+					j++
+					continue
+				}
+				if filter[j] == '"' {
+					break
+				}
+			}
+			if j >= len(filter) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			var s string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &s); err != nil {
+				return nil, fmt.Errorf("invalid string at %d: %v", i, err)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: s})
+			i = j + 1
+		default:
+			j := i
+			for ; j < len(filter) && !strings.ContainsRune(" \t\n\r()[]\"", rune(filter[j])); j++ {
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: filter[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+var compareOperators = map[string]bool{
+	"eq": true, "ne": true, "co": true, "sw": true, "ew": true,
+	"gt": true, "ge": true, "lt": true, "le": true,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// ParseFilter parses the scim filter expression.
+func ParseFilter(filter string) (Expr, error) {
+	tokens, err := tokenize(filter)
+	if err != nil {
+		return nil, NewBadRequest(ErrInvalidFilter, "%v", err)
+	}
+	if len(tokens) == 0 {
+		return nil, NewBadRequest(ErrInvalidFilter, "empty filter")
+	}
+
+	p := &parser{tokens: tokens}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, NewBadRequest(ErrInvalidFilter, "%v", err)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, NewBadRequest(ErrInvalidFilter, "unexpected %q", p.tokens[p.pos].text)
+	}
+	return expr, nil
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) keyword(word string) bool {
+	t, ok := p.peek()
+	if ok && t.kind == tokenWord && strings.EqualFold(t.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	t, ok := p.peek()
+	if !ok {
+		return fmt.Errorf("expected %q but the filter ended", text)
+	}
+	if t.kind != kind {
+		return fmt.Errorf("expected %q but got %q", text, t.text)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &logicalExpr{and: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if !p.keyword("not") {
+		return p.parseAtom()
+	}
+	if err := p.expect(tokenLParen, "("); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(tokenRParen, ")"); err != nil {
+		return nil, err
+	}
+	return &notExpr{expr: expr}, nil
+}
+
+func (p *parser) parseAtom() (Expr, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+
+	if t.kind == tokenLParen {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected attribute but got %q", t.text)
+	}
+	p.pos++
+	attr := attrPath(t.text)
+
+	if next, ok := p.peek(); ok && next.kind == tokenLBracket {
+		p.pos++
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRBracket, "]"); err != nil {
+			return nil, err
+		}
+		return &valuePathExpr{attr: attr, expr: expr}, nil
+	}
+
+	opToken, ok := p.peek()
+	if !ok || opToken.kind != tokenWord {
+		return nil, fmt.Errorf("expected operator after %q", t.text)
+	}
+	p.pos++
+	op := strings.ToLower(opToken.text)
+	if op == "pr" {
+		return &presentExpr{attr: attr}, nil
+	}
+	if !compareOperators[op] {
+		return nil, fmt.Errorf("unsupported operator %q", opToken.text)
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	return &compareExpr{attr: attr, op: op, value: value}, nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("expected value but the filter ended")
+	}
+	p.pos++
+
+	if t.kind == tokenString {
+		return t.text, nil
+	}
+	if t.kind != tokenWord {
+		return nil, fmt.Errorf("expected value but got %q", t.text)
+	}
+	switch strings.ToLower(t.text) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if f, err := strconv.ParseFloat(t.text, 64); err == nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("invalid value %q", t.text)
+}
@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testUser = `{
+	"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+	"id": "usr-1",
+	"userName": "Jane",
+	"displayName": "Jane Doe",
+	"active": true,
+	"name": {"givenName": "Jane", "familyName": "Doe"},
+	"emails": [
+		{"value": "jane@example.com", "type": "work", "primary": true},
+		{"value": "jane@home.example.com", "type": "home"}
+	],
+	"meta": {"version": "W/\"3\""}
+}`
+
+func testResource(t *testing.T, data string) map[string]interface{} {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestParseFilter(t *testing.T) {
+	resource := testResource(t, testUser)
+	tests := []struct {
+		filter string
+		want   bool
+	}{
+		{`userName eq "jane"`, true},
+		{`USERNAME Eq "JANE"`, true},
+		{`userName eq "john"`, false},
+		{`userName ne "john"`, true},
+		{`urn:ietf:params:scim:schemas:core:2.0:User:userName eq "jane"`, true},
+		{`displayName co "doe"`, true},
+		{`displayName sw "jane"`, true},
+		{`displayName ew "jane"`, false},
+		{`name.familyName eq "Doe"`, true},
+		{`emails eq "jane@home.example.com"`, true},
+		{`emails.value ew "@example.com"`, true},
+		{`emails[type eq "work" and value co "jane"]`, true},
+		{`emails[type eq "other"]`, false},
+		{`active eq true`, true},
+		{`active eq false`, false},
+		{`title pr`, false},
+		{`name pr and not (userName eq "john")`, true},
+		{`userName eq "john" or displayName gt "A"`, true},
+		{`(userName eq "john" or userName eq "bob") and active eq true`, false},
+		{`title eq null`, true},
+		{`displayName eq "Jane \"JD\" Doe"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			expr, err := ParseFilter(tt.filter)
+			if err != nil {
+				t.Fatalf("ParseFilter() error = %v", err)
+			}
+			if got := expr.Match(resource); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	for _, filter := range []string{
+		``,
+		`userName`,
+		`userName xx "jane"`,
+		`userName eq`,
+		`userName eq "jane`,
+		`(userName eq "jane"`,
+		`emails[type eq "work"`,
+		`userName eq "jane" extra`,
+		`not userName eq "jane"`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			_, err := ParseFilter(filter)
+			if err == nil {
+				t.Fatalf("ParseFilter() expected an error")
+			}
+			if err.(*Error).ScimType != ErrInvalidFilter {
+				t.Errorf("ParseFilter() scimType = %v, want %v", err.(*Error).ScimType, ErrInvalidFilter)
+			}
+		})
+	}
+}
+
+func TestEquality(t *testing.T) {
+	expr, _ := ParseFilter(`userName eq "jane"`)
+	if value, ok := Equality(expr, "username"); !ok || value != "jane" {
+		t.Errorf("Equality() = %v, %v, want jane, true", value, ok)
+	}
+	expr, _ = ParseFilter(`userName eq "jane" and active eq true`)
+	if _, ok := Equality(expr, "userName"); ok {
+		t.Errorf("Equality() of a compound filter must not match")
+	}
+}
@@ -0,0 +1,337 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Path is the target of a patch operation, in the form of "attr",
+// "attr.sub", "attr[filter]" or "attr[filter].sub".
+type Path struct {
+	Attr   string
+	Filter Expr
+	Sub    string
+}
+
+// ParsePath parses the path of a patch operation.
+func ParsePath(path string) (*Path, error) {
+	path = trimSchema(strings.TrimSpace(path))
+	if path == "" {
+		return nil, NewBadRequest(ErrInvalidPath, "empty path")
+	}
+
+	open := strings.Index(path, "[")
+	if open < 0 {
+		parts := strings.SplitN(path, ".", 2)
+		p := &Path{Attr: parts[0]}
+		if len(parts) == 2 {
+			p.Sub = parts[1]
+		}
+		return p, nil
+	}
+
+	end := strings.LastIndex(path, "]")
+	if end < open || open == 0 {
+		return nil, NewBadRequest(ErrInvalidPath, "invalid path %q", path)
+	}
+	filter, err := ParseFilter(path[open+1 : end])
+	if err != nil {
+		return nil, NewBadRequest(ErrInvalidPath, "invalid filter of path %q: %v", path, err)
+	}
+	p := &Path{Attr: path[:open], Filter: filter}
+	rest := path[end+1:]
+	switch {
+	case rest == "":
+	case strings.HasPrefix(rest, ".") && len(rest) > 1:
+		p.Sub = rest[1:]
+	default:
+		return nil, NewBadRequest(ErrInvalidPath, "invalid path %q", path)
+	}
+	return p, nil
+}
+
+// ToMap converts the resource to its json object form.
+func ToMap(resource interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FromMap converts the json object form back to the resource.
+func FromMap(m map[string]interface{}, resource interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, resource); err != nil {
+		return NewBadRequest(ErrInvalidValue, "%v", err)
+	}
+	return nil
+}
+
+// ApplyPatch applies the operations to the resource in its json object form,
+// see RFC 7644 section 3.5.2.
+func ApplyPatch(resource map[string]interface{}, operations []PatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		switch op {
+		case "add", "replace", "remove":
+		default:
+			return NewBadRequest(ErrInvalidSyntax, "unsupported patch operation %q", operation.Op)
+		}
+
+		var value interface{}
+		if len(operation.Value) > 0 {
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return NewBadRequest(ErrInvalidSyntax, "invalid value of patch operation: %v", err)
+			}
+		}
+
+		if operation.Path == "" {
+			if op == "remove" {
+				return NewBadRequest(ErrNoTarget, "path is required for remove operations")
+			}
+			obj, ok := value.(map[string]interface{})
+			if !ok {
+				return NewBadRequest(ErrInvalidValue, "value must be an object when path is not specified")
+			}
+			keys := make([]string, 0, len(obj))
+			for k := range obj {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				path, err := ParsePath(k)
+				if err != nil {
+					return err
+				}
+				if err := apply(resource, op, path, obj[k]); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		path, err := ParsePath(operation.Path)
+		if err != nil {
+			return err
+		}
+		if err := apply(resource, op, path, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func apply(resource map[string]interface{}, op string, path *Path, value interface{}) error {
+	current, key, exists := lookup(resource, path.Attr)
+
+	if path.Filter != nil {
+		return applyFiltered(resource, key, current, op, path, value)
+	}
+
+	if path.Sub != "" {
+		switch t := current.(type) {
+		case []interface{}:
+			for _, item := range t {
+				if m, ok := item.(map[string]interface{}); ok {
+					setOrDelete(m, op, path.Sub, value)
+				}
+			}
+		case map[string]interface{}:
+			setOrDelete(t, op, path.Sub, value)
+		default:
+			if op != "remove" {
+				m := make(map[string]interface{})
+				setOrDelete(m, op, path.Sub, value)
+				resource[key] = m
+			}
+		}
+		return nil
+	}
+
+	switch op {
+	case "remove":
+		if !exists {
+			return nil
+		}
+		if items, ok := current.([]interface{}); ok && value != nil {
+			resource[key] = removeValues(items, value)
+			return nil
+		}
+		delete(resource, key)
+	case "add":
+		switch t := current.(type) {
+		case []interface{}:
+			resource[key] = appendValues(t, value)
+		case map[string]interface{}:
+			if m, ok := value.(map[string]interface{}); ok {
+				merge(t, m)
+				return nil
+			}
+			resource[key] = value
+		default:
+			resource[key] = value
+		}
+	case "replace":
+		if t, ok := current.(map[string]interface{}); ok {
+			if m, ok := value.(map[string]interface{}); ok {
+				merge(t, m)
+				return nil
+			}
+		}
+		resource[key] = value
+	}
+	return nil
+}
+
+func applyFiltered(resource map[string]interface{}, key string, current interface{}, op string, path *Path, value interface{}) error {
+	items, _ := current.([]interface{})
+	kept := make([]interface{}, 0, len(items))
+	matched := false
+	for _, item := range items {
+		m, ok := item.(map[string]interface{})
+		if !ok || !path.Filter.Match(m) {
+			kept = append(kept, item)
+			continue
+		}
+		matched = true
+		switch {
+		case op == "remove" && path.Sub == "":
+			continue
+		case path.Sub != "":
+			setOrDelete(m, op, path.Sub, value)
+		default:
+			if v, ok := value.(map[string]interface{}); ok {
+				merge(m, v)
+			}
+		}
+		kept = append(kept, m)
+	}
+
+	if !matched {
+		if op == "remove" {
+			return nil
+		}
+		seed, ok := seedFromFilter(path.Filter)
+		if !ok {
+			return NewBadRequest(ErrNoTarget, "no values of %q match the filter", path.Attr)
+		}
+		if path.Sub != "" {
+			setOrDelete(seed, op, path.Sub, value)
+		} else if v, ok := value.(map[string]interface{}); ok {
+			merge(seed, v)
+		}
+		kept = append(kept, seed)
+	}
+
+	resource[key] = kept
+	return nil
+}
+
+// seedFromFilter returns a new value of the multi-valued attribute for a
+// simple equality filter, such as `emails[type eq "work"]`.
+func seedFromFilter(filter Expr) (map[string]interface{}, bool) {
+	e, ok := filter.(*compareExpr)
+	if !ok || e.op != "eq" || len(e.attr) != 1 || e.value == nil {
+		return nil, false
+	}
+	return map[string]interface{}{e.attr[0]: e.value}, true
+}
+
+func setOrDelete(m map[string]interface{}, op string, attr string, value interface{}) {
+	_, key, _ := lookup(m, attr)
+	if op == "remove" {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
+
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		_, key, _ := lookup(dst, k)
+		dst[key] = v
+	}
+}
+
+func appendValues(items []interface{}, value interface{}) []interface{} {
+	for _, v := range asList(value) {
+		duplicated := false
+		for _, item := range items {
+			if sameValue(item, v) {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+func removeValues(items []interface{}, value interface{}) []interface{} {
+	values := asList(value)
+	kept := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		removed := false
+		for _, v := range values {
+			if sameValue(item, v) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+func asList(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	return []interface{}{value}
+}
+
+// sameValue returns whether two values of a multi-valued attribute are the
+// same, complex values are compared by their "value" sub-attribute.
+func sameValue(a, b interface{}) bool {
+	return fmt.Sprint(primitive(a)) == fmt.Sprint(primitive(b))
+}
+
+func primitive(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if value, _, ok := lookup(m, "value"); ok {
+			return value
+		}
+	}
+	return v
+}
@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations string
+		check      func(t *testing.T, resource map[string]interface{})
+	}{
+		{
+			name:       "replace simple attribute",
+			operations: `[{"op": "Replace", "path": "displayName", "value": "J. Doe"}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				if resource["displayName"] != "J. Doe" {
+					t.Errorf("displayName = %v", resource["displayName"])
+				}
+			},
+		},
+		{
+			name:       "replace without path",
+			operations: `[{"op": "replace", "value": {"active": "False", "name.givenName": "Janet"}}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				var user User
+				if err := FromMap(resource, &user); err != nil {
+					t.Fatal(err)
+				}
+				if user.IsActive() {
+					t.Errorf("active = true, want false")
+				}
+				if user.Name.GivenName != "Janet" || user.Name.FamilyName != "Doe" {
+					t.Errorf("name = %v", user.Name)
+				}
+			},
+		},
+		{
+			name:       "replace sub attribute with filter",
+			operations: `[{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "jd@example.com"}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				var user User
+				if err := FromMap(resource, &user); err != nil {
+					t.Fatal(err)
+				}
+				if PrimaryValue(user.Emails) != "jd@example.com" || len(user.Emails) != 2 {
+					t.Errorf("emails = %v", user.Emails)
+				}
+			},
+		},
+		{
+			name:       "replace unmatched filter adds value",
+			operations: `[{"op": "replace", "path": "phoneNumbers[type eq \"work\"].value", "value": "123"}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				want := []interface{}{map[string]interface{}{"type": "work", "value": "123"}}
+				if !reflect.DeepEqual(resource["phoneNumbers"], want) {
+					t.Errorf("phoneNumbers = %v, want %v", resource["phoneNumbers"], want)
+				}
+			},
+		},
+		{
+			name:       "add members deduplicated",
+			operations: `[{"op": "add", "path": "members", "value": [{"value": "u1"}, {"value": "u3"}]}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				if got := values(resource["members"]); !reflect.DeepEqual(got, []string{"u1", "u2", "u3"}) {
+					t.Errorf("members = %v", got)
+				}
+			},
+		},
+		{
+			name:       "remove member by filter",
+			operations: `[{"op": "remove", "path": "members[value eq \"u1\"]"}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				if got := values(resource["members"]); !reflect.DeepEqual(got, []string{"u2"}) {
+					t.Errorf("members = %v", got)
+				}
+			},
+		},
+		{
+			name:       "remove member by value",
+			operations: `[{"op": "remove", "path": "members", "value": [{"value": "u2"}]}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				if got := values(resource["members"]); !reflect.DeepEqual(got, []string{"u1"}) {
+					t.Errorf("members = %v", got)
+				}
+			},
+		},
+		{
+			name:       "remove attribute",
+			operations: `[{"op": "remove", "path": "members"}, {"op": "remove", "path": "title"}]`,
+			check: func(t *testing.T, resource map[string]interface{}) {
+				if _, ok := resource["members"]; ok {
+					t.Errorf("members is not removed")
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := testResource(t, testUser)
+			resource["members"] = []interface{}{
+				map[string]interface{}{"value": "u1"},
+				map[string]interface{}{"value": "u2"},
+			}
+			var operations []PatchOperation
+			if err := json.Unmarshal([]byte(tt.operations), &operations); err != nil {
+				t.Fatal(err)
+			}
+			if err := ApplyPatch(resource, operations); err != nil {
+				t.Fatalf("ApplyPatch() error = %v", err)
+			}
+			tt.check(t, resource)
+		})
+	}
+}
+
+func TestApplyPatchError(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations string
+		scimType   string
+	}{
+		{"unknown operation", `[{"op": "move", "path": "userName"}]`, ErrInvalidSyntax},
+		{"remove without path", `[{"op": "remove"}]`, ErrNoTarget},
+		{"add without path and object", `[{"op": "add", "value": "jane"}]`, ErrInvalidValue},
+		{"invalid path", `[{"op": "add", "path": "emails[type eq]", "value": "x"}]`, ErrInvalidPath},
+		{"unmatched complex filter", `[{"op": "replace", "path": "emails[type pr].value", "value": "x"}]`, ErrNoTarget},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := testResource(t, `{"userName": "jane"}`)
+			var operations []PatchOperation
+			if err := json.Unmarshal([]byte(tt.operations), &operations); err != nil {
+				t.Fatal(err)
+			}
+			err := ApplyPatch(resource, operations)
+			if err == nil {
+				t.Fatalf("ApplyPatch() expected an error")
+			}
+			if got := err.(*Error).ScimType; got != tt.scimType {
+				t.Errorf("ApplyPatch() scimType = %v, want %v", got, tt.scimType)
+			}
+		})
+	}
+}
+
+func values(v interface{}) []string {
+	var result []string
+	for _, item := range v.([]interface{}) {
+		result = append(result, item.(map[string]interface{})["value"].(string))
+	}
+	return result
+}
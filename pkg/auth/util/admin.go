@@ -26,6 +26,10 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 )
 
+// IsPlatformAdmin returns whether the user is an administrator of the given
+// tenant, listed in the administrators of the identity provider or bound to
+// the administrator policy of the tenant. Administrators of other tenants are
+// not administrators of the tenant.
 func IsPlatformAdmin(ctx context.Context, username string, tenantID string, authClient authinternalclient.AuthInterface,
 	enforcer *casbin.SyncedEnforcer) (bool, error) {
 	idp, err := authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
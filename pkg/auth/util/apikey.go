@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package util
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/authorization/condition"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// APIKeyExtraKey is the key of the user extra carrying the name of the api key
+// the user authenticated with.
+const APIKeyExtraKey = "apikey"
+
+// apiKeyUsageInterval is the interval the last usage of an api key is
+// recorded at most once in, unless it is used from another address.
+const apiKeyUsageInterval = time.Minute
+
+// APIKeyRetired returns whether the api key is rotated and its overlap window
+// has ended.
+func APIKeyRetired(apiKey *auth.APIKey, now time.Time) bool {
+	return apiKey.Status.Successor != "" && !apiKey.Status.RetireAt.IsZero() && !now.Before(apiKey.Status.RetireAt.Time)
+}
+
+// CheckAPIKeySourceIP returns an error if the api key may not be used from the
+// ip, which is the case if the key is restricted to some addresses and the ip
+// is unknown.
+func CheckAPIKeySourceIP(apiKey *auth.APIKey, ip net.IP) error {
+	if len(apiKey.Spec.SourceIPs) == 0 {
+		return nil
+	}
+	if ip == nil {
+		return fmt.Errorf("api key %s is restricted to %v, but the source ip is unknown", apiKey.Name, apiKey.Spec.SourceIPs)
+	}
+	if !condition.MatchSourceIP(apiKey.Spec.SourceIPs, ip) {
+		return fmt.Errorf("api key %s is not allowed to be used from %s", apiKey.Name, ip)
+	}
+	return nil
+}
+
+// CheckAPIKeyScope returns an error if the action on the resource in the
+// project is out of the scope of the api key.
+func CheckAPIKeyScope(apiKey *auth.APIKey, action, resource, projectID string) error {
+	scope := apiKey.Spec.Scope
+	if scope == nil {
+		return nil
+	}
+	if len(scope.Actions) != 0 && !matchAny(action, scope.Actions) {
+		return fmt.Errorf("action %s is out of the scope of api key %s", action, apiKey.Name)
+	}
+	if len(scope.Resources) != 0 && !matchAny(resource, scope.Resources) {
+		return fmt.Errorf("resource %s is out of the scope of api key %s", resource, apiKey.Name)
+	}
+	if len(scope.Projects) != 0 && (projectID == "" || !matchAny(projectID, scope.Projects)) {
+		return fmt.Errorf("project %q is out of the scope of api key %s", projectID, apiKey.Name)
+	}
+	return nil
+}
+
+func matchAny(key string, patterns []string) bool {
+	for _, p := range patterns {
+		if KeyMatchCustom(key, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordAPIKeyUsage records the time and the source ip the api key is used at,
+// the errors are logged only since they must not fail the authentication.
+func RecordAPIKeyUsage(ctx context.Context, authClient authinternalclient.AuthInterface, apiKey *auth.APIKey, ip net.IP, now time.Time) {
+	lastUsedIP := apiKey.Status.LastUsedIP
+	if ip != nil {
+		lastUsedIP = ip.String()
+	}
+	if lastUsedIP == apiKey.Status.LastUsedIP && now.Sub(apiKey.Status.LastUsedTime.Time) < apiKeyUsageInterval {
+		return
+	}
+
+	apiKey.Status.LastUsedTime = metav1.NewTime(now)
+	apiKey.Status.LastUsedIP = lastUsedIP
+	if _, err := authClient.APIKeys().UpdateStatus(ctx, apiKey, metav1.UpdateOptions{}); err != nil {
+		log.Warn("Failed to record usage of api key", log.String("apiKey", apiKey.Name), log.Err(err))
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package util
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func TestCheckAPIKeyScope(t *testing.T) {
+	apiKey := &auth.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "apk-test"},
+		Spec: auth.APIKeySpec{
+			Scope: &auth.APIKeyScope{
+				Actions:   []string{"get*", "listClusters"},
+				Resources: []string{"cluster:cls-a*"},
+				Projects:  []string{"prj-test"},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		action    string
+		resource  string
+		projectID string
+		wantErr   bool
+	}{
+		{"in scope", "getCluster", "cluster:cls-abc", "prj-test", false},
+		{"case insensitive", "ListClusters", "cluster:cls-abc", "prj-test", false},
+		{"action out of scope", "deleteCluster", "cluster:cls-abc", "prj-test", true},
+		{"resource out of scope", "getCluster", "cluster:cls-b", "prj-test", true},
+		{"project out of scope", "getCluster", "cluster:cls-abc", "prj-other", true},
+		{"no project", "getCluster", "cluster:cls-abc", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAPIKeyScope(apiKey, tt.action, tt.resource, tt.projectID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckAPIKeyScope() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := CheckAPIKeyScope(&auth.APIKey{}, "deleteCluster", "cluster:cls-b", ""); err != nil {
+		t.Errorf("CheckAPIKeyScope() of unrestricted api key error = %v", err)
+	}
+}
+
+func TestCheckAPIKeySourceIP(t *testing.T) {
+	apiKey := &auth.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "apk-test"},
+		Spec: auth.APIKeySpec{
+			SourceIPs: []string{"10.0.0.0/8", "192.168.1.1"},
+		},
+	}
+	tests := []struct {
+		name    string
+		ip      net.IP
+		wantErr bool
+	}{
+		{"in cidr", net.ParseIP("10.1.2.3"), false},
+		{"address", net.ParseIP("192.168.1.1"), false},
+		{"out of list", net.ParseIP("192.168.1.2"), true},
+		{"unknown", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAPIKeySourceIP(apiKey, tt.ip)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckAPIKeySourceIP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := CheckAPIKeySourceIP(&auth.APIKey{}, nil); err != nil {
+		t.Errorf("CheckAPIKeySourceIP() of unrestricted api key error = %v", err)
+	}
+}
+
+func TestAPIKeyRetired(t *testing.T) {
+	now := time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC)
+	apiKey := &auth.APIKey{}
+	if APIKeyRetired(apiKey, now) {
+		t.Errorf("APIKeyRetired() of api key not rotated = true")
+	}
+
+	apiKey.Status.Successor = "apk-successor"
+	apiKey.Status.RetireAt = metav1.NewTime(now.Add(time.Hour))
+	if APIKeyRetired(apiKey, now) {
+		t.Errorf("APIKeyRetired() within the overlap window = true")
+	}
+	if !APIKeyRetired(apiKey, now.Add(time.Hour)) {
+		t.Errorf("APIKeyRetired() after the overlap window = false")
+	}
+}
@@ -146,6 +146,42 @@ func FilterGroup(ctx context.Context, group *auth.LocalGroup) error {
 	return nil
 }
 
+// FilterFederatedIdentity is used to filter federated identity that do not belong to the tenant.
+func FilterFederatedIdentity(ctx context.Context, identity *auth.FederatedIdentity) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if identity.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("federatedIdentity"), identity.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterMultiFactorEnrollment is used to filter multi-factor enrollment that do not belong to the tenant.
+func FilterMultiFactorEnrollment(ctx context.Context, enrollment *auth.MultiFactorEnrollment) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if enrollment.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("multiFactorEnrollment"), enrollment.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterAccessRequest is used to filter access request that do not belong to the tenant.
+func FilterAccessRequest(ctx context.Context, request *auth.AccessRequest) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if request.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("accessRequest"), request.ObjectMeta.Name)
+	}
+	return nil
+}
+
 // PredicateUserNameListOptions determines the query options according to the username
 // attribute of the request user.
 func PredicateUserNameListOptions(ctx context.Context, options *metainternal.ListOptions) *metainternal.ListOptions {
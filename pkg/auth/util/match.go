@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"regexp"
+	"strings"
+
+	casbinutil "github.com/casbin/casbin/v2/util"
+)
+
+var keyMatchPattern = regexp.MustCompile(`(.*):[^/]+(.*)`)
+
+// KeyMatchCustom determines whether key1 matches the pattern of key2 , key2 can contain a * and :*.
+// For example, "/project:123/cluster:456" matches "/project:*/cluster:456", "registry:123/*" matches "registry:123/456"
+func KeyMatchCustom(key1 string, key2 string) bool {
+	// case insensitive
+	key1 = strings.ToLower(key1)
+	key2 = strings.ToLower(key2)
+
+	key2 = strings.Replace(key2, "*", ".*", -1)
+
+	for {
+		if !strings.Contains(key2, "/:") {
+			break
+		}
+
+		key2 = keyMatchPattern.ReplaceAllString(key2, "$1[^/]+$2")
+	}
+
+	return casbinutil.RegexMatch(key1, "^"+key2+"$")
+}
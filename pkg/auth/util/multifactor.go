@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package util
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/authentication/mfa"
+)
+
+// MultiFactorRequired returns whether the local identity must log in with a
+// second factor, together with its multi-factor enrollment, which is nil if
+// it has not enrolled any. The second factor is required if the local
+// identity has enrolled one, or the multi-factor policy of the tenant applies
+// to it.
+func MultiFactorRequired(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity) (*auth.MultiFactorEnrollment, bool, error) {
+	enrollment, err := authClient.MultiFactorEnrollments().Get(ctx, localIdentity.ObjectMeta.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, false, err
+		}
+		enrollment = nil
+	}
+	if enrollment != nil && mfa.Enrolled(enrollment) {
+		return enrollment, true, nil
+	}
+
+	required, err := multiFactorPolicyApplies(ctx, authClient, localIdentity)
+	return enrollment, required, err
+}
+
+func multiFactorPolicyApplies(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity) (bool, error) {
+	idp, err := authClient.IdentityProviders().Get(ctx, localIdentity.Spec.TenantID, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	policy := idp.Spec.MultiFactor
+	if policy == nil {
+		return false, nil
+	}
+
+	if len(policy.Groups) != 0 {
+		groups, err := GetGroupsForUser(ctx, authClient, localIdentity.ObjectMeta.Name)
+		if err != nil {
+			return false, err
+		}
+		required := sets.NewString(policy.Groups...)
+		for _, g := range groups.Items {
+			if required.Has(g.ObjectMeta.Name) {
+				return true, nil
+			}
+		}
+	}
+
+	if len(policy.Roles) != 0 {
+		roles, err := GetRolesForUser(ctx, authClient, localIdentity.ObjectMeta.Name)
+		if err != nil {
+			return false, err
+		}
+		required := sets.NewString(policy.Roles...)
+		for _, r := range roles.Items {
+			if required.Has(r.ObjectMeta.Name) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -19,10 +19,17 @@
 package util
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/authentication/passwordpolicy"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -64,3 +71,47 @@ func VerifyDecodedPassword(decodedPasswd string, bcryptedPasswd string) error {
 
 	return bcrypt.CompareHashAndPassword(hashBytes, decodedBytes)
 }
+
+// IsBcryptPassword returns whether the base64 string is a bcrypted password.
+func IsBcryptPassword(password string) bool {
+	hashBytes, err := base64.StdEncoding.DecodeString(password)
+	if err != nil {
+		return false
+	}
+	_, err = bcrypt.Cost(hashBytes)
+	return err == nil
+}
+
+// GetPasswordPolicy returns the password policy of the tenant, nil means
+// no policy is configured.
+func GetPasswordPolicy(ctx context.Context, authClient authinternalclient.AuthInterface, tenantID string) (*auth.PasswordPolicy, error) {
+	idp, err := authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return idp.Spec.PasswordPolicy, nil
+}
+
+// RecordLoginFailure counts a failed login of the local identity, which is
+// locked out when the lockout threshold of the password policy is reached.
+func RecordLoginFailure(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity, policy *auth.PasswordPolicy) {
+	if passwordpolicy.RecordFailure(policy, &localIdentity.Status, time.Now()) {
+		log.Warn("User is locked out after too many failed logins", log.String("tenantID", localIdentity.Spec.TenantID), log.String("username", localIdentity.Spec.Username), log.Int32("failedLoginCount", localIdentity.Status.FailedLoginCount))
+	}
+	if _, err := authClient.LocalIdentities().UpdateStatus(ctx, localIdentity, metav1.UpdateOptions{}); err != nil {
+		log.Error("Failed to record failed login", log.String("localIdentity", localIdentity.ObjectMeta.Name), log.Err(err))
+	}
+}
+
+// RecordLoginSuccess resets the failed logins of the local identity.
+func RecordLoginSuccess(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity) {
+	if !passwordpolicy.RecordSuccess(&localIdentity.Status) {
+		return
+	}
+	if _, err := authClient.LocalIdentities().UpdateStatus(ctx, localIdentity, metav1.UpdateOptions{}); err != nil {
+		log.Error("Failed to reset failed logins", log.String("localIdentity", localIdentity.ObjectMeta.Name), log.Err(err))
+	}
+}
@@ -105,6 +105,16 @@ func GetGroupsForUser(ctx context.Context, authClient authinternalclient.AuthInt
 	return groupList, err
 }
 
+func GetRolesForUser(ctx context.Context, authClient authinternalclient.AuthInterface, userID string) (auth.RoleList, error) {
+	roleList := auth.RoleList{}
+	err := authClient.RESTClient().Get().
+		Resource("localidentities").
+		Name(userID).
+		SubResource("roles").Do(ctx).Into(&roleList)
+
+	return roleList, err
+}
+
 func ParseTenantAndName(str string) (string, string) {
 	parts := strings.Split(str, "##")
 	if len(parts) > 1 {
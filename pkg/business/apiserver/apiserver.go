@@ -26,6 +26,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	restclient "k8s.io/client-go/rest"
 	"tkestack.io/tke/api/business"
 	businessv1 "tkestack.io/tke/api/business/v1"
 	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
@@ -35,6 +36,7 @@ import (
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-business-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	"tkestack.io/tke/pkg/business/billing"
 	businessrest "tkestack.io/tke/pkg/business/registry/rest"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -48,8 +50,11 @@ type ExtraConfig struct {
 	PlatformClient          platformversionedclient.PlatformV1Interface
 	RegistryClient          registryversionedclient.RegistryV1Interface
 	AuthClient              authversionedclient.AuthV1Interface
+	AuthClientConfig        *restclient.Config
 	PrivilegedUsername      string
 	FeatureOptions          *options.FeatureOptions
+	BillingStore            billing.Store
+	PriceSheet              *billing.PriceSheet
 }
 
 // Config contains the core configuration instance of apiserver and
@@ -104,8 +109,11 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 			PlatformClient:       c.ExtraConfig.PlatformClient,
 			RegistryClient:       c.ExtraConfig.RegistryClient,
 			AuthClient:           c.ExtraConfig.AuthClient,
+			AuthClientConfig:     c.ExtraConfig.AuthClientConfig,
 			PrivilegedUsername:   c.ExtraConfig.PrivilegedUsername,
 			Features:             c.ExtraConfig.FeatureOptions,
+			BillingStore:         c.ExtraConfig.BillingStore,
+			PriceSheet:           c.ExtraConfig.PriceSheet,
 		},
 	}
 	m.InstallAPIs(c.ExtraConfig, c.GenericConfig.RESTOptionsGetter, restStorageProviders...)
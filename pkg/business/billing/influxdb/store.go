@@ -0,0 +1,193 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package influxdb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	influxclient "github.com/influxdata/influxdb1-client/v2"
+	"tkestack.io/tke/pkg/business/billing"
+)
+
+const (
+	measurement = "namespace_usage"
+	timeout     = 30 * time.Second
+
+	tagTenantID  = "tenant_id"
+	tagProject   = "project"
+	tagNamespace = "namespace"
+	tagCluster   = "cluster"
+
+	fieldCPU     = "cpu"
+	fieldMemory  = "memory"
+	fieldGPU     = "gpu"
+	fieldStorage = "storage"
+	fieldPeriod  = "period"
+)
+
+// Store saves the usage history of namespaces into InfluxDB, one series per
+// namespace with the period in seconds saved along the usage.
+type Store struct {
+	client   influxclient.Client
+	database string
+}
+
+var _ billing.Store = &Store{}
+
+// NewStore creates the usage history store, creating the database if it
+// does not exist.
+func NewStore(opts *billing.Options) (*Store, error) {
+	client, err := influxclient.NewHTTPClient(influxclient.HTTPConfig{
+		Addr:      opts.InfluxDBAddress,
+		Username:  opts.InfluxDBUsername,
+		Password:  opts.InfluxDBPassword,
+		UserAgent: "tke-business",
+		Timeout:   timeout,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{client: client, database: opts.InfluxDBDatabase}
+	if err := s.exec(fmt.Sprintf("CREATE DATABASE %q", s.database)); err != nil {
+		return nil, fmt.Errorf("failed to create database %s: %v", s.database, err)
+	}
+	return s, nil
+}
+
+// Write saves the usage samples.
+func (s *Store) Write(samples []billing.Sample) error {
+	points, err := influxclient.NewBatchPoints(influxclient.BatchPointsConfig{
+		Database:  s.database,
+		Precision: "s",
+	})
+	if err != nil {
+		return err
+	}
+	for _, sample := range samples {
+		point, err := influxclient.NewPoint(measurement,
+			map[string]string{
+				tagTenantID:  sample.TenantID,
+				tagProject:   sample.Project,
+				tagNamespace: sample.Namespace,
+				tagCluster:   sample.Cluster,
+			},
+			map[string]interface{}{
+				fieldCPU:     sample.Usage.CPU,
+				fieldMemory:  sample.Usage.Memory,
+				fieldGPU:     sample.Usage.GPU,
+				fieldStorage: sample.Usage.Storage,
+				fieldPeriod:  sample.Period.Seconds(),
+			},
+			sample.Time)
+		if err != nil {
+			return err
+		}
+		points.AddPoint(point)
+	}
+	return s.client.Write(points)
+}
+
+// Query returns the usage samples of the given projects of the tenant taken
+// in [start, end).
+func (s *Store) Query(tenantID string, projects []string, start, end time.Time) ([]billing.Sample, error) {
+	if len(projects) == 0 {
+		return nil, nil
+	}
+	conditions := make([]string, 0, len(projects))
+	for _, project := range projects {
+		conditions = append(conditions, fmt.Sprintf("%q = %s", tagProject, quote(project)))
+	}
+	command := fmt.Sprintf("SELECT %q, %q, %q, %q, %q FROM %q WHERE %q = %s AND (%s) AND time >= %ds AND time < %ds GROUP BY %q, %q, %q, %q",
+		fieldCPU, fieldMemory, fieldGPU, fieldStorage, fieldPeriod, measurement,
+		tagTenantID, quote(tenantID), strings.Join(conditions, " OR "), start.Unix(), end.Unix(),
+		tagTenantID, tagProject, tagNamespace, tagCluster)
+	response, err := s.client.Query(influxclient.NewQuery(command, s.database, "s"))
+	if err != nil {
+		return nil, err
+	}
+	if err := response.Error(); err != nil {
+		return nil, err
+	}
+
+	var samples []billing.Sample
+	for _, result := range response.Results {
+		for _, row := range result.Series {
+			for _, values := range row.Values {
+				sample := billing.Sample{
+					TenantID:  row.Tags[tagTenantID],
+					Project:   row.Tags[tagProject],
+					Namespace: row.Tags[tagNamespace],
+					Cluster:   row.Tags[tagCluster],
+				}
+				for i, column := range row.Columns {
+					if i >= len(values) {
+						break
+					}
+					value := number(values[i])
+					switch column {
+					case "time":
+						sample.Time = time.Unix(int64(value), 0)
+					case fieldCPU:
+						sample.Usage.CPU = value
+					case fieldMemory:
+						sample.Usage.Memory = value
+					case fieldGPU:
+						sample.Usage.GPU = value
+					case fieldStorage:
+						sample.Usage.Storage = value
+					case fieldPeriod:
+						sample.Period = time.Duration(value * float64(time.Second))
+					}
+				}
+				samples = append(samples, sample)
+			}
+		}
+	}
+	return samples, nil
+}
+
+func (s *Store) exec(command string) error {
+	response, err := s.client.Query(influxclient.NewQuery(command, "", ""))
+	if err != nil {
+		return err
+	}
+	return response.Error()
+}
+
+// quote returns the string literal of value in InfluxQL.
+func quote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+// number returns the value of a column, the client decodes numbers as
+// json.Number.
+func number(value interface{}) float64 {
+	switch v := value.(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case float64:
+		return v
+	default:
+		return 0
+	}
+}
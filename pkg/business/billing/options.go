@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package billing
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagInfluxDBAddress  = "billing-influxdb-address"
+	flagInfluxDBUsername = "billing-influxdb-username"
+	flagInfluxDBPassword = "billing-influxdb-password"
+	flagInfluxDBDatabase = "billing-influxdb-database"
+	flagPriceSheet       = "billing-price-sheet"
+)
+
+const (
+	configInfluxDBAddress  = "billing.influxdb_address"
+	configInfluxDBUsername = "billing.influxdb_username"
+	configInfluxDBPassword = "billing.influxdb_password"
+	configInfluxDBDatabase = "billing.influxdb_database"
+	configPriceSheet       = "billing.price_sheet"
+)
+
+const defaultInfluxDBDatabase = "tke_billing"
+
+// Options holds the options of the usage history store, and of the price
+// sheet if the component renders billing reports.
+type Options struct {
+	InfluxDBAddress  string
+	InfluxDBUsername string
+	InfluxDBPassword string
+	InfluxDBDatabase string
+	PriceSheetFile   string
+
+	withPriceSheet bool
+}
+
+// NewOptions creates the default Options object. The price sheet flag is only
+// added if withPriceSheet is true.
+func NewOptions(withPriceSheet bool) *Options {
+	return &Options{
+		InfluxDBDatabase: defaultInfluxDBDatabase,
+		withPriceSheet:   withPriceSheet,
+	}
+}
+
+// AddFlags adds flags related to billing to the specified FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.String(flagInfluxDBAddress, o.InfluxDBAddress,
+		"The address of the InfluxDB storing the usage history of namespaces, billing is disabled if it is empty.")
+	_ = viper.BindPFlag(configInfluxDBAddress, fs.Lookup(flagInfluxDBAddress))
+	fs.String(flagInfluxDBUsername, o.InfluxDBUsername,
+		"The username used to access the InfluxDB storing the usage history.")
+	_ = viper.BindPFlag(configInfluxDBUsername, fs.Lookup(flagInfluxDBUsername))
+	fs.String(flagInfluxDBPassword, o.InfluxDBPassword,
+		"The password used to access the InfluxDB storing the usage history.")
+	_ = viper.BindPFlag(configInfluxDBPassword, fs.Lookup(flagInfluxDBPassword))
+	fs.String(flagInfluxDBDatabase, o.InfluxDBDatabase,
+		"The InfluxDB database storing the usage history.")
+	_ = viper.BindPFlag(configInfluxDBDatabase, fs.Lookup(flagInfluxDBDatabase))
+	if o.withPriceSheet {
+		fs.String(flagPriceSheet, o.PriceSheetFile,
+			"Path to the price sheet file used to render the billing reports of projects.")
+		_ = viper.BindPFlag(configPriceSheet, fs.Lookup(flagPriceSheet))
+	}
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *Options) ApplyFlags() []error {
+	var errs []error
+
+	o.InfluxDBAddress = viper.GetString(configInfluxDBAddress)
+	o.InfluxDBUsername = viper.GetString(configInfluxDBUsername)
+	o.InfluxDBPassword = viper.GetString(configInfluxDBPassword)
+	o.InfluxDBDatabase = viper.GetString(configInfluxDBDatabase)
+	if o.withPriceSheet {
+		o.PriceSheetFile = viper.GetString(configPriceSheet)
+	}
+
+	if o.InfluxDBAddress != "" && o.InfluxDBDatabase == "" {
+		errs = append(errs, fmt.Errorf("--%s must be specified", flagInfluxDBDatabase))
+	}
+	if o.withPriceSheet && o.InfluxDBAddress != "" && o.PriceSheetFile == "" {
+		errs = append(errs, fmt.Errorf("--%s must be specified if --%s is set", flagPriceSheet, flagInfluxDBAddress))
+	}
+	return errs
+}
+
+// Enabled returns true if the usage history store is configured.
+func (o *Options) Enabled() bool {
+	return o.InfluxDBAddress != ""
+}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package billing
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"sigs.k8s.io/yaml"
+)
+
+// PriceSheet is the prices of resources used to render billing reports.
+type PriceSheet struct {
+	// Currency of the prices, such as USD.
+	Currency string `json:"currency"`
+	// Default is the prices of the clusters not found in Clusters.
+	Default Prices `json:"default"`
+	// Clusters overrides the default prices by cluster name.
+	// +optional
+	Clusters map[string]Prices `json:"clusters,omitempty"`
+}
+
+// Prices is the price of an hour of every resource.
+type Prices struct {
+	// CPU is the price of a core hour.
+	CPU float64 `json:"cpu"`
+	// Memory is the price of a GiB hour.
+	Memory float64 `json:"memory"`
+	// GPU is the price of a device hour.
+	GPU float64 `json:"gpu"`
+	// Storage is the price of a GiB hour.
+	Storage float64 `json:"storage"`
+}
+
+// Cost returns the cost of the resource hours.
+func (p Prices) Cost(hours Usage) float64 {
+	return hours.CPU*p.CPU + hours.Memory*p.Memory + hours.GPU*p.GPU + hours.Storage*p.Storage
+}
+
+func (p Prices) validate() error {
+	if p.CPU < 0 || p.Memory < 0 || p.GPU < 0 || p.Storage < 0 {
+		return fmt.Errorf("prices must not be negative")
+	}
+	return nil
+}
+
+// LoadPriceSheet reads the price sheet from a YAML or JSON file.
+func LoadPriceSheet(file string) (*PriceSheet, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	sheet := &PriceSheet{}
+	if err := yaml.UnmarshalStrict(data, sheet); err != nil {
+		return nil, fmt.Errorf("failed to parse price sheet %s: %v", file, err)
+	}
+	if err := sheet.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid price sheet %s: %v", file, err)
+	}
+	return sheet, nil
+}
+
+// Validate tests if the price sheet is well formed.
+func (s *PriceSheet) Validate() error {
+	if s.Currency == "" {
+		return fmt.Errorf("currency must be specified")
+	}
+	if err := s.Default.validate(); err != nil {
+		return fmt.Errorf("default: %v", err)
+	}
+	for cluster, prices := range s.Clusters {
+		if err := prices.validate(); err != nil {
+			return fmt.Errorf("cluster %s: %v", cluster, err)
+		}
+	}
+	return nil
+}
+
+// PricesOf returns the prices of the resources of the cluster.
+func (s *PriceSheet) PricesOf(cluster string) Prices {
+	if prices, ok := s.Clusters[cluster]; ok {
+		return prices
+	}
+	return s.Default
+}
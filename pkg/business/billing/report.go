@@ -0,0 +1,198 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package billing
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Report formats.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// Kinds of the rows of CSV reports.
+const (
+	rowNamespace = "namespace"
+	rowProject   = "project"
+	rowTotal     = "total"
+)
+
+// Report is the cost of a project and of its sub-projects in a date range.
+type Report struct {
+	Project  string    `json:"project"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Currency string    `json:"currency"`
+	// Items is the cost of every namespace of the project tree.
+	Items []Item `json:"items"`
+	// Projects is the cost of every project of the project tree, starting
+	// with the project of the report.
+	Projects []ProjectCost `json:"projects"`
+	// Total is the cost of the whole project tree.
+	Total float64 `json:"total"`
+}
+
+// Item is the cost of a namespace.
+type Item struct {
+	Project   string `json:"project"`
+	Namespace string `json:"namespace"`
+	Cluster   string `json:"cluster"`
+	// Hours is the resource hours used by the namespace.
+	Hours Usage   `json:"hours"`
+	Cost  float64 `json:"cost"`
+}
+
+// ProjectCost is the cost of a project, and of the project and all of its
+// sub-projects.
+type ProjectCost struct {
+	Project string `json:"project"`
+	// +optional
+	Parent      string  `json:"parent,omitempty"`
+	Hours       Usage   `json:"hours"`
+	Cost        float64 `json:"cost"`
+	RollUpHours Usage   `json:"rollUpHours"`
+	RollUpCost  float64 `json:"rollUpCost"`
+}
+
+// ProjectNode is a project of the tree a report is rendered for.
+type ProjectNode struct {
+	Name string
+	// Parent is empty for the root of the tree.
+	Parent string
+}
+
+// NewReport renders the report of a project tree from the usage samples.
+// Parents must precede their children in projects, whose first element is
+// the project of the report.
+func NewReport(projects []ProjectNode, samples []Sample, sheet *PriceSheet, start, end time.Time) *Report {
+	report := &Report{
+		Start:    start,
+		End:      end,
+		Currency: sheet.Currency,
+		Items:    []Item{},
+		Projects: make([]ProjectCost, 0, len(projects)),
+	}
+	index := make(map[string]int, len(projects))
+	for i, project := range projects {
+		index[project.Name] = i
+		report.Projects = append(report.Projects, ProjectCost{Project: project.Name, Parent: project.Parent})
+	}
+	if len(projects) != 0 {
+		report.Project = projects[0].Name
+	}
+
+	items := make(map[Item]*Item)
+	for _, sample := range samples {
+		i, ok := index[sample.Project]
+		if !ok || sample.Time.Before(start) || !sample.Time.Before(end) {
+			continue
+		}
+		key := Item{Project: sample.Project, Namespace: sample.Namespace, Cluster: sample.Cluster}
+		item, ok := items[key]
+		if !ok {
+			item = &Item{Project: sample.Project, Namespace: sample.Namespace, Cluster: sample.Cluster}
+			items[key] = item
+		}
+		hours := sample.Usage.Scale(sample.Period.Hours())
+		cost := sheet.PricesOf(sample.Cluster).Cost(hours)
+		item.Hours = item.Hours.Add(hours)
+		item.Cost += cost
+		report.Projects[i].Hours = report.Projects[i].Hours.Add(hours)
+		report.Projects[i].Cost += cost
+	}
+	for _, item := range items {
+		report.Items = append(report.Items, *item)
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		a, b := report.Items[i], report.Items[j]
+		if a.Project != b.Project {
+			return index[a.Project] < index[b.Project]
+		}
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Cluster < b.Cluster
+	})
+
+	// Roll the costs up from the leaves, children follow their parents.
+	for i := range report.Projects {
+		report.Projects[i].RollUpHours = report.Projects[i].Hours
+		report.Projects[i].RollUpCost = report.Projects[i].Cost
+	}
+	for i := len(report.Projects) - 1; i > 0; i-- {
+		project := report.Projects[i]
+		if parent, ok := index[project.Parent]; ok && parent < i {
+			report.Projects[parent].RollUpHours = report.Projects[parent].RollUpHours.Add(project.RollUpHours)
+			report.Projects[parent].RollUpCost += project.RollUpCost
+		}
+	}
+	if len(report.Projects) != 0 {
+		report.Total = report.Projects[0].RollUpCost
+	}
+	return report
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(r)
+}
+
+// WriteCSV writes a row for every namespace, a roll-up row for every project
+// and a total row.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	header := []string{"kind", "project", "namespace", "cluster",
+		"cpu_core_hours", "memory_gib_hours", "gpu_hours", "storage_gib_hours", "cost", "currency"}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	row := func(kind, project, namespace, cluster string, hours Usage, cost float64) []string {
+		return []string{kind, project, namespace, cluster,
+			formatFloat(hours.CPU), formatFloat(hours.Memory), formatFloat(hours.GPU), formatFloat(hours.Storage),
+			formatFloat(cost), r.Currency}
+	}
+	for _, item := range r.Items {
+		if err := writer.Write(row(rowNamespace, item.Project, item.Namespace, item.Cluster, item.Hours, item.Cost)); err != nil {
+			return err
+		}
+	}
+	var total Usage
+	for _, project := range r.Projects {
+		if err := writer.Write(row(rowProject, project.Project, "", "", project.RollUpHours, project.RollUpCost)); err != nil {
+			return err
+		}
+		total = total.Add(project.Hours)
+	}
+	if err := writer.Write(row(rowTotal, r.Project, "", "", total, r.Total)); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
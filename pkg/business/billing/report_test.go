@@ -0,0 +1,163 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package billing
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSampleFromNamespace(t *testing.T) {
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "cls-a-team", Namespace: "prj-a"},
+		Spec:       v1.NamespaceSpec{TenantID: "default", ClusterName: "cls-a", Namespace: "team"},
+		Status: v1.NamespaceStatus{Used: v1.ResourceList{
+			"requests.cpu":                    resource.MustParse("1500m"),
+			"limits.cpu":                      resource.MustParse("4"),
+			"limits.memory":                   resource.MustParse("2Gi"),
+			"requests.storage":                resource.MustParse("10Gi"),
+			"requests.nvidia.com/gpu":         resource.MustParse("1"),
+			"requests.tencent.com/vcuda-core": resource.MustParse("50"),
+		}},
+	}
+	now := time.Now()
+	sample := SampleFromNamespace(namespace, now, 5*time.Minute)
+
+	if sample.Project != "prj-a" || sample.Namespace != "cls-a-team" || sample.Cluster != "cls-a" || sample.TenantID != "default" {
+		t.Errorf("unexpected sample identity %+v", sample)
+	}
+	want := Usage{CPU: 1.5, Memory: 2, GPU: 1.5, Storage: 10}
+	if !almostEqual(sample.Usage.CPU, want.CPU) || !almostEqual(sample.Usage.Memory, want.Memory) ||
+		!almostEqual(sample.Usage.GPU, want.GPU) || !almostEqual(sample.Usage.Storage, want.Storage) {
+		t.Errorf("got usage %+v, want %+v", sample.Usage, want)
+	}
+}
+
+func TestNewReport(t *testing.T) {
+	sheet := &PriceSheet{
+		Currency: "USD",
+		Default:  Prices{CPU: 1, Memory: 0.5, GPU: 10, Storage: 0.01},
+		Clusters: map[string]Prices{"cls-b": {CPU: 2}},
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+	projects := []ProjectNode{
+		{Name: "prj-root"},
+		{Name: "prj-child", Parent: "prj-root"},
+		{Name: "prj-grandchild", Parent: "prj-child"},
+	}
+	samples := []Sample{
+		// Two hours of one core and two GiB in the root project.
+		{Time: start.Add(time.Hour), Project: "prj-root", Namespace: "cls-a-web", Cluster: "cls-a", Period: time.Hour, Usage: Usage{CPU: 1, Memory: 2}},
+		{Time: start.Add(2 * time.Hour), Project: "prj-root", Namespace: "cls-a-web", Cluster: "cls-a", Period: time.Hour, Usage: Usage{CPU: 1, Memory: 2}},
+		// Half an hour of one GPU in the child project.
+		{Time: start.Add(time.Hour), Project: "prj-child", Namespace: "cls-a-ml", Cluster: "cls-a", Period: 30 * time.Minute, Usage: Usage{GPU: 1}},
+		// An hour of two cores on a cluster with its own prices.
+		{Time: start.Add(time.Hour), Project: "prj-grandchild", Namespace: "cls-b-job", Cluster: "cls-b", Period: time.Hour, Usage: Usage{CPU: 2, Memory: 4}},
+		// Samples out of the range or of other projects are ignored.
+		{Time: end, Project: "prj-root", Namespace: "cls-a-web", Cluster: "cls-a", Period: time.Hour, Usage: Usage{CPU: 100}},
+		{Time: start.Add(time.Hour), Project: "prj-other", Namespace: "cls-a-other", Cluster: "cls-a", Period: time.Hour, Usage: Usage{CPU: 100}},
+	}
+
+	report := NewReport(projects, samples, sheet, start, end)
+
+	if report.Project != "prj-root" || report.Currency != "USD" {
+		t.Errorf("unexpected report header %+v", report)
+	}
+	if len(report.Items) != 3 {
+		t.Fatalf("got %d items, want 3: %+v", len(report.Items), report.Items)
+	}
+	wantCosts := map[string]float64{
+		"prj-root":       2*1 + 4*0.5,
+		"prj-child":      0.5 * 10,
+		"prj-grandchild": 2 * 2,
+	}
+	for _, item := range report.Items {
+		if !almostEqual(item.Cost, wantCosts[item.Project]) {
+			t.Errorf("item %s/%s: got cost %v, want %v", item.Project, item.Namespace, item.Cost, wantCosts[item.Project])
+		}
+	}
+	wantRollUp := map[string]float64{
+		"prj-root":       wantCosts["prj-root"] + wantCosts["prj-child"] + wantCosts["prj-grandchild"],
+		"prj-child":      wantCosts["prj-child"] + wantCosts["prj-grandchild"],
+		"prj-grandchild": wantCosts["prj-grandchild"],
+	}
+	for _, project := range report.Projects {
+		if !almostEqual(project.Cost, wantCosts[project.Project]) {
+			t.Errorf("project %s: got cost %v, want %v", project.Project, project.Cost, wantCosts[project.Project])
+		}
+		if !almostEqual(project.RollUpCost, wantRollUp[project.Project]) {
+			t.Errorf("project %s: got roll-up cost %v, want %v", project.Project, project.RollUpCost, wantRollUp[project.Project])
+		}
+	}
+	if !almostEqual(report.Total, wantRollUp["prj-root"]) {
+		t.Errorf("got total %v, want %v", report.Total, wantRollUp["prj-root"])
+	}
+	if !almostEqual(report.Projects[0].RollUpHours.CPU, 4) {
+		t.Errorf("got %v core hours rolled up, want 4", report.Projects[0].RollUpHours.CPU)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := report.WriteCSV(buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	rows, err := csv.NewReader(buf).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	// Header, three namespaces, three projects and the total.
+	if len(rows) != 8 {
+		t.Fatalf("got %d rows, want 8: %v", len(rows), rows)
+	}
+	total := rows[len(rows)-1]
+	if total[0] != rowTotal || total[8] != "13.0000" || total[9] != "USD" {
+		t.Errorf("unexpected total row %v", total)
+	}
+}
+
+func TestPriceSheetValidate(t *testing.T) {
+	sheet := &PriceSheet{Currency: "USD", Default: Prices{CPU: 1}}
+	if err := sheet.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if got := sheet.PricesOf("cls-a"); got.CPU != 1 {
+		t.Errorf("got %+v, want the default prices", got)
+	}
+
+	for _, invalid := range []*PriceSheet{
+		{Default: Prices{CPU: 1}},
+		{Currency: "USD", Default: Prices{Memory: -1}},
+		{Currency: "USD", Clusters: map[string]Prices{"cls-a": {GPU: -1}}},
+	} {
+		if err := invalid.Validate(); err == nil {
+			t.Errorf("expected an error for %+v", invalid)
+		}
+	}
+}
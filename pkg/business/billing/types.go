@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package billing
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+// Sample is the resource usage of a namespace during a sampling period.
+type Sample struct {
+	Time     time.Time
+	TenantID string
+	Project  string
+	// Namespace is the name of the business namespace.
+	Namespace string
+	Cluster   string
+	// Period is the length of time the usage applies to.
+	Period time.Duration
+	Usage  Usage
+}
+
+// Usage is an amount of resources, or of resource hours once multiplied by
+// the sampling periods.
+type Usage struct {
+	// CPU is in cores.
+	CPU float64 `json:"cpu"`
+	// Memory is in GiB.
+	Memory float64 `json:"memory"`
+	// GPU is in devices.
+	GPU float64 `json:"gpu"`
+	// Storage is in GiB.
+	Storage float64 `json:"storage"`
+}
+
+// Add returns the sum of the two usages.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		CPU:     u.CPU + other.CPU,
+		Memory:  u.Memory + other.Memory,
+		GPU:     u.GPU + other.GPU,
+		Storage: u.Storage + other.Storage,
+	}
+}
+
+// Scale returns the usage multiplied by factor.
+func (u Usage) Scale(factor float64) Usage {
+	return Usage{
+		CPU:     u.CPU * factor,
+		Memory:  u.Memory * factor,
+		GPU:     u.GPU * factor,
+		Storage: u.Storage * factor,
+	}
+}
+
+const gibibyte = 1 << 30
+
+// The resource quota names are tried in order, the requests take precedence
+// over the limits since they are what the scheduler reserves.
+var (
+	cpuResourceNames     = []string{"requests.cpu", "cpu", "limits.cpu"}
+	memoryResourceNames  = []string{"requests.memory", "memory", "limits.memory"}
+	storageResourceNames = []string{"requests.storage"}
+)
+
+// SampleFromNamespace returns the usage sample of the namespace computed from
+// the used quota of its status.
+func SampleFromNamespace(namespace *v1.Namespace, now time.Time, period time.Duration) Sample {
+	used := namespace.Status.Used
+	return Sample{
+		Time:      now,
+		TenantID:  namespace.Spec.TenantID,
+		Project:   namespace.Namespace,
+		Namespace: namespace.Name,
+		Cluster:   namespace.Spec.ClusterName,
+		Period:    period,
+		Usage: Usage{
+			CPU:     firstQuantity(used, cpuResourceNames).AsApproximateFloat64(),
+			Memory:  firstQuantity(used, memoryResourceNames).AsApproximateFloat64() / gibibyte,
+			GPU:     gpuDevices(used),
+			Storage: firstQuantity(used, storageResourceNames).AsApproximateFloat64() / gibibyte,
+		},
+	}
+}
+
+func firstQuantity(list v1.ResourceList, names []string) *resource.Quantity {
+	for _, name := range names {
+		if quantity, ok := list[name]; ok {
+			return &quantity
+		}
+	}
+	return resource.NewQuantity(0, resource.DecimalSI)
+}
+
+// gpuDevices returns the number of GPU devices, where a vcuda-core is one
+// percent of a device.
+func gpuDevices(list v1.ResourceList) float64 {
+	devices := firstQuantity(list, []string{"requests.nvidia.com/gpu", "nvidia.com/gpu"}).AsApproximateFloat64()
+	cores := firstQuantity(list, []string{"requests.tencent.com/vcuda-core", "tencent.com/vcuda-core"}).AsApproximateFloat64()
+	return devices + cores/100
+}
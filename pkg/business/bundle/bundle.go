@@ -0,0 +1,287 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/business"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+// Version is the version of the bundle format. Bundles of other versions
+// are rejected on import.
+const Version = "v1"
+
+// Bundle is a portable archive of a project, its sub-projects and the
+// objects related to them. Objects are stripped of their status and of the
+// metadata owned by the installation they were exported from.
+type Bundle struct {
+	Version    string      `json:"version"`
+	TenantID   string      `json:"tenantID"`
+	Project    string      `json:"project"`
+	ExportTime metav1.Time `json:"exportTime"`
+	// Projects is the project of the bundle and all of its descendants,
+	// parents before their children.
+	Projects        []v1.Project        `json:"projects"`
+	Namespaces      []v1.Namespace      `json:"namespaces,omitempty"`
+	ImageNamespaces []v1.ImageNamespace `json:"imageNamespaces,omitempty"`
+	ChartGroups     []v1.ChartGroup     `json:"chartGroups,omitempty"`
+	PolicyBindings  []PolicyBinding     `json:"policyBindings,omitempty"`
+}
+
+// PolicyBinding is the users and the groups bound to a project-scoped policy
+// in a project.
+type PolicyBinding struct {
+	Project  string `json:"project"`
+	PolicyID string `json:"policyID"`
+	// PolicyDisplayName is used to find the policy when no policy has the
+	// same ID in the installation the bundle is imported into.
+	// +optional
+	PolicyDisplayName string `json:"policyDisplayName,omitempty"`
+	// Users are the names of the users.
+	// +optional
+	Users []string `json:"users,omitempty"`
+	// Groups are the IDs of the groups, they only resolve if both
+	// installations share the identity provider.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// New creates an empty bundle of the project.
+func New(tenantID, project string) *Bundle {
+	return &Bundle{
+		Version:    Version,
+		TenantID:   tenantID,
+		Project:    project,
+		ExportTime: metav1.Now(),
+	}
+}
+
+// Decode reads a bundle and checks its version.
+func Decode(r io.Reader) (*Bundle, error) {
+	b := &Bundle{}
+	if err := json.NewDecoder(r).Decode(b); err != nil {
+		return nil, fmt.Errorf("invalid bundle: %v", err)
+	}
+	if b.Version != Version {
+		return nil, fmt.Errorf("unsupported bundle version %q, must be %s", b.Version, Version)
+	}
+	return b, nil
+}
+
+// AddProject adds a project to the bundle, its parent must be added before.
+func (b *Bundle) AddProject(project *business.Project) error {
+	out := v1.Project{}
+	if err := v1.Convert_business_Project_To_v1_Project(project, &out, nil); err != nil {
+		return err
+	}
+	out.TypeMeta = metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "Project"}
+	out.ObjectMeta = portableObjectMeta(out.ObjectMeta)
+	out.Spec.Finalizers = nil
+	out.Status = v1.ProjectStatus{}
+	b.Projects = append(b.Projects, out)
+	return nil
+}
+
+// AddNamespace adds a business namespace to the bundle.
+func (b *Bundle) AddNamespace(namespace *business.Namespace) error {
+	out := v1.Namespace{}
+	if err := v1.Convert_business_Namespace_To_v1_Namespace(namespace, &out, nil); err != nil {
+		return err
+	}
+	out.TypeMeta = metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "Namespace"}
+	out.ObjectMeta = portableObjectMeta(out.ObjectMeta)
+	out.Spec.Finalizers = nil
+	out.Status = v1.NamespaceStatus{}
+	b.Namespaces = append(b.Namespaces, out)
+	return nil
+}
+
+// AddImageNamespace adds an image namespace to the bundle.
+func (b *Bundle) AddImageNamespace(imageNamespace *business.ImageNamespace) error {
+	out := v1.ImageNamespace{}
+	if err := v1.Convert_business_ImageNamespace_To_v1_ImageNamespace(imageNamespace, &out, nil); err != nil {
+		return err
+	}
+	out.TypeMeta = metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "ImageNamespace"}
+	out.ObjectMeta = portableObjectMeta(out.ObjectMeta)
+	out.Spec.Finalizers = nil
+	out.Status = v1.ImageNamespaceStatus{}
+	b.ImageNamespaces = append(b.ImageNamespaces, out)
+	return nil
+}
+
+// AddChartGroup adds a chart group to the bundle.
+func (b *Bundle) AddChartGroup(chartGroup *business.ChartGroup) error {
+	out := v1.ChartGroup{}
+	if err := v1.Convert_business_ChartGroup_To_v1_ChartGroup(chartGroup, &out, nil); err != nil {
+		return err
+	}
+	out.TypeMeta = metav1.TypeMeta{APIVersion: v1.SchemeGroupVersion.String(), Kind: "ChartGroup"}
+	out.ObjectMeta = portableObjectMeta(out.ObjectMeta)
+	out.Spec.Finalizers = nil
+	out.Status = v1.ChartGroupStatus{}
+	b.ChartGroups = append(b.ChartGroups, out)
+	return nil
+}
+
+// Validate checks the bundle is the bundle of the project, and that all of
+// its objects belong to the projects of the bundle.
+func (b *Bundle) Validate(projectName string) error {
+	if len(b.Projects) == 0 || b.Project != b.Projects[0].Name {
+		return fmt.Errorf("the first project of the bundle must be %s", b.Project)
+	}
+	if b.Project != projectName {
+		return fmt.Errorf("the bundle is the bundle of project %s, not %s", b.Project, projectName)
+	}
+	projects := map[string]bool{b.Project: true}
+	for _, project := range b.Projects[1:] {
+		if projects[project.Name] {
+			return fmt.Errorf("duplicate project %s", project.Name)
+		}
+		if !projects[project.Spec.ParentProjectName] {
+			return fmt.Errorf("the parent of project %s must precede it in the bundle", project.Name)
+		}
+		projects[project.Name] = true
+	}
+	for _, namespace := range b.Namespaces {
+		if !projects[namespace.Namespace] {
+			return fmt.Errorf("namespace %s belongs to project %s which is not in the bundle", namespace.Name, namespace.Namespace)
+		}
+	}
+	for _, imageNamespace := range b.ImageNamespaces {
+		if !projects[imageNamespace.Namespace] {
+			return fmt.Errorf("image namespace %s belongs to project %s which is not in the bundle", imageNamespace.Name, imageNamespace.Namespace)
+		}
+	}
+	for _, chartGroup := range b.ChartGroups {
+		if !projects[chartGroup.Namespace] {
+			return fmt.Errorf("chart group %s belongs to project %s which is not in the bundle", chartGroup.Name, chartGroup.Namespace)
+		}
+	}
+	for _, binding := range b.PolicyBindings {
+		if !projects[binding.Project] {
+			return fmt.Errorf("policy binding of %s belongs to project %s which is not in the bundle", binding.PolicyID, binding.Project)
+		}
+	}
+	return nil
+}
+
+// Remap moves the bundle to the tenant, and renames its clusters with the
+// mapping. Clusters missing from the mapping keep their names.
+func (b *Bundle) Remap(tenantID string, clusters map[string]string) {
+	cluster := func(name string) string {
+		if mapped, ok := clusters[name]; ok {
+			return mapped
+		}
+		return name
+	}
+	remapClusterHard := func(hard v1.ClusterHard) v1.ClusterHard {
+		if hard == nil {
+			return nil
+		}
+		out := make(v1.ClusterHard, len(hard))
+		for name, value := range hard {
+			out[cluster(name)] = value
+		}
+		return out
+	}
+
+	for i := range b.Projects {
+		project := &b.Projects[i]
+		project.Spec.TenantID = tenantID
+		project.Spec.Clusters = remapClusterHard(project.Spec.Clusters)
+		if project.Spec.Borrowing != nil {
+			project.Spec.Borrowing.Ceiling = remapClusterHard(project.Spec.Borrowing.Ceiling)
+		}
+	}
+	for i := range b.Namespaces {
+		namespace := &b.Namespaces[i]
+		namespace.Spec.TenantID = tenantID
+		namespace.Spec.ClusterName = cluster(namespace.Spec.ClusterName)
+		namespace.Name = fmt.Sprintf("%s-%s", namespace.Spec.ClusterName, namespace.Spec.Namespace)
+	}
+	for i := range b.ImageNamespaces {
+		b.ImageNamespaces[i].Spec.TenantID = tenantID
+	}
+	for i := range b.ChartGroups {
+		b.ChartGroups[i].Spec.TenantID = tenantID
+	}
+	// The IDs of the predefined policies embed the tenant.
+	oldPrefix := fmt.Sprintf("pol-%s-", b.TenantID)
+	newPrefix := fmt.Sprintf("pol-%s-", tenantID)
+	for i := range b.PolicyBindings {
+		binding := &b.PolicyBindings[i]
+		if strings.HasPrefix(binding.PolicyID, oldPrefix) {
+			binding.PolicyID = newPrefix + strings.TrimPrefix(binding.PolicyID, oldPrefix)
+		}
+	}
+	b.TenantID = tenantID
+}
+
+// Clusters returns the names of the clusters the bundle refers to, sorted.
+func (b *Bundle) Clusters() []string {
+	set := make(map[string]bool)
+	for _, project := range b.Projects {
+		for name := range project.Spec.Clusters {
+			set[name] = true
+		}
+	}
+	for _, namespace := range b.Namespaces {
+		set[namespace.Spec.ClusterName] = true
+	}
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseClusterMapping parses the cluster mapping of the import options.
+func ParseClusterMapping(items []string) (map[string]string, error) {
+	mapping := make(map[string]string, len(items))
+	for _, item := range items {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid cluster mapping %q, must be in the source=destination format", item)
+		}
+		if _, ok := mapping[parts[0]]; ok {
+			return nil, fmt.Errorf("duplicate cluster mapping of %s", parts[0])
+		}
+		mapping[parts[0]] = parts[1]
+	}
+	return mapping, nil
+}
+
+// portableObjectMeta drops the metadata owned by the installation the object
+// comes from.
+func portableObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/business"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+func newTestBundle(t *testing.T) *Bundle {
+	b := New("default", "prj-a")
+	hard := business.ClusterHard{
+		"cls-a": business.HardQuantity{Hard: business.ResourceList{"cpu": resource.MustParse("8")}},
+	}
+	projects := []*business.Project{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prj-a", UID: "uid-a", ResourceVersion: "10", Finalizers: []string{"orphan"}},
+			Spec: business.ProjectSpec{
+				TenantID:   "default",
+				Members:    []string{"alice"},
+				Clusters:   hard,
+				Finalizers: []business.FinalizerName{business.ProjectFinalize},
+			},
+			Status: business.ProjectStatus{Phase: business.ProjectActive, CalculatedChildProjects: []string{"prj-b"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prj-b"},
+			Spec: business.ProjectSpec{
+				TenantID:          "default",
+				ParentProjectName: "prj-a",
+				Clusters:          hard,
+				Borrowing:         &business.ProjectBorrowing{Ceiling: hard},
+			},
+		},
+	}
+	for _, project := range projects {
+		if err := b.AddProject(project); err != nil {
+			t.Fatalf("AddProject: %v", err)
+		}
+	}
+	if err := b.AddNamespace(&business.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "cls-a-web", Namespace: "prj-b"},
+		Spec:       business.NamespaceSpec{TenantID: "default", ClusterName: "cls-a", Namespace: "web"},
+		Status:     business.NamespaceStatus{Phase: business.NamespaceAvailable},
+	}); err != nil {
+		t.Fatalf("AddNamespace: %v", err)
+	}
+	if err := b.AddImageNamespace(&business.ImageNamespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "images", Namespace: "prj-a"},
+		Spec:       business.ImageNamespaceSpec{TenantID: "default", Name: "images"},
+	}); err != nil {
+		t.Fatalf("AddImageNamespace: %v", err)
+	}
+	b.PolicyBindings = append(b.PolicyBindings,
+		PolicyBinding{Project: "prj-a", PolicyID: "pol-default-project-owner", Users: []string{"alice"}},
+		PolicyBinding{Project: "prj-b", PolicyID: "pol-custom", PolicyDisplayName: "Viewer", Users: []string{"bob"}},
+	)
+	return b
+}
+
+func TestAddProjectDropsInstallationState(t *testing.T) {
+	b := newTestBundle(t)
+	project := b.Projects[0]
+	if project.UID != "" || project.ResourceVersion != "" || len(project.Finalizers) != 0 || len(project.Spec.Finalizers) != 0 {
+		t.Errorf("metadata owned by the installation is kept: %+v", project.ObjectMeta)
+	}
+	if !reflect.DeepEqual(project.Status, v1.ProjectStatus{}) {
+		t.Errorf("status is kept: %+v", project.Status)
+	}
+	if project.Kind != "Project" || project.APIVersion != v1.SchemeGroupVersion.String() {
+		t.Errorf("got type %s/%s", project.APIVersion, project.Kind)
+	}
+	if b.Namespaces[0].Status.Phase != "" {
+		t.Errorf("namespace status is kept: %+v", b.Namespaces[0].Status)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	b := newTestBundle(t)
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	decoded, err := Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if err := decoded.Validate("prj-a"); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+	if len(decoded.Projects) != 2 || len(decoded.Namespaces) != 1 || len(decoded.PolicyBindings) != 2 {
+		t.Errorf("decoded bundle lost objects: %+v", decoded)
+	}
+
+	b.Version = "v0"
+	data, _ = json.Marshal(b)
+	if _, err := Decode(bytes.NewReader(data)); err == nil {
+		t.Errorf("Decode accepted unsupported version")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(b *Bundle)
+		project string
+		wantErr bool
+	}{
+		{name: "valid", project: "prj-a"},
+		{name: "other project", project: "prj-b", wantErr: true},
+		{
+			name:    "child before parent",
+			mutate:  func(b *Bundle) { b.Projects[0], b.Projects[1] = b.Projects[1], b.Projects[0] },
+			project: "prj-a",
+			wantErr: true,
+		},
+		{
+			name:    "namespace outside of the bundle",
+			mutate:  func(b *Bundle) { b.Namespaces[0].Namespace = "prj-c" },
+			project: "prj-a",
+			wantErr: true,
+		},
+		{
+			name:    "policy binding outside of the bundle",
+			mutate:  func(b *Bundle) { b.PolicyBindings[0].Project = "prj-c" },
+			project: "prj-a",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBundle(t)
+			if tt.mutate != nil {
+				tt.mutate(b)
+			}
+			if err := b.Validate(tt.project); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRemap(t *testing.T) {
+	b := newTestBundle(t)
+	b.Remap("tenant-b", map[string]string{"cls-a": "cls-b"})
+
+	if b.TenantID != "tenant-b" {
+		t.Errorf("got tenant %s", b.TenantID)
+	}
+	for _, project := range b.Projects {
+		if project.Spec.TenantID != "tenant-b" {
+			t.Errorf("project %s: got tenant %s", project.Name, project.Spec.TenantID)
+		}
+		if _, ok := project.Spec.Clusters["cls-b"]; !ok || len(project.Spec.Clusters) != 1 {
+			t.Errorf("project %s: clusters not remapped: %v", project.Name, project.Spec.Clusters)
+		}
+	}
+	if _, ok := b.Projects[1].Spec.Borrowing.Ceiling["cls-b"]; !ok {
+		t.Errorf("borrowing ceiling not remapped: %v", b.Projects[1].Spec.Borrowing.Ceiling)
+	}
+	namespace := b.Namespaces[0]
+	if namespace.Spec.ClusterName != "cls-b" || namespace.Name != "cls-b-web" || namespace.Spec.TenantID != "tenant-b" {
+		t.Errorf("namespace not remapped: %s %+v", namespace.Name, namespace.Spec)
+	}
+	if b.ImageNamespaces[0].Spec.TenantID != "tenant-b" {
+		t.Errorf("image namespace not remapped: %+v", b.ImageNamespaces[0].Spec)
+	}
+	if got := b.PolicyBindings[0].PolicyID; got != "pol-tenant-b-project-owner" {
+		t.Errorf("predefined policy not remapped: %s", got)
+	}
+	if got := b.PolicyBindings[1].PolicyID; got != "pol-custom" {
+		t.Errorf("custom policy remapped: %s", got)
+	}
+	if got := b.Clusters(); !reflect.DeepEqual(got, []string{"cls-b"}) {
+		t.Errorf("Clusters() = %v", got)
+	}
+}
+
+func TestParseClusterMapping(t *testing.T) {
+	mapping, err := ParseClusterMapping([]string{"cls-a=cls-b", "cls-c=cls-d"})
+	if err != nil {
+		t.Fatalf("ParseClusterMapping: %v", err)
+	}
+	if want := map[string]string{"cls-a": "cls-b", "cls-c": "cls-d"}; !reflect.DeepEqual(mapping, want) {
+		t.Errorf("got %v, want %v", mapping, want)
+	}
+	for _, items := range [][]string{{"cls-a"}, {"=cls-b"}, {"cls-a="}, {"cls-a=cls-b", "cls-a=cls-c"}} {
+		if _, err := ParseClusterMapping(items); err == nil {
+			t.Errorf("ParseClusterMapping(%v) accepted invalid mapping", items)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package bundle
+
+// Kinds of the objects of bundles.
+const (
+	KindProject        = "Project"
+	KindNamespace      = "Namespace"
+	KindImageNamespace = "ImageNamespace"
+	KindChartGroup     = "ChartGroup"
+	KindPolicyBinding  = "PolicyBinding"
+	// KindCluster is the kind of the clusters the bundles refer to, which
+	// are never created by imports.
+	KindCluster = "Cluster"
+)
+
+// Result is the result of importing a bundle.
+type Result struct {
+	Project  string `json:"project"`
+	TenantID string `json:"tenantID"`
+	DryRun   bool   `json:"dryRun"`
+	// Conflicts prevent the bundle from being imported, nothing is created
+	// if there is any.
+	// +optional
+	Conflicts []Conflict `json:"conflicts,omitempty"`
+	// Created are the objects created, in the order they were created. If
+	// the import fails, they are deleted again and only the objects which
+	// could not be deleted are left.
+	// +optional
+	Created []Object `json:"created,omitempty"`
+	// Error is why the import stopped.
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// Object identifies an object of a bundle.
+type Object struct {
+	Kind string `json:"kind"`
+	// Project is the project the object belongs to, empty for projects.
+	// +optional
+	Project string `json:"project,omitempty"`
+	Name    string `json:"name"`
+}
+
+// Conflict is why an object of a bundle can't be imported.
+type Conflict struct {
+	Object  `json:",inline"`
+	Message string `json:"message"`
+}
+
+// AddConflict records why the object can't be imported.
+func (r *Result) AddConflict(kind, project, name, message string) {
+	r.Conflicts = append(r.Conflicts, Conflict{
+		Object:  Object{Kind: kind, Project: project, Name: name},
+		Message: message,
+	})
+}
+
+// AddCreated records the object was created.
+func (r *Result) AddCreated(kind, project, name string) {
+	r.Created = append(r.Created, Object{Kind: kind, Project: project, Name: name})
+}
@@ -26,6 +26,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -61,6 +62,10 @@ const (
 	controllerName = "nsemigration-controller"
 )
 
+// copyBatchPeriod bounds the time spent copying resources before the progress
+// is persisted.
+const copyBatchPeriod = 10 * time.Second
+
 // Controller is responsible for performing actions dependent upon a emigration phase.
 type Controller struct {
 	client         clientset.Interface
@@ -226,6 +231,10 @@ func (c *Controller) processUpdate(ctx context.Context, emigration *v1.NsEmigrat
 		return c.processOldOneDetached(ctx, emigration)
 	case v1.NsEmigrationNewOneCreated:
 		return c.processNewOneCreated(ctx, emigration)
+	case v1.NsEmigrationResourcesCopying:
+		return c.processResourcesCopying(ctx, emigration)
+	case v1.NsEmigrationRollingBack:
+		return c.processRollingBack(ctx, emigration)
 	case v1.NsEmigrationOldOneTerminating:
 		return c.processOldOneTerminating(ctx, emigration)
 	case v1.NsEmigrationFinished:
@@ -285,6 +294,16 @@ func (c *Controller) processOldOneLocked(ctx context.Context, emigration *v1.NsE
 	if err != nil {
 		return c.persistUpdateEmigration(ctx, emigration)
 	}
+	if emigration.Spec.DestinationCluster != "" {
+		// The old namespace keeps its workloads on the source cluster until
+		// they have been copied to the destination cluster.
+		if err := c.createNewNamespace(ctx, emigration, oldNS, emigration.Spec.DestinationCluster); err != nil {
+			return c.persistUpdateEmigration(ctx, emigration)
+		}
+		emigration.Status.Phase = v1.NsEmigrationNewOneCreated
+		emigration.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
 	if err := c.detachFromClusterNamespace(ctx, oldNS); err != nil {
 		emigration.Status.Message = fmt.Sprintf("%s, failed to detach namespace %s/%s from cluster",
 			emigration.Status.Phase, oldNS.Namespace, oldNS.Spec.Namespace)
@@ -306,30 +325,50 @@ func (c *Controller) processOldOneDetached(ctx context.Context, emigration *v1.N
 	if err != nil {
 		return c.persistUpdateEmigration(ctx, emigration)
 	}
+	if err := c.createNewNamespace(ctx, emigration, oldNS, oldNS.Spec.ClusterName); err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	emigration.Status.Phase = v1.NsEmigrationNewOneCreated
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
+// createNewNamespace creates the namespace in the destination project on the
+// given cluster, marking the emigration failed if it can not.
+func (c *Controller) createNewNamespace(ctx context.Context, emigration *v1.NsEmigration, oldNS *v1.Namespace, clusterName string) error {
 	newNS := v1.Namespace{}
 	newNS.Namespace = emigration.Spec.Destination
 	newNS.Spec.TenantID = oldNS.Spec.TenantID
-	newNS.Spec.ClusterName = oldNS.Spec.ClusterName
+	newNS.Spec.ClusterName = clusterName
 	newNS.Spec.Namespace = oldNS.Spec.Namespace
 	newNS.Spec.Hard = oldNS.Spec.Hard
-	if _, err := c.client.BusinessV1().Namespaces(newNS.Namespace).Create(ctx, &newNS, metav1.CreateOptions{}); err != nil {
+	created, err := c.client.BusinessV1().Namespaces(newNS.Namespace).Create(ctx, &newNS, metav1.CreateOptions{})
+	if err != nil {
 		emigration.Status.Message = fmt.Sprintf("%s, failed to create namespace %s/%s",
 			emigration.Status.Phase, emigration.Spec.Destination, emigration.Spec.NsShowName)
 		emigration.Status.Phase = v1.NsEmigrationFailed
 		emigration.Status.Reason = err.Error()
 		emigration.Status.LastTransitionTime = metav1.Now()
-		return c.persistUpdateEmigration(ctx, emigration)
+		return err
 	}
-	emigration.Status.Phase = v1.NsEmigrationNewOneCreated
-	emigration.Status.LastTransitionTime = metav1.Now()
-	return c.persistUpdateEmigration(ctx, emigration)
+	emigration.Status.DestinationNamespace = created.ObjectMeta.Name
+	return nil
+}
+
+// destinationNamespaceName returns the name of the namespace created in the
+// destination project.
+func destinationNamespaceName(emigration *v1.NsEmigration) string {
+	if emigration.Status.DestinationNamespace != "" {
+		return emigration.Status.DestinationNamespace
+	}
+	return emigration.Spec.Namespace
 }
 
 func (c *Controller) processNewOneCreated(ctx context.Context, emigration *v1.NsEmigration) error {
 	if emigration.Status.Phase != v1.NsEmigrationNewOneCreated {
 		panic(fmt.Sprintf("%s != %s", emigration.Status.Phase, v1.NsEmigrationNewOneCreated))
 	}
-	newNS, err := c.client.BusinessV1().Namespaces(emigration.Spec.Destination).Get(ctx, emigration.Spec.Namespace, metav1.GetOptions{})
+	newNS, err := c.client.BusinessV1().Namespaces(emigration.Spec.Destination).Get(ctx, destinationNamespaceName(emigration), metav1.GetOptions{})
 	if err != nil {
 		emigration.Status.Message = fmt.Sprintf("%s, failed to check status of namespace %s/%s",
 			emigration.Status.Phase, emigration.Spec.Destination, emigration.Spec.NsShowName)
@@ -350,6 +389,22 @@ func (c *Controller) processNewOneCreated(ctx context.Context, emigration *v1.Ns
 		emigration.Status.LastTransitionTime = metav1.Now()
 		return c.persistUpdateEmigration(ctx, emigration)
 	}
+	if emigration.Spec.DestinationCluster != "" {
+		emigration.Status.Phase = v1.NsEmigrationResourcesCopying
+		emigration.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	if err := c.deleteOldNamespace(ctx, emigration); err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	emigration.Status.Phase = v1.NsEmigrationOldOneTerminating
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
+// deleteOldNamespace deletes the locked old namespace, marking the emigration
+// failed if it can not.
+func (c *Controller) deleteOldNamespace(ctx context.Context, emigration *v1.NsEmigration) error {
 	oldNS, err := c.client.BusinessV1().Namespaces(emigration.Namespace).Get(ctx, emigration.Spec.Namespace, metav1.GetOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		emigration.Status.Message = fmt.Sprintf("%s, failed to check status of namespace %s/%s",
@@ -357,7 +412,7 @@ func (c *Controller) processNewOneCreated(ctx context.Context, emigration *v1.Ns
 		emigration.Status.Phase = v1.NsEmigrationFailed
 		emigration.Status.Reason = err.Error()
 		emigration.Status.LastTransitionTime = metav1.Now()
-		return c.persistUpdateEmigration(ctx, emigration)
+		return err
 	}
 	if err == nil {
 		if oldNS.Status.Phase != v1.NamespaceLocked {
@@ -365,7 +420,7 @@ func (c *Controller) processNewOneCreated(ctx context.Context, emigration *v1.Ns
 				emigration.Status.Phase, oldNS.Namespace, oldNS.Spec.Namespace, oldNS.Status.Phase)
 			emigration.Status.Phase = v1.NsEmigrationFailed
 			emigration.Status.LastTransitionTime = metav1.Now()
-			return c.persistUpdateEmigration(ctx, emigration)
+			return fmt.Errorf("namespace %s/%s is not locked", oldNS.Namespace, oldNS.Name)
 		}
 		background := metav1.DeletePropagationBackground
 		deleteOpt := metav1.DeleteOptions{PropagationPolicy: &background}
@@ -374,15 +429,126 @@ func (c *Controller) processNewOneCreated(ctx context.Context, emigration *v1.Ns
 				emigration.Status.Phase, oldNS.Namespace, oldNS.Spec.Namespace)
 			emigration.Status.Phase = v1.NsEmigrationFailed
 			emigration.Status.Reason = err.Error()
+			emigration.Status.LastTransitionTime = metav1.Now()
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Controller) processResourcesCopying(ctx context.Context, emigration *v1.NsEmigration) error {
+	if emigration.Status.Phase != v1.NsEmigrationResourcesCopying {
+		panic(fmt.Sprintf("%s != %s", emigration.Status.Phase, v1.NsEmigrationResourcesCopying))
+	}
+	oldNS, err := c.getOldNamespace(ctx, emigration)
+	if err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	m, err := c.newMigrator(ctx, emigration, oldNS)
+	if err != nil {
+		return c.rollBack(ctx, emigration, "failed to connect clusters", err)
+	}
+	if emigration.Status.Resources == nil {
+		resources, err := m.list(ctx)
+		if err != nil {
+			return c.rollBack(ctx, emigration, "failed to list resources", err)
+		}
+		emigration.Status.Resources = resources
+	}
+
+	// Persist the progress at least every copyBatchPeriod.
+	deadline := time.Now().Add(copyBatchPeriod)
+	for i := range emigration.Status.Resources {
+		resource := &emigration.Status.Resources[i]
+		if resource.Phase == v1.NsEmigrationResourceCopied {
+			continue
+		}
+		if time.Now().After(deadline) {
 			emigration.Status.LastTransitionTime = metav1.Now()
 			return c.persistUpdateEmigration(ctx, emigration)
 		}
+		if err := m.copy(ctx, resource.Kind, resource.Name); err != nil {
+			resource.Phase = v1.NsEmigrationResourceFailed
+			resource.Message = err.Error()
+			return c.rollBack(ctx, emigration, fmt.Sprintf("failed to copy %s %s", resource.Kind, resource.Name), err)
+		}
+		resource.Phase = v1.NsEmigrationResourceCopied
+		resource.Message = ""
+	}
+
+	if err := c.deleteOldNamespace(ctx, emigration); err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
 	}
 	emigration.Status.Phase = v1.NsEmigrationOldOneTerminating
 	emigration.Status.LastTransitionTime = metav1.Now()
 	return c.persistUpdateEmigration(ctx, emigration)
 }
 
+// rollBack starts rolling back the emigration after a failed copy.
+func (c *Controller) rollBack(ctx context.Context, emigration *v1.NsEmigration, message string, err error) error {
+	emigration.Status.Message = fmt.Sprintf("%s, %s", emigration.Status.Phase, message)
+	emigration.Status.Phase = v1.NsEmigrationRollingBack
+	emigration.Status.Reason = err.Error()
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
+func (c *Controller) processRollingBack(ctx context.Context, emigration *v1.NsEmigration) error {
+	if emigration.Status.Phase != v1.NsEmigrationRollingBack {
+		panic(fmt.Sprintf("%s != %s", emigration.Status.Phase, v1.NsEmigrationRollingBack))
+	}
+	oldNS, err := c.getOldNamespace(ctx, emigration)
+	if err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+
+	if len(emigration.Status.Resources) != 0 {
+		m, err := c.newMigrator(ctx, emigration, oldNS)
+		if err != nil {
+			return err
+		}
+		// Remove the copies in the reverse order, workloads first.
+		var errs []error
+		for i := len(emigration.Status.Resources) - 1; i >= 0; i-- {
+			resource := &emigration.Status.Resources[i]
+			if resource.Phase == v1.NsEmigrationResourcePending || resource.Phase == v1.NsEmigrationResourceRolledBack {
+				continue
+			}
+			if err := m.remove(ctx, resource.Kind, resource.Name); err != nil {
+				resource.Phase = v1.NsEmigrationResourceFailed
+				resource.Message = err.Error()
+				errs = append(errs, err)
+				continue
+			}
+			resource.Phase = v1.NsEmigrationResourceRolledBack
+			resource.Message = ""
+		}
+		if len(errs) != 0 {
+			if err := c.persistUpdateEmigration(ctx, emigration); err != nil {
+				return err
+			}
+			return utilerrors.NewAggregate(errs)
+		}
+	}
+
+	background := metav1.DeletePropagationBackground
+	deleteOpt := metav1.DeleteOptions{PropagationPolicy: &background}
+	if emigration.Status.DestinationNamespace != "" {
+		if err := c.client.BusinessV1().Namespaces(emigration.Spec.Destination).Delete(ctx, emigration.Status.DestinationNamespace, deleteOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	oldNS.Status.Phase = v1.NamespaceAvailable
+	oldNS.Status.LastTransitionTime = metav1.Now()
+	if err := businessns.PersistUpdateNamesapce(ctx, c.client, oldNS); err != nil {
+		return err
+	}
+	emigration.Status.Phase = v1.NsEmigrationRolledBack
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
 func (c *Controller) processOldOneTerminating(ctx context.Context, emigration *v1.NsEmigration) error {
 	if emigration.Status.Phase != v1.NsEmigrationOldOneTerminating {
 		panic(fmt.Sprintf("%s != %s", emigration.Status.Phase, v1.NsEmigrationOldOneTerminating))
@@ -420,7 +586,7 @@ func (c *Controller) processFinished(ctx context.Context, emigration *v1.NsEmigr
 }
 
 func (c *Controller) processOthers(ctx context.Context, emigration *v1.NsEmigration) error {
-	if emigration.Status.Phase == v1.NsEmigrationFailed {
+	if emigration.Status.Phase == v1.NsEmigrationFailed || emigration.Status.Phase == v1.NsEmigrationRolledBack {
 		return nil
 	}
 	emigration.Status.Message = fmt.Sprintf("invalid emigration phase %s", emigration.Status.Phase)
@@ -441,6 +607,26 @@ func (c *Controller) detachFromClusterNamespace(ctx context.Context, namespace *
 	return cls.DetachFromClusterNamespace(ctx, kubeClient, namespace)
 }
 
+func (c *Controller) newMigrator(ctx context.Context, emigration *v1.NsEmigration, oldNS *v1.Namespace) (*migrator, error) {
+	source, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, oldNS.Spec.ClusterName)
+	if err != nil {
+		log.Error("Failed to create the kubernetes client",
+			log.String("namespaceName", oldNS.ObjectMeta.Name),
+			log.String("clusterName", oldNS.Spec.ClusterName),
+			log.Err(err))
+		return nil, err
+	}
+	destination, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, emigration.Spec.DestinationCluster)
+	if err != nil {
+		log.Error("Failed to create the kubernetes client",
+			log.String("namespaceName", oldNS.ObjectMeta.Name),
+			log.String("clusterName", emigration.Spec.DestinationCluster),
+			log.Err(err))
+		return nil, err
+	}
+	return newMigrator(source, destination, oldNS.Spec.ClusterName, oldNS.Spec.Namespace, &emigration.Spec), nil
+}
+
 func (c *Controller) persistUpdateEmigration(ctx context.Context, emigration *v1.NsEmigration) error {
 	var err error
 	for i := 0; i < clientRetryCount; i++ {
@@ -0,0 +1,372 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package emigration
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+// annotationEmigratedFrom marks the resources copied by an emigration with
+// the cluster and namespace they were copied from.
+const annotationEmigratedFrom = "tkestack.io/emigratedFrom"
+
+// Kinds of the resources copied to the destination cluster.
+const (
+	kindConfigMap             = "ConfigMap"
+	kindSecret                = "Secret"
+	kindPersistentVolumeClaim = "PersistentVolumeClaim"
+	kindService               = "Service"
+	kindDeployment            = "Deployment"
+	kindStatefulSet           = "StatefulSet"
+)
+
+// migrator copies the resources of a kubernetes namespace from the source
+// cluster to the destination cluster.
+type migrator struct {
+	source               kubernetes.Interface
+	destination          kubernetes.Interface
+	namespace            string
+	origin               string
+	registryMappings     map[string]string
+	storageClassMappings map[string]string
+}
+
+func newMigrator(source, destination kubernetes.Interface, sourceCluster, namespace string, spec *v1.NsEmigrationSpec) *migrator {
+	return &migrator{
+		source:               source,
+		destination:          destination,
+		namespace:            namespace,
+		origin:               sourceCluster + "/" + namespace,
+		registryMappings:     spec.RegistryMappings,
+		storageClassMappings: spec.StorageClassMappings,
+	}
+}
+
+// list returns the resources to copy, in the order they must be created so
+// that the configuration and storage of the workloads exist before them.
+func (m *migrator) list(ctx context.Context) ([]v1.NsEmigrationResourceStatus, error) {
+	var resources []v1.NsEmigrationResourceStatus
+	add := func(kind string, meta metav1.ObjectMeta) {
+		// Resources managed by a controller are created again by it.
+		if metav1.GetControllerOf(&meta) != nil {
+			return
+		}
+		resources = append(resources, v1.NsEmigrationResourceStatus{
+			Kind:  kind,
+			Name:  meta.Name,
+			Phase: v1.NsEmigrationResourcePending,
+		})
+	}
+
+	configMaps, err := m.source.CoreV1().ConfigMaps(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range configMaps.Items {
+		// Published into every namespace by kube-controller-manager.
+		if item.Name == "kube-root-ca.crt" {
+			continue
+		}
+		add(kindConfigMap, item.ObjectMeta)
+	}
+	secrets, err := m.source.CoreV1().Secrets(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range secrets.Items {
+		if item.Type == corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+		add(kindSecret, item.ObjectMeta)
+	}
+	pvcs, err := m.source.CoreV1().PersistentVolumeClaims(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range pvcs.Items {
+		add(kindPersistentVolumeClaim, item.ObjectMeta)
+	}
+	services, err := m.source.CoreV1().Services(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range services.Items {
+		add(kindService, item.ObjectMeta)
+	}
+	deployments, err := m.source.AppsV1().Deployments(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range deployments.Items {
+		add(kindDeployment, item.ObjectMeta)
+	}
+	statefulSets, err := m.source.AppsV1().StatefulSets(m.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range statefulSets.Items {
+		add(kindStatefulSet, item.ObjectMeta)
+	}
+	return resources, nil
+}
+
+// copy creates the resource in the destination cluster, rewriting its image
+// registry and storage class references. Copying a resource again is a no-op.
+func (m *migrator) copy(ctx context.Context, kind, name string) error {
+	var err error
+	switch kind {
+	case kindConfigMap:
+		var obj *corev1.ConfigMap
+		if obj, err = m.source.CoreV1().ConfigMaps(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			_, err = m.destination.CoreV1().ConfigMaps(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	case kindSecret:
+		var obj *corev1.Secret
+		if obj, err = m.source.CoreV1().Secrets(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			_, err = m.destination.CoreV1().Secrets(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	case kindPersistentVolumeClaim:
+		var obj *corev1.PersistentVolumeClaim
+		if obj, err = m.source.CoreV1().PersistentVolumeClaims(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			if storageClass, ok := obj.Annotations[corev1.BetaStorageClassAnnotation]; ok {
+				if mapped, ok := m.storageClassMappings[storageClass]; ok {
+					obj.Annotations[corev1.BetaStorageClassAnnotation] = mapped
+				}
+			}
+			m.rewritePersistentVolumeClaimSpec(&obj.Spec)
+			obj.Status = corev1.PersistentVolumeClaimStatus{}
+			_, err = m.destination.CoreV1().PersistentVolumeClaims(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	case kindService:
+		var obj *corev1.Service
+		if obj, err = m.source.CoreV1().Services(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			rewriteServiceSpec(&obj.Spec)
+			obj.Status = corev1.ServiceStatus{}
+			_, err = m.destination.CoreV1().Services(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	case kindDeployment:
+		var obj *appsv1.Deployment
+		if obj, err = m.source.AppsV1().Deployments(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			m.rewritePodSpec(&obj.Spec.Template.Spec)
+			obj.Status = appsv1.DeploymentStatus{}
+			_, err = m.destination.AppsV1().Deployments(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	case kindStatefulSet:
+		var obj *appsv1.StatefulSet
+		if obj, err = m.source.AppsV1().StatefulSets(m.namespace).Get(ctx, name, metav1.GetOptions{}); err == nil {
+			obj.ObjectMeta = m.objectMeta(obj.ObjectMeta)
+			m.rewritePodSpec(&obj.Spec.Template.Spec)
+			for i := range obj.Spec.VolumeClaimTemplates {
+				m.rewritePersistentVolumeClaimSpec(&obj.Spec.VolumeClaimTemplates[i].Spec)
+			}
+			obj.Status = appsv1.StatefulSetStatus{}
+			_, err = m.destination.AppsV1().StatefulSets(m.namespace).Create(ctx, obj, metav1.CreateOptions{})
+		}
+	default:
+		return fmt.Errorf("unsupported kind %s", kind)
+	}
+	if errors.IsAlreadyExists(err) {
+		return m.checkCopied(ctx, kind, name)
+	}
+	return err
+}
+
+// checkCopied returns an error unless the resource existing in the
+// destination cluster was copied by this emigration.
+func (m *migrator) checkCopied(ctx context.Context, kind, name string) error {
+	meta, err := m.destinationMeta(ctx, kind, name)
+	if err != nil {
+		return err
+	}
+	if meta.Annotations[annotationEmigratedFrom] != m.origin {
+		return fmt.Errorf("%s %s already exists in the destination cluster", kind, name)
+	}
+	return nil
+}
+
+// remove deletes the copied resource from the destination cluster. Resources
+// not created by this emigration are left untouched.
+func (m *migrator) remove(ctx context.Context, kind, name string) error {
+	meta, err := m.destinationMeta(ctx, kind, name)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if meta.Annotations[annotationEmigratedFrom] != m.origin {
+		return nil
+	}
+
+	background := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{
+		PropagationPolicy: &background,
+		Preconditions:     &metav1.Preconditions{UID: &meta.UID},
+	}
+	switch kind {
+	case kindConfigMap:
+		err = m.destination.CoreV1().ConfigMaps(m.namespace).Delete(ctx, name, options)
+	case kindSecret:
+		err = m.destination.CoreV1().Secrets(m.namespace).Delete(ctx, name, options)
+	case kindPersistentVolumeClaim:
+		err = m.destination.CoreV1().PersistentVolumeClaims(m.namespace).Delete(ctx, name, options)
+	case kindService:
+		err = m.destination.CoreV1().Services(m.namespace).Delete(ctx, name, options)
+	case kindDeployment:
+		err = m.destination.AppsV1().Deployments(m.namespace).Delete(ctx, name, options)
+	case kindStatefulSet:
+		err = m.destination.AppsV1().StatefulSets(m.namespace).Delete(ctx, name, options)
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (m *migrator) destinationMeta(ctx context.Context, kind, name string) (*metav1.ObjectMeta, error) {
+	switch kind {
+	case kindConfigMap:
+		obj, err := m.destination.CoreV1().ConfigMaps(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case kindSecret:
+		obj, err := m.destination.CoreV1().Secrets(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case kindPersistentVolumeClaim:
+		obj, err := m.destination.CoreV1().PersistentVolumeClaims(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case kindService:
+		obj, err := m.destination.CoreV1().Services(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case kindDeployment:
+		obj, err := m.destination.AppsV1().Deployments(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case kindStatefulSet:
+		obj, err := m.destination.AppsV1().StatefulSets(m.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+// objectMeta returns the metadata of the copy, dropping the fields owned by
+// the source cluster.
+func (m *migrator) objectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		// Bindings and revisions of the source cluster are meaningless in
+		// the destination cluster.
+		if strings.HasPrefix(k, "pv.kubernetes.io/") ||
+			strings.HasPrefix(k, "volume.beta.kubernetes.io/storage-provisioner") ||
+			strings.HasPrefix(k, "volume.kubernetes.io/") ||
+			k == "deployment.kubernetes.io/revision" {
+			continue
+		}
+		annotations[k] = v
+	}
+	annotations[annotationEmigratedFrom] = m.origin
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   m.namespace,
+		Labels:      meta.Labels,
+		Annotations: annotations,
+	}
+}
+
+func (m *migrator) rewritePodSpec(spec *corev1.PodSpec) {
+	for i := range spec.InitContainers {
+		spec.InitContainers[i].Image = m.rewriteImage(spec.InitContainers[i].Image)
+	}
+	for i := range spec.Containers {
+		spec.Containers[i].Image = m.rewriteImage(spec.Containers[i].Image)
+	}
+}
+
+// rewriteImage replaces the longest registry prefix of the image found in the
+// registry mappings.
+func (m *migrator) rewriteImage(image string) string {
+	prefixes := make([]string, 0, len(m.registryMappings))
+	for prefix := range m.registryMappings {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return len(prefixes[i]) > len(prefixes[j])
+	})
+	for _, prefix := range prefixes {
+		from := strings.TrimSuffix(prefix, "/")
+		if strings.HasPrefix(image, from+"/") {
+			return strings.TrimSuffix(m.registryMappings[prefix], "/") + strings.TrimPrefix(image, from)
+		}
+	}
+	return image
+}
+
+func (m *migrator) rewritePersistentVolumeClaimSpec(spec *corev1.PersistentVolumeClaimSpec) {
+	// The claim is bound to a new volume in the destination cluster.
+	spec.VolumeName = ""
+	if spec.StorageClassName != nil {
+		if storageClass, ok := m.storageClassMappings[*spec.StorageClassName]; ok {
+			spec.StorageClassName = &storageClass
+		}
+	}
+}
+
+func rewriteServiceSpec(spec *corev1.ServiceSpec) {
+	// Cluster IPs and node ports are allocated again by the destination
+	// cluster, except for headless services.
+	if spec.ClusterIP != corev1.ClusterIPNone {
+		spec.ClusterIP = ""
+		spec.ClusterIPs = nil
+	}
+	spec.HealthCheckNodePort = 0
+	for i := range spec.Ports {
+		spec.Ports[i].NodePort = 0
+	}
+}
@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package emigration
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+func newTestMigrator(source, destination *fake.Clientset) *migrator {
+	return newMigrator(source, destination, "cls-a", "team-a", &v1.NsEmigrationSpec{
+		RegistryMappings: map[string]string{
+			"registry-a.example.com":          "registry-b.example.com",
+			"registry-a.example.com/library/": "registry-b.example.com/mirror",
+		},
+		StorageClassMappings: map[string]string{"cbs": "ceph-rbd"},
+	})
+}
+
+func TestMigratorList(t *testing.T) {
+	controller := true
+	source := fake.NewSimpleClientset(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-root-ca.crt", Namespace: "team-a"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "default-token", Namespace: "team-a"}, Type: corev1.SecretTypeServiceAccountToken},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data-db-0", Namespace: "team-a",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "db", Controller: &controller}}}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "team-a"}},
+	)
+	m := newTestMigrator(source, fake.NewSimpleClientset())
+
+	resources, err := m.list(context.Background())
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	want := []string{kindConfigMap + "/config", kindService + "/web", kindDeployment + "/web", kindStatefulSet + "/db"}
+	if len(resources) != len(want) {
+		t.Fatalf("got %d resources, want %v: %+v", len(resources), want, resources)
+	}
+	for i, resource := range resources {
+		if got := resource.Kind + "/" + resource.Name; got != want[i] {
+			t.Errorf("resource %d: got %s, want %s", i, got, want[i])
+		}
+		if resource.Phase != v1.NsEmigrationResourcePending {
+			t.Errorf("resource %d: got phase %s, want %s", i, resource.Phase, v1.NsEmigrationResourcePending)
+		}
+	}
+}
+
+func TestMigratorCopy(t *testing.T) {
+	ctx := context.Background()
+	storageClass := "cbs"
+	source := fake.NewSimpleClientset(
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a", UID: "uid-1",
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": "3", "owner": "team-a"}},
+			Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				InitContainers: []corev1.Container{{Name: "init", Image: "registry-a.example.com/tools/init:v1"}},
+				Containers: []corev1.Container{
+					{Name: "web", Image: "registry-a.example.com/library/nginx:1.19"},
+					{Name: "sidecar", Image: "docker.io/envoyproxy/envoy:v1.16"},
+				},
+			}}},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "team-a",
+				Annotations: map[string]string{"pv.kubernetes.io/bind-completed": "yes"}},
+			Spec: corev1.PersistentVolumeClaimSpec{StorageClassName: &storageClass, VolumeName: "pvc-123"},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "team-a"},
+			Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeNodePort, ClusterIP: "10.0.0.10",
+				Ports: []corev1.ServicePort{{Port: 80, NodePort: 30080}}},
+		},
+	)
+	destination := fake.NewSimpleClientset()
+	m := newTestMigrator(source, destination)
+
+	for _, resource := range []v1.NsEmigrationResourceStatus{
+		{Kind: kindPersistentVolumeClaim, Name: "data"},
+		{Kind: kindService, Name: "web"},
+		{Kind: kindDeployment, Name: "web"},
+	} {
+		if err := m.copy(ctx, resource.Kind, resource.Name); err != nil {
+			t.Fatalf("copy %s %s: %v", resource.Kind, resource.Name, err)
+		}
+	}
+
+	deployment, err := destination.AppsV1().Deployments("team-a").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get deployment: %v", err)
+	}
+	if deployment.UID != "" {
+		t.Errorf("uid of the source deployment was copied")
+	}
+	if _, ok := deployment.Annotations["deployment.kubernetes.io/revision"]; ok {
+		t.Errorf("revision annotation was copied")
+	}
+	if got := deployment.Annotations[annotationEmigratedFrom]; got != "cls-a/team-a" {
+		t.Errorf("got %s annotation %q, want %q", annotationEmigratedFrom, got, "cls-a/team-a")
+	}
+	images := map[string]string{
+		"init":    "registry-b.example.com/tools/init:v1",
+		"web":     "registry-b.example.com/mirror/nginx:1.19",
+		"sidecar": "docker.io/envoyproxy/envoy:v1.16",
+	}
+	containers := append(deployment.Spec.Template.Spec.InitContainers, deployment.Spec.Template.Spec.Containers...)
+	for _, container := range containers {
+		if container.Image != images[container.Name] {
+			t.Errorf("container %s: got image %s, want %s", container.Name, container.Image, images[container.Name])
+		}
+	}
+
+	pvc, err := destination.CoreV1().PersistentVolumeClaims("team-a").Get(ctx, "data", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get pvc: %v", err)
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "ceph-rbd" {
+		t.Errorf("got storage class %v, want ceph-rbd", pvc.Spec.StorageClassName)
+	}
+	if pvc.Spec.VolumeName != "" {
+		t.Errorf("got volume name %s, want it cleared", pvc.Spec.VolumeName)
+	}
+	if _, ok := pvc.Annotations["pv.kubernetes.io/bind-completed"]; ok {
+		t.Errorf("binding annotation was copied")
+	}
+
+	service, err := destination.CoreV1().Services("team-a").Get(ctx, "web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get service: %v", err)
+	}
+	if service.Spec.ClusterIP != "" || service.Spec.Ports[0].NodePort != 0 {
+		t.Errorf("got cluster ip %q and node port %d, want them cleared", service.Spec.ClusterIP, service.Spec.Ports[0].NodePort)
+	}
+
+	// Copying again after a partial failure must not fail.
+	if err := m.copy(ctx, kindDeployment, "web"); err != nil {
+		t.Errorf("copy again: %v", err)
+	}
+}
+
+func TestMigratorCopyConflict(t *testing.T) {
+	source := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"}})
+	destination := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "team-a"}})
+	m := newTestMigrator(source, destination)
+
+	if err := m.copy(context.Background(), kindConfigMap, "config"); err == nil {
+		t.Errorf("expected an error when the resource exists but was not copied by the emigration")
+	}
+}
+
+func TestMigratorRemove(t *testing.T) {
+	ctx := context.Background()
+	source := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "copied", Namespace: "team-a"}})
+	destination := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "team-a"}})
+	m := newTestMigrator(source, destination)
+
+	if err := m.copy(ctx, kindConfigMap, "copied"); err != nil {
+		t.Fatalf("copy: %v", err)
+	}
+	for _, name := range []string{"copied", "existing", "missing"} {
+		if err := m.remove(ctx, kindConfigMap, name); err != nil {
+			t.Errorf("remove %s: %v", name, err)
+		}
+	}
+	if _, err := destination.CoreV1().ConfigMaps("team-a").Get(ctx, "copied", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("copied config map was not removed: %v", err)
+	}
+	if _, err := destination.CoreV1().ConfigMaps("team-a").Get(ctx, "existing", metav1.GetOptions{}); err != nil {
+		t.Errorf("config map not created by the emigration was removed: %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package lifecycle
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// action is what the controller does to a project at some point of its
+// lifecycle.
+type action int
+
+const (
+	actionNone action = iota
+	// actionRestart restarts the lifecycle of a project whose expiry time
+	// changed.
+	actionRestart
+	// actionWarn warns the owner that the project is about to expire.
+	actionWarn
+	// actionExpire locks the expired project.
+	actionExpire
+	// actionDelete deletes the project after the grace period.
+	actionDelete
+)
+
+const (
+	reasonExpiring = "Expiring"
+	reasonExpired  = "Expired"
+)
+
+// Controller is responsible for enforcing the lifecycle of projects.
+type Controller struct {
+	client       clientset.Interface
+	notifyClient notifyversionedclient.NotifyV1Interface
+	lister       businessv1lister.ProjectLister
+	listerSynced cache.InformerSynced
+	options      *Options
+	checkPeriod  time.Duration
+}
+
+// NewController creates a new lifecycle controller. The owners are not warned
+// if notifyClient is nil.
+func NewController(client clientset.Interface, notifyClient notifyversionedclient.NotifyV1Interface,
+	projectInformer businessv1informer.ProjectInformer, options *Options, checkPeriod time.Duration) *Controller {
+	return &Controller{
+		client:       client,
+		notifyClient: notifyClient,
+		lister:       projectInformer.Lister(),
+		listerSynced: projectInformer.Informer().HasSynced,
+		options:      options,
+		checkPeriod:  checkPeriod,
+	}
+}
+
+// Run checks the lifecycle of all projects periodically until stopCh is
+// closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+
+	log.Info("Starting project lifecycle controller")
+	defer log.Info("Shutting down project lifecycle controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for project caches to sync")
+	}
+
+	wait.Until(c.check, c.checkPeriod, stopCh)
+}
+
+func (c *Controller) check() {
+	projects, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list projects", log.Err(err))
+		return
+	}
+	ctx := context.Background()
+	for _, project := range projects {
+		if err := c.process(ctx, project.DeepCopy(), metav1.Now()); err != nil {
+			log.Error("Failed to enforce the lifecycle of project", log.String("projectName", project.Name), log.Err(err))
+		}
+	}
+}
+
+func (c *Controller) process(ctx context.Context, project *v1.Project, now metav1.Time) error {
+	switch nextAction(project, now.Time, c.options) {
+	case actionRestart:
+		log.Info("Project expiry time changed, restarting its lifecycle", log.String("projectName", project.Name))
+		if project.Status.Lifecycle.ExpiredTime != nil {
+			project.Status.Locked = nil
+		}
+		project.Status.Lifecycle = nil
+		return c.persistUpdate(ctx, project)
+	case actionWarn:
+		log.Info("Project is about to expire, warning its owner", log.String("projectName", project.Name),
+			log.String("owner", project.Spec.Lifecycle.Owner))
+		if err := c.notify(ctx, project, reasonExpiring); err != nil {
+			return err
+		}
+		project.Status.Lifecycle = &v1.ProjectLifecycleStatus{
+			ExpiryTime:  project.Spec.Lifecycle.ExpiryTime,
+			WarningTime: &now,
+		}
+		return c.persistUpdate(ctx, project)
+	case actionExpire:
+		log.Info("Project expired, locking it", log.String("projectName", project.Name))
+		if err := c.notify(ctx, project, reasonExpired); err != nil {
+			// The project is locked anyway, the owner can see it.
+			log.Warn("Failed to notify the owner of expired project", log.String("projectName", project.Name), log.Err(err))
+		}
+		status := &v1.ProjectLifecycleStatus{ExpiryTime: project.Spec.Lifecycle.ExpiryTime}
+		if project.Status.Lifecycle != nil {
+			status.WarningTime = project.Status.Lifecycle.WarningTime
+		}
+		status.ExpiredTime = &now
+		locked := true
+		project.Status.Locked = &locked
+		project.Status.Lifecycle = status
+		return c.persistUpdate(ctx, project)
+	case actionDelete:
+		log.Info("Project grace period elapsed, deleting it", log.String("projectName", project.Name))
+		err := c.client.BusinessV1().Projects().Delete(ctx, project.Name, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// nextAction returns what to do to the project at the given time.
+func nextAction(project *v1.Project, now time.Time, options *Options) action {
+	if project.Status.Phase != v1.ProjectActive {
+		return actionNone
+	}
+	var expiry *metav1.Time
+	if project.Spec.Lifecycle != nil {
+		expiry = project.Spec.Lifecycle.ExpiryTime
+	}
+	status := project.Status.Lifecycle
+	if status != nil && !status.ExpiryTime.Equal(expiry) {
+		return actionRestart
+	}
+	if expiry == nil {
+		return actionNone
+	}
+
+	switch {
+	case status != nil && status.ExpiredTime != nil:
+		if options.GracePeriod > 0 && !now.Before(status.ExpiredTime.Add(options.GracePeriod)) {
+			return actionDelete
+		}
+	case !now.Before(expiry.Time):
+		return actionExpire
+	case status == nil || status.WarningTime == nil:
+		if !now.Add(options.WarningPeriod).Before(expiry.Time) {
+			return actionWarn
+		}
+	}
+	return actionNone
+}
+
+// notify sends a message to the notify receivers of the owner of the project.
+func (c *Controller) notify(ctx context.Context, project *v1.Project, reason string) error {
+	if c.notifyClient == nil || c.options.NotifyChannel == "" {
+		return nil
+	}
+	owner := project.Spec.Lifecycle.Owner
+	receiverList, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", project.Spec.TenantID),
+			fields.OneTermEqualSelector("spec.username", owner),
+		).String(),
+	})
+	if err != nil {
+		return err
+	}
+	if len(receiverList.Items) == 0 {
+		log.Warn("Project owner has no notify receiver", log.String("projectName", project.Name), log.String("owner", owner))
+		return nil
+	}
+	receivers := make([]string, 0, len(receiverList.Items))
+	for _, receiver := range receiverList.Items {
+		receivers = append(receivers, receiver.Name)
+	}
+
+	messageRequest := &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: c.options.NotifyChannel,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:     project.Spec.TenantID,
+			TemplateName: c.options.NotifyTemplate,
+			Receivers:    receivers,
+			Variables:    Variables(project, reason),
+		},
+	}
+	_, err = c.notifyClient.MessageRequests(c.options.NotifyChannel).Create(ctx, messageRequest, metav1.CreateOptions{})
+	return err
+}
+
+// Variables returns the template variables of the lifecycle notifications of
+// the project.
+func Variables(project *v1.Project, reason string) map[string]string {
+	lifecycle := project.Spec.Lifecycle
+	return map[string]string{
+		"reason":      reason,
+		"project":     project.Name,
+		"displayName": project.Spec.DisplayName,
+		"owner":       lifecycle.Owner,
+		"costCenter":  lifecycle.CostCenter,
+		"tier":        string(lifecycle.Tier),
+		"expiryTime":  lifecycle.ExpiryTime.Format(time.RFC3339),
+	}
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, project *v1.Project) error {
+	_, err := c.client.BusinessV1().Projects().UpdateStatus(ctx, project, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		log.Info("Not persisting update to project that no longer exists", log.String("projectName", project.Name))
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,169 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package lifecycle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+)
+
+var testNow = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestProject(expiry *time.Time, status *v1.ProjectLifecycleStatus) *v1.Project {
+	project := &v1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj-a"},
+		Spec: v1.ProjectSpec{
+			TenantID:  "default",
+			Lifecycle: &v1.ProjectLifecycle{Owner: "alice", Tier: v1.ProjectTierDevelopment},
+		},
+		Status: v1.ProjectStatus{Phase: v1.ProjectActive, Lifecycle: status},
+	}
+	if expiry != nil {
+		t := metav1.NewTime(*expiry)
+		project.Spec.Lifecycle.ExpiryTime = &t
+	}
+	return project
+}
+
+func timeOf(t time.Time) *metav1.Time {
+	mt := metav1.NewTime(t)
+	return &mt
+}
+
+func TestNextAction(t *testing.T) {
+	options := &Options{WarningPeriod: 24 * time.Hour, GracePeriod: 48 * time.Hour}
+	expiry := testNow.Add(time.Hour)
+	expired := testNow.Add(-time.Hour)
+	far := testNow.Add(72 * time.Hour)
+
+	tests := []struct {
+		name    string
+		project *v1.Project
+		options *Options
+		want    action
+	}{
+		{
+			name:    "no expiry",
+			project: newTestProject(nil, nil),
+			want:    actionNone,
+		},
+		{
+			name:    "far from expiry",
+			project: newTestProject(&far, nil),
+			want:    actionNone,
+		},
+		{
+			name:    "within warning period",
+			project: newTestProject(&expiry, nil),
+			want:    actionWarn,
+		},
+		{
+			name:    "already warned",
+			project: newTestProject(&expiry, &v1.ProjectLifecycleStatus{ExpiryTime: timeOf(expiry), WarningTime: timeOf(testNow)}),
+			want:    actionNone,
+		},
+		{
+			name:    "expired",
+			project: newTestProject(&expired, nil),
+			want:    actionExpire,
+		},
+		{
+			name: "within grace period",
+			project: newTestProject(&expired, &v1.ProjectLifecycleStatus{
+				ExpiryTime: timeOf(expired), ExpiredTime: timeOf(testNow.Add(-time.Hour))}),
+			want: actionNone,
+		},
+		{
+			name: "grace period elapsed",
+			project: newTestProject(&expired, &v1.ProjectLifecycleStatus{
+				ExpiryTime: timeOf(expired), ExpiredTime: timeOf(testNow.Add(-49 * time.Hour))}),
+			want: actionDelete,
+		},
+		{
+			name: "never deleted without grace period",
+			project: newTestProject(&expired, &v1.ProjectLifecycleStatus{
+				ExpiryTime: timeOf(expired), ExpiredTime: timeOf(testNow.Add(-49 * time.Hour))}),
+			options: &Options{WarningPeriod: 24 * time.Hour},
+			want:    actionNone,
+		},
+		{
+			name: "expiry extended",
+			project: newTestProject(&far, &v1.ProjectLifecycleStatus{
+				ExpiryTime: timeOf(expired), ExpiredTime: timeOf(testNow)}),
+			want: actionRestart,
+		},
+		{
+			name: "expiry removed",
+			project: newTestProject(nil, &v1.ProjectLifecycleStatus{
+				ExpiryTime: timeOf(expiry), WarningTime: timeOf(testNow)}),
+			want: actionRestart,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := tt.options
+			if o == nil {
+				o = options
+			}
+			if got := nextAction(tt.project, testNow, o); got != tt.want {
+				t.Errorf("nextAction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProcess(t *testing.T) {
+	expired := testNow.Add(-time.Hour)
+	project := newTestProject(&expired, nil)
+	client := fake.NewSimpleClientset(project)
+	c := &Controller{client: client, options: &Options{WarningPeriod: 24 * time.Hour}}
+	ctx := context.Background()
+
+	if err := c.process(ctx, project.DeepCopy(), metav1.NewTime(testNow)); err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	got, err := client.BusinessV1().Projects().Get(ctx, project.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get project: %v", err)
+	}
+	if got.Status.Locked == nil || !*got.Status.Locked {
+		t.Errorf("expired project is not locked")
+	}
+	if got.Status.Lifecycle == nil || got.Status.Lifecycle.ExpiredTime == nil {
+		t.Fatalf("expired time is not recorded: %+v", got.Status.Lifecycle)
+	}
+
+	// Extending the expiry unlocks the project.
+	far := metav1.NewTime(testNow.Add(72 * time.Hour))
+	got.Spec.Lifecycle.ExpiryTime = &far
+	if err := c.process(ctx, got.DeepCopy(), metav1.NewTime(testNow)); err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	got, err = client.BusinessV1().Projects().Get(ctx, project.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get project: %v", err)
+	}
+	if got.Status.Locked != nil || got.Status.Lifecycle != nil {
+		t.Errorf("extended project is still locked: %v, %+v", got.Status.Locked, got.Status.Lifecycle)
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package lifecycle
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagWarningPeriod  = "project-expiry-warning-period"
+	flagGracePeriod    = "project-expiry-grace-period"
+	flagNotifyChannel  = "project-expiry-notify-channel"
+	flagNotifyTemplate = "project-expiry-notify-template"
+)
+
+const (
+	configWarningPeriod  = "project_lifecycle.expiry_warning_period"
+	configGracePeriod    = "project_lifecycle.expiry_grace_period"
+	configNotifyChannel  = "project_lifecycle.notify_channel"
+	configNotifyTemplate = "project_lifecycle.notify_template"
+)
+
+const defaultWarningPeriod = 7 * 24 * time.Hour
+
+// Options holds the options of the enforcement of the lifecycle of projects.
+type Options struct {
+	// WarningPeriod is how long before a project expires its owner is warned.
+	WarningPeriod time.Duration
+	// GracePeriod is how long an expired project stays locked before it is
+	// deleted, expired projects are never deleted if it is zero.
+	GracePeriod time.Duration
+	// NotifyChannel and NotifyTemplate are the notify channel and template
+	// used to warn the owners, nobody is warned if they are empty.
+	NotifyChannel  string
+	NotifyTemplate string
+}
+
+// NewOptions creates the default Options object.
+func NewOptions() *Options {
+	return &Options{
+		WarningPeriod: defaultWarningPeriod,
+	}
+}
+
+// AddFlags adds flags related to the lifecycle of projects to the specified
+// FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.Duration(flagWarningPeriod, o.WarningPeriod,
+		"How long before a project expires its owner is warned.")
+	_ = viper.BindPFlag(configWarningPeriod, fs.Lookup(flagWarningPeriod))
+	fs.Duration(flagGracePeriod, o.GracePeriod,
+		"How long an expired project stays locked before it is deleted, expired projects are never deleted if it is 0.")
+	_ = viper.BindPFlag(configGracePeriod, fs.Lookup(flagGracePeriod))
+	fs.String(flagNotifyChannel, o.NotifyChannel,
+		"The notify channel used to warn the owners of the projects about to expire.")
+	_ = viper.BindPFlag(configNotifyChannel, fs.Lookup(flagNotifyChannel))
+	fs.String(flagNotifyTemplate, o.NotifyTemplate,
+		"The notify template used to warn the owners of the projects about to expire.")
+	_ = viper.BindPFlag(configNotifyTemplate, fs.Lookup(flagNotifyTemplate))
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *Options) ApplyFlags() []error {
+	var errs []error
+
+	o.WarningPeriod = viper.GetDuration(configWarningPeriod)
+	o.GracePeriod = viper.GetDuration(configGracePeriod)
+	o.NotifyChannel = viper.GetString(configNotifyChannel)
+	o.NotifyTemplate = viper.GetString(configNotifyTemplate)
+
+	if o.WarningPeriod < 0 {
+		errs = append(errs, fmt.Errorf("--%s must not be negative", flagWarningPeriod))
+	}
+	if o.GracePeriod < 0 {
+		errs = append(errs, fmt.Errorf("--%s must not be negative", flagGracePeriod))
+	}
+	if (o.NotifyChannel == "") != (o.NotifyTemplate == "") {
+		errs = append(errs, fmt.Errorf("--%s and --%s must be specified together", flagNotifyChannel, flagNotifyTemplate))
+	}
+	return errs
+}
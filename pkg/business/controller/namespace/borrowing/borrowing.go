@@ -0,0 +1,320 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+// Package borrowing computes the capacity the namespaces of a project borrow
+// from its parent project and sibling projects, and the capacity reclaimed by
+// the lenders.
+package borrowing
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+const (
+	// highWatermarkPercent is the usage of the quota of a namespace, in
+	// percent, from which the namespace borrows capacity.
+	highWatermarkPercent = 90
+	// stepPercent is the quantity borrowed at a time, in percent of the hard
+	// limit of the namespace.
+	stepPercent = 50
+)
+
+// These are the reasons of the changes of loans.
+const (
+	// ReasonBorrowed means the namespace borrowed capacity.
+	ReasonBorrowed = "QuotaBorrowed"
+	// ReasonReturned means the namespace returned capacity it does not need
+	// anymore.
+	ReasonReturned = "QuotaReturned"
+	// ReasonReclaimed means the lender reclaimed capacity it needs.
+	ReasonReclaimed = "QuotaReclaimed"
+)
+
+// Change describes a change of the capacity borrowed by a namespace.
+type Change struct {
+	Reason   string
+	Lender   string
+	Resource string
+	Quantity resource.Quantity
+}
+
+// Message returns a human readable description of the change.
+func (c Change) Message() string {
+	switch c.Reason {
+	case ReasonBorrowed:
+		return fmt.Sprintf("Borrowed %s of %s from project %s", c.Quantity.String(), c.Resource, c.Lender)
+	case ReasonReclaimed:
+		return fmt.Sprintf("Project %s reclaimed %s of %s", c.Lender, c.Quantity.String(), c.Resource)
+	default:
+		return fmt.Sprintf("Returned %s of %s to project %s", c.Quantity.String(), c.Resource, c.Lender)
+	}
+}
+
+// Planner computes the loans of the namespaces of the projects of a tenant.
+type Planner struct {
+	projects map[string]*v1.Project
+}
+
+// NewPlanner creates a planner for the given projects, which must contain the
+// borrowing project, its parent project and its sibling projects.
+func NewPlanner(projects []*v1.Project) *Planner {
+	p := &Planner{projects: make(map[string]*v1.Project, len(projects))}
+	for _, project := range projects {
+		p.projects[project.Name] = project
+	}
+	return p
+}
+
+// Plan updates the loans of the namespace in the status of its project
+// according to the quantities used by the namespace. It returns the changes
+// of the loans, the project is only modified if there are some.
+func (p *Planner) Plan(namespace *v1.Namespace, used v1.ResourceList) []Change {
+	project, ok := p.projects[namespace.Namespace]
+	if !ok {
+		return nil
+	}
+	var changes []Change
+	clusterName := namespace.Spec.ClusterName
+	now := metav1.Now()
+
+	// Return or reclaim the capacity of the current loans first.
+	for i := range project.Status.Loans {
+		loan := &project.Status.Loans[i]
+		if loan.Namespace != namespace.Name || loan.ClusterName != clusterName {
+			continue
+		}
+		for _, name := range sortedNames(loan.Resources) {
+			quantity := loan.Resources[name].DeepCopy()
+			var change *Change
+			if available := p.available(loan.Lender, clusterName, name); available.Sign() < 0 {
+				// The lender allocated capacity it lent, it needs it back.
+				shortfall := available.DeepCopy()
+				shortfall.Neg()
+				reclaimed := quantity.DeepCopy()
+				if shortfall.Cmp(reclaimed) < 0 {
+					reclaimed = shortfall
+				}
+				change = &Change{Reason: ReasonReclaimed, Lender: loan.Lender, Resource: name, Quantity: reclaimed}
+			} else if project.Spec.Borrowing == nil || !reachesHighWatermark(used[name], namespace.Spec.Hard[name]) {
+				// The namespace does not need the capacity anymore.
+				change = &Change{Reason: ReasonReturned, Lender: loan.Lender, Resource: name, Quantity: quantity.DeepCopy()}
+			}
+			if change == nil {
+				continue
+			}
+			quantity.Sub(change.Quantity)
+			if quantity.Sign() > 0 {
+				loan.Resources[name] = quantity
+			} else {
+				delete(loan.Resources, name)
+			}
+			loan.LastTransitionTime = now
+			changes = append(changes, *change)
+		}
+	}
+
+	if project.Spec.Borrowing != nil {
+		ceiling := project.Spec.Borrowing.Ceiling[clusterName].Hard
+		borrowed := Borrowed(project, namespace.Name, clusterName)
+		for _, name := range sortedNames(namespace.Spec.Hard) {
+			hard := namespace.Spec.Hard[name]
+			ceilingQuantity, ok := ceiling[name]
+			if !ok || hard.Sign() <= 0 {
+				continue
+			}
+			limit := ceilingQuantity.DeepCopy()
+			effective := hard.DeepCopy()
+			if q, ok := borrowed[name]; ok {
+				effective.Add(q)
+			}
+			if !reachesHighWatermark(used[name], effective) {
+				continue
+			}
+			wanted := percentOf(hard, stepPercent)
+			limit.Sub(p.borrowedByProject(project, clusterName, name))
+			if limit.Cmp(wanted) < 0 {
+				wanted = limit
+			}
+			for _, lender := range p.lenders(project, clusterName) {
+				if wanted.Sign() <= 0 {
+					break
+				}
+				available := p.available(lender.Name, clusterName, name)
+				if available.Sign() <= 0 {
+					continue
+				}
+				if available.Cmp(wanted) > 0 {
+					available = wanted.DeepCopy()
+				}
+				loan := loanOf(project, lender.Name, namespace.Name, clusterName)
+				q := loan.Resources[name].DeepCopy()
+				q.Add(available)
+				loan.Resources[name] = q
+				loan.LastTransitionTime = now
+				wanted.Sub(available)
+				changes = append(changes, Change{Reason: ReasonBorrowed, Lender: lender.Name, Resource: name, Quantity: available})
+			}
+		}
+	}
+
+	if len(changes) > 0 {
+		loans := project.Status.Loans[:0]
+		for _, loan := range project.Status.Loans {
+			if len(loan.Resources) > 0 {
+				loans = append(loans, loan)
+			}
+		}
+		project.Status.Loans = loans
+	}
+	return changes
+}
+
+// Borrowed returns the quantities borrowed by the namespace of the project in
+// the cluster.
+func Borrowed(project *v1.Project, namespaceName, clusterName string) v1.ResourceList {
+	borrowed := make(v1.ResourceList)
+	for _, loan := range project.Status.Loans {
+		if loan.Namespace != namespaceName || loan.ClusterName != clusterName {
+			continue
+		}
+		for name, quantity := range loan.Resources {
+			q := borrowed[name].DeepCopy()
+			q.Add(quantity)
+			borrowed[name] = q
+		}
+	}
+	if len(borrowed) == 0 {
+		return nil
+	}
+	return borrowed
+}
+
+// lenders returns the projects the project can borrow from in the cluster:
+// its parent project first, then its sibling projects sorted by name.
+func (p *Planner) lenders(project *v1.Project, clusterName string) []*v1.Project {
+	var parent *v1.Project
+	var siblings []*v1.Project
+	for _, other := range p.projects {
+		if other.Name == project.Name || other.Status.Phase == v1.ProjectTerminating {
+			continue
+		}
+		if _, ok := other.Spec.Clusters[clusterName]; !ok {
+			continue
+		}
+		switch {
+		case other.Name == project.Spec.ParentProjectName:
+			parent = other
+		case other.Spec.ParentProjectName == project.Spec.ParentProjectName && other.Spec.TenantID == project.Spec.TenantID:
+			siblings = append(siblings, other)
+		}
+	}
+	sort.Slice(siblings, func(i, j int) bool { return siblings[i].Name < siblings[j].Name })
+	if parent != nil {
+		return append([]*v1.Project{parent}, siblings...)
+	}
+	return siblings
+}
+
+// available returns the quantity of the resource the lender has neither
+// allocated nor lent in the cluster. It is negative if the lender allocated
+// some of the capacity it lent.
+func (p *Planner) available(lenderName, clusterName, name string) resource.Quantity {
+	lender, ok := p.projects[lenderName]
+	if !ok || lender.Status.Phase == v1.ProjectTerminating {
+		// The lender is gone, all of its capacity is reclaimed.
+		q := p.lent(lenderName, clusterName, name)
+		q.Neg()
+		return q
+	}
+	available := lender.Spec.Clusters[clusterName].Hard[name].DeepCopy()
+	if used, ok := lender.Status.Clusters[clusterName].Used[name]; ok {
+		available.Sub(used)
+	}
+	available.Sub(p.lent(lenderName, clusterName, name))
+	return available
+}
+
+// lent returns the quantity of the resource the lender lent in the cluster.
+func (p *Planner) lent(lenderName, clusterName, name string) resource.Quantity {
+	var lent resource.Quantity
+	for _, project := range p.projects {
+		for _, loan := range project.Status.Loans {
+			if loan.Lender == lenderName && loan.ClusterName == clusterName {
+				if q, ok := loan.Resources[name]; ok {
+					lent.Add(q)
+				}
+			}
+		}
+	}
+	return lent
+}
+
+// borrowedByProject returns the quantity of the resource borrowed by all the
+// namespaces of the project in the cluster.
+func (p *Planner) borrowedByProject(project *v1.Project, clusterName, name string) resource.Quantity {
+	var borrowed resource.Quantity
+	for _, loan := range project.Status.Loans {
+		if loan.ClusterName == clusterName {
+			if q, ok := loan.Resources[name]; ok {
+				borrowed.Add(q)
+			}
+		}
+	}
+	return borrowed
+}
+
+func loanOf(project *v1.Project, lender, namespaceName, clusterName string) *v1.ProjectLoan {
+	for i := range project.Status.Loans {
+		loan := &project.Status.Loans[i]
+		if loan.Lender == lender && loan.Namespace == namespaceName && loan.ClusterName == clusterName {
+			return loan
+		}
+	}
+	project.Status.Loans = append(project.Status.Loans, v1.ProjectLoan{
+		Lender:      lender,
+		ClusterName: clusterName,
+		Namespace:   namespaceName,
+		Resources:   make(v1.ResourceList),
+	})
+	return &project.Status.Loans[len(project.Status.Loans)-1]
+}
+
+func reachesHighWatermark(used, hard resource.Quantity) bool {
+	return hard.Sign() > 0 && used.Cmp(percentOf(hard, highWatermarkPercent)) >= 0
+}
+
+func percentOf(q resource.Quantity, percent int64) resource.Quantity {
+	if milli := q.MilliValue(); milli < math.MaxInt64/100 {
+		return *resource.NewMilliQuantity(milli*percent/100, q.Format)
+	}
+	return *resource.NewQuantity(q.Value()/100*percent, q.Format)
+}
+
+func sortedNames(list v1.ResourceList) []string {
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
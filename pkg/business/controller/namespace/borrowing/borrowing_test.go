@@ -0,0 +1,204 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package borrowing
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+const (
+	testCluster = "cls-test"
+	testCPU     = "requests.cpu"
+)
+
+func cpu(value string) v1.ResourceList {
+	return v1.ResourceList{testCPU: resource.MustParse(value)}
+}
+
+func newProject(name, parent, hard, used string) *v1.Project {
+	return &v1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1.ProjectSpec{
+			TenantID:          "default",
+			ParentProjectName: parent,
+			Clusters:          v1.ClusterHard{testCluster: v1.HardQuantity{Hard: cpu(hard)}},
+		},
+		Status: v1.ProjectStatus{
+			Phase:    v1.ProjectActive,
+			Clusters: v1.ClusterUsed{testCluster: v1.UsedQuantity{Used: cpu(used)}},
+		},
+	}
+}
+
+func newNamespace(project, hard string) *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns-test", Namespace: project},
+		Spec: v1.NamespaceSpec{
+			ClusterName: testCluster,
+			Hard:        cpu(hard),
+		},
+	}
+}
+
+func withBorrowing(project *v1.Project, ceiling string) *v1.Project {
+	project.Spec.Borrowing = &v1.ProjectBorrowing{
+		Ceiling: v1.ClusterHard{testCluster: v1.HardQuantity{Hard: cpu(ceiling)}},
+	}
+	return project
+}
+
+func withLoan(project *v1.Project, lender, quantity string) *v1.Project {
+	project.Status.Loans = append(project.Status.Loans, v1.ProjectLoan{
+		Lender:      lender,
+		ClusterName: testCluster,
+		Namespace:   "ns-test",
+		Resources:   cpu(quantity),
+	})
+	return project
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name         string
+		borrower     *v1.Project
+		others       []*v1.Project
+		used         string
+		wantReason   string
+		wantLender   string
+		wantQuantity string
+		wantBorrowed string
+	}{
+		{
+			name:         "borrow from parent",
+			borrower:     withBorrowing(newProject("child", "parent", "4", "4"), "4"),
+			others:       []*v1.Project{newProject("parent", "", "10", "6")},
+			used:         "3800m",
+			wantReason:   ReasonBorrowed,
+			wantLender:   "parent",
+			wantQuantity: "2",
+			wantBorrowed: "2",
+		},
+		{
+			name:         "borrow up to the ceiling",
+			borrower:     withBorrowing(newProject("child", "parent", "4", "4"), "1"),
+			others:       []*v1.Project{newProject("parent", "", "10", "6")},
+			used:         "4",
+			wantReason:   ReasonBorrowed,
+			wantLender:   "parent",
+			wantQuantity: "1",
+			wantBorrowed: "1",
+		},
+		{
+			name:     "borrow from sibling",
+			borrower: withBorrowing(newProject("child", "parent", "4", "4"), "4"),
+			others: []*v1.Project{
+				newProject("parent", "", "8", "8"),
+				newProject("sibling", "parent", "4", "1"),
+			},
+			used:         "4",
+			wantReason:   ReasonBorrowed,
+			wantLender:   "sibling",
+			wantQuantity: "2",
+			wantBorrowed: "2",
+		},
+		{
+			name:     "nothing to borrow",
+			borrower: withBorrowing(newProject("child", "parent", "4", "4"), "4"),
+			others:   []*v1.Project{newProject("parent", "", "8", "8")},
+			used:     "4",
+		},
+		{
+			name:     "no need to borrow",
+			borrower: withBorrowing(newProject("child", "parent", "4", "4"), "4"),
+			others:   []*v1.Project{newProject("parent", "", "10", "6")},
+			used:     "3",
+		},
+		{
+			name:         "lender reclaims",
+			borrower:     withLoan(withBorrowing(newProject("child", "parent", "4", "4"), "4"), "parent", "2"),
+			others:       []*v1.Project{newProject("parent", "", "10", "9")},
+			used:         "5",
+			wantReason:   ReasonReclaimed,
+			wantLender:   "parent",
+			wantQuantity: "1",
+			wantBorrowed: "1",
+		},
+		{
+			name:         "namespace returns",
+			borrower:     withLoan(withBorrowing(newProject("child", "parent", "4", "4"), "4"), "parent", "2"),
+			others:       []*v1.Project{newProject("parent", "", "10", "6")},
+			used:         "1",
+			wantReason:   ReasonReturned,
+			wantLender:   "parent",
+			wantQuantity: "2",
+		},
+		{
+			name:         "borrowing disabled",
+			borrower:     withLoan(newProject("child", "parent", "4", "4"), "parent", "2"),
+			others:       []*v1.Project{newProject("parent", "", "10", "6")},
+			used:         "5",
+			wantReason:   ReasonReturned,
+			wantLender:   "parent",
+			wantQuantity: "2",
+		},
+		{
+			name:         "lender deleted",
+			borrower:     withLoan(withBorrowing(newProject("child", "parent", "4", "4"), "4"), "gone", "2"),
+			others:       []*v1.Project{newProject("parent", "", "4", "4")},
+			used:         "5",
+			wantReason:   ReasonReclaimed,
+			wantLender:   "gone",
+			wantQuantity: "2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planner := NewPlanner(append([]*v1.Project{tt.borrower}, tt.others...))
+			namespace := newNamespace(tt.borrower.Name, "4")
+			changes := planner.Plan(namespace, cpu(tt.used))
+			if tt.wantReason == "" {
+				if len(changes) != 0 {
+					t.Fatalf("Plan() = %v, want no change", changes)
+				}
+				return
+			}
+			if len(changes) != 1 {
+				t.Fatalf("Plan() = %v, want one change", changes)
+			}
+			change := changes[0]
+			if change.Reason != tt.wantReason || change.Lender != tt.wantLender ||
+				change.Quantity.Cmp(resource.MustParse(tt.wantQuantity)) != 0 {
+				t.Errorf("Plan() = %+v, want %s %s from %s", change, tt.wantReason, tt.wantQuantity, tt.wantLender)
+			}
+			borrowed := Borrowed(tt.borrower, namespace.Name, testCluster)
+			if tt.wantBorrowed == "" {
+				if borrowed != nil {
+					t.Errorf("Borrowed() = %v, want nothing", borrowed)
+				}
+				return
+			}
+			if q := borrowed[testCPU]; q.Cmp(resource.MustParse(tt.wantBorrowed)) != 0 {
+				t.Errorf("Borrowed() = %s, want %s", q.String(), tt.wantBorrowed)
+			}
+		})
+	}
+}
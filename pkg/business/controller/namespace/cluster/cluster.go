@@ -130,11 +130,13 @@ func EnsureNamespaceOnCluster(ctx context.Context, kubeClient *kubernetes.Client
 	return nil
 }
 
+// EnsureResourceQuotaOnCluster sizes the resource quota of the namespace on
+// the cluster to its hard limits plus the quantities it borrowed.
 func EnsureResourceQuotaOnCluster(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace) error {
 	resourceQuota, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
 	if err != nil && errors.IsNotFound(err) {
 		// create resource quota
-		resourceList := resource.ConvertToCoreV1ResourceList(namespace.Spec.Hard)
+		resourceList := resource.ConvertToCoreV1ResourceList(effectiveHard(namespace))
 		rq := &corev1.ResourceQuota{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      namespace.Spec.Namespace,
@@ -155,7 +157,7 @@ func EnsureResourceQuotaOnCluster(ctx context.Context, kubeClient *kubernetes.Cl
 		log.Error("Failed to get the resource quota on cluster", log.String("namespace", namespace.Spec.Namespace), log.String("namespaceName", namespace.ObjectMeta.Name), log.String("clusterName", namespace.Spec.ClusterName), log.Err(err))
 		return err
 	}
-	resourceList := resource.ConvertToCoreV1ResourceList(namespace.Spec.Hard)
+	resourceList := resource.ConvertToCoreV1ResourceList(effectiveHard(namespace))
 	if !reflect.DeepEqual(resourceQuota.Spec.Hard, resourceList) {
 		resourceQuota.Spec.Hard = resourceList
 		_, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).Update(ctx, resourceQuota, metav1.UpdateOptions{})
@@ -167,6 +169,50 @@ func EnsureResourceQuotaOnCluster(ctx context.Context, kubeClient *kubernetes.Cl
 	return nil
 }
 
+// effectiveHard returns the hard limits of the namespace plus the quantities it
+// borrowed.
+func effectiveHard(namespace *v1.Namespace) v1.ResourceList {
+	if len(namespace.Status.Borrowed) == 0 {
+		return namespace.Spec.Hard
+	}
+	hard := make(v1.ResourceList, len(namespace.Spec.Hard))
+	for k, v := range namespace.Spec.Hard {
+		quantity := v.DeepCopy()
+		if borrowed, ok := namespace.Status.Borrowed[k]; ok {
+			quantity.Add(borrowed)
+		}
+		hard[k] = quantity
+	}
+	return hard
+}
+
+// RecordResourceQuotaEvent records an event about the resource quota of the
+// namespace on the cluster.
+func RecordResourceQuotaEvent(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, reason, message string) error {
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: namespace.Spec.Namespace + ".",
+			Namespace:    namespace.Spec.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       "ResourceQuota",
+			APIVersion: "v1",
+			Namespace:  namespace.Spec.Namespace,
+			Name:       namespace.Spec.Namespace,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           corev1.EventTypeNormal,
+		Source:         corev1.EventSource{Component: "tke-business-controller"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	_, err := kubeClient.CoreV1().Events(namespace.Spec.Namespace).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
 func DetachFromClusterNamespace(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace) error {
 	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
 	if ns == nil || errors.IsNotFound(err) {
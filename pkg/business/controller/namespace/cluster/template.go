@@ -0,0 +1,499 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// Pod security admission labels set from the namespace template.
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	podSecurityAuditLabel   = "pod-security.kubernetes.io/audit"
+	podSecurityWarnLabel    = "pod-security.kubernetes.io/warn"
+)
+
+// Kinds of the objects rendered from the namespace template.
+const (
+	kindNamespace     = "Namespace"
+	kindLimitRange    = "LimitRange"
+	kindNetworkPolicy = "NetworkPolicy"
+	kindRoleBinding   = "RoleBinding"
+)
+
+// syncResult is the outcome of reconciling a rendered object.
+type syncResult int
+
+const (
+	syncUnchanged syncResult = iota
+	syncCreated
+	syncUpdated
+)
+
+// EnsureNamespaceTemplateOnCluster renders the namespace template onto the
+// kubernetes namespace, corrects the objects that drifted from it and removes
+// the objects rendered from a previous template. The sync state of every
+// rendered object is recorded in the namespace status. A nil template removes
+// all rendered objects and the labels and annotations it set.
+func EnsureNamespaceTemplateOnCluster(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate) error {
+	if template == nil {
+		if _, err := ensureNamespaceMetadata(ctx, kubeClient, namespace, nil); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		namespace.Status.TemplateName = ""
+		namespace.Status.TemplateGeneration = 0
+		namespace.Status.TemplateObjects = nil
+		return pruneTemplateObjects(ctx, kubeClient, namespace, "", nil)
+	}
+
+	// Updates caused by a new template generation are a rollout, not a drift.
+	rollout := namespace.Status.TemplateName != template.ObjectMeta.Name ||
+		namespace.Status.TemplateGeneration != template.ObjectMeta.Generation
+	previous := make(map[string]v1.NamespaceTemplateObjectStatus)
+	for _, status := range namespace.Status.TemplateObjects {
+		previous[objectKey(status.Kind, status.Name)] = status
+	}
+
+	var statuses []v1.NamespaceTemplateObjectStatus
+	wanted := sets.NewString()
+	record := func(kind, name string, result syncResult, err error) {
+		key := objectKey(kind, name)
+		wanted.Insert(key)
+		status, ok := previous[key]
+		if !ok {
+			status = v1.NamespaceTemplateObjectStatus{Kind: kind, Name: name}
+		}
+		now := metav1.Now()
+		if err != nil {
+			log.Error("Failed to render the namespace template object on cluster",
+				log.String("namespace", namespace.Spec.Namespace), log.String("clusterName", namespace.Spec.ClusterName),
+				log.String("kind", kind), log.String("name", name), log.Err(err))
+			status.State = v1.NamespaceTemplateObjectFailed
+			status.Message = err.Error()
+		} else {
+			if result != syncUnchanged || status.State != v1.NamespaceTemplateObjectSynced {
+				status.LastSyncTime = now
+			}
+			if result == syncUpdated && !rollout {
+				log.Info("Corrected the namespace template object drifted on cluster",
+					log.String("namespace", namespace.Spec.Namespace), log.String("clusterName", namespace.Spec.ClusterName),
+					log.String("kind", kind), log.String("name", name))
+				status.LastDriftTime = now
+			}
+			status.State = v1.NamespaceTemplateObjectSynced
+			status.Message = ""
+		}
+		statuses = append(statuses, status)
+	}
+
+	result, err := ensureNamespaceMetadata(ctx, kubeClient, namespace, template)
+	record(kindNamespace, namespace.Spec.Namespace, result, err)
+	if template.Spec.LimitRange != nil {
+		result, err := ensureLimitRange(ctx, kubeClient, namespace, template)
+		record(kindLimitRange, template.ObjectMeta.Name, result, err)
+	}
+	for i := range template.Spec.NetworkPolicies {
+		result, err := ensureNetworkPolicy(ctx, kubeClient, namespace, template, &template.Spec.NetworkPolicies[i])
+		record(kindNetworkPolicy, template.Spec.NetworkPolicies[i].Name, result, err)
+	}
+	for i := range template.Spec.RoleBindings {
+		result, err := ensureRoleBinding(ctx, kubeClient, namespace, template, &template.Spec.RoleBindings[i])
+		record(kindRoleBinding, template.Spec.RoleBindings[i].Name, result, err)
+	}
+
+	namespace.Status.TemplateName = template.ObjectMeta.Name
+	namespace.Status.TemplateGeneration = template.ObjectMeta.Generation
+	namespace.Status.TemplateObjects = statuses
+	return pruneTemplateObjects(ctx, kubeClient, namespace, template.ObjectMeta.Name, wanted)
+}
+
+func objectKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// templateLabels returns the labels of the objects rendered from the template.
+func templateLabels(namespace *v1.Namespace, template *v1.NamespaceTemplate) map[string]string {
+	return map[string]string{
+		util.LabelProjectName:       namespace.ObjectMeta.Namespace,
+		util.LabelNamespaceTemplate: template.ObjectMeta.Name,
+	}
+}
+
+// mergeLabels adds the wanted entries to labels and reports whether anything
+// changed.
+func mergeLabels(labels *map[string]string, wanted map[string]string) bool {
+	changed := false
+	for k, v := range wanted {
+		if current, ok := (*labels)[k]; ok && current == v {
+			continue
+		}
+		if *labels == nil {
+			*labels = make(map[string]string)
+		}
+		(*labels)[k] = v
+		changed = true
+	}
+	return changed
+}
+
+// applyTemplateEntries sets the wanted entries, removes the entries recorded
+// under the record annotation which are no longer wanted, records the comma
+// separated keys of the wanted entries and reports whether anything changed.
+func applyTemplateEntries(entries, annotations *map[string]string, record string, wanted map[string]string) bool {
+	changed := false
+	for _, k := range strings.Split((*annotations)[record], ",") {
+		if _, ok := wanted[k]; ok {
+			continue
+		}
+		if _, ok := (*entries)[k]; ok {
+			delete(*entries, k)
+			changed = true
+		}
+	}
+	if mergeLabels(entries, wanted) {
+		changed = true
+	}
+
+	keys := make([]string, 0, len(wanted))
+	for k := range wanted {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if len(keys) == 0 {
+		if _, ok := (*annotations)[record]; ok {
+			delete(*annotations, record)
+			changed = true
+		}
+		return changed
+	}
+	if mergeLabels(annotations, map[string]string{record: strings.Join(keys, ",")}) {
+		changed = true
+	}
+	return changed
+}
+
+// ensureNamespaceMetadata sets the labels and the annotations of the template
+// on the kubernetes namespace, and removes the ones a previous template set.
+// A nil template removes them all.
+func ensureNamespaceMetadata(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate) (syncResult, error) {
+	labels := make(map[string]string)
+	var annotations map[string]string
+	if template != nil {
+		for k, v := range template.Spec.Labels {
+			labels[k] = v
+		}
+		if podSecurity := template.Spec.PodSecurity; podSecurity != nil {
+			for k, v := range map[string]string{
+				podSecurityEnforceLabel: podSecurity.Enforce,
+				podSecurityAuditLabel:   podSecurity.Audit,
+				podSecurityWarnLabel:    podSecurity.Warn,
+			} {
+				if v != "" {
+					labels[k] = v
+				}
+			}
+		}
+		annotations = template.Spec.Annotations
+	}
+
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return syncUnchanged, err
+	}
+	labelsChanged := applyTemplateEntries(&ns.ObjectMeta.Labels, &ns.ObjectMeta.Annotations, util.AnnotationNamespaceTemplateLabels, labels)
+	annotationsChanged := applyTemplateEntries(&ns.ObjectMeta.Annotations, &ns.ObjectMeta.Annotations, util.AnnotationNamespaceTemplateAnnotations, annotations)
+	if !labelsChanged && !annotationsChanged {
+		return syncUnchanged, nil
+	}
+	if _, err := kubeClient.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{}); err != nil {
+		return syncUnchanged, err
+	}
+	return syncUpdated, nil
+}
+
+func ensureLimitRange(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate) (syncResult, error) {
+	spec := template.Spec.LimitRange.DeepCopy()
+	defaultLimitRangeSpec(spec)
+
+	client := kubeClient.CoreV1().LimitRanges(namespace.Spec.Namespace)
+	limitRange, err := client.Get(ctx, template.ObjectMeta.Name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		limitRange = &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   template.ObjectMeta.Name,
+				Labels: templateLabels(namespace, template),
+			},
+			Spec: *spec,
+		}
+		if _, err := client.Create(ctx, limitRange, metav1.CreateOptions{}); err != nil {
+			return syncUnchanged, err
+		}
+		return syncCreated, nil
+	}
+	if err != nil {
+		return syncUnchanged, err
+	}
+	labelsChanged := mergeLabels(&limitRange.ObjectMeta.Labels, templateLabels(namespace, template))
+	if !labelsChanged && apiequality.Semantic.DeepEqual(&limitRange.Spec, spec) {
+		return syncUnchanged, nil
+	}
+	limitRange.Spec = *spec
+	if _, err := client.Update(ctx, limitRange, metav1.UpdateOptions{}); err != nil {
+		return syncUnchanged, err
+	}
+	return syncUpdated, nil
+}
+
+func ensureNetworkPolicy(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate, wanted *v1.NamespaceTemplateNetworkPolicy) (syncResult, error) {
+	spec := wanted.Spec.DeepCopy()
+	defaultNetworkPolicySpec(spec)
+
+	client := kubeClient.NetworkingV1().NetworkPolicies(namespace.Spec.Namespace)
+	networkPolicy, err := client.Get(ctx, wanted.Name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		networkPolicy = &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   wanted.Name,
+				Labels: templateLabels(namespace, template),
+			},
+			Spec: *spec,
+		}
+		if _, err := client.Create(ctx, networkPolicy, metav1.CreateOptions{}); err != nil {
+			return syncUnchanged, err
+		}
+		return syncCreated, nil
+	}
+	if err != nil {
+		return syncUnchanged, err
+	}
+	labelsChanged := mergeLabels(&networkPolicy.ObjectMeta.Labels, templateLabels(namespace, template))
+	if !labelsChanged && apiequality.Semantic.DeepEqual(&networkPolicy.Spec, spec) {
+		return syncUnchanged, nil
+	}
+	networkPolicy.Spec = *spec
+	if _, err := client.Update(ctx, networkPolicy, metav1.UpdateOptions{}); err != nil {
+		return syncUnchanged, err
+	}
+	return syncUpdated, nil
+}
+
+func ensureRoleBinding(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate, wanted *v1.NamespaceTemplateRoleBinding) (syncResult, error) {
+	subjects := make([]rbacv1.Subject, len(wanted.Subjects))
+	copy(subjects, wanted.Subjects)
+	defaultSubjects(subjects)
+
+	client := kubeClient.RbacV1().RoleBindings(namespace.Spec.Namespace)
+	roleBinding, err := client.Get(ctx, wanted.Name, metav1.GetOptions{})
+	if err == nil && roleBinding.RoleRef != wanted.RoleRef {
+		// The role of a binding is immutable, so it has to be bound again.
+		if err := client.Delete(ctx, wanted.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return syncUnchanged, err
+		}
+		if _, err := createRoleBinding(ctx, kubeClient, namespace, template, wanted, subjects); err != nil {
+			return syncUnchanged, err
+		}
+		return syncUpdated, nil
+	}
+	if err != nil && errors.IsNotFound(err) {
+		if _, err := createRoleBinding(ctx, kubeClient, namespace, template, wanted, subjects); err != nil {
+			return syncUnchanged, err
+		}
+		return syncCreated, nil
+	}
+	if err != nil {
+		return syncUnchanged, err
+	}
+	labelsChanged := mergeLabels(&roleBinding.ObjectMeta.Labels, templateLabels(namespace, template))
+	if !labelsChanged && apiequality.Semantic.DeepEqual(roleBinding.Subjects, subjects) {
+		return syncUnchanged, nil
+	}
+	roleBinding.Subjects = subjects
+	if _, err := client.Update(ctx, roleBinding, metav1.UpdateOptions{}); err != nil {
+		return syncUnchanged, err
+	}
+	return syncUpdated, nil
+}
+
+func createRoleBinding(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, template *v1.NamespaceTemplate, wanted *v1.NamespaceTemplateRoleBinding, subjects []rbacv1.Subject) (*rbacv1.RoleBinding, error) {
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   wanted.Name,
+			Labels: templateLabels(namespace, template),
+		},
+		RoleRef:  wanted.RoleRef,
+		Subjects: subjects,
+	}
+	return kubeClient.RbacV1().RoleBindings(namespace.Spec.Namespace).Create(ctx, roleBinding, metav1.CreateOptions{})
+}
+
+// pruneTemplateObjects deletes the objects rendered from a namespace template
+// that are not wanted by the given template any more.
+func pruneTemplateObjects(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace, templateName string, wanted sets.String) error {
+	listOptions := metav1.ListOptions{LabelSelector: util.LabelNamespaceTemplate}
+	isWanted := func(kind string, meta metav1.ObjectMeta) bool {
+		return meta.Labels[util.LabelNamespaceTemplate] == templateName && wanted.Has(objectKey(kind, meta.Name))
+	}
+	prune := func(kind, name string, del func() error) error {
+		if err := del(); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s %s: %v", kind, name, err)
+		}
+		log.Info("Deleted the object no longer in the namespace template",
+			log.String("namespace", namespace.Spec.Namespace), log.String("clusterName", namespace.Spec.ClusterName),
+			log.String("kind", kind), log.String("name", name))
+		return nil
+	}
+
+	limitRangeClient := kubeClient.CoreV1().LimitRanges(namespace.Spec.Namespace)
+	limitRanges, err := limitRangeClient.List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, item := range limitRanges.Items {
+		if isWanted(kindLimitRange, item.ObjectMeta) {
+			continue
+		}
+		name := item.Name
+		if err := prune(kindLimitRange, name, func() error {
+			return limitRangeClient.Delete(ctx, name, metav1.DeleteOptions{})
+		}); err != nil {
+			return err
+		}
+	}
+
+	networkPolicyClient := kubeClient.NetworkingV1().NetworkPolicies(namespace.Spec.Namespace)
+	networkPolicies, err := networkPolicyClient.List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, item := range networkPolicies.Items {
+		if isWanted(kindNetworkPolicy, item.ObjectMeta) {
+			continue
+		}
+		name := item.Name
+		if err := prune(kindNetworkPolicy, name, func() error {
+			return networkPolicyClient.Delete(ctx, name, metav1.DeleteOptions{})
+		}); err != nil {
+			return err
+		}
+	}
+
+	roleBindingClient := kubeClient.RbacV1().RoleBindings(namespace.Spec.Namespace)
+	roleBindings, err := roleBindingClient.List(ctx, listOptions)
+	if err != nil {
+		return err
+	}
+	for _, item := range roleBindings.Items {
+		if isWanted(kindRoleBinding, item.ObjectMeta) {
+			continue
+		}
+		name := item.Name
+		if err := prune(kindRoleBinding, name, func() error {
+			return roleBindingClient.Delete(ctx, name, metav1.DeleteOptions{})
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// defaultLimitRangeSpec applies the defaults the kubernetes apiserver sets on
+// limit ranges, so the rendered spec can be compared with the stored one.
+func defaultLimitRangeSpec(spec *corev1.LimitRangeSpec) {
+	for i := range spec.Limits {
+		item := &spec.Limits[i]
+		if item.Type != corev1.LimitTypeContainer {
+			continue
+		}
+		if item.Default == nil {
+			item.Default = corev1.ResourceList{}
+		}
+		for k, v := range item.Max {
+			if _, ok := item.Default[k]; !ok {
+				item.Default[k] = v.DeepCopy()
+			}
+		}
+		if item.DefaultRequest == nil {
+			item.DefaultRequest = corev1.ResourceList{}
+		}
+		for k, v := range item.Default {
+			if _, ok := item.DefaultRequest[k]; !ok {
+				item.DefaultRequest[k] = v.DeepCopy()
+			}
+		}
+		for k, v := range item.Min {
+			if _, ok := item.DefaultRequest[k]; !ok {
+				item.DefaultRequest[k] = v.DeepCopy()
+			}
+		}
+	}
+}
+
+// defaultNetworkPolicySpec applies the defaults the kubernetes apiserver sets
+// on network policies, so the rendered spec can be compared with the stored
+// one.
+func defaultNetworkPolicySpec(spec *networkingv1.NetworkPolicySpec) {
+	if len(spec.PolicyTypes) == 0 {
+		spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+		if len(spec.Egress) != 0 {
+			spec.PolicyTypes = append(spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		}
+	}
+	defaultPorts := func(ports []networkingv1.NetworkPolicyPort) {
+		for i := range ports {
+			if ports[i].Protocol == nil {
+				protocol := corev1.ProtocolTCP
+				ports[i].Protocol = &protocol
+			}
+		}
+	}
+	for i := range spec.Ingress {
+		defaultPorts(spec.Ingress[i].Ports)
+	}
+	for i := range spec.Egress {
+		defaultPorts(spec.Egress[i].Ports)
+	}
+}
+
+// defaultSubjects applies the defaults the kubernetes apiserver sets on the
+// subjects of role bindings.
+func defaultSubjects(subjects []rbacv1.Subject) {
+	for i := range subjects {
+		if subjects[i].APIGroup != "" {
+			continue
+		}
+		switch subjects[i].Kind {
+		case rbacv1.UserKind, rbacv1.GroupKind:
+			subjects[i].APIGroup = rbacv1.GroupName
+		}
+	}
+}
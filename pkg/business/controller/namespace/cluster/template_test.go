@@ -0,0 +1,266 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package namespace
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/pkg/business/util"
+)
+
+func newTestNamespace() *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "ns-1", Namespace: "prj-1"},
+		Spec:       v1.NamespaceSpec{ClusterName: "cls-1", Namespace: "team-a"},
+	}
+}
+
+func newTestTemplate() *v1.NamespaceTemplate {
+	return &v1.NamespaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Generation: 1},
+		Spec: v1.NamespaceTemplateSpec{
+			Labels:      map[string]string{"team": "a"},
+			PodSecurity: &v1.NamespaceTemplatePodSecurity{Enforce: "baseline"},
+			LimitRange: &corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{{
+					Type: corev1.LimitTypeContainer,
+					Default: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("500m"),
+					},
+				}},
+			},
+			NetworkPolicies: []v1.NamespaceTemplateNetworkPolicy{{
+				Name: "default-deny",
+				Spec: networkingv1.NetworkPolicySpec{
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+				},
+			}},
+			RoleBindings: []v1.NamespaceTemplateRoleBinding{{
+				Name:     "developers",
+				RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+				Subjects: []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "developers"}},
+			}},
+		},
+	}
+}
+
+func TestEnsureNamespaceTemplateOnCluster(t *testing.T) {
+	ctx := context.Background()
+	namespace := newTestNamespace()
+	template := newTestTemplate()
+	kubeClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(namespace.Status.TemplateObjects) != 4 {
+		t.Fatalf("expected 4 template objects, got %+v", namespace.Status.TemplateObjects)
+	}
+	for _, status := range namespace.Status.TemplateObjects {
+		if status.State != v1.NamespaceTemplateObjectSynced {
+			t.Errorf("%s/%s: expected synced, got %s: %s", status.Kind, status.Name, status.State, status.Message)
+		}
+	}
+
+	ns, _ := kubeClient.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	if ns.Labels["team"] != "a" || ns.Labels[podSecurityEnforceLabel] != "baseline" {
+		t.Errorf("unexpected namespace labels: %v", ns.Labels)
+	}
+	limitRange, err := kubeClient.CoreV1().LimitRanges("team-a").Get(ctx, "default", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("limit range not rendered: %v", err)
+	}
+	if limitRange.Labels[util.LabelNamespaceTemplate] != "default" {
+		t.Errorf("unexpected limit range labels: %v", limitRange.Labels)
+	}
+	if _, ok := limitRange.Spec.Limits[0].DefaultRequest[corev1.ResourceCPU]; !ok {
+		t.Errorf("expected defaulted request, got %+v", limitRange.Spec.Limits[0])
+	}
+	roleBinding, err := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, "developers", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("role binding not rendered: %v", err)
+	}
+	if roleBinding.Subjects[0].APIGroup != rbacv1.GroupName {
+		t.Errorf("expected defaulted subject api group, got %+v", roleBinding.Subjects[0])
+	}
+
+	// Rendering again without any change must not touch the objects.
+	synced := namespace.Status.DeepCopy()
+	kubeClient.ClearActions()
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, action := range kubeClient.Actions() {
+		if action.GetVerb() != "get" && action.GetVerb() != "list" {
+			t.Errorf("unexpected action %s %s", action.GetVerb(), action.GetResource().Resource)
+		}
+	}
+	if len(namespace.Status.TemplateObjects) != len(synced.TemplateObjects) {
+		t.Fatalf("unexpected template objects: %+v", namespace.Status.TemplateObjects)
+	}
+	for i := range synced.TemplateObjects {
+		if namespace.Status.TemplateObjects[i] != synced.TemplateObjects[i] {
+			t.Errorf("unexpected status change: %+v", namespace.Status.TemplateObjects[i])
+		}
+	}
+}
+
+func TestEnsureNamespaceTemplateOnClusterDrift(t *testing.T) {
+	ctx := context.Background()
+	namespace := newTestNamespace()
+	template := newTestTemplate()
+	kubeClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	networkPolicy, _ := kubeClient.NetworkingV1().NetworkPolicies("team-a").Get(ctx, "default-deny", metav1.GetOptions{})
+	networkPolicy.Spec.Ingress = []networkingv1.NetworkPolicyIngressRule{{}}
+	if _, err := kubeClient.NetworkingV1().NetworkPolicies("team-a").Update(ctx, networkPolicy, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	networkPolicy, _ = kubeClient.NetworkingV1().NetworkPolicies("team-a").Get(ctx, "default-deny", metav1.GetOptions{})
+	if len(networkPolicy.Spec.Ingress) != 0 {
+		t.Errorf("drift was not corrected: %+v", networkPolicy.Spec)
+	}
+	for _, status := range namespace.Status.TemplateObjects {
+		drifted := status.Kind == kindNetworkPolicy
+		if drifted == status.LastDriftTime.IsZero() {
+			t.Errorf("%s/%s: unexpected last drift time %v", status.Kind, status.Name, status.LastDriftTime)
+		}
+	}
+
+	// A new template generation is a rollout rather than a drift.
+	template.Spec.RoleBindings[0].RoleRef.Name = "view"
+	template.Generation++
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	roleBinding, _ := kubeClient.RbacV1().RoleBindings("team-a").Get(ctx, "developers", metav1.GetOptions{})
+	if roleBinding.RoleRef.Name != "view" {
+		t.Errorf("role binding was not bound again: %+v", roleBinding.RoleRef)
+	}
+	for _, status := range namespace.Status.TemplateObjects {
+		if status.Kind == kindRoleBinding && !status.LastDriftTime.IsZero() {
+			t.Errorf("rollout reported as drift: %+v", status)
+		}
+	}
+}
+
+func TestEnsureNamespaceTemplateOnClusterPrune(t *testing.T) {
+	ctx := context.Background()
+	namespace := newTestNamespace()
+	template := newTestTemplate()
+	kubeClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	template.Spec.NetworkPolicies = nil
+	template.Generation++
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	networkPolicies, _ := kubeClient.NetworkingV1().NetworkPolicies("team-a").List(ctx, metav1.ListOptions{})
+	if len(networkPolicies.Items) != 0 {
+		t.Errorf("expected network policy to be pruned, got %d", len(networkPolicies.Items))
+	}
+
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	limitRanges, _ := kubeClient.CoreV1().LimitRanges("team-a").List(ctx, metav1.ListOptions{})
+	roleBindings, _ := kubeClient.RbacV1().RoleBindings("team-a").List(ctx, metav1.ListOptions{})
+	if len(limitRanges.Items) != 0 || len(roleBindings.Items) != 0 {
+		t.Errorf("expected all rendered objects to be pruned")
+	}
+	if namespace.Status.TemplateName != "" || len(namespace.Status.TemplateObjects) != 0 {
+		t.Errorf("expected template status to be cleared, got %+v", namespace.Status)
+	}
+}
+
+func TestEnsureNamespaceTemplateOnClusterMetadata(t *testing.T) {
+	ctx := context.Background()
+	namespace := newTestNamespace()
+	template := newTestTemplate()
+	template.Spec.Annotations = map[string]string{"owner": "team-a", "contact": "a@example.com"}
+	kubeClient := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:        "team-a",
+		Labels:      map[string]string{"manual": "true"},
+		Annotations: map[string]string{"note": "kept"},
+	}})
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ns, _ := kubeClient.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	if got := ns.Annotations[util.AnnotationNamespaceTemplateLabels]; got != "pod-security.kubernetes.io/enforce,team" {
+		t.Errorf("unexpected recorded labels %q", got)
+	}
+	if got := ns.Annotations[util.AnnotationNamespaceTemplateAnnotations]; got != "contact,owner" {
+		t.Errorf("unexpected recorded annotations %q", got)
+	}
+
+	// The labels and the annotations removed from the template are removed
+	// from the namespace, the ones set by others are kept.
+	template.Spec.Labels = map[string]string{"tier": "dev"}
+	template.Spec.PodSecurity = nil
+	template.Spec.Annotations = map[string]string{"owner": "team-b"}
+	template.Generation++
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ns, _ = kubeClient.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	expectedLabels := map[string]string{"manual": "true", "tier": "dev"}
+	if !reflect.DeepEqual(ns.Labels, expectedLabels) {
+		t.Errorf("expected labels %v, got %v", expectedLabels, ns.Labels)
+	}
+	expectedAnnotations := map[string]string{
+		"note":                                      "kept",
+		"owner":                                     "team-b",
+		util.AnnotationNamespaceTemplateLabels:      "tier",
+		util.AnnotationNamespaceTemplateAnnotations: "owner",
+	}
+	if !reflect.DeepEqual(ns.Annotations, expectedAnnotations) {
+		t.Errorf("expected annotations %v, got %v", expectedAnnotations, ns.Annotations)
+	}
+
+	// Detaching the template removes all it set.
+	if err := EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ns, _ = kubeClient.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	if expected := map[string]string{"manual": "true"}; !reflect.DeepEqual(ns.Labels, expected) {
+		t.Errorf("expected labels %v, got %v", expected, ns.Labels)
+	}
+	if expected := map[string]string{"note": "kept"}; !reflect.DeepEqual(ns.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, ns.Annotations)
+	}
+}
@@ -242,6 +242,7 @@ type deleteResourceFunc func(ctx context.Context, deleter *namespacedResourcesDe
 
 var deleteResourceFuncs = []deleteResourceFunc{
 	recalculateProjectUsed,
+	releaseBorrowedQuota,
 	deleteNamespaceFromCluster,
 }
 
@@ -307,6 +308,31 @@ func recalculateProjectUsed(ctx context.Context, deleter *namespacedResourcesDel
 	return nil
 }
 
+func releaseBorrowedQuota(ctx context.Context, deleter *namespacedResourcesDeleter, namespace *v1.Namespace) error {
+	log.Debug("Namespace controller - releaseBorrowedQuota", log.String("namespaceName", namespace.ObjectMeta.Name))
+
+	project, err := deleter.businessClient.Projects().Get(ctx, namespace.ObjectMeta.Namespace, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Failed to get the project", log.String("namespaceName", namespace.ObjectMeta.Name), log.String("projectName", namespace.ObjectMeta.Namespace), log.Err(err))
+		return err
+	}
+	var loans []v1.ProjectLoan
+	for _, loan := range project.Status.Loans {
+		if loan.Namespace != namespace.ObjectMeta.Name {
+			loans = append(loans, loan)
+		}
+	}
+	if len(loans) == len(project.Status.Loans) {
+		return nil
+	}
+	project.Status.Loans = loans
+	if _, err := deleter.businessClient.Projects().Update(ctx, project, metav1.UpdateOptions{}); err != nil {
+		log.Error("Failed to update the project status", log.String("namespaceName", namespace.ObjectMeta.Name), log.String("projectName", namespace.ObjectMeta.Namespace), log.Err(err))
+		return err
+	}
+	return nil
+}
+
 func deleteNamespaceFromCluster(ctx context.Context, deleter *namespacedResourcesDeleter, namespace *v1.Namespace) error {
 	kubeClient, err := platformutil.BuildExternalClientSetWithName(ctx, deleter.platformClient, namespace.Spec.ClusterName)
 	if err != nil {
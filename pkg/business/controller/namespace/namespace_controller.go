@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	v1 "tkestack.io/tke/api/business/v1"
@@ -341,7 +342,7 @@ func (c *Controller) handlePhase(ctx context.Context, key string, cachedNamespac
 		return c.persistUpdateNamespace(ctx, namespace)
 	case v1.NamespaceAvailable:
 		// Since project has been updated, try our best update namespace CachedSpecHard.
-		cachedHard := namespace.Status.CachedSpecHard
+		cachedStatus := namespace.Status.DeepCopy()
 		namespace.Status.CachedSpecHard = namespace.Spec.Hard
 		if err := c.ensureNamespaceOnCluster(ctx, namespace); err != nil {
 			namespace.Status.Phase = v1.NamespaceFailed
@@ -350,7 +351,7 @@ func (c *Controller) handlePhase(ctx context.Context, key string, cachedNamespac
 			namespace.Status.LastTransitionTime = metav1.Now()
 			return c.persistUpdateNamespace(ctx, namespace)
 		}
-		if !reflect.DeepEqual(namespace.Spec.Hard, cachedHard) {
+		if !reflect.DeepEqual(&namespace.Status, cachedStatus) {
 			_ = c.persistUpdateNamespace(ctx, namespace)
 		}
 		c.startNamespaceHealthCheck(key)
@@ -411,7 +412,27 @@ func (c *Controller) ensureNamespaceOnCluster(ctx context.Context, namespace *v1
 	if err := cls.EnsureNamespaceOnCluster(ctx, kubeClient, namespace); err != nil {
 		return err
 	}
-	return cls.EnsureResourceQuotaOnCluster(ctx, kubeClient, namespace)
+	if err := cls.EnsureResourceQuotaOnCluster(ctx, kubeClient, namespace); err != nil {
+		return err
+	}
+	return c.ensureNamespaceTemplateOnCluster(ctx, kubeClient, namespace)
+}
+
+func (c *Controller) ensureNamespaceTemplateOnCluster(ctx context.Context, kubeClient kubernetes.Interface, namespace *v1.Namespace) error {
+	project, err := c.client.BusinessV1().Projects().Get(ctx, namespace.ObjectMeta.Namespace, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Failed to get the project", log.String("projectName", namespace.ObjectMeta.Namespace), log.Err(err))
+		return err
+	}
+	var template *v1.NamespaceTemplate
+	if project.Spec.NamespaceTemplate != "" {
+		template, err = c.client.BusinessV1().NamespaceTemplates().Get(ctx, project.Spec.NamespaceTemplate, metav1.GetOptions{})
+		if err != nil {
+			log.Error("Failed to get the namespace template", log.String("projectName", project.ObjectMeta.Name), log.String("namespaceTemplate", project.Spec.NamespaceTemplate), log.Err(err))
+			return err
+		}
+	}
+	return cls.EnsureNamespaceTemplateOnCluster(ctx, kubeClient, namespace, template)
 }
 
 func (c *Controller) persistUpdateNamespace(ctx context.Context, namespace *v1.Namespace) error {
@@ -27,10 +27,13 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/pkg/business/controller/namespace/borrowing"
 	cls "tkestack.io/tke/pkg/business/controller/namespace/cluster"
 	"tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/log"
@@ -136,12 +139,73 @@ func (c *Controller) checkNamespaceHealth(ctx context.Context, namespace *v1.Nam
 		namespace.Status.Reason = reason
 		return c.persistUpdateNamespace(ctx, namespace)
 	}
-	if !reflect.DeepEqual(namespace.Status.Used, used) {
-		log.Infof("%s:%s update used", namespace.Namespace, namespace.Name)
-		namespace.Status.Used = used
+	// Correct the objects rendered from the namespace template that drifted
+	// since the last check.
+	cachedStatus := namespace.Status.DeepCopy()
+	if err := c.ensureNamespaceTemplateOnCluster(ctx, kubeClient, namespace); err != nil {
+		namespace.Status.Phase = v1.NamespaceFailed
+		namespace.Status.LastTransitionTime = metav1.Now()
+		namespace.Status.Message = "EnsureNamespaceTemplateError"
+		namespace.Status.Reason = err.Error()
+		return c.persistUpdateNamespace(ctx, namespace)
+	}
+	namespace.Status.Used = used
+	// Borrow capacity for the namespace running out of quota, and give back
+	// what it does not need or the lenders reclaim.
+	if err := c.ensureBorrowing(ctx, kubeClient, namespace, used); err != nil {
+		log.Error("Failed to ensure the borrowed quota of namespace", log.String("projectName", namespace.Namespace), log.String("namespaceName", namespace.Name), log.Err(err))
+	}
+	if !reflect.DeepEqual(&namespace.Status, cachedStatus) {
+		log.Infof("%s:%s update status", namespace.Namespace, namespace.Name)
 		namespace.Status.Message = ""
 		namespace.Status.Reason = ""
 		return c.persistUpdateNamespace(ctx, namespace)
 	}
 	return nil
 }
+
+// ensureBorrowing updates the loans of the namespace in the status of its
+// project and resizes the resource quota of the namespace on the cluster
+// accordingly.
+func (c *Controller) ensureBorrowing(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace, used v1.ResourceList) error {
+	project, err := c.client.BusinessV1().Projects().Get(ctx, namespace.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if project.Spec.Borrowing == nil && borrowing.Borrowed(project, namespace.Name, namespace.Spec.ClusterName) == nil {
+		namespace.Status.Borrowed = nil
+		return nil
+	}
+
+	projectList, err := c.client.BusinessV1().Projects().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.tenantID", project.Spec.TenantID).String(),
+	})
+	if err != nil {
+		return err
+	}
+	projects := []*v1.Project{project}
+	for i := range projectList.Items {
+		if projectList.Items[i].Name != project.Name {
+			projects = append(projects, &projectList.Items[i])
+		}
+	}
+	changes := borrowing.NewPlanner(projects).Plan(namespace, used)
+	if len(changes) > 0 {
+		if err := c.persistUpdateProject(ctx, project); err != nil {
+			return err
+		}
+	}
+
+	namespace.Status.Borrowed = borrowing.Borrowed(project, namespace.Name, namespace.Spec.ClusterName)
+	if err := cls.EnsureResourceQuotaOnCluster(ctx, kubeClient, namespace); err != nil {
+		return err
+	}
+	for _, change := range changes {
+		log.Info("Namespace borrowed quota changed", log.String("projectName", namespace.Namespace), log.String("namespaceName", namespace.Name),
+			log.String("reason", change.Reason), log.String("message", change.Message()))
+		if err := cls.RecordResourceQuotaEvent(ctx, kubeClient, namespace, change.Reason, change.Message()); err != nil {
+			log.Warn("Failed to record resource quota event", log.String("namespaceName", namespace.Name), log.Err(err))
+		}
+	}
+	return nil
+}
@@ -254,6 +254,7 @@ var deleteResourceFuncs = []deleteResourceFunc{
 	deleteChartGroups,
 	deleteImageNamespaces,
 	deleteNamespaces,
+	deleteQuotaRequests,
 	deleteChildProjects,
 	recalculateParentProjectUsed,
 	deleteBusinessUsersFromAuth,
@@ -360,6 +361,16 @@ func deleteNamespaces(ctx context.Context, deleter *projectedResourcesDeleter, p
 	return nil
 }
 
+func deleteQuotaRequests(ctx context.Context, deleter *projectedResourcesDeleter, project *businessv1.Project) error {
+	log.Debug("Project controller - deleteQuotaRequests", log.String("projectName", project.ObjectMeta.Name))
+
+	if err := deleter.businessClient.QuotaRequests(project.ObjectMeta.Name).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{}); err != nil {
+		log.Error("Project controller - failed to delete quotaRequests", log.String("projectName", project.ObjectMeta.Name), log.Err(err))
+		return err
+	}
+	return nil
+}
+
 func deleteImageNamespaces(ctx context.Context, deleter *projectedResourcesDeleter, project *businessv1.Project) error {
 	if !deleter.registryEnabled {
 		return nil
@@ -0,0 +1,272 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package quotarequest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	quotaRequestEnqueueGracePeriod = 1 * time.Second
+)
+
+const (
+	controllerName = "quotarequest-controller"
+)
+
+// Controller is responsible for notifying the decisions on quota requests.
+type Controller struct {
+	client       clientset.Interface
+	notifyClient notifyversionedclient.NotifyV1Interface
+	queue        workqueue.RateLimitingInterface
+	lister       businessv1lister.QuotaRequestLister
+	listerSynced cache.InformerSynced
+}
+
+// NewController creates a new quota request controller.
+func NewController(client clientset.Interface, notifyClient notifyversionedclient.NotifyV1Interface,
+	quotaRequestInformer businessv1informer.QuotaRequestInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:       client,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("quotarequest_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	quotaRequestInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				cur, ok := newObj.(*v1.QuotaRequest)
+				if ok && needsNotification(cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = quotaRequestInformer.Lister()
+	controller.listerSynced = quotaRequestInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.AddAfter(key, quotaRequestEnqueueGracePeriod)
+}
+
+func needsNotification(request *v1.QuotaRequest) bool {
+	return request.Status.Phase != v1.QuotaRequestPending && request.Status.Phase != "" && !request.Status.Notified
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting quota request controller")
+	defer log.Info("Shutting down quota request controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for quota request caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of quota request objects.
+// Each quota request can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same quota request at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the quota request to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the quota request with the given key. This function is
+// not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+
+	defer func() {
+		log.Info("Finished syncing quota request", log.String("quotaRequest", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	request, err := c.lister.QuotaRequests(namespace).Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Quota request has been deleted.", log.String("quotaRequest", key))
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve quota request from store", log.String("quotaRequest", key), log.Err(err))
+		return err
+	}
+	if !needsNotification(request) {
+		return nil
+	}
+	return c.notify(context.Background(), request.DeepCopy())
+}
+
+// notify sends the decision on the quota request through the notify channel
+// configured by the approval settings of the project, and records the result
+// in the status of the request.
+func (c *Controller) notify(ctx context.Context, request *v1.QuotaRequest) error {
+	notification, err := c.notificationOf(ctx, request.Namespace)
+	if err != nil {
+		return err
+	}
+	if notification == nil {
+		log.Debug("No notification is configured for quota requests", log.String("projectName", request.Namespace))
+		return nil
+	}
+
+	_, err = c.notifyClient.MessageRequests(notification.ChannelName).Create(ctx, messageRequest(request, notification), metav1.CreateOptions{})
+	if err != nil {
+		log.Error("Failed to create message request of quota request",
+			log.String("quotaRequest", request.Name), log.String("projectName", request.Namespace), log.Err(err))
+		request.Status.Message = fmt.Sprintf("failed to notify: %v", err)
+	} else {
+		request.Status.Notified = true
+		request.Status.Message = ""
+	}
+	if _, updateErr := c.client.BusinessV1().QuotaRequests(request.Namespace).UpdateStatus(ctx, request, metav1.UpdateOptions{}); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+// notificationOf returns the notification settings of the approvers of the
+// quota requests of the project: those of its parent project, or its own if
+// it has no parent project.
+func (c *Controller) notificationOf(ctx context.Context, projectName string) (*v1.QuotaRequestNotification, error) {
+	project, err := c.client.BusinessV1().Projects().Get(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if project.Spec.ParentProjectName != "" {
+		project, err = c.client.BusinessV1().Projects().Get(ctx, project.Spec.ParentProjectName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if project.Spec.QuotaApproval == nil {
+		return nil, nil
+	}
+	return project.Spec.QuotaApproval.Notification, nil
+}
+
+func messageRequest(request *v1.QuotaRequest, notification *v1.QuotaRequestNotification) *notifyv1.MessageRequest {
+	return &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: notification.ChannelName,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:       request.Spec.TenantID,
+			TemplateName:   notification.TemplateName,
+			Receivers:      notification.Receivers,
+			ReceiverGroups: notification.ReceiverGroups,
+			Variables:      Variables(request),
+		},
+	}
+}
+
+// Variables returns the template variables of the decision on the quota
+// request.
+func Variables(request *v1.QuotaRequest) map[string]string {
+	hard := make([]string, 0, len(request.Spec.Hard))
+	for k, v := range request.Spec.Hard {
+		hard = append(hard, fmt.Sprintf("%s=%s", k, v.String()))
+	}
+	sort.Strings(hard)
+
+	return map[string]string{
+		"quotaRequest":  request.Name,
+		"project":       request.Namespace,
+		"cluster":       request.Spec.ClusterName,
+		"hard":          strings.Join(hard, ","),
+		"justification": request.Spec.Justification,
+		"requester":     request.Spec.Requester,
+		"phase":         string(request.Status.Phase),
+		"reviewer":      request.Status.Reviewer,
+		"comment":       request.Status.Comment,
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package usage
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	"tkestack.io/tke/pkg/business/billing"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Controller records the used quota of every namespace into the usage
+// history store periodically.
+type Controller struct {
+	lister       businessv1lister.NamespaceLister
+	listerSynced cache.InformerSynced
+	store        billing.Store
+	samplePeriod time.Duration
+}
+
+// NewController creates a new usage controller sampling the namespaces every
+// samplePeriod.
+func NewController(namespaceInformer businessv1informer.NamespaceInformer, store billing.Store, samplePeriod time.Duration) *Controller {
+	return &Controller{
+		lister:       namespaceInformer.Lister(),
+		listerSynced: namespaceInformer.Informer().HasSynced,
+		store:        store,
+		samplePeriod: samplePeriod,
+	}
+}
+
+// Run samples the namespaces until stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+
+	log.Info("Starting usage controller")
+	defer log.Info("Shutting down usage controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for namespace caches to sync")
+		return
+	}
+
+	wait.Until(c.sample, c.samplePeriod, stopCh)
+}
+
+// sample writes a usage sample for every namespace using resources. Samples
+// are not retried, a missed one is a gap in the history.
+func (c *Controller) sample() {
+	namespaces, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list namespaces", log.Err(err))
+		return
+	}
+	now := time.Now()
+	samples := make([]billing.Sample, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if len(namespace.Status.Used) == 0 {
+			continue
+		}
+		samples = append(samples, billing.SampleFromNamespace(namespace, now, c.samplePeriod))
+	}
+	if len(samples) == 0 {
+		return
+	}
+	if err := c.store.Write(samples); err != nil {
+		log.Error("Failed to write usage samples", log.Int("samples", len(samples)), log.Err(err))
+	}
+}
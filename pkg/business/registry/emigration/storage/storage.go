@@ -149,9 +149,10 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 
 	em := nsObj.(*business.NsEmigration)
 
-	if em.Status.Phase != business.NsEmigrationFinished && em.Status.Phase != business.NsEmigrationFailed {
-		return nil, false, fmt.Errorf("emigration is in %s phase, only %s or %s emigrations can be deleted",
-			em.Status.Phase, business.NsEmigrationFinished, business.NsEmigrationFailed)
+	if em.Status.Phase != business.NsEmigrationFinished && em.Status.Phase != business.NsEmigrationFailed &&
+		em.Status.Phase != business.NsEmigrationRolledBack {
+		return nil, false, fmt.Errorf("emigration is in %s phase, only %s, %s or %s emigrations can be deleted",
+			em.Status.Phase, business.NsEmigrationFinished, business.NsEmigrationFailed, business.NsEmigrationRolledBack)
 	}
 
 	// Ensure we have a UID precondition
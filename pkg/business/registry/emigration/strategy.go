@@ -113,7 +113,7 @@ func (s *Strategy) AfterCreate(obj runtime.Object) error {
 
 // Validate validates a new emigration.
 func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return ValidateNsEmigrationCreate(ctx, obj.(*business.NsEmigration), s.businessClient)
+	return ValidateNsEmigrationCreate(ctx, obj.(*business.NsEmigration), s.businessClient, s.platformClient)
 }
 
 // AllowCreateOnUpdate is false for emigrations.
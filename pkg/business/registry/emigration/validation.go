@@ -21,6 +21,7 @@ package emigration
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
 	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/pkg/business/registry/namespace"
 )
 
@@ -36,7 +38,8 @@ import (
 var _validateNsEmigrationName = apimachineryvalidation.NameIsDNSLabel
 
 // ValidateNsEmigrationCreate tests if required fields in the NsEmigration are set correctly.
-func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmigration, businessClient *businessinternalclient.BusinessClient) field.ErrorList {
+func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmigration, businessClient *businessinternalclient.BusinessClient,
+	platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
 	allErrs := validateNsEmigration(emigration, businessClient)
 
 	fldNamespace := field.NewPath("spec", "namespace")
@@ -56,9 +59,20 @@ func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmig
 	fldDestPrj := field.NewPath("spec", "destination")
 	if emigration.Spec.Destination == "" {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination, "empty project name"))
-	} else if emigration.Spec.Destination == emigration.Namespace {
+	} else if emigration.Spec.Destination == emigration.Namespace && emigration.Spec.DestinationCluster == "" {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination, "is still the current project"))
 	}
+
+	// A cross-cluster emigration creates the new namespace on the destination
+	// cluster, so the destination project must have quota there.
+	destinationName := emigration.Spec.Namespace
+	if emigration.Spec.DestinationCluster != "" && ns != nil {
+		allErrs = append(allErrs, validateDestinationCluster(ctx, emigration, ns, platformClient)...)
+		ns = ns.DeepCopy()
+		ns.Spec.ClusterName = emigration.Spec.DestinationCluster
+		destinationName = fmt.Sprintf("%s-%s", ns.Spec.ClusterName, ns.Spec.Namespace)
+	}
+
 	project, err := businessClient.Projects().Get(ctx, emigration.Spec.Destination, v1.GetOptions{})
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination,
@@ -68,18 +82,54 @@ func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmig
 		fldHard := field.NewPath(fmt.Sprintf("namespace(%s)", emigration.Spec.Namespace), "spec", "hard")
 		allErrs = append(allErrs, namespace.ValidateAgainstProject(ns, nil, project, fldProject, fldHard)...)
 	}
-	_, err = businessClient.Namespaces(emigration.Spec.Destination).Get(ctx, emigration.Spec.Namespace, v1.GetOptions{})
+	_, err = businessClient.Namespaces(emigration.Spec.Destination).Get(ctx, destinationName, v1.GetOptions{})
 	if err == nil {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination,
-			fmt.Sprintf("already has a namespace with the name %s", emigration.Spec.Namespace)))
+			fmt.Sprintf("already has a namespace with the name %s", destinationName)))
 	} else if !errors.IsNotFound(err) {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination,
-			fmt.Sprintf("failed to check whether there is a namespace with the name %s, %s", emigration.Spec.Namespace, err)))
+			fmt.Sprintf("failed to check whether there is a namespace with the name %s, %s", destinationName, err)))
 	}
 
 	return allErrs
 }
 
+// validateDestinationCluster tests if the destination cluster and the
+// mappings of a cross-cluster emigration are set correctly.
+func validateDestinationCluster(ctx context.Context, emigration *business.NsEmigration, ns *business.Namespace,
+	platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fldCluster := field.NewPath("spec", "destinationCluster")
+	if emigration.Spec.DestinationCluster == ns.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(fldCluster, emigration.Spec.DestinationCluster, "is still the current cluster"))
+	}
+	cluster, err := platformClient.Clusters().Get(ctx, emigration.Spec.DestinationCluster, v1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldCluster, emigration.Spec.DestinationCluster))
+		} else {
+			allErrs = append(allErrs, field.InternalError(fldCluster, err))
+		}
+	} else if cluster.Spec.TenantID != emigration.Spec.TenantID {
+		allErrs = append(allErrs, field.NotFound(fldCluster, emigration.Spec.DestinationCluster))
+	}
+
+	fldRegistryMappings := field.NewPath("spec", "registryMappings")
+	for from, to := range emigration.Spec.RegistryMappings {
+		if strings.Trim(from, "/") == "" || strings.Trim(to, "/") == "" {
+			allErrs = append(allErrs, field.Invalid(fldRegistryMappings.Key(from), to, "must map a registry prefix to another one"))
+		}
+	}
+	fldStorageClassMappings := field.NewPath("spec", "storageClassMappings")
+	for from, to := range emigration.Spec.StorageClassMappings {
+		if from == "" || to == "" {
+			allErrs = append(allErrs, field.Invalid(fldStorageClassMappings.Key(from), to, "must map a storage class to another one"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateNsEmigrationUpdate tests if required fields in the NsEmigration are set during
 // an update.
 func ValidateNsEmigrationUpdate(ctx context.Context, emigration, old *business.NsEmigration, businessClient *businessinternalclient.BusinessClient) field.ErrorList {
@@ -270,6 +270,10 @@ func (getter testObjectGetter) Namespace(ctx context.Context, project, name stri
 	return nil, fmt.Errorf("failed to get namespace by project '%s' and name '%s'", project, name)
 }
 
+func (getter testObjectGetter) NamespaceTemplate(ctx context.Context, name string, options apimachinerymetav1.GetOptions) (*business.NamespaceTemplate, error) {
+	return nil, fmt.Errorf("failed to get namespace template by name '%s'", name)
+}
+
 type testClusterGetter struct{}
 
 func (getter testClusterGetter) Cluster(ctx context.Context, name string, options apimachinerymetav1.GetOptions) (*platformv1.Cluster, error) {
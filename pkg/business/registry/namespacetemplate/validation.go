@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package namespacetemplate
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	"tkestack.io/tke/pkg/business/util"
+)
+
+// ValidateNamespaceTemplateName is a ValidateNameFunc for names that must be a
+// DNS label.
+var ValidateNamespaceTemplateName = apimachineryvalidation.NameIsDNSLabel
+
+var (
+	podSecurityLevels   = sets.NewString("privileged", "baseline", "restricted")
+	limitTypes          = sets.NewString(string(corev1.LimitTypePod), string(corev1.LimitTypeContainer), string(corev1.LimitTypePersistentVolumeClaim))
+	roleRefKinds        = sets.NewString("Role", "ClusterRole")
+	subjectKinds        = sets.NewString(rbacv1.UserKind, rbacv1.GroupKind, rbacv1.ServiceAccountKind)
+	reservedLabels      = sets.NewString(util.LabelProjectName, util.LabelNamespaceName, util.LabelNamespaceTemplate)
+	reservedAnnotations = sets.NewString(util.AnnotationNamespaceTemplateLabels, util.AnnotationNamespaceTemplateAnnotations)
+)
+
+// ValidateNamespaceTemplate tests if required fields in the namespace template
+// are set.
+func ValidateNamespaceTemplate(template *business.NamespaceTemplate) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&template.ObjectMeta,
+		false, ValidateNamespaceTemplateName, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	allErrs = append(allErrs, metav1validation.ValidateLabels(template.Spec.Labels, fldSpecPath.Child("labels"))...)
+	for k := range template.Spec.Labels {
+		if reservedLabels.Has(k) {
+			allErrs = append(allErrs, field.Forbidden(fldSpecPath.Child("labels").Key(k), "label is managed by the platform"))
+		}
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(template.Spec.Annotations, fldSpecPath.Child("annotations"))...)
+	for k := range template.Spec.Annotations {
+		if reservedAnnotations.Has(k) {
+			allErrs = append(allErrs, field.Forbidden(fldSpecPath.Child("annotations").Key(k), "annotation is managed by the platform"))
+		}
+	}
+
+	if podSecurity := template.Spec.PodSecurity; podSecurity != nil {
+		fldPodSecurityPath := fldSpecPath.Child("podSecurity")
+		for _, mode := range []struct{ name, level string }{
+			{"enforce", podSecurity.Enforce},
+			{"audit", podSecurity.Audit},
+			{"warn", podSecurity.Warn},
+		} {
+			if mode.level != "" && !podSecurityLevels.Has(mode.level) {
+				allErrs = append(allErrs, field.NotSupported(fldPodSecurityPath.Child(mode.name), mode.level, podSecurityLevels.List()))
+			}
+		}
+	}
+
+	if template.Spec.LimitRange != nil {
+		fldLimitsPath := fldSpecPath.Child("limitRange", "limits")
+		for i, limit := range template.Spec.LimitRange.Limits {
+			if !limitTypes.Has(string(limit.Type)) {
+				allErrs = append(allErrs, field.NotSupported(fldLimitsPath.Index(i).Child("type"), limit.Type, limitTypes.List()))
+			}
+			for name, quantities := range map[string]corev1.ResourceList{
+				"max":                  limit.Max,
+				"min":                  limit.Min,
+				"default":              limit.Default,
+				"defaultRequest":       limit.DefaultRequest,
+				"maxLimitRequestRatio": limit.MaxLimitRequestRatio,
+			} {
+				for k, v := range quantities {
+					if v.Sign() < 0 {
+						allErrs = append(allErrs, field.Invalid(fldLimitsPath.Index(i).Child(name).Key(string(k)), v.String(), "must be greater than or equal to 0"))
+					}
+				}
+			}
+		}
+	}
+
+	fldNetworkPoliciesPath := fldSpecPath.Child("networkPolicies")
+	networkPolicyNames := sets.NewString()
+	for i, networkPolicy := range template.Spec.NetworkPolicies {
+		fldNamePath := fldNetworkPoliciesPath.Index(i).Child("name")
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(networkPolicy.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldNamePath, networkPolicy.Name, msg))
+		}
+		if networkPolicyNames.Has(networkPolicy.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldNamePath, networkPolicy.Name))
+		}
+		networkPolicyNames.Insert(networkPolicy.Name)
+	}
+
+	fldRoleBindingsPath := fldSpecPath.Child("roleBindings")
+	roleBindingNames := sets.NewString()
+	for i, roleBinding := range template.Spec.RoleBindings {
+		fldRoleBindingPath := fldRoleBindingsPath.Index(i)
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(roleBinding.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldRoleBindingPath.Child("name"), roleBinding.Name, msg))
+		}
+		if roleBindingNames.Has(roleBinding.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldRoleBindingPath.Child("name"), roleBinding.Name))
+		}
+		roleBindingNames.Insert(roleBinding.Name)
+
+		fldRoleRefPath := fldRoleBindingPath.Child("roleRef")
+		if roleBinding.RoleRef.APIGroup != rbacv1.GroupName {
+			allErrs = append(allErrs, field.NotSupported(fldRoleRefPath.Child("apiGroup"), roleBinding.RoleRef.APIGroup, []string{rbacv1.GroupName}))
+		}
+		if !roleRefKinds.Has(roleBinding.RoleRef.Kind) {
+			allErrs = append(allErrs, field.NotSupported(fldRoleRefPath.Child("kind"), roleBinding.RoleRef.Kind, roleRefKinds.List()))
+		}
+		if roleBinding.RoleRef.Name == "" {
+			allErrs = append(allErrs, field.Required(fldRoleRefPath.Child("name"), "must specify role name"))
+		}
+		for j, subject := range roleBinding.Subjects {
+			fldSubjectPath := fldRoleBindingPath.Child("subjects").Index(j)
+			if !subjectKinds.Has(subject.Kind) {
+				allErrs = append(allErrs, field.NotSupported(fldSubjectPath.Child("kind"), subject.Kind, subjectKinds.List()))
+			}
+			if subject.Name == "" {
+				allErrs = append(allErrs, field.Required(fldSubjectPath.Child("name"), "must specify subject name"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNamespaceTemplateUpdate tests if required fields in the namespace
+// template are set during an update.
+func ValidateNamespaceTemplateUpdate(template *business.NamespaceTemplate, old *business.NamespaceTemplate) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&template.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateNamespaceTemplate(template)...)
+
+	if template.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"),
+			template.Spec.TenantID, fmt.Sprintf("disallowed change the tenant from %s", old.Spec.TenantID)))
+	}
+
+	return allErrs
+}
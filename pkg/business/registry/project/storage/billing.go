@@ -0,0 +1,193 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/business/billing"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// BillingREST implements the REST endpoint for getting the billing report of
+// a project and its sub-projects.
+type BillingREST struct {
+	store          *registry.Store
+	businessClient *businessinternalclient.BusinessClient
+	billingStore   billing.Store
+	priceSheet     *billing.PriceSheet
+}
+
+var _ rest.Connecter = &BillingREST{}
+
+// NewBillingREST returns the billing subresource of projects, rendering the
+// usage history in billingStore with the prices of priceSheet.
+func NewBillingREST(store *registry.Store, businessClient *businessinternalclient.BusinessClient,
+	billingStore billing.Store, priceSheet *billing.PriceSheet) *BillingREST {
+	return &BillingREST{
+		store:          store,
+		businessClient: businessClient,
+		billingStore:   billingStore,
+		priceSheet:     priceSheet,
+	}
+}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *BillingREST) New() runtime.Object {
+	return &business.Project{}
+}
+
+// NewConnectOptions returns an empty options object that will be used to pass
+// options to the Connect method.
+func (r *BillingREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &business.ProjectBillingOptions{}, false, ""
+}
+
+// ConnectMethods returns the list of HTTP methods handled by Connect
+func (r *BillingREST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// ProducesMIMETypes returns a list of the MIME types the specified HTTP verb (GET, POST, DELETE,
+// PATCH) can respond with.
+func (r *BillingREST) ProducesMIMETypes(_ string) []string {
+	return []string{"application/json", "text/csv"}
+}
+
+// Connect returns an http.Handler writing the billing report of the project.
+func (r *BillingREST) Connect(ctx context.Context, projectName string, opts runtime.Object, _ rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, projectName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	project := obj.(*business.Project)
+
+	options := opts.(*business.ProjectBillingOptions)
+	if options.Start == "" {
+		return nil, apierrors.NewBadRequest("start must be specified")
+	}
+	start, err := parseReportTime(options.Start)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid start: %v", err))
+	}
+	end := time.Now()
+	if options.End != "" {
+		if end, err = parseReportTime(options.End); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid end: %v", err))
+		}
+	}
+	if !start.Before(end) {
+		return nil, apierrors.NewBadRequest("start must be before end")
+	}
+	format := options.Format
+	if format == "" {
+		format = billing.FormatJSON
+	}
+	if format != billing.FormatJSON && format != billing.FormatCSV {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("unsupported format %s, must be %s or %s", format, billing.FormatJSON, billing.FormatCSV))
+	}
+
+	projects, err := r.projectTree(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(projects))
+	for _, node := range projects {
+		names = append(names, node.Name)
+	}
+	samples, err := r.billingStore.Query(project.Spec.TenantID, names, start, end)
+	if err != nil {
+		log.Error("Failed to query the usage history", log.String("projectName", project.Name), log.Err(err))
+		return nil, apierrors.NewServiceUnavailable(fmt.Sprintf("failed to query the usage history: %v", err))
+	}
+	report := billing.NewReport(projects, samples, r.priceSheet, start, end)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var err error
+		if format == billing.FormatCSV {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition",
+				fmt.Sprintf("attachment; filename=%s-%s-%s.csv", project.Name, start.Format("20060102"), end.Format("20060102")))
+			err = report.WriteCSV(w)
+		} else {
+			w.Header().Set("Content-Type", "application/json")
+			err = report.WriteJSON(w)
+		}
+		if err != nil {
+			log.Error("Failed to write the billing report", log.String("projectName", project.Name), log.Err(err))
+		}
+	}), nil
+}
+
+// projectTree returns the project and all of its descendants, parents before
+// their children.
+func (r *BillingREST) projectTree(ctx context.Context, project *business.Project) ([]billing.ProjectNode, error) {
+	projects, err := descendants(ctx, r.businessClient, project)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []billing.ProjectNode{{Name: project.Name}}
+	for _, child := range projects[1:] {
+		nodes = append(nodes, billing.ProjectNode{Name: child.Name, Parent: child.Spec.ParentProjectName})
+	}
+	return nodes, nil
+}
+
+// descendants returns the project and all of its descendants, parents before
+// their children.
+func descendants(ctx context.Context, businessClient businessinternalclient.BusinessInterface, project *business.Project) ([]*business.Project, error) {
+	projects := []*business.Project{project}
+	visited := map[string]bool{project.Name: true}
+	for i := 0; i < len(projects); i++ {
+		for _, name := range projects[i].Status.CalculatedChildProjects {
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+			child, err := businessClient.Projects().Get(ctx, name, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			projects = append(projects, child)
+		}
+	}
+	return projects, nil
+}
+
+// parseReportTime parses a time in RFC3339 format, or a date in UTC.
+func parseReportTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
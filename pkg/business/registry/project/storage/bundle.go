@@ -0,0 +1,696 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restclient "k8s.io/client-go/rest"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/business"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/apiserver/filter"
+	"tkestack.io/tke/pkg/business/bundle"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// projectActiveTimeout is how long importing a bundle waits for each of the
+// projects it creates to become active.
+const projectActiveTimeout = 30 * time.Second
+
+// BundleClients are the clients the export and import subresources of
+// projects read and create the objects of bundles with.
+type BundleClients struct {
+	BusinessClient businessinternalclient.BusinessInterface
+	PlatformClient platformversionedclient.PlatformV1Interface
+	// AuthClient is nil if the auth apiserver is not deployed, policy
+	// bindings are neither exported nor imported then.
+	AuthClient authversionedclient.AuthV1Interface
+	// LoopbackClientConfig and AuthClientConfig are the configs of the
+	// business and auth clients, which are impersonated to create the
+	// objects of the imported bundles as the request user.
+	LoopbackClientConfig *restclient.Config
+	AuthClientConfig     *restclient.Config
+	// RegistryEnabled is whether image namespaces and chart groups are
+	// served.
+	RegistryEnabled bool
+}
+
+// forUser returns the clients creating the objects as the user, so that the
+// user must be allowed to create every object of the bundle.
+func (c *BundleClients) forUser(userInfo user.Info) (*BundleClients, error) {
+	impersonate := restclient.ImpersonationConfig{
+		UserName: userInfo.GetName(),
+		Groups:   userInfo.GetGroups(),
+		Extra:    userInfo.GetExtra(),
+	}
+	clients := *c
+	config := restclient.CopyConfig(c.LoopbackClientConfig)
+	config.Impersonate = impersonate
+	businessClient, err := businessinternalclient.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	clients.BusinessClient = businessClient
+	if c.AuthClient != nil {
+		config := restclient.CopyConfig(c.AuthClientConfig)
+		config.Impersonate = impersonate
+		authClient, err := versionedclientset.NewForConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		clients.AuthClient = authClient.AuthV1()
+	}
+	return &clients, nil
+}
+
+// ExportREST implements the REST endpoint for exporting a project, its
+// sub-projects and their related objects as a bundle.
+type ExportREST struct {
+	store   *registry.Store
+	clients *BundleClients
+}
+
+var _ rest.Connecter = &ExportREST{}
+
+// NewExportREST returns the export subresource of projects.
+func NewExportREST(store *registry.Store, clients *BundleClients) *ExportREST {
+	return &ExportREST{
+		store:   store,
+		clients: clients,
+	}
+}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *ExportREST) New() runtime.Object {
+	return &business.Project{}
+}
+
+// NewConnectOptions returns an empty options object that will be used to pass
+// options to the Connect method.
+func (r *ExportREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// ConnectMethods returns the list of HTTP methods handled by Connect
+func (r *ExportREST) ConnectMethods() []string {
+	return []string{http.MethodGet}
+}
+
+// Connect returns an http.Handler writing the bundle of the project.
+func (r *ExportREST) Connect(ctx context.Context, projectName string, _ runtime.Object, _ rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, projectName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	project := obj.(*business.Project)
+
+	b, err := r.export(ctx, project)
+	if err != nil {
+		log.Error("Failed to export project", log.String("projectName", project.Name), log.Err(err))
+		return nil, err
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-bundle.json", project.Name))
+		if err := json.NewEncoder(w).Encode(b); err != nil {
+			log.Error("Failed to write the bundle", log.String("projectName", project.Name), log.Err(err))
+		}
+	}), nil
+}
+
+func (r *ExportREST) export(ctx context.Context, project *business.Project) (*bundle.Bundle, error) {
+	projects, err := descendants(ctx, r.clients.BusinessClient, project)
+	if err != nil {
+		return nil, err
+	}
+
+	b := bundle.New(project.Spec.TenantID, project.Name)
+	for _, p := range projects {
+		if err := b.AddProject(p); err != nil {
+			return nil, err
+		}
+	}
+	for _, p := range projects {
+		namespaceList, err := r.clients.BusinessClient.Namespaces(p.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for i := range namespaceList.Items {
+			if namespaceList.Items[i].DeletionTimestamp != nil {
+				continue
+			}
+			if err := b.AddNamespace(&namespaceList.Items[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		if r.clients.RegistryEnabled {
+			imageNamespaceList, err := r.clients.BusinessClient.ImageNamespaces(p.Name).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for i := range imageNamespaceList.Items {
+				if imageNamespaceList.Items[i].DeletionTimestamp != nil {
+					continue
+				}
+				if err := b.AddImageNamespace(&imageNamespaceList.Items[i]); err != nil {
+					return nil, err
+				}
+			}
+			chartGroupList, err := r.clients.BusinessClient.ChartGroups(p.Name).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for i := range chartGroupList.Items {
+				if chartGroupList.Items[i].DeletionTimestamp != nil {
+					continue
+				}
+				if err := b.AddChartGroup(&chartGroupList.Items[i]); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if r.clients.AuthClient != nil {
+			if err := r.exportPolicyBindings(ctx, b, p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b, nil
+}
+
+// exportPolicyBindings adds the policy bindings of the project to the
+// bundle, referring to the users by name as their IDs are not portable.
+func (r *ExportREST) exportPolicyBindings(ctx context.Context, b *bundle.Bundle, project *business.Project) error {
+	bindingList, err := r.clients.AuthClient.ProjectPolicyBindings().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", project.Spec.TenantID),
+			fields.OneTermEqualSelector("spec.projectID", project.Name),
+		).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindingList.Items {
+		if binding.Status.Phase == authv1.BindingTerminating {
+			continue
+		}
+		out := bundle.PolicyBinding{
+			Project:  project.Name,
+			PolicyID: binding.Spec.PolicyID,
+		}
+		policy, err := r.clients.AuthClient.Policies().Get(ctx, binding.Spec.PolicyID, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err == nil {
+			out.PolicyDisplayName = policy.Spec.DisplayName
+		}
+		for _, subject := range binding.Spec.Users {
+			name := subject.Name
+			if name == "" {
+				if name, err = r.userName(ctx, project.Spec.TenantID, subject.ID); err != nil {
+					return err
+				}
+			}
+			if name == "" {
+				log.Warn("User of policy binding no longer exists, not exporting it",
+					log.String("projectName", project.Name), log.String("policyID", binding.Spec.PolicyID), log.String("userID", subject.ID))
+				continue
+			}
+			out.Users = append(out.Users, name)
+		}
+		for _, subject := range binding.Spec.Groups {
+			out.Groups = append(out.Groups, subject.ID)
+		}
+		b.PolicyBindings = append(b.PolicyBindings, out)
+	}
+	return nil
+}
+
+// userName returns the name of the user, or empty if the user doesn't exist.
+func (r *ExportREST) userName(ctx context.Context, tenantID, userID string) (string, error) {
+	user := &authv1.User{}
+	err := r.clients.AuthClient.RESTClient().Get().
+		Resource("users").
+		Name(userID).
+		SetHeader(filter.HeaderTenantID, tenantID).
+		Do(ctx).Into(user)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return user.Spec.Name, nil
+}
+
+// ImportREST implements the REST endpoint for importing a bundle exported by
+// the export subresource, possibly in another installation.
+type ImportREST struct {
+	store   *registry.Store
+	clients *BundleClients
+}
+
+var _ rest.Connecter = &ImportREST{}
+
+// NewImportREST returns the import subresource of projects.
+func NewImportREST(store *registry.Store, clients *BundleClients) *ImportREST {
+	return &ImportREST{
+		store:   store,
+		clients: clients,
+	}
+}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *ImportREST) New() runtime.Object {
+	return &business.Project{}
+}
+
+// NewConnectOptions returns an empty options object that will be used to pass
+// options to the Connect method.
+func (r *ImportREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &business.ProjectImportOptions{}, false, ""
+}
+
+// ConnectMethods returns the list of HTTP methods handled by Connect
+func (r *ImportREST) ConnectMethods() []string {
+	return []string{http.MethodPost}
+}
+
+// Connect returns an http.Handler importing the bundle in the request body.
+func (r *ImportREST) Connect(ctx context.Context, projectName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	options := opts.(*business.ProjectImportOptions)
+	clusters, err := bundle.ParseClusterMapping(options.ClusterMapping)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" && options.TenantID != "" && options.TenantID != tenantID {
+		return nil, apierrors.NewForbidden(business.Resource("projects"), projectName,
+			fmt.Errorf("can not import into tenant %s", options.TenantID))
+	}
+	if tenantID == "" {
+		tenantID = options.TenantID
+	}
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewUnauthorized("no user")
+	}
+	userClients, err := r.clients.forUser(userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &importHandler{
+		clients:     r.clients,
+		userClients: userClients,
+		projectName: projectName,
+		tenantID:    tenantID,
+		clusters:    clusters,
+		dryRun:      options.DryRun,
+		responder:   responder,
+	}, nil
+}
+
+type importHandler struct {
+	clients *BundleClients
+	// userClients create the objects as the request user, while the objects
+	// are looked up and rolled back with clients.
+	userClients *BundleClients
+	projectName string
+	// tenantID is empty if the bundle is imported into its own tenant.
+	tenantID  string
+	clusters  map[string]string
+	dryRun    bool
+	responder rest.Responder
+}
+
+func (h *importHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	b, err := bundle.Decode(req.Body)
+	if err != nil {
+		h.responder.Error(apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	if err := b.Validate(h.projectName); err != nil {
+		h.responder.Error(apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	tenantID := h.tenantID
+	if tenantID == "" {
+		tenantID = b.TenantID
+	}
+	b.Remap(tenantID, h.clusters)
+
+	result := &bundle.Result{
+		Project:  b.Project,
+		TenantID: b.TenantID,
+		DryRun:   h.dryRun,
+	}
+	if err := h.check(ctx, b, result); err != nil {
+		h.responder.Error(err)
+		return
+	}
+
+	code := http.StatusOK
+	switch {
+	case len(result.Conflicts) != 0:
+		code = http.StatusConflict
+	case !h.dryRun:
+		if err := h.create(ctx, b, result); err != nil {
+			log.Error("Failed to import project", log.String("projectName", b.Project), log.Err(err))
+			h.rollback(ctx, b, result)
+			result.Error = err.Error()
+			code = http.StatusInternalServerError
+			if status, ok := err.(apierrors.APIStatus); ok {
+				code = int(status.Status().Code)
+			}
+		} else {
+			code = http.StatusCreated
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		log.Error("Failed to write the import result", log.String("projectName", b.Project), log.Err(err))
+	}
+}
+
+// check records why the objects of the bundle can't be imported.
+func (h *importHandler) check(ctx context.Context, b *bundle.Bundle, result *bundle.Result) error {
+	client := h.clients.BusinessClient
+
+	if parentName := b.Projects[0].Spec.ParentProjectName; parentName != "" {
+		parent, err := client.Projects().Get(ctx, parentName, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			result.AddConflict(bundle.KindProject, "", parentName, "the parent project does not exist")
+		case err != nil:
+			return err
+		case parent.Spec.TenantID != b.TenantID:
+			result.AddConflict(bundle.KindProject, "", parentName, "the parent project belongs to another tenant")
+		}
+	}
+	for _, project := range b.Projects {
+		if err := conflictIfExists(result, bundle.KindProject, "", project.Name, func() error {
+			_, err := client.Projects().Get(ctx, project.Name, metav1.GetOptions{})
+			return err
+		}); err != nil {
+			return err
+		}
+		if template := project.Spec.NamespaceTemplate; template != "" {
+			_, err := client.NamespaceTemplates().Get(ctx, template, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				result.AddConflict(bundle.KindProject, "", project.Name, fmt.Sprintf("namespace template %s does not exist", template))
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+	for _, clusterName := range b.Clusters() {
+		cluster, err := h.clients.PlatformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			result.AddConflict(bundle.KindCluster, "", clusterName, "the cluster does not exist, map it to an existing cluster")
+		case err != nil:
+			return err
+		case cluster.Spec.TenantID != b.TenantID:
+			result.AddConflict(bundle.KindCluster, "", clusterName, "the cluster belongs to another tenant")
+		}
+	}
+	for _, namespace := range b.Namespaces {
+		if err := conflictIfExists(result, bundle.KindNamespace, namespace.Namespace, namespace.Name, func() error {
+			_, err := client.Namespaces(namespace.Namespace).Get(ctx, namespace.Name, metav1.GetOptions{})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	for _, imageNamespace := range b.ImageNamespaces {
+		if !h.clients.RegistryEnabled {
+			result.AddConflict(bundle.KindImageNamespace, imageNamespace.Namespace, imageNamespace.Name, "the registry is not enabled")
+			continue
+		}
+		if err := conflictIfExists(result, bundle.KindImageNamespace, imageNamespace.Namespace, imageNamespace.Name, func() error {
+			_, err := client.ImageNamespaces(imageNamespace.Namespace).Get(ctx, imageNamespace.Name, metav1.GetOptions{})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	for _, chartGroup := range b.ChartGroups {
+		if !h.clients.RegistryEnabled {
+			result.AddConflict(bundle.KindChartGroup, chartGroup.Namespace, chartGroup.Name, "the registry is not enabled")
+			continue
+		}
+		if err := conflictIfExists(result, bundle.KindChartGroup, chartGroup.Namespace, chartGroup.Name, func() error {
+			_, err := client.ChartGroups(chartGroup.Namespace).Get(ctx, chartGroup.Name, metav1.GetOptions{})
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	for i := range b.PolicyBindings {
+		binding := &b.PolicyBindings[i]
+		if h.clients.AuthClient == nil {
+			result.AddConflict(bundle.KindPolicyBinding, binding.Project, binding.PolicyID, "the auth is not enabled")
+			continue
+		}
+		policyID, err := h.resolvePolicy(ctx, b.TenantID, binding)
+		if err != nil {
+			return err
+		}
+		if policyID == "" {
+			result.AddConflict(bundle.KindPolicyBinding, binding.Project, binding.PolicyID,
+				fmt.Sprintf("no project-scoped policy has ID %s or display name %q", binding.PolicyID, binding.PolicyDisplayName))
+			continue
+		}
+		binding.PolicyID = policyID
+	}
+	return nil
+}
+
+// conflictIfExists records a conflict if get finds the object.
+func conflictIfExists(result *bundle.Result, kind, project, name string, get func() error) error {
+	err := get()
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	result.AddConflict(kind, project, name, "already exists")
+	return nil
+}
+
+// resolvePolicy returns the ID of the policy of the binding in the tenant,
+// looking it up by display name if no policy has its ID. It returns empty if
+// the policy is not found.
+func (h *importHandler) resolvePolicy(ctx context.Context, tenantID string, binding *bundle.PolicyBinding) (string, error) {
+	policy, err := h.clients.AuthClient.Policies().Get(ctx, binding.PolicyID, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	if err == nil && policy.Spec.TenantID == tenantID && policy.Spec.Scope == authv1.PolicyProject {
+		return policy.Name, nil
+	}
+	if binding.PolicyDisplayName == "" {
+		return "", nil
+	}
+	policyList, err := h.clients.AuthClient.Policies().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", tenantID),
+			fields.OneTermEqualSelector("spec.scope", string(authv1.PolicyProject)),
+			fields.OneTermEqualSelector("spec.displayName", binding.PolicyDisplayName),
+		).String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(policyList.Items) != 1 {
+		return "", nil
+	}
+	return policyList.Items[0].Name, nil
+}
+
+// create creates the objects of the bundle as the request user, the projects
+// first, parents before their children.
+func (h *importHandler) create(ctx context.Context, b *bundle.Bundle, result *bundle.Result) error {
+	client := h.userClients.BusinessClient
+
+	for i := range b.Projects {
+		project := &business.Project{}
+		if err := businessv1.Convert_v1_Project_To_business_Project(&b.Projects[i], project, nil); err != nil {
+			return err
+		}
+		if _, err := client.Projects().Create(ctx, project, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		result.AddCreated(bundle.KindProject, "", project.Name)
+		// Sub-projects and namespaces can't be created in a project before
+		// it is activated by the business controller.
+		if err := h.waitForActive(ctx, project.Name); err != nil {
+			return err
+		}
+	}
+	for i := range b.Namespaces {
+		namespace := &business.Namespace{}
+		if err := businessv1.Convert_v1_Namespace_To_business_Namespace(&b.Namespaces[i], namespace, nil); err != nil {
+			return err
+		}
+		created, err := client.Namespaces(namespace.Namespace).Create(ctx, namespace, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		result.AddCreated(bundle.KindNamespace, created.Namespace, created.Name)
+	}
+	for i := range b.ImageNamespaces {
+		imageNamespace := &business.ImageNamespace{}
+		if err := businessv1.Convert_v1_ImageNamespace_To_business_ImageNamespace(&b.ImageNamespaces[i], imageNamespace, nil); err != nil {
+			return err
+		}
+		created, err := client.ImageNamespaces(imageNamespace.Namespace).Create(ctx, imageNamespace, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		result.AddCreated(bundle.KindImageNamespace, created.Namespace, created.Name)
+	}
+	for i := range b.ChartGroups {
+		chartGroup := &business.ChartGroup{}
+		if err := businessv1.Convert_v1_ChartGroup_To_business_ChartGroup(&b.ChartGroups[i], chartGroup, nil); err != nil {
+			return err
+		}
+		created, err := client.ChartGroups(chartGroup.Namespace).Create(ctx, chartGroup, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		result.AddCreated(bundle.KindChartGroup, created.Namespace, created.Name)
+	}
+	for i := range b.PolicyBindings {
+		binding := &b.PolicyBindings[i]
+		if err := h.userClients.AuthClient.RESTClient().Post().
+			Resource("projects").
+			Name(binding.Project).
+			SubResource("binding").
+			Body(bindingRequest(b.TenantID, binding)).
+			Do(ctx).
+			Error(); err != nil {
+			return err
+		}
+		result.AddCreated(bundle.KindPolicyBinding, binding.Project, binding.PolicyID)
+	}
+	return nil
+}
+
+func bindingRequest(tenantID string, binding *bundle.PolicyBinding) *authv1.ProjectPolicyBindingRequest {
+	request := &authv1.ProjectPolicyBindingRequest{
+		TenantID: tenantID,
+		Policies: []string{binding.PolicyID},
+	}
+	for _, name := range binding.Users {
+		request.Users = append(request.Users, authv1.Subject{Name: name})
+	}
+	for _, id := range binding.Groups {
+		request.Groups = append(request.Groups, authv1.Subject{ID: id})
+	}
+	return request
+}
+
+// rollback deletes the objects created by a failed import in the reverse
+// order, the objects which fail to be deleted are left in the result.
+func (h *importHandler) rollback(ctx context.Context, b *bundle.Bundle, result *bundle.Result) {
+	client := h.clients.BusinessClient
+	var left []bundle.Object
+	for i := len(result.Created) - 1; i >= 0; i-- {
+		object := result.Created[i]
+		var err error
+		switch object.Kind {
+		case bundle.KindProject:
+			err = client.Projects().Delete(ctx, object.Name, metav1.DeleteOptions{})
+		case bundle.KindNamespace:
+			err = client.Namespaces(object.Project).Delete(ctx, object.Name, metav1.DeleteOptions{})
+		case bundle.KindImageNamespace:
+			err = client.ImageNamespaces(object.Project).Delete(ctx, object.Name, metav1.DeleteOptions{})
+		case bundle.KindChartGroup:
+			err = client.ChartGroups(object.Project).Delete(ctx, object.Name, metav1.DeleteOptions{})
+		case bundle.KindPolicyBinding:
+			for j := range b.PolicyBindings {
+				binding := &b.PolicyBindings[j]
+				if binding.Project != object.Project || binding.PolicyID != object.Name {
+					continue
+				}
+				err = h.clients.AuthClient.RESTClient().Post().
+					Resource("projects").
+					Name(binding.Project).
+					SubResource("unbinding").
+					Body(bindingRequest(b.TenantID, binding)).
+					Do(ctx).
+					Error()
+				break
+			}
+		}
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Error("Failed to roll back the imported object", log.String("kind", object.Kind),
+				log.String("project", object.Project), log.String("name", object.Name), log.Err(err))
+			left = append([]bundle.Object{object}, left...)
+		}
+	}
+	result.Created = left
+}
+
+// waitForActive waits until the business controller activated the project.
+func (h *importHandler) waitForActive(ctx context.Context, projectName string) error {
+	return wait.PollImmediate(time.Second, projectActiveTimeout, func() (bool, error) {
+		project, err := h.clients.BusinessClient.Projects().Get(ctx, projectName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch project.Status.Phase {
+		case business.ProjectFailed:
+			return false, fmt.Errorf("project %s failed: %s", projectName, project.Status.Message)
+		case business.ProjectActive:
+			return project.Status.Locked == nil || !*project.Status.Locked, nil
+		}
+		return false, nil
+	})
+}
@@ -28,6 +28,7 @@ import (
 	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/cmd/tke-business-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/log"
 
@@ -106,6 +107,7 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	}
 
 	project.Spec.Members = oldProject.Spec.Members
+	setLifecycleLabels(project)
 }
 
 // NamespaceScoped is false for projects.
@@ -137,6 +139,27 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 	locked := true
 	project.Status.Locked = &locked
+	setLifecycleLabels(project)
+}
+
+// setLifecycleLabels mirrors the cost center and the environment tier of the
+// project into its labels, so that projects can be selected by them.
+func setLifecycleLabels(project *business.Project) {
+	delete(project.Labels, util.LabelCostCenter)
+	delete(project.Labels, util.LabelTier)
+	lifecycle := project.Spec.Lifecycle
+	if lifecycle == nil || (lifecycle.CostCenter == "" && lifecycle.Tier == "") {
+		return
+	}
+	if project.Labels == nil {
+		project.Labels = make(map[string]string)
+	}
+	if lifecycle.CostCenter != "" {
+		project.Labels[util.LabelCostCenter] = lifecycle.CostCenter
+	}
+	if lifecycle.Tier != "" {
+		project.Labels[util.LabelTier] = string(lifecycle.Tier)
+	}
 }
 
 // AfterCreate implements a further operation to run after a resource is
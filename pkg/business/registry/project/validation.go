@@ -21,11 +21,13 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
 	"tkestack.io/tke/pkg/platform/util/validation"
@@ -54,6 +56,22 @@ func ValidateProject(ctx context.Context, project *business.Project, old *busine
 			field.Required(fldSpecPath.Child("displayName"), "must specify display name"))
 	}
 
+	if project.Spec.NamespaceTemplate != "" {
+		allErrs = append(allErrs, validateNamespaceTemplate(ctx, project, objectGetter)...)
+	}
+
+	if project.Spec.QuotaApproval != nil {
+		allErrs = append(allErrs, validateQuotaApproval(project.Spec.QuotaApproval, fldSpecPath.Child("quotaApproval"))...)
+	}
+
+	if project.Spec.Borrowing != nil {
+		allErrs = append(allErrs, validateBorrowing(project, fldSpecPath.Child("borrowing"))...)
+	}
+
+	if project.Spec.Lifecycle != nil {
+		allErrs = append(allErrs, validateLifecycle(project, old, fldSpecPath.Child("lifecycle"))...)
+	}
+
 	hardErrs := field.ErrorList{}
 	fldHardPath := fldSpecPath.Child("clusters")
 	if len(project.Spec.Clusters) > 0 {
@@ -119,6 +137,85 @@ func ValidateProjectUpdate(ctx context.Context, project *business.Project, old *
 	return allErrs
 }
 
+func validateQuotaApproval(approval *business.ProjectQuotaApproval, fldPath *field.Path) (allErrs field.ErrorList) {
+	for i, approver := range approval.Approvers {
+		if approver == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("approvers").Index(i), "must specify approver name"))
+		}
+	}
+	if notification := approval.Notification; notification != nil {
+		fldNotificationPath := fldPath.Child("notification")
+		if notification.ChannelName == "" {
+			allErrs = append(allErrs, field.Required(fldNotificationPath.Child("channelName"), "must specify notify channel"))
+		}
+		if notification.TemplateName == "" {
+			allErrs = append(allErrs, field.Required(fldNotificationPath.Child("templateName"), "must specify notify template"))
+		}
+	}
+	return allErrs
+}
+
+func validateBorrowing(project *business.Project, fldPath *field.Path) (allErrs field.ErrorList) {
+	fldCeilingPath := fldPath.Child("ceiling")
+	for clusterName, clusterCeiling := range project.Spec.Borrowing.Ceiling {
+		if _, ok := project.Spec.Clusters[clusterName]; !ok {
+			allErrs = append(allErrs, field.Invalid(fldCeilingPath.Key(clusterName), clusterName,
+				"project can only borrow in the clusters it has quota of"))
+		}
+		for k, v := range clusterCeiling.Hard {
+			resPath := fldCeilingPath.Key(clusterName + k)
+			allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(k, resPath)...)
+			allErrs = append(allErrs, resource.ValidateResourceQuantityValue(k, v, resPath)...)
+		}
+	}
+	return allErrs
+}
+
+var _supportedProjectTiers = sets.NewString(
+	string(business.ProjectTierDevelopment),
+	string(business.ProjectTierTesting),
+	string(business.ProjectTierStaging),
+	string(business.ProjectTierProduction),
+)
+
+func validateLifecycle(project *business.Project, old *business.Project, fldPath *field.Path) (allErrs field.ErrorList) {
+	lifecycle := project.Spec.Lifecycle
+	if lifecycle.Owner == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("owner"), "must specify owner"))
+	}
+	if lifecycle.CostCenter != "" {
+		for _, msg := range utilvalidation.IsValidLabelValue(lifecycle.CostCenter) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("costCenter"), lifecycle.CostCenter, msg))
+		}
+	}
+	if lifecycle.Tier != "" && !_supportedProjectTiers.Has(string(lifecycle.Tier)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("tier"), lifecycle.Tier, _supportedProjectTiers.List()))
+	}
+	if lifecycle.ExpiryTime != nil && lifecycle.ExpiryTime.Time.Before(time.Now()) {
+		// An expired project can still be updated, as long as its expiry
+		// time is not moved to another time in the past.
+		if old == nil || old.Spec.Lifecycle == nil || !old.Spec.Lifecycle.ExpiryTime.Equal(lifecycle.ExpiryTime) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("expiryTime"), lifecycle.ExpiryTime, "must be in the future"))
+		}
+	}
+	return allErrs
+}
+
+func validateNamespaceTemplate(ctx context.Context, project *business.Project, getter validation.BusinessObjectGetter) (allErrs field.ErrorList) {
+	fldPath := field.NewPath("spec", "namespaceTemplate")
+	template, err := getter.NamespaceTemplate(ctx, project.Spec.NamespaceTemplate, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldPath, project.Spec.NamespaceTemplate))
+		}
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	if template.Spec.TenantID != project.Spec.TenantID {
+		allErrs = append(allErrs, field.NotFound(fldPath, project.Spec.NamespaceTemplate))
+	}
+	return allErrs
+}
+
 func validateAgainstChildren(ctx context.Context, project *business.Project, getter validation.BusinessObjectGetter) (allErrs field.ErrorList) {
 	clusterTimes := map[string]int{}          /* cluster -> times */
 	quotaTimes := map[string]map[string]int{} /* cluster -> (kind -> times) */
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"testing"
 
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryresource "k8s.io/apimachinery/pkg/api/resource"
 	apimachinerymetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"tkestack.io/tke/api/business"
@@ -42,6 +43,9 @@ const (
 	Phase             = "Active"
 
 	Namespace = "test-namespace"
+
+	NamespaceTemplateName        = "namespace-template"
+	OtherTenantNamespaceTemplate = "other-tenant-namespace-template"
 )
 
 var (
@@ -118,8 +122,44 @@ var (
 	_namespaceMap = map[string]*business.Namespace{
 		NamespaceName: &_childNamespace,
 	}
+
+	_namespaceTemplateMap = map[string]*business.NamespaceTemplate{
+		NamespaceTemplateName: {
+			ObjectMeta: apimachinerymetav1.ObjectMeta{Name: NamespaceTemplateName},
+			Spec:       business.NamespaceTemplateSpec{TenantID: TenantID},
+		},
+		OtherTenantNamespaceTemplate: {
+			ObjectMeta: apimachinerymetav1.ObjectMeta{Name: OtherTenantNamespaceTemplate},
+			Spec:       business.NamespaceTemplateSpec{TenantID: "other-tenant-id"},
+		},
+	}
 )
 
+func TestNamespaceTemplateReference(t *testing.T) {
+	defer func() {
+		_parentProject.Spec.NamespaceTemplate = ""
+	}()
+
+	tests := []struct {
+		template string
+		valid    bool
+	}{
+		{NamespaceTemplateName, true},
+		{OtherTenantNamespaceTemplate, false},
+		{"missing-namespace-template", false},
+	}
+	for _, test := range tests {
+		_parentProject.Spec.NamespaceTemplate = test.template
+		errors := ValidateProject(context.Background(), &_parentProject, nil, newObjectGetter(), newClusterGetter())
+		if test.valid && len(errors) != 0 {
+			t.Errorf("%s: unexpected errors: %v", test.template, errors)
+		}
+		if !test.valid && len(errors) == 0 {
+			t.Errorf("%s: expected errors", test.template)
+		}
+	}
+}
+
 func TestClusterLimitation(t *testing.T) {
 	_childProject.Spec.Clusters = business.ClusterHard{
 		ClusterName: {},
@@ -418,6 +458,14 @@ func (getter testObjectGetter) Namespace(ctx context.Context, project, name stri
 	return nil, fmt.Errorf("failed to get namespace by project '%s' and name '%s'", project, name)
 }
 
+func (getter testObjectGetter) NamespaceTemplate(ctx context.Context, name string, options apimachinerymetav1.GetOptions) (*business.NamespaceTemplate, error) {
+	template, has := _namespaceTemplateMap[name]
+	if has {
+		return template, nil
+	}
+	return nil, apimachineryerrors.NewNotFound(business.Resource("namespacetemplates"), name)
+}
+
 type testClusterGetter struct{}
 
 func (getter testClusterGetter) Cluster(ctx context.Context, name string, options apimachinerymetav1.GetOptions) (*platformv1.Cluster, error) {
@@ -0,0 +1,289 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/util/retry"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/business/registry/quotarequest"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for quota requests and all sub resources.
+type Storage struct {
+	QuotaRequest *REST
+	Status       *StatusREST
+	Approval     *ApprovalREST
+}
+
+// NewStorage returns a Storage object that will work against quota requests.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, businessClient *businessinternalclient.BusinessClient,
+	privilegedUsername string) *Storage {
+	strategy := quotarequest.NewStrategy(businessClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &business.QuotaRequest{} },
+		NewListFunc:              func() runtime.Object { return &business.QuotaRequestList{} },
+		DefaultQualifiedResource: business.Resource("quotarequests"),
+		PredicateFunc:            quotarequest.MatchQuotaRequest,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		ShouldDeleteDuringUpdate: registry.ShouldDeleteDuringUpdate,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    quotarequest.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create quota request etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = quotarequest.NewStatusStrategy(strategy)
+
+	approvalStrategy := quotarequest.NewApprovalStrategy(strategy)
+	approvalStore := *store
+	approvalStore.UpdateStrategy = approvalStrategy
+
+	return &Storage{
+		QuotaRequest: &REST{store},
+		Status:       &StatusREST{&statusStore},
+		Approval: &ApprovalREST{
+			store:              &approvalStore,
+			strategy:           approvalStrategy,
+			businessClient:     businessClient,
+			privilegedUsername: privilegedUsername,
+		},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return QuotaRequest
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*business.QuotaRequest)
+	if err := util.FilterQuotaRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for quota requests against etcd.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"qr"}
+}
+
+// DeleteCollection selects all resources in the storage matching given 'listOptions'
+// and deletes them.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	return r.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for quota request termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a quota request.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// ApprovalREST implements the REST endpoint for approving or rejecting a
+// quota request.
+type ApprovalREST struct {
+	store              *registry.Store
+	strategy           *quotarequest.ApprovalStrategy
+	businessClient     *businessinternalclient.BusinessClient
+	privilegedUsername string
+}
+
+// ApprovalREST implements Patcher
+var _ = rest.Patcher(&ApprovalREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *ApprovalREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ApprovalREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update approves or rejects the quota request. Approving a request changes
+// the resource limits of the project in the requested cluster, which fails
+// if the parent project does not have enough capacity left.
+func (r *ApprovalREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	oldRequest := obj.(*business.QuotaRequest)
+
+	project, err := r.businessClient.Projects().Get(ctx, oldRequest.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	if username != r.privilegedUsername {
+		approval, err := quotarequest.ApprovalOf(ctx, r.businessClient, project)
+		if err != nil {
+			return nil, false, err
+		}
+		if !quotarequest.IsApprover(approval, username) {
+			return nil, false, errors.NewForbidden(business.Resource("quotarequests"), name,
+				fmt.Errorf("user %s is not an approver of the quota requests of project %s", username, project.Name))
+		}
+	}
+
+	newObj, err := objInfo.UpdatedObject(ctx, oldRequest)
+	if err != nil {
+		return nil, false, err
+	}
+	request := newObj.(*business.QuotaRequest)
+
+	// Validate the decision before changing the project, the store validates
+	// it again when persisting the request.
+	prepared := request.DeepCopy()
+	r.strategy.PrepareForUpdate(ctx, prepared, oldRequest)
+	if errs := r.strategy.ValidateUpdate(ctx, prepared, oldRequest); len(errs) > 0 {
+		return nil, false, errors.NewInvalid(business.Kind("QuotaRequest"), name, errs)
+	}
+
+	if prepared.Status.Phase == business.QuotaRequestApproved {
+		if err := r.applyToProject(ctx, oldRequest); err != nil {
+			log.Info("Failed to apply quota request to project",
+				log.String("quotaRequest", name),
+				log.String("projectName", oldRequest.Namespace),
+				log.Err(err))
+			return nil, false, err
+		}
+	}
+
+	return r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(request), createValidation, updateValidation, false, options)
+}
+
+// applyToProject merges the requested resource limits into the limits of the
+// project in the requested cluster. The project validation checks them
+// against the capacity of the parent project.
+func (r *ApprovalREST) applyToProject(ctx context.Context, request *business.QuotaRequest) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		project, err := r.businessClient.Projects().Get(ctx, request.Namespace, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if project.Spec.Clusters == nil {
+			project.Spec.Clusters = make(business.ClusterHard)
+		}
+		clusterHard, ok := project.Spec.Clusters[request.Spec.ClusterName]
+		if !ok || clusterHard.Hard == nil {
+			clusterHard.Hard = make(business.ResourceList)
+		}
+		for k, v := range request.Spec.Hard {
+			clusterHard.Hard[k] = v
+		}
+		project.Spec.Clusters[request.Spec.ClusterName] = clusterHard
+		_, err = r.businessClient.Projects().Update(ctx, project, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package quotarequest
+
+import (
+	"context"
+	"fmt"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/util/resource"
+)
+
+// _maxJustificationLength is the max length of the justification of a quota
+// request.
+const _maxJustificationLength = 1024
+
+// ValidateQuotaRequestName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateQuotaRequestName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateQuotaRequestCreate tests if required fields in the quota request are set correctly.
+func ValidateQuotaRequestCreate(ctx context.Context, request *business.QuotaRequest,
+	businessClient *businessinternalclient.BusinessClient) field.ErrorList {
+	allErrs := ValidateQuotaRequest(request)
+
+	fldProjectPath := field.NewPath("metadata", "namespace")
+	project, err := businessClient.Projects().Get(ctx, request.Namespace, metav1.GetOptions{})
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldProjectPath, request.Namespace,
+			fmt.Sprintf("failed to get this project, %s", err)))
+	} else if project.Spec.TenantID != request.Spec.TenantID {
+		allErrs = append(allErrs, field.NotFound(fldProjectPath, request.Namespace))
+	} else if project.Status.Phase == business.ProjectTerminating {
+		allErrs = append(allErrs, field.Invalid(fldProjectPath, request.Namespace, "project is terminating"))
+	}
+
+	return allErrs
+}
+
+// ValidateQuotaRequest tests if the spec of the quota request is valid.
+func ValidateQuotaRequest(request *business.QuotaRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&request.ObjectMeta, true, ValidateQuotaRequestName, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if request.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("clusterName"), "must specify cluster name"))
+	}
+
+	fldHardPath := fldSpecPath.Child("hard")
+	if len(request.Spec.Hard) == 0 {
+		allErrs = append(allErrs, field.Required(fldHardPath, "must specify requested resources"))
+	}
+	for k, v := range request.Spec.Hard {
+		resPath := fldHardPath.Key(k)
+		allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(k, resPath)...)
+		allErrs = append(allErrs, resource.ValidateResourceQuantityValue(k, v, resPath)...)
+	}
+
+	fldJustificationPath := fldSpecPath.Child("justification")
+	if request.Spec.Justification == "" {
+		allErrs = append(allErrs, field.Required(fldJustificationPath, "must specify justification"))
+	} else if len(request.Spec.Justification) > _maxJustificationLength {
+		allErrs = append(allErrs, field.TooLong(fldJustificationPath, request.Spec.Justification, _maxJustificationLength))
+	}
+
+	if request.Spec.Requester == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("requester"), "must specify requester"))
+	}
+
+	return allErrs
+}
+
+// ValidateQuotaRequestUpdate tests if required fields in the quota request are set during
+// an update.
+func ValidateQuotaRequestUpdate(request *business.QuotaRequest, old *business.QuotaRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&request.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateQuotaRequest(request)...)
+	return allErrs
+}
+
+// ValidateQuotaRequestApproval tests if the quota request can be approved or
+// rejected.
+func ValidateQuotaRequestApproval(request *business.QuotaRequest, old *business.QuotaRequest) field.ErrorList {
+	allErrs := ValidateQuotaRequestUpdate(request, old)
+
+	fldPhasePath := field.NewPath("status", "phase")
+	if old.Status.Phase != business.QuotaRequestPending {
+		allErrs = append(allErrs, field.Forbidden(fldPhasePath,
+			fmt.Sprintf("quota request has already been %s", old.Status.Phase)))
+	}
+	if request.Status.Phase != business.QuotaRequestApproved && request.Status.Phase != business.QuotaRequestRejected {
+		allErrs = append(allErrs, field.NotSupported(fldPhasePath, request.Status.Phase,
+			[]string{string(business.QuotaRequestApproved), string(business.QuotaRequestRejected)}))
+	}
+	if request.Status.Reviewer == request.Spec.Requester {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("status", "reviewer"),
+			"requester can not review own quota request"))
+	}
+
+	return allErrs
+}
+
+// ApprovalOf returns the approval settings that apply to the quota requests
+// of the given project: those of its parent project, or its own if it has no
+// parent project. It returns nil if no approval is defined.
+func ApprovalOf(ctx context.Context, businessClient *businessinternalclient.BusinessClient,
+	project *business.Project) (*business.ProjectQuotaApproval, error) {
+	if project.Spec.ParentProjectName == "" {
+		return project.Spec.QuotaApproval, nil
+	}
+	parent, err := businessClient.Projects().Get(ctx, project.Spec.ParentProjectName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return parent.Spec.QuotaApproval, nil
+}
+
+// IsApprover returns true if the user is one of the approvers.
+func IsApprover(approval *business.ProjectQuotaApproval, username string) bool {
+	if approval == nil {
+		return false
+	}
+	for _, approver := range approval.Approvers {
+		if approver == username {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package quotarequest
+
+import (
+	"strings"
+	"testing"
+
+	apimachineryresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/business"
+)
+
+func newQuotaRequest() *business.QuotaRequest {
+	return &business.QuotaRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "qr-test",
+			Namespace:       "prj-test",
+			ResourceVersion: "1",
+		},
+		Spec: business.QuotaRequestSpec{
+			TenantID:    "default",
+			ClusterName: "cls-test",
+			Hard: business.ResourceList{
+				"requests.cpu": apimachineryresource.MustParse("4"),
+			},
+			Justification: "more replicas for the promotion",
+			Requester:     "alice",
+		},
+		Status: business.QuotaRequestStatus{
+			Phase: business.QuotaRequestPending,
+		},
+	}
+}
+
+func TestValidateQuotaRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(request *business.QuotaRequest)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			mutate: func(request *business.QuotaRequest) {},
+		},
+		{
+			name:    "no cluster",
+			mutate:  func(request *business.QuotaRequest) { request.Spec.ClusterName = "" },
+			wantErr: "spec.clusterName",
+		},
+		{
+			name:    "no resources",
+			mutate:  func(request *business.QuotaRequest) { request.Spec.Hard = nil },
+			wantErr: "spec.hard",
+		},
+		{
+			name: "negative resource",
+			mutate: func(request *business.QuotaRequest) {
+				request.Spec.Hard["requests.memory"] = apimachineryresource.MustParse("-1Gi")
+			},
+			wantErr: "spec.hard[requests.memory]",
+		},
+		{
+			name:    "no justification",
+			mutate:  func(request *business.QuotaRequest) { request.Spec.Justification = "" },
+			wantErr: "spec.justification",
+		},
+		{
+			name: "justification too long",
+			mutate: func(request *business.QuotaRequest) {
+				request.Spec.Justification = strings.Repeat("x", _maxJustificationLength+1)
+			},
+			wantErr: "spec.justification",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newQuotaRequest()
+			tt.mutate(request)
+			errs := ValidateQuotaRequest(request)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Fatalf("ValidateQuotaRequest() unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || !strings.Contains(errs.ToAggregate().Error(), tt.wantErr) {
+				t.Fatalf("ValidateQuotaRequest() errors = %v, want error on %s", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateQuotaRequestApproval(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldPhase business.QuotaRequestPhase
+		phase    business.QuotaRequestPhase
+		reviewer string
+		wantErr  bool
+	}{
+		{"approve", business.QuotaRequestPending, business.QuotaRequestApproved, "bob", false},
+		{"reject", business.QuotaRequestPending, business.QuotaRequestRejected, "bob", false},
+		{"already approved", business.QuotaRequestApproved, business.QuotaRequestRejected, "bob", true},
+		{"back to pending", business.QuotaRequestPending, business.QuotaRequestPending, "bob", true},
+		{"own request", business.QuotaRequestPending, business.QuotaRequestApproved, "alice", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := newQuotaRequest()
+			old.Status.Phase = tt.oldPhase
+			request := old.DeepCopy()
+			request.Status.Phase = tt.phase
+			request.Status.Reviewer = tt.reviewer
+			errs := ValidateQuotaRequestApproval(request, old)
+			if (len(errs) != 0) != tt.wantErr {
+				t.Fatalf("ValidateQuotaRequestApproval() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsApprover(t *testing.T) {
+	approval := &business.ProjectQuotaApproval{Approvers: []string{"bob", "carol"}}
+	if !IsApprover(approval, "carol") {
+		t.Errorf("IsApprover() = false for an approver")
+	}
+	if IsApprover(approval, "alice") {
+		t.Errorf("IsApprover() = true for a user who is not an approver")
+	}
+	if IsApprover(nil, "bob") {
+		t.Errorf("IsApprover() = true without approval settings")
+	}
+}
@@ -32,14 +32,17 @@ import (
 	registryversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
 	"tkestack.io/tke/cmd/tke-business-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	"tkestack.io/tke/pkg/business/billing"
 	chartgroupstorage "tkestack.io/tke/pkg/business/registry/chartgroup/storage"
 	configmapstorage "tkestack.io/tke/pkg/business/registry/configmap/storage"
 	emigrationstorage "tkestack.io/tke/pkg/business/registry/emigration/storage"
 	imagenamespacestorage "tkestack.io/tke/pkg/business/registry/imagenamespace/storage"
 	namespacestorage "tkestack.io/tke/pkg/business/registry/namespace/storage"
+	namespacetemplatestorage "tkestack.io/tke/pkg/business/registry/namespacetemplate/storage"
 	platformstorage "tkestack.io/tke/pkg/business/registry/platform/storage"
 	portalstorage "tkestack.io/tke/pkg/business/registry/portal/storage"
 	projectstorage "tkestack.io/tke/pkg/business/registry/project/storage"
+	quotarequeststorage "tkestack.io/tke/pkg/business/registry/quotarequest/storage"
 )
 
 // StorageProvider is a REST type for core resources storage that implement
@@ -49,8 +52,11 @@ type StorageProvider struct {
 	PlatformClient       platformversionedclient.PlatformV1Interface
 	RegistryClient       registryversionedclient.RegistryV1Interface
 	AuthClient           authversionedclient.AuthV1Interface
+	AuthClientConfig     *restclient.Config
 	PrivilegedUsername   string
 	Features             *options.FeatureOptions
+	BillingStore         billing.Store
+	PriceSheet           *billing.PriceSheet
 }
 
 // Implement RESTStorageProvider
@@ -82,6 +88,19 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["projects"] = projectREST.Project
 		storageMap["projects/status"] = projectREST.Status
 		storageMap["projects/finalize"] = projectREST.Finalize
+		if s.BillingStore != nil {
+			storageMap["projects/billing"] = projectstorage.NewBillingREST(projectREST.Project.Store, businessClient, s.BillingStore, s.PriceSheet)
+		}
+		bundleClients := &projectstorage.BundleClients{
+			BusinessClient:       businessClient,
+			PlatformClient:       s.PlatformClient,
+			AuthClient:           s.AuthClient,
+			LoopbackClientConfig: loopbackClientConfig,
+			AuthClientConfig:     s.AuthClientConfig,
+			RegistryEnabled:      s.RegistryClient != nil,
+		}
+		storageMap["projects/export"] = projectstorage.NewExportREST(projectREST.Project.Store, bundleClients)
+		storageMap["projects/import"] = projectstorage.NewImportREST(projectREST.Project.Store, bundleClients)
 
 		namespaceREST := namespacestorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.PrivilegedUsername)
 		storageMap["namespaces"] = namespaceREST.Namespace
@@ -101,6 +120,14 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		emigrationREST := emigrationstorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.PrivilegedUsername)
 		storageMap["nsemigrations"] = emigrationREST.Emigration
 
+		namespaceTemplateREST := namespacetemplatestorage.NewStorage(restOptionsGetter, businessClient)
+		storageMap["namespacetemplates"] = namespaceTemplateREST.NamespaceTemplate
+
+		quotaRequestREST := quotarequeststorage.NewStorage(restOptionsGetter, businessClient, s.PrivilegedUsername)
+		storageMap["quotarequests"] = quotaRequestREST.QuotaRequest
+		storageMap["quotarequests/status"] = quotaRequestREST.Status
+		storageMap["quotarequests/approval"] = quotaRequestREST.Approval
+
 		if s.RegistryClient != nil {
 			imageNamespaceREST := imagenamespacestorage.NewStorage(restOptionsGetter, businessClient, s.RegistryClient, s.PrivilegedUsername)
 			storageMap["imagenamespaces"] = imageNamespaceREST.ImageNamespace
@@ -98,3 +98,29 @@ func FilterNsEmigration(ctx context.Context, emigration *business.NsEmigration)
 	}
 	return nil
 }
+
+// FilterNamespaceTemplate is used to filter namespace templates that do not
+// belong to the tenant.
+func FilterNamespaceTemplate(ctx context.Context, template *business.NamespaceTemplate) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if template.Spec.TenantID != tenantID {
+		return errors.NewNotFound(businessv1.Resource("namespacetemplate"), template.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterQuotaRequest is used to filter quota requests that do not belong to
+// the tenant.
+func FilterQuotaRequest(ctx context.Context, request *business.QuotaRequest) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if request.Spec.TenantID != tenantID {
+		return errors.NewNotFound(businessv1.Resource("quotarequest"), request.ObjectMeta.Name)
+	}
+	return nil
+}
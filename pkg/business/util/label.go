@@ -23,4 +23,17 @@ const (
 	LabelProjectName = "tkestack.io/projectName"
 	// LabelNamespaceName is the label name for namespace
 	LabelNamespaceName = "tkestack.io/namespaceName"
+	// LabelNamespaceTemplate is the label name for the namespace template
+	// that rendered an object
+	LabelNamespaceTemplate = "tkestack.io/namespaceTemplate"
+	// LabelCostCenter is the label name for the cost center of a project
+	LabelCostCenter = "tkestack.io/costCenter"
+	// LabelTier is the label name for the environment tier of a project
+	LabelTier = "tkestack.io/tier"
+	// AnnotationNamespaceTemplateLabels is the annotation name recording the
+	// keys of the namespace labels set from the namespace template
+	AnnotationNamespaceTemplateLabels = "tkestack.io/namespaceTemplateLabels"
+	// AnnotationNamespaceTemplateAnnotations is the annotation name recording
+	// the keys of the namespace annotations set from the namespace template
+	AnnotationNamespaceTemplateAnnotations = "tkestack.io/namespaceTemplateAnnotations"
 )
@@ -98,6 +98,10 @@ func componentPrefix() map[moduleName][]modulePath {
 				prefix:    fmt.Sprintf("%s/", authapiserver.APIKeyPasswordPath),
 				protected: false,
 			},
+			modulePath{
+				prefix:    authapiserver.SCIMPath,
+				protected: true,
+			},
 		},
 		moduleNameMonitor: {
 			modulePath{
@@ -0,0 +1,377 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package apiserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const alertCountKey = "alertCount"
+
+const (
+	alertStatusFiring   = "firing"
+	alertStatusResolved = "resolved"
+)
+
+// AlertConfig contains the configuration of how the alarm webhook groups and
+// deduplicates the received alerts. The groups and the sent alerts are
+// recorded by the message requests, so that the alerts received by all
+// replicas of the notify api are grouped and deduplicated together, and the
+// deduplication holds as long as the message requests are retained.
+type AlertConfig struct {
+	// GroupBy is the list of labels by which alerts are grouped into one
+	// message request.
+	GroupBy []string
+	// GroupWait is the length of the time windows within which alerts of the
+	// same group are sent together, the windows are aligned to the clock so
+	// that an alert waits for at most the group wait, zero means every alert
+	// is sent immediately.
+	GroupWait time.Duration
+	// RepeatInterval is how long an alert that has already been sent is
+	// dropped when it fires again, zero means no deduplication.
+	RepeatInterval time.Duration
+}
+
+// alertNotification is an alert to be sent through one notify way.
+type alertNotification struct {
+	channel        string
+	template       string
+	receivers      []string
+	receiverGroups []string
+	alert          Alert
+}
+
+// alertDispatcher deduplicates alert notifications, groups them within the
+// configured time window and creates message requests for them. The message
+// request of a group is named after the group and the end of its window, and
+// is sent by the message request controller once the window ends. Every
+// message request created for alerts is labelled with the fingerprints of
+// its alerts and annotated with the time it is sent, by which the repeated
+// alerts are found.
+type alertDispatcher struct {
+	config       AlertConfig
+	notifyClient notifyinternalclient.NotifyInterface
+	now          func() time.Time
+}
+
+func newAlertDispatcher(config AlertConfig, notifyClient notifyinternalclient.NotifyInterface) *alertDispatcher {
+	return &alertDispatcher{
+		config:       config,
+		notifyClient: notifyClient,
+		now:          time.Now,
+	}
+}
+
+// dispatch sends the notification right away if grouping is disabled,
+// otherwise adds it into the message request of its group which will be sent
+// after the group window ends. Notifications repeated within the repeat
+// interval after they have been sent are dropped.
+func (d *alertDispatcher) dispatch(ctx context.Context, n alertNotification) error {
+	fp := fingerprint(n)
+	repeat, err := d.isRepeat(ctx, n)
+	if err != nil {
+		return err
+	}
+	if repeat {
+		log.Infof("Drop repeated alert %s in channel %s", fp, n.channel)
+		return nil
+	}
+	if d.config.GroupWait <= 0 {
+		messageRequest := newMessageRequest(n.channel, n.template, n.receivers, n.receiverGroups, getVariables(n.alert), n.alert.Status)
+		messageRequest.Labels = map[string]string{alertLabel(fp): "true"}
+		messageRequest.Annotations = map[string]string{notifyutil.AnnotationSendAfter: d.now().Format(time.RFC3339Nano)}
+		return d.create(ctx, messageRequest)
+	}
+
+	windowEnd := d.now().Truncate(d.config.GroupWait).Add(d.config.GroupWait)
+	for i := 0; i < 2; i++ {
+		added, err := d.addToGroup(ctx, n, fp, windowEnd)
+		if err != nil || added {
+			return err
+		}
+		// The message request of the window is being sent already, which
+		// happens if the clock of the controller is ahead.
+		windowEnd = windowEnd.Add(d.config.GroupWait)
+	}
+	return fmt.Errorf("message requests of alert group %s are being sent", d.groupName(n, windowEnd))
+}
+
+// addToGroup adds the notification into the message request of its group in
+// the window, the message request is created if it does not exist. It
+// returns false if the message request is not pending any more.
+func (d *alertDispatcher) addToGroup(ctx context.Context, n alertNotification, fp string, windowEnd time.Time) (bool, error) {
+	name := d.groupName(n, windowEnd)
+	messageRequests := d.notifyClient.MessageRequests(n.channel)
+	added := false
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		// the message request may be created or updated by other replicas
+		return errors.IsConflict(err) || errors.IsAlreadyExists(err)
+	}, func() error {
+		messageRequest, err := messageRequests.Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			messageRequest = newMessageRequest(n.channel, n.template, n.receivers, n.receiverGroups, groupVariables([]Alert{n.alert}), n.alert.Status)
+			messageRequest.Name = name
+			messageRequest.Labels = map[string]string{alertLabel(fp): "true"}
+			messageRequest.Annotations = map[string]string{notifyutil.AnnotationSendAfter: windowEnd.Format(time.RFC3339Nano)}
+			if err := d.create(ctx, messageRequest); err != nil {
+				return err
+			}
+			added = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if phase := messageRequest.Status.Phase; phase != "" && phase != notify.MessageRequestPending {
+			return nil
+		}
+		if _, ok := messageRequest.Labels[alertLabel(fp)]; !ok {
+			if messageRequest.Labels == nil {
+				messageRequest.Labels = make(map[string]string)
+			}
+			messageRequest.Labels[alertLabel(fp)] = "true"
+			if messageRequest.Spec.Variables == nil {
+				messageRequest.Spec.Variables = make(map[string]string)
+			}
+			appendAlertVariables(messageRequest.Spec.Variables, n.alert)
+			if _, err := messageRequests.Update(ctx, messageRequest, metav1.UpdateOptions{}); err != nil {
+				return err
+			}
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
+
+func (d *alertDispatcher) create(ctx context.Context, messageRequest *notify.MessageRequest) error {
+	_, err := d.notifyClient.MessageRequests(messageRequest.ObjectMeta.Namespace).Create(ctx, messageRequest, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	log.Infof("messageRequest created: %+v", messageRequest.Spec)
+	return nil
+}
+
+// isRepeat returns whether the notification has been sent, or is waiting in
+// its group to be sent, within the repeat interval. An alert which fires
+// again after its resolved notification has been sent is not a repeat.
+func (d *alertDispatcher) isRepeat(ctx context.Context, n alertNotification) (bool, error) {
+	if d.config.RepeatInterval <= 0 {
+		return false, nil
+	}
+	sentAt, err := d.lastSent(ctx, n.channel, fingerprint(n))
+	if err != nil || sentAt.IsZero() || d.now().Sub(sentAt) >= d.config.RepeatInterval {
+		return false, err
+	}
+
+	opposite := n
+	switch n.alert.Status {
+	case alertStatusFiring:
+		opposite.alert.Status = alertStatusResolved
+	case alertStatusResolved:
+		opposite.alert.Status = alertStatusFiring
+	default:
+		return true, nil
+	}
+	oppositeSentAt, err := d.lastSent(ctx, n.channel, fingerprint(opposite))
+	if err != nil {
+		return false, err
+	}
+	return !oppositeSentAt.After(sentAt), nil
+}
+
+// lastSent returns the latest time at which a message request containing the
+// alert of the fingerprint is sent, or the zero time if there is none.
+func (d *alertDispatcher) lastSent(ctx context.Context, channel string, fp string) (time.Time, error) {
+	list, err := d.notifyClient.MessageRequests(channel).List(ctx, metav1.ListOptions{
+		LabelSelector: alertLabel(fp),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	var last time.Time
+	for _, messageRequest := range list.Items {
+		sendAfter, err := time.Parse(time.RFC3339Nano, messageRequest.Annotations[notifyutil.AnnotationSendAfter])
+		if err == nil && sendAfter.After(last) {
+			last = sendAfter
+		}
+	}
+	return last, nil
+}
+
+// groupKey identifies the group of the notification by its notify way,
+// receivers, alert status and the values of the group by labels.
+func (d *alertDispatcher) groupKey(n alertNotification) string {
+	parts := []string{
+		n.channel,
+		n.template,
+		strings.Join(n.receivers, ","),
+		strings.Join(n.receiverGroups, ","),
+		n.alert.Status,
+	}
+	for _, name := range d.config.GroupBy {
+		parts = append(parts, fmt.Sprintf("%s=%s", name, n.alert.Labels[name]))
+	}
+	return strings.Join(parts, "|")
+}
+
+// groupName returns the name of the message request of the group of the
+// notification in the window ending at the given time.
+func (d *alertDispatcher) groupName(n alertNotification, windowEnd time.Time) string {
+	h := sha256.Sum256([]byte(d.groupKey(n)))
+	return fmt.Sprintf("alert-%s-%d", hex.EncodeToString(h[:])[:16], windowEnd.Unix())
+}
+
+// alertLabel returns the label name marking the message requests which
+// contain the alert of the fingerprint.
+func alertLabel(fp string) string {
+	return notifyutil.LabelAlertPrefix + fp
+}
+
+// fingerprint identifies the same alert firing repeatedly through the same
+// notify way.
+func fingerprint(n alertNotification) string {
+	names := make([]string, 0, len(n.alert.Labels))
+	for name := range n.alert.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s:%s\x00%s\x00", n.channel, n.template, n.alert.Status)
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\x00", name, n.alert.Labels[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// groupVariables merges the variables of the alerts, the summaries of all
+// alerts are concatenated so that the message describes the whole group.
+func groupVariables(alerts []Alert) map[string]string {
+	variables := getVariables(alerts[0])
+	variables[alertCountKey] = "1"
+	for _, alert := range alerts[1:] {
+		appendAlertVariables(variables, alert)
+	}
+	return variables
+}
+
+// appendAlertVariables appends the summary of the alert to the variables of
+// a group and counts it.
+func appendAlertVariables(variables map[string]string, alert Alert) {
+	count, _ := strconv.Atoi(variables[alertCountKey])
+	variables[summaryKey] = variables[summaryKey] + "\n\n" + getVariables(alert)[summaryKey]
+	variables[alertCountKey] = strconv.Itoa(count + 1)
+}
+
+// alertSilencer checks alerts against the silences of a tenant and records
+// the suppressed alerts in the status of the matched silences.
+type alertSilencer struct {
+	notifyClient notifyinternalclient.NotifyInterface
+	now          func() time.Time
+}
+
+// silenced returns whether the alert labels are matched by any active
+// silence of the tenant.
+func (s *alertSilencer) silenced(ctx context.Context, tenantID string, labels map[string]string) (bool, error) {
+	silences, err := s.notifyClient.Silences().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.tenantID", tenantID).String(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	now := s.now()
+	matched := false
+	for i := range silences.Items {
+		silence := &silences.Items[i]
+		if !silenceActive(silence, now) || !silenceMatches(silence, labels) {
+			continue
+		}
+		matched = true
+		if err := s.recordSuppressed(ctx, silence.Name, now); err != nil {
+			log.Error("Failed to update status of silence", log.String("silence", silence.Name), log.Err(err))
+		}
+	}
+	return matched, nil
+}
+
+func (s *alertSilencer) recordSuppressed(ctx context.Context, name string, now time.Time) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		silence, err := s.notifyClient.Silences().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		silence.Status.SuppressedCount++
+		silence.Status.LastSuppressedTime = metav1.NewTime(now)
+		_, err = s.notifyClient.Silences().UpdateStatus(ctx, silence, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func silenceActive(silence *notify.Silence, now time.Time) bool {
+	return !now.Before(silence.Spec.StartsAt.Time) && now.Before(silence.Spec.EndsAt.Time)
+}
+
+// silenceMatches returns true if all matchers of the silence match the labels,
+// a missing label is regarded as an empty value.
+func silenceMatches(silence *notify.Silence, labels map[string]string) bool {
+	for _, matcher := range silence.Spec.Matchers {
+		value := labels[matcher.Name]
+		var ok bool
+		switch matcher.Operator {
+		case notify.SilenceMatchEqual, "":
+			ok = value == matcher.Value
+		case notify.SilenceMatchNotEqual:
+			ok = value != matcher.Value
+		case notify.SilenceMatchRegexp, notify.SilenceMatchNotRegexp:
+			re, err := regexp.Compile("^(?:" + matcher.Value + ")$")
+			if err != nil {
+				return false
+			}
+			ok = re.MatchString(value) == (matcher.Operator == notify.SilenceMatchRegexp)
+		default:
+			return false
+		}
+		if !ok {
+			return false
+		}
+	}
+	return len(silence.Spec.Matchers) > 0
+}
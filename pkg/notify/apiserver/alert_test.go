@@ -0,0 +1,276 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
+)
+
+func newTestNotification(labels map[string]string) alertNotification {
+	return alertNotification{
+		channel:   "channel-1",
+		template:  "template-1",
+		receivers: []string{"receiver-1"},
+		alert: Alert{
+			Labels: labels,
+			Status: "firing",
+		},
+	}
+}
+
+// newFakeNotifyClient returns a fake client which generates names for the
+// created message requests like the apiserver does.
+func newFakeNotifyClient(objects ...runtime.Object) notifyinternalclient.NotifyInterface {
+	client := fake.NewSimpleClientset(objects...)
+	count := 0
+	client.PrependReactor("create", "messagerequests", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		count++
+		messageRequest := action.(clienttesting.CreateAction).GetObject().(*notify.MessageRequest)
+		if messageRequest.Name == "" {
+			messageRequest.Name = fmt.Sprintf("message-request-%d", count)
+		}
+		return false, nil, nil
+	})
+	return client.Notify()
+}
+
+func TestSilenceMatches(t *testing.T) {
+	labels := map[string]string{"alertname": "cpu_usage", "cluster_id": "cls-1"}
+	tests := []struct {
+		name     string
+		matchers []notify.SilenceMatcher
+		want     bool
+	}{
+		{"equal", []notify.SilenceMatcher{{Name: "cluster_id", Value: "cls-1", Operator: notify.SilenceMatchEqual}}, true},
+		{"not equal", []notify.SilenceMatcher{{Name: "cluster_id", Value: "cls-1", Operator: notify.SilenceMatchNotEqual}}, false},
+		{"regexp", []notify.SilenceMatcher{{Name: "alertname", Value: "cpu_.*", Operator: notify.SilenceMatchRegexp}}, true},
+		{"regexp is anchored", []notify.SilenceMatcher{{Name: "alertname", Value: "cpu", Operator: notify.SilenceMatchRegexp}}, false},
+		{"not regexp", []notify.SilenceMatcher{{Name: "alertname", Value: "mem_.*", Operator: notify.SilenceMatchNotRegexp}}, true},
+		{"missing label", []notify.SilenceMatcher{{Name: "namespace", Value: "", Operator: notify.SilenceMatchEqual}}, true},
+		{"all matchers", []notify.SilenceMatcher{
+			{Name: "cluster_id", Value: "cls-1", Operator: notify.SilenceMatchEqual},
+			{Name: "alertname", Value: "mem_usage", Operator: notify.SilenceMatchEqual},
+		}, false},
+		{"no matchers", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			silence := &notify.Silence{Spec: notify.SilenceSpec{Matchers: tt.matchers}}
+			if got := silenceMatches(silence, labels); got != tt.want {
+				t.Errorf("silenceMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlertSilencer(t *testing.T) {
+	now := time.Now()
+	silence := &notify.Silence{
+		ObjectMeta: metav1.ObjectMeta{Name: "silence-1"},
+		Spec: notify.SilenceSpec{
+			TenantID: "default",
+			Matchers: []notify.SilenceMatcher{{Name: "cluster_id", Value: "cls-1", Operator: notify.SilenceMatchEqual}},
+			StartsAt: metav1.NewTime(now.Add(-time.Hour)),
+			EndsAt:   metav1.NewTime(now.Add(time.Hour)),
+		},
+	}
+	client := newFakeNotifyClient(silence)
+	silencer := &alertSilencer{notifyClient: client, now: func() time.Time { return now }}
+
+	for i := 0; i < 2; i++ {
+		silenced, err := silencer.silenced(context.Background(), "default", map[string]string{"cluster_id": "cls-1"})
+		if err != nil || !silenced {
+			t.Fatalf("silenced() = %v, %v, want true", silenced, err)
+		}
+	}
+	silenced, err := silencer.silenced(context.Background(), "default", map[string]string{"cluster_id": "cls-2"})
+	if err != nil || silenced {
+		t.Fatalf("silenced() = %v, %v, want false", silenced, err)
+	}
+
+	got, err := client.Silences().Get(context.Background(), "silence-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.SuppressedCount != 2 {
+		t.Errorf("SuppressedCount = %d, want 2", got.Status.SuppressedCount)
+	}
+
+	silencer.now = func() time.Time { return now.Add(2 * time.Hour) }
+	silenced, err = silencer.silenced(context.Background(), "default", map[string]string{"cluster_id": "cls-1"})
+	if err != nil || silenced {
+		t.Fatalf("silenced() after expiry = %v, %v, want false", silenced, err)
+	}
+}
+
+func TestAlertDispatcherDeduplicates(t *testing.T) {
+	client := newFakeNotifyClient()
+	d := newAlertDispatcher(AlertConfig{RepeatInterval: time.Hour}, client)
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	n := newTestNotification(map[string]string{"alertname": "cpu_usage"})
+	for i := 0; i < 3; i++ {
+		if err := d.dispatch(context.Background(), n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertMessageRequests(t, client, 1)
+
+	d.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if err := d.dispatch(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+	assertMessageRequests(t, client, 2)
+}
+
+func TestAlertDispatcherGroups(t *testing.T) {
+	client := newFakeNotifyClient()
+	config := AlertConfig{GroupBy: []string{"cluster_id"}, GroupWait: time.Hour}
+	now := time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC)
+	// the alerts are received by two replicas
+	dispatchers := []*alertDispatcher{newAlertDispatcher(config, client), newAlertDispatcher(config, client)}
+	for _, d := range dispatchers {
+		d.now = func() time.Time { return now }
+	}
+
+	for i, labels := range []map[string]string{
+		{"alertname": "cpu_usage", "cluster_id": "cls-1"},
+		{"alertname": "mem_usage", "cluster_id": "cls-1"},
+		{"alertname": "mem_usage", "cluster_id": "cls-1"},
+		{"alertname": "cpu_usage", "cluster_id": "cls-2"},
+	} {
+		if err := dispatchers[i%2].dispatch(context.Background(), newTestNotification(labels)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := assertMessageRequests(t, client, 2)
+	counts := map[string]bool{}
+	for _, mr := range list.Items {
+		counts[mr.Spec.Variables[alertCountKey]] = true
+		if sendAfter := mr.Annotations[notifyutil.AnnotationSendAfter]; sendAfter != "2020-01-01T11:00:00Z" {
+			t.Errorf("message request %s is sent after %s, want the end of the window", mr.Name, sendAfter)
+		}
+	}
+	if !counts["1"] || !counts["2"] {
+		t.Errorf("unexpected alert counts of groups: %v", counts)
+	}
+
+	now = now.Add(time.Hour)
+	if err := dispatchers[0].dispatch(context.Background(), newTestNotification(map[string]string{"alertname": "cpu_usage", "cluster_id": "cls-1"})); err != nil {
+		t.Fatal(err)
+	}
+	assertMessageRequests(t, client, 3)
+}
+
+func TestAlertDispatcherGroupsIntoNextWindowOnceSending(t *testing.T) {
+	client := newFakeNotifyClient()
+	d := newAlertDispatcher(AlertConfig{GroupWait: time.Hour}, client)
+	now := time.Date(2020, 1, 1, 10, 30, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	if err := d.dispatch(context.Background(), newTestNotification(map[string]string{"alertname": "cpu_usage"})); err != nil {
+		t.Fatal(err)
+	}
+	list := assertMessageRequests(t, client, 1)
+	sending := list.Items[0]
+	sending.Status.Phase = notify.MessageRequestSending
+	if _, err := client.MessageRequests("channel-1").UpdateStatus(context.Background(), &sending, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := d.dispatch(context.Background(), newTestNotification(map[string]string{"alertname": "mem_usage"})); err != nil {
+		t.Fatal(err)
+	}
+	for _, mr := range assertMessageRequests(t, client, 2).Items {
+		if mr.Name != sending.Name && mr.Annotations[notifyutil.AnnotationSendAfter] != "2020-01-01T12:00:00Z" {
+			t.Errorf("alert is not grouped into the next window: %v", mr.Annotations)
+		}
+	}
+}
+
+func assertMessageRequests(t *testing.T, client notifyinternalclient.NotifyInterface, want int) *notify.MessageRequestList {
+	t.Helper()
+	list, err := client.MessageRequests("channel-1").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != want {
+		t.Fatalf("got %d message requests, want %d", len(list.Items), want)
+	}
+	return list
+}
+
+func TestAlertDispatcherReturnsCreateError(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	failures := 1
+	clientset.PrependReactor("create", "messagerequests", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if failures > 0 {
+			failures--
+			return true, nil, fmt.Errorf("notify api is unavailable")
+		}
+		return false, nil, nil
+	})
+	client := clientset.Notify()
+	d := newAlertDispatcher(AlertConfig{GroupWait: time.Hour, RepeatInterval: time.Hour}, client)
+
+	// the alert is not recorded as sent, so that it is sent when the
+	// alertmanager retries it
+	n := newTestNotification(map[string]string{"alertname": "cpu_usage"})
+	if err := d.dispatch(context.Background(), n); err == nil {
+		t.Fatal("expected the error of creating the message request")
+	}
+	assertMessageRequests(t, client, 0)
+	if err := d.dispatch(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+	assertMessageRequests(t, client, 1)
+	if err := d.dispatch(context.Background(), n); err != nil {
+		t.Fatal(err)
+	}
+	assertMessageRequests(t, client, 1)
+}
+
+func TestAlertDispatcherSendsRefiredAlert(t *testing.T) {
+	client := newFakeNotifyClient()
+	d := newAlertDispatcher(AlertConfig{RepeatInterval: time.Hour}, client)
+	now := time.Now()
+	d.now = func() time.Time { return now }
+
+	firing := newTestNotification(map[string]string{"alertname": "cpu_usage"})
+	resolved := newTestNotification(map[string]string{"alertname": "cpu_usage"})
+	resolved.alert.Status = "resolved"
+	for _, n := range []alertNotification{firing, firing, resolved, resolved, firing} {
+		now = now.Add(time.Minute)
+		if err := d.dispatch(context.Background(), n); err != nil {
+			t.Fatal(err)
+		}
+	}
+	assertMessageRequests(t, client, 3)
+}
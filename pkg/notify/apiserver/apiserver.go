@@ -24,6 +24,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	notifyv1 "tkestack.io/tke/api/notify/v1"
 	"tkestack.io/tke/pkg/apiserver/storage"
@@ -37,9 +38,11 @@ type ExtraConfig struct {
 	APIResourceConfigSource serverstorage.APIResourceConfigSource
 	StorageFactory          serverstorage.StorageFactory
 	VersionedInformers      versionedinformers.SharedInformerFactory
+	PlatformClient          platformversionedclient.PlatformV1Interface
 	PrivilegedUsername      string
 	MessageRequestTTL       time.Duration
 	MessageTTL              time.Duration
+	AlertConfig             AlertConfig
 }
 
 // Config contains the core configuration instance of apiserver and
@@ -88,11 +91,12 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	}
 
 	// Register handlers
-	registerAlarmWebhook(s.Handler.NonGoRestfulMux, c.GenericConfig.LoopbackClientConfig)
+	registerAlarmWebhook(s.Handler.NonGoRestfulMux, c.GenericConfig.LoopbackClientConfig, c.ExtraConfig.AlertConfig)
 	// The order here is preserved in discovery.
 	restStorageProviders := []storage.RESTStorageProvider{
 		&notifyrest.StorageProvider{
 			LoopbackClientConfig: c.GenericConfig.LoopbackClientConfig,
+			PlatformClient:       c.ExtraConfig.PlatformClient,
 			PrivilegedUsername:   c.ExtraConfig.PrivilegedUsername,
 			MessageRequestTTL:    c.ExtraConfig.MessageRequestTTL,
 			MessageTTL:           c.ExtraConfig.MessageTTL,
@@ -19,6 +19,7 @@
 package apiserver
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -54,6 +55,7 @@ const (
 	evaluateValueKey      = "evaluateValue"
 	metricDisplayNameKey  = "metricDisplayName"
 	summaryKey            = "summary"
+	severityKey           = "severity"
 )
 
 // Request response struct
@@ -84,7 +86,11 @@ type Notification struct {
 	Alerts            []Alert           `json:"alerts"`
 }
 
-func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclient.Config) {
+func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclient.Config, alertConfig AlertConfig) {
+	notifyClient := notifyinternalclient.NewForConfigOrDie(loopbackClientConfig)
+	dispatcher := newAlertDispatcher(alertConfig, notifyClient)
+	silencer := &alertSilencer{notifyClient: notifyClient, now: time.Now}
+
 	m.HandleFunc("/webhook", func(w http.ResponseWriter, req *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		// Check method
@@ -109,6 +115,7 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 			return
 		}
 		log.Infof("Receive alerts: %+v", notifyInfo.Alerts)
+		channelTenants := make(map[string]string)
 		for _, alert := range notifyInfo.Alerts {
 			annotations := alert.Annotations
 			notifyWay, ok := annotations["notifyWay"]
@@ -121,7 +128,7 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 				setErrResponse("notifyWay is nil", http.StatusBadRequest, w)
 				return
 			}
-			variables := getVariables(alert)
+			silencedTenants := make(map[string]bool)
 			for _, way := range ways {
 				channelAndTemplate := strings.Split(way, ":")
 				if len(channelAndTemplate) != 2 {
@@ -147,15 +154,37 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 					setErrResponse("receivers and receiverGroups are nil", http.StatusBadRequest, w)
 					return
 				}
-				messageRequest := newMessageRequest(channel, template, receivers, receiverGroups, variables, alert.Status)
 
-				notifyClient := notifyinternalclient.NewForConfigOrDie(loopbackClientConfig)
-				_, err = notifyClient.MessageRequests(messageRequest.ObjectMeta.Namespace).Create(req.Context(), messageRequest, metav1.CreateOptions{})
+				tenantID, ok := channelTenants[channel]
+				if !ok {
+					tenantID = channelTenantID(req.Context(), notifyClient, channel)
+					channelTenants[channel] = tenantID
+				}
+				silenced, ok := silencedTenants[tenantID]
+				if !ok {
+					silenced, err = silencer.silenced(req.Context(), tenantID, alert.Labels)
+					if err != nil {
+						setErrResponse(err.Error(), http.StatusInternalServerError, w)
+						return
+					}
+					silencedTenants[tenantID] = silenced
+				}
+				if silenced {
+					log.Infof("Alert %v is silenced in channel %s", alert.Labels, channel)
+					continue
+				}
+
+				err = dispatcher.dispatch(req.Context(), alertNotification{
+					channel:        channel,
+					template:       template,
+					receivers:      receivers,
+					receiverGroups: receiverGroups,
+					alert:          alert,
+				})
 				if err != nil {
 					setErrResponse(err.Error(), http.StatusInternalServerError, w)
 					return
 				}
-				log.Infof("messageRequest created: %+v", messageRequest.Spec)
 			}
 		}
 		response := &responseMsg{
@@ -170,6 +199,18 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 	})
 }
 
+// channelTenantID returns the tenant of the channel, the alerts of an unknown
+// channel are checked against the silences without tenant and the error is
+// reported when the message request is created.
+func channelTenantID(ctx context.Context, notifyClient notifyinternalclient.NotifyInterface, channelName string) string {
+	channel, err := notifyClient.Channels().Get(ctx, channelName, metav1.GetOptions{})
+	if err != nil {
+		log.Warn("Failed to get channel of alert", log.String("channel", channelName), log.Err(err))
+		return ""
+	}
+	return channel.Spec.TenantID
+}
+
 func setErrResponse(msg string, statusCode int, w http.ResponseWriter) {
 	response := &responseMsg{
 		StatusCode: statusCode,
@@ -308,6 +349,7 @@ func getVariables(alert Alert) map[string]string {
 	variables[metricDisplayNameKey] = metricDisplayNameValue
 	variables[summaryKey] = summary
 	variables[virtualMachineKey] = virtualMachineName
+	variables[severityKey] = labels["severity"]
 
 	return variables
 }
@@ -0,0 +1,34 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// MessageRequestControllerConfiguration contains elements describing MessageRequestController.
+type MessageRequestControllerConfiguration struct {
+	// RetryBaseDelay is the delay before the first retry of a failed receiver,
+	// it doubles on every subsequent attempt.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay is the upper bound of the delay between two attempts.
+	RetryMaxDelay time.Duration
+	// MaxAttempts is the maximum number of delivery attempts per receiver
+	// before the message request is dead-lettered. A value not greater than 1
+	// disables retrying.
+	MaxAttempts int32
+}
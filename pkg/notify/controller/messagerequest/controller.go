@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	clientset "tkestack.io/tke/api/client/clientset/versioned"
@@ -37,10 +38,17 @@ import (
 	notifyv1lister "tkestack.io/tke/api/client/listers/notify/v1"
 	v1 "tkestack.io/tke/api/notify/v1"
 	controllerutil "tkestack.io/tke/pkg/controller"
+	messagerequestconfig "tkestack.io/tke/pkg/notify/controller/messagerequest/config"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/dingtalk"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/lark"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/slack"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/smtp"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/tencentcloudsms"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/webhook"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/wechat"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/wecom"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/metrics"
 )
@@ -57,20 +65,26 @@ const (
 // Controller is responsible for performing actions dependent upon a message request controller phase.
 type Controller struct {
 	client       clientset.Interface
+	kubeClient   kubernetes.Interface
 	cache        *messageRequestCache
 	queue        workqueue.RateLimitingInterface
 	lister       notifyv1lister.MessageRequestLister
 	listerSynced cache.InformerSynced
 	stopCh       <-chan struct{}
+	retryConfig  messagerequestconfig.MessageRequestControllerConfiguration
 }
 
 // NewController creates a new Controller object.
-func NewController(client clientset.Interface, informer notifyv1informer.MessageRequestInformer, resyncPeriod time.Duration) *Controller {
+func NewController(client clientset.Interface, kubeClient kubernetes.Interface, informer notifyv1informer.MessageRequestInformer, resyncPeriod time.Duration, retryConfig *messagerequestconfig.MessageRequestControllerConfiguration) *Controller {
 	// create the controller so we can inject the enqueue function
 	controller := &Controller{
-		client: client,
-		cache:  &messageRequestCache{messageRequestMap: make(map[string]*cachedMessageRequest)},
-		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		client:     client,
+		kubeClient: kubeClient,
+		cache:      &messageRequestCache{messageRequestMap: make(map[string]*cachedMessageRequest)},
+		queue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+	if retryConfig != nil {
+		controller.retryConfig = *retryConfig
 	}
 
 	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
@@ -132,6 +146,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	for i := 0; i < workers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
 	}
+	go wait.Until(c.sendDigests, time.Minute, stopCh)
 
 	<-stopCh
 }
@@ -236,34 +251,216 @@ func (c *Controller) processMessageRequestUpdate(ctx context.Context, cachedMess
 func (c *Controller) createMessageRequestIfNeeded(ctx context.Context, key string, cachedMessageRequest *cachedMessageRequest, messageRequest *v1.MessageRequest) error {
 	switch messageRequest.Status.Phase {
 	case v1.MessageRequestPending:
+		if sendAfter, ok := sendAfterTime(messageRequest); ok {
+			if delay := time.Until(sendAfter); delay > 0 {
+				c.queue.AddAfter(key, delay)
+				return nil
+			}
+		}
+		messageRequest = messageRequest.DeepCopy()
+		messageRequest.Status.Phase = v1.MessageRequestSending
+		messageRequest.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdate(ctx, messageRequest)
+	case v1.MessageRequestRetrying:
+		nextAttemptTime, ok := c.nextAttemptTime(messageRequest)
+		if !ok {
+			messageRequest = messageRequest.DeepCopy()
+			messageRequest.Status.Phase = v1.MessageRequestDeadLetter
+			messageRequest.Status.LastTransitionTime = metav1.Now()
+			return c.persistUpdate(ctx, messageRequest)
+		}
+		if nextDelivery, deferred := nextDeliveryTime(messageRequest); deferred && nextDelivery.Before(nextAttemptTime) {
+			nextAttemptTime = nextDelivery
+		}
+		if delay := time.Until(nextAttemptTime); delay > 0 {
+			c.queue.AddAfter(key, delay)
+			return nil
+		}
 		messageRequest = messageRequest.DeepCopy()
 		messageRequest.Status.Phase = v1.MessageRequestSending
 		messageRequest.Status.LastTransitionTime = metav1.Now()
 		return c.persistUpdate(ctx, messageRequest)
 	case v1.MessageRequestSending:
-		if cachedMessageRequest.state != nil && cachedMessageRequest.state.Status.Phase == v1.MessageRequestPending {
-			sentMessages, failedReceiverErrors := c.sendMessage(ctx, messageRequest)
+		if cachedMessageRequest.state != nil &&
+			(cachedMessageRequest.state.Status.Phase == v1.MessageRequestPending ||
+				cachedMessageRequest.state.Status.Phase == v1.MessageRequestRetrying ||
+				cachedMessageRequest.state.Status.Phase == v1.MessageRequestDeferred) {
+			now := time.Now()
+			receivers, policies := c.resolveReceivers(ctx, messageRequest, now)
+			opts := &sendOptions{preferences: true, now: now}
+			if due := c.dueReceivers(messageRequest, now); due != nil {
+				receivers = receivers.Intersection(receiverNames(due))
+				opts.only = due
+			}
+			sentMessages, failedReceiverErrors, deferrals := c.sendMessage(ctx, messageRequest, receivers, opts)
 			if len(sentMessages) > 0 {
 				c.archiveMessage(ctx, messageRequest, sentMessages)
 			}
 			messageRequest = messageRequest.DeepCopy()
-			messageRequest.Status.LastTransitionTime = metav1.Now()
-			if len(failedReceiverErrors) == 0 {
+			messageRequest.Status.LastTransitionTime = metav1.NewTime(now)
+			c.recordAttempt(messageRequest, failedReceiverErrors, now)
+			recordDeferrals(messageRequest, deferrals, now)
+			startEscalations(messageRequest, policies, now)
+			switch {
+			case len(messageRequest.Status.Errors) == 0:
 				messageRequest.Status.Phase = v1.MessageRequestSent
-			} else {
+			case c.retryConfig.MaxAttempts <= 1:
 				if len(sentMessages) == 0 {
 					messageRequest.Status.Phase = v1.MessageRequestFailed
 				} else {
 					messageRequest.Status.Phase = v1.MessageRequestPartialFailure
 				}
-				messageRequest.Status.Errors = failedReceiverErrors
+			default:
+				if _, ok := c.nextAttemptTime(messageRequest); ok {
+					messageRequest.Status.Phase = v1.MessageRequestRetrying
+				} else {
+					messageRequest.Status.Phase = v1.MessageRequestDeadLetter
+				}
+			}
+			if messageRequest.Status.Phase == v1.MessageRequestSent || messageRequest.Status.Phase == v1.MessageRequestPartialFailure {
+				if len(messageRequest.Status.Deferrals) > 0 {
+					messageRequest.Status.Phase = v1.MessageRequestDeferred
+				} else if _, ok := nextEscalationTime(messageRequest); ok {
+					messageRequest.Status.Phase = v1.MessageRequestEscalating
+				}
 			}
 			return c.persistUpdate(ctx, messageRequest)
 		}
+	case v1.MessageRequestDeferred:
+		nextDelivery, ok := nextDeliveryTime(messageRequest)
+		if ok {
+			if delay := time.Until(nextDelivery); delay > 0 {
+				c.queue.AddAfter(key, delay)
+				return nil
+			}
+		}
+		messageRequest = messageRequest.DeepCopy()
+		if ok {
+			messageRequest.Status.Phase = v1.MessageRequestSending
+		} else if _, escalating := nextEscalationTime(messageRequest); escalating {
+			messageRequest.Status.Phase = v1.MessageRequestEscalating
+		} else {
+			messageRequest.Status.Phase = v1.MessageRequestSent
+		}
+		messageRequest.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdate(ctx, messageRequest)
+	case v1.MessageRequestEscalating:
+		return c.escalate(ctx, key, messageRequest)
 	}
 	return nil
 }
 
+// sendAfterTime returns the time before which the message request is held
+// pending, false is returned if the message request is not held.
+func sendAfterTime(messageRequest *v1.MessageRequest) (time.Time, bool) {
+	value, ok := messageRequest.Annotations[notifyutil.AnnotationSendAfter]
+	if !ok {
+		return time.Time{}, false
+	}
+	sendAfter, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		log.Warn("Invalid send after time of message request", log.String("messageRequestName", messageRequest.Name), log.String("sendAfter", value))
+		return time.Time{}, false
+	}
+	return sendAfter, true
+}
+
+// dueReceivers returns the receivers whose retry is due and the receiver
+// channels whose deferred delivery is due at the given time, the returned set
+// is nil if the message request has never been attempted, which means all of
+// the receivers should be sent.
+func (c *Controller) dueReceivers(messageRequest *v1.MessageRequest, now time.Time) sets.String {
+	if len(messageRequest.Status.Retries) == 0 && len(messageRequest.Status.Deferrals) == 0 {
+		return nil
+	}
+	receivers := sets.NewString()
+	for receiverName, retry := range messageRequest.Status.Retries {
+		if c.isDue(retry, now) {
+			receivers.Insert(receiverName)
+		}
+	}
+	receivers.Insert(dueDeferrals(messageRequest, now)...)
+	return receivers
+}
+
+func (c *Controller) isDue(retry v1.MessageRequestRetryStatus, now time.Time) bool {
+	return retry.Attempts < c.retryConfig.MaxAttempts && !retry.NextAttemptTime.Time.After(now)
+}
+
+// nextAttemptTime returns the earliest time at which a failed receiver should
+// be retried, false is returned if all of the retries have been exhausted.
+func (c *Controller) nextAttemptTime(messageRequest *v1.MessageRequest) (time.Time, bool) {
+	if len(messageRequest.Status.Retries) == 0 {
+		return time.Time{}, true
+	}
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, retry := range messageRequest.Status.Retries {
+		if retry.Attempts >= c.retryConfig.MaxAttempts {
+			continue
+		}
+		if !found || retry.NextAttemptTime.Time.Before(next) {
+			next = retry.NextAttemptTime.Time
+			found = true
+		}
+	}
+	return next, found
+}
+
+// recordAttempt updates the errors and retry state of the message request
+// status according to the result of a delivery attempt.
+func (c *Controller) recordAttempt(messageRequest *v1.MessageRequest, failedReceiverErrors map[string]string, now time.Time) {
+	status := &messageRequest.Status
+	if status.Retries == nil {
+		status.Retries = make(map[string]v1.MessageRequestRetryStatus)
+	}
+	if status.Errors == nil {
+		status.Errors = make(map[string]string)
+	}
+	// clear the state of the receivers which have been delivered in this attempt
+	for key, retry := range status.Retries {
+		if _, failed := failedReceiverErrors[key]; !failed && c.isDue(retry, now) {
+			delete(status.Retries, key)
+			delete(status.Errors, key)
+		}
+	}
+	for key, reason := range failedReceiverErrors {
+		retry := status.Retries[key]
+		retry.Attempts++
+		retry.LastAttemptTime = metav1.NewTime(now)
+		retry.LastError = reason
+		if retry.Attempts < c.retryConfig.MaxAttempts {
+			retry.NextAttemptTime = metav1.NewTime(now.Add(retryBackoff(&c.retryConfig, retry.Attempts)))
+		} else {
+			retry.NextAttemptTime = metav1.Time{}
+		}
+		status.Retries[key] = retry
+		status.Errors[key] = reason
+	}
+	if len(status.Retries) == 0 {
+		status.Retries = nil
+	}
+	if len(status.Errors) == 0 {
+		status.Errors = nil
+	}
+}
+
+// retryBackoff returns the delay before the next attempt of a receiver which
+// has failed the given number of attempts, the delay doubles after every
+// attempt and is capped at RetryMaxDelay.
+func retryBackoff(cfg *messagerequestconfig.MessageRequestControllerConfiguration, attempts int32) time.Duration {
+	delay := cfg.RetryBaseDelay
+	for i := int32(1); i < attempts && delay < cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if cfg.RetryMaxDelay > 0 && delay > cfg.RetryMaxDelay {
+		delay = cfg.RetryMaxDelay
+	}
+	return delay
+}
+
 func (c *Controller) persistUpdate(ctx context.Context, messageRequest *v1.MessageRequest) error {
 	var err error
 	for i := 0; i < clientRetryCount; i++ {
@@ -301,29 +498,18 @@ type sentMessage struct {
 	receiverChannelName string
 	clusterID           string
 	alertStatus         string
+	webhookRequest      *v1.WebhookRequest
+	deliveryState       v1.MessageDeliveryState
+	deliveryReason      string
+	deferredTime        metav1.Time
 }
 
-func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.MessageRequest) (sentMessages []sentMessage, failedReceiverErrors map[string]string) {
+// sendMessage sends the message of the message request to the given receivers,
+// the deliveries deferred by the preferences of the receivers are returned
+// keyed by deferralKey.
+func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.MessageRequest, receiversSet sets.String, opts *sendOptions) (sentMessages []sentMessage, failedReceiverErrors map[string]string, deferrals map[string]v1.MessageRequestDeferralStatus) {
 	failedReceiverErrors = make(map[string]string)
-	receiversSet := sets.NewString()
-	for _, receiverGroupName := range messageRequest.Spec.ReceiverGroups {
-		if receiverGroupName == "" {
-			continue
-		}
-		if receiverGroup, err := c.client.NotifyV1().ReceiverGroups().Get(ctx, receiverGroupName, metav1.GetOptions{}); err != nil {
-			log.Error("Failed to retrieve the specify receiver group", log.String("receiverGroupName", receiverGroupName), log.Err(err))
-		} else {
-			if len(receiverGroup.Spec.Receivers) != 0 {
-				receiversSet.Insert(receiverGroup.Spec.Receivers...)
-			}
-		}
-	}
-	for _, receiver := range messageRequest.Spec.Receivers {
-		if receiver != "" {
-			receiversSet.Insert(receiver)
-		}
-	}
-
+	deferrals = make(map[string]v1.MessageRequestDeferralStatus)
 	if receiversSet.Len() == 0 {
 		return
 	}
@@ -373,9 +559,14 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 		clusterID = v
 	}
 	if channel.Spec.Webhook != nil && template.Spec.Text != nil {
-		content, err := webhook.Send(channel.Spec.Webhook, template.Spec.Text, receivers, messageRequest.Spec.Variables, messageRequest.Status.AlertStatus)
+		credential, err := webhook.LoadCredential(ctx, c.kubeClient, channel.Spec.TenantID, channel.Spec.Webhook)
+		if err != nil {
+			failReceivers(failedReceiverErrors, receivers, err.Error())
+			return
+		}
+		content, request, err := webhook.Send(channel.Spec.Webhook, template.Spec.Text, receivers, messageRequest.Spec.Variables, messageRequest.Status.AlertStatus, credential)
 		if err != nil {
-			failedReceiverErrors[strings.Join(receiversSet.List(), ",")] = err.Error()
+			failReceivers(failedReceiverErrors, receivers, err.Error())
 			return
 		}
 		sentMessages = append(sentMessages, sentMessage{
@@ -383,6 +574,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 			receiverChannel:     v1.ReceiverChannelWebhook,
 			identity:            channel.Spec.Webhook.URL,
 			body:                content,
+			webhookRequest:      request,
 			alarmPolicyName:     alarmPolicyName,
 			alarmPolicyType:     alarmPolicyType,
 			receiverChannelName: channel.Name,
@@ -391,10 +583,81 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 		})
 		return
 	}
+	if chatChannel := chatReceiverChannel(channel); chatChannel != "" && template.Spec.Markdown != nil && len(receivers) > 0 {
+		var receiverNames []string
+		for _, receiver := range receivers {
+			receiverNames = append(receiverNames, receiver.ObjectMeta.Name)
+		}
+		receiverName := strings.Join(receiverNames, ",")
+		header, body, err := sendChatMessage(channel, template.Spec.Markdown, receivers, messageRequest.Spec.Variables, messageRequest.Status.AlertStatus)
+		if err != nil {
+			failReceivers(failedReceiverErrors, receivers, err.Error())
+			return
+		}
+		sentMessages = append(sentMessages, sentMessage{
+			receiverName:        receiverName,
+			receiverChannel:     chatChannel,
+			identity:            strings.Join(util.GetIdentities(receivers, chatChannel), ","),
+			header:              header,
+			body:                body,
+			alarmPolicyName:     alarmPolicyName,
+			alarmPolicyType:     alarmPolicyType,
+			receiverChannelName: channel.Name,
+			clusterID:           clusterID,
+			alertStatus:         messageRequest.Status.AlertStatus,
+		})
+		return
+	}
+	digested := sets.NewString()
 	for _, receiver := range receivers {
 		receiverName := receiver.ObjectMeta.Name
 		templateCount := 0
-		if template.Spec.TencentCloudSMS != nil {
+		held := 0
+		// hold returns whether the delivery to the receiver channel is not
+		// made in this attempt.
+		hold := func(receiverChannel v1.ReceiverChannel) bool {
+			key := deferralKey(receiverName, receiverChannel)
+			if !opts.selected(receiverName, receiverChannel) {
+				held++
+				return true
+			}
+			if deferral, ok := messageRequest.Status.Deferrals[key]; ok && deferral.DeliverAfter.Time.After(opts.now) {
+				held++
+				return true
+			}
+			if !opts.preferences {
+				return false
+			}
+			h := c.holdDelivery(ctx, messageRequest, receiver, receiverChannel, opts.now)
+			switch {
+			case h == nil:
+				return false
+			case h.digest:
+				// the message has been queued for the digest in the first attempt
+				if opts.only == nil && !digested.Has(receiverName) {
+					digested.Insert(receiverName)
+					sentMessages = append(sentMessages, sentMessage{
+						receiverName:        receiverName,
+						receiverChannel:     v1.ReceiverChannelEmail,
+						identity:            receiver.Spec.Identities[v1.ReceiverChannelEmail],
+						username:            receiver.Spec.Username,
+						body:                messageRequest.Spec.Variables[summaryKey],
+						alarmPolicyName:     alarmPolicyName,
+						alarmPolicyType:     alarmPolicyType,
+						receiverChannelName: channel.Name,
+						clusterID:           clusterID,
+						alertStatus:         messageRequest.Status.AlertStatus,
+						deliveryState:       v1.MessageDeliveryDigestPending,
+						deliveryReason:      "Merged into the digest of the receiver",
+					})
+				}
+			default:
+				deferrals[key] = *h.deferral
+			}
+			held++
+			return true
+		}
+		if template.Spec.TencentCloudSMS != nil && !hold(v1.ReceiverChannelMobile) {
 			templateCount++
 			if channel.Spec.TencentCloudSMS == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding tencent cloud account"
@@ -424,7 +687,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				alertStatus:         messageRequest.Status.AlertStatus,
 			})
 		}
-		if template.Spec.Wechat != nil {
+		if template.Spec.Wechat != nil && !hold(v1.ReceiverChannelWechatOpenID) {
 			templateCount++
 			if channel.Spec.Wechat == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding Wechat account"
@@ -454,7 +717,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				alertStatus:         messageRequest.Status.AlertStatus,
 			})
 		}
-		if template.Spec.Text != nil {
+		if template.Spec.Text != nil && !hold(v1.ReceiverChannelEmail) {
 			templateCount++
 			if channel.Spec.SMTP == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding smtp server"
@@ -484,13 +747,63 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				alertStatus:         messageRequest.Status.AlertStatus,
 			})
 		}
-		if templateCount == 0 {
+		if templateCount == 0 && held == 0 {
 			failedReceiverErrors[receiverName] = "Notification sending template is not configured"
 		}
 	}
+	for i := range sentMessages {
+		deferral, ok := messageRequest.Status.Deferrals[deferralKey(sentMessages[i].receiverName, sentMessages[i].receiverChannel)]
+		if ok && sentMessages[i].deliveryState == "" {
+			sentMessages[i].deliveryState = v1.MessageDeliveryDeferred
+			sentMessages[i].deliveryReason = deferral.Reason
+			sentMessages[i].deferredTime = deferral.DeferredTime
+		}
+	}
+	c.recordDeliveries(ctx, receivers, sentMessages, opts.now)
 	return
 }
 
+// chatReceiverChannel returns the receiver channel used to mention the
+// receivers in the chat tool channel, an empty string is returned if the
+// channel is not a chat tool channel.
+func chatReceiverChannel(channel *v1.Channel) v1.ReceiverChannel {
+	switch {
+	case channel.Spec.Slack != nil:
+		return v1.ReceiverChannelSlack
+	case channel.Spec.DingTalk != nil:
+		return v1.ReceiverChannelDingTalk
+	case channel.Spec.WeCom != nil:
+		return v1.ReceiverChannelWeCom
+	case channel.Spec.Lark != nil:
+		return v1.ReceiverChannelLark
+	}
+	return ""
+}
+
+// sendChatMessage sends one markdown message which mentions all of the
+// receivers to the group of chat tool channel.
+func sendChatMessage(channel *v1.Channel, template *v1.TemplateMarkdown, receivers []*v1.Receiver, variables map[string]string, status string) (header, body string, err error) {
+	switch {
+	case channel.Spec.Slack != nil:
+		return slack.Send(channel.Spec.Slack, template, receivers, variables, status)
+	case channel.Spec.DingTalk != nil:
+		return dingtalk.Send(channel.Spec.DingTalk, template, receivers, variables, status)
+	case channel.Spec.WeCom != nil:
+		return wecom.Send(channel.Spec.WeCom, template, receivers, variables, status)
+	case channel.Spec.Lark != nil:
+		return lark.Send(channel.Spec.Lark, template, receivers, variables, status)
+	}
+	return "", "", fmt.Errorf("channel %s is not a chat tool channel", channel.ObjectMeta.Name)
+}
+
+// failReceivers records the error of a request which sends the message to
+// all of the receivers together, so that each of them is retried on its own.
+func failReceivers(failedReceiverErrors map[string]string, receivers []*v1.Receiver, reason string) {
+	for _, receiver := range receivers {
+		failedReceiverErrors[receiver.ObjectMeta.Name] = reason
+	}
+}
+
 func (c *Controller) archiveMessage(ctx context.Context, messageRequest *v1.MessageRequest, sentMessages []sentMessage) {
 	for _, sentMessage := range sentMessages {
 		message := &v1.Message{
@@ -507,10 +820,15 @@ func (c *Controller) archiveMessage(ctx context.Context, messageRequest *v1.Mess
 				AlarmPolicyType:     sentMessage.alarmPolicyType,
 				ReceiverChannelName: sentMessage.receiverChannelName,
 				ClusterID:           sentMessage.clusterID,
+				WebhookRequest:      sentMessage.webhookRequest,
+				MessageRequestName:  messageRequest.ObjectMeta.Name,
 			},
 			Status: v1.MessageStatus{
-				Phase:       v1.MessageUnread,
-				AlertStatus: sentMessage.alertStatus,
+				Phase:          v1.MessageUnread,
+				AlertStatus:    sentMessage.alertStatus,
+				DeliveryState:  sentMessage.deliveryState,
+				DeliveryReason: sentMessage.deliveryReason,
+				DeferredTime:   sentMessage.deferredTime,
 			},
 		}
 		if _, err := c.client.NotifyV1().Messages().Create(ctx, message, metav1.CreateOptions{}); err != nil {
@@ -0,0 +1,193 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	v1 "tkestack.io/tke/api/notify/v1"
+	messagerequestconfig "tkestack.io/tke/pkg/notify/controller/messagerequest/config"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	cfg := &messagerequestconfig.MessageRequestControllerConfiguration{
+		RetryBaseDelay: time.Second,
+		RetryMaxDelay:  10 * time.Second,
+		MaxAttempts:    10,
+	}
+	expects := map[int32]time.Duration{
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		4: 8 * time.Second,
+		5: 10 * time.Second,
+		9: 10 * time.Second,
+	}
+	for attempts, expect := range expects {
+		if got := retryBackoff(cfg, attempts); got != expect {
+			t.Errorf("retryBackoff(%d) = %v, want %v", attempts, got, expect)
+		}
+	}
+}
+
+func TestRecordAttempt(t *testing.T) {
+	c := &Controller{
+		retryConfig: messagerequestconfig.MessageRequestControllerConfiguration{
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  time.Minute,
+			MaxAttempts:    2,
+		},
+	}
+	now := time.Now()
+	messageRequest := &v1.MessageRequest{}
+
+	c.recordAttempt(messageRequest, map[string]string{"a": "timeout", "b": "timeout"}, now)
+	if len(messageRequest.Status.Retries) != 2 || messageRequest.Status.Retries["a"].Attempts != 1 {
+		t.Fatalf("unexpected retries after first attempt: %+v", messageRequest.Status.Retries)
+	}
+	next, ok := c.nextAttemptTime(messageRequest)
+	if !ok || !next.Equal(now.Add(time.Second)) {
+		t.Fatalf("unexpected next attempt time %v, %v", next, ok)
+	}
+	if receivers := c.dueReceivers(messageRequest, now); receivers.Len() != 0 {
+		t.Fatalf("no receiver should be due before the backoff elapsed, got %v", receivers.List())
+	}
+
+	later := now.Add(time.Second)
+	if receivers := c.dueReceivers(messageRequest, later); !receivers.HasAll("a", "b") {
+		t.Fatalf("all receivers should be due after the backoff elapsed, got %v", receivers.List())
+	}
+	c.recordAttempt(messageRequest, map[string]string{"b": "timeout"}, later)
+	if _, ok := messageRequest.Status.Retries["a"]; ok {
+		t.Fatalf("delivered receiver should be removed from retries")
+	}
+	if _, ok := messageRequest.Status.Errors["a"]; ok {
+		t.Fatalf("delivered receiver should be removed from errors")
+	}
+	if retry := messageRequest.Status.Retries["b"]; retry.Attempts != 2 || !retry.NextAttemptTime.IsZero() {
+		t.Fatalf("unexpected retry state of exhausted receiver: %+v", retry)
+	}
+	if _, ok := c.nextAttemptTime(messageRequest); ok {
+		t.Fatalf("retries should be exhausted")
+	}
+}
+
+func TestRecordAttemptOfWebhookPerReceiver(t *testing.T) {
+	failures := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := fake.NewSimpleClientset(
+		&v1.Channel{
+			ObjectMeta: metav1.ObjectMeta{Name: "channel-1"},
+			Spec:       v1.ChannelSpec{Webhook: &v1.ChannelWebhook{URL: server.URL}},
+		},
+		&v1.Template{
+			ObjectMeta: metav1.ObjectMeta{Name: "template-1", Namespace: "channel-1"},
+			Spec:       v1.TemplateSpec{Text: &v1.TemplateText{Body: "hello"}},
+		},
+		&v1.Receiver{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+		&v1.Receiver{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	)
+	c := &Controller{
+		client: client,
+		retryConfig: messagerequestconfig.MessageRequestControllerConfiguration{
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  time.Minute,
+			MaxAttempts:    3,
+		},
+	}
+	messageRequest := &v1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "mr-1", Namespace: "channel-1"},
+		Spec:       v1.MessageRequestSpec{TemplateName: "template-1"},
+	}
+	now := time.Now()
+
+	_, failedReceiverErrors, _ := c.sendMessage(context.Background(), messageRequest, sets.NewString("a", "b"), &sendOptions{now: now})
+	c.recordAttempt(messageRequest, failedReceiverErrors, now)
+	for _, name := range []string{"a", "b"} {
+		if retry := messageRequest.Status.Retries[name]; retry.Attempts != 1 {
+			t.Errorf("unexpected retry state of receiver %s: %+v", name, retry)
+		}
+	}
+
+	later := now.Add(time.Second)
+	due := c.dueReceivers(messageRequest, later)
+	if !due.Equal(sets.NewString("a", "b")) {
+		t.Fatalf("unexpected due receivers %v", due.List())
+	}
+	sentMessages, failedReceiverErrors, _ := c.sendMessage(context.Background(), messageRequest, due, &sendOptions{now: later})
+	c.recordAttempt(messageRequest, failedReceiverErrors, later)
+	if len(sentMessages) != 1 || messageRequest.Status.Retries != nil || messageRequest.Status.Errors != nil {
+		t.Errorf("delivered receivers should be cleared, got %+v", messageRequest.Status)
+	}
+}
+
+func TestPendingMessageRequestHeldUntilSendAfter(t *testing.T) {
+	tests := []struct {
+		name      string
+		sendAfter time.Time
+		want      v1.MessageRequestPhase
+	}{
+		{"held", time.Now().Add(time.Hour), v1.MessageRequestPending},
+		{"due", time.Now().Add(-time.Second), v1.MessageRequestSending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messageRequest := &v1.MessageRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "alert-1",
+					Namespace:   "channel-1",
+					Annotations: map[string]string{notifyutil.AnnotationSendAfter: tt.sendAfter.Format(time.RFC3339Nano)},
+				},
+				Status: v1.MessageRequestStatus{Phase: v1.MessageRequestPending},
+			}
+			c := &Controller{
+				client: fake.NewSimpleClientset(messageRequest),
+				queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+			}
+			defer c.queue.ShutDown()
+
+			if err := c.createMessageRequestIfNeeded(context.Background(), "channel-1/alert-1", &cachedMessageRequest{}, messageRequest); err != nil {
+				t.Fatal(err)
+			}
+			got, err := c.client.NotifyV1().MessageRequests("channel-1").Get(context.Background(), "alert-1", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status.Phase != tt.want {
+				t.Errorf("phase = %s, want %s", got.Status.Phase, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package messagerequest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/smtp"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultDigestInterval = 60 * time.Minute
+
+	digestCountKey    = "digestCount"
+	digestSummaryKey  = "digestSummary"
+	digestReceiverKey = "receiver"
+)
+
+// sendDigests merges the messages waiting for the digest of every receiver
+// into one email once the oldest of them has waited for the digest interval.
+func (c *Controller) sendDigests() {
+	ctx := context.Background()
+	messageList, err := c.client.NotifyV1().Messages().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("status.deliveryState", string(v1.MessageDeliveryDigestPending)).String(),
+	})
+	if err != nil {
+		log.Error("Failed to list the messages waiting for digest", log.Err(err))
+		return
+	}
+	pending := make(map[string][]v1.Message)
+	for _, message := range messageList.Items {
+		pending[message.Spec.ReceiverName] = append(pending[message.Spec.ReceiverName], message)
+	}
+	now := time.Now()
+	for receiverName, messages := range pending {
+		if err := c.sendDigest(ctx, receiverName, messages, now); err != nil {
+			log.Error("Failed to send the digest", log.String("receiverName", receiverName), log.Err(err))
+		}
+	}
+}
+
+func (c *Controller) sendDigest(ctx context.Context, receiverName string, messages []v1.Message, now time.Time) error {
+	receiver, err := c.client.NotifyV1().Receivers().Get(ctx, receiverName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if receiver.Spec.Preferences == nil || receiver.Spec.Preferences.Digest == nil {
+		log.Warn("The digest of the receiver has been disabled, the pending messages are kept", log.String("receiverName", receiverName))
+		return nil
+	}
+	digest := receiver.Spec.Preferences.Digest
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].ObjectMeta.CreationTimestamp.Before(&messages[j].ObjectMeta.CreationTimestamp)
+	})
+	if !digestDue(digest, messages[0].ObjectMeta.CreationTimestamp.Time, now) {
+		return nil
+	}
+
+	channel, err := c.client.NotifyV1().Channels().Get(ctx, digest.Channel, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	template, err := c.client.NotifyV1().Templates(channel.ObjectMeta.Name).Get(ctx, digest.Template, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	email, ok := receiver.Spec.Identities[v1.ReceiverChannelEmail]
+	switch {
+	case channel.Spec.TenantID != receiver.Spec.TenantID || template.Spec.TenantID != receiver.Spec.TenantID:
+		return fmt.Errorf("the digest channel or template does not belong to the tenant of the receiver")
+	case channel.Spec.SMTP == nil:
+		return fmt.Errorf("the digest channel is not configured with smtp server")
+	case template.Spec.Text == nil:
+		return fmt.Errorf("the digest template is not a text template")
+	case !ok:
+		return fmt.Errorf("the notification recipient did not configure the email")
+	}
+
+	header, body, err := smtp.SendDigest(channel.Spec.SMTP, template.Spec.Text, email, digestVariables(receiver, messages))
+	if err != nil {
+		return err
+	}
+	digestMessage, err := c.client.NotifyV1().Messages().Create(ctx, &v1.Message{
+		Spec: v1.MessageSpec{
+			TenantID:            receiver.Spec.TenantID,
+			ReceiverName:        receiverName,
+			ReceiverChannel:     v1.ReceiverChannelEmail,
+			Identity:            email,
+			Username:            receiver.Spec.Username,
+			Header:              header,
+			Body:                body,
+			ReceiverChannelName: channel.ObjectMeta.Name,
+		},
+		Status: v1.MessageStatus{
+			Phase:         v1.MessageUnread,
+			DeliveryState: v1.MessageDeliveryDigest,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range messages {
+		message := messages[i].DeepCopy()
+		message.Status.DeliveryState = v1.MessageDeliveryDigested
+		message.Status.DigestMessageName = digestMessage.ObjectMeta.Name
+		if _, err := c.client.NotifyV1().Messages().UpdateStatus(ctx, message, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to mark the message as digested", log.String("messageName", message.ObjectMeta.Name), log.Err(err))
+		}
+	}
+	return nil
+}
+
+// digestDue returns whether the digest should be sent now that the oldest
+// pending message was created at the given time.
+func digestDue(digest *v1.ReceiverDigest, oldest time.Time, now time.Time) bool {
+	interval := defaultDigestInterval
+	if digest.IntervalMinutes > 0 {
+		interval = time.Duration(digest.IntervalMinutes) * time.Minute
+	}
+	return !oldest.Add(interval).After(now)
+}
+
+// digestVariables returns the variables used to render the digest template.
+func digestVariables(receiver *v1.Receiver, messages []v1.Message) map[string]string {
+	summaries := make([]string, 0, len(messages))
+	for _, message := range messages {
+		summaries = append(summaries, message.Spec.Body)
+	}
+	receiverDisplayName := receiver.Spec.DisplayName
+	if receiverDisplayName == "" {
+		receiverDisplayName = receiver.ObjectMeta.Name
+	}
+	return map[string]string{
+		digestCountKey:    strconv.Itoa(len(messages)),
+		digestSummaryKey:  strings.Join(summaries, "\n\n"),
+		digestReceiverKey: receiverDisplayName,
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type markdown struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type at struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	AtUserIds []string `json:"atUserIds,omitempty"`
+}
+
+// messageBody represents the body info to request a dingtalk robot
+type messageBody struct {
+	MsgType  string   `json:"msgtype"`
+	Markdown markdown `json:"markdown"`
+	At       at       `json:"at"`
+}
+
+type resMessageBody struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// Send notification to dingtalk by custom robot
+func Send(channel *v1.ChannelDingTalk, template *v1.TemplateMarkdown, receivers []*v1.Receiver, variables map[string]string, status string) (header, body string, err error) {
+	header, err = util.ParseTemplate("dingtalkHeader", template.Title, variables)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = util.ParseTemplate("dingtalkBody", template.Body, variables)
+	if err != nil {
+		return header, "", err
+	}
+
+	reqBody := messageBody{
+		MsgType: "markdown",
+		At: at{
+			AtUserIds: util.GetIdentities(receivers, v1.ReceiverChannelDingTalk),
+			AtMobiles: util.GetIdentities(receivers, v1.ReceiverChannelMobile),
+		},
+	}
+	// the mentioned users must appear in the text to be highlighted
+	var mentions []string
+	for _, identity := range append(reqBody.At.AtUserIds, reqBody.At.AtMobiles...) {
+		mentions = append(mentions, "@"+identity)
+	}
+	title := header
+	if title == "" {
+		title = util.GetAlertStatus(status)
+	}
+	reqBody.Markdown = markdown{
+		Title: title,
+		Text:  util.GetAlertStatus(status) + "\n\n" + body,
+	}
+	if len(mentions) > 0 {
+		reqBody.Markdown.Text += "\n\n" + strings.Join(mentions, " ")
+	}
+	log.Debugf("dingtalk body: %v", reqBody)
+
+	webhookURL, err := signURL(channel, time.Now())
+	if err != nil {
+		return header, body, err
+	}
+	response, err := util.PostJSON(webhookURL, reqBody)
+	if err != nil {
+		return header, body, err
+	}
+	var resMessage resMessageBody
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return header, body, err
+	}
+	if resMessage.ErrCode != 0 {
+		return header, body, fmt.Errorf("post dingtalk robot error: errcode=%v, errmsg=%v", resMessage.ErrCode, resMessage.ErrMsg)
+	}
+	return header, body, nil
+}
+
+// signURL appends the timestamp and signature to the webhook url if the
+// secret of robot is specified.
+// See: https://open.dingtalk.com/document/robots/customize-robot-security-settings
+func signURL(channel *v1.ChannelDingTalk, now time.Time) (string, error) {
+	if channel.Secret == "" {
+		return channel.WebhookURL, nil
+	}
+	reqURL, err := url.Parse(channel.WebhookURL)
+	if err != nil {
+		return "", err
+	}
+	timestamp := strconv.FormatInt(now.UnixNano()/int64(time.Millisecond), 10)
+	h := hmac.New(sha256.New, []byte(channel.Secret))
+	h.Write([]byte(timestamp + "\n" + channel.Secret))
+	q := reqURL.Query()
+	q.Set("timestamp", timestamp)
+	q.Set("sign", base64.StdEncoding.EncodeToString(h.Sum(nil)))
+	reqURL.RawQuery = q.Encode()
+	return reqURL.String(), nil
+}
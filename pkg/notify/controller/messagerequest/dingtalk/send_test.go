@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dingtalk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestSignURL(t *testing.T) {
+	channel := &v1.ChannelDingTalk{
+		WebhookURL: "https://oapi.dingtalk.com/robot/send?access_token=token",
+		Secret:     "SEC000000",
+	}
+	signed, err := signURL(channel, time.Unix(1600000000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+	if q.Get("access_token") != "token" {
+		t.Errorf("access token should be kept, got %q", q.Get("access_token"))
+	}
+	if q.Get("timestamp") != "1600000000000" {
+		t.Errorf("unexpected timestamp %q", q.Get("timestamp"))
+	}
+	if q.Get("sign") == "" {
+		t.Errorf("sign should be set")
+	}
+
+	channel.Secret = ""
+	if unsigned, _ := signURL(channel, time.Now()); unsigned != channel.WebhookURL {
+		t.Errorf("url should not be signed without secret, got %q", unsigned)
+	}
+}
+
+func TestSend(t *testing.T) {
+	var reqBody messageBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelDingTalk{WebhookURL: server.URL + "/robot/send?access_token=token"}
+	template := &v1.TemplateMarkdown{Title: "{{.title}}", Body: "cluster {{.clusterID}} is down"}
+	receivers := []*v1.Receiver{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: v1.ReceiverSpec{Identities: map[v1.ReceiverChannel]string{v1.ReceiverChannelMobile: "13800000000"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+	}
+	variables := map[string]string{"title": "alarm", "clusterID": "cls-1"}
+
+	header, body, err := Send(channel, template, receivers, variables, "firing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header != "alarm" || body != "cluster cls-1 is down" {
+		t.Errorf("unexpected rendered message %q, %q", header, body)
+	}
+	if len(reqBody.At.AtMobiles) != 1 || reqBody.At.AtMobiles[0] != "13800000000" {
+		t.Errorf("unexpected mentioned mobiles %v", reqBody.At.AtMobiles)
+	}
+	if !strings.Contains(reqBody.Markdown.Text, "@13800000000") {
+		t.Errorf("mentioned mobile should be in text, got %q", reqBody.Markdown.Text)
+	}
+}
@@ -0,0 +1,334 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package messagerequest
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	v1 "tkestack.io/tke/api/notify/v1"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// resolveReceivers returns the receivers of the message request at the given
+// time. The receiver groups with an escalation policy are resolved to the
+// targets of their current escalation level, and the policies are returned
+// keyed by the name of the receiver group.
+func (c *Controller) resolveReceivers(ctx context.Context, messageRequest *v1.MessageRequest, now time.Time) (sets.String, map[string]*v1.EscalationPolicy) {
+	receiversSet := sets.NewString()
+	policies := make(map[string]*v1.EscalationPolicy)
+	for _, receiverGroupName := range messageRequest.Spec.ReceiverGroups {
+		if receiverGroupName == "" {
+			continue
+		}
+		receiverGroup, err := c.client.NotifyV1().ReceiverGroups().Get(ctx, receiverGroupName, metav1.GetOptions{})
+		if err != nil {
+			log.Error("Failed to retrieve the specify receiver group", log.String("receiverGroupName", receiverGroupName), log.Err(err))
+			continue
+		}
+		if receiverGroup.Spec.EscalationPolicy == "" {
+			receiversSet.Insert(receiverGroup.Spec.Receivers...)
+			continue
+		}
+		policy, err := c.client.NotifyV1().EscalationPolicies().Get(ctx, receiverGroup.Spec.EscalationPolicy, metav1.GetOptions{})
+		if err != nil || len(policy.Spec.Rules) == 0 {
+			// fall back to the static receivers so that the message is not lost
+			log.Error("Failed to retrieve the escalation policy of receiver group", log.String("receiverGroupName", receiverGroupName), log.String("escalationPolicy", receiverGroup.Spec.EscalationPolicy), log.Err(err))
+			receiversSet.Insert(receiverGroup.Spec.Receivers...)
+			continue
+		}
+		level := int(messageRequest.Status.Escalations[receiverGroupName].Level)
+		if level >= len(policy.Spec.Rules) {
+			level = len(policy.Spec.Rules) - 1
+		}
+		receiversSet.Insert(c.resolveTargets(ctx, policy.Spec.Rules[level].Targets, now).UnsortedList()...)
+		policies[receiverGroupName] = policy
+	}
+	for _, receiver := range messageRequest.Spec.Receivers {
+		if receiver != "" {
+			receiversSet.Insert(receiver)
+		}
+	}
+	return receiversSet, policies
+}
+
+// resolveTargets returns the receivers referenced by the escalation targets
+// at the given time.
+func (c *Controller) resolveTargets(ctx context.Context, targets []v1.EscalationTarget, now time.Time) sets.String {
+	receivers := sets.NewString()
+	for _, target := range targets {
+		switch target.Kind {
+		case v1.EscalationTargetReceiver:
+			receivers.Insert(target.Name)
+		case v1.EscalationTargetReceiverGroup:
+			receiverGroup, err := c.client.NotifyV1().ReceiverGroups().Get(ctx, target.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Error("Failed to retrieve the receiver group of escalation target", log.String("receiverGroupName", target.Name), log.Err(err))
+				continue
+			}
+			receivers.Insert(receiverGroup.Spec.Receivers...)
+		case v1.EscalationTargetOnCallSchedule:
+			schedule, err := c.client.NotifyV1().OnCallSchedules().Get(ctx, target.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Error("Failed to retrieve the on-call schedule of escalation target", log.String("onCallScheduleName", target.Name), log.Err(err))
+				continue
+			}
+			receivers.Insert(onCallReceivers(schedule, now)...)
+		}
+	}
+	return receivers
+}
+
+// startEscalations records the first escalation level of the receiver groups
+// which have been notified according to their escalation policies.
+func startEscalations(messageRequest *v1.MessageRequest, policies map[string]*v1.EscalationPolicy, now time.Time) {
+	if len(policies) == 0 || messageRequest.Status.Escalations != nil {
+		return
+	}
+	messageRequest.Status.Escalations = make(map[string]v1.MessageRequestEscalationStatus)
+	for receiverGroupName, policy := range policies {
+		messageRequest.Status.Escalations[receiverGroupName] = v1.MessageRequestEscalationStatus{
+			Policy:             policy.ObjectMeta.Name,
+			LastEscalationTime: metav1.NewTime(now),
+			NextEscalationTime: nextEscalationLevelTime(policy, 0, now),
+		}
+	}
+}
+
+// nextEscalationLevelTime returns the time at which the level after the
+// given one should be notified, it is empty if the level is the last one.
+func nextEscalationLevelTime(policy *v1.EscalationPolicy, level int32, now time.Time) metav1.Time {
+	if int(level)+1 >= len(policy.Spec.Rules) {
+		return metav1.Time{}
+	}
+	delay := time.Duration(policy.Spec.Rules[level].DelayMinutes) * time.Minute
+	return metav1.NewTime(now.Add(delay))
+}
+
+// nextEscalationTime returns the earliest time at which a receiver group
+// should be escalated, false is returned if all of the escalations have
+// finished.
+func nextEscalationTime(messageRequest *v1.MessageRequest) (time.Time, bool) {
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, escalation := range messageRequest.Status.Escalations {
+		if escalation.NextEscalationTime.IsZero() {
+			continue
+		}
+		if !found || escalation.NextEscalationTime.Time.Before(next) {
+			next = escalation.NextEscalationTime.Time
+			found = true
+		}
+	}
+	return next, found
+}
+
+// escalate notifies the next level of the receiver groups whose escalation
+// is due, unless a message of the request has been acknowledged.
+func (c *Controller) escalate(ctx context.Context, key string, messageRequest *v1.MessageRequest) error {
+	now := time.Now()
+	next, ok := nextEscalationTime(messageRequest)
+	if ok {
+		if delay := next.Sub(now); delay > 0 {
+			c.queue.AddAfter(key, delay)
+			return nil
+		}
+	}
+
+	acknowledged, err := c.acknowledged(ctx, messageRequest)
+	if err != nil {
+		return err
+	}
+	messageRequest = messageRequest.DeepCopy()
+	messageRequest.Status.LastTransitionTime = metav1.NewTime(now)
+	if !ok {
+		messageRequest.Status.Phase = v1.MessageRequestSent
+		return c.persistUpdate(ctx, messageRequest)
+	}
+	if acknowledged {
+		for receiverGroupName, escalation := range messageRequest.Status.Escalations {
+			escalation.NextEscalationTime = metav1.Time{}
+			messageRequest.Status.Escalations[receiverGroupName] = escalation
+		}
+		messageRequest.Status.Phase = v1.MessageRequestAcknowledged
+		return c.persistUpdate(ctx, messageRequest)
+	}
+
+	receivers := sets.NewString()
+	for receiverGroupName, escalation := range messageRequest.Status.Escalations {
+		if escalation.NextEscalationTime.IsZero() || escalation.NextEscalationTime.Time.After(now) {
+			continue
+		}
+		escalation.NextEscalationTime = metav1.Time{}
+		policy, err := c.client.NotifyV1().EscalationPolicies().Get(ctx, escalation.Policy, metav1.GetOptions{})
+		if err != nil {
+			log.Error("Failed to retrieve the escalation policy, stop escalating", log.String("receiverGroupName", receiverGroupName), log.String("escalationPolicy", escalation.Policy), log.Err(err))
+			messageRequest.Status.Escalations[receiverGroupName] = escalation
+			continue
+		}
+		escalation.Level++
+		if int(escalation.Level) < len(policy.Spec.Rules) {
+			receivers.Insert(c.resolveTargets(ctx, policy.Spec.Rules[escalation.Level].Targets, now).UnsortedList()...)
+			escalation.LastEscalationTime = metav1.NewTime(now)
+			escalation.NextEscalationTime = nextEscalationLevelTime(policy, escalation.Level, now)
+		}
+		messageRequest.Status.Escalations[receiverGroupName] = escalation
+	}
+
+	// the escalated receivers are notified regardless of their preferences
+	sentMessages, failedReceiverErrors, _ := c.sendMessage(ctx, messageRequest, receivers, &sendOptions{now: now})
+	if len(sentMessages) > 0 {
+		c.archiveMessage(ctx, messageRequest, sentMessages)
+	}
+	if len(failedReceiverErrors) > 0 && messageRequest.Status.Errors == nil {
+		messageRequest.Status.Errors = make(map[string]string)
+	}
+	for receiverName, reason := range failedReceiverErrors {
+		messageRequest.Status.Errors[receiverName] = reason
+	}
+	if _, ok := nextEscalationTime(messageRequest); !ok {
+		messageRequest.Status.Phase = v1.MessageRequestSent
+	}
+	return c.persistUpdate(ctx, messageRequest)
+}
+
+// acknowledged returns whether any message sent by the message request has
+// been acknowledged.
+func (c *Controller) acknowledged(ctx context.Context, messageRequest *v1.MessageRequest) (bool, error) {
+	messages, err := c.client.NotifyV1().Messages().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"spec.receiverChannelName": messageRequest.ObjectMeta.Namespace,
+			"spec.messageRequestName":  messageRequest.ObjectMeta.Name,
+			"status.phase":             string(v1.MessageAcknowledged),
+		}).String(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(messages.Items) > 0, nil
+}
+
+// onCallReceivers returns the receivers on call at the given time, the
+// active overrides take precedence over the layers, and the last active
+// layer takes precedence over the former ones.
+func onCallReceivers(schedule *v1.OnCallSchedule, now time.Time) []string {
+	var receivers []string
+	for _, override := range schedule.Spec.Overrides {
+		if !now.Before(override.StartsAt.Time) && now.Before(override.EndsAt.Time) {
+			receivers = append(receivers, override.Receiver)
+		}
+	}
+	if len(receivers) > 0 {
+		return receivers
+	}
+
+	location := time.UTC
+	if schedule.Spec.TimeZone != "" {
+		loc, err := time.LoadLocation(schedule.Spec.TimeZone)
+		if err != nil {
+			log.Error("Failed to load the time zone of on-call schedule", log.String("onCallScheduleName", schedule.ObjectMeta.Name), log.Err(err))
+		} else {
+			location = loc
+		}
+	}
+	localNow := now.In(location)
+	for i := len(schedule.Spec.Layers) - 1; i >= 0; i-- {
+		if receiver, ok := layerReceiver(&schedule.Spec.Layers[i], localNow); ok {
+			return []string{receiver}
+		}
+	}
+	return nil
+}
+
+// layerReceiver returns the receiver on duty in the layer at the given local
+// time. Daily and weekly hand-offs are counted by calendar days, so that they
+// happen at the same local time of day across daylight saving changes.
+func layerReceiver(layer *v1.OnCallLayer, now time.Time) (string, bool) {
+	if len(layer.Receivers) == 0 {
+		return "", false
+	}
+	start := layer.Start.Time.In(now.Location())
+	if now.Before(start) || !restrictionsActive(layer.Restrictions, now) {
+		return "", false
+	}
+
+	var units int64
+	switch layer.RotationType {
+	case v1.OnCallRotationHourly:
+		units = int64(now.Sub(start) / time.Hour)
+	case v1.OnCallRotationDaily, v1.OnCallRotationWeekly:
+		units = calendarDays(start, now)
+		handoff := time.Date(now.Year(), now.Month(), now.Day(), start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), now.Location())
+		if now.Before(handoff) {
+			units--
+		}
+		if layer.RotationType == v1.OnCallRotationWeekly {
+			units /= 7
+		}
+	default:
+		return "", false
+	}
+
+	shiftLength := int64(layer.ShiftLength)
+	if shiftLength <= 0 {
+		shiftLength = 1
+	}
+	shift := units / shiftLength
+	return layer.Receivers[shift%int64(len(layer.Receivers))], true
+}
+
+// calendarDays returns the number of calendar days between the dates of the
+// two local times.
+func calendarDays(from, to time.Time) int64 {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int64(toDate.Sub(fromDate) / (24 * time.Hour))
+}
+
+// restrictionsActive returns whether the local time falls in any of the
+// daily time windows, no restriction means always active.
+func restrictionsActive(restrictions []v1.OnCallRestriction, now time.Time) bool {
+	if len(restrictions) == 0 {
+		return true
+	}
+	offset := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+	for _, restriction := range restrictions {
+		startTime, err := notifyutil.ParseClockTime(restriction.StartTime)
+		if err != nil {
+			continue
+		}
+		endTime, err := notifyutil.ParseClockTime(restriction.EndTime)
+		if err != nil {
+			continue
+		}
+		if startTime < endTime {
+			if offset >= startTime && offset < endTime {
+				return true
+			}
+		} else if offset >= startTime || offset < endTime {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package messagerequest
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestOnCallReceivers(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip(err)
+	}
+	start := time.Date(2021, 1, 4, 9, 0, 0, 0, shanghai)
+	schedule := &v1.OnCallSchedule{
+		Spec: v1.OnCallScheduleSpec{
+			TimeZone: "Asia/Shanghai",
+			Layers: []v1.OnCallLayer{
+				{
+					Name:         "primary",
+					Receivers:    []string{"alice", "bob", "carol"},
+					Start:        metav1.NewTime(start),
+					RotationType: v1.OnCallRotationDaily,
+				},
+				{
+					Name:         "night",
+					Receivers:    []string{"dave"},
+					Start:        metav1.NewTime(start),
+					RotationType: v1.OnCallRotationWeekly,
+					Restrictions: []v1.OnCallRestriction{{StartTime: "22:00", EndTime: "06:00"}},
+				},
+			},
+			Overrides: []v1.OnCallOverride{
+				{
+					Receiver: "erin",
+					StartsAt: metav1.NewTime(time.Date(2021, 1, 10, 12, 0, 0, 0, shanghai)),
+					EndsAt:   metav1.NewTime(time.Date(2021, 1, 10, 14, 0, 0, 0, shanghai)),
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{"before start", start.Add(-time.Hour), nil},
+		{"first shift", start.Add(time.Hour), []string{"alice"}},
+		{"before hand-off", time.Date(2021, 1, 5, 8, 59, 0, 0, shanghai), []string{"alice"}},
+		{"after hand-off", time.Date(2021, 1, 5, 9, 0, 0, 0, shanghai), []string{"bob"}},
+		{"rotation wraps", time.Date(2021, 1, 7, 10, 0, 0, 0, shanghai), []string{"alice"}},
+		{"hand-off in UTC is local", time.Date(2021, 1, 6, 1, 0, 0, 0, time.UTC), []string{"carol"}},
+		{"restricted layer", time.Date(2021, 1, 5, 23, 0, 0, 0, shanghai), []string{"dave"}},
+		{"restriction crosses midnight", time.Date(2021, 1, 6, 5, 0, 0, 0, shanghai), []string{"dave"}},
+		{"override", time.Date(2021, 1, 10, 13, 0, 0, 0, shanghai), []string{"erin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := onCallReceivers(schedule, tt.now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("onCallReceivers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLayerReceiverAcrossDaylightSaving(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	layer := &v1.OnCallLayer{
+		Receivers:    []string{"alice", "bob"},
+		Start:        metav1.NewTime(time.Date(2021, 3, 13, 9, 0, 0, 0, newYork)),
+		RotationType: v1.OnCallRotationDaily,
+	}
+	// the clocks move forward on 2021-03-14, the hand-off stays at 09:00 local time
+	for _, tt := range []struct {
+		now  time.Time
+		want string
+	}{
+		{time.Date(2021, 3, 14, 8, 59, 0, 0, newYork), "alice"},
+		{time.Date(2021, 3, 14, 9, 0, 0, 0, newYork), "bob"},
+		{time.Date(2021, 3, 15, 9, 0, 0, 0, newYork), "alice"},
+	} {
+		if got, _ := layerReceiver(layer, tt.now); got != tt.want {
+			t.Errorf("layerReceiver(%v) = %s, want %s", tt.now, got, tt.want)
+		}
+	}
+}
+
+func TestStartEscalations(t *testing.T) {
+	now := time.Now()
+	policy := &v1.EscalationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Spec: v1.EscalationPolicySpec{
+			Rules: []v1.EscalationRule{
+				{DelayMinutes: 10, Targets: []v1.EscalationTarget{{Kind: v1.EscalationTargetReceiver, Name: "alice"}}},
+				{Targets: []v1.EscalationTarget{{Kind: v1.EscalationTargetReceiver, Name: "bob"}}},
+			},
+		},
+	}
+	messageRequest := &v1.MessageRequest{}
+	startEscalations(messageRequest, map[string]*v1.EscalationPolicy{"group": policy}, now)
+
+	next, ok := nextEscalationTime(messageRequest)
+	if !ok || !next.Equal(now.Add(10*time.Minute)) {
+		t.Fatalf("nextEscalationTime() = %v, %v, want %v", next, ok, now.Add(10*time.Minute))
+	}
+	if got := nextEscalationLevelTime(policy, 1, now); !got.IsZero() {
+		t.Errorf("the last level should not escalate, got %v", got)
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lark
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type text struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+type element struct {
+	Tag  string `json:"tag"`
+	Text text   `json:"text"`
+}
+
+type cardHeader struct {
+	Title text `json:"title"`
+}
+
+type card struct {
+	Header   *cardHeader `json:"header,omitempty"`
+	Elements []element   `json:"elements"`
+}
+
+// messageBody represents the body info to request a lark custom bot
+type messageBody struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Sign      string `json:"sign,omitempty"`
+	MsgType   string `json:"msg_type"`
+	Card      card   `json:"card"`
+}
+
+type resMessageBody struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Send notification to lark by custom bot
+func Send(channel *v1.ChannelLark, template *v1.TemplateMarkdown, receivers []*v1.Receiver, variables map[string]string, status string) (header, body string, err error) {
+	header, err = util.ParseTemplate("larkHeader", template.Title, variables)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = util.ParseTemplate("larkBody", template.Body, variables)
+	if err != nil {
+		return header, "", err
+	}
+
+	content := util.GetAlertStatus(status) + "\n" + body
+	var mentions []string
+	for _, openID := range util.GetIdentities(receivers, v1.ReceiverChannelLark) {
+		mentions = append(mentions, fmt.Sprintf("<at id=%s></at>", openID))
+	}
+	if len(mentions) > 0 {
+		content += "\n" + strings.Join(mentions, " ")
+	}
+	reqBody := messageBody{
+		MsgType: "interactive",
+		Card: card{
+			Elements: []element{{Tag: "div", Text: text{Tag: "lark_md", Content: content}}},
+		},
+	}
+	if header != "" {
+		reqBody.Card.Header = &cardHeader{Title: text{Tag: "plain_text", Content: header}}
+	}
+	if channel.Secret != "" {
+		reqBody.Timestamp, reqBody.Sign = sign(channel.Secret, time.Now())
+	}
+	log.Debugf("lark body: %v", reqBody)
+
+	response, err := util.PostJSON(channel.WebhookURL, reqBody)
+	if err != nil {
+		return header, body, err
+	}
+	var resMessage resMessageBody
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return header, body, err
+	}
+	if resMessage.Code != 0 {
+		return header, body, fmt.Errorf("post lark bot error: code=%v, msg=%v", resMessage.Code, resMessage.Msg)
+	}
+	return header, body, nil
+}
+
+// sign returns the timestamp and signature of the request.
+// See: https://open.feishu.cn/document/ukTMukTMukTM/ucTM5YjL3ETO24yNxkjN#3c6592d6
+func sign(secret string, now time.Time) (timestamp string, signature string) {
+	timestamp = strconv.FormatInt(now.Unix(), 10)
+	h := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return timestamp, base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
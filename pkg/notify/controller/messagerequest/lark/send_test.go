@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lark
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestSend(t *testing.T) {
+	var reqBody messageBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelLark{WebhookURL: server.URL, Secret: "secret"}
+	template := &v1.TemplateMarkdown{Body: "cluster {{.clusterID}} is down"}
+	receivers := []*v1.Receiver{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: v1.ReceiverSpec{Identities: map[v1.ReceiverChannel]string{v1.ReceiverChannelLark: "ou_1"}}},
+	}
+
+	if _, _, err := Send(channel, template, receivers, map[string]string{"clusterID": "cls-1"}, "resolved"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(reqBody.Card.Elements[0].Text.Content, "<at id=ou_1></at>") {
+		t.Errorf("receiver should be mentioned, got %q", reqBody.Card.Elements[0].Text.Content)
+	}
+	h := hmac.New(sha256.New, []byte(reqBody.Timestamp+"\n"+channel.Secret))
+	if expect := base64.StdEncoding.EncodeToString(h.Sum(nil)); reqBody.Sign != expect {
+		t.Errorf("unexpected sign %q, want %q", reqBody.Sign, expect)
+	}
+}
+
+func TestSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"code":19021,"msg":"sign match fail or timestamp is not within one hour from current time"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelLark{WebhookURL: server.URL}
+	if _, _, err := Send(channel, &v1.TemplateMarkdown{Body: "body"}, nil, map[string]string{}, "firing"); err == nil {
+		t.Errorf("error response of lark should fail the sending")
+	}
+}
@@ -0,0 +1,325 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package messagerequest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	v1 "tkestack.io/tke/api/notify/v1"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	severityKey = "severity"
+	summaryKey  = "summary"
+)
+
+// sendOptions controls which deliveries are made by sendMessage.
+type sendOptions struct {
+	// only restricts the deliveries to the receivers and the receiver
+	// channels, keyed by deferralKey, in the set if it is not nil.
+	only sets.String
+	// preferences honours the notification preferences of the receivers.
+	preferences bool
+	now         time.Time
+}
+
+// selected returns whether the delivery to the receiver channel is made in
+// this attempt.
+func (o *sendOptions) selected(receiverName string, receiverChannel v1.ReceiverChannel) bool {
+	return o.only == nil || o.only.Has(receiverName) || o.only.Has(deferralKey(receiverName, receiverChannel))
+}
+
+// deliveryHold describes why a delivery to the receiver is not made now.
+type deliveryHold struct {
+	// digest means that the message will be merged into the next digest.
+	digest   bool
+	deferral *v1.MessageRequestDeferralStatus
+}
+
+func deferralKey(receiverName string, receiverChannel v1.ReceiverChannel) string {
+	return receiverName + "/" + string(receiverChannel)
+}
+
+// receiverNames returns the receiver names of the retry and deferral keys.
+func receiverNames(keys sets.String) sets.String {
+	names := sets.NewString()
+	for key := range keys {
+		names.Insert(strings.SplitN(key, "/", 2)[0])
+	}
+	return names
+}
+
+// holdDelivery checks the delivery to the receiver channel against the
+// notification preferences of the receiver, nil is returned if the message
+// should be delivered now.
+func (c *Controller) holdDelivery(ctx context.Context, messageRequest *v1.MessageRequest, receiver *v1.Receiver, receiverChannel v1.ReceiverChannel, now time.Time) *deliveryHold {
+	preferences := receiver.Spec.Preferences
+	if preferences == nil {
+		return nil
+	}
+	if preferences.Digest != nil {
+		severity := messageRequest.Spec.Variables[severityKey]
+		for _, s := range preferences.Digest.Severities {
+			if s == severity {
+				return &deliveryHold{digest: true}
+			}
+		}
+	}
+
+	var (
+		deliverAfter time.Time
+		reasons      []string
+	)
+	if until, ok := quietUntil(preferences, receiverChannel, now); ok {
+		deliverAfter = until
+		reasons = append(reasons, "quiet hours")
+	}
+	if until, ok := rateLimitedUntil(preferences.RateLimits, receiver.Status.Deliveries, receiverChannel, now); ok {
+		if until.After(deliverAfter) {
+			deliverAfter = until
+		}
+		reasons = append(reasons, "rate limit")
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return &deliveryHold{
+		deferral: &v1.MessageRequestDeferralStatus{
+			DeferredTime: metav1.NewTime(now),
+			DeliverAfter: metav1.NewTime(deliverAfter),
+			Reason:       fmt.Sprintf("Deferred by the %s of the receiver", strings.Join(reasons, " and ")),
+		},
+	}
+}
+
+// quietUntil returns the end of the quiet hours of the receiver channel which
+// the time falls in, false is returned if the time is not in quiet hours.
+func quietUntil(preferences *v1.ReceiverPreferences, receiverChannel v1.ReceiverChannel, now time.Time) (time.Time, bool) {
+	location := time.UTC
+	if preferences.TimeZone != "" {
+		if loc, err := time.LoadLocation(preferences.TimeZone); err == nil {
+			location = loc
+		}
+	}
+	local := now.In(location)
+	offset := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute + time.Duration(local.Second())*time.Second
+	at := func(days int, clock time.Duration) time.Time {
+		return time.Date(local.Year(), local.Month(), local.Day()+days, int(clock/time.Hour), int(clock%time.Hour/time.Minute), 0, 0, location)
+	}
+
+	var (
+		until time.Time
+		found bool
+	)
+	for _, quietHours := range preferences.QuietHours {
+		if len(quietHours.Channels) > 0 && !containsReceiverChannel(quietHours.Channels, receiverChannel) {
+			continue
+		}
+		startTime, err := notifyutil.ParseClockTime(quietHours.StartTime)
+		if err != nil {
+			continue
+		}
+		endTime, err := notifyutil.ParseClockTime(quietHours.EndTime)
+		if err != nil {
+			continue
+		}
+		var end time.Time
+		switch {
+		case startTime < endTime && offset >= startTime && offset < endTime:
+			end = at(0, endTime)
+		case startTime >= endTime && offset >= startTime:
+			end = at(1, endTime)
+		case startTime >= endTime && offset < endTime:
+			end = at(0, endTime)
+		default:
+			continue
+		}
+		if end.After(until) {
+			until = end
+		}
+		found = true
+	}
+	return until, found
+}
+
+func containsReceiverChannel(receiverChannels []v1.ReceiverChannel, receiverChannel v1.ReceiverChannel) bool {
+	for _, rc := range receiverChannels {
+		if rc == receiverChannel {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitedUntil returns the time after which the rate limits of the
+// receiver allow another message on the receiver channel, false is returned
+// if the message is allowed now.
+func rateLimitedUntil(rateLimits []v1.ReceiverRateLimit, deliveries []v1.ReceiverDelivery, receiverChannel v1.ReceiverChannel, now time.Time) (time.Time, bool) {
+	var (
+		until time.Time
+		found bool
+	)
+	for _, rateLimit := range rateLimits {
+		if rateLimit.Channel != "" && rateLimit.Channel != receiverChannel {
+			continue
+		}
+		period := time.Duration(rateLimit.PeriodMinutes) * time.Minute
+		var sentTimes []time.Time
+		for _, delivery := range deliveries {
+			if rateLimit.Channel != "" && delivery.Channel != rateLimit.Channel {
+				continue
+			}
+			if sentTime := delivery.Time.Time; now.Sub(sentTime) < period {
+				sentTimes = append(sentTimes, sentTime)
+			}
+		}
+		if len(sentTimes) < int(rateLimit.Limit) {
+			continue
+		}
+		sort.Slice(sentTimes, func(i, j int) bool { return sentTimes[i].Before(sentTimes[j]) })
+		// wait until enough messages slide out of the period
+		end := sentTimes[len(sentTimes)-int(rateLimit.Limit)].Add(period)
+		if end.After(until) {
+			until = end
+		}
+		found = true
+	}
+	return until, found
+}
+
+// recordDeliveries counts the messages delivered to the receivers in their
+// status for the rate limits.
+func (c *Controller) recordDeliveries(ctx context.Context, receivers []*v1.Receiver, sentMessages []sentMessage, now time.Time) {
+	for _, receiver := range receivers {
+		if receiver.Spec.Preferences == nil || len(receiver.Spec.Preferences.RateLimits) == 0 {
+			continue
+		}
+		var receiverChannels []v1.ReceiverChannel
+		for _, sentMessage := range sentMessages {
+			if sentMessage.receiverName == receiver.ObjectMeta.Name && sentMessage.deliveryState != v1.MessageDeliveryDigestPending {
+				receiverChannels = append(receiverChannels, sentMessage.receiverChannel)
+			}
+		}
+		if len(receiverChannels) == 0 {
+			continue
+		}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest, err := c.client.NotifyV1().Receivers().Get(ctx, receiver.ObjectMeta.Name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if latest.Spec.Preferences == nil {
+				return nil
+			}
+			latest.Status.Deliveries = appendDeliveries(latest.Spec.Preferences.RateLimits, latest.Status.Deliveries, receiverChannels, now)
+			_, err = c.client.NotifyV1().Receivers().UpdateStatus(ctx, latest, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			log.Error("Failed to record the deliveries of receiver", log.String("receiverName", receiver.ObjectMeta.Name), log.Err(err))
+		}
+	}
+}
+
+// appendDeliveries appends the deliveries on the receiver channels limited
+// by the rate limits and removes the deliveries which no rate limit counts
+// any more, so that the deliveries are bounded by the limits.
+func appendDeliveries(rateLimits []v1.ReceiverRateLimit, deliveries []v1.ReceiverDelivery, receiverChannels []v1.ReceiverChannel, now time.Time) []v1.ReceiverDelivery {
+	var longest time.Duration
+	limited := sets.NewString()
+	for _, rateLimit := range rateLimits {
+		if period := time.Duration(rateLimit.PeriodMinutes) * time.Minute; period > longest {
+			longest = period
+		}
+		limited.Insert(string(rateLimit.Channel))
+	}
+	var result []v1.ReceiverDelivery
+	for _, delivery := range deliveries {
+		if now.Sub(delivery.Time.Time) < longest && (limited.Has("") || limited.Has(string(delivery.Channel))) {
+			result = append(result, delivery)
+		}
+	}
+	for _, receiverChannel := range receiverChannels {
+		if limited.Has("") || limited.Has(string(receiverChannel)) {
+			result = append(result, v1.ReceiverDelivery{Channel: receiverChannel, Time: metav1.NewTime(now)})
+		}
+	}
+	return result
+}
+
+// recordDeferrals updates the deferred deliveries of the message request
+// status, the due deferrals have been attempted and are replaced by the
+// deferrals of this attempt.
+func recordDeferrals(messageRequest *v1.MessageRequest, deferrals map[string]v1.MessageRequestDeferralStatus, now time.Time) {
+	status := &messageRequest.Status
+	previous := status.Deferrals
+	remaining := make(map[string]v1.MessageRequestDeferralStatus)
+	for key, deferral := range previous {
+		if deferral.DeliverAfter.Time.After(now) {
+			remaining[key] = deferral
+		}
+	}
+	for key, deferral := range deferrals {
+		if old, ok := previous[key]; ok {
+			// keep the time at which the delivery was deferred first
+			deferral.DeferredTime = old.DeferredTime
+		}
+		remaining[key] = deferral
+	}
+	if len(remaining) == 0 {
+		remaining = nil
+	}
+	status.Deferrals = remaining
+}
+
+// nextDeliveryTime returns the earliest time at which a deferred delivery
+// should be attempted, false is returned if there is no deferred delivery.
+func nextDeliveryTime(messageRequest *v1.MessageRequest) (time.Time, bool) {
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, deferral := range messageRequest.Status.Deferrals {
+		if !found || deferral.DeliverAfter.Time.Before(next) {
+			next = deferral.DeliverAfter.Time
+			found = true
+		}
+	}
+	return next, found
+}
+
+// dueDeferrals returns the keys of the deferred deliveries which are due at
+// the given time.
+func dueDeferrals(messageRequest *v1.MessageRequest, now time.Time) []string {
+	var keys []string
+	for key, deferral := range messageRequest.Status.Deferrals {
+		if !deferral.DeliverAfter.Time.After(now) {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package messagerequest
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestQuietUntil(t *testing.T) {
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Skip(err)
+	}
+	preferences := &v1.ReceiverPreferences{
+		TimeZone: "Asia/Shanghai",
+		QuietHours: []v1.ReceiverQuietHours{
+			{StartTime: "22:00", EndTime: "07:00"},
+			{Channels: []v1.ReceiverChannel{v1.ReceiverChannelMobile}, StartTime: "12:00", EndTime: "14:00"},
+		},
+	}
+	tests := []struct {
+		name    string
+		channel v1.ReceiverChannel
+		now     time.Time
+		want    time.Time
+		wantOk  bool
+	}{
+		{"outside", v1.ReceiverChannelEmail, time.Date(2021, 1, 4, 9, 0, 0, 0, shanghai), time.Time{}, false},
+		{"before midnight", v1.ReceiverChannelEmail, time.Date(2021, 1, 4, 23, 0, 0, 0, shanghai), time.Date(2021, 1, 5, 7, 0, 0, 0, shanghai), true},
+		{"after midnight", v1.ReceiverChannelEmail, time.Date(2021, 1, 5, 6, 59, 0, 0, shanghai), time.Date(2021, 1, 5, 7, 0, 0, 0, shanghai), true},
+		{"end is exclusive", v1.ReceiverChannelEmail, time.Date(2021, 1, 5, 7, 0, 0, 0, shanghai), time.Time{}, false},
+		{"evaluated in time zone", v1.ReceiverChannelEmail, time.Date(2021, 1, 4, 15, 0, 0, 0, time.UTC), time.Date(2021, 1, 5, 7, 0, 0, 0, shanghai), true},
+		{"channel matched", v1.ReceiverChannelMobile, time.Date(2021, 1, 4, 13, 0, 0, 0, shanghai), time.Date(2021, 1, 4, 14, 0, 0, 0, shanghai), true},
+		{"channel not matched", v1.ReceiverChannelEmail, time.Date(2021, 1, 4, 13, 0, 0, 0, shanghai), time.Time{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := quietUntil(preferences, tt.channel, tt.now)
+			if ok != tt.wantOk || !got.Equal(tt.want) {
+				t.Errorf("quietUntil() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestRateLimitedUntil(t *testing.T) {
+	now := time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC)
+	delivery := func(channel v1.ReceiverChannel, ago time.Duration) v1.ReceiverDelivery {
+		return v1.ReceiverDelivery{Channel: channel, Time: metav1.NewTime(now.Add(-ago))}
+	}
+	deliveries := []v1.ReceiverDelivery{
+		delivery(v1.ReceiverChannelEmail, 50*time.Minute),
+		delivery(v1.ReceiverChannelEmail, 20*time.Minute),
+		delivery(v1.ReceiverChannelMobile, 10*time.Minute),
+		delivery(v1.ReceiverChannelEmail, 2*time.Hour),
+	}
+	tests := []struct {
+		name       string
+		rateLimits []v1.ReceiverRateLimit
+		channel    v1.ReceiverChannel
+		want       time.Time
+		wantOk     bool
+	}{
+		{"under limit", []v1.ReceiverRateLimit{{Channel: v1.ReceiverChannelEmail, Limit: 3, PeriodMinutes: 60}}, v1.ReceiverChannelEmail, time.Time{}, false},
+		{"channel limit", []v1.ReceiverRateLimit{{Channel: v1.ReceiverChannelEmail, Limit: 1, PeriodMinutes: 60}}, v1.ReceiverChannelEmail, now.Add(40 * time.Minute), true},
+		{"other channel", []v1.ReceiverRateLimit{{Channel: v1.ReceiverChannelEmail, Limit: 1, PeriodMinutes: 60}}, v1.ReceiverChannelMobile, time.Time{}, false},
+		{"receiver limit", []v1.ReceiverRateLimit{{Limit: 2, PeriodMinutes: 60}}, v1.ReceiverChannelMobile, now.Add(40 * time.Minute), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := rateLimitedUntil(tt.rateLimits, deliveries, tt.channel, now)
+			if ok != tt.wantOk || !got.Equal(tt.want) {
+				t.Errorf("rateLimitedUntil() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestAppendDeliveries(t *testing.T) {
+	now := time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC)
+	rateLimits := []v1.ReceiverRateLimit{{Channel: v1.ReceiverChannelEmail, Limit: 2, PeriodMinutes: 60}}
+	deliveries := []v1.ReceiverDelivery{
+		{Channel: v1.ReceiverChannelEmail, Time: metav1.NewTime(now.Add(-2 * time.Hour))},
+		{Channel: v1.ReceiverChannelEmail, Time: metav1.NewTime(now.Add(-30 * time.Minute))},
+		{Channel: v1.ReceiverChannelMobile, Time: metav1.NewTime(now.Add(-10 * time.Minute))},
+	}
+
+	got := appendDeliveries(rateLimits, deliveries, []v1.ReceiverChannel{v1.ReceiverChannelEmail, v1.ReceiverChannelMobile}, now)
+	want := []v1.ReceiverDelivery{
+		{Channel: v1.ReceiverChannelEmail, Time: metav1.NewTime(now.Add(-30 * time.Minute))},
+		{Channel: v1.ReceiverChannelEmail, Time: metav1.NewTime(now)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("appendDeliveries() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Channel != want[i].Channel || !got[i].Time.Equal(&want[i].Time) {
+			t.Errorf("appendDeliveries()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestRecordDeferrals(t *testing.T) {
+	now := time.Date(2021, 1, 4, 9, 0, 0, 0, time.UTC)
+	first := metav1.NewTime(now.Add(-time.Hour))
+	messageRequest := &v1.MessageRequest{
+		Status: v1.MessageRequestStatus{
+			Deferrals: map[string]v1.MessageRequestDeferralStatus{
+				"alice/email":  {DeferredTime: first, DeliverAfter: metav1.NewTime(now)},
+				"bob/mobile":   {DeferredTime: first, DeliverAfter: metav1.NewTime(now.Add(time.Hour))},
+				"carol/wechat": {DeferredTime: first, DeliverAfter: metav1.NewTime(now.Add(-time.Minute))},
+			},
+		},
+	}
+	recordDeferrals(messageRequest, map[string]v1.MessageRequestDeferralStatus{
+		"alice/email": {DeferredTime: metav1.NewTime(now), DeliverAfter: metav1.NewTime(now.Add(time.Minute))},
+	}, now)
+
+	deferrals := messageRequest.Status.Deferrals
+	if len(deferrals) != 2 {
+		t.Fatalf("recordDeferrals() left %v, want alice/email and bob/mobile", deferrals)
+	}
+	if got := deferrals["alice/email"]; !got.DeferredTime.Equal(&first) || !got.DeliverAfter.Time.Equal(now.Add(time.Minute)) {
+		t.Errorf("recordDeferrals() re-deferred alice/email = %+v, want the first deferred time kept", got)
+	}
+	if _, ok := deferrals["bob/mobile"]; !ok {
+		t.Errorf("recordDeferrals() dropped the pending deferral bob/mobile")
+	}
+	if next, ok := nextDeliveryTime(messageRequest); !ok || !next.Equal(now.Add(time.Minute)) {
+		t.Errorf("nextDeliveryTime() = %v, %v, want %v", next, ok, now.Add(time.Minute))
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type textObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type block struct {
+	Type string      `json:"type"`
+	Text *textObject `json:"text,omitempty"`
+}
+
+// messageBody represents the body info to request a slack incoming webhook
+type messageBody struct {
+	Text   string  `json:"text"`
+	Blocks []block `json:"blocks"`
+}
+
+// Send notification to slack by incoming webhook
+func Send(channel *v1.ChannelSlack, template *v1.TemplateMarkdown, receivers []*v1.Receiver, variables map[string]string, status string) (header, body string, err error) {
+	header, err = util.ParseTemplate("slackHeader", template.Title, variables)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = util.ParseTemplate("slackBody", template.Body, variables)
+	if err != nil {
+		return header, "", err
+	}
+
+	var mentions []string
+	for _, memberID := range util.GetIdentities(receivers, v1.ReceiverChannelSlack) {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", memberID))
+	}
+	text := util.GetAlertStatus(status) + "\n" + body
+	if len(mentions) > 0 {
+		text += "\n" + strings.Join(mentions, " ")
+	}
+
+	reqBody := messageBody{
+		Text: text,
+	}
+	if header != "" {
+		reqBody.Blocks = append(reqBody.Blocks, block{
+			Type: "header",
+			Text: &textObject{Type: "plain_text", Text: header},
+		})
+	}
+	reqBody.Blocks = append(reqBody.Blocks, block{
+		Type: "section",
+		Text: &textObject{Type: "mrkdwn", Text: text},
+	})
+	log.Debugf("slack body: %v", reqBody)
+
+	_, err = util.PostJSON(channel.WebhookURL, reqBody)
+	return header, body, err
+}
@@ -31,6 +31,16 @@ import (
 
 // Send notification by smtp
 func Send(channel *v1.ChannelSMTP, template *v1.TemplateText, email string, variables map[string]string, status string) (header, body string, err error) {
+	return send(channel, template, email, variables, util.GetAlertStatus(status))
+}
+
+// SendDigest sends the digest of messages by smtp, the digest is not an alert
+// so no alert status is prepended to the body.
+func SendDigest(channel *v1.ChannelSMTP, template *v1.TemplateText, email string, variables map[string]string) (header, body string, err error) {
+	return send(channel, template, email, variables, "")
+}
+
+func send(channel *v1.ChannelSMTP, template *v1.TemplateText, email string, variables map[string]string, alertStatus string) (header, body string, err error) {
 	// 这里的header和body分别表示模版渲染后的邮件标题和邮件内容
 	header, err = util.ParseTemplate("smtpHeader", template.Header, variables)
 	if err != nil {
@@ -57,9 +67,9 @@ func Send(channel *v1.ChannelSMTP, template *v1.TemplateText, email string, vari
 		msg += fmt.Sprintf("%s: %s\r\n", k, v)
 	}
 
-	alertStatus := util.GetAlertStatus(status)
-
-	msg += "\r\n" + alertStatus // apend alert status first
+	if alertStatus != "" {
+		msg += "\r\n" + alertStatus // apend alert status first
+	}
 	msg += "\r\n" + body
 
 	log.Debug("msg", log.String("msg", msg[:]))
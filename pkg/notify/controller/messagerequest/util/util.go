@@ -28,8 +28,10 @@ import (
 
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"time"
 
+	v1 "tkestack.io/tke/api/notify/v1"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -114,3 +116,35 @@ func GetAlertStatus(status string) string {
 	alertStatus = fmt.Sprintf("告警状态： %s", alertStatus)
 	return alertStatus
 }
+
+// PostJSON is used to do a post request with json body to the given url
+func PostJSON(rawURL string, body interface{}) ([]byte, error) {
+	reqURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	path := reqURL.Path
+	if reqURL.RawQuery != "" {
+		path += "?" + reqURL.RawQuery
+	}
+	return Request(Option{
+		Protocol: reqURL.Scheme,
+		Host:     reqURL.Host,
+		Path:     path,
+		Method:   http.MethodPost,
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Body:     body,
+	})
+}
+
+// GetIdentities returns the identities of the receivers in the given channel,
+// the receivers without the identity are ignored.
+func GetIdentities(receivers []*v1.Receiver, channel v1.ReceiverChannel) []string {
+	var identities []string
+	for _, receiver := range receivers {
+		if identity, ok := receiver.Spec.Identities[channel]; ok && identity != "" {
+			identities = append(identities, identity)
+		}
+	}
+	return identities
+}
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/util"
+)
+
+// LoadCredential reads the secrets referenced by the webhook channel of the
+// tenant, nil is returned if the channel does not reference any secret. The
+// secrets must be in the namespace of the secrets of the tenant.
+func LoadCredential(ctx context.Context, kubeClient kubernetes.Interface, tenantID string, channel *v1.ChannelWebhook) (*Credential, error) {
+	if channel.SigningSecretRef == nil && channel.ClientCertificateSecretRef == nil {
+		return nil, nil
+	}
+	if kubeClient == nil {
+		return nil, fmt.Errorf("the kubernetes client is not configured to read the secrets of webhook channel")
+	}
+
+	credential := &Credential{}
+	if ref := channel.SigningSecretRef; ref != nil {
+		secret, err := getSecret(ctx, kubeClient, tenantID, ref.Namespace, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := secret.Data[ref.Key]
+		if !ok || len(key) == 0 {
+			return nil, fmt.Errorf("key %s of secret %s/%s is not found", ref.Key, secret.Namespace, secret.Name)
+		}
+		credential.SigningKey = key
+	}
+	if ref := channel.ClientCertificateSecretRef; ref != nil {
+		secret, err := getSecret(ctx, kubeClient, tenantID, ref.Namespace, ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate in secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+		credential.Certificate = &cert
+		if ca, ok := secret.Data[corev1.ServiceAccountRootCAKey]; ok && len(ca) > 0 {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("invalid ca certificate in secret %s/%s", secret.Namespace, secret.Name)
+			}
+			credential.RootCAs = pool
+		}
+	}
+	return credential, nil
+}
+
+func getSecret(ctx context.Context, kubeClient kubernetes.Interface, tenantID, namespace, name string) (*corev1.Secret, error) {
+	if namespace == "" {
+		namespace = util.SecretNamespace(tenantID)
+	} else if namespace != util.SecretNamespace(tenantID) {
+		return nil, fmt.Errorf("secret %s/%s is not in namespace %s of the tenant", namespace, name, util.SecretNamespace(tenantID))
+	}
+	secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %v", namespace, name, err)
+	}
+	return secret, nil
+}
@@ -19,52 +19,182 @@
 package webhook
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
-	"net/url"
+	"strconv"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	v1 "tkestack.io/tke/api/notify/v1"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	notifyutil "tkestack.io/tke/pkg/notify/util"
 	"tkestack.io/tke/pkg/util/log"
 )
 
+const (
+	// SignatureHeader is the header of the HMAC-SHA256 signature of request,
+	// the signature is computed over the timestamp, a dot and the body.
+	SignatureHeader = "X-TKE-Signature"
+	// TimestampHeader is the header of the unix timestamp of request.
+	TimestampHeader = "X-TKE-Timestamp"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// redacted replaces the values of the headers of the channel in the request
+// recorded in the message, since any of them may hold a credential.
+const redacted = "<redacted>"
+
 // webhookBody represents the body info to request a webhook server
 type webhookBody struct {
 	Receivers []*v1.Receiver `json:"receivers"`
 	Content   string         `json:"content"`
 }
 
+// Credential holds the secrets referenced by the webhook channel.
+type Credential struct {
+	// SigningKey is the key used to sign the request.
+	SigningKey []byte
+	// Certificate is the client certificate used for mutual TLS.
+	Certificate *tls.Certificate
+	// RootCAs is used instead of the system roots to verify the webhook
+	// server if it is not nil.
+	RootCAs *x509.CertPool
+}
+
 // Send notification to webhook server
-func Send(channel *v1.ChannelWebhook, template *v1.TemplateText, receivers []*v1.Receiver, variables map[string]string, status string) (content string, err error) {
+func Send(channel *v1.ChannelWebhook, template *v1.TemplateText, receivers []*v1.Receiver, variables map[string]string, status string, credential *Credential) (content string, request *v1.WebhookRequest, err error) {
 	content, err = util.ParseTemplate("webhookContent", template.Body, variables)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	alertStatus := util.GetAlertStatus(status)
 	contentWithAlertStatus := alertStatus + "\r\n" + content //add  alertStatus first
-	body := webhookBody{
-		Receivers: receivers,
-		Content:   contentWithAlertStatus,
+	var rawBody []byte
+	if channel.BodyTemplate == "" {
+		rawBody, err = json.Marshal(webhookBody{
+			Receivers: receivers,
+			Content:   contentWithAlertStatus,
+		})
+	} else {
+		rawBody, err = RenderBody(channel.BodyTemplate, receivers, variables, content, status)
+	}
+	if err != nil {
+		return content, nil, err
 	}
-	log.Debugf("webhook body: %v", body)
-	err = requestToWebhook(channel, body)
-	return content, err
+	log.Debugf("webhook body: %s", string(rawBody))
+
+	request, err = requestToWebhook(channel, rawBody, credential)
+	return content, request, err
+}
+
+// RenderBody renders the request body by the go template of webhook channel.
+func RenderBody(bodyTemplate string, receivers []*v1.Receiver, variables map[string]string, content, status string) ([]byte, error) {
+	tmpl, err := notifyutil.ParseWebhookBodyTemplate(bodyTemplate)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{}, len(variables)+3)
+	for k, v := range variables {
+		data[k] = v
+	}
+	data["content"] = content
+	data["alertStatus"] = status
+	data["receivers"] = receivers
+
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the request body.
+func Sign(key []byte, timestamp string, body []byte) string {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
 }
 
-// requestToWebhook is used to do a post request to webhook server
-func requestToWebhook(channel *v1.ChannelWebhook, reqBody interface{}) error {
-	reqURL, err := url.Parse(channel.URL)
+// requestToWebhook is used to do a request to webhook server
+func requestToWebhook(channel *v1.ChannelWebhook, rawBody []byte, credential *Credential) (*v1.WebhookRequest, error) {
+	method := channel.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequest(method, channel.URL, bytes.NewReader(rawBody))
 	if err != nil {
-		return err
-	}
-	option := util.Option{
-		Protocol: reqURL.Scheme,
-		Host:     reqURL.Host,
-		Path:     reqURL.Path,
-		Method:   http.MethodPost,
-		Body:     reqBody,
-		Headers:  map[string]string{"Content-Type": "application/json"},
-	}
-	_, err = util.Request(option)
-	return err
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	channelHeaders := sets.NewString()
+	for k, v := range channel.Headers {
+		req.Header.Set(k, v)
+		channelHeaders.Insert(http.CanonicalHeaderKey(k))
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: channel.InsecureSkipVerify,
+	}
+	if credential != nil {
+		if len(credential.SigningKey) > 0 {
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			req.Header.Set(TimestampHeader, timestamp)
+			req.Header.Set(SignatureHeader, "sha256="+Sign(credential.SigningKey, timestamp, rawBody))
+		}
+		if credential.Certificate != nil {
+			tlsConfig.Certificates = []tls.Certificate{*credential.Certificate}
+		}
+		if credential.RootCAs != nil {
+			tlsConfig.RootCAs = credential.RootCAs
+		}
+	}
+
+	request := &v1.WebhookRequest{
+		Method:  method,
+		URL:     channel.URL,
+		Headers: make(map[string]string, len(req.Header)),
+		Body:    string(rawBody),
+	}
+	for k := range req.Header {
+		if channelHeaders.Has(k) {
+			request.Headers[k] = redacted
+		} else {
+			request.Headers[k] = req.Header.Get(k)
+		}
+	}
+
+	timeout := defaultTimeout
+	if channel.TimeoutSeconds > 0 {
+		timeout = time.Duration(channel.TimeoutSeconds) * time.Second
+	}
+	c := http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return request, err
+	}
+	defer resp.Body.Close()
+	_, _ = ioutil.ReadAll(resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return request, fmt.Errorf("http %s error : url=%v , statusCode=%v", method, channel.URL, resp.StatusCode)
+	}
+	return request, nil
 }
@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package webhook
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestSendTemplatedAndSigned(t *testing.T) {
+	var (
+		method string
+		header http.Header
+		body   []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelWebhook{
+		URL:          server.URL,
+		Method:       http.MethodPut,
+		Headers:      map[string]string{"Authorization": "Bearer token", "x-api-key": "key"},
+		BodyTemplate: `{"text": {{json .content}}, "cluster": {{json .clusterID}}, "status": "{{.alertStatus}}", "to": "{{(index .receivers 0).Name}}"}`,
+	}
+	template := &v1.TemplateText{Body: `cluster "{{.clusterID}}" is down`}
+	receivers := []*v1.Receiver{{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}}
+	variables := map[string]string{"clusterID": "cls-1"}
+	credential := &Credential{SigningKey: []byte("secret")}
+
+	content, request, err := Send(channel, template, receivers, variables, "firing", credential)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut {
+		t.Errorf("unexpected method %s", method)
+	}
+	var payload map[string]string
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatalf("rendered body is not json: %s", string(body))
+	}
+	if payload["text"] != content || payload["cluster"] != "cls-1" || payload["status"] != "firing" || payload["to"] != "alice" {
+		t.Errorf("unexpected rendered body %v", payload)
+	}
+	timestamp := header.Get(TimestampHeader)
+	if expect := "sha256=" + Sign(credential.SigningKey, timestamp, body); header.Get(SignatureHeader) != expect {
+		t.Errorf("unexpected signature %q, want %q", header.Get(SignatureHeader), expect)
+	}
+	if request.Body != string(body) || request.Method != http.MethodPut {
+		t.Errorf("sent request is not recorded: %+v", request)
+	}
+	if header.Get("X-Api-Key") != "key" {
+		t.Errorf("unexpected api key header %q", header.Get("X-Api-Key"))
+	}
+	for _, k := range []string{"Authorization", "X-Api-Key"} {
+		if request.Headers[k] != redacted {
+			t.Errorf("header %s of channel should be redacted, got %q", k, request.Headers[k])
+		}
+	}
+	if request.Headers["Content-Type"] != "application/json" || request.Headers[http.CanonicalHeaderKey(TimestampHeader)] != timestamp {
+		t.Errorf("headers not set by the channel should be recorded, got %v", request.Headers)
+	}
+}
+
+func TestSendDefaultBody(t *testing.T) {
+	var body webhookBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if r.Header.Get(SignatureHeader) != "" {
+			t.Errorf("request should not be signed without signing key")
+		}
+	}))
+	defer server.Close()
+
+	receivers := []*v1.Receiver{{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}}
+	if _, _, err := Send(&v1.ChannelWebhook{URL: server.URL}, &v1.TemplateText{Body: "hello"}, receivers, map[string]string{}, "resolved", nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Receivers) != 1 || body.Content == "" {
+		t.Errorf("unexpected default body %+v", body)
+	}
+}
+
+func TestSendVerifiesServerCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+
+	tests := []struct {
+		name       string
+		insecure   bool
+		credential *Credential
+		wantErr    bool
+	}{
+		{"system roots", false, nil, true},
+		{"ca of credential", false, &Credential{RootCAs: pool}, false},
+		{"insecure skip verify", true, nil, false},
+	}
+	receivers := []*v1.Receiver{{ObjectMeta: metav1.ObjectMeta{Name: "alice"}}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel := &v1.ChannelWebhook{URL: server.URL, InsecureSkipVerify: tt.insecure}
+			_, _, err := Send(channel, &v1.TemplateText{Body: "hello"}, receivers, map[string]string{}, "firing", tt.credential)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
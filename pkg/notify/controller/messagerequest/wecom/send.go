@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package wecom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type markdown struct {
+	Content string `json:"content"`
+}
+
+// messageBody represents the body info to request a wecom group robot
+type messageBody struct {
+	MsgType  string   `json:"msgtype"`
+	Markdown markdown `json:"markdown"`
+}
+
+type resMessageBody struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// Send notification to wecom by group robot
+func Send(channel *v1.ChannelWeCom, template *v1.TemplateMarkdown, receivers []*v1.Receiver, variables map[string]string, status string) (header, body string, err error) {
+	header, err = util.ParseTemplate("wecomHeader", template.Title, variables)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = util.ParseTemplate("wecomBody", template.Body, variables)
+	if err != nil {
+		return header, "", err
+	}
+
+	content := util.GetAlertStatus(status) + "\n" + body
+	if header != "" {
+		content = "# " + header + "\n" + content
+	}
+	var mentions []string
+	for _, userID := range util.GetIdentities(receivers, v1.ReceiverChannelWeCom) {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", userID))
+	}
+	if len(mentions) > 0 {
+		content += "\n" + strings.Join(mentions, " ")
+	}
+	reqBody := messageBody{
+		MsgType:  "markdown",
+		Markdown: markdown{Content: content},
+	}
+	log.Debugf("wecom body: %v", reqBody)
+
+	response, err := util.PostJSON(channel.WebhookURL, reqBody)
+	if err != nil {
+		return header, body, err
+	}
+	var resMessage resMessageBody
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return header, body, err
+	}
+	if resMessage.ErrCode != 0 {
+		return header, body, fmt.Errorf("post wecom robot error: errcode=%v, errmsg=%v", resMessage.ErrCode, resMessage.ErrMsg)
+	}
+	return header, body, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package auditrule
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for auditRule.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	notifyClient   *notifyinternalclient.NotifyClient
+	platformClient platformversionedclient.PlatformV1Interface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating auditRule objects.
+func NewStrategy(notifyClient *notifyinternalclient.NotifyClient, platformClient platformversionedclient.PlatformV1Interface) *Strategy {
+	return &Strategy{notify.Scheme, namesutil.Generator, notifyClient, platformClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (s *Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldAuditRule := old.(*notify.AuditRule)
+	auditRule, _ := obj.(*notify.AuditRule)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldAuditRule.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update auditRule information", log.String("oldTenantID", oldAuditRule.Spec.TenantID), log.String("newTenantID", auditRule.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		auditRule.Spec.TenantID = tenantID
+	}
+
+	if auditRule.Name == "" && auditRule.GenerateName == "" {
+		auditRule.GenerateName = "auditrule-"
+	}
+}
+
+// NamespaceScoped is false for auditRules.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	auditRule, _ := obj.(*notify.AuditRule)
+	if len(tenantID) != 0 {
+		auditRule.Spec.TenantID = tenantID
+	}
+
+	if auditRule.Name == "" && auditRule.GenerateName == "" {
+		auditRule.GenerateName = "auditrule-"
+	}
+}
+
+// Validate validates a new auditRule.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateAuditRule(ctx, obj.(*notify.AuditRule), s.notifyClient, s.platformClient)
+}
+
+// AllowCreateOnUpdate is false for auditRules.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end auditRule.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAuditRuleUpdate(ctx, obj.(*notify.AuditRule), old.(*notify.AuditRule), s.notifyClient, s.platformClient)
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	auditRule, ok := obj.(*notify.AuditRule)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a auditRule")
+	}
+	return labels.Set(auditRule.ObjectMeta.Labels), ToSelectableFields(auditRule), nil
+}
+
+// MatchAuditRule returns a generic matcher for a given label and field selector.
+func MatchAuditRule(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(auditRule *notify.AuditRule) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&auditRule.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": auditRule.Spec.TenantID,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
@@ -0,0 +1,163 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package auditrule
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateAuditRuleName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateAuditRuleName = apimachineryvalidation.NameIsDNSLabel
+
+// AuditVariables are the template variables of the message requests created
+// by the audit server for the events matched by an audit rule.
+var AuditVariables = sets.NewString(
+	"ruleName",
+	"ruleDisplayName",
+	"auditID",
+	"stage",
+	"requestURI",
+	"verb",
+	"userName",
+	"userAgent",
+	"resource",
+	"namespace",
+	"name",
+	"apiGroup",
+	"apiVersion",
+	"sourceIPs",
+	"status",
+	"message",
+	"reason",
+	"code",
+	"requestReceivedTime",
+	"clusterName",
+)
+
+// ValidateAuditRule tests if required fields in the auditRule are set.
+func ValidateAuditRule(ctx context.Context, auditRule *notify.AuditRule, notifyClient *notifyinternalclient.NotifyClient, platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&auditRule.ObjectMeta, false, ValidateAuditRuleName, field.NewPath("metadata"))
+
+	if auditRule.Spec.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
+	}
+
+	matchPath := field.NewPath("spec", "match")
+	for i, code := range auditRule.Spec.Match.Codes {
+		if code < 100 || code > 599 {
+			allErrs = append(allErrs, field.Invalid(matchPath.Child("codes").Index(i), code, "must be a http status code"))
+		}
+	}
+	for i, name := range auditRule.Spec.Match.Clusters {
+		fldPath := matchPath.Child("clusters").Index(i)
+		cluster, err := platformClient.Clusters().Get(ctx, name, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath, name))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if cluster.Spec.TenantID != auditRule.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to audit cluster %s", name)))
+		}
+	}
+
+	if len(auditRule.Spec.Receivers) == 0 && len(auditRule.Spec.ReceiverGroups) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "receivers"), "must specify a receiver or receiver group"))
+	}
+	for i, name := range auditRule.Spec.Receivers {
+		fldPath := field.NewPath("spec", "receivers").Index(i)
+		receiver, err := notifyClient.Receivers().Get(ctx, name, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath, name))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if receiver.Spec.TenantID != auditRule.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to notify receiver %s", name)))
+		}
+	}
+	for i, name := range auditRule.Spec.ReceiverGroups {
+		fldPath := field.NewPath("spec", "receiverGroups").Index(i)
+		receiverGroup, err := notifyClient.ReceiverGroups().Get(ctx, name, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath, name))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if receiverGroup.Spec.TenantID != auditRule.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to notify receiver group %s", name)))
+		}
+	}
+
+	channelPath := field.NewPath("spec", "channelName")
+	if auditRule.Spec.ChannelName == "" {
+		allErrs = append(allErrs, field.Required(channelPath, "must specify channel name"))
+		return allErrs
+	}
+	channel, err := notifyClient.Channels().Get(ctx, auditRule.Spec.ChannelName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(channelPath, auditRule.Spec.ChannelName))
+		return allErrs
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(channelPath, err))
+		return allErrs
+	} else if channel.Spec.TenantID != auditRule.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(channelPath, "no authorized to create message request in this channel"))
+		return allErrs
+	}
+
+	templatePath := field.NewPath("spec", "templateName")
+	if auditRule.Spec.TemplateName == "" {
+		allErrs = append(allErrs, field.Required(templatePath, "must specify template name"))
+		return allErrs
+	}
+	template, err := notifyClient.Templates(channel.ObjectMeta.Name).Get(ctx, auditRule.Spec.TemplateName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(templatePath, auditRule.Spec.TemplateName))
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(templatePath, err))
+	} else {
+		for _, key := range template.Spec.Keys {
+			if !AuditVariables.Has(key) {
+				allErrs = append(allErrs, field.Invalid(templatePath, auditRule.Spec.TemplateName, fmt.Sprintf("template key %s is not one of the audit variables %v", key, AuditVariables.List())))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAuditRuleUpdate tests if required fields in the auditRule are set during
+// an update.
+func ValidateAuditRuleUpdate(ctx context.Context, auditRule *notify.AuditRule, old *notify.AuditRule, notifyClient *notifyinternalclient.NotifyClient, platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&auditRule.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateAuditRule(ctx, auditRule, notifyClient, platformClient)...)
+
+	if auditRule.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
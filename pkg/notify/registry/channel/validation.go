@@ -19,9 +19,15 @@
 package channel
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
+
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/util"
 )
 
 // ValidateChannelName is a ValidateNameFunc for names that must be a DNS
@@ -79,20 +85,102 @@ func ValidateChannel(channel *notify.Channel) field.ErrorList {
 
 	if channel.Spec.Webhook != nil {
 		channelCount++
-		if channel.Spec.Webhook.URL == "" {
-			allErrs = append(allErrs, field.Required(field.NewPath("spec", "webhook", "url"), "must specify url of webhook server"))
-		}
+		allErrs = append(allErrs, validateWebhook(channel.Spec.Webhook, channel.Spec.TenantID, field.NewPath("spec", "webhook"))...)
+	}
+
+	if channel.Spec.Slack != nil {
+		channelCount++
+		allErrs = append(allErrs, validateWebhookURL(channel.Spec.Slack.WebhookURL, field.NewPath("spec", "slack", "webhookURL"))...)
+	}
+
+	if channel.Spec.DingTalk != nil {
+		channelCount++
+		allErrs = append(allErrs, validateWebhookURL(channel.Spec.DingTalk.WebhookURL, field.NewPath("spec", "dingTalk", "webhookURL"))...)
+	}
+
+	if channel.Spec.WeCom != nil {
+		channelCount++
+		allErrs = append(allErrs, validateWebhookURL(channel.Spec.WeCom.WebhookURL, field.NewPath("spec", "weCom", "webhookURL"))...)
+	}
+
+	if channel.Spec.Lark != nil {
+		channelCount++
+		allErrs = append(allErrs, validateWebhookURL(channel.Spec.Lark.WebhookURL, field.NewPath("spec", "lark", "webhookURL"))...)
 	}
 
 	if channelCount == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec"), "must specify one of channel type: `tencentCloudSMS`, `wechat`, `webhook` or `smtp`"))
+		allErrs = append(allErrs, field.Required(field.NewPath("spec"), "must specify one of channel type: `tencentCloudSMS`, `wechat`, `webhook`, `smtp`, `slack`, `dingTalk`, `weCom` or `lark`"))
 	} else if channelCount > 1 {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "may not specify more than 1 channel type: `tencentCloudSMS`, `wechat`, `webhook` or `smtp`"))
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "may not specify more than 1 channel type: `tencentCloudSMS`, `wechat`, `webhook`, `smtp`, `slack`, `dingTalk`, `weCom` or `lark`"))
 	}
 
 	return allErrs
 }
 
+var supportedWebhookMethods = sets.NewString(http.MethodPost, http.MethodPut, http.MethodPatch)
+
+// validateWebhook tests if required fields in the webhook channel are set.
+func validateWebhook(webhook *notify.ChannelWebhook, tenantID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if webhook.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must specify url of webhook server"))
+	}
+	if webhook.Method != "" && !supportedWebhookMethods.Has(webhook.Method) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("method"), webhook.Method, supportedWebhookMethods.List()))
+	}
+	if webhook.BodyTemplate != "" {
+		if _, err := util.ParseWebhookBodyTemplate(webhook.BodyTemplate); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bodyTemplate"), webhook.BodyTemplate, err.Error()))
+		}
+	}
+	if ref := webhook.SigningSecretRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("signingSecretRef", "name"), "must specify name of secret"))
+		}
+		if ref.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("signingSecretRef", "key"), "must specify key of secret"))
+		}
+		allErrs = append(allErrs, validateSecretNamespace(ref.Namespace, tenantID, fldPath.Child("signingSecretRef", "namespace"))...)
+	}
+	if ref := webhook.ClientCertificateSecretRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("clientCertificateSecretRef", "name"), "must specify name of secret"))
+		}
+		allErrs = append(allErrs, validateSecretNamespace(ref.Namespace, tenantID, fldPath.Child("clientCertificateSecretRef", "namespace"))...)
+	}
+	if webhook.TimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), webhook.TimeoutSeconds, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+// validateSecretNamespace tests if the referenced secret is in the namespace
+// of the secrets of the tenant.
+func validateSecretNamespace(namespace, tenantID string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if namespace != "" && namespace != util.SecretNamespace(tenantID) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("must reference a secret in namespace %s", util.SecretNamespace(tenantID))))
+	}
+	return allErrs
+}
+
+// validateWebhookURL tests if the webhook url of chat tool robot is a valid
+// http or https url.
+func validateWebhookURL(webhookURL string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if webhookURL == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify webhook url of robot"))
+		return allErrs
+	}
+	u, err := url.Parse(webhookURL)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, webhookURL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, webhookURL, "must be an absolute http or https url"))
+	}
+	return allErrs
+}
+
 // ValidateChannelUpdate tests if required fields in the channel are set during
 // an update.
 func ValidateChannelUpdate(channel *notify.Channel, old *notify.Channel) field.ErrorList {
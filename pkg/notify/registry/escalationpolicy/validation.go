@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package escalationpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateEscalationPolicyName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateEscalationPolicyName = apimachineryvalidation.NameIsDNSLabel
+
+var supportedTargetKinds = sets.NewString(
+	string(notify.EscalationTargetReceiver),
+	string(notify.EscalationTargetReceiverGroup),
+	string(notify.EscalationTargetOnCallSchedule),
+)
+
+// ValidateEscalationPolicy tests if required fields in the escalationPolicy are set.
+func ValidateEscalationPolicy(ctx context.Context, escalationPolicy *notify.EscalationPolicy, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&escalationPolicy.ObjectMeta, false, ValidateEscalationPolicyName, field.NewPath("metadata"))
+
+	if escalationPolicy.Spec.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
+	}
+
+	fldPath := field.NewPath("spec", "rules")
+	if len(escalationPolicy.Spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify a rule"))
+	}
+	for i, rule := range escalationPolicy.Spec.Rules {
+		rulePath := fldPath.Index(i)
+		if rule.DelayMinutes < 0 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("delayMinutes"), rule.DelayMinutes, "must be greater than or equal to 0"))
+		} else if rule.DelayMinutes == 0 && i < len(escalationPolicy.Spec.Rules)-1 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("delayMinutes"), "must specify the delay before escalating to the next rule"))
+		}
+		if len(rule.Targets) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("targets"), "must specify a target"))
+		}
+		for j, target := range rule.Targets {
+			targetPath := rulePath.Child("targets").Index(j)
+			if !supportedTargetKinds.Has(string(target.Kind)) {
+				allErrs = append(allErrs, field.NotSupported(targetPath.Child("kind"), target.Kind, supportedTargetKinds.List()))
+				continue
+			}
+			if target.Name == "" {
+				allErrs = append(allErrs, field.Required(targetPath.Child("name"), "must specify target name"))
+				continue
+			}
+			allErrs = append(allErrs, validateTarget(ctx, targetPath.Child("name"), target, escalationPolicy.Spec.TenantID, notifyClient)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateTarget checks that the target exists and belongs to the tenant.
+func validateTarget(ctx context.Context, fldPath *field.Path, target notify.EscalationTarget, tenantID string, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := field.ErrorList{}
+	var (
+		targetTenantID string
+		err            error
+	)
+	switch target.Kind {
+	case notify.EscalationTargetReceiver:
+		var receiver *notify.Receiver
+		if receiver, err = notifyClient.Receivers().Get(ctx, target.Name, metav1.GetOptions{}); err == nil {
+			targetTenantID = receiver.Spec.TenantID
+		}
+	case notify.EscalationTargetReceiverGroup:
+		var receiverGroup *notify.ReceiverGroup
+		if receiverGroup, err = notifyClient.ReceiverGroups().Get(ctx, target.Name, metav1.GetOptions{}); err == nil {
+			targetTenantID = receiverGroup.Spec.TenantID
+		}
+	case notify.EscalationTargetOnCallSchedule:
+		var onCallSchedule *notify.OnCallSchedule
+		if onCallSchedule, err = notifyClient.OnCallSchedules().Get(ctx, target.Name, metav1.GetOptions{}); err == nil {
+			targetTenantID = onCallSchedule.Spec.TenantID
+		}
+	}
+	if err != nil && errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(fldPath, target.Name))
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+	} else if targetTenantID != tenantID {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to escalate to %s %s", target.Kind, target.Name)))
+	}
+	return allErrs
+}
+
+// ValidateEscalationPolicyUpdate tests if required fields in the escalationPolicy are set during
+// an update.
+func ValidateEscalationPolicyUpdate(ctx context.Context, escalationPolicy *notify.EscalationPolicy, old *notify.EscalationPolicy, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&escalationPolicy.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateEscalationPolicy(ctx, escalationPolicy, notifyClient)...)
+
+	if escalationPolicy.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package storage
+
+import (
+	"context"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+)
+
+// AcknowledgeREST implements the REST endpoint for acknowledging a message,
+// which stops the escalation of the message request that sent it.
+type AcknowledgeREST struct {
+	store *registry.Store
+}
+
+var _ = rest.Connecter(&AcknowledgeREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *AcknowledgeREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *AcknowledgeREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *AcknowledgeREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler for the message acknowledgement
+func (r *AcknowledgeREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	return &acknowledgeHandler{
+		store:     r.store,
+		name:      name,
+		responder: responder,
+	}, nil
+}
+
+type acknowledgeHandler struct {
+	store     *registry.Store
+	name      string
+	responder rest.Responder
+}
+
+func (h *acknowledgeHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	username, _ := authentication.UsernameAndTenantID(req.Context())
+	objInfo := rest.DefaultUpdatedObjectInfo(nil, func(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+		message := oldObj.(*notify.Message).DeepCopy()
+		acknowledge(message, username)
+		return message, nil
+	})
+	obj, _, err := h.store.Update(req.Context(), h.name, objInfo, rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		h.responder.Error(err)
+		return
+	}
+	h.responder.Object(http.StatusOK, obj)
+}
+
+// acknowledge marks the message as acknowledged by the user, acknowledging
+// a message again keeps the first acknowledgement.
+func acknowledge(message *notify.Message, username string) {
+	if message.Status.Phase == notify.MessageAcknowledged {
+		return
+	}
+	now := metav1.Now()
+	message.Status.Phase = notify.MessageAcknowledged
+	message.Status.LastTransitionTime = now
+	message.Status.AcknowledgedTime = now
+	message.Status.AcknowledgedBy = username
+}
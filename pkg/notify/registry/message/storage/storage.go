@@ -39,8 +39,9 @@ import (
 
 // Storage includes storage for messages and all sub resources.
 type Storage struct {
-	Message *REST
-	Status  *StatusREST
+	Message     *REST
+	Status      *StatusREST
+	Acknowledge *AcknowledgeREST
 }
 
 // NewStorage returns a Storage object that will work against messages.
@@ -74,8 +75,9 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 	statusStore.UpdateStrategy = messagestrategy.NewStatusStrategy(strategy)
 
 	return &Storage{
-		Message: &REST{store, privilegedUsername},
-		Status:  &StatusREST{&statusStore},
+		Message:     &REST{store, privilegedUsername},
+		Status:      &StatusREST{&statusStore},
+		Acknowledge: &AcknowledgeREST{store},
 	}
 }
 
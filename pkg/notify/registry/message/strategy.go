@@ -157,6 +157,8 @@ func MatchMessage(label labels.Selector, field fields.Selector) storage.Selectio
 			"spec.alarmPolicyType",
 			"spec.receiverChannelName",
 			"spec.clusterID",
+			"spec.messageRequestName",
+			"status.deliveryState",
 			"status.alertStatus",
 		},
 	}
@@ -175,6 +177,8 @@ func ToSelectableFields(message *notify.Message) fields.Set {
 		"spec.alarmPolicyType":     message.Spec.AlarmPolicyType,
 		"spec.receiverChannelName": message.Spec.ReceiverChannelName,
 		"spec.clusterID":           message.Spec.ClusterID,
+		"spec.messageRequestName":  message.Spec.MessageRequestName,
+		"status.deliveryState":     string(message.Status.DeliveryState),
 		"status.alertStatus":       message.Status.AlertStatus,
 	}
 	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
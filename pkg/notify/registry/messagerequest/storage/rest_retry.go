@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+)
+
+// RetryREST implements the REST endpoint for manually retrying a failed or
+// dead-lettered message request.
+type RetryREST struct {
+	store *registry.Store
+}
+
+var _ = rest.Connecter(&RetryREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *RetryREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *RetryREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *RetryREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler for the message request retry
+func (r *RetryREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	return &retryHandler{
+		store:     r.store,
+		name:      name,
+		responder: responder,
+	}, nil
+}
+
+type retryHandler struct {
+	store     *registry.Store
+	name      string
+	responder rest.Responder
+}
+
+func (h *retryHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	objInfo := rest.DefaultUpdatedObjectInfo(nil, func(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+		messageRequest := oldObj.(*notify.MessageRequest).DeepCopy()
+		if err := resetRetries(messageRequest); err != nil {
+			return nil, err
+		}
+		return messageRequest, nil
+	})
+	obj, _, err := h.store.Update(req.Context(), h.name, objInfo, rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		h.responder.Error(err)
+		return
+	}
+	h.responder.Object(http.StatusOK, obj)
+}
+
+// resetRetries moves a failed or dead-lettered message request back to the
+// retrying phase, all of the failed receivers will be attempted again with a
+// fresh retry budget.
+func resetRetries(messageRequest *notify.MessageRequest) error {
+	switch messageRequest.Status.Phase {
+	case notify.MessageRequestFailed, notify.MessageRequestPartialFailure, notify.MessageRequestDeadLetter:
+	default:
+		return errors.NewConflict(notify.Resource("messagerequests"), messageRequest.ObjectMeta.Name,
+			fmt.Errorf("message request in phase %q can not be retried", messageRequest.Status.Phase))
+	}
+
+	retries := make(map[string]notify.MessageRequestRetryStatus)
+	for key, retry := range messageRequest.Status.Retries {
+		retries[key] = notify.MessageRequestRetryStatus{
+			LastAttemptTime: retry.LastAttemptTime,
+			LastError:       retry.LastError,
+		}
+	}
+	// message requests failed before retrying was supported only have errors
+	for key, reason := range messageRequest.Status.Errors {
+		if _, ok := retries[key]; !ok {
+			retries[key] = notify.MessageRequestRetryStatus{
+				LastError: reason,
+			}
+		}
+	}
+	if len(retries) == 0 {
+		retries = nil
+	}
+	messageRequest.Status.Retries = retries
+	messageRequest.Status.Phase = notify.MessageRequestRetrying
+	messageRequest.Status.LastTransitionTime = metav1.Now()
+	return nil
+}
@@ -40,6 +40,7 @@ import (
 type Storage struct {
 	MessageRequest *REST
 	Status         *StatusREST
+	Retry          *RetryREST
 }
 
 // NewStorage returns a Storage object that will work against messages.
@@ -75,6 +76,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, notifyClient *noti
 	return &Storage{
 		MessageRequest: &REST{store, privilegedUsername},
 		Status:         &StatusREST{&statusStore},
+		Retry:          &RetryREST{&statusStore},
 	}
 }
 
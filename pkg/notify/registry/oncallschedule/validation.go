@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package oncallschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/util"
+)
+
+// ValidateOnCallScheduleName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateOnCallScheduleName = apimachineryvalidation.NameIsDNSLabel
+
+var supportedRotationTypes = sets.NewString(
+	string(notify.OnCallRotationHourly),
+	string(notify.OnCallRotationDaily),
+	string(notify.OnCallRotationWeekly),
+)
+
+// ValidateOnCallSchedule tests if required fields in the onCallSchedule are set.
+func ValidateOnCallSchedule(ctx context.Context, onCallSchedule *notify.OnCallSchedule, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&onCallSchedule.ObjectMeta, false, ValidateOnCallScheduleName, field.NewPath("metadata"))
+
+	if onCallSchedule.Spec.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
+	}
+
+	if onCallSchedule.Spec.TimeZone != "" {
+		if _, err := time.LoadLocation(onCallSchedule.Spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "timeZone"), onCallSchedule.Spec.TimeZone, err.Error()))
+		}
+	}
+
+	fldPath := field.NewPath("spec", "layers")
+	if len(onCallSchedule.Spec.Layers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify a layer"))
+	}
+	for i, layer := range onCallSchedule.Spec.Layers {
+		layerPath := fldPath.Index(i)
+		if layer.Name == "" {
+			allErrs = append(allErrs, field.Required(layerPath.Child("name"), "must specify layer name"))
+		}
+		if len(layer.Receivers) == 0 {
+			allErrs = append(allErrs, field.Required(layerPath.Child("receivers"), "must specify a receiver"))
+		}
+		for _, receiverName := range layer.Receivers {
+			allErrs = append(allErrs, validateReceiver(ctx, layerPath.Child("receivers").Key(receiverName), receiverName, onCallSchedule.Spec.TenantID, notifyClient)...)
+		}
+		if layer.Start.IsZero() {
+			allErrs = append(allErrs, field.Required(layerPath.Child("start"), "must specify start time"))
+		}
+		if !supportedRotationTypes.Has(string(layer.RotationType)) {
+			allErrs = append(allErrs, field.NotSupported(layerPath.Child("rotationType"), layer.RotationType, supportedRotationTypes.List()))
+		}
+		if layer.ShiftLength < 0 {
+			allErrs = append(allErrs, field.Invalid(layerPath.Child("shiftLength"), layer.ShiftLength, "must be greater than or equal to 0"))
+		}
+		for j, restriction := range layer.Restrictions {
+			if _, err := util.ParseClockTime(restriction.StartTime); err != nil {
+				allErrs = append(allErrs, field.Invalid(layerPath.Child("restrictions").Index(j).Child("startTime"), restriction.StartTime, err.Error()))
+			}
+			if _, err := util.ParseClockTime(restriction.EndTime); err != nil {
+				allErrs = append(allErrs, field.Invalid(layerPath.Child("restrictions").Index(j).Child("endTime"), restriction.EndTime, err.Error()))
+			}
+		}
+	}
+
+	for i, override := range onCallSchedule.Spec.Overrides {
+		overridePath := field.NewPath("spec", "overrides").Index(i)
+		if override.Receiver == "" {
+			allErrs = append(allErrs, field.Required(overridePath.Child("receiver"), "must specify a receiver"))
+		} else {
+			allErrs = append(allErrs, validateReceiver(ctx, overridePath.Child("receiver"), override.Receiver, onCallSchedule.Spec.TenantID, notifyClient)...)
+		}
+		if !override.EndsAt.After(override.StartsAt.Time) {
+			allErrs = append(allErrs, field.Invalid(overridePath.Child("endsAt"), override.EndsAt, "must be after the start time"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateReceiver(ctx context.Context, fldPath *field.Path, receiverName, tenantID string, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := field.ErrorList{}
+	receiver, err := notifyClient.Receivers().Get(ctx, receiverName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(fldPath, receiverName))
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+	} else if receiver.Spec.TenantID != tenantID {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to add receiver %s to this schedule", receiverName)))
+	}
+	return allErrs
+}
+
+// ValidateOnCallScheduleUpdate tests if required fields in the onCallSchedule are set during
+// an update.
+func ValidateOnCallScheduleUpdate(ctx context.Context, onCallSchedule *notify.OnCallSchedule, old *notify.OnCallSchedule, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&onCallSchedule.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateOnCallSchedule(ctx, onCallSchedule, notifyClient)...)
+
+	if onCallSchedule.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
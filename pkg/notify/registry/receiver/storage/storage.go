@@ -27,6 +27,7 @@ import (
 	genericregistry "k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
 	"tkestack.io/tke/api/notify"
 	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
 	receiverstrategy "tkestack.io/tke/pkg/notify/registry/receiver"
@@ -37,11 +38,12 @@ import (
 // Storage includes storage for receivers and all sub resources.
 type Storage struct {
 	Receiver *REST
+	Status   *StatusREST
 }
 
 // NewStorage returns a Storage object that will work against receivers.
-func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername string) *Storage {
-	strategy := receiverstrategy.NewStrategy()
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, notifyClient *notifyinternalclient.NotifyClient, privilegedUsername string) *Storage {
+	strategy := receiverstrategy.NewStrategy(notifyClient)
 	store := &registry.Store{
 		NewFunc:                  func() runtime.Object { return &notify.Receiver{} },
 		NewListFunc:              func() runtime.Object { return &notify.ReceiverList{} },
@@ -63,8 +65,12 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 		log.Panic("Failed to create receiver etcd rest storage", log.Err(err))
 	}
 
+	statusStore := *store
+	statusStore.UpdateStrategy = receiverstrategy.NewStatusStrategy(strategy)
+
 	return &Storage{
 		Receiver: &REST{store, privilegedUsername},
+		Status:   &StatusREST{&statusStore},
 	}
 }
 
@@ -131,3 +137,29 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 	}
 	return r.Store.Delete(ctx, name, deleteValidation, options)
 }
+
+// StatusREST implements the REST endpoint for changing the status of a receiver.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// New returns an empty object that can be used with Create and Update after request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	"k8s.io/apiserver/pkg/storage"
 	"k8s.io/apiserver/pkg/storage/names"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
 	"tkestack.io/tke/api/notify"
 	"tkestack.io/tke/pkg/apiserver/authentication"
 	"tkestack.io/tke/pkg/util/log"
@@ -40,12 +42,14 @@ import (
 type Strategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
+
+	notifyClient *notifyinternalclient.NotifyClient
 }
 
 // NewStrategy creates a strategy that is the default logic that applies when
 // creating and updating receiver objects.
-func NewStrategy() *Strategy {
-	return &Strategy{notify.Scheme, namesutil.Generator}
+func NewStrategy(notifyClient *notifyinternalclient.NotifyClient) *Strategy {
+	return &Strategy{notify.Scheme, namesutil.Generator, notifyClient}
 }
 
 // DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
@@ -69,6 +73,7 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	if receiver.Name == "" && receiver.GenerateName == "" {
 		receiver.GenerateName = "recv-"
 	}
+	receiver.Status = oldReceiver.Status
 }
 
 // NamespaceScoped is false for receivers.
@@ -93,11 +98,12 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	if receiver.Name == "" && receiver.GenerateName == "" {
 		receiver.GenerateName = "recv-"
 	}
+	receiver.Status = notify.ReceiverStatus{}
 }
 
 // Validate validates a new receiver.
 func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return ValidateReceiver(obj.(*notify.Receiver))
+	return ValidateReceiver(ctx, obj.(*notify.Receiver), s.notifyClient)
 }
 
 // AllowCreateOnUpdate is false for receivers.
@@ -123,7 +129,7 @@ func (Strategy) Canonicalize(obj runtime.Object) {
 
 // ValidateUpdate is the default update validation for an end receiver.
 func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return ValidateReceiverUpdate(obj.(*notify.Receiver), old.(*notify.Receiver))
+	return ValidateReceiverUpdate(ctx, obj.(*notify.Receiver), old.(*notify.Receiver), s.notifyClient)
 }
 
 // WarningsOnUpdate returns warnings for the given update.
@@ -163,3 +169,32 @@ func ToSelectableFields(receiver *notify.Receiver) fields.Set {
 	}
 	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
 }
+
+// StatusStrategy implements verification logic for status of receiver.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newReceiver := obj.(*notify.Receiver)
+	oldReceiver := old.(*notify.Receiver)
+	newReceiver.Spec = oldReceiver.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return apimachineryvalidation.ValidateObjectMetaUpdate(&obj.(*notify.Receiver).ObjectMeta, &old.(*notify.Receiver).ObjectMeta, field.NewPath("metadata"))
+}
@@ -19,10 +19,18 @@
 package receiver
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
 	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/util"
 )
 
 // ValidateReceiverName is a ValidateNameFunc for names that must be a DNS
@@ -30,7 +38,7 @@ import (
 var ValidateReceiverName = apimachineryvalidation.NameIsDNSLabel
 
 // ValidateReceiver tests if required fields in the receiver are set.
-func ValidateReceiver(receiver *notify.Receiver) field.ErrorList {
+func ValidateReceiver(ctx context.Context, receiver *notify.Receiver, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
 	allErrs := apimachineryvalidation.ValidateObjectMeta(&receiver.ObjectMeta, false, ValidateReceiverName, field.NewPath("metadata"))
 
 	if receiver.Spec.DisplayName == "" {
@@ -43,14 +51,107 @@ func ValidateReceiver(receiver *notify.Receiver) field.ErrorList {
 		}
 	}
 
+	if receiver.Spec.Preferences != nil {
+		allErrs = append(allErrs, validatePreferences(ctx, receiver, notifyClient, field.NewPath("spec", "preferences"))...)
+	}
+
+	return allErrs
+}
+
+func validatePreferences(ctx context.Context, receiver *notify.Receiver, notifyClient *notifyinternalclient.NotifyClient, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	preferences := receiver.Spec.Preferences
+
+	if preferences.TimeZone != "" {
+		if _, err := time.LoadLocation(preferences.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), preferences.TimeZone, err.Error()))
+		}
+	}
+
+	for i, quietHours := range preferences.QuietHours {
+		quietHoursPath := fldPath.Child("quietHours").Index(i)
+		for j, rc := range quietHours.Channels {
+			if !IsStandardReceiverChannel(string(rc)) {
+				allErrs = append(allErrs, field.Invalid(quietHoursPath.Child("channels").Index(j), rc, "must be a standard channel receiver"))
+			}
+		}
+		if _, err := util.ParseClockTime(quietHours.StartTime); err != nil {
+			allErrs = append(allErrs, field.Invalid(quietHoursPath.Child("startTime"), quietHours.StartTime, err.Error()))
+		}
+		if _, err := util.ParseClockTime(quietHours.EndTime); err != nil {
+			allErrs = append(allErrs, field.Invalid(quietHoursPath.Child("endTime"), quietHours.EndTime, err.Error()))
+		}
+	}
+
+	for i, rateLimit := range preferences.RateLimits {
+		rateLimitPath := fldPath.Child("rateLimits").Index(i)
+		if rateLimit.Channel != "" && !IsStandardReceiverChannel(string(rateLimit.Channel)) {
+			allErrs = append(allErrs, field.Invalid(rateLimitPath.Child("channel"), rateLimit.Channel, "must be a standard channel receiver"))
+		}
+		if rateLimit.Limit <= 0 {
+			allErrs = append(allErrs, field.Invalid(rateLimitPath.Child("limit"), rateLimit.Limit, "must be greater than 0"))
+		}
+		if rateLimit.PeriodMinutes <= 0 {
+			allErrs = append(allErrs, field.Invalid(rateLimitPath.Child("periodMinutes"), rateLimit.PeriodMinutes, "must be greater than 0"))
+		}
+	}
+
+	if digest := preferences.Digest; digest != nil {
+		digestPath := fldPath.Child("digest")
+		if len(digest.Severities) == 0 {
+			allErrs = append(allErrs, field.Required(digestPath.Child("severities"), "must specify a severity"))
+		}
+		if digest.IntervalMinutes < 0 {
+			allErrs = append(allErrs, field.Invalid(digestPath.Child("intervalMinutes"), digest.IntervalMinutes, "must be greater than or equal to 0"))
+		}
+		allErrs = append(allErrs, validateDigestChannel(ctx, receiver, digest, notifyClient, digestPath)...)
+	}
+
+	return allErrs
+}
+
+// validateDigestChannel tests if the channel and the template sending the
+// digest exist and belong to the tenant of the receiver.
+func validateDigestChannel(ctx context.Context, receiver *notify.Receiver, digest *notify.ReceiverDigest, notifyClient *notifyinternalclient.NotifyClient, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	channelPath := fldPath.Child("channel")
+	if digest.Channel == "" {
+		return append(allErrs, field.Required(channelPath, "must specify the channel sending the digest"))
+	}
+	channel, err := notifyClient.Channels().Get(ctx, digest.Channel, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return append(allErrs, field.NotFound(channelPath, digest.Channel))
+	} else if err != nil {
+		return append(allErrs, field.InternalError(channelPath, err))
+	} else if channel.Spec.TenantID != receiver.Spec.TenantID {
+		return append(allErrs, field.Forbidden(channelPath, "no authorized to send digest in this channel"))
+	} else if channel.Spec.SMTP == nil {
+		return append(allErrs, field.Invalid(channelPath, digest.Channel, "must be a channel configured with smtp server"))
+	}
+
+	templatePath := fldPath.Child("template")
+	if digest.Template == "" {
+		return append(allErrs, field.Required(templatePath, "must specify the template rendering the digest"))
+	}
+	template, err := notifyClient.Templates(channel.ObjectMeta.Name).Get(ctx, digest.Template, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.NotFound(templatePath, digest.Template))
+	} else if err != nil {
+		allErrs = append(allErrs, field.InternalError(templatePath, err))
+	} else if template.Spec.TenantID != receiver.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(templatePath, fmt.Sprintf("no authorized to render digest with template %s", digest.Template)))
+	} else if template.Spec.Text == nil {
+		allErrs = append(allErrs, field.Invalid(templatePath, digest.Template, "must be a text template"))
+	}
 	return allErrs
 }
 
 // ValidateReceiverUpdate tests if required fields in the receiver are set during
 // an update.
-func ValidateReceiverUpdate(receiver *notify.Receiver, old *notify.Receiver) field.ErrorList {
+func ValidateReceiverUpdate(ctx context.Context, receiver *notify.Receiver, old *notify.Receiver, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
 	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&receiver.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
-	allErrs = append(allErrs, ValidateReceiver(receiver)...)
+	allErrs = append(allErrs, ValidateReceiver(ctx, receiver, notifyClient)...)
 
 	if receiver.Spec.TenantID != old.Spec.TenantID {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
@@ -63,6 +164,10 @@ var standardReceiverChannel = sets.NewString(
 	string(notify.ReceiverChannelMobile),
 	string(notify.ReceiverChannelWechatOpenID),
 	string(notify.ReceiverChannelWebhook),
+	string(notify.ReceiverChannelSlack),
+	string(notify.ReceiverChannelDingTalk),
+	string(notify.ReceiverChannelWeCom),
+	string(notify.ReceiverChannelLark),
 )
 
 // IsStandardReceiverChannel returns true if the receiver channel is known to
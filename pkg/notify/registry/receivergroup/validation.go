@@ -43,7 +43,9 @@ func ValidateReceiverGroup(ctx context.Context, receiverGroup *notify.ReceiverGr
 	}
 
 	if len(receiverGroup.Spec.Receivers) == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec", "receivers"), "must specify a receiver"))
+		if receiverGroup.Spec.EscalationPolicy == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "receivers"), "must specify a receiver or an escalation policy"))
+		}
 	} else {
 		for _, receiverName := range receiverGroup.Spec.Receivers {
 			receiver, err := notifyClient.Receivers().Get(ctx, receiverName, metav1.GetOptions{})
@@ -57,6 +59,18 @@ func ValidateReceiverGroup(ctx context.Context, receiverGroup *notify.ReceiverGr
 		}
 	}
 
+	if receiverGroup.Spec.EscalationPolicy != "" {
+		fldPath := field.NewPath("spec", "escalationPolicy")
+		escalationPolicy, err := notifyClient.EscalationPolicies().Get(ctx, receiverGroup.Spec.EscalationPolicy, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath, receiverGroup.Spec.EscalationPolicy))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if escalationPolicy.Spec.TenantID != receiverGroup.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("no authorized to use escalation policy %s in this group", receiverGroup.Spec.EscalationPolicy)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -27,15 +27,20 @@ import (
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
 	restclient "k8s.io/client-go/rest"
 	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/api/notify"
 	v1 "tkestack.io/tke/api/notify/v1"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	auditrulestorage "tkestack.io/tke/pkg/notify/registry/auditrule/storage"
 	channelstorage "tkestack.io/tke/pkg/notify/registry/channel/storage"
 	configmapstorage "tkestack.io/tke/pkg/notify/registry/configmap/storage"
+	escalationpolicystorage "tkestack.io/tke/pkg/notify/registry/escalationpolicy/storage"
 	messagestorage "tkestack.io/tke/pkg/notify/registry/message/storage"
 	messagerequeststorage "tkestack.io/tke/pkg/notify/registry/messagerequest/storage"
+	oncallschedulestorage "tkestack.io/tke/pkg/notify/registry/oncallschedule/storage"
 	receiverstorage "tkestack.io/tke/pkg/notify/registry/receiver/storage"
 	receivergroupstorage "tkestack.io/tke/pkg/notify/registry/receivergroup/storage"
+	silencestorage "tkestack.io/tke/pkg/notify/registry/silence/storage"
 	templatestorage "tkestack.io/tke/pkg/notify/registry/template/storage"
 )
 
@@ -43,6 +48,7 @@ import (
 // RestStorageProvider interface
 type StorageProvider struct {
 	LoopbackClientConfig *restclient.Config
+	PlatformClient       platformversionedclient.PlatformV1Interface
 	PrivilegedUsername   string
 	MessageRequestTTL    time.Duration
 	MessageTTL           time.Duration
@@ -84,16 +90,32 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		messageREST := messagestorage.NewStorage(restOptionsGetter, s.PrivilegedUsername, s.MessageTTL)
 		storageMap["messages"] = messageREST.Message
 		storageMap["messages/status"] = messageREST.Status
+		storageMap["messages/acknowledge"] = messageREST.Acknowledge
 
 		messageRequestREST := messagerequeststorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername, s.MessageRequestTTL)
 		storageMap["messagerequests"] = messageRequestREST.MessageRequest
 		storageMap["messagerequests/status"] = messageRequestREST.Status
+		storageMap["messagerequests/retry"] = messageRequestREST.Retry
 
-		receiverREST := receiverstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		receiverREST := receiverstorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
 		storageMap["receivers"] = receiverREST.Receiver
+		storageMap["receivers/status"] = receiverREST.Status
 
 		receiverGroupREST := receivergroupstorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
 		storageMap["receivergroups"] = receiverGroupREST.ReceiverGroup
+
+		silenceREST := silencestorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["silences"] = silenceREST.Silence
+		storageMap["silences/status"] = silenceREST.Status
+
+		onCallScheduleREST := oncallschedulestorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
+		storageMap["oncallschedules"] = onCallScheduleREST.OnCallSchedule
+
+		escalationPolicyREST := escalationpolicystorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
+		storageMap["escalationpolicies"] = escalationPolicyREST.EscalationPolicy
+
+		auditRuleREST := auditrulestorage.NewStorage(restOptionsGetter, notifyClient, s.PlatformClient, s.PrivilegedUsername)
+		storageMap["auditrules"] = auditRuleREST.AuditRule
 	}
 
 	return storageMap
@@ -0,0 +1,199 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package silence
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for silence.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating silence objects.
+func NewStrategy() *Strategy {
+	return &Strategy{notify.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldSilence := old.(*notify.Silence)
+	silence, _ := obj.(*notify.Silence)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldSilence.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update silence information", log.String("oldTenantID", oldSilence.Spec.TenantID), log.String("newTenantID", silence.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		silence.Spec.TenantID = tenantID
+	}
+	silence.Spec.CreatedBy = oldSilence.Spec.CreatedBy
+	silence.Status = oldSilence.Status
+}
+
+// NamespaceScoped is false for silences.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	silence, _ := obj.(*notify.Silence)
+	if len(tenantID) != 0 {
+		silence.Spec.TenantID = tenantID
+	}
+	if len(username) != 0 {
+		silence.Spec.CreatedBy = username
+	}
+
+	if silence.Name == "" && silence.GenerateName == "" {
+		silence.GenerateName = "silence-"
+	}
+	for i := range silence.Spec.Matchers {
+		if silence.Spec.Matchers[i].Operator == "" {
+			silence.Spec.Matchers[i].Operator = notify.SilenceMatchEqual
+		}
+	}
+	silence.Status = notify.SilenceStatus{}
+}
+
+// Validate validates a new silence.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateSilence(obj.(*notify.Silence))
+}
+
+// AllowCreateOnUpdate is false for silences.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end silence.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateSilenceUpdate(obj.(*notify.Silence), old.(*notify.Silence))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	silence, ok := obj.(*notify.Silence)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a silence")
+	}
+	return labels.Set(silence.ObjectMeta.Labels), ToSelectableFields(silence), nil
+}
+
+// MatchSilence returns a generic matcher for a given label and field selector.
+func MatchSilence(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(silence *notify.Silence) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&silence.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":  silence.Spec.TenantID,
+		"spec.createdBy": silence.Spec.CreatedBy,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of silence.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newSilence := obj.(*notify.Silence)
+	oldSilence := old.(*notify.Silence)
+	newSilence.Spec = oldSilence.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateSilenceUpdate(obj.(*notify.Silence), old.(*notify.Silence))
+}
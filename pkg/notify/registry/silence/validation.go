@@ -0,0 +1,85 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package silence
+
+import (
+	"regexp"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateSilenceName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateSilenceName = apimachineryvalidation.NameIsDNSLabel
+
+var supportedOperators = sets.NewString(
+	string(notify.SilenceMatchEqual),
+	string(notify.SilenceMatchNotEqual),
+	string(notify.SilenceMatchRegexp),
+	string(notify.SilenceMatchNotRegexp),
+)
+
+// ValidateSilence tests if required fields in the silence are set.
+func ValidateSilence(silence *notify.Silence) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&silence.ObjectMeta, false, ValidateSilenceName, field.NewPath("metadata"))
+
+	fldPath := field.NewPath("spec", "matchers")
+	if len(silence.Spec.Matchers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify at least one matcher"))
+	}
+	for i, matcher := range silence.Spec.Matchers {
+		if matcher.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("name"), "must specify label name"))
+		}
+		if !supportedOperators.Has(string(matcher.Operator)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("operator"), matcher.Operator, supportedOperators.List()))
+			continue
+		}
+		if matcher.Operator == notify.SilenceMatchRegexp || matcher.Operator == notify.SilenceMatchNotRegexp {
+			if _, err := regexp.Compile("^(?:" + matcher.Value + ")$"); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("value"), matcher.Value, err.Error()))
+			}
+		}
+	}
+
+	if silence.Spec.StartsAt.IsZero() {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "startsAt"), "must specify start time"))
+	}
+	if silence.Spec.EndsAt.IsZero() {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "endsAt"), "must specify end time"))
+	} else if !silence.Spec.EndsAt.After(silence.Spec.StartsAt.Time) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "endsAt"), silence.Spec.EndsAt, "must be after the start time"))
+	}
+
+	return allErrs
+}
+
+// ValidateSilenceUpdate tests if required fields in the silence are set during
+// an update.
+func ValidateSilenceUpdate(silence *notify.Silence, old *notify.Silence) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&silence.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateSilence(silence)...)
+
+	if silence.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
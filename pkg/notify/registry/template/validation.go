@@ -79,6 +79,16 @@ func ValidateTemplate(ctx context.Context, template *notify.Template, notifyClie
 					}
 				}
 			}
+
+			if channel.Spec.Slack != nil || channel.Spec.DingTalk != nil || channel.Spec.WeCom != nil || channel.Spec.Lark != nil {
+				if template.Spec.Markdown == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("markdown"), "must specify markdown template"))
+				} else {
+					if template.Spec.Markdown.Body == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("markdown", "body"), "must specify body of markdown channel"))
+					}
+				}
+			}
 		}
 	}
 
@@ -98,3 +98,51 @@ func FilterTemplate(ctx context.Context, tpl *notify.Template) error {
 	}
 	return nil
 }
+
+// FilterSilence is used to filter silence that do not belong to the tenant.
+func FilterSilence(ctx context.Context, silence *notify.Silence) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if silence.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("silence"), silence.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterOnCallSchedule is used to filter on-call schedule that do not belong to the tenant.
+func FilterOnCallSchedule(ctx context.Context, onCallSchedule *notify.OnCallSchedule) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if onCallSchedule.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("oncallschedule"), onCallSchedule.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterEscalationPolicy is used to filter escalation policy that do not belong to the tenant.
+func FilterEscalationPolicy(ctx context.Context, escalationPolicy *notify.EscalationPolicy) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if escalationPolicy.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("escalationpolicy"), escalationPolicy.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterAuditRule is used to filter audit rule that do not belong to the tenant.
+func FilterAuditRule(ctx context.Context, auditRule *notify.AuditRule) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if auditRule.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("auditrule"), auditRule.ObjectMeta.Name)
+	}
+	return nil
+}
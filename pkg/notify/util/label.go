@@ -0,0 +1,28 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package util
+
+const (
+	// AnnotationSendAfter is the annotation name of a message request holding
+	// the time in RFC 3339 format before which the message request is not sent
+	AnnotationSendAfter = "notify.tkestack.io/sendAfter"
+	// LabelAlertPrefix is the prefix of the label names of a message request
+	// created by the alarm webhook, each of which is followed by the
+	// fingerprint of an alert the message request contains
+	LabelAlertPrefix = "alert.notify.tkestack.io/"
+)
@@ -39,6 +39,7 @@ type ClusterGetter interface {
 type BusinessObjectGetter interface {
 	Project(ctx context.Context, name string, options metav1.GetOptions) (*business.Project, error)
 	Namespace(ctx context.Context, project, name string, options metav1.GetOptions) (*business.Namespace, error)
+	NamespaceTemplate(ctx context.Context, name string, options metav1.GetOptions) (*business.NamespaceTemplate, error)
 }
 
 func NewClusterGetter(platformClient platformversionedclient.PlatformV1Interface) ClusterGetter {
@@ -69,6 +70,10 @@ func (getter *businessObjectGetter) Namespace(ctx context.Context, project, name
 	return getter.businessClient.Namespaces(project).Get(ctx, name, options)
 }
 
+func (getter *businessObjectGetter) NamespaceTemplate(ctx context.Context, name string, options metav1.GetOptions) (*business.NamespaceTemplate, error) {
+	return getter.businessClient.NamespaceTemplates().Get(ctx, name, options)
+}
+
 // ValidateCluster validate cluster
 func ValidateCluster(ctx context.Context, platformClient platforminternalclient.PlatformInterface, clusterName string) field.ErrorList {
 	var allErrs field.ErrorList